@@ -4,6 +4,7 @@ package api
 
 import (
 	"context"
+	"io"
 	"net/url"
 	"strings"
 	"time"
@@ -17,7 +18,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
-	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -34,6 +35,12 @@ type Invoker interface {
 	//
 	// POST /config/views
 	AddView(ctx context.Context, params AddViewParams) (AddViewRes, error)
+	// AggregateRecords invokes aggregateRecords operation.
+	//
+	// Aggregate records of a table with count, sum, min, max or avg grouped by the given fields.
+	//
+	// GET /rest/aggregate/{table}
+	AggregateRecords(ctx context.Context, params AggregateRecordsParams) (AggregateRecordsRes, error)
 	// BatchParameterQuery invokes batchParameterQuery operation.
 	//
 	// Call a SQL query batch command posted in query.
@@ -76,12 +83,25 @@ type Invoker interface {
 	//
 	// POST /rest/extend/{path}
 	CallPostExtend(ctx context.Context, request *CallPostExtendReq, params CallPostExtendParams) (CallPostExtendRes, error)
+	// CancelUpload invokes cancelUpload operation.
+	//
+	// Cancel a resumable upload and remove the received data.
+	//
+	// DELETE /rest/uploads/{uploadId}
+	CancelUpload(ctx context.Context, params CancelUploadParams) (CancelUploadRes, error)
 	// CreateDirectory invokes createDirectory operation.
 	//
 	// Create a new directory.
 	//
 	// PUT /rest/file/{path}
 	CreateDirectory(ctx context.Context, params CreateDirectoryParams) (CreateDirectoryRes, error)
+	// CreateUpload invokes createUpload operation.
+	//
+	// Create a resumable upload of a new file to the given location. The data is sent in chunks to the
+	// returned upload.
+	//
+	// POST /rest/upload/{path}
+	CreateUpload(ctx context.Context, params CreateUploadParams) (CreateUploadRes, error)
 	// DeleteExtend invokes deleteExtend operation.
 	//
 	// Delete extend/plugin data.
@@ -100,6 +120,12 @@ type Invoker interface {
 	//
 	// DELETE /tasks/{jobName}/{jobId}
 	DeleteJobResult(ctx context.Context, params DeleteJobResultParams) (DeleteJobResultRes, error)
+	// DeleteRecordByKey invokes deleteRecordByKey operation.
+	//
+	// Delete the record with the given primary key.
+	//
+	// DELETE /rest/view/{table}/pk/{key}
+	DeleteRecordByKey(ctx context.Context, params DeleteRecordByKeyParams) (DeleteRecordByKeyRes, error)
 	// DeleteRecordsSearched invokes deleteRecordsSearched operation.
 	//
 	// Delete a record with a given search.
@@ -118,6 +144,19 @@ type Invoker interface {
 	//
 	// GET /rest/file/{path}
 	DownloadFile(ctx context.Context, params DownloadFileParams) (DownloadFileRes, error)
+	// ExecuteTransaction invokes executeTransaction operation.
+	//
+	// Execute a list of insert, update and delete operations on tables of one database in one transaction.
+	//
+	// POST /rest/transaction
+	ExecuteTransaction(ctx context.Context, request *Transaction) (ExecuteTransactionRes, error)
+	// GetArchive invokes getArchive operation.
+	//
+	// Retrieves the large object field of all records matching the search as ZIP archive with one entry
+	// per record.
+	//
+	// GET /archive/{table}/{field}/{search}
+	GetArchive(ctx context.Context, params GetArchiveParams) (GetArchiveRes, error)
 	// GetConfig invokes getConfig operation.
 	//
 	// Get current active configuration.
@@ -142,6 +181,31 @@ type Invoker interface {
 	//
 	// GET /image/{table}/{field}/{search}
 	GetImage(ctx context.Context, params GetImageParams) (GetImageRes, error)
+	// GetImageCacheStats invokes getImageCacheStats operation.
+	//
+	// Get hit and miss statistics of the derived image cache.
+	//
+	// GET /config/images/cache
+	GetImageCacheStats(ctx context.Context) (GetImageCacheStatsRes, error)
+	// GetImageDuplicates invokes getImageDuplicates operation.
+	//
+	// Retrieves groups of records with near-duplicate images out of the stored perceptual hashes.
+	//
+	// GET /hash/{table}/{hashField}/duplicates
+	GetImageDuplicates(ctx context.Context, params GetImageDuplicatesParams) (GetImageDuplicatesRes, error)
+	// GetImageHash invokes getImageHash operation.
+	//
+	// Calculates the perceptual hash of an image field of a specific table record.
+	//
+	// GET /image/{table}/{field}/{search}/hash
+	GetImageHash(ctx context.Context, params GetImageHashParams) (GetImageHashRes, error)
+	// GetImageMetadata invokes getImageMetadata operation.
+	//
+	// Retrieves the EXIF tags, dimensions and format of an image field of a specific table record without
+	// the image data.
+	//
+	// GET /image/{table}/{field}/{search}/metadata
+	GetImageMetadata(ctx context.Context, params GetImageMetadataParams) (GetImageMetadataRes, error)
 	// GetJobExecutionResult invokes getJobExecutionResult operation.
 	//
 	// Retrieves a specific job result.
@@ -202,6 +266,24 @@ type Invoker interface {
 	//
 	// GET /rest/view
 	GetMaps(ctx context.Context) (GetMapsRes, error)
+	// GetRecordByKey invokes getRecordByKey operation.
+	//
+	// Read the record with the given primary key.
+	//
+	// GET /rest/view/{table}/pk/{key}
+	GetRecordByKey(ctx context.Context, params GetRecordByKeyParams) (GetRecordByKeyRes, error)
+	// GetRecordHistory invokes getRecordHistory operation.
+	//
+	// Read the change history of the record with the given primary key.
+	//
+	// GET /rest/history/{table}/{key}
+	GetRecordHistory(ctx context.Context, params GetRecordHistoryParams) (GetRecordHistoryRes, error)
+	// GetUpload invokes getUpload operation.
+	//
+	// Retrieves the offset and state of a resumable upload.
+	//
+	// GET /rest/uploads/{uploadId}
+	GetUpload(ctx context.Context, params GetUploadParams) (GetUploadRes, error)
 	// GetUserInfo invokes getUserInfo operation.
 	//
 	// Get the token user information.
@@ -226,6 +308,18 @@ type Invoker interface {
 	//
 	// GET /config/views
 	GetViews(ctx context.Context) (GetViewsRes, error)
+	// HeadMapRecordsFields invokes headMapRecordsFields operation.
+	//
+	// Return the total number of records matching the search in the X-Total-Count header.
+	//
+	// HEAD /rest/view/{table}/{fields}/{search}
+	HeadMapRecordsFields(ctx context.Context, params HeadMapRecordsFieldsParams) (HeadMapRecordsFieldsRes, error)
+	// ImportRecords invokes importRecords operation.
+	//
+	// Import a CSV or NDJSON stream of records into a table.
+	//
+	// POST /rest/import/{table}
+	ImportRecords(ctx context.Context, request ImportRecordsReq, params ImportRecordsParams) (ImportRecordsRes, error)
 	// InsertMapFileRecords invokes insertMapFileRecords operation.
 	//
 	// Store send records into Map definition.
@@ -262,10 +356,16 @@ type Invoker interface {
 	//
 	// PUT /logout
 	LogoutSessionCompat(ctx context.Context) (LogoutSessionCompatRes, error)
+	// PatchRecordByKey invokes patchRecordByKey operation.
+	//
+	// Update the fields of the record with the given primary key present in the JSON Merge Patch.
+	//
+	// PATCH /rest/view/{table}/pk/{key}
+	PatchRecordByKey(ctx context.Context, request PatchRecordByKeyReq, params PatchRecordByKeyParams) (PatchRecordByKeyRes, error)
 	// PostDatabase invokes postDatabase operation.
 	//
-	// Create a new database, the input need to be JSON. A structure level parameter indicate version to
-	// be used.
+	// Create a new database, the input need to be JSON. A structure level parameter indicate version to be
+	// used.
 	//
 	// POST /rest/database
 	PostDatabase(ctx context.Context, request *Database) (PostDatabaseRes, error)
@@ -275,6 +375,12 @@ type Invoker interface {
 	//
 	// POST /tasks
 	PostJob(ctx context.Context, request PostJobReq) (PostJobRes, error)
+	// PurgeImageCache invokes purgeImageCache operation.
+	//
+	// Purge the derived image cache, all entries or the entries of one table.
+	//
+	// DELETE /config/images/cache
+	PurgeImageCache(ctx context.Context, params PurgeImageCacheParams) (PurgeImageCacheRes, error)
 	// PushLoginSession invokes pushLoginSession operation.
 	//
 	// Login using baseauth or bearer to receive or validate token.
@@ -341,9 +447,19 @@ type Invoker interface {
 	//
 	// PUT /tasks/{jobName}
 	TriggerJob(ctx context.Context, params TriggerJobParams) (TriggerJobRes, error)
+	// UpdateImageHashes invokes updateImageHashes operation.
+	//
+	// Calculates the perceptual hash of the image field of all records matching the search and stores it
+	// in the hash field.
+	//
+	// PUT /hash/{table}/{field}
+	UpdateImageHashes(ctx context.Context, params UpdateImageHashesParams) (UpdateImageHashesRes, error)
 	// UpdateLobByMap invokes updateLobByMap operation.
 	//
-	// Set a lob at a specific table record of an field in a Map.
+	// Set a lob at a specific table record of an field in a Map. The request body is streamed into the
+	// field of exactly one record matching the search. On PostgreSQL and MySQL tables with primary key the
+	// body is appended block by block inside one transaction, other tables read the body into memory up to
+	// the maxBinaryBufferSize limit.
 	//
 	// PUT /binary/{table}/{field}/{search}
 	UpdateLobByMap(ctx context.Context, request UpdateLobByMapReq, params UpdateLobByMapParams) (UpdateLobByMapRes, error)
@@ -353,6 +469,13 @@ type Invoker interface {
 	//
 	// PUT /rest/view/{table}/{search}
 	UpdateRecordsByFields(ctx context.Context, request OptUpdateRecordsByFieldsReq, params UpdateRecordsByFieldsParams) (UpdateRecordsByFieldsRes, error)
+	// UploadChunk invokes uploadChunk operation.
+	//
+	// Append a chunk of data at the offset of a resumable upload. The file is moved to the location if the
+	// upload is complete.
+	//
+	// PATCH /rest/uploads/{uploadId}
+	UploadChunk(ctx context.Context, request UploadChunkReq, params UploadChunkParams) (UploadChunkRes, error)
 	// UploadFile invokes uploadFile operation.
 	//
 	// Upload a new file to the given location.
@@ -367,14 +490,6 @@ type Client struct {
 	sec       SecuritySource
 	baseClient
 }
-type errorHandler interface {
-	NewError(ctx context.Context, err error) *ErrorStatusCode
-}
-
-var _ Handler = struct {
-	errorHandler
-	*Client
-}{}
 
 // NewClient initializes new Client defined by OAS.
 func NewClient(serverURL string, sec SecuritySource, opts ...ClientOption) (*Client, error) {
@@ -561,7 +676,14 @@ func (c *Client) sendAddView(ctx context.Context, params AddViewParams) (res Add
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
 	result, err := decodeAddViewResponse(resp)
@@ -572,21 +694,21 @@ func (c *Client) sendAddView(ctx context.Context, params AddViewParams) (res Add
 	return result, nil
 }
 
-// BatchParameterQuery invokes batchParameterQuery operation.
+// AggregateRecords invokes aggregateRecords operation.
 //
-// Call a SQL query batch command posted in query.
+// Aggregate records of a table with count, sum, min, max or avg grouped by the given fields.
 //
-// GET /rest/batch/{table}/{query}
-func (c *Client) BatchParameterQuery(ctx context.Context, params BatchParameterQueryParams) (BatchParameterQueryRes, error) {
-	res, err := c.sendBatchParameterQuery(ctx, params)
+// GET /rest/aggregate/{table}
+func (c *Client) AggregateRecords(ctx context.Context, params AggregateRecordsParams) (AggregateRecordsRes, error) {
+	res, err := c.sendAggregateRecords(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendBatchParameterQuery(ctx context.Context, params BatchParameterQueryParams) (res BatchParameterQueryRes, err error) {
+func (c *Client) sendAggregateRecords(ctx context.Context, params AggregateRecordsParams) (res AggregateRecordsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("batchParameterQuery"),
+		otelogen.OperationID("aggregateRecords"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/batch/{table}/{query}"),
+		semconv.URLTemplateKey.String("/rest/aggregate/{table}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -602,7 +724,7 @@ func (c *Client) sendBatchParameterQuery(ctx context.Context, params BatchParame
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, BatchParameterQueryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AggregateRecordsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -619,8 +741,8 @@ func (c *Client) sendBatchParameterQuery(ctx context.Context, params BatchParame
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/rest/batch/"
+	var pathParts [2]string
+	pathParts[0] = "/rest/aggregate/"
 	{
 		// Encode "table" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -639,39 +761,102 @@ func (c *Client) sendBatchParameterQuery(ctx context.Context, params BatchParame
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "query" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "query",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Query))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "metrics" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "metrics",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Metrics))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[3] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "groupby" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "groupby",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Groupby.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
-		// Encode "validate" parameter.
+		// Encode "filter" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "validate",
+			Name:    "filter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Validate.Get(); ok {
+			if val, ok := params.Filter.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "having" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "having",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Having.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "orderby" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "orderby",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Orderby.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -692,7 +877,7 @@ func (c *Client) sendBatchParameterQuery(ctx context.Context, params BatchParame
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, BatchParameterQueryOperation, r); {
+			switch err := c.securityBasicAuth(ctx, AggregateRecordsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -703,7 +888,7 @@ func (c *Client) sendBatchParameterQuery(ctx context.Context, params BatchParame
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, BatchParameterQueryOperation, r); {
+			switch err := c.securityTokenCheck(ctx, AggregateRecordsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -714,7 +899,7 @@ func (c *Client) sendBatchParameterQuery(ctx context.Context, params BatchParame
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, BatchParameterQueryOperation, r); {
+			switch err := c.securityBearerAuth(ctx, AggregateRecordsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -749,10 +934,17 @@ func (c *Client) sendBatchParameterQuery(ctx context.Context, params BatchParame
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeBatchParameterQueryResponse(resp)
+	result, err := decodeAggregateRecordsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -760,21 +952,21 @@ func (c *Client) sendBatchParameterQuery(ctx context.Context, params BatchParame
 	return result, nil
 }
 
-// BatchQuery invokes batchQuery operation.
+// BatchParameterQuery invokes batchParameterQuery operation.
 //
-// Call a SQL query batch command using insert or update data in body.
+// Call a SQL query batch command posted in query.
 //
-// POST /rest/batch/{table}
-func (c *Client) BatchQuery(ctx context.Context, request BatchQueryReq, params BatchQueryParams) (BatchQueryRes, error) {
-	res, err := c.sendBatchQuery(ctx, request, params)
+// GET /rest/batch/{table}/{query}
+func (c *Client) BatchParameterQuery(ctx context.Context, params BatchParameterQueryParams) (BatchParameterQueryRes, error) {
+	res, err := c.sendBatchParameterQuery(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendBatchQuery(ctx context.Context, request BatchQueryReq, params BatchQueryParams) (res BatchQueryRes, err error) {
+func (c *Client) sendBatchParameterQuery(ctx context.Context, params BatchParameterQueryParams) (res BatchParameterQueryRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("batchQuery"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/rest/batch/{table}"),
+		otelogen.OperationID("batchParameterQuery"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/rest/batch/{table}/{query}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -790,7 +982,7 @@ func (c *Client) sendBatchQuery(ctx context.Context, request BatchQueryReq, para
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, BatchQueryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, BatchParameterQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -807,7 +999,7 @@ func (c *Client) sendBatchQuery(ctx context.Context, request BatchQueryReq, para
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [4]string
 	pathParts[0] = "/rest/batch/"
 	{
 		// Encode "table" parameter.
@@ -827,20 +1019,39 @@ func (c *Client) sendBatchQuery(ctx context.Context, request BatchQueryReq, para
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/"
+	{
+		// Encode "query" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "query",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Query))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "validate" parameter.
+		// Encode "delimiter" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "validate",
+			Name:    "delimiter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Validate.Get(); ok {
+			if val, ok := params.Delimiter.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -848,52 +1059,151 @@ func (c *Client) sendBatchQuery(ctx context.Context, request BatchQueryReq, para
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
-	u.RawQuery = q.Values().Encode()
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-	if err := encodeBatchQueryRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
-
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, BatchQueryOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BasicAuth\"")
-			}
+		// Encode "quote" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "quote",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		{
-			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, BatchQueryOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 1
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"TokenCheck\"")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Quote.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, BatchQueryOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 2
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
-			}
+	}
+	{
+		// Encode "header" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "header",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Header.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "null" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "null",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Null.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dateformat" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dateformat",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Dateformat.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "bom" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "bom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Bom.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "validate" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "validate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Validate.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, BatchParameterQueryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, BatchParameterQueryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, BatchParameterQueryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
 		}
 
 		if ok := func() bool {
@@ -921,10 +1231,17 @@ func (c *Client) sendBatchQuery(ctx context.Context, request BatchQueryReq, para
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeBatchQueryResponse(resp)
+	result, err := decodeBatchParameterQueryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -932,20 +1249,20 @@ func (c *Client) sendBatchQuery(ctx context.Context, request BatchQueryReq, para
 	return result, nil
 }
 
-// BatchSelect invokes batchSelect operation.
+// BatchQuery invokes batchQuery operation.
 //
-// Call a SQL query batch command store in batch database table with name.
+// Call a SQL query batch command using insert or update data in body.
 //
-// GET /rest/batch/{table}
-func (c *Client) BatchSelect(ctx context.Context, params BatchSelectParams) (BatchSelectRes, error) {
-	res, err := c.sendBatchSelect(ctx, params)
+// POST /rest/batch/{table}
+func (c *Client) BatchQuery(ctx context.Context, request BatchQueryReq, params BatchQueryParams) (BatchQueryRes, error) {
+	res, err := c.sendBatchQuery(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendBatchSelect(ctx context.Context, params BatchSelectParams) (res BatchSelectRes, err error) {
+func (c *Client) sendBatchQuery(ctx context.Context, request BatchQueryReq, params BatchQueryParams) (res BatchQueryRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("batchSelect"),
-		semconv.HTTPRequestMethodKey.String("GET"),
+		otelogen.OperationID("batchQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
 		semconv.URLTemplateKey.String("/rest/batch/{table}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
@@ -962,7 +1279,7 @@ func (c *Client) sendBatchSelect(ctx context.Context, params BatchSelectParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, BatchSelectOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, BatchQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1004,25 +1321,101 @@ func (c *Client) sendBatchSelect(ctx context.Context, params BatchSelectParams)
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "param" parameter.
+		// Encode "delimiter" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "param",
+			Name:    "delimiter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.Param != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.Param {
-						if err := func() error {
-							return e.EncodeValue(conv.StringToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
+			if val, ok := params.Delimiter.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "quote" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "quote",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Quote.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "header" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "header",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Header.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "null" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "null",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Null.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dateformat" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dateformat",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Dateformat.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "bom" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "bom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Bom.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -1049,17 +1442,20 @@ func (c *Client) sendBatchSelect(ctx context.Context, params BatchSelectParams)
 	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeBatchQueryRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, BatchSelectOperation, r); {
+			switch err := c.securityBasicAuth(ctx, BatchQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1070,7 +1466,7 @@ func (c *Client) sendBatchSelect(ctx context.Context, params BatchSelectParams)
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, BatchSelectOperation, r); {
+			switch err := c.securityTokenCheck(ctx, BatchQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1081,7 +1477,7 @@ func (c *Client) sendBatchSelect(ctx context.Context, params BatchSelectParams)
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, BatchSelectOperation, r); {
+			switch err := c.securityBearerAuth(ctx, BatchQueryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1116,10 +1512,17 @@ func (c *Client) sendBatchSelect(ctx context.Context, params BatchSelectParams)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeBatchSelectResponse(resp)
+	result, err := decodeBatchQueryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1127,21 +1530,21 @@ func (c *Client) sendBatchSelect(ctx context.Context, params BatchSelectParams)
 	return result, nil
 }
 
-// BrowseList invokes browseList operation.
+// BatchSelect invokes batchSelect operation.
 //
-// Retrieves a list of Browseable locations.
+// Call a SQL query batch command store in batch database table with name.
 //
-// GET /rest/file/browse
-func (c *Client) BrowseList(ctx context.Context) (BrowseListRes, error) {
-	res, err := c.sendBrowseList(ctx)
+// GET /rest/batch/{table}
+func (c *Client) BatchSelect(ctx context.Context, params BatchSelectParams) (BatchSelectRes, error) {
+	res, err := c.sendBatchSelect(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendBrowseList(ctx context.Context) (res BrowseListRes, err error) {
+func (c *Client) sendBatchSelect(ctx context.Context, params BatchSelectParams) (res BatchSelectRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("browseList"),
+		otelogen.OperationID("batchSelect"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/file/browse"),
+		semconv.URLTemplateKey.String("/rest/batch/{table}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1157,7 +1560,7 @@ func (c *Client) sendBrowseList(ctx context.Context) (res BrowseListRes, err err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, BrowseListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, BatchSelectOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1174,11 +1577,178 @@ func (c *Client) sendBrowseList(ctx context.Context) (res BrowseListRes, err err
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/rest/file/browse"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
+	var pathParts [2]string
+	pathParts[0] = "/rest/batch/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "param" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "param",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Param != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Param {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "delimiter" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "delimiter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Delimiter.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "quote" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "quote",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Quote.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "header" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "header",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Header.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "null" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "null",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Null.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dateformat" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dateformat",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Dateformat.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "bom" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "bom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Bom.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "validate" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "validate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Validate.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -1189,7 +1759,7 @@ func (c *Client) sendBrowseList(ctx context.Context) (res BrowseListRes, err err
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, BrowseListOperation, r); {
+			switch err := c.securityBasicAuth(ctx, BatchSelectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1200,7 +1770,7 @@ func (c *Client) sendBrowseList(ctx context.Context) (res BrowseListRes, err err
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, BrowseListOperation, r); {
+			switch err := c.securityTokenCheck(ctx, BatchSelectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1211,7 +1781,7 @@ func (c *Client) sendBrowseList(ctx context.Context) (res BrowseListRes, err err
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, BrowseListOperation, r); {
+			switch err := c.securityBearerAuth(ctx, BatchSelectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1246,10 +1816,17 @@ func (c *Client) sendBrowseList(ctx context.Context) (res BrowseListRes, err err
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeBrowseListResponse(resp)
+	result, err := decodeBatchSelectResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1257,21 +1834,21 @@ func (c *Client) sendBrowseList(ctx context.Context) (res BrowseListRes, err err
 	return result, nil
 }
 
-// BrowseLocation invokes browseLocation operation.
+// BrowseList invokes browseList operation.
 //
-// Retrieves a list of files in the defined location.
+// Retrieves a list of Browseable locations.
 //
-// GET /rest/file/browse/{path}
-func (c *Client) BrowseLocation(ctx context.Context, params BrowseLocationParams) (BrowseLocationRes, error) {
-	res, err := c.sendBrowseLocation(ctx, params)
+// GET /rest/file/browse
+func (c *Client) BrowseList(ctx context.Context) (BrowseListRes, error) {
+	res, err := c.sendBrowseList(ctx)
 	return res, err
 }
 
-func (c *Client) sendBrowseLocation(ctx context.Context, params BrowseLocationParams) (res BrowseLocationRes, err error) {
+func (c *Client) sendBrowseList(ctx context.Context) (res BrowseListRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("browseLocation"),
+		otelogen.OperationID("browseList"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/file/browse/{path}"),
+		semconv.URLTemplateKey.String("/rest/file/browse"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1287,7 +1864,7 @@ func (c *Client) sendBrowseLocation(ctx context.Context, params BrowseLocationPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, BrowseLocationOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, BrowseListOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1304,49 +1881,10 @@ func (c *Client) sendBrowseLocation(ctx context.Context, params BrowseLocationPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/rest/file/browse/"
-	{
-		// Encode "path" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "path",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Path))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/rest/file/browse"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "filter" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "filter",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Filter.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -1358,7 +1896,7 @@ func (c *Client) sendBrowseLocation(ctx context.Context, params BrowseLocationPa
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, BrowseLocationOperation, r); {
+			switch err := c.securityBasicAuth(ctx, BrowseListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1369,7 +1907,7 @@ func (c *Client) sendBrowseLocation(ctx context.Context, params BrowseLocationPa
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, BrowseLocationOperation, r); {
+			switch err := c.securityTokenCheck(ctx, BrowseListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1380,7 +1918,7 @@ func (c *Client) sendBrowseLocation(ctx context.Context, params BrowseLocationPa
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, BrowseLocationOperation, r); {
+			switch err := c.securityBearerAuth(ctx, BrowseListOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1415,10 +1953,17 @@ func (c *Client) sendBrowseLocation(ctx context.Context, params BrowseLocationPa
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeBrowseLocationResponse(resp)
+	result, err := decodeBrowseListResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1426,21 +1971,21 @@ func (c *Client) sendBrowseLocation(ctx context.Context, params BrowseLocationPa
 	return result, nil
 }
 
-// CallExtend invokes callExtend operation.
+// BrowseLocation invokes browseLocation operation.
 //
-// Call extend plugin, own implementation plugin.
+// Retrieves a list of files in the defined location.
 //
-// GET /rest/extend/{path}
-func (c *Client) CallExtend(ctx context.Context, params CallExtendParams) (CallExtendRes, error) {
-	res, err := c.sendCallExtend(ctx, params)
+// GET /rest/file/browse/{path}
+func (c *Client) BrowseLocation(ctx context.Context, params BrowseLocationParams) (BrowseLocationRes, error) {
+	res, err := c.sendBrowseLocation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCallExtend(ctx context.Context, params CallExtendParams) (res CallExtendRes, err error) {
+func (c *Client) sendBrowseLocation(ctx context.Context, params BrowseLocationParams) (res BrowseLocationRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("callExtend"),
+		otelogen.OperationID("browseLocation"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/extend/{path}"),
+		semconv.URLTemplateKey.String("/rest/file/browse/{path}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1456,7 +2001,7 @@ func (c *Client) sendCallExtend(ctx context.Context, params CallExtendParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CallExtendOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, BrowseLocationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1474,7 +2019,7 @@ func (c *Client) sendCallExtend(ctx context.Context, params CallExtendParams) (r
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/rest/extend/"
+	pathParts[0] = "/rest/file/browse/"
 	{
 		// Encode "path" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1498,16 +2043,16 @@ func (c *Client) sendCallExtend(ctx context.Context, params CallExtendParams) (r
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "params" parameter.
+		// Encode "filter" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "params",
+			Name:    "filter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if v := params.Params; v != nil {
-				return (*v).EncodeURI(e)
+			if val, ok := params.Filter.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -1527,7 +2072,7 @@ func (c *Client) sendCallExtend(ctx context.Context, params CallExtendParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, CallExtendOperation, r); {
+			switch err := c.securityBasicAuth(ctx, BrowseLocationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1538,7 +2083,7 @@ func (c *Client) sendCallExtend(ctx context.Context, params CallExtendParams) (r
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, CallExtendOperation, r); {
+			switch err := c.securityTokenCheck(ctx, BrowseLocationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1549,7 +2094,7 @@ func (c *Client) sendCallExtend(ctx context.Context, params CallExtendParams) (r
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CallExtendOperation, r); {
+			switch err := c.securityBearerAuth(ctx, BrowseLocationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1584,10 +2129,17 @@ func (c *Client) sendCallExtend(ctx context.Context, params CallExtendParams) (r
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeCallExtendResponse(resp)
+	result, err := decodeBrowseLocationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1595,20 +2147,20 @@ func (c *Client) sendCallExtend(ctx context.Context, params CallExtendParams) (r
 	return result, nil
 }
 
-// CallPostExtend invokes callPostExtend operation.
+// CallExtend invokes callExtend operation.
 //
-// Post extend/plugin.
+// Call extend plugin, own implementation plugin.
 //
-// POST /rest/extend/{path}
-func (c *Client) CallPostExtend(ctx context.Context, request *CallPostExtendReq, params CallPostExtendParams) (CallPostExtendRes, error) {
-	res, err := c.sendCallPostExtend(ctx, request, params)
+// GET /rest/extend/{path}
+func (c *Client) CallExtend(ctx context.Context, params CallExtendParams) (CallExtendRes, error) {
+	res, err := c.sendCallExtend(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCallPostExtend(ctx context.Context, request *CallPostExtendReq, params CallPostExtendParams) (res CallPostExtendRes, err error) {
+func (c *Client) sendCallExtend(ctx context.Context, params CallExtendParams) (res CallExtendRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("callPostExtend"),
-		semconv.HTTPRequestMethodKey.String("POST"),
+		otelogen.OperationID("callExtend"),
+		semconv.HTTPRequestMethodKey.String("GET"),
 		semconv.URLTemplateKey.String("/rest/extend/{path}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
@@ -1625,7 +2177,7 @@ func (c *Client) sendCallPostExtend(ctx context.Context, request *CallPostExtend
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CallPostExtendOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CallExtendOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1667,15 +2219,18 @@ func (c *Client) sendCallPostExtend(ctx context.Context, request *CallPostExtend
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "file" parameter.
+		// Encode "params" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "file",
+			Name:    "params",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.File))
+			if v := params.Params; v != nil {
+				return (*v).EncodeURI(e)
+			}
+			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
@@ -1683,20 +2238,17 @@ func (c *Client) sendCallPostExtend(ctx context.Context, request *CallPostExtend
 	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCallPostExtendRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, CallPostExtendOperation, r); {
+			switch err := c.securityBasicAuth(ctx, CallExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1707,7 +2259,7 @@ func (c *Client) sendCallPostExtend(ctx context.Context, request *CallPostExtend
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, CallPostExtendOperation, r); {
+			switch err := c.securityTokenCheck(ctx, CallExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1718,7 +2270,7 @@ func (c *Client) sendCallPostExtend(ctx context.Context, request *CallPostExtend
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CallPostExtendOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CallExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1753,10 +2305,17 @@ func (c *Client) sendCallPostExtend(ctx context.Context, request *CallPostExtend
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeCallPostExtendResponse(resp)
+	result, err := decodeCallExtendResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1764,21 +2323,21 @@ func (c *Client) sendCallPostExtend(ctx context.Context, request *CallPostExtend
 	return result, nil
 }
 
-// CreateDirectory invokes createDirectory operation.
+// CallPostExtend invokes callPostExtend operation.
 //
-// Create a new directory.
+// Post extend/plugin.
 //
-// PUT /rest/file/{path}
-func (c *Client) CreateDirectory(ctx context.Context, params CreateDirectoryParams) (CreateDirectoryRes, error) {
-	res, err := c.sendCreateDirectory(ctx, params)
+// POST /rest/extend/{path}
+func (c *Client) CallPostExtend(ctx context.Context, request *CallPostExtendReq, params CallPostExtendParams) (CallPostExtendRes, error) {
+	res, err := c.sendCallPostExtend(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateDirectory(ctx context.Context, params CreateDirectoryParams) (res CreateDirectoryRes, err error) {
+func (c *Client) sendCallPostExtend(ctx context.Context, request *CallPostExtendReq, params CallPostExtendParams) (res CallPostExtendRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createDirectory"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/rest/file/{path}"),
+		otelogen.OperationID("callPostExtend"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/rest/extend/{path}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1794,7 +2353,7 @@ func (c *Client) sendCreateDirectory(ctx context.Context, params CreateDirectory
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateDirectoryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CallPostExtendOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1812,7 +2371,7 @@ func (c *Client) sendCreateDirectory(ctx context.Context, params CreateDirectory
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/rest/file/"
+	pathParts[0] = "/rest/extend/"
 	{
 		// Encode "path" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1833,18 +2392,39 @@ func (c *Client) sendCreateDirectory(ctx context.Context, params CreateDirectory
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "file" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "file",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.File))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCallPostExtendRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, CreateDirectoryOperation, r); {
+			switch err := c.securityBasicAuth(ctx, CallPostExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1855,7 +2435,7 @@ func (c *Client) sendCreateDirectory(ctx context.Context, params CreateDirectory
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, CreateDirectoryOperation, r); {
+			switch err := c.securityTokenCheck(ctx, CallPostExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1866,7 +2446,7 @@ func (c *Client) sendCreateDirectory(ctx context.Context, params CreateDirectory
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateDirectoryOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CallPostExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1901,10 +2481,17 @@ func (c *Client) sendCreateDirectory(ctx context.Context, params CreateDirectory
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateDirectoryResponse(resp)
+	result, err := decodeCallPostExtendResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1912,21 +2499,21 @@ func (c *Client) sendCreateDirectory(ctx context.Context, params CreateDirectory
 	return result, nil
 }
 
-// DeleteExtend invokes deleteExtend operation.
+// CancelUpload invokes cancelUpload operation.
 //
-// Delete extend/plugin data.
+// Cancel a resumable upload and remove the received data.
 //
-// DELETE /rest/extend/{path}
-func (c *Client) DeleteExtend(ctx context.Context, params DeleteExtendParams) (DeleteExtendRes, error) {
-	res, err := c.sendDeleteExtend(ctx, params)
+// DELETE /rest/uploads/{uploadId}
+func (c *Client) CancelUpload(ctx context.Context, params CancelUploadParams) (CancelUploadRes, error) {
+	res, err := c.sendCancelUpload(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteExtend(ctx context.Context, params DeleteExtendParams) (res DeleteExtendRes, err error) {
+func (c *Client) sendCancelUpload(ctx context.Context, params CancelUploadParams) (res CancelUploadRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteExtend"),
+		otelogen.OperationID("cancelUpload"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/rest/extend/{path}"),
+		semconv.URLTemplateKey.String("/rest/uploads/{uploadId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1942,7 +2529,7 @@ func (c *Client) sendDeleteExtend(ctx context.Context, params DeleteExtendParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteExtendOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CancelUploadOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1960,16 +2547,16 @@ func (c *Client) sendDeleteExtend(ctx context.Context, params DeleteExtendParams
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/rest/extend/"
+	pathParts[0] = "/rest/uploads/"
 	{
-		// Encode "path" parameter.
+		// Encode "uploadId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "path",
+			Param:   "uploadId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Path))
+			return e.EncodeValue(conv.StringToString(params.UploadId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1981,24 +2568,6 @@ func (c *Client) sendDeleteExtend(ctx context.Context, params DeleteExtendParams
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "file" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "file",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.File))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
@@ -2010,7 +2579,7 @@ func (c *Client) sendDeleteExtend(ctx context.Context, params DeleteExtendParams
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, DeleteExtendOperation, r); {
+			switch err := c.securityBasicAuth(ctx, CancelUploadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2021,7 +2590,7 @@ func (c *Client) sendDeleteExtend(ctx context.Context, params DeleteExtendParams
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, DeleteExtendOperation, r); {
+			switch err := c.securityTokenCheck(ctx, CancelUploadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2032,7 +2601,7 @@ func (c *Client) sendDeleteExtend(ctx context.Context, params DeleteExtendParams
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteExtendOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CancelUploadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2067,10 +2636,17 @@ func (c *Client) sendDeleteExtend(ctx context.Context, params DeleteExtendParams
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteExtendResponse(resp)
+	result, err := decodeCancelUploadResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2078,20 +2654,20 @@ func (c *Client) sendDeleteExtend(ctx context.Context, params DeleteExtendParams
 	return result, nil
 }
 
-// DeleteFileLocation invokes deleteFileLocation operation.
+// CreateDirectory invokes createDirectory operation.
 //
-// Delete the file on the given location.
+// Create a new directory.
 //
-// DELETE /rest/file/{path}
-func (c *Client) DeleteFileLocation(ctx context.Context, params DeleteFileLocationParams) (DeleteFileLocationRes, error) {
-	res, err := c.sendDeleteFileLocation(ctx, params)
+// PUT /rest/file/{path}
+func (c *Client) CreateDirectory(ctx context.Context, params CreateDirectoryParams) (CreateDirectoryRes, error) {
+	res, err := c.sendCreateDirectory(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteFileLocation(ctx context.Context, params DeleteFileLocationParams) (res DeleteFileLocationRes, err error) {
+func (c *Client) sendCreateDirectory(ctx context.Context, params CreateDirectoryParams) (res CreateDirectoryRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteFileLocation"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
+		otelogen.OperationID("createDirectory"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
 		semconv.URLTemplateKey.String("/rest/file/{path}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
@@ -2108,7 +2684,7 @@ func (c *Client) sendDeleteFileLocation(ctx context.Context, params DeleteFileLo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteFileLocationOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateDirectoryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2147,29 +2723,8 @@ func (c *Client) sendDeleteFileLocation(ctx context.Context, params DeleteFileLo
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "file" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "file",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.File.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2179,7 +2734,7 @@ func (c *Client) sendDeleteFileLocation(ctx context.Context, params DeleteFileLo
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, DeleteFileLocationOperation, r); {
+			switch err := c.securityBasicAuth(ctx, CreateDirectoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2190,7 +2745,7 @@ func (c *Client) sendDeleteFileLocation(ctx context.Context, params DeleteFileLo
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, DeleteFileLocationOperation, r); {
+			switch err := c.securityTokenCheck(ctx, CreateDirectoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2201,7 +2756,7 @@ func (c *Client) sendDeleteFileLocation(ctx context.Context, params DeleteFileLo
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteFileLocationOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateDirectoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2236,10 +2791,17 @@ func (c *Client) sendDeleteFileLocation(ctx context.Context, params DeleteFileLo
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteFileLocationResponse(resp)
+	result, err := decodeCreateDirectoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2247,21 +2809,22 @@ func (c *Client) sendDeleteFileLocation(ctx context.Context, params DeleteFileLo
 	return result, nil
 }
 
-// DeleteJobResult invokes deleteJobResult operation.
+// CreateUpload invokes createUpload operation.
 //
-// Delete a specific job result.
+// Create a resumable upload of a new file to the given location. The data is sent in chunks to the
+// returned upload.
 //
-// DELETE /tasks/{jobName}/{jobId}
-func (c *Client) DeleteJobResult(ctx context.Context, params DeleteJobResultParams) (DeleteJobResultRes, error) {
-	res, err := c.sendDeleteJobResult(ctx, params)
+// POST /rest/upload/{path}
+func (c *Client) CreateUpload(ctx context.Context, params CreateUploadParams) (CreateUploadRes, error) {
+	res, err := c.sendCreateUpload(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteJobResult(ctx context.Context, params DeleteJobResultParams) (res DeleteJobResultRes, err error) {
+func (c *Client) sendCreateUpload(ctx context.Context, params CreateUploadParams) (res CreateUploadRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteJobResult"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/tasks/{jobName}/{jobId}"),
+		otelogen.OperationID("createUpload"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/rest/upload/{path}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2277,7 +2840,7 @@ func (c *Client) sendDeleteJobResult(ctx context.Context, params DeleteJobResult
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteJobResultOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateUploadOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2294,17 +2857,17 @@ func (c *Client) sendDeleteJobResult(ctx context.Context, params DeleteJobResult
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/tasks/"
+	var pathParts [2]string
+	pathParts[0] = "/rest/upload/"
 	{
-		// Encode "jobName" parameter.
+		// Encode "path" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "jobName",
+			Param:   "path",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.JobName))
+			return e.EncodeValue(conv.StringToString(params.Path))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2314,39 +2877,69 @@ func (c *Client) sendDeleteJobResult(ctx context.Context, params DeleteJobResult
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "jobId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "jobId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.JobId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "file" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "file",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.File.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[3] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Upload-Length",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.Int64ToString(params.UploadLength))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Upload-Checksum",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.UploadChecksum.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, DeleteJobResultOperation, r); {
+			switch err := c.securityBasicAuth(ctx, CreateUploadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2357,7 +2950,7 @@ func (c *Client) sendDeleteJobResult(ctx context.Context, params DeleteJobResult
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, DeleteJobResultOperation, r); {
+			switch err := c.securityTokenCheck(ctx, CreateUploadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2368,7 +2961,7 @@ func (c *Client) sendDeleteJobResult(ctx context.Context, params DeleteJobResult
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteJobResultOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateUploadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2403,10 +2996,17 @@ func (c *Client) sendDeleteJobResult(ctx context.Context, params DeleteJobResult
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteJobResultResponse(resp)
+	result, err := decodeCreateUploadResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2414,21 +3014,21 @@ func (c *Client) sendDeleteJobResult(ctx context.Context, params DeleteJobResult
 	return result, nil
 }
 
-// DeleteRecordsSearched invokes deleteRecordsSearched operation.
+// DeleteExtend invokes deleteExtend operation.
 //
-// Delete a record with a given search.
+// Delete extend/plugin data.
 //
-// DELETE /rest/view/{table}/{search}
-func (c *Client) DeleteRecordsSearched(ctx context.Context, params DeleteRecordsSearchedParams) (DeleteRecordsSearchedRes, error) {
-	res, err := c.sendDeleteRecordsSearched(ctx, params)
+// DELETE /rest/extend/{path}
+func (c *Client) DeleteExtend(ctx context.Context, params DeleteExtendParams) (DeleteExtendRes, error) {
+	res, err := c.sendDeleteExtend(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteRecordsSearched(ctx context.Context, params DeleteRecordsSearchedParams) (res DeleteRecordsSearchedRes, err error) {
+func (c *Client) sendDeleteExtend(ctx context.Context, params DeleteExtendParams) (res DeleteExtendRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteRecordsSearched"),
+		otelogen.OperationID("deleteExtend"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/rest/view/{table}/{search}"),
+		semconv.URLTemplateKey.String("/rest/extend/{path}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2444,7 +3044,7 @@ func (c *Client) sendDeleteRecordsSearched(ctx context.Context, params DeleteRec
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteRecordsSearchedOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteExtendOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2461,17 +3061,17 @@ func (c *Client) sendDeleteRecordsSearched(ctx context.Context, params DeleteRec
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/rest/view/"
+	var pathParts [2]string
+	pathParts[0] = "/rest/extend/"
 	{
-		// Encode "table" parameter.
+		// Encode "path" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "table",
+			Param:   "path",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Table))
+			return e.EncodeValue(conv.StringToString(params.Path))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2481,178 +3081,20 @@ func (c *Client) sendDeleteRecordsSearched(ctx context.Context, params DeleteRec
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/"
-	{
-		// Encode "search" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "search",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Search))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "start" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "start",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Start.Get(); ok {
-				return e.EncodeValue(conv.Float64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "sorted_by" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sorted_by",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.SortedBy.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "sqlsearch" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sqlsearch",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Sqlsearch.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "compact" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "compact",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Compact.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "flatten" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "flatten",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Flatten.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "descriptor" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "descriptor",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Descriptor.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "orderby" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "orderby",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Orderby.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "xmlnotation" parameter.
+		// Encode "file" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "xmlnotation",
+			Name:    "file",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Xmlnotation.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
+			return e.EncodeValue(conv.StringToString(params.File))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
@@ -2670,7 +3112,7 @@ func (c *Client) sendDeleteRecordsSearched(ctx context.Context, params DeleteRec
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, DeleteRecordsSearchedOperation, r); {
+			switch err := c.securityBasicAuth(ctx, DeleteExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2681,7 +3123,7 @@ func (c *Client) sendDeleteRecordsSearched(ctx context.Context, params DeleteRec
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, DeleteRecordsSearchedOperation, r); {
+			switch err := c.securityTokenCheck(ctx, DeleteExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2692,7 +3134,7 @@ func (c *Client) sendDeleteRecordsSearched(ctx context.Context, params DeleteRec
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteRecordsSearchedOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2727,10 +3169,17 @@ func (c *Client) sendDeleteRecordsSearched(ctx context.Context, params DeleteRec
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteRecordsSearchedResponse(resp)
+	result, err := decodeDeleteExtendResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2738,21 +3187,21 @@ func (c *Client) sendDeleteRecordsSearched(ctx context.Context, params DeleteRec
 	return result, nil
 }
 
-// DeleteView invokes deleteView operation.
+// DeleteFileLocation invokes deleteFileLocation operation.
 //
-// Delete/disable table view in configuration.
+// Delete the file on the given location.
 //
-// DELETE /config/views
-func (c *Client) DeleteView(ctx context.Context, params DeleteViewParams) (DeleteViewRes, error) {
-	res, err := c.sendDeleteView(ctx, params)
+// DELETE /rest/file/{path}
+func (c *Client) DeleteFileLocation(ctx context.Context, params DeleteFileLocationParams) (DeleteFileLocationRes, error) {
+	res, err := c.sendDeleteFileLocation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteView(ctx context.Context, params DeleteViewParams) (res DeleteViewRes, err error) {
+func (c *Client) sendDeleteFileLocation(ctx context.Context, params DeleteFileLocationParams) (res DeleteFileLocationRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteView"),
+		otelogen.OperationID("deleteFileLocation"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/config/views"),
+		semconv.URLTemplateKey.String("/rest/file/{path}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2768,7 +3217,7 @@ func (c *Client) sendDeleteView(ctx context.Context, params DeleteViewParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteViewOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteFileLocationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2785,26 +3234,30 @@ func (c *Client) sendDeleteView(ctx context.Context, params DeleteViewParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/config/views"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [2]string
+	pathParts[0] = "/rest/file/"
 	{
-		// Encode "url" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "url",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "path" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "path",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Path))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.URL))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
 		// Encode "file" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -2814,7 +3267,10 @@ func (c *Client) sendDeleteView(ctx context.Context, params DeleteViewParams) (r
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.IntToString(params.File))
+			if val, ok := params.File.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
@@ -2832,7 +3288,7 @@ func (c *Client) sendDeleteView(ctx context.Context, params DeleteViewParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, DeleteViewOperation, r); {
+			switch err := c.securityBasicAuth(ctx, DeleteFileLocationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2843,7 +3299,7 @@ func (c *Client) sendDeleteView(ctx context.Context, params DeleteViewParams) (r
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, DeleteViewOperation, r); {
+			switch err := c.securityTokenCheck(ctx, DeleteFileLocationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2854,7 +3310,7 @@ func (c *Client) sendDeleteView(ctx context.Context, params DeleteViewParams) (r
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteViewOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteFileLocationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2889,10 +3345,17 @@ func (c *Client) sendDeleteView(ctx context.Context, params DeleteViewParams) (r
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteViewResponse(resp)
+	result, err := decodeDeleteFileLocationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2900,21 +3363,21 @@ func (c *Client) sendDeleteView(ctx context.Context, params DeleteViewParams) (r
 	return result, nil
 }
 
-// DownloadFile invokes downloadFile operation.
+// DeleteJobResult invokes deleteJobResult operation.
 //
-// Download a file out of file location.
+// Delete a specific job result.
 //
-// GET /rest/file/{path}
-func (c *Client) DownloadFile(ctx context.Context, params DownloadFileParams) (DownloadFileRes, error) {
-	res, err := c.sendDownloadFile(ctx, params)
+// DELETE /tasks/{jobName}/{jobId}
+func (c *Client) DeleteJobResult(ctx context.Context, params DeleteJobResultParams) (DeleteJobResultRes, error) {
+	res, err := c.sendDeleteJobResult(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDownloadFile(ctx context.Context, params DownloadFileParams) (res DownloadFileRes, err error) {
+func (c *Client) sendDeleteJobResult(ctx context.Context, params DeleteJobResultParams) (res DeleteJobResultRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("downloadFile"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/file/{path}"),
+		otelogen.OperationID("deleteJobResult"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/tasks/{jobName}/{jobId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2930,7 +3393,7 @@ func (c *Client) sendDownloadFile(ctx context.Context, params DownloadFileParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DownloadFileOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteJobResultOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2947,17 +3410,17 @@ func (c *Client) sendDownloadFile(ctx context.Context, params DownloadFileParams
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/rest/file/"
+	var pathParts [4]string
+	pathParts[0] = "/tasks/"
 	{
-		// Encode "path" parameter.
+		// Encode "jobName" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "path",
+			Param:   "jobName",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Path))
+			return e.EncodeValue(conv.StringToString(params.JobName))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2967,10 +3430,29 @@ func (c *Client) sendDownloadFile(ctx context.Context, params DownloadFileParams
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/"
+	{
+		// Encode "jobId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "jobId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.JobId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2980,7 +3462,7 @@ func (c *Client) sendDownloadFile(ctx context.Context, params DownloadFileParams
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, DownloadFileOperation, r); {
+			switch err := c.securityBasicAuth(ctx, DeleteJobResultOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2991,7 +3473,7 @@ func (c *Client) sendDownloadFile(ctx context.Context, params DownloadFileParams
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, DownloadFileOperation, r); {
+			switch err := c.securityTokenCheck(ctx, DeleteJobResultOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3002,7 +3484,7 @@ func (c *Client) sendDownloadFile(ctx context.Context, params DownloadFileParams
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DownloadFileOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteJobResultOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3037,10 +3519,17 @@ func (c *Client) sendDownloadFile(ctx context.Context, params DownloadFileParams
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeDownloadFileResponse(resp)
+	result, err := decodeDeleteJobResultResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3048,21 +3537,21 @@ func (c *Client) sendDownloadFile(ctx context.Context, params DownloadFileParams
 	return result, nil
 }
 
-// GetConfig invokes getConfig operation.
+// DeleteRecordByKey invokes deleteRecordByKey operation.
 //
-// Get current active configuration.
+// Delete the record with the given primary key.
 //
-// GET /config
-func (c *Client) GetConfig(ctx context.Context) (GetConfigRes, error) {
-	res, err := c.sendGetConfig(ctx)
+// DELETE /rest/view/{table}/pk/{key}
+func (c *Client) DeleteRecordByKey(ctx context.Context, params DeleteRecordByKeyParams) (DeleteRecordByKeyRes, error) {
+	res, err := c.sendDeleteRecordByKey(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetConfig(ctx context.Context) (res GetConfigRes, err error) {
+func (c *Client) sendDeleteRecordByKey(ctx context.Context, params DeleteRecordByKeyParams) (res DeleteRecordByKeyRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getConfig"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/config"),
+		otelogen.OperationID("deleteRecordByKey"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/rest/view/{table}/pk/{key}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3078,7 +3567,7 @@ func (c *Client) sendGetConfig(ctx context.Context) (res GetConfigRes, err error
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetConfigOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteRecordByKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3095,12 +3584,49 @@ func (c *Client) sendGetConfig(ctx context.Context) (res GetConfigRes, err error
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/config"
+	var pathParts [4]string
+	pathParts[0] = "/rest/view/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/pk/"
+	{
+		// Encode "key" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "key",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Key))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3110,7 +3636,7 @@ func (c *Client) sendGetConfig(ctx context.Context) (res GetConfigRes, err error
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetConfigOperation, r); {
+			switch err := c.securityBasicAuth(ctx, DeleteRecordByKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3121,7 +3647,7 @@ func (c *Client) sendGetConfig(ctx context.Context) (res GetConfigRes, err error
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetConfigOperation, r); {
+			switch err := c.securityTokenCheck(ctx, DeleteRecordByKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3132,7 +3658,7 @@ func (c *Client) sendGetConfig(ctx context.Context) (res GetConfigRes, err error
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetConfigOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteRecordByKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3167,10 +3693,17 @@ func (c *Client) sendGetConfig(ctx context.Context) (res GetConfigRes, err error
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetConfigResponse(resp)
+	result, err := decodeDeleteRecordByKeyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3178,21 +3711,21 @@ func (c *Client) sendGetConfig(ctx context.Context) (res GetConfigRes, err error
 	return result, nil
 }
 
-// GetDatabases invokes getDatabases operation.
+// DeleteRecordsSearched invokes deleteRecordsSearched operation.
 //
-// Retrieves a list of databases known by server.
+// Delete a record with a given search.
 //
-// GET /rest/database
-func (c *Client) GetDatabases(ctx context.Context) (GetDatabasesRes, error) {
-	res, err := c.sendGetDatabases(ctx)
+// DELETE /rest/view/{table}/{search}
+func (c *Client) DeleteRecordsSearched(ctx context.Context, params DeleteRecordsSearchedParams) (DeleteRecordsSearchedRes, error) {
+	res, err := c.sendDeleteRecordsSearched(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetDatabases(ctx context.Context) (res GetDatabasesRes, err error) {
+func (c *Client) sendDeleteRecordsSearched(ctx context.Context, params DeleteRecordsSearchedParams) (res DeleteRecordsSearchedRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDatabases"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/database"),
+		otelogen.OperationID("deleteRecordsSearched"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/rest/view/{table}/{search}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3208,7 +3741,7 @@ func (c *Client) sendGetDatabases(ctx context.Context) (res GetDatabasesRes, err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetDatabasesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteRecordsSearchedOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3225,44 +3758,4003 @@ func (c *Client) sendGetDatabases(ctx context.Context) (res GetDatabasesRes, err
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/rest/database"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
+	var pathParts [4]string
+	pathParts[0] = "/rest/view/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "search" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "search",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Search))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
 	}
+	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetDatabasesOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BasicAuth\"")
-			}
+		// Encode "dryRun" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dryRun",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		{
-			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetDatabasesOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 1
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"TokenCheck\"")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DryRun.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
 			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		{
+	}
+	{
+		// Encode "sample" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sample",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sample.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "confirm" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "confirm",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Confirm.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "start" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Start.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sorted_by" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sorted_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SortedBy.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sqlsearch" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sqlsearch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "compact" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "compact",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Compact.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "flatten" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "flatten",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Flatten.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "descriptor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "descriptor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Descriptor.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "orderby" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "orderby",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Orderby.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "xmlnotation" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "xmlnotation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Xmlnotation.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, DeleteRecordsSearchedOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, DeleteRecordsSearchedOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteRecordsSearchedOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteRecordsSearchedResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteView invokes deleteView operation.
+//
+// Delete/disable table view in configuration.
+//
+// DELETE /config/views
+func (c *Client) DeleteView(ctx context.Context, params DeleteViewParams) (DeleteViewRes, error) {
+	res, err := c.sendDeleteView(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDeleteView(ctx context.Context, params DeleteViewParams) (res DeleteViewRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteView"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/config/views"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteViewOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/config/views"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "url" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "url",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.URL))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "file" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "file",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.File))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, DeleteViewOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, DeleteViewOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteViewOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteViewResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DownloadFile invokes downloadFile operation.
+//
+// Download a file out of file location.
+//
+// GET /rest/file/{path}
+func (c *Client) DownloadFile(ctx context.Context, params DownloadFileParams) (DownloadFileRes, error) {
+	res, err := c.sendDownloadFile(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDownloadFile(ctx context.Context, params DownloadFileParams) (res DownloadFileRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("downloadFile"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/rest/file/{path}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DownloadFileOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/rest/file/"
+	{
+		// Encode "path" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "path",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Path))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, DownloadFileOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, DownloadFileOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DownloadFileOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeDownloadFileResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ExecuteTransaction invokes executeTransaction operation.
+//
+// Execute a list of insert, update and delete operations on tables of one database in one transaction.
+//
+// POST /rest/transaction
+func (c *Client) ExecuteTransaction(ctx context.Context, request *Transaction) (ExecuteTransactionRes, error) {
+	res, err := c.sendExecuteTransaction(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendExecuteTransaction(ctx context.Context, request *Transaction) (res ExecuteTransactionRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("executeTransaction"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/rest/transaction"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ExecuteTransactionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/rest/transaction"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeExecuteTransactionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, ExecuteTransactionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, ExecuteTransactionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ExecuteTransactionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeExecuteTransactionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetArchive invokes getArchive operation.
+//
+// Retrieves the large object field of all records matching the search as ZIP archive with one entry
+// per record.
+//
+// GET /archive/{table}/{field}/{search}
+func (c *Client) GetArchive(ctx context.Context, params GetArchiveParams) (GetArchiveRes, error) {
+	res, err := c.sendGetArchive(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetArchive(ctx context.Context, params GetArchiveParams) (res GetArchiveRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getArchive"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/archive/{table}/{field}/{search}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetArchiveOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [6]string
+	pathParts[0] = "/archive/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "field" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "field",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Field))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/"
+	{
+		// Encode "search" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "search",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Search))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "mimetypeField" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MimetypeField.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "nameField" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nameField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.NameField.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "namePattern" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "namePattern",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.NamePattern.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "maxSize" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "maxSize",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MaxSize.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetArchiveOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetArchiveOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetArchiveOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetArchiveResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetConfig invokes getConfig operation.
+//
+// Get current active configuration.
+//
+// GET /config
+func (c *Client) GetConfig(ctx context.Context) (GetConfigRes, error) {
+	res, err := c.sendGetConfig(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetConfig(ctx context.Context) (res GetConfigRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getConfig"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/config"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetConfigOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/config"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetConfigOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetConfigOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetConfigOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetConfigResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetDatabases invokes getDatabases operation.
+//
+// Retrieves a list of databases known by server.
+//
+// GET /rest/database
+func (c *Client) GetDatabases(ctx context.Context) (GetDatabasesRes, error) {
+	res, err := c.sendGetDatabases(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetDatabases(ctx context.Context) (res GetDatabasesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDatabases"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/rest/database"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetDatabasesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/rest/database"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetDatabasesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetDatabasesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetDatabasesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetDatabasesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetFields invokes getFields operation.
+//
+// Retrieves all fields of an file.
+//
+// GET /rest/tables/{table}/fields
+func (c *Client) GetFields(ctx context.Context, params GetFieldsParams) (GetFieldsRes, error) {
+	res, err := c.sendGetFields(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetFields(ctx context.Context, params GetFieldsParams) (res GetFieldsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getFields"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/rest/tables/{table}/fields"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetFieldsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/rest/tables/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/fields"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetFieldsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetFieldsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetFieldsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetFieldsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetImage invokes getImage operation.
+//
+// Retrieves a field of a specific table record of a Map definition.
+//
+// GET /image/{table}/{field}/{search}
+func (c *Client) GetImage(ctx context.Context, params GetImageParams) (GetImageRes, error) {
+	res, err := c.sendGetImage(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetImage(ctx context.Context, params GetImageParams) (res GetImageRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getImage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/image/{table}/{field}/{search}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetImageOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [6]string
+	pathParts[0] = "/image/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "field" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "field",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Field))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/"
+	{
+		// Encode "search" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "search",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Search))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "reference" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "reference",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Reference.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "mimetype" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mimetype",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Mimetype.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "mimetypeField" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.MimetypeField))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sqlsearch" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sqlsearch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "width" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "width",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Width.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "height" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "height",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Height.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "fit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "fit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Fit.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "quality" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "quality",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Quality.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "format" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Format.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetImageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetImageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetImageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetImageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetImageCacheStats invokes getImageCacheStats operation.
+//
+// Get hit and miss statistics of the derived image cache.
+//
+// GET /config/images/cache
+func (c *Client) GetImageCacheStats(ctx context.Context) (GetImageCacheStatsRes, error) {
+	res, err := c.sendGetImageCacheStats(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetImageCacheStats(ctx context.Context) (res GetImageCacheStatsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getImageCacheStats"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/config/images/cache"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetImageCacheStatsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/config/images/cache"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetImageCacheStatsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetImageCacheStatsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetImageCacheStatsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetImageCacheStatsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetImageDuplicates invokes getImageDuplicates operation.
+//
+// Retrieves groups of records with near-duplicate images out of the stored perceptual hashes.
+//
+// GET /hash/{table}/{hashField}/duplicates
+func (c *Client) GetImageDuplicates(ctx context.Context, params GetImageDuplicatesParams) (GetImageDuplicatesRes, error) {
+	res, err := c.sendGetImageDuplicates(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetImageDuplicates(ctx context.Context, params GetImageDuplicatesParams) (res GetImageDuplicatesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getImageDuplicates"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/hash/{table}/{hashField}/duplicates"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetImageDuplicatesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/hash/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "hashField" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "hashField",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.HashField))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/duplicates"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "distance" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "distance",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Distance.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "search" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "search",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Search.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetImageDuplicatesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetImageDuplicatesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetImageDuplicatesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetImageDuplicatesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetImageHash invokes getImageHash operation.
+//
+// Calculates the perceptual hash of an image field of a specific table record.
+//
+// GET /image/{table}/{field}/{search}/hash
+func (c *Client) GetImageHash(ctx context.Context, params GetImageHashParams) (GetImageHashRes, error) {
+	res, err := c.sendGetImageHash(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetImageHash(ctx context.Context, params GetImageHashParams) (res GetImageHashRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getImageHash"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/image/{table}/{field}/{search}/hash"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetImageHashOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [7]string
+	pathParts[0] = "/image/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "field" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "field",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Field))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/"
+	{
+		// Encode "search" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "search",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Search))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	pathParts[6] = "/hash"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "mimetypeField" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MimetypeField.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "algorithm" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "algorithm",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Algorithm.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetImageHashOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetImageHashOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetImageHashOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetImageHashResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetImageMetadata invokes getImageMetadata operation.
+//
+// Retrieves the EXIF tags, dimensions and format of an image field of a specific table record without
+// the image data.
+//
+// GET /image/{table}/{field}/{search}/metadata
+func (c *Client) GetImageMetadata(ctx context.Context, params GetImageMetadataParams) (GetImageMetadataRes, error) {
+	res, err := c.sendGetImageMetadata(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetImageMetadata(ctx context.Context, params GetImageMetadataParams) (res GetImageMetadataRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getImageMetadata"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/image/{table}/{field}/{search}/metadata"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetImageMetadataOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [7]string
+	pathParts[0] = "/image/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "field" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "field",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Field))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/"
+	{
+		// Encode "search" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "search",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Search))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	pathParts[6] = "/metadata"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "mimetypeField" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MimetypeField.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetImageMetadataOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetImageMetadataOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetImageMetadataOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetImageMetadataResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetJobExecutionResult invokes getJobExecutionResult operation.
+//
+// Retrieves a specific job result.
+//
+// GET /tasks/results
+func (c *Client) GetJobExecutionResult(ctx context.Context, params GetJobExecutionResultParams) (GetJobExecutionResultRes, error) {
+	res, err := c.sendGetJobExecutionResult(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetJobExecutionResult(ctx context.Context, params GetJobExecutionResultParams) (res GetJobExecutionResultRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getJobExecutionResult"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/tasks/results"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetJobExecutionResultOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/tasks/results"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetJobExecutionResultOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetJobExecutionResultOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetJobExecutionResultOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetJobExecutionResultResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetJobFullInfo invokes getJobFullInfo operation.
+//
+// Retrieves a full job definition.
+//
+// GET /tasks/{jobName}
+func (c *Client) GetJobFullInfo(ctx context.Context, params GetJobFullInfoParams) (GetJobFullInfoRes, error) {
+	res, err := c.sendGetJobFullInfo(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetJobFullInfo(ctx context.Context, params GetJobFullInfoParams) (res GetJobFullInfoRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getJobFullInfo"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/tasks/{jobName}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetJobFullInfoOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/tasks/"
+	{
+		// Encode "jobName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "jobName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.JobName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetJobFullInfoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetJobFullInfoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetJobFullInfoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetJobFullInfoResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetJobResult invokes getJobResult operation.
+//
+// Delete a specific job result.
+//
+// GET /tasks/{jobName}/{jobId}
+func (c *Client) GetJobResult(ctx context.Context, params GetJobResultParams) (GetJobResultRes, error) {
+	res, err := c.sendGetJobResult(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetJobResult(ctx context.Context, params GetJobResultParams) (res GetJobResultRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getJobResult"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/tasks/{jobName}/{jobId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetJobResultOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/tasks/"
+	{
+		// Encode "jobName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "jobName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.JobName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "jobId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "jobId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.JobId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetJobResultOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetJobResultOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetJobResultOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetJobResultResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetJobs invokes getJobs operation.
+//
+// Retrieves a list of jobs known by the Interface.
+//
+// GET /tasks
+func (c *Client) GetJobs(ctx context.Context, params GetJobsParams) (GetJobsRes, error) {
+	res, err := c.sendGetJobs(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetJobs(ctx context.Context, params GetJobsParams) (res GetJobsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getJobs"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/tasks"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetJobsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/tasks"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "start_time" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "start_time",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.StartTime.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "end_time" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "end_time",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.EndTime.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetJobsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetJobsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetJobsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetJobsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetJobsConfig invokes getJobsConfig operation.
+//
+// Read job configuration section.
+//
+// GET /config/jobs
+func (c *Client) GetJobsConfig(ctx context.Context) (GetJobsConfigRes, error) {
+	res, err := c.sendGetJobsConfig(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetJobsConfig(ctx context.Context) (res GetJobsConfigRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getJobsConfig"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/config/jobs"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetJobsConfigOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/config/jobs"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetJobsConfigOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetJobsConfigOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetJobsConfigOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetJobsConfigResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLobByMap invokes getLobByMap operation.
+//
+// Retrieves a lob of a specific table record of an field in a Map.
+//
+// GET /binary/{table}/{field}/{search}
+func (c *Client) GetLobByMap(ctx context.Context, params GetLobByMapParams) (GetLobByMapRes, error) {
+	res, err := c.sendGetLobByMap(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetLobByMap(ctx context.Context, params GetLobByMapParams) (res GetLobByMapRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLobByMap"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/binary/{table}/{field}/{search}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLobByMapOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [6]string
+	pathParts[0] = "/binary/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "field" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "field",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Field))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/"
+	{
+		// Encode "search" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "search",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Search))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "mimetypeField" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MimetypeField.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "mimetype" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mimetype",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Mimetype.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sqlsearch" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sqlsearch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Range",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Range.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Range",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfRange.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetLobByMapOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetLobByMapOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetLobByMapOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetLobByMapResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLoginSession invokes getLoginSession operation.
+//
+// Login using baseauth or bearer to receive or validate token.
+//
+// GET /login
+func (c *Client) GetLoginSession(ctx context.Context) (GetLoginSessionRes, error) {
+	res, err := c.sendGetLoginSession(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetLoginSession(ctx context.Context) (res GetLoginSessionRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLoginSession"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/login"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLoginSessionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetLoginSessionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetLoginSessionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetLoginSessionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetMapMetadata invokes getMapMetadata operation.
+//
+// Retrieves metadata of a Map definition.
+//
+// GET /rest/metadata/view/{table}
+func (c *Client) GetMapMetadata(ctx context.Context, params GetMapMetadataParams) (GetMapMetadataRes, error) {
+	res, err := c.sendGetMapMetadata(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetMapMetadata(ctx context.Context, params GetMapMetadataParams) (res GetMapMetadataRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMapMetadata"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/rest/metadata/view/{table}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMapMetadataOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/rest/metadata/view/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, GetMapMetadataOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, GetMapMetadataOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetDatabasesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetMapMetadataOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3297,10 +7789,17 @@ func (c *Client) sendGetDatabases(ctx context.Context) (res GetDatabasesRes, err
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetDatabasesResponse(resp)
+	result, err := decodeGetMapMetadataResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3308,21 +7807,21 @@ func (c *Client) sendGetDatabases(ctx context.Context) (res GetDatabasesRes, err
 	return result, nil
 }
 
-// GetFields invokes getFields operation.
+// GetMapRecordsFields invokes getMapRecordsFields operation.
 //
-// Retrieves all fields of an file.
+// Retrieves a field of a specific table record of a Map definition.
 //
-// GET /rest/tables/{table}/fields
-func (c *Client) GetFields(ctx context.Context, params GetFieldsParams) (GetFieldsRes, error) {
-	res, err := c.sendGetFields(ctx, params)
+// GET /rest/view/{table}/{fields}/{search}
+func (c *Client) GetMapRecordsFields(ctx context.Context, params GetMapRecordsFieldsParams) (GetMapRecordsFieldsRes, error) {
+	res, err := c.sendGetMapRecordsFields(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetFields(ctx context.Context, params GetFieldsParams) (res GetFieldsRes, err error) {
+func (c *Client) sendGetMapRecordsFields(ctx context.Context, params GetMapRecordsFieldsParams) (res GetMapRecordsFieldsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getFields"),
+		otelogen.OperationID("getMapRecordsFields"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/tables/{table}/fields"),
+		semconv.URLTemplateKey.String("/rest/view/{table}/{fields}/{search}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3338,7 +7837,7 @@ func (c *Client) sendGetFields(ctx context.Context, params GetFieldsParams) (res
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetFieldsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMapRecordsFieldsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3355,8 +7854,8 @@ func (c *Client) sendGetFields(ctx context.Context, params GetFieldsParams) (res
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/rest/tables/"
+	var pathParts [6]string
+	pathParts[0] = "/rest/view/"
 	{
 		// Encode "table" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3375,208 +7874,297 @@ func (c *Client) sendGetFields(ctx context.Context, params GetFieldsParams) (res
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/fields"
+	pathParts[2] = "/"
+	{
+		// Encode "fields" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "fields",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Fields))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/"
+	{
+		// Encode "search" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "search",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Search))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "start" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Start.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetFieldsOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BasicAuth\"")
+		// Encode "sorted_by" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sorted_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SortedBy.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		{
-			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetFieldsOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 1
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"TokenCheck\"")
+	}
+	{
+		// Encode "sqlsearch" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sqlsearch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetFieldsOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 2
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
+	}
+	{
+		// Encode "compact" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "compact",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Compact.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "flatten" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "flatten",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Flatten.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
 			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "descriptor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "descriptor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-				{0b00000100},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Descriptor.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
 			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
-		return res, errors.Wrap(err, "do request")
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
-	defer resp.Body.Close()
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	stage = "DecodeResponse"
-	result, err := decodeGetFieldsResponse(resp)
-	if err != nil {
-		return res, errors.Wrap(err, "decode response")
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
+	{
+		// Encode "count" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	return result, nil
-}
-
-// GetImage invokes getImage operation.
-//
-// Retrieves a field of a specific table record of a Map definition.
-//
-// GET /image/{table}/{field}/{search}
-func (c *Client) GetImage(ctx context.Context, params GetImageParams) (GetImageRes, error) {
-	res, err := c.sendGetImage(ctx, params)
-	return res, err
-}
-
-func (c *Client) sendGetImage(ctx context.Context, params GetImageParams) (res GetImageRes, err error) {
-	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getImage"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/image/{table}/{field}/{search}"),
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Count.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
-	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
-
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-
-	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetImageOperation,
-		trace.WithAttributes(otelAttrs...),
-		clientSpanKind,
-	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	{
+		// Encode "orderby" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "orderby",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		span.End()
-	}()
 
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [6]string
-	pathParts[0] = "/image/"
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Orderby.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
-		// Encode "table" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "table",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Table))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "xmlnotation" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "xmlnotation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Xmlnotation.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/"
 	{
-		// Encode "field" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "field",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Field))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "delimiter" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "delimiter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Delimiter.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[3] = encoded
 	}
-	pathParts[4] = "/"
 	{
-		// Encode "search" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "search",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Search))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "quote" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "quote",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Quote.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[5] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
 	{
-		// Encode "reference" parameter.
+		// Encode "header" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "reference",
+			Name:    "header",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Reference.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Header.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -3584,15 +8172,15 @@ func (c *Client) sendGetImage(ctx context.Context, params GetImageParams) (res G
 		}
 	}
 	{
-		// Encode "mimetype" parameter.
+		// Encode "null" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "mimetype",
+			Name:    "null",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Mimetype.Get(); ok {
+			if val, ok := params.Null.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -3601,30 +8189,33 @@ func (c *Client) sendGetImage(ctx context.Context, params GetImageParams) (res G
 		}
 	}
 	{
-		// Encode "mimetypeField" parameter.
+		// Encode "dateformat" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "mimetypeField",
+			Name:    "dateformat",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.MimetypeField))
+			if val, ok := params.Dateformat.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "sqlsearch" parameter.
+		// Encode "bom" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sqlsearch",
+			Name:    "bom",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Sqlsearch.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Bom.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -3644,7 +8235,7 @@ func (c *Client) sendGetImage(ctx context.Context, params GetImageParams) (res G
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetImageOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetMapRecordsFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3655,7 +8246,7 @@ func (c *Client) sendGetImage(ctx context.Context, params GetImageParams) (res G
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetImageOperation, r); {
+			switch err := c.securityTokenCheck(ctx, GetMapRecordsFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3666,7 +8257,7 @@ func (c *Client) sendGetImage(ctx context.Context, params GetImageParams) (res G
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetImageOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetMapRecordsFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3701,10 +8292,17 @@ func (c *Client) sendGetImage(ctx context.Context, params GetImageParams) (res G
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetImageResponse(resp)
+	result, err := decodeGetMapRecordsFieldsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3712,21 +8310,21 @@ func (c *Client) sendGetImage(ctx context.Context, params GetImageParams) (res G
 	return result, nil
 }
 
-// GetJobExecutionResult invokes getJobExecutionResult operation.
+// GetMaps invokes getMaps operation.
 //
-// Retrieves a specific job result.
+// Retrieves a list of available views.
 //
-// GET /tasks/results
-func (c *Client) GetJobExecutionResult(ctx context.Context, params GetJobExecutionResultParams) (GetJobExecutionResultRes, error) {
-	res, err := c.sendGetJobExecutionResult(ctx, params)
+// GET /rest/view
+func (c *Client) GetMaps(ctx context.Context) (GetMapsRes, error) {
+	res, err := c.sendGetMaps(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetJobExecutionResult(ctx context.Context, params GetJobExecutionResultParams) (res GetJobExecutionResultRes, err error) {
+func (c *Client) sendGetMaps(ctx context.Context) (res GetMapsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJobExecutionResult"),
+		otelogen.OperationID("getMaps"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/tasks/results"),
+		semconv.URLTemplateKey.String("/rest/view"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3742,7 +8340,7 @@ func (c *Client) sendGetJobExecutionResult(ctx context.Context, params GetJobExe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetJobExecutionResultOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMapsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3760,47 +8358,9 @@ func (c *Client) sendGetJobExecutionResult(ctx context.Context, params GetJobExe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/tasks/results"
+	pathParts[0] = "/rest/view"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "from" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "from",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.From.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "to" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "to",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.To.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -3812,7 +8372,7 @@ func (c *Client) sendGetJobExecutionResult(ctx context.Context, params GetJobExe
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetJobExecutionResultOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetMapsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3823,7 +8383,7 @@ func (c *Client) sendGetJobExecutionResult(ctx context.Context, params GetJobExe
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetJobExecutionResultOperation, r); {
+			switch err := c.securityTokenCheck(ctx, GetMapsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3834,7 +8394,7 @@ func (c *Client) sendGetJobExecutionResult(ctx context.Context, params GetJobExe
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetJobExecutionResultOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetMapsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3869,10 +8429,17 @@ func (c *Client) sendGetJobExecutionResult(ctx context.Context, params GetJobExe
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetJobExecutionResultResponse(resp)
+	result, err := decodeGetMapsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3880,21 +8447,21 @@ func (c *Client) sendGetJobExecutionResult(ctx context.Context, params GetJobExe
 	return result, nil
 }
 
-// GetJobFullInfo invokes getJobFullInfo operation.
+// GetRecordByKey invokes getRecordByKey operation.
 //
-// Retrieves a full job definition.
+// Read the record with the given primary key.
 //
-// GET /tasks/{jobName}
-func (c *Client) GetJobFullInfo(ctx context.Context, params GetJobFullInfoParams) (GetJobFullInfoRes, error) {
-	res, err := c.sendGetJobFullInfo(ctx, params)
+// GET /rest/view/{table}/pk/{key}
+func (c *Client) GetRecordByKey(ctx context.Context, params GetRecordByKeyParams) (GetRecordByKeyRes, error) {
+	res, err := c.sendGetRecordByKey(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetJobFullInfo(ctx context.Context, params GetJobFullInfoParams) (res GetJobFullInfoRes, err error) {
+func (c *Client) sendGetRecordByKey(ctx context.Context, params GetRecordByKeyParams) (res GetRecordByKeyRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJobFullInfo"),
+		otelogen.OperationID("getRecordByKey"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/tasks/{jobName}"),
+		semconv.URLTemplateKey.String("/rest/view/{table}/pk/{key}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3910,7 +8477,7 @@ func (c *Client) sendGetJobFullInfo(ctx context.Context, params GetJobFullInfoPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetJobFullInfoOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRecordByKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3927,17 +8494,17 @@ func (c *Client) sendGetJobFullInfo(ctx context.Context, params GetJobFullInfoPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/tasks/"
+	var pathParts [4]string
+	pathParts[0] = "/rest/view/"
 	{
-		// Encode "jobName" parameter.
+		// Encode "table" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "jobName",
+			Param:   "table",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.JobName))
+			return e.EncodeValue(conv.StringToString(params.Table))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3947,6 +8514,25 @@ func (c *Client) sendGetJobFullInfo(ctx context.Context, params GetJobFullInfoPa
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/pk/"
+	{
+		// Encode "key" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "key",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Key))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3960,7 +8546,7 @@ func (c *Client) sendGetJobFullInfo(ctx context.Context, params GetJobFullInfoPa
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetJobFullInfoOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetRecordByKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3971,7 +8557,7 @@ func (c *Client) sendGetJobFullInfo(ctx context.Context, params GetJobFullInfoPa
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetJobFullInfoOperation, r); {
+			switch err := c.securityTokenCheck(ctx, GetRecordByKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3982,7 +8568,7 @@ func (c *Client) sendGetJobFullInfo(ctx context.Context, params GetJobFullInfoPa
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetJobFullInfoOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetRecordByKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4017,10 +8603,17 @@ func (c *Client) sendGetJobFullInfo(ctx context.Context, params GetJobFullInfoPa
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetJobFullInfoResponse(resp)
+	result, err := decodeGetRecordByKeyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4028,21 +8621,21 @@ func (c *Client) sendGetJobFullInfo(ctx context.Context, params GetJobFullInfoPa
 	return result, nil
 }
 
-// GetJobResult invokes getJobResult operation.
+// GetRecordHistory invokes getRecordHistory operation.
 //
-// Delete a specific job result.
+// Read the change history of the record with the given primary key.
 //
-// GET /tasks/{jobName}/{jobId}
-func (c *Client) GetJobResult(ctx context.Context, params GetJobResultParams) (GetJobResultRes, error) {
-	res, err := c.sendGetJobResult(ctx, params)
+// GET /rest/history/{table}/{key}
+func (c *Client) GetRecordHistory(ctx context.Context, params GetRecordHistoryParams) (GetRecordHistoryRes, error) {
+	res, err := c.sendGetRecordHistory(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetJobResult(ctx context.Context, params GetJobResultParams) (res GetJobResultRes, err error) {
+func (c *Client) sendGetRecordHistory(ctx context.Context, params GetRecordHistoryParams) (res GetRecordHistoryRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJobResult"),
+		otelogen.OperationID("getRecordHistory"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/tasks/{jobName}/{jobId}"),
+		semconv.URLTemplateKey.String("/rest/history/{table}/{key}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4058,7 +8651,7 @@ func (c *Client) sendGetJobResult(ctx context.Context, params GetJobResultParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetJobResultOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRecordHistoryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4076,16 +8669,16 @@ func (c *Client) sendGetJobResult(ctx context.Context, params GetJobResultParams
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [4]string
-	pathParts[0] = "/tasks/"
+	pathParts[0] = "/rest/history/"
 	{
-		// Encode "jobName" parameter.
+		// Encode "table" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "jobName",
+			Param:   "table",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.JobName))
+			return e.EncodeValue(conv.StringToString(params.Table))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -4097,14 +8690,14 @@ func (c *Client) sendGetJobResult(ctx context.Context, params GetJobResultParams
 	}
 	pathParts[2] = "/"
 	{
-		// Encode "jobId" parameter.
+		// Encode "key" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "jobId",
+			Param:   "key",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.JobId))
+			return e.EncodeValue(conv.StringToString(params.Key))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -4127,7 +8720,7 @@ func (c *Client) sendGetJobResult(ctx context.Context, params GetJobResultParams
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetJobResultOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetRecordHistoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4138,7 +8731,7 @@ func (c *Client) sendGetJobResult(ctx context.Context, params GetJobResultParams
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetJobResultOperation, r); {
+			switch err := c.securityTokenCheck(ctx, GetRecordHistoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4149,7 +8742,7 @@ func (c *Client) sendGetJobResult(ctx context.Context, params GetJobResultParams
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetJobResultOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetRecordHistoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4184,10 +8777,17 @@ func (c *Client) sendGetJobResult(ctx context.Context, params GetJobResultParams
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetJobResultResponse(resp)
+	result, err := decodeGetRecordHistoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4195,21 +8795,21 @@ func (c *Client) sendGetJobResult(ctx context.Context, params GetJobResultParams
 	return result, nil
 }
 
-// GetJobs invokes getJobs operation.
+// GetUpload invokes getUpload operation.
 //
-// Retrieves a list of jobs known by the Interface.
+// Retrieves the offset and state of a resumable upload.
 //
-// GET /tasks
-func (c *Client) GetJobs(ctx context.Context, params GetJobsParams) (GetJobsRes, error) {
-	res, err := c.sendGetJobs(ctx, params)
+// GET /rest/uploads/{uploadId}
+func (c *Client) GetUpload(ctx context.Context, params GetUploadParams) (GetUploadRes, error) {
+	res, err := c.sendGetUpload(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetJobs(ctx context.Context, params GetJobsParams) (res GetJobsRes, err error) {
+func (c *Client) sendGetUpload(ctx context.Context, params GetUploadParams) (res GetUploadRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJobs"),
+		otelogen.OperationID("getUpload"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/tasks"),
+		semconv.URLTemplateKey.String("/rest/uploads/{uploadId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4225,7 +8825,7 @@ func (c *Client) sendGetJobs(ctx context.Context, params GetJobsParams) (res Get
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetJobsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUploadOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4242,47 +8842,27 @@ func (c *Client) sendGetJobs(ctx context.Context, params GetJobsParams) (res Get
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/tasks"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "start_time" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "start_time",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.StartTime.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [2]string
+	pathParts[0] = "/rest/uploads/"
 	{
-		// Encode "end_time" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "end_time",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "uploadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uploadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UploadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.EndTime.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -4295,7 +8875,7 @@ func (c *Client) sendGetJobs(ctx context.Context, params GetJobsParams) (res Get
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetJobsOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetUploadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4306,7 +8886,7 @@ func (c *Client) sendGetJobs(ctx context.Context, params GetJobsParams) (res Get
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetJobsOperation, r); {
+			switch err := c.securityTokenCheck(ctx, GetUploadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4317,7 +8897,7 @@ func (c *Client) sendGetJobs(ctx context.Context, params GetJobsParams) (res Get
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetJobsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetUploadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4352,10 +8932,97 @@ func (c *Client) sendGetJobs(ctx context.Context, params GetJobsParams) (res Get
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetJobsResponse(resp)
+	result, err := decodeGetUploadResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetUserInfo invokes getUserInfo operation.
+//
+// Get the token user information.
+//
+// GET /rest/user
+func (c *Client) GetUserInfo(ctx context.Context) (GetUserInfoRes, error) {
+	res, err := c.sendGetUserInfo(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetUserInfo(ctx context.Context) (res GetUserInfoRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserInfo"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/rest/user"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUserInfoOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/rest/user"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetUserInfoResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4363,21 +9030,21 @@ func (c *Client) sendGetJobs(ctx context.Context, params GetJobsParams) (res Get
 	return result, nil
 }
 
-// GetJobsConfig invokes getJobsConfig operation.
+// GetVersion invokes getVersion operation.
 //
-// Read job configuration section.
+// Get the current server version.
 //
-// GET /config/jobs
-func (c *Client) GetJobsConfig(ctx context.Context) (GetJobsConfigRes, error) {
-	res, err := c.sendGetJobsConfig(ctx)
+// GET /version
+func (c *Client) GetVersion(ctx context.Context) (GetVersionRes, error) {
+	res, err := c.sendGetVersion(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetJobsConfig(ctx context.Context) (res GetJobsConfigRes, err error) {
+func (c *Client) sendGetVersion(ctx context.Context) (res GetVersionRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJobsConfig"),
+		otelogen.OperationID("getVersion"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/config/jobs"),
+		semconv.URLTemplateKey.String("/version"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4393,7 +9060,7 @@ func (c *Client) sendGetJobsConfig(ctx context.Context) (res GetJobsConfigRes, e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetJobsConfigOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetVersionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4411,7 +9078,7 @@ func (c *Client) sendGetJobsConfig(ctx context.Context) (res GetJobsConfigRes, e
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/config/jobs"
+	pathParts[0] = "/version"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -4420,72 +9087,22 @@ func (c *Client) sendGetJobsConfig(ctx context.Context) (res GetJobsConfigRes, e
 		return res, errors.Wrap(err, "create request")
 	}
 
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetJobsConfigOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BasicAuth\"")
-			}
-		}
-		{
-			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetJobsConfigOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 1
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"TokenCheck\"")
-			}
-		}
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetJobsConfigOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 2
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-				{0b00000100},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
-	}
-
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetJobsConfigResponse(resp)
+	result, err := decodeGetVersionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4493,21 +9110,21 @@ func (c *Client) sendGetJobsConfig(ctx context.Context) (res GetJobsConfigRes, e
 	return result, nil
 }
 
-// GetLobByMap invokes getLobByMap operation.
+// GetVideo invokes getVideo operation.
 //
-// Retrieves a lob of a specific table record of an field in a Map.
+// Retrieves a video stream of a specific table record of a Map definition.
 //
-// GET /binary/{table}/{field}/{search}
-func (c *Client) GetLobByMap(ctx context.Context, params GetLobByMapParams) (GetLobByMapRes, error) {
-	res, err := c.sendGetLobByMap(ctx, params)
+// GET /video/{table}/{field}/{search}
+func (c *Client) GetVideo(ctx context.Context, params GetVideoParams) (GetVideoRes, error) {
+	res, err := c.sendGetVideo(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetLobByMap(ctx context.Context, params GetLobByMapParams) (res GetLobByMapRes, err error) {
+func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res GetVideoRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getLobByMap"),
+		otelogen.OperationID("getVideo"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/binary/{table}/{field}/{search}"),
+		semconv.URLTemplateKey.String("/video/{table}/{field}/{search}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4523,7 +9140,7 @@ func (c *Client) sendGetLobByMap(ctx context.Context, params GetLobByMapParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetLobByMapOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetVideoOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4541,7 +9158,7 @@ func (c *Client) sendGetLobByMap(ctx context.Context, params GetLobByMapParams)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [6]string
-	pathParts[0] = "/binary/"
+	pathParts[0] = "/video/"
 	{
 		// Encode "table" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -4603,15 +9220,15 @@ func (c *Client) sendGetLobByMap(ctx context.Context, params GetLobByMapParams)
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "mimetypeField" parameter.
+		// Encode "reference" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "mimetypeField",
+			Name:    "reference",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.MimetypeField.Get(); ok {
+			if val, ok := params.Reference.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -4620,32 +9237,29 @@ func (c *Client) sendGetLobByMap(ctx context.Context, params GetLobByMapParams)
 		}
 	}
 	{
-		// Encode "mimetype" parameter.
+		// Encode "mimetypeField" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "mimetype",
+			Name:    "mimetypeField",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Mimetype.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
+			return e.EncodeValue(conv.StringToString(params.MimetypeField))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "sqlsearch" parameter.
+		// Encode "mimetype" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sqlsearch",
+			Name:    "mimetype",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Sqlsearch.Get(); ok {
+			if val, ok := params.Mimetype.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -4653,137 +9267,24 @@ func (c *Client) sendGetLobByMap(ctx context.Context, params GetLobByMapParams)
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
-	u.RawQuery = q.Values().Encode()
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetLobByMapOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BasicAuth\"")
-			}
-		}
-		{
-			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetLobByMapOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 1
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"TokenCheck\"")
-			}
-		}
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetLobByMapOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 2
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-				{0b00000100},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
-	}
-
-	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
-		return res, errors.Wrap(err, "do request")
-	}
-	defer resp.Body.Close()
-
-	stage = "DecodeResponse"
-	result, err := decodeGetLobByMapResponse(resp)
-	if err != nil {
-		return res, errors.Wrap(err, "decode response")
-	}
-
-	return result, nil
-}
-
-// GetLoginSession invokes getLoginSession operation.
-//
-// Login using baseauth or bearer to receive or validate token.
-//
-// GET /login
-func (c *Client) GetLoginSession(ctx context.Context) (GetLoginSessionRes, error) {
-	res, err := c.sendGetLoginSession(ctx)
-	return res, err
-}
-
-func (c *Client) sendGetLoginSession(ctx context.Context) (res GetLoginSessionRes, err error) {
-	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getLoginSession"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/login"),
-	}
-	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
-
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-
-	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetLoginSessionOperation,
-		trace.WithAttributes(otelAttrs...),
-		clientSpanKind,
-	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		// Encode "sqlsearch" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		span.End()
-	}()
 
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/login"
-	uri.AddPathParts(u, pathParts[:]...)
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sqlsearch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -4791,12 +9292,43 @@ func (c *Client) sendGetLoginSession(ctx context.Context) (res GetLoginSessionRe
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Range",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Range.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Range",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfRange.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetLoginSessionOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetVideoOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4807,7 +9339,7 @@ func (c *Client) sendGetLoginSession(ctx context.Context) (res GetLoginSessionRe
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetLoginSessionOperation, r); {
+			switch err := c.securityTokenCheck(ctx, GetVideoOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4816,12 +9348,24 @@ func (c *Client) sendGetLoginSession(ctx context.Context) (res GetLoginSessionRe
 				return res, errors.Wrap(err, "security \"TokenCheck\"")
 			}
 		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetVideoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -4841,10 +9385,17 @@ func (c *Client) sendGetLoginSession(ctx context.Context) (res GetLoginSessionRe
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetLoginSessionResponse(resp)
+	result, err := decodeGetVideoResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4852,21 +9403,21 @@ func (c *Client) sendGetLoginSession(ctx context.Context) (res GetLoginSessionRe
 	return result, nil
 }
 
-// GetMapMetadata invokes getMapMetadata operation.
+// GetViews invokes getViews operation.
 //
-// Retrieves metadata of a Map definition.
+// Get the available table views of all databases.
 //
-// GET /rest/metadata/view/{table}
-func (c *Client) GetMapMetadata(ctx context.Context, params GetMapMetadataParams) (GetMapMetadataRes, error) {
-	res, err := c.sendGetMapMetadata(ctx, params)
+// GET /config/views
+func (c *Client) GetViews(ctx context.Context) (GetViewsRes, error) {
+	res, err := c.sendGetViews(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetMapMetadata(ctx context.Context, params GetMapMetadataParams) (res GetMapMetadataRes, err error) {
+func (c *Client) sendGetViews(ctx context.Context) (res GetViewsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMapMetadata"),
+		otelogen.OperationID("getViews"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/metadata/view/{table}"),
+		semconv.URLTemplateKey.String("/config/views"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4882,7 +9433,7 @@ func (c *Client) sendGetMapMetadata(ctx context.Context, params GetMapMetadataPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetMapMetadataOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetViewsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4899,26 +9450,8 @@ func (c *Client) sendGetMapMetadata(ctx context.Context, params GetMapMetadataPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/rest/metadata/view/"
-	{
-		// Encode "table" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "table",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Table))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/config/views"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -4932,7 +9465,7 @@ func (c *Client) sendGetMapMetadata(ctx context.Context, params GetMapMetadataPa
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetMapMetadataOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetViewsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4943,7 +9476,7 @@ func (c *Client) sendGetMapMetadata(ctx context.Context, params GetMapMetadataPa
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetMapMetadataOperation, r); {
+			switch err := c.securityTokenCheck(ctx, GetViewsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4954,7 +9487,7 @@ func (c *Client) sendGetMapMetadata(ctx context.Context, params GetMapMetadataPa
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetMapMetadataOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetViewsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4989,10 +9522,17 @@ func (c *Client) sendGetMapMetadata(ctx context.Context, params GetMapMetadataPa
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetMapMetadataResponse(resp)
+	result, err := decodeGetViewsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5000,20 +9540,20 @@ func (c *Client) sendGetMapMetadata(ctx context.Context, params GetMapMetadataPa
 	return result, nil
 }
 
-// GetMapRecordsFields invokes getMapRecordsFields operation.
+// HeadMapRecordsFields invokes headMapRecordsFields operation.
 //
-// Retrieves a field of a specific table record of a Map definition.
+// Return the total number of records matching the search in the X-Total-Count header.
 //
-// GET /rest/view/{table}/{fields}/{search}
-func (c *Client) GetMapRecordsFields(ctx context.Context, params GetMapRecordsFieldsParams) (GetMapRecordsFieldsRes, error) {
-	res, err := c.sendGetMapRecordsFields(ctx, params)
+// HEAD /rest/view/{table}/{fields}/{search}
+func (c *Client) HeadMapRecordsFields(ctx context.Context, params HeadMapRecordsFieldsParams) (HeadMapRecordsFieldsRes, error) {
+	res, err := c.sendHeadMapRecordsFields(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetMapRecordsFields(ctx context.Context, params GetMapRecordsFieldsParams) (res GetMapRecordsFieldsRes, err error) {
+func (c *Client) sendHeadMapRecordsFields(ctx context.Context, params HeadMapRecordsFieldsParams) (res HeadMapRecordsFieldsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMapRecordsFields"),
-		semconv.HTTPRequestMethodKey.String("GET"),
+		otelogen.OperationID("headMapRecordsFields"),
+		semconv.HTTPRequestMethodKey.String("HEAD"),
 		semconv.URLTemplateKey.String("/rest/view/{table}/{fields}/{search}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
@@ -5030,7 +9570,7 @@ func (c *Client) sendGetMapRecordsFields(ctx context.Context, params GetMapRecor
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetMapRecordsFieldsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, HeadMapRecordsFieldsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5085,176 +9625,40 @@ func (c *Client) sendGetMapRecordsFields(ctx context.Context, params GetMapRecor
 			return res, errors.Wrap(err, "encode path")
 		}
 		pathParts[3] = encoded
-	}
-	pathParts[4] = "/"
-	{
-		// Encode "search" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "search",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Search))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[5] = encoded
-	}
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "start" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "start",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Start.Get(); ok {
-				return e.EncodeValue(conv.Float64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "sorted_by" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sorted_by",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.SortedBy.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "sqlsearch" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sqlsearch",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Sqlsearch.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "compact" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "compact",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Compact.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "flatten" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "flatten",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Flatten.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "descriptor" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "descriptor",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Descriptor.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "orderby" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "orderby",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	}
+	pathParts[4] = "/"
+	{
+		// Encode "search" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "search",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Search))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Orderby.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[5] = encoded
 	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "xmlnotation" parameter.
+		// Encode "descriptor" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "xmlnotation",
+			Name:    "descriptor",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Xmlnotation.Get(); ok {
+			if val, ok := params.Descriptor.Get(); ok {
 				return e.EncodeValue(conv.BoolToString(val))
 			}
 			return nil
@@ -5265,7 +9669,7 @@ func (c *Client) sendGetMapRecordsFields(ctx context.Context, params GetMapRecor
 	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "HEAD", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -5275,7 +9679,7 @@ func (c *Client) sendGetMapRecordsFields(ctx context.Context, params GetMapRecor
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetMapRecordsFieldsOperation, r); {
+			switch err := c.securityBasicAuth(ctx, HeadMapRecordsFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5286,7 +9690,7 @@ func (c *Client) sendGetMapRecordsFields(ctx context.Context, params GetMapRecor
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetMapRecordsFieldsOperation, r); {
+			switch err := c.securityTokenCheck(ctx, HeadMapRecordsFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5297,7 +9701,7 @@ func (c *Client) sendGetMapRecordsFields(ctx context.Context, params GetMapRecor
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetMapRecordsFieldsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, HeadMapRecordsFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5332,10 +9736,17 @@ func (c *Client) sendGetMapRecordsFields(ctx context.Context, params GetMapRecor
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetMapRecordsFieldsResponse(resp)
+	result, err := decodeHeadMapRecordsFieldsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5343,21 +9754,21 @@ func (c *Client) sendGetMapRecordsFields(ctx context.Context, params GetMapRecor
 	return result, nil
 }
 
-// GetMaps invokes getMaps operation.
+// ImportRecords invokes importRecords operation.
 //
-// Retrieves a list of available views.
+// Import a CSV or NDJSON stream of records into a table.
 //
-// GET /rest/view
-func (c *Client) GetMaps(ctx context.Context) (GetMapsRes, error) {
-	res, err := c.sendGetMaps(ctx)
+// POST /rest/import/{table}
+func (c *Client) ImportRecords(ctx context.Context, request ImportRecordsReq, params ImportRecordsParams) (ImportRecordsRes, error) {
+	res, err := c.sendImportRecords(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetMaps(ctx context.Context) (res GetMapsRes, err error) {
+func (c *Client) sendImportRecords(ctx context.Context, request ImportRecordsReq, params ImportRecordsParams) (res ImportRecordsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMaps"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/view"),
+		otelogen.OperationID("importRecords"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/rest/import/{table}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5373,7 +9784,7 @@ func (c *Client) sendGetMaps(ctx context.Context) (res GetMapsRes, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetMapsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ImportRecordsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5390,22 +9801,149 @@ func (c *Client) sendGetMaps(ctx context.Context) (res GetMapsRes, err error) {
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/rest/view"
+	var pathParts [2]string
+	pathParts[0] = "/rest/import/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "batchSize" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "batchSize",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.BatchSize.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "onError" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "onError",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.OnError.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "delimiter" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "delimiter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Delimiter.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "header" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "header",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Header.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "null" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "null",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Null.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dateformat" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dateformat",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Dateformat.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeImportRecordsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetMapsOperation, r); {
+			switch err := c.securityBasicAuth(ctx, ImportRecordsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5416,7 +9954,7 @@ func (c *Client) sendGetMaps(ctx context.Context) (res GetMapsRes, err error) {
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetMapsOperation, r); {
+			switch err := c.securityTokenCheck(ctx, ImportRecordsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5427,7 +9965,7 @@ func (c *Client) sendGetMaps(ctx context.Context) (res GetMapsRes, err error) {
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetMapsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ImportRecordsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5462,83 +10000,17 @@ func (c *Client) sendGetMaps(ctx context.Context) (res GetMapsRes, err error) {
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
-
-	stage = "DecodeResponse"
-	result, err := decodeGetMapsResponse(resp)
-	if err != nil {
-		return res, errors.Wrap(err, "decode response")
-	}
-
-	return result, nil
-}
-
-// GetUserInfo invokes getUserInfo operation.
-//
-// Get the token user information.
-//
-// GET /rest/user
-func (c *Client) GetUserInfo(ctx context.Context) (GetUserInfoRes, error) {
-	res, err := c.sendGetUserInfo(ctx)
-	return res, err
-}
-
-func (c *Client) sendGetUserInfo(ctx context.Context) (res GetUserInfoRes, err error) {
-	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserInfo"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/user"),
-	}
-	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
-
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-
-	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetUserInfoOperation,
-		trace.WithAttributes(otelAttrs...),
-		clientSpanKind,
-	)
-	// Track stage for error reporting.
-	var stage string
+	body := resp.Body
 	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-		}
-		span.End()
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
 	}()
 
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/rest/user"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
-	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
-		return res, errors.Wrap(err, "do request")
-	}
-	defer resp.Body.Close()
-
 	stage = "DecodeResponse"
-	result, err := decodeGetUserInfoResponse(resp)
+	result, err := decodeImportRecordsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5546,21 +10018,21 @@ func (c *Client) sendGetUserInfo(ctx context.Context) (res GetUserInfoRes, err e
 	return result, nil
 }
 
-// GetVersion invokes getVersion operation.
+// InsertMapFileRecords invokes insertMapFileRecords operation.
 //
-// Get the current server version.
+// Store send records into Map definition.
 //
-// GET /version
-func (c *Client) GetVersion(ctx context.Context) (GetVersionRes, error) {
-	res, err := c.sendGetVersion(ctx)
+// POST /rest/view
+func (c *Client) InsertMapFileRecords(ctx context.Context, request OptInsertMapFileRecordsReq) (InsertMapFileRecordsRes, error) {
+	res, err := c.sendInsertMapFileRecords(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetVersion(ctx context.Context) (res GetVersionRes, err error) {
+func (c *Client) sendInsertMapFileRecords(ctx context.Context, request OptInsertMapFileRecordsReq) (res InsertMapFileRecordsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getVersion"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/version"),
+		otelogen.OperationID("insertMapFileRecords"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/rest/view"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5576,7 +10048,7 @@ func (c *Client) sendGetVersion(ctx context.Context) (res GetVersionRes, err err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetVersionOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, InsertMapFileRecordsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5594,24 +10066,91 @@ func (c *Client) sendGetVersion(ctx context.Context) (res GetVersionRes, err err
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/version"
+	pathParts[0] = "/rest/view"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeInsertMapFileRecordsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, InsertMapFileRecordsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, InsertMapFileRecordsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, InsertMapFileRecordsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetVersionResponse(resp)
+	result, err := decodeInsertMapFileRecordsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5619,21 +10158,21 @@ func (c *Client) sendGetVersion(ctx context.Context) (res GetVersionRes, err err
 	return result, nil
 }
 
-// GetVideo invokes getVideo operation.
+// InsertRecord invokes insertRecord operation.
 //
-// Retrieves a video stream of a specific table record of a Map definition.
+// Insert given record.
 //
-// GET /video/{table}/{field}/{search}
-func (c *Client) GetVideo(ctx context.Context, params GetVideoParams) (GetVideoRes, error) {
-	res, err := c.sendGetVideo(ctx, params)
+// POST /rest/view/{table}
+func (c *Client) InsertRecord(ctx context.Context, request OptInsertRecordReq, params InsertRecordParams) (InsertRecordRes, error) {
+	res, err := c.sendInsertRecord(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res GetVideoRes, err error) {
+func (c *Client) sendInsertRecord(ctx context.Context, request OptInsertRecordReq, params InsertRecordParams) (res InsertRecordRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getVideo"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/video/{table}/{field}/{search}"),
+		otelogen.OperationID("insertRecord"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/rest/view/{table}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5649,7 +10188,7 @@ func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res G
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetVideoOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, InsertRecordOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5666,8 +10205,8 @@ func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res G
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [6]string
-	pathParts[0] = "/video/"
+	var pathParts [2]string
+	pathParts[0] = "/rest/view/"
 	{
 		// Encode "table" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -5686,58 +10225,20 @@ func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res G
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/"
-	{
-		// Encode "field" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "field",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Field))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/"
-	{
-		// Encode "search" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "search",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Search))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[5] = encoded
-	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "reference" parameter.
+		// Encode "returning" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "reference",
+			Name:    "returning",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Reference.Get(); ok {
+			if val, ok := params.Returning.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -5746,29 +10247,49 @@ func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res G
 		}
 	}
 	{
-		// Encode "mimetypeField" parameter.
+		// Encode "onConflict" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "mimetypeField",
+			Name:    "onConflict",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.MimetypeField))
+			if val, ok := params.OnConflict.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "mimetype" parameter.
+		// Encode "conflictFields" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "mimetype",
+			Name:    "conflictFields",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Mimetype.Get(); ok {
+			if val, ok := params.ConflictFields.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "updateFields" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "updateFields",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.UpdateFields.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -5776,24 +10297,147 @@ func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res G
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "sqlsearch" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sqlsearch",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeInsertRecordRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, InsertRecordOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, InsertRecordOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
+			}
+		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, InsertRecordOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	stage = "DecodeResponse"
+	result, err := decodeInsertRecordResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListModelling invokes listModelling operation.
+//
+// Retrieves all tables, views or data representation objects.
+//
+// GET /rest/map
+func (c *Client) ListModelling(ctx context.Context) (ListModellingRes, error) {
+	res, err := c.sendListModelling(ctx)
+	return res, err
+}
+
+func (c *Client) sendListModelling(ctx context.Context) (res ListModellingRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listModelling"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/rest/map"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Sqlsearch.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListModellingOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-	}
-	u.RawQuery = q.Values().Encode()
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/rest/map"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -5806,7 +10450,7 @@ func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res G
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetVideoOperation, r); {
+			switch err := c.securityBasicAuth(ctx, ListModellingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5817,7 +10461,7 @@ func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res G
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetVideoOperation, r); {
+			switch err := c.securityTokenCheck(ctx, ListModellingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5828,7 +10472,7 @@ func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res G
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetVideoOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ListModellingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5863,10 +10507,17 @@ func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res G
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetVideoResponse(resp)
+	result, err := decodeListModellingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5874,21 +10525,21 @@ func (c *Client) sendGetVideo(ctx context.Context, params GetVideoParams) (res G
 	return result, nil
 }
 
-// GetViews invokes getViews operation.
+// ListTables invokes listTables operation.
 //
-// Get the available table views of all databases.
+// Retrieves all tables of databases.
 //
-// GET /config/views
-func (c *Client) GetViews(ctx context.Context) (GetViewsRes, error) {
-	res, err := c.sendGetViews(ctx)
+// GET /rest/tables
+func (c *Client) ListTables(ctx context.Context) (ListTablesRes, error) {
+	res, err := c.sendListTables(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetViews(ctx context.Context) (res GetViewsRes, err error) {
+func (c *Client) sendListTables(ctx context.Context) (res ListTablesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getViews"),
+		otelogen.OperationID("listTables"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/config/views"),
+		semconv.URLTemplateKey.String("/rest/tables"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5904,7 +10555,7 @@ func (c *Client) sendGetViews(ctx context.Context) (res GetViewsRes, err error)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetViewsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTablesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5922,7 +10573,7 @@ func (c *Client) sendGetViews(ctx context.Context) (res GetViewsRes, err error)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/config/views"
+	pathParts[0] = "/rest/tables"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -5936,7 +10587,7 @@ func (c *Client) sendGetViews(ctx context.Context) (res GetViewsRes, err error)
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetViewsOperation, r); {
+			switch err := c.securityBasicAuth(ctx, ListTablesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5947,7 +10598,7 @@ func (c *Client) sendGetViews(ctx context.Context) (res GetViewsRes, err error)
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, GetViewsOperation, r); {
+			switch err := c.securityTokenCheck(ctx, ListTablesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5958,7 +10609,7 @@ func (c *Client) sendGetViews(ctx context.Context) (res GetViewsRes, err error)
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetViewsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ListTablesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5993,10 +10644,17 @@ func (c *Client) sendGetViews(ctx context.Context) (res GetViewsRes, err error)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetViewsResponse(resp)
+	result, err := decodeListTablesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6004,21 +10662,21 @@ func (c *Client) sendGetViews(ctx context.Context) (res GetViewsRes, err error)
 	return result, nil
 }
 
-// InsertMapFileRecords invokes insertMapFileRecords operation.
+// LoginSession invokes loginSession operation.
 //
-// Store send records into Map definition.
+// Login using baseauth or bearer to receive or validate token.
 //
-// POST /rest/view
-func (c *Client) InsertMapFileRecords(ctx context.Context, request OptInsertMapFileRecordsReq) (InsertMapFileRecordsRes, error) {
-	res, err := c.sendInsertMapFileRecords(ctx, request)
+// PUT /login
+func (c *Client) LoginSession(ctx context.Context) (LoginSessionRes, error) {
+	res, err := c.sendLoginSession(ctx)
 	return res, err
 }
 
-func (c *Client) sendInsertMapFileRecords(ctx context.Context, request OptInsertMapFileRecordsReq) (res InsertMapFileRecordsRes, err error) {
+func (c *Client) sendLoginSession(ctx context.Context) (res LoginSessionRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("insertMapFileRecords"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/rest/view"),
+		otelogen.OperationID("loginSession"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/login"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6034,7 +10692,7 @@ func (c *Client) sendInsertMapFileRecords(ctx context.Context, request OptInsert
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, InsertMapFileRecordsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, LoginSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6052,24 +10710,21 @@ func (c *Client) sendInsertMapFileRecords(ctx context.Context, request OptInsert
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/rest/view"
+	pathParts[0] = "/login"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeInsertMapFileRecordsRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, InsertMapFileRecordsOperation, r); {
+			switch err := c.securityBasicAuth(ctx, LoginSessionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6080,7 +10735,7 @@ func (c *Client) sendInsertMapFileRecords(ctx context.Context, request OptInsert
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, InsertMapFileRecordsOperation, r); {
+			switch err := c.securityTokenCheck(ctx, LoginSessionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6089,24 +10744,12 @@ func (c *Client) sendInsertMapFileRecords(ctx context.Context, request OptInsert
 				return res, errors.Wrap(err, "security \"TokenCheck\"")
 			}
 		}
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, InsertMapFileRecordsOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 2
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
-			}
-		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
-				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -6126,10 +10769,17 @@ func (c *Client) sendInsertMapFileRecords(ctx context.Context, request OptInsert
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeInsertMapFileRecordsResponse(resp)
+	result, err := decodeLoginSessionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6137,21 +10787,21 @@ func (c *Client) sendInsertMapFileRecords(ctx context.Context, request OptInsert
 	return result, nil
 }
 
-// InsertRecord invokes insertRecord operation.
+// LogoutSessionCompat invokes logoutSessionCompat operation.
 //
-// Insert given record.
+// Invalidate given token session.
 //
-// POST /rest/view/{table}
-func (c *Client) InsertRecord(ctx context.Context, request OptInsertRecordReq, params InsertRecordParams) (InsertRecordRes, error) {
-	res, err := c.sendInsertRecord(ctx, request, params)
+// PUT /logout
+func (c *Client) LogoutSessionCompat(ctx context.Context) (LogoutSessionCompatRes, error) {
+	res, err := c.sendLogoutSessionCompat(ctx)
 	return res, err
 }
 
-func (c *Client) sendInsertRecord(ctx context.Context, request OptInsertRecordReq, params InsertRecordParams) (res InsertRecordRes, err error) {
+func (c *Client) sendLogoutSessionCompat(ctx context.Context) (res LogoutSessionCompatRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("insertRecord"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/rest/view/{table}"),
+		otelogen.OperationID("logoutSessionCompat"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/logout"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6167,7 +10817,7 @@ func (c *Client) sendInsertRecord(ctx context.Context, request OptInsertRecordRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, InsertRecordOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, LogoutSessionCompatOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6184,64 +10834,22 @@ func (c *Client) sendInsertRecord(ctx context.Context, request OptInsertRecordRe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/rest/view/"
-	{
-		// Encode "table" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "table",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Table))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/logout"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "returning" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "returning",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Returning.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeInsertRecordRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, InsertRecordOperation, r); {
+			switch err := c.securityBasicAuth(ctx, LogoutSessionCompatOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6252,7 +10860,7 @@ func (c *Client) sendInsertRecord(ctx context.Context, request OptInsertRecordRe
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, InsertRecordOperation, r); {
+			switch err := c.securityTokenCheck(ctx, LogoutSessionCompatOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6263,7 +10871,7 @@ func (c *Client) sendInsertRecord(ctx context.Context, request OptInsertRecordRe
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, InsertRecordOperation, r); {
+			switch err := c.securityBearerAuth(ctx, LogoutSessionCompatOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6298,10 +10906,17 @@ func (c *Client) sendInsertRecord(ctx context.Context, request OptInsertRecordRe
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeInsertRecordResponse(resp)
+	result, err := decodeLogoutSessionCompatResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6309,21 +10924,21 @@ func (c *Client) sendInsertRecord(ctx context.Context, request OptInsertRecordRe
 	return result, nil
 }
 
-// ListModelling invokes listModelling operation.
+// PatchRecordByKey invokes patchRecordByKey operation.
 //
-// Retrieves all tables, views or data representation objects.
+// Update the fields of the record with the given primary key present in the JSON Merge Patch.
 //
-// GET /rest/map
-func (c *Client) ListModelling(ctx context.Context) (ListModellingRes, error) {
-	res, err := c.sendListModelling(ctx)
+// PATCH /rest/view/{table}/pk/{key}
+func (c *Client) PatchRecordByKey(ctx context.Context, request PatchRecordByKeyReq, params PatchRecordByKeyParams) (PatchRecordByKeyRes, error) {
+	res, err := c.sendPatchRecordByKey(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListModelling(ctx context.Context) (res ListModellingRes, err error) {
+func (c *Client) sendPatchRecordByKey(ctx context.Context, request PatchRecordByKeyReq, params PatchRecordByKeyParams) (res PatchRecordByKeyRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listModelling"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/map"),
+		otelogen.OperationID("patchRecordByKey"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/rest/view/{table}/pk/{key}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6339,7 +10954,7 @@ func (c *Client) sendListModelling(ctx context.Context) (res ListModellingRes, e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListModellingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PatchRecordByKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6347,31 +10962,88 @@ func (c *Client) sendListModelling(ctx context.Context) (res ListModellingRes, e
 	var stage string
 	defer func() {
 		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/rest/view/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/pk/"
+	{
+		// Encode "key" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "key",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Key))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/rest/map"
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePatchRecordByKeyRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, ListModellingOperation, r); {
+			switch err := c.securityBasicAuth(ctx, PatchRecordByKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6382,7 +11054,7 @@ func (c *Client) sendListModelling(ctx context.Context) (res ListModellingRes, e
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, ListModellingOperation, r); {
+			switch err := c.securityTokenCheck(ctx, PatchRecordByKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6393,7 +11065,7 @@ func (c *Client) sendListModelling(ctx context.Context) (res ListModellingRes, e
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListModellingOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PatchRecordByKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6428,10 +11100,17 @@ func (c *Client) sendListModelling(ctx context.Context) (res ListModellingRes, e
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeListModellingResponse(resp)
+	result, err := decodePatchRecordByKeyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6439,21 +11118,22 @@ func (c *Client) sendListModelling(ctx context.Context) (res ListModellingRes, e
 	return result, nil
 }
 
-// ListTables invokes listTables operation.
+// PostDatabase invokes postDatabase operation.
 //
-// Retrieves all tables of databases.
+// Create a new database, the input need to be JSON. A structure level parameter indicate version to be
+// used.
 //
-// GET /rest/tables
-func (c *Client) ListTables(ctx context.Context) (ListTablesRes, error) {
-	res, err := c.sendListTables(ctx)
+// POST /rest/database
+func (c *Client) PostDatabase(ctx context.Context, request *Database) (PostDatabaseRes, error) {
+	res, err := c.sendPostDatabase(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendListTables(ctx context.Context) (res ListTablesRes, err error) {
+func (c *Client) sendPostDatabase(ctx context.Context, request *Database) (res PostDatabaseRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTables"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/tables"),
+		otelogen.OperationID("postDatabase"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/rest/database"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6469,7 +11149,7 @@ func (c *Client) sendListTables(ctx context.Context) (res ListTablesRes, err err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTablesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostDatabaseOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6487,21 +11167,24 @@ func (c *Client) sendListTables(ctx context.Context) (res ListTablesRes, err err
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/rest/tables"
+	pathParts[0] = "/rest/database"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePostDatabaseRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, ListTablesOperation, r); {
+			switch err := c.securityBasicAuth(ctx, PostDatabaseOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6512,7 +11195,7 @@ func (c *Client) sendListTables(ctx context.Context) (res ListTablesRes, err err
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, ListTablesOperation, r); {
+			switch err := c.securityTokenCheck(ctx, PostDatabaseOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6523,7 +11206,7 @@ func (c *Client) sendListTables(ctx context.Context) (res ListTablesRes, err err
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTablesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PostDatabaseOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6558,10 +11241,17 @@ func (c *Client) sendListTables(ctx context.Context) (res ListTablesRes, err err
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTablesResponse(resp)
+	result, err := decodePostDatabaseResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6569,21 +11259,21 @@ func (c *Client) sendListTables(ctx context.Context) (res ListTablesRes, err err
 	return result, nil
 }
 
-// LoginSession invokes loginSession operation.
+// PostJob invokes postJob operation.
 //
-// Login using baseauth or bearer to receive or validate token.
+// Create a new Job database.
 //
-// PUT /login
-func (c *Client) LoginSession(ctx context.Context) (LoginSessionRes, error) {
-	res, err := c.sendLoginSession(ctx)
+// POST /tasks
+func (c *Client) PostJob(ctx context.Context, request PostJobReq) (PostJobRes, error) {
+	res, err := c.sendPostJob(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendLoginSession(ctx context.Context) (res LoginSessionRes, err error) {
+func (c *Client) sendPostJob(ctx context.Context, request PostJobReq) (res PostJobRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("loginSession"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/login"),
+		otelogen.OperationID("postJob"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/tasks"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6599,7 +11289,7 @@ func (c *Client) sendLoginSession(ctx context.Context) (res LoginSessionRes, err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, LoginSessionOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostJobOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6617,21 +11307,24 @@ func (c *Client) sendLoginSession(ctx context.Context) (res LoginSessionRes, err
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/login"
+	pathParts[0] = "/tasks"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePostJobRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, LoginSessionOperation, r); {
+			switch err := c.securityBasicAuth(ctx, PostJobOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6642,7 +11335,7 @@ func (c *Client) sendLoginSession(ctx context.Context) (res LoginSessionRes, err
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, LoginSessionOperation, r); {
+			switch err := c.securityTokenCheck(ctx, PostJobOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6651,12 +11344,24 @@ func (c *Client) sendLoginSession(ctx context.Context) (res LoginSessionRes, err
 				return res, errors.Wrap(err, "security \"TokenCheck\"")
 			}
 		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PostJobOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -6676,10 +11381,17 @@ func (c *Client) sendLoginSession(ctx context.Context) (res LoginSessionRes, err
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeLoginSessionResponse(resp)
+	result, err := decodePostJobResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6687,21 +11399,21 @@ func (c *Client) sendLoginSession(ctx context.Context) (res LoginSessionRes, err
 	return result, nil
 }
 
-// LogoutSessionCompat invokes logoutSessionCompat operation.
+// PurgeImageCache invokes purgeImageCache operation.
 //
-// Invalidate given token session.
+// Purge the derived image cache, all entries or the entries of one table.
 //
-// PUT /logout
-func (c *Client) LogoutSessionCompat(ctx context.Context) (LogoutSessionCompatRes, error) {
-	res, err := c.sendLogoutSessionCompat(ctx)
+// DELETE /config/images/cache
+func (c *Client) PurgeImageCache(ctx context.Context, params PurgeImageCacheParams) (PurgeImageCacheRes, error) {
+	res, err := c.sendPurgeImageCache(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendLogoutSessionCompat(ctx context.Context) (res LogoutSessionCompatRes, err error) {
+func (c *Client) sendPurgeImageCache(ctx context.Context, params PurgeImageCacheParams) (res PurgeImageCacheRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("logoutSessionCompat"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/logout"),
+		otelogen.OperationID("purgeImageCache"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/config/images/cache"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6717,7 +11429,7 @@ func (c *Client) sendLogoutSessionCompat(ctx context.Context) (res LogoutSession
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, LogoutSessionCompatOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PurgeImageCacheOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6735,11 +11447,32 @@ func (c *Client) sendLogoutSessionCompat(ctx context.Context) (res LogoutSession
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/logout"
+	pathParts[0] = "/config/images/cache"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "table" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "table",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Table.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -6749,7 +11482,7 @@ func (c *Client) sendLogoutSessionCompat(ctx context.Context) (res LogoutSession
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, LogoutSessionCompatOperation, r); {
+			switch err := c.securityBasicAuth(ctx, PurgeImageCacheOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6760,7 +11493,7 @@ func (c *Client) sendLogoutSessionCompat(ctx context.Context) (res LogoutSession
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, LogoutSessionCompatOperation, r); {
+			switch err := c.securityTokenCheck(ctx, PurgeImageCacheOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6771,7 +11504,7 @@ func (c *Client) sendLogoutSessionCompat(ctx context.Context) (res LogoutSession
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, LogoutSessionCompatOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PurgeImageCacheOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6806,10 +11539,17 @@ func (c *Client) sendLogoutSessionCompat(ctx context.Context) (res LogoutSession
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeLogoutSessionCompatResponse(resp)
+	result, err := decodePurgeImageCacheResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6817,22 +11557,21 @@ func (c *Client) sendLogoutSessionCompat(ctx context.Context) (res LogoutSession
 	return result, nil
 }
 
-// PostDatabase invokes postDatabase operation.
+// PushLoginSession invokes pushLoginSession operation.
 //
-// Create a new database, the input need to be JSON. A structure level parameter indicate version to
-// be used.
+// Login using baseauth or bearer to receive or validate token.
 //
-// POST /rest/database
-func (c *Client) PostDatabase(ctx context.Context, request *Database) (PostDatabaseRes, error) {
-	res, err := c.sendPostDatabase(ctx, request)
+// POST /login
+func (c *Client) PushLoginSession(ctx context.Context) (PushLoginSessionRes, error) {
+	res, err := c.sendPushLoginSession(ctx)
 	return res, err
 }
 
-func (c *Client) sendPostDatabase(ctx context.Context, request *Database) (res PostDatabaseRes, err error) {
+func (c *Client) sendPushLoginSession(ctx context.Context) (res PushLoginSessionRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("postDatabase"),
+		otelogen.OperationID("pushLoginSession"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/rest/database"),
+		semconv.URLTemplateKey.String("/login"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6848,7 +11587,7 @@ func (c *Client) sendPostDatabase(ctx context.Context, request *Database) (res P
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PostDatabaseOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PushLoginSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6866,7 +11605,7 @@ func (c *Client) sendPostDatabase(ctx context.Context, request *Database) (res P
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/rest/database"
+	pathParts[0] = "/login"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6874,16 +11613,13 @@ func (c *Client) sendPostDatabase(ctx context.Context, request *Database) (res P
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostDatabaseRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, PostDatabaseOperation, r); {
+			switch err := c.securityBasicAuth(ctx, PushLoginSessionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6894,7 +11630,7 @@ func (c *Client) sendPostDatabase(ctx context.Context, request *Database) (res P
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, PostDatabaseOperation, r); {
+			switch err := c.securityTokenCheck(ctx, PushLoginSessionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6903,24 +11639,12 @@ func (c *Client) sendPostDatabase(ctx context.Context, request *Database) (res P
 				return res, errors.Wrap(err, "security \"TokenCheck\"")
 			}
 		}
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PostDatabaseOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 2
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
-			}
-		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
-				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -6940,10 +11664,17 @@ func (c *Client) sendPostDatabase(ctx context.Context, request *Database) (res P
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodePostDatabaseResponse(resp)
+	result, err := decodePushLoginSessionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6951,21 +11682,21 @@ func (c *Client) sendPostDatabase(ctx context.Context, request *Database) (res P
 	return result, nil
 }
 
-// PostJob invokes postJob operation.
+// RemoveSessionCompat invokes removeSessionCompat operation.
 //
-// Create a new Job database.
+// Invalidate given token session.
 //
-// POST /tasks
-func (c *Client) PostJob(ctx context.Context, request PostJobReq) (PostJobRes, error) {
-	res, err := c.sendPostJob(ctx, request)
+// GET /logoff
+func (c *Client) RemoveSessionCompat(ctx context.Context) (RemoveSessionCompatRes, error) {
+	res, err := c.sendRemoveSessionCompat(ctx)
 	return res, err
 }
 
-func (c *Client) sendPostJob(ctx context.Context, request PostJobReq) (res PostJobRes, err error) {
+func (c *Client) sendRemoveSessionCompat(ctx context.Context) (res RemoveSessionCompatRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("postJob"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/tasks"),
+		otelogen.OperationID("removeSessionCompat"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/logoff"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6981,7 +11712,7 @@ func (c *Client) sendPostJob(ctx context.Context, request PostJobReq) (res PostJ
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PostJobOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveSessionCompatOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6999,24 +11730,21 @@ func (c *Client) sendPostJob(ctx context.Context, request PostJobReq) (res PostJ
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/tasks"
+	pathParts[0] = "/logoff"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostJobRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, PostJobOperation, r); {
+			switch err := c.securityBasicAuth(ctx, RemoveSessionCompatOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7027,7 +11755,7 @@ func (c *Client) sendPostJob(ctx context.Context, request PostJobReq) (res PostJ
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, PostJobOperation, r); {
+			switch err := c.securityTokenCheck(ctx, RemoveSessionCompatOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7038,7 +11766,7 @@ func (c *Client) sendPostJob(ctx context.Context, request PostJobReq) (res PostJ
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PostJobOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RemoveSessionCompatOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7073,10 +11801,17 @@ func (c *Client) sendPostJob(ctx context.Context, request PostJobReq) (res PostJ
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodePostJobResponse(resp)
+	result, err := decodeRemoveSessionCompatResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7084,21 +11819,21 @@ func (c *Client) sendPostJob(ctx context.Context, request PostJobReq) (res PostJ
 	return result, nil
 }
 
-// PushLoginSession invokes pushLoginSession operation.
+// SearchModelling invokes searchModelling operation.
 //
-// Login using baseauth or bearer to receive or validate token.
+// Retrieves all columns, fields of a tables, views or data representation.
 //
-// POST /login
-func (c *Client) PushLoginSession(ctx context.Context) (PushLoginSessionRes, error) {
-	res, err := c.sendPushLoginSession(ctx)
+// GET /rest/map/{path}
+func (c *Client) SearchModelling(ctx context.Context, params SearchModellingParams) (SearchModellingRes, error) {
+	res, err := c.sendSearchModelling(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPushLoginSession(ctx context.Context) (res PushLoginSessionRes, err error) {
+func (c *Client) sendSearchModelling(ctx context.Context, params SearchModellingParams) (res SearchModellingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pushLoginSession"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/login"),
+		otelogen.OperationID("searchModelling"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/rest/map/{path}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7114,7 +11849,7 @@ func (c *Client) sendPushLoginSession(ctx context.Context) (res PushLoginSession
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PushLoginSessionOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchModellingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7131,12 +11866,30 @@ func (c *Client) sendPushLoginSession(ctx context.Context) (res PushLoginSession
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/login"
+	var pathParts [2]string
+	pathParts[0] = "/rest/map/"
+	{
+		// Encode "path" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "path",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Path))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -7146,7 +11899,7 @@ func (c *Client) sendPushLoginSession(ctx context.Context) (res PushLoginSession
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, PushLoginSessionOperation, r); {
+			switch err := c.securityBasicAuth(ctx, SearchModellingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7157,7 +11910,7 @@ func (c *Client) sendPushLoginSession(ctx context.Context) (res PushLoginSession
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, PushLoginSessionOperation, r); {
+			switch err := c.securityTokenCheck(ctx, SearchModellingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7166,12 +11919,24 @@ func (c *Client) sendPushLoginSession(ctx context.Context) (res PushLoginSession
 				return res, errors.Wrap(err, "security \"TokenCheck\"")
 			}
 		}
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SearchModellingOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -7191,10 +11956,17 @@ func (c *Client) sendPushLoginSession(ctx context.Context) (res PushLoginSession
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodePushLoginSessionResponse(resp)
+	result, err := decodeSearchModellingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7202,21 +11974,21 @@ func (c *Client) sendPushLoginSession(ctx context.Context) (res PushLoginSession
 	return result, nil
 }
 
-// RemoveSessionCompat invokes removeSessionCompat operation.
+// SearchRecordsFields invokes searchRecordsFields operation.
 //
-// Invalidate given token session.
+// Query a record with a given SQL query.
 //
-// GET /logoff
-func (c *Client) RemoveSessionCompat(ctx context.Context) (RemoveSessionCompatRes, error) {
-	res, err := c.sendRemoveSessionCompat(ctx)
+// GET /rest/view/{table}/{search}
+func (c *Client) SearchRecordsFields(ctx context.Context, params SearchRecordsFieldsParams) (SearchRecordsFieldsRes, error) {
+	res, err := c.sendSearchRecordsFields(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveSessionCompat(ctx context.Context) (res RemoveSessionCompatRes, err error) {
+func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecordsFieldsParams) (res SearchRecordsFieldsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeSessionCompat"),
+		otelogen.OperationID("searchRecordsFields"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/logoff"),
+		semconv.URLTemplateKey.String("/rest/view/{table}/{search}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7232,7 +12004,7 @@ func (c *Client) sendRemoveSessionCompat(ctx context.Context) (res RemoveSession
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveSessionCompatOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchRecordsFieldsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7244,162 +12016,361 @@ func (c *Client) sendRemoveSessionCompat(ctx context.Context) (res RemoveSession
 			span.SetStatus(codes.Error, stage)
 			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/logoff"
-	uri.AddPathParts(u, pathParts[:]...)
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/rest/view/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "search" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "search",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Search))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "start" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Start.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sorted_by" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sorted_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SortedBy.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sqlsearch" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sqlsearch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "compact" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "compact",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Compact.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "flatten" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "flatten",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Flatten.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "descriptor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "descriptor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Descriptor.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
-
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, RemoveSessionCompatOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BasicAuth\"")
-			}
+		// Encode "count" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		{
-			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, RemoveSessionCompatOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 1
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"TokenCheck\"")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Count.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
 			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemoveSessionCompatOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 2
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
-			}
+	}
+	{
+		// Encode "orderby" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "orderby",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-				{0b00000100},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Orderby.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "xmlnotation" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "xmlnotation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
-		return res, errors.Wrap(err, "do request")
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Xmlnotation.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
-	defer resp.Body.Close()
+	{
+		// Encode "delimiter" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "delimiter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	stage = "DecodeResponse"
-	result, err := decodeRemoveSessionCompatResponse(resp)
-	if err != nil {
-		return res, errors.Wrap(err, "decode response")
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Delimiter.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
+	{
+		// Encode "quote" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "quote",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	return result, nil
-}
-
-// SearchModelling invokes searchModelling operation.
-//
-// Retrieves all columns, fields of a tables, views or data representation.
-//
-// GET /rest/map/{path}
-func (c *Client) SearchModelling(ctx context.Context, params SearchModellingParams) (SearchModellingRes, error) {
-	res, err := c.sendSearchModelling(ctx, params)
-	return res, err
-}
-
-func (c *Client) sendSearchModelling(ctx context.Context, params SearchModellingParams) (res SearchModellingRes, err error) {
-	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("searchModelling"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/map/{path}"),
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Quote.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
-	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
-
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
+	{
+		// Encode "header" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "header",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Header.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "null" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "null",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SearchModellingOperation,
-		trace.WithAttributes(otelAttrs...),
-		clientSpanKind,
-	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Null.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dateformat" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dateformat",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		span.End()
-	}()
 
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/rest/map/"
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Dateformat.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
-		// Encode "path" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "path",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Path))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "bom" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "bom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Bom.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -7412,7 +12383,7 @@ func (c *Client) sendSearchModelling(ctx context.Context, params SearchModelling
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, SearchModellingOperation, r); {
+			switch err := c.securityBasicAuth(ctx, SearchRecordsFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7423,7 +12394,7 @@ func (c *Client) sendSearchModelling(ctx context.Context, params SearchModelling
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, SearchModellingOperation, r); {
+			switch err := c.securityTokenCheck(ctx, SearchRecordsFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7434,7 +12405,7 @@ func (c *Client) sendSearchModelling(ctx context.Context, params SearchModelling
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SearchModellingOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SearchRecordsFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7469,10 +12440,17 @@ func (c *Client) sendSearchModelling(ctx context.Context, params SearchModelling
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeSearchModellingResponse(resp)
+	result, err := decodeSearchRecordsFieldsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7480,21 +12458,21 @@ func (c *Client) sendSearchModelling(ctx context.Context, params SearchModelling
 	return result, nil
 }
 
-// SearchRecordsFields invokes searchRecordsFields operation.
+// SearchTable invokes searchTable operation.
 //
-// Query a record with a given SQL query.
+// Retrieves all fields of an file.
 //
-// GET /rest/view/{table}/{search}
-func (c *Client) SearchRecordsFields(ctx context.Context, params SearchRecordsFieldsParams) (SearchRecordsFieldsRes, error) {
-	res, err := c.sendSearchRecordsFields(ctx, params)
+// GET /rest/tables/{table}/{fields}/{search}
+func (c *Client) SearchTable(ctx context.Context, params SearchTableParams) (SearchTableRes, error) {
+	res, err := c.sendSearchTable(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecordsFieldsParams) (res SearchRecordsFieldsRes, err error) {
+func (c *Client) sendSearchTable(ctx context.Context, params SearchTableParams) (res SearchTableRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("searchRecordsFields"),
+		otelogen.OperationID("searchTable"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/view/{table}/{search}"),
+		semconv.URLTemplateKey.String("/rest/tables/{table}/{fields}/{search}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7510,7 +12488,7 @@ func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecor
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SearchRecordsFieldsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchTableOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7527,8 +12505,8 @@ func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecor
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/rest/view/"
+	var pathParts [6]string
+	pathParts[0] = "/rest/tables/"
 	{
 		// Encode "table" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -7548,6 +12526,25 @@ func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecor
 		pathParts[1] = encoded
 	}
 	pathParts[2] = "/"
+	{
+		// Encode "fields" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "fields",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Fields))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/"
 	{
 		// Encode "search" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -7564,39 +12561,22 @@ func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecor
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[3] = encoded
+		pathParts[5] = encoded
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "start" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "start",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Start.Get(); ok {
-				return e.EncodeValue(conv.Float64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "limit" parameter.
+		// Encode "orderby" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
+			Name:    "orderby",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
+			if val, ok := params.Orderby.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -7604,139 +12584,160 @@ func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecor
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "sorted_by" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sorted_by",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	u.RawQuery = q.Values().Encode()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.SortedBy.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
+
 	{
-		// Encode "sqlsearch" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sqlsearch",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, SearchTableOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Sqlsearch.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+		{
+			stage = "Security:TokenCheck"
+			switch err := c.securityTokenCheck(ctx, SearchTableOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"TokenCheck\"")
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
 		}
-	}
-	{
-		// Encode "compact" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "compact",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SearchTableOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 2
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Compact.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	{
-		// Encode "flatten" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "flatten",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Flatten.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
-	{
-		// Encode "descriptor" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "descriptor",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Descriptor.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "DecodeResponse"
+	result, err := decodeSearchTableResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
 	}
-	{
-		// Encode "orderby" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "orderby",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Orderby.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	return result, nil
+}
+
+// SetConfig invokes setConfig operation.
+//
+// Update current configuration and test.
+//
+// PUT /config
+func (c *Client) SetConfig(ctx context.Context, request SetConfigReq) (SetConfigRes, error) {
+	res, err := c.sendSetConfig(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSetConfig(ctx context.Context, request SetConfigReq) (res SetConfigRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("setConfig"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/config"),
 	}
-	{
-		// Encode "xmlnotation" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "xmlnotation",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SetConfigOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Xmlnotation.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/config"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeSetConfigRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, SearchRecordsFieldsOperation, r); {
+			switch err := c.securityBasicAuth(ctx, SetConfigOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7747,7 +12748,7 @@ func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecor
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, SearchRecordsFieldsOperation, r); {
+			switch err := c.securityTokenCheck(ctx, SetConfigOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7758,7 +12759,7 @@ func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecor
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SearchRecordsFieldsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SetConfigOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7793,10 +12794,17 @@ func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecor
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeSearchRecordsFieldsResponse(resp)
+	result, err := decodeSetConfigResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7804,21 +12812,21 @@ func (c *Client) sendSearchRecordsFields(ctx context.Context, params SearchRecor
 	return result, nil
 }
 
-// SearchTable invokes searchTable operation.
+// SetJobsConfig invokes setJobsConfig operation.
 //
-// Retrieves all fields of an file.
+// Set the Job configuration database.
 //
-// GET /rest/tables/{table}/{fields}/{search}
-func (c *Client) SearchTable(ctx context.Context, params SearchTableParams) (SearchTableRes, error) {
-	res, err := c.sendSearchTable(ctx, params)
+// PUT /config/jobs
+func (c *Client) SetJobsConfig(ctx context.Context, request OptJobStore) (SetJobsConfigRes, error) {
+	res, err := c.sendSetJobsConfig(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendSearchTable(ctx context.Context, params SearchTableParams) (res SearchTableRes, err error) {
+func (c *Client) sendSetJobsConfig(ctx context.Context, request OptJobStore) (res SetJobsConfigRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("searchTable"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/rest/tables/{table}/{fields}/{search}"),
+		otelogen.OperationID("setJobsConfig"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/config/jobs"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7834,7 +12842,7 @@ func (c *Client) sendSearchTable(ctx context.Context, params SearchTableParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SearchTableOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SetJobsConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7851,99 +12859,25 @@ func (c *Client) sendSearchTable(ctx context.Context, params SearchTableParams)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [6]string
-	pathParts[0] = "/rest/tables/"
-	{
-		// Encode "table" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "table",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Table))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/"
-	{
-		// Encode "fields" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "fields",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Fields))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/"
-	{
-		// Encode "search" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "search",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Search))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[5] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/config/jobs"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "orderby" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "orderby",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Orderby.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeSetJobsConfigRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, SearchTableOperation, r); {
+			switch err := c.securityBasicAuth(ctx, SetJobsConfigOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7954,7 +12888,7 @@ func (c *Client) sendSearchTable(ctx context.Context, params SearchTableParams)
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, SearchTableOperation, r); {
+			switch err := c.securityTokenCheck(ctx, SetJobsConfigOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7965,7 +12899,7 @@ func (c *Client) sendSearchTable(ctx context.Context, params SearchTableParams)
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SearchTableOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SetJobsConfigOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8000,10 +12934,17 @@ func (c *Client) sendSearchTable(ctx context.Context, params SearchTableParams)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeSearchTableResponse(resp)
+	result, err := decodeSetJobsConfigResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8011,21 +12952,21 @@ func (c *Client) sendSearchTable(ctx context.Context, params SearchTableParams)
 	return result, nil
 }
 
-// SetConfig invokes setConfig operation.
+// ShutdownServer invokes shutdownServer operation.
 //
-// Update current configuration and test.
+// Trigger shutdown of server instance.
 //
-// PUT /config
-func (c *Client) SetConfig(ctx context.Context, request SetConfigReq) (SetConfigRes, error) {
-	res, err := c.sendSetConfig(ctx, request)
+// PUT /rest/shutdown/{hash}
+func (c *Client) ShutdownServer(ctx context.Context, params ShutdownServerParams) (ShutdownServerRes, error) {
+	res, err := c.sendShutdownServer(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendSetConfig(ctx context.Context, request SetConfigReq) (res SetConfigRes, err error) {
+func (c *Client) sendShutdownServer(ctx context.Context, params ShutdownServerParams) (res ShutdownServerRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("setConfig"),
+		otelogen.OperationID("shutdownServer"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/config"),
+		semconv.URLTemplateKey.String("/rest/shutdown/{hash}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8041,7 +12982,7 @@ func (c *Client) sendSetConfig(ctx context.Context, request SetConfigReq) (res S
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SetConfigOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ShutdownServerOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8058,8 +12999,26 @@ func (c *Client) sendSetConfig(ctx context.Context, request SetConfigReq) (res S
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/config"
+	var pathParts [2]string
+	pathParts[0] = "/rest/shutdown/"
+	{
+		// Encode "hash" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "hash",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Hash))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -8067,16 +13026,13 @@ func (c *Client) sendSetConfig(ctx context.Context, request SetConfigReq) (res S
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSetConfigRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, SetConfigOperation, r); {
+			switch err := c.securityBasicAuth(ctx, ShutdownServerOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8087,7 +13043,7 @@ func (c *Client) sendSetConfig(ctx context.Context, request SetConfigReq) (res S
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, SetConfigOperation, r); {
+			switch err := c.securityTokenCheck(ctx, ShutdownServerOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8098,7 +13054,7 @@ func (c *Client) sendSetConfig(ctx context.Context, request SetConfigReq) (res S
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SetConfigOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ShutdownServerOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8133,10 +13089,17 @@ func (c *Client) sendSetConfig(ctx context.Context, request SetConfigReq) (res S
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeSetConfigResponse(resp)
+	result, err := decodeShutdownServerResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8144,21 +13107,21 @@ func (c *Client) sendSetConfig(ctx context.Context, request SetConfigReq) (res S
 	return result, nil
 }
 
-// SetJobsConfig invokes setJobsConfig operation.
+// StoreConfig invokes storeConfig operation.
 //
-// Set the Job configuration database.
+// Store current active configuration to configuration file.
 //
-// PUT /config/jobs
-func (c *Client) SetJobsConfig(ctx context.Context, request OptJobStore) (SetJobsConfigRes, error) {
-	res, err := c.sendSetJobsConfig(ctx, request)
+// POST /config
+func (c *Client) StoreConfig(ctx context.Context) (StoreConfigRes, error) {
+	res, err := c.sendStoreConfig(ctx)
 	return res, err
 }
 
-func (c *Client) sendSetJobsConfig(ctx context.Context, request OptJobStore) (res SetJobsConfigRes, err error) {
+func (c *Client) sendStoreConfig(ctx context.Context) (res StoreConfigRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("setJobsConfig"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/config/jobs"),
+		otelogen.OperationID("storeConfig"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/config"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8174,7 +13137,7 @@ func (c *Client) sendSetJobsConfig(ctx context.Context, request OptJobStore) (re
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SetJobsConfigOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StoreConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8192,24 +13155,21 @@ func (c *Client) sendSetJobsConfig(ctx context.Context, request OptJobStore) (re
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/config/jobs"
+	pathParts[0] = "/config"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSetJobsConfigRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, SetJobsConfigOperation, r); {
+			switch err := c.securityBasicAuth(ctx, StoreConfigOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8220,7 +13180,7 @@ func (c *Client) sendSetJobsConfig(ctx context.Context, request OptJobStore) (re
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, SetJobsConfigOperation, r); {
+			switch err := c.securityTokenCheck(ctx, StoreConfigOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8231,7 +13191,7 @@ func (c *Client) sendSetJobsConfig(ctx context.Context, request OptJobStore) (re
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SetJobsConfigOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StoreConfigOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8266,10 +13226,17 @@ func (c *Client) sendSetJobsConfig(ctx context.Context, request OptJobStore) (re
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeSetJobsConfigResponse(resp)
+	result, err := decodeStoreConfigResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8277,21 +13244,21 @@ func (c *Client) sendSetJobsConfig(ctx context.Context, request OptJobStore) (re
 	return result, nil
 }
 
-// ShutdownServer invokes shutdownServer operation.
+// TriggerExtend invokes triggerExtend operation.
 //
-// Trigger shutdown of server instance.
+// Put extend/plugin request.
 //
-// PUT /rest/shutdown/{hash}
-func (c *Client) ShutdownServer(ctx context.Context, params ShutdownServerParams) (ShutdownServerRes, error) {
-	res, err := c.sendShutdownServer(ctx, params)
+// PUT /rest/extend/{path}
+func (c *Client) TriggerExtend(ctx context.Context, params TriggerExtendParams) (TriggerExtendRes, error) {
+	res, err := c.sendTriggerExtend(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendShutdownServer(ctx context.Context, params ShutdownServerParams) (res ShutdownServerRes, err error) {
+func (c *Client) sendTriggerExtend(ctx context.Context, params TriggerExtendParams) (res TriggerExtendRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("shutdownServer"),
+		otelogen.OperationID("triggerExtend"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/rest/shutdown/{hash}"),
+		semconv.URLTemplateKey.String("/rest/extend/{path}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8307,7 +13274,7 @@ func (c *Client) sendShutdownServer(ctx context.Context, params ShutdownServerPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ShutdownServerOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, TriggerExtendOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8325,16 +13292,16 @@ func (c *Client) sendShutdownServer(ctx context.Context, params ShutdownServerPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/rest/shutdown/"
+	pathParts[0] = "/rest/extend/"
 	{
-		// Encode "hash" parameter.
+		// Encode "path" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "hash",
+			Param:   "path",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Hash))
+			return e.EncodeValue(conv.StringToString(params.Path))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -8357,7 +13324,7 @@ func (c *Client) sendShutdownServer(ctx context.Context, params ShutdownServerPa
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, ShutdownServerOperation, r); {
+			switch err := c.securityBasicAuth(ctx, TriggerExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8368,7 +13335,7 @@ func (c *Client) sendShutdownServer(ctx context.Context, params ShutdownServerPa
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, ShutdownServerOperation, r); {
+			switch err := c.securityTokenCheck(ctx, TriggerExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8379,7 +13346,7 @@ func (c *Client) sendShutdownServer(ctx context.Context, params ShutdownServerPa
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ShutdownServerOperation, r); {
+			switch err := c.securityBearerAuth(ctx, TriggerExtendOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8414,10 +13381,17 @@ func (c *Client) sendShutdownServer(ctx context.Context, params ShutdownServerPa
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeShutdownServerResponse(resp)
+	result, err := decodeTriggerExtendResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8425,21 +13399,21 @@ func (c *Client) sendShutdownServer(ctx context.Context, params ShutdownServerPa
 	return result, nil
 }
 
-// StoreConfig invokes storeConfig operation.
+// TriggerJob invokes triggerJob operation.
 //
-// Store current active configuration to configuration file.
+// Trigger a job.
 //
-// POST /config
-func (c *Client) StoreConfig(ctx context.Context) (StoreConfigRes, error) {
-	res, err := c.sendStoreConfig(ctx)
+// PUT /tasks/{jobName}
+func (c *Client) TriggerJob(ctx context.Context, params TriggerJobParams) (TriggerJobRes, error) {
+	res, err := c.sendTriggerJob(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendStoreConfig(ctx context.Context) (res StoreConfigRes, err error) {
+func (c *Client) sendTriggerJob(ctx context.Context, params TriggerJobParams) (res TriggerJobRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storeConfig"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/config"),
+		otelogen.OperationID("triggerJob"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/tasks/{jobName}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8455,7 +13429,7 @@ func (c *Client) sendStoreConfig(ctx context.Context) (res StoreConfigRes, err e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StoreConfigOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, TriggerJobOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8472,12 +13446,30 @@ func (c *Client) sendStoreConfig(ctx context.Context) (res StoreConfigRes, err e
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/config"
+	var pathParts [2]string
+	pathParts[0] = "/tasks/"
+	{
+		// Encode "jobName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "jobName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.JobName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -8487,7 +13479,7 @@ func (c *Client) sendStoreConfig(ctx context.Context) (res StoreConfigRes, err e
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, StoreConfigOperation, r); {
+			switch err := c.securityBasicAuth(ctx, TriggerJobOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8498,7 +13490,7 @@ func (c *Client) sendStoreConfig(ctx context.Context) (res StoreConfigRes, err e
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, StoreConfigOperation, r); {
+			switch err := c.securityTokenCheck(ctx, TriggerJobOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8509,7 +13501,7 @@ func (c *Client) sendStoreConfig(ctx context.Context) (res StoreConfigRes, err e
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StoreConfigOperation, r); {
+			switch err := c.securityBearerAuth(ctx, TriggerJobOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8544,10 +13536,17 @@ func (c *Client) sendStoreConfig(ctx context.Context) (res StoreConfigRes, err e
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeStoreConfigResponse(resp)
+	result, err := decodeTriggerJobResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8555,21 +13554,22 @@ func (c *Client) sendStoreConfig(ctx context.Context) (res StoreConfigRes, err e
 	return result, nil
 }
 
-// TriggerExtend invokes triggerExtend operation.
+// UpdateImageHashes invokes updateImageHashes operation.
 //
-// Put extend/plugin request.
+// Calculates the perceptual hash of the image field of all records matching the search and stores it
+// in the hash field.
 //
-// PUT /rest/extend/{path}
-func (c *Client) TriggerExtend(ctx context.Context, params TriggerExtendParams) (TriggerExtendRes, error) {
-	res, err := c.sendTriggerExtend(ctx, params)
+// PUT /hash/{table}/{field}
+func (c *Client) UpdateImageHashes(ctx context.Context, params UpdateImageHashesParams) (UpdateImageHashesRes, error) {
+	res, err := c.sendUpdateImageHashes(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendTriggerExtend(ctx context.Context, params TriggerExtendParams) (res TriggerExtendRes, err error) {
+func (c *Client) sendUpdateImageHashes(ctx context.Context, params UpdateImageHashesParams) (res UpdateImageHashesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("triggerExtend"),
+		otelogen.OperationID("updateImageHashes"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/rest/extend/{path}"),
+		semconv.URLTemplateKey.String("/hash/{table}/{field}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8585,7 +13585,7 @@ func (c *Client) sendTriggerExtend(ctx context.Context, params TriggerExtendPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, TriggerExtendOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateImageHashesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8602,17 +13602,17 @@ func (c *Client) sendTriggerExtend(ctx context.Context, params TriggerExtendPara
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/rest/extend/"
+	var pathParts [4]string
+	pathParts[0] = "/hash/"
 	{
-		// Encode "path" parameter.
+		// Encode "table" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "path",
+			Param:   "table",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Path))
+			return e.EncodeValue(conv.StringToString(params.Table))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -8622,8 +13622,113 @@ func (c *Client) sendTriggerExtend(ctx context.Context, params TriggerExtendPara
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/"
+	{
+		// Encode "field" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "field",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Field))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "hashField" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "hashField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.HashField))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "search" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "search",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Search.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "mimetypeField" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MimetypeField.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "algorithm" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "algorithm",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Algorithm.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "overwrite" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "overwrite",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Overwrite.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
@@ -8635,7 +13740,7 @@ func (c *Client) sendTriggerExtend(ctx context.Context, params TriggerExtendPara
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, TriggerExtendOperation, r); {
+			switch err := c.securityBasicAuth(ctx, UpdateImageHashesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8646,7 +13751,7 @@ func (c *Client) sendTriggerExtend(ctx context.Context, params TriggerExtendPara
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, TriggerExtendOperation, r); {
+			switch err := c.securityTokenCheck(ctx, UpdateImageHashesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8657,7 +13762,7 @@ func (c *Client) sendTriggerExtend(ctx context.Context, params TriggerExtendPara
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, TriggerExtendOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateImageHashesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8692,10 +13797,17 @@ func (c *Client) sendTriggerExtend(ctx context.Context, params TriggerExtendPara
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeTriggerExtendResponse(resp)
+	result, err := decodeUpdateImageHashesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8703,21 +13815,24 @@ func (c *Client) sendTriggerExtend(ctx context.Context, params TriggerExtendPara
 	return result, nil
 }
 
-// TriggerJob invokes triggerJob operation.
+// UpdateLobByMap invokes updateLobByMap operation.
 //
-// Trigger a job.
+// Set a lob at a specific table record of an field in a Map. The request body is streamed into the
+// field of exactly one record matching the search. On PostgreSQL and MySQL tables with primary key the
+// body is appended block by block inside one transaction, other tables read the body into memory up to
+// the maxBinaryBufferSize limit.
 //
-// PUT /tasks/{jobName}
-func (c *Client) TriggerJob(ctx context.Context, params TriggerJobParams) (TriggerJobRes, error) {
-	res, err := c.sendTriggerJob(ctx, params)
+// PUT /binary/{table}/{field}/{search}
+func (c *Client) UpdateLobByMap(ctx context.Context, request UpdateLobByMapReq, params UpdateLobByMapParams) (UpdateLobByMapRes, error) {
+	res, err := c.sendUpdateLobByMap(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendTriggerJob(ctx context.Context, params TriggerJobParams) (res TriggerJobRes, err error) {
+func (c *Client) sendUpdateLobByMap(ctx context.Context, request UpdateLobByMapReq, params UpdateLobByMapParams) (res UpdateLobByMapRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("triggerJob"),
+		otelogen.OperationID("updateLobByMap"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/tasks/{jobName}"),
+		semconv.URLTemplateKey.String("/binary/{table}/{field}/{search}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8733,7 +13848,7 @@ func (c *Client) sendTriggerJob(ctx context.Context, params TriggerJobParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, TriggerJobOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateLobByMapOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8741,26 +13856,64 @@ func (c *Client) sendTriggerJob(ctx context.Context, params TriggerJobParams) (r
 	var stage string
 	defer func() {
 		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [6]string
+	pathParts[0] = "/binary/"
+	{
+		// Encode "table" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "table",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Table))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "field" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "field",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Field))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/tasks/"
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/"
 	{
-		// Encode "jobName" parameter.
+		// Encode "search" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "jobName",
+			Param:   "search",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.JobName))
+			return e.EncodeValue(conv.StringToString(params.Search))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -8768,22 +13921,80 @@ func (c *Client) sendTriggerJob(ctx context.Context, params TriggerJobParams) (r
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[1] = encoded
+		pathParts[5] = encoded
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "mimetypeField" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MimetypeField.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "mimetype" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mimetype",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Mimetype.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "maxSize" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "maxSize",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MaxSize.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateLobByMapRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, TriggerJobOperation, r); {
+			switch err := c.securityBasicAuth(ctx, UpdateLobByMapOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8794,7 +14005,7 @@ func (c *Client) sendTriggerJob(ctx context.Context, params TriggerJobParams) (r
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, TriggerJobOperation, r); {
+			switch err := c.securityTokenCheck(ctx, UpdateLobByMapOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8805,7 +14016,7 @@ func (c *Client) sendTriggerJob(ctx context.Context, params TriggerJobParams) (r
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, TriggerJobOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateLobByMapOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8840,10 +14051,17 @@ func (c *Client) sendTriggerJob(ctx context.Context, params TriggerJobParams) (r
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeTriggerJobResponse(resp)
+	result, err := decodeUpdateLobByMapResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8851,21 +14069,21 @@ func (c *Client) sendTriggerJob(ctx context.Context, params TriggerJobParams) (r
 	return result, nil
 }
 
-// UpdateLobByMap invokes updateLobByMap operation.
+// UpdateRecordsByFields invokes updateRecordsByFields operation.
 //
-// Set a lob at a specific table record of an field in a Map.
+// Update a record dependent on field(s) of a specific table.
 //
-// PUT /binary/{table}/{field}/{search}
-func (c *Client) UpdateLobByMap(ctx context.Context, request UpdateLobByMapReq, params UpdateLobByMapParams) (UpdateLobByMapRes, error) {
-	res, err := c.sendUpdateLobByMap(ctx, request, params)
+// PUT /rest/view/{table}/{search}
+func (c *Client) UpdateRecordsByFields(ctx context.Context, request OptUpdateRecordsByFieldsReq, params UpdateRecordsByFieldsParams) (UpdateRecordsByFieldsRes, error) {
+	res, err := c.sendUpdateRecordsByFields(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateLobByMap(ctx context.Context, request UpdateLobByMapReq, params UpdateLobByMapParams) (res UpdateLobByMapRes, err error) {
+func (c *Client) sendUpdateRecordsByFields(ctx context.Context, request OptUpdateRecordsByFieldsReq, params UpdateRecordsByFieldsParams) (res UpdateRecordsByFieldsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateLobByMap"),
+		otelogen.OperationID("updateRecordsByFields"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/binary/{table}/{field}/{search}"),
+		semconv.URLTemplateKey.String("/rest/view/{table}/{search}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8881,7 +14099,7 @@ func (c *Client) sendUpdateLobByMap(ctx context.Context, request UpdateLobByMapR
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateLobByMapOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateRecordsByFieldsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8898,8 +14116,8 @@ func (c *Client) sendUpdateLobByMap(ctx context.Context, request UpdateLobByMapR
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [6]string
-	pathParts[0] = "/binary/"
+	var pathParts [4]string
+	pathParts[0] = "/rest/view/"
 	{
 		// Encode "table" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -8920,14 +14138,14 @@ func (c *Client) sendUpdateLobByMap(ctx context.Context, request UpdateLobByMapR
 	}
 	pathParts[2] = "/"
 	{
-		// Encode "field" parameter.
+		// Encode "search" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "field",
+			Param:   "search",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Field))
+			return e.EncodeValue(conv.StringToString(params.Search))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -8937,42 +14155,95 @@ func (c *Client) sendUpdateLobByMap(ctx context.Context, request UpdateLobByMapR
 		}
 		pathParts[3] = encoded
 	}
-	pathParts[4] = "/"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "search" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "search",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Search))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "dryRun" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dryRun",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DryRun.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[5] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "sample" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sample",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sample.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "confirm" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "confirm",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Confirm.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateLobByMapRequest(request, r); err != nil {
+	if err := encodeUpdateRecordsByFieldsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, UpdateLobByMapOperation, r); {
+			switch err := c.securityBasicAuth(ctx, UpdateRecordsByFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8983,7 +14254,7 @@ func (c *Client) sendUpdateLobByMap(ctx context.Context, request UpdateLobByMapR
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, UpdateLobByMapOperation, r); {
+			switch err := c.securityTokenCheck(ctx, UpdateRecordsByFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8994,7 +14265,7 @@ func (c *Client) sendUpdateLobByMap(ctx context.Context, request UpdateLobByMapR
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateLobByMapOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateRecordsByFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9029,10 +14300,17 @@ func (c *Client) sendUpdateLobByMap(ctx context.Context, request UpdateLobByMapR
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateLobByMapResponse(resp)
+	result, err := decodeUpdateRecordsByFieldsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9040,21 +14318,22 @@ func (c *Client) sendUpdateLobByMap(ctx context.Context, request UpdateLobByMapR
 	return result, nil
 }
 
-// UpdateRecordsByFields invokes updateRecordsByFields operation.
+// UploadChunk invokes uploadChunk operation.
 //
-// Update a record dependent on field(s) of a specific table.
+// Append a chunk of data at the offset of a resumable upload. The file is moved to the location if the
+// upload is complete.
 //
-// PUT /rest/view/{table}/{search}
-func (c *Client) UpdateRecordsByFields(ctx context.Context, request OptUpdateRecordsByFieldsReq, params UpdateRecordsByFieldsParams) (UpdateRecordsByFieldsRes, error) {
-	res, err := c.sendUpdateRecordsByFields(ctx, request, params)
+// PATCH /rest/uploads/{uploadId}
+func (c *Client) UploadChunk(ctx context.Context, request UploadChunkReq, params UploadChunkParams) (UploadChunkRes, error) {
+	res, err := c.sendUploadChunk(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateRecordsByFields(ctx context.Context, request OptUpdateRecordsByFieldsReq, params UpdateRecordsByFieldsParams) (res UpdateRecordsByFieldsRes, err error) {
+func (c *Client) sendUploadChunk(ctx context.Context, request UploadChunkReq, params UploadChunkParams) (res UploadChunkRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateRecordsByFields"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/rest/view/{table}/{search}"),
+		otelogen.OperationID("uploadChunk"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/rest/uploads/{uploadId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -9070,7 +14349,7 @@ func (c *Client) sendUpdateRecordsByFields(ctx context.Context, request OptUpdat
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateRecordsByFieldsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UploadChunkOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9087,17 +14366,17 @@ func (c *Client) sendUpdateRecordsByFields(ctx context.Context, request OptUpdat
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/rest/view/"
+	var pathParts [2]string
+	pathParts[0] = "/rest/uploads/"
 	{
-		// Encode "table" parameter.
+		// Encode "uploadId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "table",
+			Param:   "uploadId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Table))
+			return e.EncodeValue(conv.StringToString(params.UploadId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -9107,42 +14386,51 @@ func (c *Client) sendUpdateRecordsByFields(ctx context.Context, request OptUpdat
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/"
-	{
-		// Encode "search" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "search",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Search))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateRecordsByFieldsRequest(request, r); err != nil {
+	if err := encodeUploadChunkRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Upload-Offset",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.Int64ToString(params.UploadOffset))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Upload-Checksum",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.UploadChecksum.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, UpdateRecordsByFieldsOperation, r); {
+			switch err := c.securityBasicAuth(ctx, UploadChunkOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9153,7 +14441,7 @@ func (c *Client) sendUpdateRecordsByFields(ctx context.Context, request OptUpdat
 		}
 		{
 			stage = "Security:TokenCheck"
-			switch err := c.securityTokenCheck(ctx, UpdateRecordsByFieldsOperation, r); {
+			switch err := c.securityTokenCheck(ctx, UploadChunkOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9164,7 +14452,7 @@ func (c *Client) sendUpdateRecordsByFields(ctx context.Context, request OptUpdat
 		}
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateRecordsByFieldsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UploadChunkOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 2
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9199,10 +14487,17 @@ func (c *Client) sendUpdateRecordsByFields(ctx context.Context, request OptUpdat
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateRecordsByFieldsResponse(resp)
+	result, err := decodeUploadChunkResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9371,7 +14666,14 @@ func (c *Client) sendUploadFile(ctx context.Context, request *UploadFileReq, par
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
 	stage = "DecodeResponse"
 	result, err := decodeUploadFileResponse(resp)
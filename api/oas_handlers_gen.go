@@ -15,7 +15,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
-	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -46,6 +46,8 @@ func (s *Server) handleAddViewRequest(args [0]string, argsEscaped bool, w http.R
 		semconv.HTTPRequestMethodKey.String("POST"),
 		semconv.HTTPRouteKey.String("/config/views"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
 	ctx, span := s.cfg.Tracer.Start(r.Context(), AddViewOperation,
@@ -69,7 +71,7 @@ func (s *Server) handleAddViewRequest(args [0]string, argsEscaped bool, w http.R
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -273,22 +275,24 @@ func (s *Server) handleAddViewRequest(args [0]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleBatchParameterQueryRequest handles batchParameterQuery operation.
+// handleAggregateRecordsRequest handles aggregateRecords operation.
 //
-// Call a SQL query batch command posted in query.
+// Aggregate records of a table with count, sum, min, max or avg grouped by the given fields.
 //
-// GET /rest/batch/{table}/{query}
-func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/aggregate/{table}
+func (s *Server) handleAggregateRecordsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("batchParameterQuery"),
+		otelogen.OperationID("aggregateRecords"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/batch/{table}/{query}"),
+		semconv.HTTPRouteKey.String("/rest/aggregate/{table}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), BatchParameterQueryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AggregateRecordsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -309,7 +313,7 @@ func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bo
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -343,15 +347,15 @@ func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: BatchParameterQueryOperation,
-			ID:   "batchParameterQuery",
+			Name: AggregateRecordsOperation,
+			ID:   "aggregateRecords",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, BatchParameterQueryOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, AggregateRecordsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -369,7 +373,7 @@ func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, BatchParameterQueryOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, AggregateRecordsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -387,7 +391,7 @@ func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, BatchParameterQueryOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, AggregateRecordsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -431,7 +435,7 @@ func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeBatchParameterQueryParams(args, argsEscaped, r)
+	params, err := decodeAggregateRecordsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -444,36 +448,52 @@ func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response BatchParameterQueryRes
+	var response AggregateRecordsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    BatchParameterQueryOperation,
+			OperationName:    AggregateRecordsOperation,
 			OperationSummary: "",
-			OperationID:      "batchParameterQuery",
+			OperationID:      "aggregateRecords",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "table",
-					In:   "path",
-				}: params.Table,
+					Name: "metrics",
+					In:   "query",
+				}: params.Metrics,
 				{
-					Name: "query",
-					In:   "path",
-				}: params.Query,
+					Name: "groupby",
+					In:   "query",
+				}: params.Groupby,
 				{
-					Name: "validate",
+					Name: "filter",
 					In:   "query",
-				}: params.Validate,
+				}: params.Filter,
+				{
+					Name: "having",
+					In:   "query",
+				}: params.Having,
+				{
+					Name: "orderby",
+					In:   "query",
+				}: params.Orderby,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = BatchParameterQueryParams
-			Response = BatchParameterQueryRes
+			Params   = AggregateRecordsParams
+			Response = AggregateRecordsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -482,14 +502,14 @@ func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackBatchParameterQueryParams,
+			unpackAggregateRecordsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.BatchParameterQuery(ctx, params)
+				response, err = s.h.AggregateRecords(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.BatchParameterQuery(ctx, params)
+		response, err = s.h.AggregateRecords(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -508,7 +528,7 @@ func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeBatchParameterQueryResponse(response, w, span); err != nil {
+	if err := encodeAggregateRecordsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -517,22 +537,24 @@ func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bo
 	}
 }
 
-// handleBatchQueryRequest handles batchQuery operation.
+// handleBatchParameterQueryRequest handles batchParameterQuery operation.
 //
-// Call a SQL query batch command using insert or update data in body.
+// Call a SQL query batch command posted in query.
 //
-// POST /rest/batch/{table}
-func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/batch/{table}/{query}
+func (s *Server) handleBatchParameterQueryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("batchQuery"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/rest/batch/{table}"),
+		otelogen.OperationID("batchParameterQuery"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/rest/batch/{table}/{query}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), BatchQueryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), BatchParameterQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -553,7 +575,7 @@ func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w htt
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -587,15 +609,15 @@ func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: BatchQueryOperation,
-			ID:   "batchQuery",
+			Name: BatchParameterQueryOperation,
+			ID:   "batchParameterQuery",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, BatchQueryOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, BatchParameterQueryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -613,7 +635,7 @@ func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, BatchQueryOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, BatchParameterQueryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -631,7 +653,7 @@ func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, BatchQueryOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, BatchParameterQueryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -675,7 +697,7 @@ func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeBatchQueryParams(args, argsEscaped, r)
+	params, err := decodeBatchParameterQueryParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -687,36 +709,49 @@ func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w htt
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeBatchQueryRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response BatchQueryRes
+	var response BatchParameterQueryRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    BatchQueryOperation,
+			OperationName:    BatchParameterQueryOperation,
 			OperationSummary: "",
-			OperationID:      "batchQuery",
-			Body:             request,
+			OperationID:      "batchParameterQuery",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
+				{
+					Name: "delimiter",
+					In:   "query",
+				}: params.Delimiter,
+				{
+					Name: "quote",
+					In:   "query",
+				}: params.Quote,
+				{
+					Name: "header",
+					In:   "query",
+				}: params.Header,
+				{
+					Name: "null",
+					In:   "query",
+				}: params.Null,
+				{
+					Name: "dateformat",
+					In:   "query",
+				}: params.Dateformat,
+				{
+					Name: "bom",
+					In:   "query",
+				}: params.Bom,
 				{
 					Name: "table",
 					In:   "path",
 				}: params.Table,
+				{
+					Name: "query",
+					In:   "path",
+				}: params.Query,
 				{
 					Name: "validate",
 					In:   "query",
@@ -726,9 +761,9 @@ func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w htt
 		}
 
 		type (
-			Request  = BatchQueryReq
-			Params   = BatchQueryParams
-			Response = BatchQueryRes
+			Request  = struct{}
+			Params   = BatchParameterQueryParams
+			Response = BatchParameterQueryRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -737,14 +772,14 @@ func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackBatchQueryParams,
+			unpackBatchParameterQueryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.BatchQuery(ctx, request, params)
+				response, err = s.h.BatchParameterQuery(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.BatchQuery(ctx, request, params)
+		response, err = s.h.BatchParameterQuery(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -763,7 +798,7 @@ func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeBatchQueryResponse(response, w, span); err != nil {
+	if err := encodeBatchParameterQueryResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -772,22 +807,24 @@ func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleBatchSelectRequest handles batchSelect operation.
+// handleBatchQueryRequest handles batchQuery operation.
 //
-// Call a SQL query batch command store in batch database table with name.
+// Call a SQL query batch command using insert or update data in body.
 //
-// GET /rest/batch/{table}
-func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /rest/batch/{table}
+func (s *Server) handleBatchQueryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("batchSelect"),
-		semconv.HTTPRequestMethodKey.String("GET"),
+		otelogen.OperationID("batchQuery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
 		semconv.HTTPRouteKey.String("/rest/batch/{table}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), BatchSelectOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), BatchQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -808,7 +845,7 @@ func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w ht
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -842,15 +879,15 @@ func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: BatchSelectOperation,
-			ID:   "batchSelect",
+			Name: BatchQueryOperation,
+			ID:   "batchQuery",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, BatchSelectOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, BatchQueryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -868,7 +905,7 @@ func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, BatchSelectOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, BatchQueryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -886,7 +923,7 @@ func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, BatchSelectOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, BatchQueryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -930,7 +967,7 @@ func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeBatchSelectParams(args, argsEscaped, r)
+	params, err := decodeBatchQueryParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -942,21 +979,56 @@ func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w ht
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeBatchQueryRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response BatchSelectRes
+	var response BatchQueryRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    BatchSelectOperation,
+			OperationName:    BatchQueryOperation,
 			OperationSummary: "",
-			OperationID:      "batchSelect",
-			Body:             nil,
+			OperationID:      "batchQuery",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "param",
+					Name: "delimiter",
 					In:   "query",
-				}: params.Param,
+				}: params.Delimiter,
+				{
+					Name: "quote",
+					In:   "query",
+				}: params.Quote,
+				{
+					Name: "header",
+					In:   "query",
+				}: params.Header,
+				{
+					Name: "null",
+					In:   "query",
+				}: params.Null,
+				{
+					Name: "dateformat",
+					In:   "query",
+				}: params.Dateformat,
+				{
+					Name: "bom",
+					In:   "query",
+				}: params.Bom,
 				{
 					Name: "table",
 					In:   "path",
@@ -970,9 +1042,9 @@ func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w ht
 		}
 
 		type (
-			Request  = struct{}
-			Params   = BatchSelectParams
-			Response = BatchSelectRes
+			Request  = BatchQueryReq
+			Params   = BatchQueryParams
+			Response = BatchQueryRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -981,14 +1053,14 @@ func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackBatchSelectParams,
+			unpackBatchQueryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.BatchSelect(ctx, params)
+				response, err = s.h.BatchQuery(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.BatchSelect(ctx, params)
+		response, err = s.h.BatchQuery(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1007,7 +1079,7 @@ func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeBatchSelectResponse(response, w, span); err != nil {
+	if err := encodeBatchQueryResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1016,22 +1088,24 @@ func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleBrowseListRequest handles browseList operation.
+// handleBatchSelectRequest handles batchSelect operation.
 //
-// Retrieves a list of Browseable locations.
+// Call a SQL query batch command store in batch database table with name.
 //
-// GET /rest/file/browse
-func (s *Server) handleBrowseListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/batch/{table}
+func (s *Server) handleBatchSelectRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("browseList"),
+		otelogen.OperationID("batchSelect"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/file/browse"),
+		semconv.HTTPRouteKey.String("/rest/batch/{table}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), BrowseListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), BatchSelectOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1052,7 +1126,7 @@ func (s *Server) handleBrowseListRequest(args [0]string, argsEscaped bool, w htt
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -1086,15 +1160,15 @@ func (s *Server) handleBrowseListRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: BrowseListOperation,
-			ID:   "browseList",
+			Name: BatchSelectOperation,
+			ID:   "batchSelect",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, BrowseListOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, BatchSelectOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1112,7 +1186,7 @@ func (s *Server) handleBrowseListRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, BrowseListOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, BatchSelectOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1130,7 +1204,7 @@ func (s *Server) handleBrowseListRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, BrowseListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, BatchSelectOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1174,42 +1248,89 @@ func (s *Server) handleBrowseListRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
+	params, err := decodeBatchSelectParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response BrowseListRes
+	var response BatchSelectRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    BrowseListOperation,
+			OperationName:    BatchSelectOperation,
 			OperationSummary: "",
-			OperationID:      "browseList",
+			OperationID:      "batchSelect",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
-		}
-
-		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = BrowseListRes
-		)
-		response, err = middleware.HookMiddleware[
-			Request,
-			Params,
+			Params: middleware.Parameters{
+				{
+					Name: "param",
+					In:   "query",
+				}: params.Param,
+				{
+					Name: "delimiter",
+					In:   "query",
+				}: params.Delimiter,
+				{
+					Name: "quote",
+					In:   "query",
+				}: params.Quote,
+				{
+					Name: "header",
+					In:   "query",
+				}: params.Header,
+				{
+					Name: "null",
+					In:   "query",
+				}: params.Null,
+				{
+					Name: "dateformat",
+					In:   "query",
+				}: params.Dateformat,
+				{
+					Name: "bom",
+					In:   "query",
+				}: params.Bom,
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "validate",
+					In:   "query",
+				}: params.Validate,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = BatchSelectParams
+			Response = BatchSelectRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
 			Response,
 		](
 			m,
 			mreq,
-			nil,
+			unpackBatchSelectParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.BrowseList(ctx)
+				response, err = s.h.BatchSelect(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.BrowseList(ctx)
+		response, err = s.h.BatchSelect(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1228,7 +1349,7 @@ func (s *Server) handleBrowseListRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeBrowseListResponse(response, w, span); err != nil {
+	if err := encodeBatchSelectResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1237,22 +1358,24 @@ func (s *Server) handleBrowseListRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleBrowseLocationRequest handles browseLocation operation.
+// handleBrowseListRequest handles browseList operation.
 //
-// Retrieves a list of files in the defined location.
+// Retrieves a list of Browseable locations.
 //
-// GET /rest/file/browse/{path}
-func (s *Server) handleBrowseLocationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/file/browse
+func (s *Server) handleBrowseListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("browseLocation"),
+		otelogen.OperationID("browseList"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/file/browse/{path}"),
+		semconv.HTTPRouteKey.String("/rest/file/browse"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), BrowseLocationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), BrowseListOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1273,7 +1396,7 @@ func (s *Server) handleBrowseLocationRequest(args [1]string, argsEscaped bool, w
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -1307,15 +1430,15 @@ func (s *Server) handleBrowseLocationRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: BrowseLocationOperation,
-			ID:   "browseLocation",
+			Name: BrowseListOperation,
+			ID:   "browseList",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, BrowseLocationOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, BrowseListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1333,7 +1456,7 @@ func (s *Server) handleBrowseLocationRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, BrowseLocationOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, BrowseListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1351,7 +1474,7 @@ func (s *Server) handleBrowseLocationRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, BrowseLocationOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, BrowseListOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1395,45 +1518,26 @@ func (s *Server) handleBrowseLocationRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeBrowseLocationParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response BrowseLocationRes
+	var response BrowseListRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    BrowseLocationOperation,
+			OperationName:    BrowseListOperation,
 			OperationSummary: "",
-			OperationID:      "browseLocation",
+			OperationID:      "browseList",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "path",
-					In:   "path",
-				}: params.Path,
-				{
-					Name: "filter",
-					In:   "query",
-				}: params.Filter,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = BrowseLocationParams
-			Response = BrowseLocationRes
+			Params   = struct{}
+			Response = BrowseListRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1442,14 +1546,14 @@ func (s *Server) handleBrowseLocationRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackBrowseLocationParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.BrowseLocation(ctx, params)
+				response, err = s.h.BrowseList(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.BrowseLocation(ctx, params)
+		response, err = s.h.BrowseList(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1468,7 +1572,7 @@ func (s *Server) handleBrowseLocationRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeBrowseLocationResponse(response, w, span); err != nil {
+	if err := encodeBrowseListResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1477,22 +1581,24 @@ func (s *Server) handleBrowseLocationRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleCallExtendRequest handles callExtend operation.
+// handleBrowseLocationRequest handles browseLocation operation.
 //
-// Call extend plugin, own implementation plugin.
+// Retrieves a list of files in the defined location.
 //
-// GET /rest/extend/{path}
-func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/file/browse/{path}
+func (s *Server) handleBrowseLocationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("callExtend"),
+		otelogen.OperationID("browseLocation"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/extend/{path}"),
+		semconv.HTTPRouteKey.String("/rest/file/browse/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CallExtendOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), BrowseLocationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1513,7 +1619,7 @@ func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w htt
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -1547,15 +1653,15 @@ func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CallExtendOperation,
-			ID:   "callExtend",
+			Name: BrowseLocationOperation,
+			ID:   "browseLocation",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, CallExtendOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, BrowseLocationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1573,7 +1679,7 @@ func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, CallExtendOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, BrowseLocationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1591,7 +1697,7 @@ func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CallExtendOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, BrowseLocationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1635,7 +1741,7 @@ func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeCallExtendParams(args, argsEscaped, r)
+	params, err := decodeBrowseLocationParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1648,13 +1754,13 @@ func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w htt
 
 	var rawBody []byte
 
-	var response CallExtendRes
+	var response BrowseLocationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CallExtendOperation,
+			OperationName:    BrowseLocationOperation,
 			OperationSummary: "",
-			OperationID:      "callExtend",
+			OperationID:      "browseLocation",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -1663,17 +1769,17 @@ func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w htt
 					In:   "path",
 				}: params.Path,
 				{
-					Name: "params",
+					Name: "filter",
 					In:   "query",
-				}: params.Params,
+				}: params.Filter,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = CallExtendParams
-			Response = CallExtendRes
+			Params   = BrowseLocationParams
+			Response = BrowseLocationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1682,14 +1788,14 @@ func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackCallExtendParams,
+			unpackBrowseLocationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CallExtend(ctx, params)
+				response, err = s.h.BrowseLocation(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CallExtend(ctx, params)
+		response, err = s.h.BrowseLocation(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1708,7 +1814,7 @@ func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeCallExtendResponse(response, w, span); err != nil {
+	if err := encodeBrowseLocationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1717,22 +1823,24 @@ func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleCallPostExtendRequest handles callPostExtend operation.
+// handleCallExtendRequest handles callExtend operation.
 //
-// Post extend/plugin.
+// Call extend plugin, own implementation plugin.
 //
-// POST /rest/extend/{path}
-func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/extend/{path}
+func (s *Server) handleCallExtendRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("callPostExtend"),
-		semconv.HTTPRequestMethodKey.String("POST"),
+		otelogen.OperationID("callExtend"),
+		semconv.HTTPRequestMethodKey.String("GET"),
 		semconv.HTTPRouteKey.String("/rest/extend/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CallPostExtendOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CallExtendOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1753,7 +1861,7 @@ func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -1787,15 +1895,15 @@ func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CallPostExtendOperation,
-			ID:   "callPostExtend",
+			Name: CallExtendOperation,
+			ID:   "callExtend",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, CallPostExtendOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, CallExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1813,7 +1921,7 @@ func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, CallPostExtendOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, CallExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1831,7 +1939,7 @@ func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CallPostExtendOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CallExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1875,7 +1983,7 @@ func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeCallPostExtendParams(args, argsEscaped, r)
+	params, err := decodeCallExtendParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1887,30 +1995,15 @@ func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCallPostExtendRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response CallPostExtendRes
+	var response CallExtendRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CallPostExtendOperation,
+			OperationName:    CallExtendOperation,
 			OperationSummary: "",
-			OperationID:      "callPostExtend",
-			Body:             request,
+			OperationID:      "callExtend",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
@@ -1918,17 +2011,17 @@ func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w
 					In:   "path",
 				}: params.Path,
 				{
-					Name: "file",
+					Name: "params",
 					In:   "query",
-				}: params.File,
+				}: params.Params,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *CallPostExtendReq
-			Params   = CallPostExtendParams
-			Response = CallPostExtendRes
+			Request  = struct{}
+			Params   = CallExtendParams
+			Response = CallExtendRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1937,14 +2030,14 @@ func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackCallPostExtendParams,
+			unpackCallExtendParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CallPostExtend(ctx, request, params)
+				response, err = s.h.CallExtend(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CallPostExtend(ctx, request, params)
+		response, err = s.h.CallExtend(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1963,7 +2056,7 @@ func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeCallPostExtendResponse(response, w, span); err != nil {
+	if err := encodeCallExtendResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1972,22 +2065,24 @@ func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleCreateDirectoryRequest handles createDirectory operation.
+// handleCallPostExtendRequest handles callPostExtend operation.
 //
-// Create a new directory.
+// Post extend/plugin.
 //
-// PUT /rest/file/{path}
-func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /rest/extend/{path}
+func (s *Server) handleCallPostExtendRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createDirectory"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/rest/file/{path}"),
+		otelogen.OperationID("callPostExtend"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/rest/extend/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateDirectoryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CallPostExtendOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2008,7 +2103,7 @@ func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool,
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -2042,15 +2137,15 @@ func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateDirectoryOperation,
-			ID:   "createDirectory",
+			Name: CallPostExtendOperation,
+			ID:   "callPostExtend",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, CreateDirectoryOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, CallPostExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2068,7 +2163,7 @@ func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, CreateDirectoryOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, CallPostExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2086,7 +2181,7 @@ func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateDirectoryOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CallPostExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2130,7 +2225,7 @@ func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeCreateDirectoryParams(args, argsEscaped, r)
+	params, err := decodeCallPostExtendParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2142,29 +2237,48 @@ func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
-
-	var response CreateDirectoryRes
-	if m := s.cfg.Middleware; m != nil {
+	request, rawBody, close, err := s.decodeCallPostExtendRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CallPostExtendRes
+	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateDirectoryOperation,
+			OperationName:    CallPostExtendOperation,
 			OperationSummary: "",
-			OperationID:      "createDirectory",
-			Body:             nil,
+			OperationID:      "callPostExtend",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
 					Name: "path",
 					In:   "path",
 				}: params.Path,
+				{
+					Name: "file",
+					In:   "query",
+				}: params.File,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = CreateDirectoryParams
-			Response = CreateDirectoryRes
+			Request  = *CallPostExtendReq
+			Params   = CallPostExtendParams
+			Response = CallPostExtendRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2173,14 +2287,14 @@ func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackCreateDirectoryParams,
+			unpackCallPostExtendParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateDirectory(ctx, params)
+				response, err = s.h.CallPostExtend(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateDirectory(ctx, params)
+		response, err = s.h.CallPostExtend(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2199,7 +2313,7 @@ func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeCreateDirectoryResponse(response, w, span); err != nil {
+	if err := encodeCallPostExtendResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2208,22 +2322,24 @@ func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleDeleteExtendRequest handles deleteExtend operation.
+// handleCancelUploadRequest handles cancelUpload operation.
 //
-// Delete extend/plugin data.
+// Cancel a resumable upload and remove the received data.
 //
-// DELETE /rest/extend/{path}
-func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /rest/uploads/{uploadId}
+func (s *Server) handleCancelUploadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteExtend"),
+		otelogen.OperationID("cancelUpload"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/rest/extend/{path}"),
+		semconv.HTTPRouteKey.String("/rest/uploads/{uploadId}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteExtendOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CancelUploadOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2244,7 +2360,7 @@ func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w h
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -2278,15 +2394,15 @@ func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteExtendOperation,
-			ID:   "deleteExtend",
+			Name: CancelUploadOperation,
+			ID:   "cancelUpload",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, DeleteExtendOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, CancelUploadOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2304,7 +2420,7 @@ func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, DeleteExtendOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, CancelUploadOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2322,7 +2438,7 @@ func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteExtendOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CancelUploadOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2366,7 +2482,7 @@ func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeDeleteExtendParams(args, argsEscaped, r)
+	params, err := decodeCancelUploadParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2379,32 +2495,28 @@ func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w h
 
 	var rawBody []byte
 
-	var response DeleteExtendRes
+	var response CancelUploadRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteExtendOperation,
+			OperationName:    CancelUploadOperation,
 			OperationSummary: "",
-			OperationID:      "deleteExtend",
+			OperationID:      "cancelUpload",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "path",
+					Name: "uploadId",
 					In:   "path",
-				}: params.Path,
-				{
-					Name: "file",
-					In:   "query",
-				}: params.File,
+				}: params.UploadId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteExtendParams
-			Response = DeleteExtendRes
+			Params   = CancelUploadParams
+			Response = CancelUploadRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2413,14 +2525,14 @@ func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackDeleteExtendParams,
+			unpackCancelUploadParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteExtend(ctx, params)
+				response, err = s.h.CancelUpload(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteExtend(ctx, params)
+		response, err = s.h.CancelUpload(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2439,7 +2551,7 @@ func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeDeleteExtendResponse(response, w, span); err != nil {
+	if err := encodeCancelUploadResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2448,22 +2560,24 @@ func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleDeleteFileLocationRequest handles deleteFileLocation operation.
+// handleCreateDirectoryRequest handles createDirectory operation.
 //
-// Delete the file on the given location.
+// Create a new directory.
 //
-// DELETE /rest/file/{path}
-func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /rest/file/{path}
+func (s *Server) handleCreateDirectoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteFileLocation"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
+		otelogen.OperationID("createDirectory"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
 		semconv.HTTPRouteKey.String("/rest/file/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteFileLocationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateDirectoryOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2484,7 +2598,7 @@ func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped boo
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -2518,15 +2632,15 @@ func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteFileLocationOperation,
-			ID:   "deleteFileLocation",
+			Name: CreateDirectoryOperation,
+			ID:   "createDirectory",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, DeleteFileLocationOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, CreateDirectoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2544,7 +2658,7 @@ func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, DeleteFileLocationOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, CreateDirectoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2562,7 +2676,7 @@ func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteFileLocationOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateDirectoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2606,7 +2720,7 @@ func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeDeleteFileLocationParams(args, argsEscaped, r)
+	params, err := decodeCreateDirectoryParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2619,13 +2733,13 @@ func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped boo
 
 	var rawBody []byte
 
-	var response DeleteFileLocationRes
+	var response CreateDirectoryRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteFileLocationOperation,
+			OperationName:    CreateDirectoryOperation,
 			OperationSummary: "",
-			OperationID:      "deleteFileLocation",
+			OperationID:      "createDirectory",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -2633,18 +2747,14 @@ func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped boo
 					Name: "path",
 					In:   "path",
 				}: params.Path,
-				{
-					Name: "file",
-					In:   "query",
-				}: params.File,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteFileLocationParams
-			Response = DeleteFileLocationRes
+			Params   = CreateDirectoryParams
+			Response = CreateDirectoryRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2653,14 +2763,14 @@ func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackDeleteFileLocationParams,
+			unpackCreateDirectoryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteFileLocation(ctx, params)
+				response, err = s.h.CreateDirectory(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteFileLocation(ctx, params)
+		response, err = s.h.CreateDirectory(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2679,7 +2789,7 @@ func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeDeleteFileLocationResponse(response, w, span); err != nil {
+	if err := encodeCreateDirectoryResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2688,22 +2798,25 @@ func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleDeleteJobResultRequest handles deleteJobResult operation.
+// handleCreateUploadRequest handles createUpload operation.
 //
-// Delete a specific job result.
+// Create a resumable upload of a new file to the given location. The data is sent in chunks to the
+// returned upload.
 //
-// DELETE /tasks/{jobName}/{jobId}
-func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /rest/upload/{path}
+func (s *Server) handleCreateUploadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteJobResult"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/tasks/{jobName}/{jobId}"),
+		otelogen.OperationID("createUpload"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/rest/upload/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteJobResultOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateUploadOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2724,7 +2837,7 @@ func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool,
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -2758,15 +2871,15 @@ func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteJobResultOperation,
-			ID:   "deleteJobResult",
+			Name: CreateUploadOperation,
+			ID:   "createUpload",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, DeleteJobResultOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, CreateUploadOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2784,7 +2897,7 @@ func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, DeleteJobResultOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, CreateUploadOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2802,7 +2915,7 @@ func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteJobResultOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateUploadOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2846,7 +2959,7 @@ func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeDeleteJobResultParams(args, argsEscaped, r)
+	params, err := decodeCreateUploadParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2859,32 +2972,40 @@ func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response DeleteJobResultRes
+	var response CreateUploadRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteJobResultOperation,
+			OperationName:    CreateUploadOperation,
 			OperationSummary: "",
-			OperationID:      "deleteJobResult",
+			OperationID:      "createUpload",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "jobName",
+					Name: "path",
 					In:   "path",
-				}: params.JobName,
+				}: params.Path,
 				{
-					Name: "jobId",
-					In:   "path",
-				}: params.JobId,
+					Name: "file",
+					In:   "query",
+				}: params.File,
+				{
+					Name: "Upload-Length",
+					In:   "header",
+				}: params.UploadLength,
+				{
+					Name: "Upload-Checksum",
+					In:   "header",
+				}: params.UploadChecksum,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteJobResultParams
-			Response = DeleteJobResultRes
+			Params   = CreateUploadParams
+			Response = CreateUploadRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2893,14 +3014,14 @@ func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackDeleteJobResultParams,
+			unpackCreateUploadParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteJobResult(ctx, params)
+				response, err = s.h.CreateUpload(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteJobResult(ctx, params)
+		response, err = s.h.CreateUpload(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2919,7 +3040,7 @@ func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeDeleteJobResultResponse(response, w, span); err != nil {
+	if err := encodeCreateUploadResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2928,22 +3049,24 @@ func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool,
 	}
 }
 
-// handleDeleteRecordsSearchedRequest handles deleteRecordsSearched operation.
+// handleDeleteExtendRequest handles deleteExtend operation.
 //
-// Delete a record with a given search.
+// Delete extend/plugin data.
 //
-// DELETE /rest/view/{table}/{search}
-func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /rest/extend/{path}
+func (s *Server) handleDeleteExtendRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteRecordsSearched"),
+		otelogen.OperationID("deleteExtend"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/rest/view/{table}/{search}"),
+		semconv.HTTPRouteKey.String("/rest/extend/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteRecordsSearchedOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteExtendOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2964,7 +3087,7 @@ func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -2998,15 +3121,15 @@ func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteRecordsSearchedOperation,
-			ID:   "deleteRecordsSearched",
+			Name: DeleteExtendOperation,
+			ID:   "deleteExtend",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, DeleteRecordsSearchedOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, DeleteExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3024,7 +3147,7 @@ func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, DeleteRecordsSearchedOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, DeleteExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3042,7 +3165,7 @@ func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteRecordsSearchedOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3086,7 +3209,7 @@ func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDeleteRecordsSearchedParams(args, argsEscaped, r)
+	params, err := decodeDeleteExtendParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3099,68 +3222,32 @@ func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped
 
 	var rawBody []byte
 
-	var response DeleteRecordsSearchedRes
+	var response DeleteExtendRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteRecordsSearchedOperation,
+			OperationName:    DeleteExtendOperation,
 			OperationSummary: "",
-			OperationID:      "deleteRecordsSearched",
+			OperationID:      "deleteExtend",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "sorted_by",
-					In:   "query",
-				}: params.SortedBy,
-				{
-					Name: "sqlsearch",
-					In:   "query",
-				}: params.Sqlsearch,
-				{
-					Name: "compact",
-					In:   "query",
-				}: params.Compact,
-				{
-					Name: "flatten",
-					In:   "query",
-				}: params.Flatten,
-				{
-					Name: "descriptor",
-					In:   "query",
-				}: params.Descriptor,
-				{
-					Name: "orderby",
-					In:   "query",
-				}: params.Orderby,
-				{
-					Name: "xmlnotation",
-					In:   "query",
-				}: params.Xmlnotation,
-				{
-					Name: "table",
+					Name: "path",
 					In:   "path",
-				}: params.Table,
+				}: params.Path,
 				{
-					Name: "search",
-					In:   "path",
-				}: params.Search,
+					Name: "file",
+					In:   "query",
+				}: params.File,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteRecordsSearchedParams
-			Response = DeleteRecordsSearchedRes
+			Params   = DeleteExtendParams
+			Response = DeleteExtendRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3169,14 +3256,14 @@ func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDeleteRecordsSearchedParams,
+			unpackDeleteExtendParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteRecordsSearched(ctx, params)
+				response, err = s.h.DeleteExtend(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteRecordsSearched(ctx, params)
+		response, err = s.h.DeleteExtend(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3195,7 +3282,7 @@ func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped
 		return
 	}
 
-	if err := encodeDeleteRecordsSearchedResponse(response, w, span); err != nil {
+	if err := encodeDeleteExtendResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3204,22 +3291,24 @@ func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped
 	}
 }
 
-// handleDeleteViewRequest handles deleteView operation.
+// handleDeleteFileLocationRequest handles deleteFileLocation operation.
 //
-// Delete/disable table view in configuration.
+// Delete the file on the given location.
 //
-// DELETE /config/views
-func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /rest/file/{path}
+func (s *Server) handleDeleteFileLocationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteView"),
+		otelogen.OperationID("deleteFileLocation"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/config/views"),
+		semconv.HTTPRouteKey.String("/rest/file/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteViewOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteFileLocationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3240,7 +3329,7 @@ func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w htt
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -3274,15 +3363,15 @@ func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteViewOperation,
-			ID:   "deleteView",
+			Name: DeleteFileLocationOperation,
+			ID:   "deleteFileLocation",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, DeleteViewOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, DeleteFileLocationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3300,7 +3389,7 @@ func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, DeleteViewOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, DeleteFileLocationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3318,7 +3407,7 @@ func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteViewOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteFileLocationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3362,7 +3451,7 @@ func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeDeleteViewParams(args, argsEscaped, r)
+	params, err := decodeDeleteFileLocationParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3375,20 +3464,20 @@ func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w htt
 
 	var rawBody []byte
 
-	var response DeleteViewRes
+	var response DeleteFileLocationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteViewOperation,
+			OperationName:    DeleteFileLocationOperation,
 			OperationSummary: "",
-			OperationID:      "deleteView",
+			OperationID:      "deleteFileLocation",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "url",
-					In:   "query",
-				}: params.URL,
+					Name: "path",
+					In:   "path",
+				}: params.Path,
 				{
 					Name: "file",
 					In:   "query",
@@ -3399,8 +3488,8 @@ func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w htt
 
 		type (
 			Request  = struct{}
-			Params   = DeleteViewParams
-			Response = DeleteViewRes
+			Params   = DeleteFileLocationParams
+			Response = DeleteFileLocationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3409,14 +3498,14 @@ func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackDeleteViewParams,
+			unpackDeleteFileLocationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteView(ctx, params)
+				response, err = s.h.DeleteFileLocation(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteView(ctx, params)
+		response, err = s.h.DeleteFileLocation(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3435,7 +3524,7 @@ func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDeleteViewResponse(response, w, span); err != nil {
+	if err := encodeDeleteFileLocationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3444,22 +3533,24 @@ func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDownloadFileRequest handles downloadFile operation.
+// handleDeleteJobResultRequest handles deleteJobResult operation.
 //
-// Download a file out of file location.
+// Delete a specific job result.
 //
-// GET /rest/file/{path}
-func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /tasks/{jobName}/{jobId}
+func (s *Server) handleDeleteJobResultRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("downloadFile"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/file/{path}"),
+		otelogen.OperationID("deleteJobResult"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/tasks/{jobName}/{jobId}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadFileOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteJobResultOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3480,7 +3571,7 @@ func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w h
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -3514,15 +3605,15 @@ func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DownloadFileOperation,
-			ID:   "downloadFile",
+			Name: DeleteJobResultOperation,
+			ID:   "deleteJobResult",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, DownloadFileOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, DeleteJobResultOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3540,7 +3631,7 @@ func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, DownloadFileOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, DeleteJobResultOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3558,7 +3649,7 @@ func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DownloadFileOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteJobResultOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3602,7 +3693,7 @@ func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeDownloadFileParams(args, argsEscaped, r)
+	params, err := decodeDeleteJobResultParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3615,28 +3706,32 @@ func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w h
 
 	var rawBody []byte
 
-	var response DownloadFileRes
+	var response DeleteJobResultRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DownloadFileOperation,
+			OperationName:    DeleteJobResultOperation,
 			OperationSummary: "",
-			OperationID:      "downloadFile",
+			OperationID:      "deleteJobResult",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "path",
+					Name: "jobName",
 					In:   "path",
-				}: params.Path,
+				}: params.JobName,
+				{
+					Name: "jobId",
+					In:   "path",
+				}: params.JobId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DownloadFileParams
-			Response = DownloadFileRes
+			Params   = DeleteJobResultParams
+			Response = DeleteJobResultRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3645,14 +3740,14 @@ func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackDownloadFileParams,
+			unpackDeleteJobResultParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DownloadFile(ctx, params)
+				response, err = s.h.DeleteJobResult(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DownloadFile(ctx, params)
+		response, err = s.h.DeleteJobResult(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3671,7 +3766,7 @@ func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeDownloadFileResponse(response, w, span); err != nil {
+	if err := encodeDeleteJobResultResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3680,22 +3775,24 @@ func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetConfigRequest handles getConfig operation.
+// handleDeleteRecordByKeyRequest handles deleteRecordByKey operation.
 //
-// Get current active configuration.
+// Delete the record with the given primary key.
 //
-// GET /config
-func (s *Server) handleGetConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /rest/view/{table}/pk/{key}
+func (s *Server) handleDeleteRecordByKeyRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getConfig"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/config"),
+		otelogen.OperationID("deleteRecordByKey"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/rest/view/{table}/pk/{key}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetConfigOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteRecordByKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3716,7 +3813,7 @@ func (s *Server) handleGetConfigRequest(args [0]string, argsEscaped bool, w http
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -3750,15 +3847,15 @@ func (s *Server) handleGetConfigRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetConfigOperation,
-			ID:   "getConfig",
+			Name: DeleteRecordByKeyOperation,
+			ID:   "deleteRecordByKey",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetConfigOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, DeleteRecordByKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3776,7 +3873,7 @@ func (s *Server) handleGetConfigRequest(args [0]string, argsEscaped bool, w http
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetConfigOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, DeleteRecordByKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3794,7 +3891,7 @@ func (s *Server) handleGetConfigRequest(args [0]string, argsEscaped bool, w http
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetConfigOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteRecordByKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3838,26 +3935,45 @@ func (s *Server) handleGetConfigRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
+	params, err := decodeDeleteRecordByKeyParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response GetConfigRes
+	var response DeleteRecordByKeyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetConfigOperation,
+			OperationName:    DeleteRecordByKeyOperation,
 			OperationSummary: "",
-			OperationID:      "getConfig",
+			OperationID:      "deleteRecordByKey",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "key",
+					In:   "path",
+				}: params.Key,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetConfigRes
+			Params   = DeleteRecordByKeyParams
+			Response = DeleteRecordByKeyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3866,14 +3982,14 @@ func (s *Server) handleGetConfigRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeleteRecordByKeyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetConfig(ctx)
+				response, err = s.h.DeleteRecordByKey(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetConfig(ctx)
+		response, err = s.h.DeleteRecordByKey(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3892,7 +4008,7 @@ func (s *Server) handleGetConfigRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeGetConfigResponse(response, w, span); err != nil {
+	if err := encodeDeleteRecordByKeyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3901,22 +4017,24 @@ func (s *Server) handleGetConfigRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleGetDatabasesRequest handles getDatabases operation.
+// handleDeleteRecordsSearchedRequest handles deleteRecordsSearched operation.
 //
-// Retrieves a list of databases known by server.
+// Delete a record with a given search.
 //
-// GET /rest/database
-func (s *Server) handleGetDatabasesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /rest/view/{table}/{search}
+func (s *Server) handleDeleteRecordsSearchedRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDatabases"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/database"),
+		otelogen.OperationID("deleteRecordsSearched"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/rest/view/{table}/{search}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDatabasesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteRecordsSearchedOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3937,7 +4055,7 @@ func (s *Server) handleGetDatabasesRequest(args [0]string, argsEscaped bool, w h
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -3971,15 +4089,15 @@ func (s *Server) handleGetDatabasesRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetDatabasesOperation,
-			ID:   "getDatabases",
+			Name: DeleteRecordsSearchedOperation,
+			ID:   "deleteRecordsSearched",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetDatabasesOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, DeleteRecordsSearchedOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3997,7 +4115,7 @@ func (s *Server) handleGetDatabasesRequest(args [0]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetDatabasesOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, DeleteRecordsSearchedOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4015,7 +4133,7 @@ func (s *Server) handleGetDatabasesRequest(args [0]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetDatabasesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteRecordsSearchedOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4059,26 +4177,93 @@ func (s *Server) handleGetDatabasesRequest(args [0]string, argsEscaped bool, w h
 			return
 		}
 	}
+	params, err := decodeDeleteRecordsSearchedParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response GetDatabasesRes
+	var response DeleteRecordsSearchedRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetDatabasesOperation,
+			OperationName:    DeleteRecordsSearchedOperation,
 			OperationSummary: "",
-			OperationID:      "getDatabases",
+			OperationID:      "deleteRecordsSearched",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "dryRun",
+					In:   "query",
+				}: params.DryRun,
+				{
+					Name: "sample",
+					In:   "query",
+				}: params.Sample,
+				{
+					Name: "confirm",
+					In:   "query",
+				}: params.Confirm,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "sorted_by",
+					In:   "query",
+				}: params.SortedBy,
+				{
+					Name: "sqlsearch",
+					In:   "query",
+				}: params.Sqlsearch,
+				{
+					Name: "compact",
+					In:   "query",
+				}: params.Compact,
+				{
+					Name: "flatten",
+					In:   "query",
+				}: params.Flatten,
+				{
+					Name: "descriptor",
+					In:   "query",
+				}: params.Descriptor,
+				{
+					Name: "orderby",
+					In:   "query",
+				}: params.Orderby,
+				{
+					Name: "xmlnotation",
+					In:   "query",
+				}: params.Xmlnotation,
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetDatabasesRes
+			Params   = DeleteRecordsSearchedParams
+			Response = DeleteRecordsSearchedRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4087,14 +4272,14 @@ func (s *Server) handleGetDatabasesRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeleteRecordsSearchedParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetDatabases(ctx)
+				response, err = s.h.DeleteRecordsSearched(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetDatabases(ctx)
+		response, err = s.h.DeleteRecordsSearched(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4113,7 +4298,7 @@ func (s *Server) handleGetDatabasesRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeGetDatabasesResponse(response, w, span); err != nil {
+	if err := encodeDeleteRecordsSearchedResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4122,22 +4307,24 @@ func (s *Server) handleGetDatabasesRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetFieldsRequest handles getFields operation.
+// handleDeleteViewRequest handles deleteView operation.
 //
-// Retrieves all fields of an file.
+// Delete/disable table view in configuration.
 //
-// GET /rest/tables/{table}/fields
-func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /config/views
+func (s *Server) handleDeleteViewRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getFields"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/tables/{table}/fields"),
-	}
+		otelogen.OperationID("deleteView"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/config/views"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetFieldsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteViewOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4158,7 +4345,7 @@ func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -4192,15 +4379,15 @@ func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetFieldsOperation,
-			ID:   "getFields",
+			Name: DeleteViewOperation,
+			ID:   "deleteView",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetFieldsOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, DeleteViewOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4218,7 +4405,7 @@ func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetFieldsOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, DeleteViewOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4236,7 +4423,7 @@ func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetFieldsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteViewOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4280,7 +4467,7 @@ func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http
 			return
 		}
 	}
-	params, err := decodeGetFieldsParams(args, argsEscaped, r)
+	params, err := decodeDeleteViewParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4293,28 +4480,32 @@ func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http
 
 	var rawBody []byte
 
-	var response GetFieldsRes
+	var response DeleteViewRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetFieldsOperation,
+			OperationName:    DeleteViewOperation,
 			OperationSummary: "",
-			OperationID:      "getFields",
+			OperationID:      "deleteView",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "table",
-					In:   "path",
-				}: params.Table,
+					Name: "url",
+					In:   "query",
+				}: params.URL,
+				{
+					Name: "file",
+					In:   "query",
+				}: params.File,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetFieldsParams
-			Response = GetFieldsRes
+			Params   = DeleteViewParams
+			Response = DeleteViewRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4323,14 +4514,14 @@ func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			unpackGetFieldsParams,
+			unpackDeleteViewParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetFields(ctx, params)
+				response, err = s.h.DeleteView(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetFields(ctx, params)
+		response, err = s.h.DeleteView(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4349,7 +4540,7 @@ func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeGetFieldsResponse(response, w, span); err != nil {
+	if err := encodeDeleteViewResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4358,22 +4549,24 @@ func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http
 	}
 }
 
-// handleGetImageRequest handles getImage operation.
+// handleDownloadFileRequest handles downloadFile operation.
 //
-// Retrieves a field of a specific table record of a Map definition.
+// Download a file out of file location.
 //
-// GET /image/{table}/{field}/{search}
-func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/file/{path}
+func (s *Server) handleDownloadFileRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getImage"),
+		otelogen.OperationID("downloadFile"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/image/{table}/{field}/{search}"),
+		semconv.HTTPRouteKey.String("/rest/file/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetImageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadFileOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4394,7 +4587,7 @@ func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -4428,15 +4621,15 @@ func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetImageOperation,
-			ID:   "getImage",
+			Name: DownloadFileOperation,
+			ID:   "downloadFile",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetImageOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, DownloadFileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4454,7 +4647,7 @@ func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetImageOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, DownloadFileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4472,7 +4665,7 @@ func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetImageOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DownloadFileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4516,7 +4709,7 @@ func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	params, err := decodeGetImageParams(args, argsEscaped, r)
+	params, err := decodeDownloadFileParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4529,52 +4722,28 @@ func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.
 
 	var rawBody []byte
 
-	var response GetImageRes
+	var response DownloadFileRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetImageOperation,
+			OperationName:    DownloadFileOperation,
 			OperationSummary: "",
-			OperationID:      "getImage",
+			OperationID:      "downloadFile",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "table",
-					In:   "path",
-				}: params.Table,
-				{
-					Name: "reference",
-					In:   "query",
-				}: params.Reference,
-				{
-					Name: "search",
-					In:   "path",
-				}: params.Search,
-				{
-					Name: "mimetype",
-					In:   "query",
-				}: params.Mimetype,
-				{
-					Name: "mimetypeField",
-					In:   "query",
-				}: params.MimetypeField,
-				{
-					Name: "field",
+					Name: "path",
 					In:   "path",
-				}: params.Field,
-				{
-					Name: "sqlsearch",
-					In:   "query",
-				}: params.Sqlsearch,
+				}: params.Path,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetImageParams
-			Response = GetImageRes
+			Params   = DownloadFileParams
+			Response = DownloadFileRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4583,14 +4752,14 @@ func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetImageParams,
+			unpackDownloadFileParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetImage(ctx, params)
+				response, err = s.h.DownloadFile(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetImage(ctx, params)
+		response, err = s.h.DownloadFile(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4609,7 +4778,7 @@ func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetImageResponse(response, w, span); err != nil {
+	if err := encodeDownloadFileResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4618,22 +4787,24 @@ func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetJobExecutionResultRequest handles getJobExecutionResult operation.
+// handleExecuteTransactionRequest handles executeTransaction operation.
 //
-// Retrieves a specific job result.
+// Execute a list of insert, update and delete operations on tables of one database in one transaction.
 //
-// GET /tasks/results
-func (s *Server) handleGetJobExecutionResultRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /rest/transaction
+func (s *Server) handleExecuteTransactionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJobExecutionResult"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tasks/results"),
+		otelogen.OperationID("executeTransaction"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/rest/transaction"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJobExecutionResultOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ExecuteTransactionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4654,7 +4825,7 @@ func (s *Server) handleGetJobExecutionResultRequest(args [0]string, argsEscaped
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -4688,15 +4859,15 @@ func (s *Server) handleGetJobExecutionResultRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetJobExecutionResultOperation,
-			ID:   "getJobExecutionResult",
+			Name: ExecuteTransactionOperation,
+			ID:   "executeTransaction",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetJobExecutionResultOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, ExecuteTransactionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4714,7 +4885,7 @@ func (s *Server) handleGetJobExecutionResultRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetJobExecutionResultOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, ExecuteTransactionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4732,7 +4903,7 @@ func (s *Server) handleGetJobExecutionResultRequest(args [0]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetJobExecutionResultOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ExecuteTransactionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4776,45 +4947,41 @@ func (s *Server) handleGetJobExecutionResultRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeGetJobExecutionResultParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeExecuteTransactionRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response GetJobExecutionResultRes
+	var response ExecuteTransactionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetJobExecutionResultOperation,
+			OperationName:    ExecuteTransactionOperation,
 			OperationSummary: "",
-			OperationID:      "getJobExecutionResult",
-			Body:             nil,
+			OperationID:      "executeTransaction",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "from",
-					In:   "query",
-				}: params.From,
-				{
-					Name: "to",
-					In:   "query",
-				}: params.To,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetJobExecutionResultParams
-			Response = GetJobExecutionResultRes
+			Request  = *Transaction
+			Params   = struct{}
+			Response = ExecuteTransactionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4823,14 +4990,14 @@ func (s *Server) handleGetJobExecutionResultRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetJobExecutionResultParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetJobExecutionResult(ctx, params)
+				response, err = s.h.ExecuteTransaction(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetJobExecutionResult(ctx, params)
+		response, err = s.h.ExecuteTransaction(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4849,7 +5016,7 @@ func (s *Server) handleGetJobExecutionResultRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetJobExecutionResultResponse(response, w, span); err != nil {
+	if err := encodeExecuteTransactionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4858,22 +5025,25 @@ func (s *Server) handleGetJobExecutionResultRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleGetJobFullInfoRequest handles getJobFullInfo operation.
+// handleGetArchiveRequest handles getArchive operation.
 //
-// Retrieves a full job definition.
+// Retrieves the large object field of all records matching the search as ZIP archive with one entry
+// per record.
 //
-// GET /tasks/{jobName}
-func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /archive/{table}/{field}/{search}
+func (s *Server) handleGetArchiveRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJobFullInfo"),
+		otelogen.OperationID("getArchive"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tasks/{jobName}"),
+		semconv.HTTPRouteKey.String("/archive/{table}/{field}/{search}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJobFullInfoOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetArchiveOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4894,7 +5064,7 @@ func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -4928,15 +5098,15 @@ func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetJobFullInfoOperation,
-			ID:   "getJobFullInfo",
+			Name: GetArchiveOperation,
+			ID:   "getArchive",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetJobFullInfoOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, GetArchiveOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4954,7 +5124,7 @@ func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetJobFullInfoOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, GetArchiveOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4972,7 +5142,7 @@ func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetJobFullInfoOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetArchiveOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5016,7 +5186,7 @@ func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetJobFullInfoParams(args, argsEscaped, r)
+	params, err := decodeGetArchiveParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5029,28 +5199,56 @@ func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetJobFullInfoRes
+	var response GetArchiveRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetJobFullInfoOperation,
+			OperationName:    GetArchiveOperation,
 			OperationSummary: "",
-			OperationID:      "getJobFullInfo",
+			OperationID:      "getArchive",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "jobName",
+					Name: "table",
 					In:   "path",
-				}: params.JobName,
+				}: params.Table,
+				{
+					Name: "field",
+					In:   "path",
+				}: params.Field,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
+				{
+					Name: "mimetypeField",
+					In:   "query",
+				}: params.MimetypeField,
+				{
+					Name: "nameField",
+					In:   "query",
+				}: params.NameField,
+				{
+					Name: "namePattern",
+					In:   "query",
+				}: params.NamePattern,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "maxSize",
+					In:   "query",
+				}: params.MaxSize,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetJobFullInfoParams
-			Response = GetJobFullInfoRes
+			Params   = GetArchiveParams
+			Response = GetArchiveRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5059,14 +5257,14 @@ func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetJobFullInfoParams,
+			unpackGetArchiveParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetJobFullInfo(ctx, params)
+				response, err = s.h.GetArchive(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetJobFullInfo(ctx, params)
+		response, err = s.h.GetArchive(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5085,7 +5283,7 @@ func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetJobFullInfoResponse(response, w, span); err != nil {
+	if err := encodeGetArchiveResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5094,22 +5292,24 @@ func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetJobResultRequest handles getJobResult operation.
+// handleGetConfigRequest handles getConfig operation.
 //
-// Delete a specific job result.
+// Get current active configuration.
 //
-// GET /tasks/{jobName}/{jobId}
-func (s *Server) handleGetJobResultRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /config
+func (s *Server) handleGetConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJobResult"),
+		otelogen.OperationID("getConfig"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tasks/{jobName}/{jobId}"),
+		semconv.HTTPRouteKey.String("/config"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJobResultOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5130,7 +5330,7 @@ func (s *Server) handleGetJobResultRequest(args [2]string, argsEscaped bool, w h
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -5164,15 +5364,15 @@ func (s *Server) handleGetJobResultRequest(args [2]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetJobResultOperation,
-			ID:   "getJobResult",
+			Name: GetConfigOperation,
+			ID:   "getConfig",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetJobResultOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, GetConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5190,7 +5390,7 @@ func (s *Server) handleGetJobResultRequest(args [2]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetJobResultOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, GetConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5208,7 +5408,7 @@ func (s *Server) handleGetJobResultRequest(args [2]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetJobResultOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5252,45 +5452,26 @@ func (s *Server) handleGetJobResultRequest(args [2]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeGetJobResultParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response GetJobResultRes
+	var response GetConfigRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetJobResultOperation,
+			OperationName:    GetConfigOperation,
 			OperationSummary: "",
-			OperationID:      "getJobResult",
+			OperationID:      "getConfig",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "jobName",
-					In:   "path",
-				}: params.JobName,
-				{
-					Name: "jobId",
-					In:   "path",
-				}: params.JobId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetJobResultParams
-			Response = GetJobResultRes
+			Params   = struct{}
+			Response = GetConfigRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5299,14 +5480,14 @@ func (s *Server) handleGetJobResultRequest(args [2]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackGetJobResultParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetJobResult(ctx, params)
+				response, err = s.h.GetConfig(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetJobResult(ctx, params)
+		response, err = s.h.GetConfig(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5325,7 +5506,7 @@ func (s *Server) handleGetJobResultRequest(args [2]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeGetJobResultResponse(response, w, span); err != nil {
+	if err := encodeGetConfigResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5334,22 +5515,24 @@ func (s *Server) handleGetJobResultRequest(args [2]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetJobsRequest handles getJobs operation.
+// handleGetDatabasesRequest handles getDatabases operation.
 //
-// Retrieves a list of jobs known by the Interface.
+// Retrieves a list of databases known by server.
 //
-// GET /tasks
-func (s *Server) handleGetJobsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/database
+func (s *Server) handleGetDatabasesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJobs"),
+		otelogen.OperationID("getDatabases"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/tasks"),
+		semconv.HTTPRouteKey.String("/rest/database"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJobsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDatabasesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5370,7 +5553,7 @@ func (s *Server) handleGetJobsRequest(args [0]string, argsEscaped bool, w http.R
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -5404,15 +5587,15 @@ func (s *Server) handleGetJobsRequest(args [0]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetJobsOperation,
-			ID:   "getJobs",
+			Name: GetDatabasesOperation,
+			ID:   "getDatabases",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetJobsOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, GetDatabasesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5430,7 +5613,7 @@ func (s *Server) handleGetJobsRequest(args [0]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetJobsOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, GetDatabasesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5448,7 +5631,7 @@ func (s *Server) handleGetJobsRequest(args [0]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetJobsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetDatabasesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5492,45 +5675,26 @@ func (s *Server) handleGetJobsRequest(args [0]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	params, err := decodeGetJobsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response GetJobsRes
+	var response GetDatabasesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetJobsOperation,
+			OperationName:    GetDatabasesOperation,
 			OperationSummary: "",
-			OperationID:      "getJobs",
+			OperationID:      "getDatabases",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "start_time",
-					In:   "query",
-				}: params.StartTime,
-				{
-					Name: "end_time",
-					In:   "query",
-				}: params.EndTime,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetJobsParams
-			Response = GetJobsRes
+			Params   = struct{}
+			Response = GetDatabasesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5539,14 +5703,14 @@ func (s *Server) handleGetJobsRequest(args [0]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetJobsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetJobs(ctx, params)
+				response, err = s.h.GetDatabases(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetJobs(ctx, params)
+		response, err = s.h.GetDatabases(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5565,7 +5729,7 @@ func (s *Server) handleGetJobsRequest(args [0]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetJobsResponse(response, w, span); err != nil {
+	if err := encodeGetDatabasesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5574,22 +5738,24 @@ func (s *Server) handleGetJobsRequest(args [0]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetJobsConfigRequest handles getJobsConfig operation.
+// handleGetFieldsRequest handles getFields operation.
 //
-// Read job configuration section.
+// Retrieves all fields of an file.
 //
-// GET /config/jobs
-func (s *Server) handleGetJobsConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/tables/{table}/fields
+func (s *Server) handleGetFieldsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getJobsConfig"),
+		otelogen.OperationID("getFields"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/config/jobs"),
+		semconv.HTTPRouteKey.String("/rest/tables/{table}/fields"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJobsConfigOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetFieldsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5610,7 +5776,7 @@ func (s *Server) handleGetJobsConfigRequest(args [0]string, argsEscaped bool, w
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -5644,15 +5810,15 @@ func (s *Server) handleGetJobsConfigRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetJobsConfigOperation,
-			ID:   "getJobsConfig",
+			Name: GetFieldsOperation,
+			ID:   "getFields",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetJobsConfigOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, GetFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5670,7 +5836,7 @@ func (s *Server) handleGetJobsConfigRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetJobsConfigOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, GetFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5688,7 +5854,7 @@ func (s *Server) handleGetJobsConfigRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetJobsConfigOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5732,26 +5898,41 @@ func (s *Server) handleGetJobsConfigRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeGetFieldsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response GetJobsConfigRes
+	var response GetFieldsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetJobsConfigOperation,
+			OperationName:    GetFieldsOperation,
 			OperationSummary: "",
-			OperationID:      "getJobsConfig",
+			OperationID:      "getFields",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetJobsConfigRes
+			Params   = GetFieldsParams
+			Response = GetFieldsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5760,14 +5941,14 @@ func (s *Server) handleGetJobsConfigRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetFieldsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetJobsConfig(ctx)
+				response, err = s.h.GetFields(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetJobsConfig(ctx)
+		response, err = s.h.GetFields(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5786,7 +5967,7 @@ func (s *Server) handleGetJobsConfigRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetJobsConfigResponse(response, w, span); err != nil {
+	if err := encodeGetFieldsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5795,22 +5976,24 @@ func (s *Server) handleGetJobsConfigRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetLobByMapRequest handles getLobByMap operation.
+// handleGetImageRequest handles getImage operation.
 //
-// Retrieves a lob of a specific table record of an field in a Map.
+// Retrieves a field of a specific table record of a Map definition.
 //
-// GET /binary/{table}/{field}/{search}
-func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /image/{table}/{field}/{search}
+func (s *Server) handleGetImageRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getLobByMap"),
+		otelogen.OperationID("getImage"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/binary/{table}/{field}/{search}"),
+		semconv.HTTPRouteKey.String("/image/{table}/{field}/{search}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetLobByMapOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetImageOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5831,7 +6014,7 @@ func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w ht
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -5865,15 +6048,15 @@ func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetLobByMapOperation,
-			ID:   "getLobByMap",
+			Name: GetImageOperation,
+			ID:   "getImage",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetLobByMapOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, GetImageOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5891,7 +6074,7 @@ func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetLobByMapOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, GetImageOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5909,7 +6092,7 @@ func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetLobByMapOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetImageOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5953,7 +6136,7 @@ func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeGetLobByMapParams(args, argsEscaped, r)
+	params, err := decodeGetImageParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5966,48 +6149,72 @@ func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w ht
 
 	var rawBody []byte
 
-	var response GetLobByMapRes
+	var response GetImageRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetLobByMapOperation,
+			OperationName:    GetImageOperation,
 			OperationSummary: "",
-			OperationID:      "getLobByMap",
+			OperationID:      "getImage",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "mimetypeField",
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "reference",
 					In:   "query",
-				}: params.MimetypeField,
+				}: params.Reference,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
 				{
 					Name: "mimetype",
 					In:   "query",
 				}: params.Mimetype,
+				{
+					Name: "mimetypeField",
+					In:   "query",
+				}: params.MimetypeField,
+				{
+					Name: "field",
+					In:   "path",
+				}: params.Field,
 				{
 					Name: "sqlsearch",
 					In:   "query",
 				}: params.Sqlsearch,
 				{
-					Name: "table",
-					In:   "path",
-				}: params.Table,
+					Name: "width",
+					In:   "query",
+				}: params.Width,
 				{
-					Name: "search",
-					In:   "path",
-				}: params.Search,
+					Name: "height",
+					In:   "query",
+				}: params.Height,
 				{
-					Name: "field",
-					In:   "path",
-				}: params.Field,
+					Name: "fit",
+					In:   "query",
+				}: params.Fit,
+				{
+					Name: "quality",
+					In:   "query",
+				}: params.Quality,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetLobByMapParams
-			Response = GetLobByMapRes
+			Params   = GetImageParams
+			Response = GetImageRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6016,14 +6223,14 @@ func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetLobByMapParams,
+			unpackGetImageParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetLobByMap(ctx, params)
+				response, err = s.h.GetImage(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetLobByMap(ctx, params)
+		response, err = s.h.GetImage(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6042,7 +6249,7 @@ func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetLobByMapResponse(response, w, span); err != nil {
+	if err := encodeGetImageResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6051,22 +6258,24 @@ func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetLoginSessionRequest handles getLoginSession operation.
+// handleGetImageCacheStatsRequest handles getImageCacheStats operation.
 //
-// Login using baseauth or bearer to receive or validate token.
+// Get hit and miss statistics of the derived image cache.
 //
-// GET /login
-func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /config/images/cache
+func (s *Server) handleGetImageCacheStatsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getLoginSession"),
+		otelogen.OperationID("getImageCacheStats"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/login"),
+		semconv.HTTPRouteKey.String("/config/images/cache"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetLoginSessionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetImageCacheStatsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6087,7 +6296,7 @@ func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool,
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -6121,15 +6330,15 @@ func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetLoginSessionOperation,
-			ID:   "getLoginSession",
+			Name: GetImageCacheStatsOperation,
+			ID:   "getImageCacheStats",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetLoginSessionOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, GetImageCacheStatsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6147,7 +6356,7 @@ func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetLoginSessionOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, GetImageCacheStatsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6164,15 +6373,34 @@ func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool,
 				ctx = sctx
 			}
 		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetImageCacheStatsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
 						continue nextRequirement
 					}
 				}
@@ -6193,13 +6421,13 @@ func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response GetLoginSessionRes
+	var response GetImageCacheStatsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetLoginSessionOperation,
+			OperationName:    GetImageCacheStatsOperation,
 			OperationSummary: "",
-			OperationID:      "getLoginSession",
+			OperationID:      "getImageCacheStats",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -6209,7 +6437,7 @@ func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool,
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = GetLoginSessionRes
+			Response = GetImageCacheStatsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6220,12 +6448,12 @@ func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool,
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetLoginSession(ctx)
+				response, err = s.h.GetImageCacheStats(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetLoginSession(ctx)
+		response, err = s.h.GetImageCacheStats(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6244,7 +6472,7 @@ func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetLoginSessionResponse(response, w, span); err != nil {
+	if err := encodeGetImageCacheStatsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6253,22 +6481,24 @@ func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleGetMapMetadataRequest handles getMapMetadata operation.
+// handleGetImageDuplicatesRequest handles getImageDuplicates operation.
 //
-// Retrieves metadata of a Map definition.
+// Retrieves groups of records with near-duplicate images out of the stored perceptual hashes.
 //
-// GET /rest/metadata/view/{table}
-func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /hash/{table}/{hashField}/duplicates
+func (s *Server) handleGetImageDuplicatesRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMapMetadata"),
+		otelogen.OperationID("getImageDuplicates"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/metadata/view/{table}"),
+		semconv.HTTPRouteKey.String("/hash/{table}/{hashField}/duplicates"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMapMetadataOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetImageDuplicatesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6289,7 +6519,7 @@ func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -6323,15 +6553,15 @@ func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetMapMetadataOperation,
-			ID:   "getMapMetadata",
+			Name: GetImageDuplicatesOperation,
+			ID:   "getImageDuplicates",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetMapMetadataOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, GetImageDuplicatesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6349,7 +6579,7 @@ func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetMapMetadataOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, GetImageDuplicatesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6367,7 +6597,7 @@ func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetMapMetadataOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetImageDuplicatesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6411,7 +6641,7 @@ func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetMapMetadataParams(args, argsEscaped, r)
+	params, err := decodeGetImageDuplicatesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6424,13 +6654,13 @@ func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetMapMetadataRes
+	var response GetImageDuplicatesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetMapMetadataOperation,
+			OperationName:    GetImageDuplicatesOperation,
 			OperationSummary: "",
-			OperationID:      "getMapMetadata",
+			OperationID:      "getImageDuplicates",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -6438,14 +6668,30 @@ func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w
 					Name: "table",
 					In:   "path",
 				}: params.Table,
+				{
+					Name: "hashField",
+					In:   "path",
+				}: params.HashField,
+				{
+					Name: "distance",
+					In:   "query",
+				}: params.Distance,
+				{
+					Name: "search",
+					In:   "query",
+				}: params.Search,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetMapMetadataParams
-			Response = GetMapMetadataRes
+			Params   = GetImageDuplicatesParams
+			Response = GetImageDuplicatesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6454,14 +6700,14 @@ func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetMapMetadataParams,
+			unpackGetImageDuplicatesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetMapMetadata(ctx, params)
+				response, err = s.h.GetImageDuplicates(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetMapMetadata(ctx, params)
+		response, err = s.h.GetImageDuplicates(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6480,7 +6726,7 @@ func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetMapMetadataResponse(response, w, span); err != nil {
+	if err := encodeGetImageDuplicatesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6489,22 +6735,24 @@ func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetMapRecordsFieldsRequest handles getMapRecordsFields operation.
+// handleGetImageHashRequest handles getImageHash operation.
 //
-// Retrieves a field of a specific table record of a Map definition.
+// Calculates the perceptual hash of an image field of a specific table record.
 //
-// GET /rest/view/{table}/{fields}/{search}
-func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /image/{table}/{field}/{search}/hash
+func (s *Server) handleGetImageHashRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMapRecordsFields"),
+		otelogen.OperationID("getImageHash"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/view/{table}/{fields}/{search}"),
+		semconv.HTTPRouteKey.String("/image/{table}/{field}/{search}/hash"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMapRecordsFieldsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetImageHashOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6525,7 +6773,7 @@ func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bo
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -6559,15 +6807,15 @@ func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetMapRecordsFieldsOperation,
-			ID:   "getMapRecordsFields",
+			Name: GetImageHashOperation,
+			ID:   "getImageHash",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetMapRecordsFieldsOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, GetImageHashOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6585,7 +6833,7 @@ func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetMapRecordsFieldsOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, GetImageHashOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6603,7 +6851,7 @@ func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetMapRecordsFieldsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetImageHashOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6647,7 +6895,7 @@ func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetMapRecordsFieldsParams(args, argsEscaped, r)
+	params, err := decodeGetImageHashParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6660,13 +6908,13 @@ func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response GetMapRecordsFieldsRes
+	var response GetImageHashRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetMapRecordsFieldsOperation,
+			OperationName:    GetImageHashOperation,
 			OperationSummary: "",
-			OperationID:      "getMapRecordsFields",
+			OperationID:      "getImageHash",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -6675,57 +6923,29 @@ func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bo
 					In:   "path",
 				}: params.Table,
 				{
-					Name: "search",
+					Name: "field",
 					In:   "path",
-				}: params.Search,
+				}: params.Field,
 				{
-					Name: "fields",
+					Name: "search",
 					In:   "path",
-				}: params.Fields,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "sorted_by",
-					In:   "query",
-				}: params.SortedBy,
-				{
-					Name: "sqlsearch",
-					In:   "query",
-				}: params.Sqlsearch,
-				{
-					Name: "compact",
-					In:   "query",
-				}: params.Compact,
-				{
-					Name: "flatten",
-					In:   "query",
-				}: params.Flatten,
-				{
-					Name: "descriptor",
-					In:   "query",
-				}: params.Descriptor,
+				}: params.Search,
 				{
-					Name: "orderby",
+					Name: "mimetypeField",
 					In:   "query",
-				}: params.Orderby,
+				}: params.MimetypeField,
 				{
-					Name: "xmlnotation",
+					Name: "algorithm",
 					In:   "query",
-				}: params.Xmlnotation,
+				}: params.Algorithm,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetMapRecordsFieldsParams
-			Response = GetMapRecordsFieldsRes
+			Params   = GetImageHashParams
+			Response = GetImageHashRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6734,14 +6954,14 @@ func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetMapRecordsFieldsParams,
+			unpackGetImageHashParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetMapRecordsFields(ctx, params)
+				response, err = s.h.GetImageHash(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetMapRecordsFields(ctx, params)
+		response, err = s.h.GetImageHash(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6760,7 +6980,7 @@ func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetMapRecordsFieldsResponse(response, w, span); err != nil {
+	if err := encodeGetImageHashResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6769,22 +6989,25 @@ func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bo
 	}
 }
 
-// handleGetMapsRequest handles getMaps operation.
+// handleGetImageMetadataRequest handles getImageMetadata operation.
 //
-// Retrieves a list of available views.
+// Retrieves the EXIF tags, dimensions and format of an image field of a specific table record without
+// the image data.
 //
-// GET /rest/view
-func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /image/{table}/{field}/{search}/metadata
+func (s *Server) handleGetImageMetadataRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMaps"),
+		otelogen.OperationID("getImageMetadata"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/view"),
+		semconv.HTTPRouteKey.String("/image/{table}/{field}/{search}/metadata"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMapsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetImageMetadataOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6805,7 +7028,7 @@ func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.R
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -6839,15 +7062,15 @@ func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetMapsOperation,
-			ID:   "getMaps",
+			Name: GetImageMetadataOperation,
+			ID:   "getImageMetadata",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetMapsOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, GetImageMetadataOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6865,7 +7088,7 @@ func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetMapsOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, GetImageMetadataOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6883,7 +7106,7 @@ func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetMapsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetImageMetadataOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6927,26 +7150,53 @@ func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.R
 			return
 		}
 	}
+	params, err := decodeGetImageMetadataParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response GetMapsRes
+	var response GetImageMetadataRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetMapsOperation,
+			OperationName:    GetImageMetadataOperation,
 			OperationSummary: "",
-			OperationID:      "getMaps",
+			OperationID:      "getImageMetadata",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "field",
+					In:   "path",
+				}: params.Field,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
+				{
+					Name: "mimetypeField",
+					In:   "query",
+				}: params.MimetypeField,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetMapsRes
+			Params   = GetImageMetadataParams
+			Response = GetImageMetadataRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6955,14 +7205,14 @@ func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetImageMetadataParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetMaps(ctx)
+				response, err = s.h.GetImageMetadata(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetMaps(ctx)
+		response, err = s.h.GetImageMetadata(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6981,7 +7231,7 @@ func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetMapsResponse(response, w, span); err != nil {
+	if err := encodeGetImageMetadataResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6990,22 +7240,24 @@ func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetUserInfoRequest handles getUserInfo operation.
+// handleGetJobExecutionResultRequest handles getJobExecutionResult operation.
 //
-// Get the token user information.
+// Retrieves a specific job result.
 //
-// GET /rest/user
-func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /tasks/results
+func (s *Server) handleGetJobExecutionResultRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserInfo"),
+		otelogen.OperationID("getJobExecutionResult"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/user"),
+		semconv.HTTPRouteKey.String("/tasks/results"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserInfoOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJobExecutionResultOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7026,7 +7278,7 @@ func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w ht
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -7058,44 +7310,4357 @@ func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w ht
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetJobExecutionResultOperation,
+			ID:   "getJobExecutionResult",
+		}
 	)
-
-	var rawBody []byte
-
-	var response GetUserInfoRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    GetUserInfoOperation,
-			OperationSummary: "",
-			OperationID:      "getUserInfo",
-			Body:             nil,
-			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetJobExecutionResultOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
 		}
-
-		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = GetUserInfoRes
-		)
-		response, err = middleware.HookMiddleware[
-			Request,
-			Params,
-			Response,
-		](
-			m,
-			mreq,
-			nil,
-			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserInfo(ctx)
-				return response, err
-			},
-		)
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetJobExecutionResultOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetJobExecutionResultOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetJobExecutionResultParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetJobExecutionResultRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetJobExecutionResultOperation,
+			OperationSummary: "",
+			OperationID:      "getJobExecutionResult",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetJobExecutionResultParams
+			Response = GetJobExecutionResultRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetJobExecutionResultParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetJobExecutionResult(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetJobExecutionResult(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetJobExecutionResultResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetJobFullInfoRequest handles getJobFullInfo operation.
+//
+// Retrieves a full job definition.
+//
+// GET /tasks/{jobName}
+func (s *Server) handleGetJobFullInfoRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getJobFullInfo"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tasks/{jobName}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJobFullInfoOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetJobFullInfoOperation,
+			ID:   "getJobFullInfo",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetJobFullInfoOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetJobFullInfoOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetJobFullInfoOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetJobFullInfoParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetJobFullInfoRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetJobFullInfoOperation,
+			OperationSummary: "",
+			OperationID:      "getJobFullInfo",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "jobName",
+					In:   "path",
+				}: params.JobName,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetJobFullInfoParams
+			Response = GetJobFullInfoRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetJobFullInfoParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetJobFullInfo(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetJobFullInfo(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetJobFullInfoResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetJobResultRequest handles getJobResult operation.
+//
+// Delete a specific job result.
+//
+// GET /tasks/{jobName}/{jobId}
+func (s *Server) handleGetJobResultRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getJobResult"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tasks/{jobName}/{jobId}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJobResultOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetJobResultOperation,
+			ID:   "getJobResult",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetJobResultOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetJobResultOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetJobResultOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetJobResultParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetJobResultRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetJobResultOperation,
+			OperationSummary: "",
+			OperationID:      "getJobResult",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "jobName",
+					In:   "path",
+				}: params.JobName,
+				{
+					Name: "jobId",
+					In:   "path",
+				}: params.JobId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetJobResultParams
+			Response = GetJobResultRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetJobResultParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetJobResult(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetJobResult(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetJobResultResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetJobsRequest handles getJobs operation.
+//
+// Retrieves a list of jobs known by the Interface.
+//
+// GET /tasks
+func (s *Server) handleGetJobsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getJobs"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/tasks"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJobsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetJobsOperation,
+			ID:   "getJobs",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetJobsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetJobsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetJobsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetJobsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetJobsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetJobsOperation,
+			OperationSummary: "",
+			OperationID:      "getJobs",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "start_time",
+					In:   "query",
+				}: params.StartTime,
+				{
+					Name: "end_time",
+					In:   "query",
+				}: params.EndTime,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetJobsParams
+			Response = GetJobsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetJobsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetJobs(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetJobs(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetJobsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetJobsConfigRequest handles getJobsConfig operation.
+//
+// Read job configuration section.
+//
+// GET /config/jobs
+func (s *Server) handleGetJobsConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getJobsConfig"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/config/jobs"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJobsConfigOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetJobsConfigOperation,
+			ID:   "getJobsConfig",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetJobsConfigOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetJobsConfigOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetJobsConfigOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response GetJobsConfigRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetJobsConfigOperation,
+			OperationSummary: "",
+			OperationID:      "getJobsConfig",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetJobsConfigRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetJobsConfig(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetJobsConfig(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetJobsConfigResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetLobByMapRequest handles getLobByMap operation.
+//
+// Retrieves a lob of a specific table record of an field in a Map.
+//
+// GET /binary/{table}/{field}/{search}
+func (s *Server) handleGetLobByMapRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLobByMap"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/binary/{table}/{field}/{search}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetLobByMapOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetLobByMapOperation,
+			ID:   "getLobByMap",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetLobByMapOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetLobByMapOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetLobByMapOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetLobByMapParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetLobByMapRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetLobByMapOperation,
+			OperationSummary: "",
+			OperationID:      "getLobByMap",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "mimetypeField",
+					In:   "query",
+				}: params.MimetypeField,
+				{
+					Name: "mimetype",
+					In:   "query",
+				}: params.Mimetype,
+				{
+					Name: "sqlsearch",
+					In:   "query",
+				}: params.Sqlsearch,
+				{
+					Name: "Range",
+					In:   "header",
+				}: params.Range,
+				{
+					Name: "If-Range",
+					In:   "header",
+				}: params.IfRange,
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
+				{
+					Name: "field",
+					In:   "path",
+				}: params.Field,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetLobByMapParams
+			Response = GetLobByMapRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetLobByMapParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetLobByMap(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetLobByMap(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetLobByMapResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetLoginSessionRequest handles getLoginSession operation.
+//
+// Login using baseauth or bearer to receive or validate token.
+//
+// GET /login
+func (s *Server) handleGetLoginSessionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLoginSession"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/login"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetLoginSessionOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetLoginSessionOperation,
+			ID:   "getLoginSession",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetLoginSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetLoginSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response GetLoginSessionRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetLoginSessionOperation,
+			OperationSummary: "",
+			OperationID:      "getLoginSession",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetLoginSessionRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetLoginSession(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetLoginSession(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetLoginSessionResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMapMetadataRequest handles getMapMetadata operation.
+//
+// Retrieves metadata of a Map definition.
+//
+// GET /rest/metadata/view/{table}
+func (s *Server) handleGetMapMetadataRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMapMetadata"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/rest/metadata/view/{table}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMapMetadataOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMapMetadataOperation,
+			ID:   "getMapMetadata",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetMapMetadataOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetMapMetadataOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetMapMetadataOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetMapMetadataParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetMapMetadataRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMapMetadataOperation,
+			OperationSummary: "",
+			OperationID:      "getMapMetadata",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetMapMetadataParams
+			Response = GetMapMetadataRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetMapMetadataParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMapMetadata(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMapMetadata(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetMapMetadataResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMapRecordsFieldsRequest handles getMapRecordsFields operation.
+//
+// Retrieves a field of a specific table record of a Map definition.
+//
+// GET /rest/view/{table}/{fields}/{search}
+func (s *Server) handleGetMapRecordsFieldsRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMapRecordsFields"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/rest/view/{table}/{fields}/{search}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMapRecordsFieldsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMapRecordsFieldsOperation,
+			ID:   "getMapRecordsFields",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetMapRecordsFieldsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetMapRecordsFieldsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetMapRecordsFieldsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetMapRecordsFieldsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetMapRecordsFieldsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMapRecordsFieldsOperation,
+			OperationSummary: "",
+			OperationID:      "getMapRecordsFields",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
+				{
+					Name: "fields",
+					In:   "path",
+				}: params.Fields,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "sorted_by",
+					In:   "query",
+				}: params.SortedBy,
+				{
+					Name: "sqlsearch",
+					In:   "query",
+				}: params.Sqlsearch,
+				{
+					Name: "compact",
+					In:   "query",
+				}: params.Compact,
+				{
+					Name: "flatten",
+					In:   "query",
+				}: params.Flatten,
+				{
+					Name: "descriptor",
+					In:   "query",
+				}: params.Descriptor,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "count",
+					In:   "query",
+				}: params.Count,
+				{
+					Name: "orderby",
+					In:   "query",
+				}: params.Orderby,
+				{
+					Name: "xmlnotation",
+					In:   "query",
+				}: params.Xmlnotation,
+				{
+					Name: "delimiter",
+					In:   "query",
+				}: params.Delimiter,
+				{
+					Name: "quote",
+					In:   "query",
+				}: params.Quote,
+				{
+					Name: "header",
+					In:   "query",
+				}: params.Header,
+				{
+					Name: "null",
+					In:   "query",
+				}: params.Null,
+				{
+					Name: "dateformat",
+					In:   "query",
+				}: params.Dateformat,
+				{
+					Name: "bom",
+					In:   "query",
+				}: params.Bom,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetMapRecordsFieldsParams
+			Response = GetMapRecordsFieldsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetMapRecordsFieldsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMapRecordsFields(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMapRecordsFields(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetMapRecordsFieldsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMapsRequest handles getMaps operation.
+//
+// Retrieves a list of available views.
+//
+// GET /rest/view
+func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMaps"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/rest/view"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMapsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMapsOperation,
+			ID:   "getMaps",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetMapsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetMapsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetMapsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response GetMapsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMapsOperation,
+			OperationSummary: "",
+			OperationID:      "getMaps",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetMapsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMaps(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMaps(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetMapsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetRecordByKeyRequest handles getRecordByKey operation.
+//
+// Read the record with the given primary key.
+//
+// GET /rest/view/{table}/pk/{key}
+func (s *Server) handleGetRecordByKeyRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getRecordByKey"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/rest/view/{table}/pk/{key}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecordByKeyOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetRecordByKeyOperation,
+			ID:   "getRecordByKey",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetRecordByKeyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetRecordByKeyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetRecordByKeyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetRecordByKeyParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetRecordByKeyRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetRecordByKeyOperation,
+			OperationSummary: "",
+			OperationID:      "getRecordByKey",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "key",
+					In:   "path",
+				}: params.Key,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetRecordByKeyParams
+			Response = GetRecordByKeyRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetRecordByKeyParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetRecordByKey(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetRecordByKey(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetRecordByKeyResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetRecordHistoryRequest handles getRecordHistory operation.
+//
+// Read the change history of the record with the given primary key.
+//
+// GET /rest/history/{table}/{key}
+func (s *Server) handleGetRecordHistoryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getRecordHistory"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/rest/history/{table}/{key}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecordHistoryOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetRecordHistoryOperation,
+			ID:   "getRecordHistory",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetRecordHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetRecordHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetRecordHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetRecordHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetRecordHistoryRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetRecordHistoryOperation,
+			OperationSummary: "",
+			OperationID:      "getRecordHistory",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "key",
+					In:   "path",
+				}: params.Key,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetRecordHistoryParams
+			Response = GetRecordHistoryRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetRecordHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetRecordHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetRecordHistory(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetRecordHistoryResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUploadRequest handles getUpload operation.
+//
+// Retrieves the offset and state of a resumable upload.
+//
+// GET /rest/uploads/{uploadId}
+func (s *Server) handleGetUploadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUpload"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/rest/uploads/{uploadId}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUploadOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUploadOperation,
+			ID:   "getUpload",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetUploadOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetUploadOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetUploadOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetUploadParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetUploadRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUploadOperation,
+			OperationSummary: "",
+			OperationID:      "getUpload",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "uploadId",
+					In:   "path",
+				}: params.UploadId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUploadParams
+			Response = GetUploadRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUploadParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUpload(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUpload(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetUploadResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserInfoRequest handles getUserInfo operation.
+//
+// Get the token user information.
+//
+// GET /rest/user
+func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserInfo"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/rest/user"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserInfoOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var rawBody []byte
+
+	var response GetUserInfoRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserInfoOperation,
+			OperationSummary: "",
+			OperationID:      "getUserInfo",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetUserInfoRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserInfo(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserInfo(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetUserInfoResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetVersionRequest handles getVersion operation.
+//
+// Get the current server version.
+//
+// GET /version
+func (s *Server) handleGetVersionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getVersion"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/version"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVersionOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var rawBody []byte
+
+	var response GetVersionRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetVersionOperation,
+			OperationSummary: "",
+			OperationID:      "getVersion",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetVersionRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetVersion(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetVersion(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetVersionResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetVideoRequest handles getVideo operation.
+//
+// Retrieves a video stream of a specific table record of a Map definition.
+//
+// GET /video/{table}/{field}/{search}
+func (s *Server) handleGetVideoRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getVideo"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/video/{table}/{field}/{search}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVideoOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetVideoOperation,
+			ID:   "getVideo",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetVideoOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetVideoOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetVideoOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetVideoParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetVideoRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetVideoOperation,
+			OperationSummary: "",
+			OperationID:      "getVideo",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
+				{
+					Name: "reference",
+					In:   "query",
+				}: params.Reference,
+				{
+					Name: "field",
+					In:   "path",
+				}: params.Field,
+				{
+					Name: "mimetypeField",
+					In:   "query",
+				}: params.MimetypeField,
+				{
+					Name: "mimetype",
+					In:   "query",
+				}: params.Mimetype,
+				{
+					Name: "sqlsearch",
+					In:   "query",
+				}: params.Sqlsearch,
+				{
+					Name: "Range",
+					In:   "header",
+				}: params.Range,
+				{
+					Name: "If-Range",
+					In:   "header",
+				}: params.IfRange,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetVideoParams
+			Response = GetVideoRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetVideoParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetVideo(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetVideo(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetVideoResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetViewsRequest handles getViews operation.
+//
+// Get the available table views of all databases.
+//
+// GET /config/views
+func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getViews"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/config/views"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetViewsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetViewsOperation,
+			ID:   "getViews",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, GetViewsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, GetViewsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetViewsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response GetViewsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetViewsOperation,
+			OperationSummary: "",
+			OperationID:      "getViews",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetViewsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetViews(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetViews(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetViewsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleHeadMapRecordsFieldsRequest handles headMapRecordsFields operation.
+//
+// Return the total number of records matching the search in the X-Total-Count header.
+//
+// HEAD /rest/view/{table}/{fields}/{search}
+func (s *Server) handleHeadMapRecordsFieldsRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("headMapRecordsFields"),
+		semconv.HTTPRequestMethodKey.String("HEAD"),
+		semconv.HTTPRouteKey.String("/rest/view/{table}/{fields}/{search}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), HeadMapRecordsFieldsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: HeadMapRecordsFieldsOperation,
+			ID:   "headMapRecordsFields",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, HeadMapRecordsFieldsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, HeadMapRecordsFieldsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, HeadMapRecordsFieldsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeHeadMapRecordsFieldsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response HeadMapRecordsFieldsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    HeadMapRecordsFieldsOperation,
+			OperationSummary: "",
+			OperationID:      "headMapRecordsFields",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
+				{
+					Name: "fields",
+					In:   "path",
+				}: params.Fields,
+				{
+					Name: "descriptor",
+					In:   "query",
+				}: params.Descriptor,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = HeadMapRecordsFieldsParams
+			Response = HeadMapRecordsFieldsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackHeadMapRecordsFieldsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.HeadMapRecordsFields(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.HeadMapRecordsFields(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeHeadMapRecordsFieldsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleImportRecordsRequest handles importRecords operation.
+//
+// Import a CSV or NDJSON stream of records into a table.
+//
+// POST /rest/import/{table}
+func (s *Server) handleImportRecordsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("importRecords"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/rest/import/{table}"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ImportRecordsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ImportRecordsOperation,
+			ID:   "importRecords",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, ImportRecordsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, ImportRecordsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ImportRecordsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeImportRecordsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeImportRecordsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ImportRecordsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ImportRecordsOperation,
+			OperationSummary: "",
+			OperationID:      "importRecords",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "batchSize",
+					In:   "query",
+				}: params.BatchSize,
+				{
+					Name: "onError",
+					In:   "query",
+				}: params.OnError,
+				{
+					Name: "delimiter",
+					In:   "query",
+				}: params.Delimiter,
+				{
+					Name: "header",
+					In:   "query",
+				}: params.Header,
+				{
+					Name: "null",
+					In:   "query",
+				}: params.Null,
+				{
+					Name: "dateformat",
+					In:   "query",
+				}: params.Dateformat,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = ImportRecordsReq
+			Params   = ImportRecordsParams
+			Response = ImportRecordsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackImportRecordsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ImportRecords(ctx, request, params)
+				return response, err
+			},
+		)
 	} else {
-		response, err = s.h.GetUserInfo(ctx)
+		response, err = s.h.ImportRecords(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7114,7 +11679,7 @@ func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetUserInfoResponse(response, w, span); err != nil {
+	if err := encodeImportRecordsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7123,22 +11688,262 @@ func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetVersionRequest handles getVersion operation.
+// handleInsertMapFileRecordsRequest handles insertMapFileRecords operation.
 //
-// Get the current server version.
+// Store send records into Map definition.
 //
-// GET /version
-func (s *Server) handleGetVersionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /rest/view
+func (s *Server) handleInsertMapFileRecordsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("insertMapFileRecords"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/rest/view"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), InsertMapFileRecordsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(attrs...)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: InsertMapFileRecordsOperation,
+			ID:   "insertMapFileRecords",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, InsertMapFileRecordsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, InsertMapFileRecordsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, InsertMapFileRecordsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeInsertMapFileRecordsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response InsertMapFileRecordsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    InsertMapFileRecordsOperation,
+			OperationSummary: "",
+			OperationID:      "insertMapFileRecords",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = OptInsertMapFileRecordsReq
+			Params   = struct{}
+			Response = InsertMapFileRecordsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.InsertMapFileRecords(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.InsertMapFileRecords(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeInsertMapFileRecordsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleInsertRecordRequest handles insertRecord operation.
+//
+// Insert given record.
+//
+// POST /rest/view/{table}
+func (s *Server) handleInsertRecordRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getVersion"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/version"),
+		otelogen.OperationID("insertRecord"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/rest/view/{table}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVersionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), InsertRecordOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7159,7 +11964,7 @@ func (s *Server) handleGetVersionRequest(args [0]string, argsEscaped bool, w htt
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -7191,28 +11996,162 @@ func (s *Server) handleGetVersionRequest(args [0]string, argsEscaped bool, w htt
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: InsertRecordOperation,
+			ID:   "insertRecord",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBasicAuth(ctx, InsertRecordOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BasicAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BasicAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTokenCheck(ctx, InsertRecordOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TokenCheck",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:TokenCheck", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, InsertRecordOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeInsertRecordParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeInsertRecordRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetVersionRes
+	var response InsertRecordRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetVersionOperation,
+			OperationName:    InsertRecordOperation,
 			OperationSummary: "",
-			OperationID:      "getVersion",
-			Body:             nil,
+			OperationID:      "insertRecord",
+			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "returning",
+					In:   "query",
+				}: params.Returning,
+				{
+					Name: "onConflict",
+					In:   "query",
+				}: params.OnConflict,
+				{
+					Name: "conflictFields",
+					In:   "query",
+				}: params.ConflictFields,
+				{
+					Name: "updateFields",
+					In:   "query",
+				}: params.UpdateFields,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = GetVersionRes
+			Request  = OptInsertRecordReq
+			Params   = InsertRecordParams
+			Response = InsertRecordRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7221,14 +12160,14 @@ func (s *Server) handleGetVersionRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackInsertRecordParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetVersion(ctx)
+				response, err = s.h.InsertRecord(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetVersion(ctx)
+		response, err = s.h.InsertRecord(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7247,7 +12186,7 @@ func (s *Server) handleGetVersionRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetVersionResponse(response, w, span); err != nil {
+	if err := encodeInsertRecordResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7256,22 +12195,24 @@ func (s *Server) handleGetVersionRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetVideoRequest handles getVideo operation.
+// handleListModellingRequest handles listModelling operation.
 //
-// Retrieves a video stream of a specific table record of a Map definition.
+// Retrieves all tables, views or data representation objects.
 //
-// GET /video/{table}/{field}/{search}
-func (s *Server) handleGetVideoRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/map
+func (s *Server) handleListModellingRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getVideo"),
+		otelogen.OperationID("listModelling"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/video/{table}/{field}/{search}"),
+		semconv.HTTPRouteKey.String("/rest/map"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVideoOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListModellingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7292,7 +12233,7 @@ func (s *Server) handleGetVideoRequest(args [3]string, argsEscaped bool, w http.
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -7326,15 +12267,15 @@ func (s *Server) handleGetVideoRequest(args [3]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetVideoOperation,
-			ID:   "getVideo",
+			Name: ListModellingOperation,
+			ID:   "listModelling",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetVideoOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, ListModellingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7352,7 +12293,7 @@ func (s *Server) handleGetVideoRequest(args [3]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetVideoOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, ListModellingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7370,7 +12311,7 @@ func (s *Server) handleGetVideoRequest(args [3]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetVideoOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ListModellingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7401,78 +12342,39 @@ func (s *Server) handleGetVideoRequest(args [3]string, argsEscaped bool, w http.
 					}
 				}
 				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-				defer recordError("Security", err)
-			}
-			return
-		}
-	}
-	params, err := decodeGetVideoParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-
-	var rawBody []byte
-
-	var response GetVideoRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    GetVideoOperation,
-			OperationSummary: "",
-			OperationID:      "getVideo",
-			Body:             nil,
-			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "table",
-					In:   "path",
-				}: params.Table,
-				{
-					Name: "search",
-					In:   "path",
-				}: params.Search,
-				{
-					Name: "reference",
-					In:   "query",
-				}: params.Reference,
-				{
-					Name: "field",
-					In:   "path",
-				}: params.Field,
-				{
-					Name: "mimetypeField",
-					In:   "query",
-				}: params.MimetypeField,
-				{
-					Name: "mimetype",
-					In:   "query",
-				}: params.Mimetype,
-				{
-					Name: "sqlsearch",
-					In:   "query",
-				}: params.Sqlsearch,
-			},
-			Raw: r,
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response ListModellingRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListModellingOperation,
+			OperationSummary: "",
+			OperationID:      "listModelling",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetVideoParams
-			Response = GetVideoRes
+			Params   = struct{}
+			Response = ListModellingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7481,14 +12383,14 @@ func (s *Server) handleGetVideoRequest(args [3]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetVideoParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetVideo(ctx, params)
+				response, err = s.h.ListModelling(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetVideo(ctx, params)
+		response, err = s.h.ListModelling(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7507,7 +12409,7 @@ func (s *Server) handleGetVideoRequest(args [3]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetVideoResponse(response, w, span); err != nil {
+	if err := encodeListModellingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7516,22 +12418,24 @@ func (s *Server) handleGetVideoRequest(args [3]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetViewsRequest handles getViews operation.
+// handleListTablesRequest handles listTables operation.
 //
-// Get the available table views of all databases.
+// Retrieves all tables of databases.
 //
-// GET /config/views
-func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/tables
+func (s *Server) handleListTablesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getViews"),
+		otelogen.OperationID("listTables"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/config/views"),
+		semconv.HTTPRouteKey.String("/rest/tables"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetViewsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTablesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7552,7 +12456,7 @@ func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -7586,15 +12490,15 @@ func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetViewsOperation,
-			ID:   "getViews",
+			Name: ListTablesOperation,
+			ID:   "listTables",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, GetViewsOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, ListTablesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7612,7 +12516,7 @@ func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, GetViewsOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, ListTablesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7630,7 +12534,7 @@ func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetViewsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ListTablesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7677,13 +12581,13 @@ func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.
 
 	var rawBody []byte
 
-	var response GetViewsRes
+	var response ListTablesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetViewsOperation,
+			OperationName:    ListTablesOperation,
 			OperationSummary: "",
-			OperationID:      "getViews",
+			OperationID:      "listTables",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -7693,7 +12597,7 @@ func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = GetViewsRes
+			Response = ListTablesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7704,12 +12608,12 @@ func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetViews(ctx)
+				response, err = s.h.ListTables(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetViews(ctx)
+		response, err = s.h.ListTables(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7728,7 +12632,7 @@ func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetViewsResponse(response, w, span); err != nil {
+	if err := encodeListTablesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7737,22 +12641,24 @@ func (s *Server) handleGetViewsRequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
-// handleInsertMapFileRecordsRequest handles insertMapFileRecords operation.
+// handleLoginSessionRequest handles loginSession operation.
 //
-// Store send records into Map definition.
+// Login using baseauth or bearer to receive or validate token.
 //
-// POST /rest/view
-func (s *Server) handleInsertMapFileRecordsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /login
+func (s *Server) handleLoginSessionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("insertMapFileRecords"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/rest/view"),
+		otelogen.OperationID("loginSession"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/login"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), InsertMapFileRecordsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), LoginSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7773,7 +12679,7 @@ func (s *Server) handleInsertMapFileRecordsRequest(args [0]string, argsEscaped b
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -7807,15 +12713,15 @@ func (s *Server) handleInsertMapFileRecordsRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: InsertMapFileRecordsOperation,
-			ID:   "insertMapFileRecords",
+			Name: LoginSessionOperation,
+			ID:   "loginSession",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, InsertMapFileRecordsOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, LoginSessionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7833,7 +12739,7 @@ func (s *Server) handleInsertMapFileRecordsRequest(args [0]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, InsertMapFileRecordsOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, LoginSessionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7850,31 +12756,12 @@ func (s *Server) handleInsertMapFileRecordsRequest(args [0]string, argsEscaped b
 				ctx = sctx
 			}
 		}
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, InsertMapFileRecordsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-					defer recordError("Security:BearerAuth", err)
-				}
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 2
-				ctx = sctx
-			}
-		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
-				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -7897,39 +12784,24 @@ func (s *Server) handleInsertMapFileRecordsRequest(args [0]string, argsEscaped b
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeInsertMapFileRecordsRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response InsertMapFileRecordsRes
+	var response LoginSessionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    InsertMapFileRecordsOperation,
+			OperationName:    LoginSessionOperation,
 			OperationSummary: "",
-			OperationID:      "insertMapFileRecords",
-			Body:             request,
+			OperationID:      "loginSession",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = OptInsertMapFileRecordsReq
+			Request  = struct{}
 			Params   = struct{}
-			Response = InsertMapFileRecordsRes
+			Response = LoginSessionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7940,12 +12812,12 @@ func (s *Server) handleInsertMapFileRecordsRequest(args [0]string, argsEscaped b
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.InsertMapFileRecords(ctx, request)
+				response, err = s.h.LoginSession(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.InsertMapFileRecords(ctx, request)
+		response, err = s.h.LoginSession(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7964,7 +12836,7 @@ func (s *Server) handleInsertMapFileRecordsRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeInsertMapFileRecordsResponse(response, w, span); err != nil {
+	if err := encodeLoginSessionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7973,22 +12845,24 @@ func (s *Server) handleInsertMapFileRecordsRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleInsertRecordRequest handles insertRecord operation.
+// handleLogoutSessionCompatRequest handles logoutSessionCompat operation.
 //
-// Insert given record.
+// Invalidate given token session.
 //
-// POST /rest/view/{table}
-func (s *Server) handleInsertRecordRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /logout
+func (s *Server) handleLogoutSessionCompatRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("insertRecord"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/rest/view/{table}"),
+		otelogen.OperationID("logoutSessionCompat"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/logout"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), InsertRecordOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), LogoutSessionCompatOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8009,7 +12883,7 @@ func (s *Server) handleInsertRecordRequest(args [1]string, argsEscaped bool, w h
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -8043,15 +12917,15 @@ func (s *Server) handleInsertRecordRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: InsertRecordOperation,
-			ID:   "insertRecord",
+			Name: LogoutSessionCompatOperation,
+			ID:   "logoutSessionCompat",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, InsertRecordOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, LogoutSessionCompatOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8069,7 +12943,7 @@ func (s *Server) handleInsertRecordRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, InsertRecordOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, LogoutSessionCompatOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8087,7 +12961,7 @@ func (s *Server) handleInsertRecordRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, InsertRecordOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, LogoutSessionCompatOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8131,60 +13005,26 @@ func (s *Server) handleInsertRecordRequest(args [1]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeInsertRecordParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeInsertRecordRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response InsertRecordRes
+	var response LogoutSessionCompatRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    InsertRecordOperation,
+			OperationName:    LogoutSessionCompatOperation,
 			OperationSummary: "",
-			OperationID:      "insertRecord",
-			Body:             request,
+			OperationID:      "logoutSessionCompat",
+			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "table",
-					In:   "path",
-				}: params.Table,
-				{
-					Name: "returning",
-					In:   "query",
-				}: params.Returning,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = OptInsertRecordReq
-			Params   = InsertRecordParams
-			Response = InsertRecordRes
+			Request  = struct{}
+			Params   = struct{}
+			Response = LogoutSessionCompatRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8193,14 +13033,14 @@ func (s *Server) handleInsertRecordRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackInsertRecordParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.InsertRecord(ctx, request, params)
+				response, err = s.h.LogoutSessionCompat(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.InsertRecord(ctx, request, params)
+		response, err = s.h.LogoutSessionCompat(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8219,7 +13059,7 @@ func (s *Server) handleInsertRecordRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeInsertRecordResponse(response, w, span); err != nil {
+	if err := encodeLogoutSessionCompatResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8228,22 +13068,24 @@ func (s *Server) handleInsertRecordRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleListModellingRequest handles listModelling operation.
+// handlePatchRecordByKeyRequest handles patchRecordByKey operation.
 //
-// Retrieves all tables, views or data representation objects.
+// Update the fields of the record with the given primary key present in the JSON Merge Patch.
 //
-// GET /rest/map
-func (s *Server) handleListModellingRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /rest/view/{table}/pk/{key}
+func (s *Server) handlePatchRecordByKeyRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listModelling"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/map"),
+		otelogen.OperationID("patchRecordByKey"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/rest/view/{table}/pk/{key}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListModellingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PatchRecordByKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8264,7 +13106,7 @@ func (s *Server) handleListModellingRequest(args [0]string, argsEscaped bool, w
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -8298,15 +13140,15 @@ func (s *Server) handleListModellingRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListModellingOperation,
-			ID:   "listModelling",
+			Name: PatchRecordByKeyOperation,
+			ID:   "patchRecordByKey",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, ListModellingOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, PatchRecordByKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8324,7 +13166,7 @@ func (s *Server) handleListModellingRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, ListModellingOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, PatchRecordByKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8342,7 +13184,7 @@ func (s *Server) handleListModellingRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListModellingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, PatchRecordByKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8386,26 +13228,64 @@ func (s *Server) handleListModellingRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodePatchRecordByKeyParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodePatchRecordByKeyRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListModellingRes
+	var response PatchRecordByKeyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListModellingOperation,
+			OperationName:    PatchRecordByKeyOperation,
 			OperationSummary: "",
-			OperationID:      "listModelling",
-			Body:             nil,
+			OperationID:      "patchRecordByKey",
+			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "If-Match",
+					In:   "header",
+				}: params.IfMatch,
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "key",
+					In:   "path",
+				}: params.Key,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = ListModellingRes
+			Request  = PatchRecordByKeyReq
+			Params   = PatchRecordByKeyParams
+			Response = PatchRecordByKeyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8414,14 +13294,14 @@ func (s *Server) handleListModellingRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackPatchRecordByKeyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListModelling(ctx)
+				response, err = s.h.PatchRecordByKey(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListModelling(ctx)
+		response, err = s.h.PatchRecordByKey(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8440,7 +13320,7 @@ func (s *Server) handleListModellingRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListModellingResponse(response, w, span); err != nil {
+	if err := encodePatchRecordByKeyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8449,22 +13329,25 @@ func (s *Server) handleListModellingRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleListTablesRequest handles listTables operation.
+// handlePostDatabaseRequest handles postDatabase operation.
 //
-// Retrieves all tables of databases.
+// Create a new database, the input need to be JSON. A structure level parameter indicate version to be
+// used.
 //
-// GET /rest/tables
-func (s *Server) handleListTablesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /rest/database
+func (s *Server) handlePostDatabaseRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTables"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/tables"),
+		otelogen.OperationID("postDatabase"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/rest/database"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTablesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PostDatabaseOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8485,7 +13368,7 @@ func (s *Server) handleListTablesRequest(args [0]string, argsEscaped bool, w htt
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -8519,15 +13402,15 @@ func (s *Server) handleListTablesRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListTablesOperation,
-			ID:   "listTables",
+			Name: PostDatabaseOperation,
+			ID:   "postDatabase",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, ListTablesOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, PostDatabaseOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8545,7 +13428,7 @@ func (s *Server) handleListTablesRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, ListTablesOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, PostDatabaseOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8563,7 +13446,7 @@ func (s *Server) handleListTablesRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListTablesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, PostDatabaseOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8609,24 +13492,39 @@ func (s *Server) handleListTablesRequest(args [0]string, argsEscaped bool, w htt
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodePostDatabaseRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListTablesRes
+	var response PostDatabaseRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListTablesOperation,
+			OperationName:    PostDatabaseOperation,
 			OperationSummary: "",
-			OperationID:      "listTables",
-			Body:             nil,
+			OperationID:      "postDatabase",
+			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *Database
 			Params   = struct{}
-			Response = ListTablesRes
+			Response = PostDatabaseRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8637,12 +13535,12 @@ func (s *Server) handleListTablesRequest(args [0]string, argsEscaped bool, w htt
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListTables(ctx)
+				response, err = s.h.PostDatabase(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListTables(ctx)
+		response, err = s.h.PostDatabase(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8661,7 +13559,7 @@ func (s *Server) handleListTablesRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeListTablesResponse(response, w, span); err != nil {
+	if err := encodePostDatabaseResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8670,22 +13568,24 @@ func (s *Server) handleListTablesRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleLoginSessionRequest handles loginSession operation.
+// handlePostJobRequest handles postJob operation.
 //
-// Login using baseauth or bearer to receive or validate token.
+// Create a new Job database.
 //
-// PUT /login
-func (s *Server) handleLoginSessionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /tasks
+func (s *Server) handlePostJobRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("loginSession"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/login"),
+		otelogen.OperationID("postJob"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/tasks"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), LoginSessionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PostJobOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8706,7 +13606,7 @@ func (s *Server) handleLoginSessionRequest(args [0]string, argsEscaped bool, w h
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -8740,15 +13640,15 @@ func (s *Server) handleLoginSessionRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: LoginSessionOperation,
-			ID:   "loginSession",
+			Name: PostJobOperation,
+			ID:   "postJob",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, LoginSessionOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, PostJobOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8766,7 +13666,7 @@ func (s *Server) handleLoginSessionRequest(args [0]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, LoginSessionOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, PostJobOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8783,12 +13683,31 @@ func (s *Server) handleLoginSessionRequest(args [0]string, argsEscaped bool, w h
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PostJobOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -8811,24 +13730,39 @@ func (s *Server) handleLoginSessionRequest(args [0]string, argsEscaped bool, w h
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodePostJobRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response LoginSessionRes
+	var response PostJobRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    LoginSessionOperation,
+			OperationName:    PostJobOperation,
 			OperationSummary: "",
-			OperationID:      "loginSession",
-			Body:             nil,
+			OperationID:      "postJob",
+			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = PostJobReq
 			Params   = struct{}
-			Response = LoginSessionRes
+			Response = PostJobRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8839,12 +13773,12 @@ func (s *Server) handleLoginSessionRequest(args [0]string, argsEscaped bool, w h
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.LoginSession(ctx)
+				response, err = s.h.PostJob(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.LoginSession(ctx)
+		response, err = s.h.PostJob(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8863,7 +13797,7 @@ func (s *Server) handleLoginSessionRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeLoginSessionResponse(response, w, span); err != nil {
+	if err := encodePostJobResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8872,22 +13806,24 @@ func (s *Server) handleLoginSessionRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleLogoutSessionCompatRequest handles logoutSessionCompat operation.
+// handlePurgeImageCacheRequest handles purgeImageCache operation.
 //
-// Invalidate given token session.
+// Purge the derived image cache, all entries or the entries of one table.
 //
-// PUT /logout
-func (s *Server) handleLogoutSessionCompatRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /config/images/cache
+func (s *Server) handlePurgeImageCacheRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("logoutSessionCompat"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/logout"),
+		otelogen.OperationID("purgeImageCache"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/config/images/cache"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), LogoutSessionCompatOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PurgeImageCacheOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8908,7 +13844,7 @@ func (s *Server) handleLogoutSessionCompatRequest(args [0]string, argsEscaped bo
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -8942,15 +13878,15 @@ func (s *Server) handleLogoutSessionCompatRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: LogoutSessionCompatOperation,
-			ID:   "logoutSessionCompat",
+			Name: PurgeImageCacheOperation,
+			ID:   "purgeImageCache",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, LogoutSessionCompatOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, PurgeImageCacheOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8968,7 +13904,7 @@ func (s *Server) handleLogoutSessionCompatRequest(args [0]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, LogoutSessionCompatOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, PurgeImageCacheOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8986,7 +13922,7 @@ func (s *Server) handleLogoutSessionCompatRequest(args [0]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, LogoutSessionCompatOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, PurgeImageCacheOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9030,26 +13966,41 @@ func (s *Server) handleLogoutSessionCompatRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
+	params, err := decodePurgeImageCacheParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response LogoutSessionCompatRes
+	var response PurgeImageCacheRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    LogoutSessionCompatOperation,
+			OperationName:    PurgeImageCacheOperation,
 			OperationSummary: "",
-			OperationID:      "logoutSessionCompat",
+			OperationID:      "purgeImageCache",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "table",
+					In:   "query",
+				}: params.Table,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = LogoutSessionCompatRes
+			Params   = PurgeImageCacheParams
+			Response = PurgeImageCacheRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9058,14 +14009,14 @@ func (s *Server) handleLogoutSessionCompatRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			nil,
+			unpackPurgeImageCacheParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.LogoutSessionCompat(ctx)
+				response, err = s.h.PurgeImageCache(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.LogoutSessionCompat(ctx)
+		response, err = s.h.PurgeImageCache(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9084,7 +14035,7 @@ func (s *Server) handleLogoutSessionCompatRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeLogoutSessionCompatResponse(response, w, span); err != nil {
+	if err := encodePurgeImageCacheResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9093,23 +14044,24 @@ func (s *Server) handleLogoutSessionCompatRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handlePostDatabaseRequest handles postDatabase operation.
+// handlePushLoginSessionRequest handles pushLoginSession operation.
 //
-// Create a new database, the input need to be JSON. A structure level parameter indicate version to
-// be used.
+// Login using baseauth or bearer to receive or validate token.
 //
-// POST /rest/database
-func (s *Server) handlePostDatabaseRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /login
+func (s *Server) handlePushLoginSessionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("postDatabase"),
+		otelogen.OperationID("pushLoginSession"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/rest/database"),
+		semconv.HTTPRouteKey.String("/login"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PostDatabaseOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PushLoginSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9130,7 +14082,7 @@ func (s *Server) handlePostDatabaseRequest(args [0]string, argsEscaped bool, w h
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -9164,64 +14116,46 @@ func (s *Server) handlePostDatabaseRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PostDatabaseOperation,
-			ID:   "postDatabase",
+			Name: PushLoginSessionOperation,
+			ID:   "pushLoginSession",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBasicAuth(ctx, PostDatabaseOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BasicAuth",
-					Err:              err,
-				}
-				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-					defer recordError("Security:BasicAuth", err)
-				}
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, PostDatabaseOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, PushLoginSessionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
-					Security:         "TokenCheck",
+					Security:         "BasicAuth",
 					Err:              err,
 				}
 				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-					defer recordError("Security:TokenCheck", err)
+					defer recordError("Security:BasicAuth", err)
 				}
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 1
+				satisfied[0] |= 1 << 0
 				ctx = sctx
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, PostDatabaseOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, PushLoginSessionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
-					Security:         "BearerAuth",
+					Security:         "TokenCheck",
 					Err:              err,
 				}
 				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-					defer recordError("Security:BearerAuth", err)
+					defer recordError("Security:TokenCheck", err)
 				}
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 2
+				satisfied[0] |= 1 << 1
 				ctx = sctx
 			}
 		}
@@ -9231,7 +14165,6 @@ func (s *Server) handlePostDatabaseRequest(args [0]string, argsEscaped bool, w h
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
-				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -9254,39 +14187,24 @@ func (s *Server) handlePostDatabaseRequest(args [0]string, argsEscaped bool, w h
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodePostDatabaseRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response PostDatabaseRes
+	var response PushLoginSessionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PostDatabaseOperation,
+			OperationName:    PushLoginSessionOperation,
 			OperationSummary: "",
-			OperationID:      "postDatabase",
-			Body:             request,
+			OperationID:      "pushLoginSession",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *Database
+			Request  = struct{}
 			Params   = struct{}
-			Response = PostDatabaseRes
+			Response = PushLoginSessionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9297,12 +14215,12 @@ func (s *Server) handlePostDatabaseRequest(args [0]string, argsEscaped bool, w h
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PostDatabase(ctx, request)
+				response, err = s.h.PushLoginSession(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PostDatabase(ctx, request)
+		response, err = s.h.PushLoginSession(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9321,7 +14239,7 @@ func (s *Server) handlePostDatabaseRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodePostDatabaseResponse(response, w, span); err != nil {
+	if err := encodePushLoginSessionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9330,22 +14248,24 @@ func (s *Server) handlePostDatabaseRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handlePostJobRequest handles postJob operation.
+// handleRemoveSessionCompatRequest handles removeSessionCompat operation.
 //
-// Create a new Job database.
+// Invalidate given token session.
 //
-// POST /tasks
-func (s *Server) handlePostJobRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /logoff
+func (s *Server) handleRemoveSessionCompatRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("postJob"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/tasks"),
+		otelogen.OperationID("removeSessionCompat"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/logoff"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PostJobOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveSessionCompatOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9366,7 +14286,7 @@ func (s *Server) handlePostJobRequest(args [0]string, argsEscaped bool, w http.R
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -9400,15 +14320,15 @@ func (s *Server) handlePostJobRequest(args [0]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PostJobOperation,
-			ID:   "postJob",
+			Name: RemoveSessionCompatOperation,
+			ID:   "removeSessionCompat",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, PostJobOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, RemoveSessionCompatOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9426,7 +14346,7 @@ func (s *Server) handlePostJobRequest(args [0]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, PostJobOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, RemoveSessionCompatOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9444,7 +14364,7 @@ func (s *Server) handlePostJobRequest(args [0]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, PostJobOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RemoveSessionCompatOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9490,39 +14410,24 @@ func (s *Server) handlePostJobRequest(args [0]string, argsEscaped bool, w http.R
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodePostJobRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response PostJobRes
+	var response RemoveSessionCompatRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PostJobOperation,
+			OperationName:    RemoveSessionCompatOperation,
 			OperationSummary: "",
-			OperationID:      "postJob",
-			Body:             request,
+			OperationID:      "removeSessionCompat",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = PostJobReq
+			Request  = struct{}
 			Params   = struct{}
-			Response = PostJobRes
+			Response = RemoveSessionCompatRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9533,12 +14438,12 @@ func (s *Server) handlePostJobRequest(args [0]string, argsEscaped bool, w http.R
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PostJob(ctx, request)
+				response, err = s.h.RemoveSessionCompat(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PostJob(ctx, request)
+		response, err = s.h.RemoveSessionCompat(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9557,7 +14462,7 @@ func (s *Server) handlePostJobRequest(args [0]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodePostJobResponse(response, w, span); err != nil {
+	if err := encodeRemoveSessionCompatResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9566,22 +14471,24 @@ func (s *Server) handlePostJobRequest(args [0]string, argsEscaped bool, w http.R
 	}
 }
 
-// handlePushLoginSessionRequest handles pushLoginSession operation.
+// handleSearchModellingRequest handles searchModelling operation.
 //
-// Login using baseauth or bearer to receive or validate token.
+// Retrieves all columns, fields of a tables, views or data representation.
 //
-// POST /login
-func (s *Server) handlePushLoginSessionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/map/{path}
+func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pushLoginSession"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/login"),
+		otelogen.OperationID("searchModelling"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/rest/map/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PushLoginSessionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SearchModellingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9602,7 +14509,7 @@ func (s *Server) handlePushLoginSessionRequest(args [0]string, argsEscaped bool,
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -9636,15 +14543,15 @@ func (s *Server) handlePushLoginSessionRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PushLoginSessionOperation,
-			ID:   "pushLoginSession",
+			Name: SearchModellingOperation,
+			ID:   "searchModelling",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, PushLoginSessionOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, SearchModellingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9662,7 +14569,7 @@ func (s *Server) handlePushLoginSessionRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, PushLoginSessionOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, SearchModellingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9679,12 +14586,31 @@ func (s *Server) handlePushLoginSessionRequest(args [0]string, argsEscaped bool,
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SearchModellingOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -9705,26 +14631,41 @@ func (s *Server) handlePushLoginSessionRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodeSearchModellingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response PushLoginSessionRes
+	var response SearchModellingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PushLoginSessionOperation,
+			OperationName:    SearchModellingOperation,
 			OperationSummary: "",
-			OperationID:      "pushLoginSession",
+			OperationID:      "searchModelling",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "path",
+					In:   "path",
+				}: params.Path,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = PushLoginSessionRes
+			Params   = SearchModellingParams
+			Response = SearchModellingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9733,14 +14674,14 @@ func (s *Server) handlePushLoginSessionRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackSearchModellingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PushLoginSession(ctx)
+				response, err = s.h.SearchModelling(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PushLoginSession(ctx)
+		response, err = s.h.SearchModelling(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9759,7 +14700,7 @@ func (s *Server) handlePushLoginSessionRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodePushLoginSessionResponse(response, w, span); err != nil {
+	if err := encodeSearchModellingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9768,22 +14709,24 @@ func (s *Server) handlePushLoginSessionRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleRemoveSessionCompatRequest handles removeSessionCompat operation.
+// handleSearchRecordsFieldsRequest handles searchRecordsFields operation.
 //
-// Invalidate given token session.
+// Query a record with a given SQL query.
 //
-// GET /logoff
-func (s *Server) handleRemoveSessionCompatRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/view/{table}/{search}
+func (s *Server) handleSearchRecordsFieldsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeSessionCompat"),
+		otelogen.OperationID("searchRecordsFields"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/logoff"),
+		semconv.HTTPRouteKey.String("/rest/view/{table}/{search}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveSessionCompatOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SearchRecordsFieldsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9804,7 +14747,7 @@ func (s *Server) handleRemoveSessionCompatRequest(args [0]string, argsEscaped bo
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -9838,15 +14781,15 @@ func (s *Server) handleRemoveSessionCompatRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveSessionCompatOperation,
-			ID:   "removeSessionCompat",
+			Name: SearchRecordsFieldsOperation,
+			ID:   "searchRecordsFields",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, RemoveSessionCompatOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, SearchRecordsFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9864,7 +14807,7 @@ func (s *Server) handleRemoveSessionCompatRequest(args [0]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, RemoveSessionCompatOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, SearchRecordsFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9882,7 +14825,7 @@ func (s *Server) handleRemoveSessionCompatRequest(args [0]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemoveSessionCompatOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SearchRecordsFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9926,26 +14869,117 @@ func (s *Server) handleRemoveSessionCompatRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
+	params, err := decodeSearchRecordsFieldsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response RemoveSessionCompatRes
+	var response SearchRecordsFieldsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveSessionCompatOperation,
+			OperationName:    SearchRecordsFieldsOperation,
 			OperationSummary: "",
-			OperationID:      "removeSessionCompat",
+			OperationID:      "searchRecordsFields",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "sorted_by",
+					In:   "query",
+				}: params.SortedBy,
+				{
+					Name: "sqlsearch",
+					In:   "query",
+				}: params.Sqlsearch,
+				{
+					Name: "compact",
+					In:   "query",
+				}: params.Compact,
+				{
+					Name: "flatten",
+					In:   "query",
+				}: params.Flatten,
+				{
+					Name: "descriptor",
+					In:   "query",
+				}: params.Descriptor,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "count",
+					In:   "query",
+				}: params.Count,
+				{
+					Name: "orderby",
+					In:   "query",
+				}: params.Orderby,
+				{
+					Name: "xmlnotation",
+					In:   "query",
+				}: params.Xmlnotation,
+				{
+					Name: "delimiter",
+					In:   "query",
+				}: params.Delimiter,
+				{
+					Name: "quote",
+					In:   "query",
+				}: params.Quote,
+				{
+					Name: "header",
+					In:   "query",
+				}: params.Header,
+				{
+					Name: "null",
+					In:   "query",
+				}: params.Null,
+				{
+					Name: "dateformat",
+					In:   "query",
+				}: params.Dateformat,
+				{
+					Name: "bom",
+					In:   "query",
+				}: params.Bom,
+				{
+					Name: "table",
+					In:   "path",
+				}: params.Table,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = RemoveSessionCompatRes
+			Params   = SearchRecordsFieldsParams
+			Response = SearchRecordsFieldsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9954,14 +14988,14 @@ func (s *Server) handleRemoveSessionCompatRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			nil,
+			unpackSearchRecordsFieldsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveSessionCompat(ctx)
+				response, err = s.h.SearchRecordsFields(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveSessionCompat(ctx)
+		response, err = s.h.SearchRecordsFields(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9980,7 +15014,7 @@ func (s *Server) handleRemoveSessionCompatRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeRemoveSessionCompatResponse(response, w, span); err != nil {
+	if err := encodeSearchRecordsFieldsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9989,22 +15023,24 @@ func (s *Server) handleRemoveSessionCompatRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleSearchModellingRequest handles searchModelling operation.
+// handleSearchTableRequest handles searchTable operation.
 //
-// Retrieves all columns, fields of a tables, views or data representation.
+// Retrieves all fields of an file.
 //
-// GET /rest/map/{path}
-func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /rest/tables/{table}/{fields}/{search}
+func (s *Server) handleSearchTableRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("searchModelling"),
+		otelogen.OperationID("searchTable"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/map/{path}"),
+		semconv.HTTPRouteKey.String("/rest/tables/{table}/{fields}/{search}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SearchModellingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SearchTableOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10025,7 +15061,7 @@ func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool,
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -10059,15 +15095,15 @@ func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SearchModellingOperation,
-			ID:   "searchModelling",
+			Name: SearchTableOperation,
+			ID:   "searchTable",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, SearchModellingOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, SearchTableOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10085,7 +15121,7 @@ func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, SearchModellingOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, SearchTableOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10103,7 +15139,7 @@ func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SearchModellingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SearchTableOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10147,7 +15183,7 @@ func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeSearchModellingParams(args, argsEscaped, r)
+	params, err := decodeSearchTableParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10160,28 +15196,40 @@ func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response SearchModellingRes
+	var response SearchTableRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SearchModellingOperation,
+			OperationName:    SearchTableOperation,
 			OperationSummary: "",
-			OperationID:      "searchModelling",
+			OperationID:      "searchTable",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "path",
+					Name: "table",
 					In:   "path",
-				}: params.Path,
+				}: params.Table,
+				{
+					Name: "fields",
+					In:   "path",
+				}: params.Fields,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
+				{
+					Name: "orderby",
+					In:   "query",
+				}: params.Orderby,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = SearchModellingParams
-			Response = SearchModellingRes
+			Params   = SearchTableParams
+			Response = SearchTableRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10190,14 +15238,14 @@ func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackSearchModellingParams,
+			unpackSearchTableParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SearchModelling(ctx, params)
+				response, err = s.h.SearchTable(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SearchModelling(ctx, params)
+		response, err = s.h.SearchTable(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10216,7 +15264,7 @@ func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeSearchModellingResponse(response, w, span); err != nil {
+	if err := encodeSearchTableResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10225,22 +15273,24 @@ func (s *Server) handleSearchModellingRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleSearchRecordsFieldsRequest handles searchRecordsFields operation.
+// handleSetConfigRequest handles setConfig operation.
 //
-// Query a record with a given SQL query.
+// Update current configuration and test.
 //
-// GET /rest/view/{table}/{search}
-func (s *Server) handleSearchRecordsFieldsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /config
+func (s *Server) handleSetConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("searchRecordsFields"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/view/{table}/{search}"),
+		otelogen.OperationID("setConfig"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/config"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SearchRecordsFieldsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SetConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10261,7 +15311,7 @@ func (s *Server) handleSearchRecordsFieldsRequest(args [2]string, argsEscaped bo
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -10295,15 +15345,15 @@ func (s *Server) handleSearchRecordsFieldsRequest(args [2]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SearchRecordsFieldsOperation,
-			ID:   "searchRecordsFields",
+			Name: SetConfigOperation,
+			ID:   "setConfig",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, SearchRecordsFieldsOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, SetConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10321,7 +15371,7 @@ func (s *Server) handleSearchRecordsFieldsRequest(args [2]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, SearchRecordsFieldsOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, SetConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10339,7 +15389,7 @@ func (s *Server) handleSearchRecordsFieldsRequest(args [2]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SearchRecordsFieldsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SetConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10383,81 +15433,41 @@ func (s *Server) handleSearchRecordsFieldsRequest(args [2]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeSearchRecordsFieldsParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeSetConfigRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response SearchRecordsFieldsRes
+	var response SetConfigRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SearchRecordsFieldsOperation,
+			OperationName:    SetConfigOperation,
 			OperationSummary: "",
-			OperationID:      "searchRecordsFields",
-			Body:             nil,
+			OperationID:      "setConfig",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "sorted_by",
-					In:   "query",
-				}: params.SortedBy,
-				{
-					Name: "sqlsearch",
-					In:   "query",
-				}: params.Sqlsearch,
-				{
-					Name: "compact",
-					In:   "query",
-				}: params.Compact,
-				{
-					Name: "flatten",
-					In:   "query",
-				}: params.Flatten,
-				{
-					Name: "descriptor",
-					In:   "query",
-				}: params.Descriptor,
-				{
-					Name: "orderby",
-					In:   "query",
-				}: params.Orderby,
-				{
-					Name: "xmlnotation",
-					In:   "query",
-				}: params.Xmlnotation,
-				{
-					Name: "table",
-					In:   "path",
-				}: params.Table,
-				{
-					Name: "search",
-					In:   "path",
-				}: params.Search,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = SearchRecordsFieldsParams
-			Response = SearchRecordsFieldsRes
+			Request  = SetConfigReq
+			Params   = struct{}
+			Response = SetConfigRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10466,14 +15476,14 @@ func (s *Server) handleSearchRecordsFieldsRequest(args [2]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackSearchRecordsFieldsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SearchRecordsFields(ctx, params)
+				response, err = s.h.SetConfig(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SearchRecordsFields(ctx, params)
+		response, err = s.h.SetConfig(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10492,7 +15502,7 @@ func (s *Server) handleSearchRecordsFieldsRequest(args [2]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeSearchRecordsFieldsResponse(response, w, span); err != nil {
+	if err := encodeSetConfigResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10501,22 +15511,24 @@ func (s *Server) handleSearchRecordsFieldsRequest(args [2]string, argsEscaped bo
 	}
 }
 
-// handleSearchTableRequest handles searchTable operation.
+// handleSetJobsConfigRequest handles setJobsConfig operation.
 //
-// Retrieves all fields of an file.
+// Set the Job configuration database.
 //
-// GET /rest/tables/{table}/{fields}/{search}
-func (s *Server) handleSearchTableRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /config/jobs
+func (s *Server) handleSetJobsConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("searchTable"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/rest/tables/{table}/{fields}/{search}"),
+		otelogen.OperationID("setJobsConfig"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/config/jobs"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SearchTableOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SetJobsConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10537,7 +15549,7 @@ func (s *Server) handleSearchTableRequest(args [3]string, argsEscaped bool, w ht
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -10571,15 +15583,15 @@ func (s *Server) handleSearchTableRequest(args [3]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SearchTableOperation,
-			ID:   "searchTable",
+			Name: SetJobsConfigOperation,
+			ID:   "setJobsConfig",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, SearchTableOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, SetJobsConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10597,7 +15609,7 @@ func (s *Server) handleSearchTableRequest(args [3]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, SearchTableOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, SetJobsConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10615,7 +15627,7 @@ func (s *Server) handleSearchTableRequest(args [3]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SearchTableOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SetJobsConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10659,53 +15671,41 @@ func (s *Server) handleSearchTableRequest(args [3]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeSearchTableParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeSetJobsConfigRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response SearchTableRes
+	var response SetJobsConfigRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SearchTableOperation,
+			OperationName:    SetJobsConfigOperation,
 			OperationSummary: "",
-			OperationID:      "searchTable",
-			Body:             nil,
+			OperationID:      "setJobsConfig",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "table",
-					In:   "path",
-				}: params.Table,
-				{
-					Name: "fields",
-					In:   "path",
-				}: params.Fields,
-				{
-					Name: "search",
-					In:   "path",
-				}: params.Search,
-				{
-					Name: "orderby",
-					In:   "query",
-				}: params.Orderby,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = SearchTableParams
-			Response = SearchTableRes
+			Request  = OptJobStore
+			Params   = struct{}
+			Response = SetJobsConfigRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10714,14 +15714,14 @@ func (s *Server) handleSearchTableRequest(args [3]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackSearchTableParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SearchTable(ctx, params)
+				response, err = s.h.SetJobsConfig(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SearchTable(ctx, params)
+		response, err = s.h.SetJobsConfig(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10740,7 +15740,7 @@ func (s *Server) handleSearchTableRequest(args [3]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeSearchTableResponse(response, w, span); err != nil {
+	if err := encodeSetJobsConfigResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10749,22 +15749,24 @@ func (s *Server) handleSearchTableRequest(args [3]string, argsEscaped bool, w ht
 	}
 }
 
-// handleSetConfigRequest handles setConfig operation.
+// handleShutdownServerRequest handles shutdownServer operation.
 //
-// Update current configuration and test.
+// Trigger shutdown of server instance.
 //
-// PUT /config
-func (s *Server) handleSetConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /rest/shutdown/{hash}
+func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("setConfig"),
+		otelogen.OperationID("shutdownServer"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/config"),
+		semconv.HTTPRouteKey.String("/rest/shutdown/{hash}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SetConfigOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ShutdownServerOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10785,7 +15787,7 @@ func (s *Server) handleSetConfigRequest(args [0]string, argsEscaped bool, w http
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -10819,15 +15821,15 @@ func (s *Server) handleSetConfigRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SetConfigOperation,
-			ID:   "setConfig",
+			Name: ShutdownServerOperation,
+			ID:   "shutdownServer",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, SetConfigOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, ShutdownServerOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10845,7 +15847,7 @@ func (s *Server) handleSetConfigRequest(args [0]string, argsEscaped bool, w http
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, SetConfigOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, ShutdownServerOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10863,7 +15865,7 @@ func (s *Server) handleSetConfigRequest(args [0]string, argsEscaped bool, w http
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SetConfigOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ShutdownServerOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10907,41 +15909,41 @@ func (s *Server) handleSetConfigRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
-
-	var rawBody []byte
-	request, rawBody, close, err := s.decodeSetConfigRequest(r)
+	params, err := decodeShutdownServerParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response SetConfigRes
+	var rawBody []byte
+
+	var response ShutdownServerRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SetConfigOperation,
+			OperationName:    ShutdownServerOperation,
 			OperationSummary: "",
-			OperationID:      "setConfig",
-			Body:             request,
+			OperationID:      "shutdownServer",
+			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "hash",
+					In:   "path",
+				}: params.Hash,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = SetConfigReq
-			Params   = struct{}
-			Response = SetConfigRes
+			Request  = struct{}
+			Params   = ShutdownServerParams
+			Response = ShutdownServerRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10950,14 +15952,14 @@ func (s *Server) handleSetConfigRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackShutdownServerParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SetConfig(ctx, request)
+				response, err = s.h.ShutdownServer(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SetConfig(ctx, request)
+		response, err = s.h.ShutdownServer(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10976,7 +15978,7 @@ func (s *Server) handleSetConfigRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeSetConfigResponse(response, w, span); err != nil {
+	if err := encodeShutdownServerResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10985,22 +15987,24 @@ func (s *Server) handleSetConfigRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleSetJobsConfigRequest handles setJobsConfig operation.
+// handleStoreConfigRequest handles storeConfig operation.
 //
-// Set the Job configuration database.
+// Store current active configuration to configuration file.
 //
-// PUT /config/jobs
-func (s *Server) handleSetJobsConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /config
+func (s *Server) handleStoreConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("setJobsConfig"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/config/jobs"),
+		otelogen.OperationID("storeConfig"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/config"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SetJobsConfigOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StoreConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11021,7 +16025,7 @@ func (s *Server) handleSetJobsConfigRequest(args [0]string, argsEscaped bool, w
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -11055,15 +16059,15 @@ func (s *Server) handleSetJobsConfigRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SetJobsConfigOperation,
-			ID:   "setJobsConfig",
+			Name: StoreConfigOperation,
+			ID:   "storeConfig",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, SetJobsConfigOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, StoreConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11081,7 +16085,7 @@ func (s *Server) handleSetJobsConfigRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, SetJobsConfigOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, StoreConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11099,7 +16103,7 @@ func (s *Server) handleSetJobsConfigRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SetJobsConfigOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StoreConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11145,39 +16149,24 @@ func (s *Server) handleSetJobsConfigRequest(args [0]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeSetJobsConfigRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response SetJobsConfigRes
+	var response StoreConfigRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SetJobsConfigOperation,
+			OperationName:    StoreConfigOperation,
 			OperationSummary: "",
-			OperationID:      "setJobsConfig",
-			Body:             request,
+			OperationID:      "storeConfig",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = OptJobStore
+			Request  = struct{}
 			Params   = struct{}
-			Response = SetJobsConfigRes
+			Response = StoreConfigRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11188,12 +16177,12 @@ func (s *Server) handleSetJobsConfigRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SetJobsConfig(ctx, request)
+				response, err = s.h.StoreConfig(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SetJobsConfig(ctx, request)
+		response, err = s.h.StoreConfig(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11212,7 +16201,7 @@ func (s *Server) handleSetJobsConfigRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSetJobsConfigResponse(response, w, span); err != nil {
+	if err := encodeStoreConfigResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11221,22 +16210,24 @@ func (s *Server) handleSetJobsConfigRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleShutdownServerRequest handles shutdownServer operation.
+// handleTriggerExtendRequest handles triggerExtend operation.
 //
-// Trigger shutdown of server instance.
+// Put extend/plugin request.
 //
-// PUT /rest/shutdown/{hash}
-func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /rest/extend/{path}
+func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("shutdownServer"),
+		otelogen.OperationID("triggerExtend"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/rest/shutdown/{hash}"),
+		semconv.HTTPRouteKey.String("/rest/extend/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ShutdownServerOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TriggerExtendOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11257,7 +16248,7 @@ func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -11291,15 +16282,15 @@ func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ShutdownServerOperation,
-			ID:   "shutdownServer",
+			Name: TriggerExtendOperation,
+			ID:   "triggerExtend",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, ShutdownServerOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, TriggerExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11317,7 +16308,7 @@ func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, ShutdownServerOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, TriggerExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11335,7 +16326,7 @@ func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ShutdownServerOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, TriggerExtendOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11379,7 +16370,7 @@ func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeShutdownServerParams(args, argsEscaped, r)
+	params, err := decodeTriggerExtendParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11392,28 +16383,28 @@ func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response ShutdownServerRes
+	var response TriggerExtendRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ShutdownServerOperation,
+			OperationName:    TriggerExtendOperation,
 			OperationSummary: "",
-			OperationID:      "shutdownServer",
+			OperationID:      "triggerExtend",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "hash",
+					Name: "path",
 					In:   "path",
-				}: params.Hash,
+				}: params.Path,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ShutdownServerParams
-			Response = ShutdownServerRes
+			Params   = TriggerExtendParams
+			Response = TriggerExtendRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11422,14 +16413,14 @@ func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackShutdownServerParams,
+			unpackTriggerExtendParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ShutdownServer(ctx, params)
+				response, err = s.h.TriggerExtend(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ShutdownServer(ctx, params)
+		response, err = s.h.TriggerExtend(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11448,7 +16439,7 @@ func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeShutdownServerResponse(response, w, span); err != nil {
+	if err := encodeTriggerExtendResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11457,22 +16448,24 @@ func (s *Server) handleShutdownServerRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleStoreConfigRequest handles storeConfig operation.
+// handleTriggerJobRequest handles triggerJob operation.
 //
-// Store current active configuration to configuration file.
+// Trigger a job.
 //
-// POST /config
-func (s *Server) handleStoreConfigRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /tasks/{jobName}
+func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("storeConfig"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/config"),
+		otelogen.OperationID("triggerJob"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/tasks/{jobName}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StoreConfigOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TriggerJobOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11493,7 +16486,7 @@ func (s *Server) handleStoreConfigRequest(args [0]string, argsEscaped bool, w ht
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -11527,15 +16520,15 @@ func (s *Server) handleStoreConfigRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StoreConfigOperation,
-			ID:   "storeConfig",
+			Name: TriggerJobOperation,
+			ID:   "triggerJob",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, StoreConfigOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, TriggerJobOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11553,7 +16546,7 @@ func (s *Server) handleStoreConfigRequest(args [0]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, StoreConfigOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, TriggerJobOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11571,7 +16564,7 @@ func (s *Server) handleStoreConfigRequest(args [0]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StoreConfigOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, TriggerJobOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11615,26 +16608,41 @@ func (s *Server) handleStoreConfigRequest(args [0]string, argsEscaped bool, w ht
 			return
 		}
 	}
+	params, err := decodeTriggerJobParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response StoreConfigRes
+	var response TriggerJobRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StoreConfigOperation,
+			OperationName:    TriggerJobOperation,
 			OperationSummary: "",
-			OperationID:      "storeConfig",
+			OperationID:      "triggerJob",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "jobName",
+					In:   "path",
+				}: params.JobName,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = StoreConfigRes
+			Params   = TriggerJobParams
+			Response = TriggerJobRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11643,14 +16651,14 @@ func (s *Server) handleStoreConfigRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			nil,
+			unpackTriggerJobParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StoreConfig(ctx)
+				response, err = s.h.TriggerJob(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StoreConfig(ctx)
+		response, err = s.h.TriggerJob(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11669,7 +16677,7 @@ func (s *Server) handleStoreConfigRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeStoreConfigResponse(response, w, span); err != nil {
+	if err := encodeTriggerJobResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11678,22 +16686,25 @@ func (s *Server) handleStoreConfigRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleTriggerExtendRequest handles triggerExtend operation.
+// handleUpdateImageHashesRequest handles updateImageHashes operation.
 //
-// Put extend/plugin request.
+// Calculates the perceptual hash of the image field of all records matching the search and stores it
+// in the hash field.
 //
-// PUT /rest/extend/{path}
-func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /hash/{table}/{field}
+func (s *Server) handleUpdateImageHashesRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("triggerExtend"),
+		otelogen.OperationID("updateImageHashes"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/rest/extend/{path}"),
+		semconv.HTTPRouteKey.String("/hash/{table}/{field}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TriggerExtendOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateImageHashesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11714,7 +16725,7 @@ func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -11748,15 +16759,15 @@ func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TriggerExtendOperation,
-			ID:   "triggerExtend",
+			Name: UpdateImageHashesOperation,
+			ID:   "updateImageHashes",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, TriggerExtendOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, UpdateImageHashesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11774,7 +16785,7 @@ func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, TriggerExtendOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, UpdateImageHashesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11792,7 +16803,7 @@ func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, TriggerExtendOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateImageHashesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11836,7 +16847,7 @@ func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeTriggerExtendParams(args, argsEscaped, r)
+	params, err := decodeUpdateImageHashesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11849,28 +16860,52 @@ func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response TriggerExtendRes
+	var response UpdateImageHashesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TriggerExtendOperation,
+			OperationName:    UpdateImageHashesOperation,
 			OperationSummary: "",
-			OperationID:      "triggerExtend",
+			OperationID:      "updateImageHashes",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "path",
+					Name: "table",
 					In:   "path",
-				}: params.Path,
+				}: params.Table,
+				{
+					Name: "field",
+					In:   "path",
+				}: params.Field,
+				{
+					Name: "hashField",
+					In:   "query",
+				}: params.HashField,
+				{
+					Name: "search",
+					In:   "query",
+				}: params.Search,
+				{
+					Name: "mimetypeField",
+					In:   "query",
+				}: params.MimetypeField,
+				{
+					Name: "algorithm",
+					In:   "query",
+				}: params.Algorithm,
+				{
+					Name: "overwrite",
+					In:   "query",
+				}: params.Overwrite,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = TriggerExtendParams
-			Response = TriggerExtendRes
+			Params   = UpdateImageHashesParams
+			Response = UpdateImageHashesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11879,14 +16914,14 @@ func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackTriggerExtendParams,
+			unpackUpdateImageHashesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TriggerExtend(ctx, params)
+				response, err = s.h.UpdateImageHashes(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TriggerExtend(ctx, params)
+		response, err = s.h.UpdateImageHashes(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11905,7 +16940,7 @@ func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeTriggerExtendResponse(response, w, span); err != nil {
+	if err := encodeUpdateImageHashesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11914,22 +16949,27 @@ func (s *Server) handleTriggerExtendRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleTriggerJobRequest handles triggerJob operation.
+// handleUpdateLobByMapRequest handles updateLobByMap operation.
 //
-// Trigger a job.
+// Set a lob at a specific table record of an field in a Map. The request body is streamed into the
+// field of exactly one record matching the search. On PostgreSQL and MySQL tables with primary key the
+// body is appended block by block inside one transaction, other tables read the body into memory up to
+// the maxBinaryBufferSize limit.
 //
-// PUT /tasks/{jobName}
-func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /binary/{table}/{field}/{search}
+func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("triggerJob"),
+		otelogen.OperationID("updateLobByMap"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/tasks/{jobName}"),
+		semconv.HTTPRouteKey.String("/binary/{table}/{field}/{search}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TriggerJobOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateLobByMapOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11950,7 +16990,7 @@ func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w htt
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -11984,15 +17024,15 @@ func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TriggerJobOperation,
-			ID:   "triggerJob",
+			Name: UpdateLobByMapOperation,
+			ID:   "updateLobByMap",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, TriggerJobOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, UpdateLobByMapOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12010,7 +17050,7 @@ func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, TriggerJobOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, UpdateLobByMapOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12028,7 +17068,7 @@ func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, TriggerJobOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateLobByMapOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12072,7 +17112,7 @@ func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeTriggerJobParams(args, argsEscaped, r)
+	params, err := decodeUpdateLobByMapParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12084,29 +17124,64 @@ func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w htt
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeUpdateLobByMapRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response TriggerJobRes
+	var response UpdateLobByMapRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TriggerJobOperation,
+			OperationName:    UpdateLobByMapOperation,
 			OperationSummary: "",
-			OperationID:      "triggerJob",
-			Body:             nil,
+			OperationID:      "updateLobByMap",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "jobName",
+					Name: "mimetypeField",
+					In:   "query",
+				}: params.MimetypeField,
+				{
+					Name: "mimetype",
+					In:   "query",
+				}: params.Mimetype,
+				{
+					Name: "maxSize",
+					In:   "query",
+				}: params.MaxSize,
+				{
+					Name: "table",
 					In:   "path",
-				}: params.JobName,
+				}: params.Table,
+				{
+					Name: "search",
+					In:   "path",
+				}: params.Search,
+				{
+					Name: "field",
+					In:   "path",
+				}: params.Field,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = TriggerJobParams
-			Response = TriggerJobRes
+			Request  = UpdateLobByMapReq
+			Params   = UpdateLobByMapParams
+			Response = UpdateLobByMapRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12115,14 +17190,14 @@ func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackTriggerJobParams,
+			unpackUpdateLobByMapParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TriggerJob(ctx, params)
+				response, err = s.h.UpdateLobByMap(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TriggerJob(ctx, params)
+		response, err = s.h.UpdateLobByMap(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -12141,7 +17216,7 @@ func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeTriggerJobResponse(response, w, span); err != nil {
+	if err := encodeUpdateLobByMapResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12150,22 +17225,24 @@ func (s *Server) handleTriggerJobRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleUpdateLobByMapRequest handles updateLobByMap operation.
+// handleUpdateRecordsByFieldsRequest handles updateRecordsByFields operation.
 //
-// Set a lob at a specific table record of an field in a Map.
+// Update a record dependent on field(s) of a specific table.
 //
-// PUT /binary/{table}/{field}/{search}
-func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /rest/view/{table}/{search}
+func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateLobByMap"),
+		otelogen.OperationID("updateRecordsByFields"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/binary/{table}/{field}/{search}"),
+		semconv.HTTPRouteKey.String("/rest/view/{table}/{search}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateLobByMapOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateRecordsByFieldsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12186,7 +17263,7 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -12220,15 +17297,15 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateLobByMapOperation,
-			ID:   "updateLobByMap",
+			Name: UpdateRecordsByFieldsOperation,
+			ID:   "updateRecordsByFields",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, UpdateLobByMapOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, UpdateRecordsByFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12246,7 +17323,7 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, UpdateLobByMapOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, UpdateRecordsByFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12264,7 +17341,7 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateLobByMapOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateRecordsByFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12308,7 +17385,7 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeUpdateLobByMapParams(args, argsEscaped, r)
+	params, err := decodeUpdateRecordsByFieldsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12320,7 +17397,7 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateLobByMapRequest(r)
+	request, rawBody, close, err := s.decodeUpdateRecordsByFieldsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -12336,16 +17413,32 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 		}
 	}()
 
-	var response UpdateLobByMapRes
+	var response UpdateRecordsByFieldsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateLobByMapOperation,
+			OperationName:    UpdateRecordsByFieldsOperation,
 			OperationSummary: "",
-			OperationID:      "updateLobByMap",
+			OperationID:      "updateRecordsByFields",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
+				{
+					Name: "dryRun",
+					In:   "query",
+				}: params.DryRun,
+				{
+					Name: "sample",
+					In:   "query",
+				}: params.Sample,
+				{
+					Name: "confirm",
+					In:   "query",
+				}: params.Confirm,
+				{
+					Name: "If-Match",
+					In:   "header",
+				}: params.IfMatch,
 				{
 					Name: "table",
 					In:   "path",
@@ -12354,18 +17447,14 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 					Name: "search",
 					In:   "path",
 				}: params.Search,
-				{
-					Name: "field",
-					In:   "path",
-				}: params.Field,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = UpdateLobByMapReq
-			Params   = UpdateLobByMapParams
-			Response = UpdateLobByMapRes
+			Request  = OptUpdateRecordsByFieldsReq
+			Params   = UpdateRecordsByFieldsParams
+			Response = UpdateRecordsByFieldsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12374,14 +17463,14 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackUpdateLobByMapParams,
+			unpackUpdateRecordsByFieldsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateLobByMap(ctx, request, params)
+				response, err = s.h.UpdateRecordsByFields(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateLobByMap(ctx, request, params)
+		response, err = s.h.UpdateRecordsByFields(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -12400,7 +17489,7 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUpdateLobByMapResponse(response, w, span); err != nil {
+	if err := encodeUpdateRecordsByFieldsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12409,22 +17498,25 @@ func (s *Server) handleUpdateLobByMapRequest(args [3]string, argsEscaped bool, w
 	}
 }
 
-// handleUpdateRecordsByFieldsRequest handles updateRecordsByFields operation.
+// handleUploadChunkRequest handles uploadChunk operation.
 //
-// Update a record dependent on field(s) of a specific table.
+// Append a chunk of data at the offset of a resumable upload. The file is moved to the location if the
+// upload is complete.
 //
-// PUT /rest/view/{table}/{search}
-func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /rest/uploads/{uploadId}
+func (s *Server) handleUploadChunkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateRecordsByFields"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/rest/view/{table}/{search}"),
+		otelogen.OperationID("uploadChunk"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/rest/uploads/{uploadId}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateRecordsByFieldsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UploadChunkOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12445,7 +17537,7 @@ func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -12479,15 +17571,15 @@ func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateRecordsByFieldsOperation,
-			ID:   "updateRecordsByFields",
+			Name: UploadChunkOperation,
+			ID:   "uploadChunk",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBasicAuth(ctx, UpdateRecordsByFieldsOperation, r)
+			sctx, ok, err := s.securityBasicAuth(ctx, UploadChunkOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12505,7 +17597,7 @@ func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityTokenCheck(ctx, UpdateRecordsByFieldsOperation, r)
+			sctx, ok, err := s.securityTokenCheck(ctx, UploadChunkOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12523,7 +17615,7 @@ func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateRecordsByFieldsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UploadChunkOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12567,7 +17659,7 @@ func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeUpdateRecordsByFieldsParams(args, argsEscaped, r)
+	params, err := decodeUploadChunkParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12579,7 +17671,7 @@ func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateRecordsByFieldsRequest(r)
+	request, rawBody, close, err := s.decodeUploadChunkRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -12595,32 +17687,36 @@ func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped
 		}
 	}()
 
-	var response UpdateRecordsByFieldsRes
+	var response UploadChunkRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateRecordsByFieldsOperation,
+			OperationName:    UploadChunkOperation,
 			OperationSummary: "",
-			OperationID:      "updateRecordsByFields",
+			OperationID:      "uploadChunk",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "table",
+					Name: "uploadId",
 					In:   "path",
-				}: params.Table,
+				}: params.UploadId,
 				{
-					Name: "search",
-					In:   "path",
-				}: params.Search,
+					Name: "Upload-Offset",
+					In:   "header",
+				}: params.UploadOffset,
+				{
+					Name: "Upload-Checksum",
+					In:   "header",
+				}: params.UploadChecksum,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptUpdateRecordsByFieldsReq
-			Params   = UpdateRecordsByFieldsParams
-			Response = UpdateRecordsByFieldsRes
+			Request  = UploadChunkReq
+			Params   = UploadChunkParams
+			Response = UploadChunkRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12629,14 +17725,14 @@ func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackUpdateRecordsByFieldsParams,
+			unpackUploadChunkParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateRecordsByFields(ctx, request, params)
+				response, err = s.h.UploadChunk(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateRecordsByFields(ctx, request, params)
+		response, err = s.h.UploadChunk(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -12655,7 +17751,7 @@ func (s *Server) handleUpdateRecordsByFieldsRequest(args [2]string, argsEscaped
 		return
 	}
 
-	if err := encodeUpdateRecordsByFieldsResponse(response, w, span); err != nil {
+	if err := encodeUploadChunkResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12677,6 +17773,8 @@ func (s *Server) handleUploadFileRequest(args [1]string, argsEscaped bool, w htt
 		semconv.HTTPRequestMethodKey.String("POST"),
 		semconv.HTTPRouteKey.String("/rest/file/{path}"),
 	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
 	ctx, span := s.cfg.Tracer.Start(r.Context(), UploadFileOperation,
@@ -12700,7 +17798,7 @@ func (s *Server) handleUploadFileRequest(args [1]string, argsEscaped bool, w htt
 		if code != 0 {
 			codeAttr := semconv.HTTPResponseStatusCode(code)
 			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
+			span.SetAttributes(attrs...)
 		}
 		attrOpt := metric.WithAttributes(attrs...)
 
@@ -5,6 +5,10 @@ type AddViewRes interface {
 	addViewRes()
 }
 
+type AggregateRecordsRes interface {
+	aggregateRecordsRes()
+}
+
 type BatchParameterQueryRes interface {
 	batchParameterQueryRes()
 }
@@ -37,10 +41,18 @@ type CallPostExtendRes interface {
 	callPostExtendRes()
 }
 
+type CancelUploadRes interface {
+	cancelUploadRes()
+}
+
 type CreateDirectoryRes interface {
 	createDirectoryRes()
 }
 
+type CreateUploadRes interface {
+	createUploadRes()
+}
+
 type DeleteExtendRes interface {
 	deleteExtendRes()
 }
@@ -53,6 +65,10 @@ type DeleteJobResultRes interface {
 	deleteJobResultRes()
 }
 
+type DeleteRecordByKeyRes interface {
+	deleteRecordByKeyRes()
+}
+
 type DeleteRecordsSearchedRes interface {
 	deleteRecordsSearchedRes()
 }
@@ -65,6 +81,14 @@ type DownloadFileRes interface {
 	downloadFileRes()
 }
 
+type ExecuteTransactionRes interface {
+	executeTransactionRes()
+}
+
+type GetArchiveRes interface {
+	getArchiveRes()
+}
+
 type GetConfigRes interface {
 	getConfigRes()
 }
@@ -77,6 +101,22 @@ type GetFieldsRes interface {
 	getFieldsRes()
 }
 
+type GetImageCacheStatsRes interface {
+	getImageCacheStatsRes()
+}
+
+type GetImageDuplicatesRes interface {
+	getImageDuplicatesRes()
+}
+
+type GetImageHashRes interface {
+	getImageHashRes()
+}
+
+type GetImageMetadataRes interface {
+	getImageMetadataRes()
+}
+
 type GetImageRes interface {
 	getImageRes()
 }
@@ -121,6 +161,18 @@ type GetMapsRes interface {
 	getMapsRes()
 }
 
+type GetRecordByKeyRes interface {
+	getRecordByKeyRes()
+}
+
+type GetRecordHistoryRes interface {
+	getRecordHistoryRes()
+}
+
+type GetUploadRes interface {
+	getUploadRes()
+}
+
 type GetUserInfoRes interface {
 	getUserInfoRes()
 }
@@ -137,6 +189,18 @@ type GetViewsRes interface {
 	getViewsRes()
 }
 
+type HeadMapRecordsFieldsRes interface {
+	headMapRecordsFieldsRes()
+}
+
+type ImportRecordsReq interface {
+	importRecordsReq()
+}
+
+type ImportRecordsRes interface {
+	importRecordsRes()
+}
+
 type InsertMapFileRecordsRes interface {
 	insertMapFileRecordsRes()
 }
@@ -161,6 +225,10 @@ type LogoutSessionCompatRes interface {
 	logoutSessionCompatRes()
 }
 
+type PatchRecordByKeyRes interface {
+	patchRecordByKeyRes()
+}
+
 type PostDatabaseRes interface {
 	postDatabaseRes()
 }
@@ -173,6 +241,10 @@ type PostJobRes interface {
 	postJobRes()
 }
 
+type PurgeImageCacheRes interface {
+	purgeImageCacheRes()
+}
+
 type PushLoginSessionRes interface {
 	pushLoginSessionRes()
 }
@@ -221,6 +293,10 @@ type TriggerJobRes interface {
 	triggerJobRes()
 }
 
+type UpdateImageHashesRes interface {
+	updateImageHashesRes()
+}
+
 type UpdateLobByMapReq interface {
 	updateLobByMapReq()
 }
@@ -233,6 +309,10 @@ type UpdateRecordsByFieldsRes interface {
 	updateRecordsByFieldsRes()
 }
 
+type UploadChunkRes interface {
+	uploadChunkRes()
+}
+
 type UploadFileRes interface {
 	uploadFileRes()
 }
@@ -3,11 +3,14 @@
 package api
 
 import (
+	"math/bits"
+	"strconv"
 	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
 	"github.com/ogen-go/ogen/json"
+	"github.com/ogen-go/ogen/validate"
 )
 
 // Encode implements json.Marshaler.
@@ -1943,6 +1946,120 @@ func (s *CreateDirectoryNotFound) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes CreateUploadBadRequest as json.
+func (s *CreateUploadBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateUploadBadRequest from json.
+func (s *CreateUploadBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateUploadBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateUploadBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateUploadBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateUploadBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateUploadConflict as json.
+func (s *CreateUploadConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateUploadConflict from json.
+func (s *CreateUploadConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateUploadConflict to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateUploadConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateUploadConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateUploadConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateUploadNotFound as json.
+func (s *CreateUploadNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateUploadNotFound from json.
+func (s *CreateUploadNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateUploadNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateUploadNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateUploadNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateUploadNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *Database) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -2569,6 +2686,82 @@ func (s *DeleteJobResultNotFound) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes DeleteRecordByKeyBadRequest as json.
+func (s *DeleteRecordByKeyBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteRecordByKeyBadRequest from json.
+func (s *DeleteRecordByKeyBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteRecordByKeyBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteRecordByKeyBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteRecordByKeyBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteRecordByKeyBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteRecordByKeyNotFound as json.
+func (s *DeleteRecordByKeyNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteRecordByKeyNotFound from json.
+func (s *DeleteRecordByKeyNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteRecordByKeyNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteRecordByKeyNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteRecordByKeyNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteRecordByKeyNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *Directories) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -2974,13 +3167,24 @@ func (s *Error) encodeFields(e *jx.Encoder) {
 			s.Error.Encode(e)
 		}
 	}
+	{
+		if s.Details != nil {
+			e.FieldStart("details")
+			e.ArrStart()
+			for _, elem := range s.Details {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
 }
 
-var jsonFieldsNameOfError = [4]string{
+var jsonFieldsNameOfError = [5]string{
 	0: "code",
 	1: "message",
 	2: "target",
 	3: "Error",
+	4: "details",
 }
 
 // Decode decodes Error from json.
@@ -3031,6 +3235,23 @@ func (s *Error) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"Error\"")
 			}
+		case "details":
+			if err := func() error {
+				s.Details = make([]ErrorDetail, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ErrorDetail
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Details = append(s.Details, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"details\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -3055,6 +3276,86 @@ func (s *Error) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *ErrorDetail) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ErrorDetail) encodeFields(e *jx.Encoder) {
+	{
+		if s.Pointer.Set {
+			e.FieldStart("pointer")
+			s.Pointer.Encode(e)
+		}
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfErrorDetail = [2]string{
+	0: "pointer",
+	1: "message",
+}
+
+// Decode decodes ErrorDetail from json.
+func (s *ErrorDetail) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ErrorDetail to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "pointer":
+			if err := func() error {
+				s.Pointer.Reset()
+				if err := s.Pointer.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pointer\"")
+			}
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ErrorDetail")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ErrorDetail) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ErrorDetail) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *ErrorError) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -3318,330 +3619,229 @@ func (s *Executions) UnmarshalJSON(data []byte) error {
 }
 
 // Encode implements json.Marshaler.
-func (s *FieldItem) Encode(e *jx.Encoder) {
+func (s *File) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *FieldItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Level.Set {
-			e.FieldStart("level")
-			s.Level.Encode(e)
-		}
-	}
+func (s *File) encodeFields(e *jx.Encoder) {
 	{
-		if s.Value.Set {
-			e.FieldStart("value")
-			s.Value.Encode(e)
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
 		}
 	}
 	{
-		if s.Format.Set {
-			e.FieldStart("format")
-			s.Format.Encode(e)
+		if s.Type.Set {
+			e.FieldStart("Type")
+			s.Type.Encode(e)
 		}
 	}
 	{
-		if s.Length.Set {
-			e.FieldStart("length")
-			s.Length.Encode(e)
+		if s.Modified.Set {
+			e.FieldStart("Modified")
+			s.Modified.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.Options.Set {
-			e.FieldStart("options")
-			s.Options.Encode(e)
-		}
-	}
-	{
-		if s.Identifier.Set {
-			e.FieldStart("identifier")
-			s.Identifier.Encode(e)
-		}
-	}
-	{
-		if s.Label.Set {
-			e.FieldStart("label")
-			s.Label.Encode(e)
+		if s.Size.Set {
+			e.FieldStart("Size")
+			s.Size.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfFieldItem = [7]string{
-	0: "level",
-	1: "value",
-	2: "format",
-	3: "length",
-	4: "options",
-	5: "identifier",
-	6: "label",
+var jsonFieldsNameOfFile = [4]string{
+	0: "Name",
+	1: "Type",
+	2: "Modified",
+	3: "Size",
 }
 
-// Decode decodes FieldItem from json.
-func (s *FieldItem) Decode(d *jx.Decoder) error {
+// Decode decodes File from json.
+func (s *File) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode FieldItem to nil")
+		return errors.New("invalid: unable to decode File to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "level":
-			if err := func() error {
-				s.Level.Reset()
-				if err := s.Level.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"level\"")
-			}
-		case "value":
-			if err := func() error {
-				s.Value.Reset()
-				if err := s.Value.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"value\"")
-			}
-		case "format":
-			if err := func() error {
-				s.Format.Reset()
-				if err := s.Format.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		case "length":
+		case "Name":
 			if err := func() error {
-				s.Length.Reset()
-				if err := s.Length.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"length\"")
+				return errors.Wrap(err, "decode field \"Name\"")
 			}
-		case "options":
+		case "Type":
 			if err := func() error {
-				s.Options.Reset()
-				if err := s.Options.Decode(d); err != nil {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"options\"")
+				return errors.Wrap(err, "decode field \"Type\"")
 			}
-		case "identifier":
+		case "Modified":
 			if err := func() error {
-				s.Identifier.Reset()
-				if err := s.Identifier.Decode(d); err != nil {
+				s.Modified.Reset()
+				if err := s.Modified.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"identifier\"")
+				return errors.Wrap(err, "decode field \"Modified\"")
 			}
-		case "label":
+		case "Size":
 			if err := func() error {
-				s.Label.Reset()
-				if err := s.Label.Decode(d); err != nil {
+				s.Size.Reset()
+				if err := s.Size.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"label\"")
+				return errors.Wrap(err, "decode field \"Size\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode FieldItem")
+		return errors.Wrap(err, "decode File")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *FieldItem) MarshalJSON() ([]byte, error) {
+func (s *File) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *FieldItem) UnmarshalJSON(data []byte) error {
+func (s *File) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes Fields as json.
-func (s Fields) Encode(e *jx.Encoder) {
-	unwrapped := []FieldItem(s)
+// Encode encodes GetArchiveBadRequest as json.
+func (s *GetArchiveBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+	unwrapped.Encode(e)
 }
 
-// Decode decodes Fields from json.
-func (s *Fields) Decode(d *jx.Decoder) error {
+// Decode decodes GetArchiveBadRequest from json.
+func (s *GetArchiveBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Fields to nil")
+		return errors.New("invalid: unable to decode GetArchiveBadRequest to nil")
 	}
-	var unwrapped []FieldItem
+	var unwrapped Error
 	if err := func() error {
-		unwrapped = make([]FieldItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem FieldItem
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
+		if err := unwrapped.Decode(d); err != nil {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = Fields(unwrapped)
+	*s = GetArchiveBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s Fields) MarshalJSON() ([]byte, error) {
+func (s *GetArchiveBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Fields) UnmarshalJSON(data []byte) error {
+func (s *GetArchiveBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *File) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetArchiveNotFound as json.
+func (s *GetArchiveNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *File) encodeFields(e *jx.Encoder) {
-	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
-		}
-	}
-	{
-		if s.Type.Set {
-			e.FieldStart("Type")
-			s.Type.Encode(e)
-		}
-	}
-	{
-		if s.Modified.Set {
-			e.FieldStart("Modified")
-			s.Modified.Encode(e, json.EncodeDateTime)
-		}
+// Decode decodes GetArchiveNotFound from json.
+func (s *GetArchiveNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetArchiveNotFound to nil")
 	}
-	{
-		if s.Size.Set {
-			e.FieldStart("Size")
-			s.Size.Encode(e)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetArchiveNotFound(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfFile = [4]string{
-	0: "Name",
-	1: "Type",
-	2: "Modified",
-	3: "Size",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetArchiveNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes File from json.
-func (s *File) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetArchiveNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetArchiveRequestEntityTooLarge as json.
+func (s *GetArchiveRequestEntityTooLarge) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetArchiveRequestEntityTooLarge from json.
+func (s *GetArchiveRequestEntityTooLarge) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode File to nil")
+		return errors.New("invalid: unable to decode GetArchiveRequestEntityTooLarge to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "Name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
-			}
-		case "Type":
-			if err := func() error {
-				s.Type.Reset()
-				if err := s.Type.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Type\"")
-			}
-		case "Modified":
-			if err := func() error {
-				s.Modified.Reset()
-				if err := s.Modified.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Modified\"")
-			}
-		case "Size":
-			if err := func() error {
-				s.Size.Reset()
-				if err := s.Size.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Size\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode File")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetArchiveRequestEntityTooLarge(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *File) MarshalJSON() ([]byte, error) {
+func (s *GetArchiveRequestEntityTooLarge) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *File) UnmarshalJSON(data []byte) error {
+func (s *GetArchiveRequestEntityTooLarge) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -3722,17 +3922,17 @@ func (s *GetFieldsNotFound) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
-// Encode encodes GetJobExecutionResultBadRequest as json.
-func (s *GetJobExecutionResultBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetImageDuplicatesBadRequest as json.
+func (s *GetImageDuplicatesBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetJobExecutionResultBadRequest from json.
-func (s *GetJobExecutionResultBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetImageDuplicatesBadRequest from json.
+func (s *GetImageDuplicatesBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetJobExecutionResultBadRequest to nil")
+		return errors.New("invalid: unable to decode GetImageDuplicatesBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -3743,34 +3943,34 @@ func (s *GetJobExecutionResultBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetJobExecutionResultBadRequest(unwrapped)
+	*s = GetImageDuplicatesBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetJobExecutionResultBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetImageDuplicatesBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetJobExecutionResultBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetImageDuplicatesBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetJobExecutionResultNotFound as json.
-func (s *GetJobExecutionResultNotFound) Encode(e *jx.Encoder) {
+// Encode encodes GetImageDuplicatesRequestEntityTooLarge as json.
+func (s *GetImageDuplicatesRequestEntityTooLarge) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetJobExecutionResultNotFound from json.
-func (s *GetJobExecutionResultNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes GetImageDuplicatesRequestEntityTooLarge from json.
+func (s *GetImageDuplicatesRequestEntityTooLarge) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetJobExecutionResultNotFound to nil")
+		return errors.New("invalid: unable to decode GetImageDuplicatesRequestEntityTooLarge to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -3781,34 +3981,34 @@ func (s *GetJobExecutionResultNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetJobExecutionResultNotFound(unwrapped)
+	*s = GetImageDuplicatesRequestEntityTooLarge(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetJobExecutionResultNotFound) MarshalJSON() ([]byte, error) {
+func (s *GetImageDuplicatesRequestEntityTooLarge) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetJobExecutionResultNotFound) UnmarshalJSON(data []byte) error {
+func (s *GetImageDuplicatesRequestEntityTooLarge) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetJobFullInfoBadRequest as json.
-func (s *GetJobFullInfoBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetImageHashBadRequest as json.
+func (s *GetImageHashBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetJobFullInfoBadRequest from json.
-func (s *GetJobFullInfoBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetImageHashBadRequest from json.
+func (s *GetImageHashBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetJobFullInfoBadRequest to nil")
+		return errors.New("invalid: unable to decode GetImageHashBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -3819,34 +4019,34 @@ func (s *GetJobFullInfoBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetJobFullInfoBadRequest(unwrapped)
+	*s = GetImageHashBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetJobFullInfoBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetImageHashBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetJobFullInfoBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetImageHashBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetJobFullInfoNotFound as json.
-func (s *GetJobFullInfoNotFound) Encode(e *jx.Encoder) {
+// Encode encodes GetImageHashNotFound as json.
+func (s *GetImageHashNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetJobFullInfoNotFound from json.
-func (s *GetJobFullInfoNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes GetImageHashNotFound from json.
+func (s *GetImageHashNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetJobFullInfoNotFound to nil")
+		return errors.New("invalid: unable to decode GetImageHashNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -3857,34 +4057,34 @@ func (s *GetJobFullInfoNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetJobFullInfoNotFound(unwrapped)
+	*s = GetImageHashNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetJobFullInfoNotFound) MarshalJSON() ([]byte, error) {
+func (s *GetImageHashNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetJobFullInfoNotFound) UnmarshalJSON(data []byte) error {
+func (s *GetImageHashNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetJobResultBadRequest as json.
-func (s *GetJobResultBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetImageMetadataBadRequest as json.
+func (s *GetImageMetadataBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetJobResultBadRequest from json.
-func (s *GetJobResultBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetImageMetadataBadRequest from json.
+func (s *GetImageMetadataBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetJobResultBadRequest to nil")
+		return errors.New("invalid: unable to decode GetImageMetadataBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -3895,34 +4095,34 @@ func (s *GetJobResultBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetJobResultBadRequest(unwrapped)
+	*s = GetImageMetadataBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetJobResultBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetImageMetadataBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetJobResultBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetImageMetadataBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetJobResultNotFound as json.
-func (s *GetJobResultNotFound) Encode(e *jx.Encoder) {
+// Encode encodes GetImageMetadataNotFound as json.
+func (s *GetImageMetadataNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetJobResultNotFound from json.
-func (s *GetJobResultNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes GetImageMetadataNotFound from json.
+func (s *GetImageMetadataNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetJobResultNotFound to nil")
+		return errors.New("invalid: unable to decode GetImageMetadataNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -3933,34 +4133,34 @@ func (s *GetJobResultNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetJobResultNotFound(unwrapped)
+	*s = GetImageMetadataNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetJobResultNotFound) MarshalJSON() ([]byte, error) {
+func (s *GetImageMetadataNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetJobResultNotFound) UnmarshalJSON(data []byte) error {
+func (s *GetImageMetadataNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes InsertMapFileRecordsBadRequest as json.
-func (s *InsertMapFileRecordsBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetJobExecutionResultBadRequest as json.
+func (s *GetJobExecutionResultBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes InsertMapFileRecordsBadRequest from json.
-func (s *InsertMapFileRecordsBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetJobExecutionResultBadRequest from json.
+func (s *GetJobExecutionResultBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode InsertMapFileRecordsBadRequest to nil")
+		return errors.New("invalid: unable to decode GetJobExecutionResultBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -3971,34 +4171,34 @@ func (s *InsertMapFileRecordsBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = InsertMapFileRecordsBadRequest(unwrapped)
+	*s = GetJobExecutionResultBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *InsertMapFileRecordsBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetJobExecutionResultBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *InsertMapFileRecordsBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetJobExecutionResultBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes InsertMapFileRecordsNotFound as json.
-func (s *InsertMapFileRecordsNotFound) Encode(e *jx.Encoder) {
+// Encode encodes GetJobExecutionResultNotFound as json.
+func (s *GetJobExecutionResultNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes InsertMapFileRecordsNotFound from json.
-func (s *InsertMapFileRecordsNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode InsertMapFileRecordsNotFound to nil")
+// Decode decodes GetJobExecutionResultNotFound from json.
+func (s *GetJobExecutionResultNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetJobExecutionResultNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4009,3082 +4209,4818 @@ func (s *InsertMapFileRecordsNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = InsertMapFileRecordsNotFound(unwrapped)
+	*s = GetJobExecutionResultNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *InsertMapFileRecordsNotFound) MarshalJSON() ([]byte, error) {
+func (s *GetJobExecutionResultNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *InsertMapFileRecordsNotFound) UnmarshalJSON(data []byte) error {
+func (s *GetJobExecutionResultNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *InsertRecordReq) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetJobFullInfoBadRequest as json.
+func (s *GetJobFullInfoBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *InsertRecordReq) encodeFields(e *jx.Encoder) {
-	{
-		if s.Records != nil {
-			e.FieldStart("Records")
-			e.ArrStart()
-			for _, elem := range s.Records {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+// Decode decodes GetJobFullInfoBadRequest from json.
+func (s *GetJobFullInfoBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetJobFullInfoBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetJobFullInfoBadRequest(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfInsertRecordReq = [1]string{
-	0: "Records",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetJobFullInfoBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes InsertRecordReq from json.
-func (s *InsertRecordReq) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetJobFullInfoBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetJobFullInfoNotFound as json.
+func (s *GetJobFullInfoNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetJobFullInfoNotFound from json.
+func (s *GetJobFullInfoNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode InsertRecordReq to nil")
+		return errors.New("invalid: unable to decode GetJobFullInfoNotFound to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "Records":
-			if err := func() error {
-				s.Records = make([]InsertRecordReqRecordsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem InsertRecordReqRecordsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Records = append(s.Records, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Records\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode InsertRecordReq")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetJobFullInfoNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *InsertRecordReq) MarshalJSON() ([]byte, error) {
+func (s *GetJobFullInfoNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *InsertRecordReq) UnmarshalJSON(data []byte) error {
+func (s *GetJobFullInfoNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s InsertRecordReqRecordsItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetJobResultBadRequest as json.
+func (s *GetJobResultBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields implements json.Marshaler.
-func (s InsertRecordReqRecordsItem) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
+// Decode decodes GetJobResultBadRequest from json.
+func (s *GetJobResultBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetJobResultBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetJobResultBadRequest(unwrapped)
+	return nil
+}
 
-		if len(elem) != 0 {
-			e.Raw(elem)
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetJobResultBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetJobResultBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetJobResultNotFound as json.
+func (s *GetJobResultNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetJobResultNotFound from json.
+func (s *GetJobResultNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetJobResultNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetJobResultNotFound(unwrapped)
+	return nil
 }
 
-// Decode decodes InsertRecordReqRecordsItem from json.
-func (s *InsertRecordReqRecordsItem) Decode(d *jx.Decoder) error {
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetJobResultNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetJobResultNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetRecordByKeyBadRequest as json.
+func (s *GetRecordByKeyBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetRecordByKeyBadRequest from json.
+func (s *GetRecordByKeyBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode InsertRecordReqRecordsItem to nil")
+		return errors.New("invalid: unable to decode GetRecordByKeyBadRequest to nil")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
-		m[string(k)] = elem
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode InsertRecordReqRecordsItem")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetRecordByKeyBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetRecordByKeyBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
 
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetRecordByKeyBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetRecordByKeyNotFound as json.
+func (s *GetRecordByKeyNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetRecordByKeyNotFound from json.
+func (s *GetRecordByKeyNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetRecordByKeyNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetRecordByKeyNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s InsertRecordReqRecordsItem) MarshalJSON() ([]byte, error) {
+func (s *GetRecordByKeyNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *InsertRecordReqRecordsItem) UnmarshalJSON(data []byte) error {
+func (s *GetRecordByKeyNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Job) Encode(e *jx.Encoder) {
+func (s *ImageCacheStats) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Job) encodeFields(e *jx.Encoder) {
+func (s *ImageCacheStats) encodeFields(e *jx.Encoder) {
 	{
-		if s.Description.Set {
-			e.FieldStart("Description")
-			s.Description.Encode(e)
+		if s.Enabled.Set {
+			e.FieldStart("Enabled")
+			s.Enabled.Encode(e)
 		}
 	}
 	{
-		if s.Environments != nil {
-			e.FieldStart("Environments")
-			e.ArrStart()
-			for _, elem := range s.Environments {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Storage.Set {
+			e.FieldStart("Storage")
+			s.Storage.Encode(e)
 		}
 	}
 	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
+		if s.Entries.Set {
+			e.FieldStart("Entries")
+			s.Entries.Encode(e)
 		}
 	}
 	{
-		if s.Parameters != nil {
-			e.FieldStart("Parameters")
-			e.ArrStart()
-			for _, elem := range s.Parameters {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Size.Set {
+			e.FieldStart("Size")
+			s.Size.Encode(e)
 		}
 	}
 	{
-		if s.Script.Set {
-			e.FieldStart("Script")
-			s.Script.Encode(e)
+		if s.MaxSize.Set {
+			e.FieldStart("MaxSize")
+			s.MaxSize.Encode(e)
 		}
 	}
 	{
-		if s.User.Set {
-			e.FieldStart("User")
-			s.User.Encode(e)
+		if s.Hits.Set {
+			e.FieldStart("Hits")
+			s.Hits.Encode(e)
 		}
 	}
 	{
-		if s.Utility.Set {
-			e.FieldStart("Utility")
-			s.Utility.Encode(e)
+		if s.Misses.Set {
+			e.FieldStart("Misses")
+			s.Misses.Encode(e)
 		}
 	}
 	{
-		if s.CronSchedule.Set {
-			e.FieldStart("CronSchedule")
-			s.CronSchedule.Encode(e)
+		if s.Evictions.Set {
+			e.FieldStart("Evictions")
+			s.Evictions.Encode(e)
+		}
+	}
+	{
+		if s.Invalidations.Set {
+			e.FieldStart("Invalidations")
+			s.Invalidations.Encode(e)
+		}
+	}
+	{
+		if s.Purged.Set {
+			e.FieldStart("Purged")
+			s.Purged.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfJob = [8]string{
-	0: "Description",
-	1: "Environments",
-	2: "Name",
-	3: "Parameters",
-	4: "Script",
-	5: "User",
-	6: "Utility",
-	7: "CronSchedule",
+var jsonFieldsNameOfImageCacheStats = [10]string{
+	0: "Enabled",
+	1: "Storage",
+	2: "Entries",
+	3: "Size",
+	4: "MaxSize",
+	5: "Hits",
+	6: "Misses",
+	7: "Evictions",
+	8: "Invalidations",
+	9: "Purged",
 }
 
-// Decode decodes Job from json.
-func (s *Job) Decode(d *jx.Decoder) error {
+// Decode decodes ImageCacheStats from json.
+func (s *ImageCacheStats) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Job to nil")
+		return errors.New("invalid: unable to decode ImageCacheStats to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Description":
+		case "Enabled":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.Enabled.Reset()
+				if err := s.Enabled.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
+				return errors.Wrap(err, "decode field \"Enabled\"")
 			}
-		case "Environments":
+		case "Storage":
 			if err := func() error {
-				s.Environments = make([]JobEnvironmentsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem JobEnvironmentsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Environments = append(s.Environments, elem)
-					return nil
-				}); err != nil {
+				s.Storage.Reset()
+				if err := s.Storage.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Environments\"")
+				return errors.Wrap(err, "decode field \"Storage\"")
 			}
-		case "Name":
+		case "Entries":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Entries.Reset()
+				if err := s.Entries.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
+				return errors.Wrap(err, "decode field \"Entries\"")
 			}
-		case "Parameters":
+		case "Size":
 			if err := func() error {
-				s.Parameters = make([]JobParametersItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem JobParametersItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Parameters = append(s.Parameters, elem)
-					return nil
-				}); err != nil {
+				s.Size.Reset()
+				if err := s.Size.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Parameters\"")
+				return errors.Wrap(err, "decode field \"Size\"")
 			}
-		case "Script":
+		case "MaxSize":
 			if err := func() error {
-				s.Script.Reset()
-				if err := s.Script.Decode(d); err != nil {
+				s.MaxSize.Reset()
+				if err := s.MaxSize.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Script\"")
+				return errors.Wrap(err, "decode field \"MaxSize\"")
 			}
-		case "User":
+		case "Hits":
 			if err := func() error {
-				s.User.Reset()
-				if err := s.User.Decode(d); err != nil {
+				s.Hits.Reset()
+				if err := s.Hits.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"User\"")
+				return errors.Wrap(err, "decode field \"Hits\"")
 			}
-		case "Utility":
+		case "Misses":
 			if err := func() error {
-				s.Utility.Reset()
-				if err := s.Utility.Decode(d); err != nil {
+				s.Misses.Reset()
+				if err := s.Misses.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Utility\"")
+				return errors.Wrap(err, "decode field \"Misses\"")
 			}
-		case "CronSchedule":
+		case "Evictions":
 			if err := func() error {
-				s.CronSchedule.Reset()
-				if err := s.CronSchedule.Decode(d); err != nil {
+				s.Evictions.Reset()
+				if err := s.Evictions.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CronSchedule\"")
+				return errors.Wrap(err, "decode field \"Evictions\"")
+			}
+		case "Invalidations":
+			if err := func() error {
+				s.Invalidations.Reset()
+				if err := s.Invalidations.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Invalidations\"")
+			}
+		case "Purged":
+			if err := func() error {
+				s.Purged.Reset()
+				if err := s.Purged.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Purged\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Job")
+		return errors.Wrap(err, "decode ImageCacheStats")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Job) MarshalJSON() ([]byte, error) {
+func (s *ImageCacheStats) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Job) UnmarshalJSON(data []byte) error {
+func (s *ImageCacheStats) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobDefinition) Encode(e *jx.Encoder) {
+func (s *ImageDuplicateGroup) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobDefinition) encodeFields(e *jx.Encoder) {
+func (s *ImageDuplicateGroup) encodeFields(e *jx.Encoder) {
 	{
-		if s.Executions != nil {
-			e.FieldStart("Executions")
+		if s.Records != nil {
+			e.FieldStart("Records")
 			e.ArrStart()
-			for _, elem := range s.Executions {
+			for _, elem := range s.Records {
 				elem.Encode(e)
 			}
 			e.ArrEnd()
 		}
 	}
-	{
-		if s.Job.Set {
-			e.FieldStart("Job")
-			s.Job.Encode(e)
-		}
-	}
-	{
-		if s.Status.Set {
-			e.FieldStart("Status")
-			s.Status.Encode(e)
-		}
-	}
 }
 
-var jsonFieldsNameOfJobDefinition = [3]string{
-	0: "Executions",
-	1: "Job",
-	2: "Status",
+var jsonFieldsNameOfImageDuplicateGroup = [1]string{
+	0: "Records",
 }
 
-// Decode decodes JobDefinition from json.
-func (s *JobDefinition) Decode(d *jx.Decoder) error {
+// Decode decodes ImageDuplicateGroup from json.
+func (s *ImageDuplicateGroup) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobDefinition to nil")
+		return errors.New("invalid: unable to decode ImageDuplicateGroup to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Executions":
+		case "Records":
 			if err := func() error {
-				s.Executions = make([]Executions, 0)
+				s.Records = make([]ImageDuplicateRecord, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Executions
+					var elem ImageDuplicateRecord
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Executions = append(s.Executions, elem)
+					s.Records = append(s.Records, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Executions\"")
-			}
-		case "Job":
-			if err := func() error {
-				s.Job.Reset()
-				if err := s.Job.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Job\"")
-			}
-		case "Status":
-			if err := func() error {
-				s.Status.Reset()
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Status\"")
+				return errors.Wrap(err, "decode field \"Records\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobDefinition")
+		return errors.Wrap(err, "decode ImageDuplicateGroup")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobDefinition) MarshalJSON() ([]byte, error) {
+func (s *ImageDuplicateGroup) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobDefinition) UnmarshalJSON(data []byte) error {
+func (s *ImageDuplicateGroup) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobDescription) Encode(e *jx.Encoder) {
+func (s *ImageDuplicateRecord) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobDescription) encodeFields(e *jx.Encoder) {
-	{
-		if s.CronSchedule.Set {
-			e.FieldStart("CronSchedule")
-			s.CronSchedule.Encode(e)
-		}
-	}
-	{
-		if s.Description.Set {
-			e.FieldStart("Description")
-			s.Description.Encode(e)
-		}
-	}
-	{
-		if s.Environments != nil {
-			e.FieldStart("Environments")
-			e.ArrStart()
-			for _, elem := range s.Environments {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
-		}
-	}
-	{
-		if s.Parameters != nil {
-			e.FieldStart("Parameters")
-			e.ArrStart()
-			for _, elem := range s.Parameters {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
+func (s *ImageDuplicateRecord) encodeFields(e *jx.Encoder) {
 	{
-		if s.Script.Set {
-			e.FieldStart("Script")
-			s.Script.Encode(e)
+		if s.Key.Set {
+			e.FieldStart("Key")
+			s.Key.Encode(e)
 		}
 	}
 	{
-		if s.User.Set {
-			e.FieldStart("User")
-			s.User.Encode(e)
+		if s.Hash.Set {
+			e.FieldStart("Hash")
+			s.Hash.Encode(e)
 		}
 	}
 	{
-		if s.Utility.Set {
-			e.FieldStart("Utility")
-			s.Utility.Encode(e)
+		if s.Distance.Set {
+			e.FieldStart("Distance")
+			s.Distance.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfJobDescription = [8]string{
-	0: "CronSchedule",
-	1: "Description",
-	2: "Environments",
-	3: "Name",
-	4: "Parameters",
-	5: "Script",
-	6: "User",
-	7: "Utility",
+var jsonFieldsNameOfImageDuplicateRecord = [3]string{
+	0: "Key",
+	1: "Hash",
+	2: "Distance",
 }
 
-// Decode decodes JobDescription from json.
-func (s *JobDescription) Decode(d *jx.Decoder) error {
+// Decode decodes ImageDuplicateRecord from json.
+func (s *ImageDuplicateRecord) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobDescription to nil")
+		return errors.New("invalid: unable to decode ImageDuplicateRecord to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "CronSchedule":
+		case "Key":
 			if err := func() error {
-				s.CronSchedule.Reset()
-				if err := s.CronSchedule.Decode(d); err != nil {
+				s.Key.Reset()
+				if err := s.Key.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CronSchedule\"")
+				return errors.Wrap(err, "decode field \"Key\"")
 			}
-		case "Description":
+		case "Hash":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.Hash.Reset()
+				if err := s.Hash.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
+				return errors.Wrap(err, "decode field \"Hash\"")
 			}
-		case "Environments":
+		case "Distance":
 			if err := func() error {
-				s.Environments = make([]JobDescriptionEnvironmentsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem JobDescriptionEnvironmentsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Environments = append(s.Environments, elem)
-					return nil
-				}); err != nil {
+				s.Distance.Reset()
+				if err := s.Distance.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Environments\"")
-			}
-		case "Name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
-			}
-		case "Parameters":
-			if err := func() error {
-				s.Parameters = make([]JobDescriptionParametersItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem JobDescriptionParametersItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Parameters = append(s.Parameters, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Parameters\"")
-			}
-		case "Script":
-			if err := func() error {
-				s.Script.Reset()
-				if err := s.Script.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Script\"")
-			}
-		case "User":
-			if err := func() error {
-				s.User.Reset()
-				if err := s.User.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"User\"")
-			}
-		case "Utility":
-			if err := func() error {
-				s.Utility.Reset()
-				if err := s.Utility.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Utility\"")
+				return errors.Wrap(err, "decode field \"Distance\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobDescription")
+		return errors.Wrap(err, "decode ImageDuplicateRecord")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobDescription) MarshalJSON() ([]byte, error) {
+func (s *ImageDuplicateRecord) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobDescription) UnmarshalJSON(data []byte) error {
+func (s *ImageDuplicateRecord) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobDescriptionEnvironmentsItem) Encode(e *jx.Encoder) {
+func (s *ImageDuplicates) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobDescriptionEnvironmentsItem) encodeFields(e *jx.Encoder) {
+func (s *ImageDuplicates) encodeFields(e *jx.Encoder) {
 	{
-		if s.Parameter.Set {
-			e.FieldStart("Parameter")
-			s.Parameter.Encode(e)
+		if s.Table.Set {
+			e.FieldStart("Table")
+			s.Table.Encode(e)
+		}
+	}
+	{
+		if s.Distance.Set {
+			e.FieldStart("Distance")
+			s.Distance.Encode(e)
+		}
+	}
+	{
+		if s.Groups != nil {
+			e.FieldStart("Groups")
+			e.ArrStart()
+			for _, elem := range s.Groups {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfJobDescriptionEnvironmentsItem = [1]string{
-	0: "Parameter",
+var jsonFieldsNameOfImageDuplicates = [3]string{
+	0: "Table",
+	1: "Distance",
+	2: "Groups",
 }
 
-// Decode decodes JobDescriptionEnvironmentsItem from json.
-func (s *JobDescriptionEnvironmentsItem) Decode(d *jx.Decoder) error {
+// Decode decodes ImageDuplicates from json.
+func (s *ImageDuplicates) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobDescriptionEnvironmentsItem to nil")
+		return errors.New("invalid: unable to decode ImageDuplicates to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Parameter":
+		case "Table":
 			if err := func() error {
-				s.Parameter.Reset()
-				if err := s.Parameter.Decode(d); err != nil {
+				s.Table.Reset()
+				if err := s.Table.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Parameter\"")
+				return errors.Wrap(err, "decode field \"Table\"")
+			}
+		case "Distance":
+			if err := func() error {
+				s.Distance.Reset()
+				if err := s.Distance.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Distance\"")
+			}
+		case "Groups":
+			if err := func() error {
+				s.Groups = make([]ImageDuplicateGroup, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ImageDuplicateGroup
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Groups = append(s.Groups, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Groups\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobDescriptionEnvironmentsItem")
+		return errors.Wrap(err, "decode ImageDuplicates")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobDescriptionEnvironmentsItem) MarshalJSON() ([]byte, error) {
+func (s *ImageDuplicates) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobDescriptionEnvironmentsItem) UnmarshalJSON(data []byte) error {
+func (s *ImageDuplicates) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobDescriptionParametersItem) Encode(e *jx.Encoder) {
+func (s *ImageGPS) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobDescriptionParametersItem) encodeFields(e *jx.Encoder) {
+func (s *ImageGPS) encodeFields(e *jx.Encoder) {
 	{
-		if s.Parameter.Set {
-			e.FieldStart("Parameter")
-			s.Parameter.Encode(e)
+		if s.Latitude.Set {
+			e.FieldStart("Latitude")
+			s.Latitude.Encode(e)
+		}
+	}
+	{
+		if s.Longitude.Set {
+			e.FieldStart("Longitude")
+			s.Longitude.Encode(e)
+		}
+	}
+	{
+		if s.Altitude.Set {
+			e.FieldStart("Altitude")
+			s.Altitude.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfJobDescriptionParametersItem = [1]string{
-	0: "Parameter",
+var jsonFieldsNameOfImageGPS = [3]string{
+	0: "Latitude",
+	1: "Longitude",
+	2: "Altitude",
 }
 
-// Decode decodes JobDescriptionParametersItem from json.
-func (s *JobDescriptionParametersItem) Decode(d *jx.Decoder) error {
+// Decode decodes ImageGPS from json.
+func (s *ImageGPS) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobDescriptionParametersItem to nil")
+		return errors.New("invalid: unable to decode ImageGPS to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Parameter":
+		case "Latitude":
 			if err := func() error {
-				s.Parameter.Reset()
-				if err := s.Parameter.Decode(d); err != nil {
+				s.Latitude.Reset()
+				if err := s.Latitude.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Parameter\"")
+				return errors.Wrap(err, "decode field \"Latitude\"")
+			}
+		case "Longitude":
+			if err := func() error {
+				s.Longitude.Reset()
+				if err := s.Longitude.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Longitude\"")
+			}
+		case "Altitude":
+			if err := func() error {
+				s.Altitude.Reset()
+				if err := s.Altitude.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Altitude\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobDescriptionParametersItem")
+		return errors.Wrap(err, "decode ImageGPS")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobDescriptionParametersItem) MarshalJSON() ([]byte, error) {
+func (s *ImageGPS) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobDescriptionParametersItem) UnmarshalJSON(data []byte) error {
+func (s *ImageGPS) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobEnvironmentsItem) Encode(e *jx.Encoder) {
+func (s *ImageHash) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobEnvironmentsItem) encodeFields(e *jx.Encoder) {
+func (s *ImageHash) encodeFields(e *jx.Encoder) {
 	{
-		if s.Parameter.Set {
-			e.FieldStart("Parameter")
-			s.Parameter.Encode(e)
+		if s.Algorithm.Set {
+			e.FieldStart("Algorithm")
+			s.Algorithm.Encode(e)
+		}
+	}
+	{
+		if s.Hash.Set {
+			e.FieldStart("Hash")
+			s.Hash.Encode(e)
+		}
+	}
+	{
+		if s.Width.Set {
+			e.FieldStart("Width")
+			s.Width.Encode(e)
+		}
+	}
+	{
+		if s.Height.Set {
+			e.FieldStart("Height")
+			s.Height.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfJobEnvironmentsItem = [1]string{
-	0: "Parameter",
+var jsonFieldsNameOfImageHash = [4]string{
+	0: "Algorithm",
+	1: "Hash",
+	2: "Width",
+	3: "Height",
 }
 
-// Decode decodes JobEnvironmentsItem from json.
-func (s *JobEnvironmentsItem) Decode(d *jx.Decoder) error {
+// Decode decodes ImageHash from json.
+func (s *ImageHash) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobEnvironmentsItem to nil")
+		return errors.New("invalid: unable to decode ImageHash to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Parameter":
+		case "Algorithm":
 			if err := func() error {
-				s.Parameter.Reset()
-				if err := s.Parameter.Decode(d); err != nil {
+				s.Algorithm.Reset()
+				if err := s.Algorithm.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Parameter\"")
+				return errors.Wrap(err, "decode field \"Algorithm\"")
+			}
+		case "Hash":
+			if err := func() error {
+				s.Hash.Reset()
+				if err := s.Hash.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Hash\"")
+			}
+		case "Width":
+			if err := func() error {
+				s.Width.Reset()
+				if err := s.Width.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Width\"")
+			}
+		case "Height":
+			if err := func() error {
+				s.Height.Reset()
+				if err := s.Height.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Height\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobEnvironmentsItem")
+		return errors.Wrap(err, "decode ImageHash")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobEnvironmentsItem) MarshalJSON() ([]byte, error) {
+func (s *ImageHash) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobEnvironmentsItem) UnmarshalJSON(data []byte) error {
+func (s *ImageHash) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobFull) Encode(e *jx.Encoder) {
+func (s *ImageHashReport) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobFull) encodeFields(e *jx.Encoder) {
+func (s *ImageHashReport) encodeFields(e *jx.Encoder) {
 	{
-		if s.Job.Set {
-			e.FieldStart("Job")
-			s.Job.Encode(e)
+		if s.Table.Set {
+			e.FieldStart("Table")
+			s.Table.Encode(e)
+		}
+	}
+	{
+		if s.Algorithm.Set {
+			e.FieldStart("Algorithm")
+			s.Algorithm.Encode(e)
+		}
+	}
+	{
+		if s.Processed.Set {
+			e.FieldStart("Processed")
+			s.Processed.Encode(e)
+		}
+	}
+	{
+		if s.Updated.Set {
+			e.FieldStart("Updated")
+			s.Updated.Encode(e)
+		}
+	}
+	{
+		if s.Skipped.Set {
+			e.FieldStart("Skipped")
+			s.Skipped.Encode(e)
+		}
+	}
+	{
+		if s.Failed.Set {
+			e.FieldStart("Failed")
+			s.Failed.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfJobFull = [1]string{
-	0: "Job",
+var jsonFieldsNameOfImageHashReport = [6]string{
+	0: "Table",
+	1: "Algorithm",
+	2: "Processed",
+	3: "Updated",
+	4: "Skipped",
+	5: "Failed",
 }
 
-// Decode decodes JobFull from json.
-func (s *JobFull) Decode(d *jx.Decoder) error {
+// Decode decodes ImageHashReport from json.
+func (s *ImageHashReport) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobFull to nil")
+		return errors.New("invalid: unable to decode ImageHashReport to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Job":
+		case "Table":
 			if err := func() error {
-				s.Job.Reset()
-				if err := s.Job.Decode(d); err != nil {
+				s.Table.Reset()
+				if err := s.Table.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Job\"")
+				return errors.Wrap(err, "decode field \"Table\"")
+			}
+		case "Algorithm":
+			if err := func() error {
+				s.Algorithm.Reset()
+				if err := s.Algorithm.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Algorithm\"")
+			}
+		case "Processed":
+			if err := func() error {
+				s.Processed.Reset()
+				if err := s.Processed.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Processed\"")
+			}
+		case "Updated":
+			if err := func() error {
+				s.Updated.Reset()
+				if err := s.Updated.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Updated\"")
+			}
+		case "Skipped":
+			if err := func() error {
+				s.Skipped.Reset()
+				if err := s.Skipped.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Skipped\"")
+			}
+		case "Failed":
+			if err := func() error {
+				s.Failed.Reset()
+				if err := s.Failed.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Failed\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobFull")
+		return errors.Wrap(err, "decode ImageHashReport")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobFull) MarshalJSON() ([]byte, error) {
+func (s *ImageHashReport) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobFull) UnmarshalJSON(data []byte) error {
+func (s *ImageHashReport) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobParameter) Encode(e *jx.Encoder) {
+func (s *ImageMetadata) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobParameter) encodeFields(e *jx.Encoder) {
+func (s *ImageMetadata) encodeFields(e *jx.Encoder) {
 	{
-		if s.Job.Set {
-			e.FieldStart("Job")
-			s.Job.Encode(e)
+		if s.Format.Set {
+			e.FieldStart("Format")
+			s.Format.Encode(e)
 		}
 	}
-}
-
-var jsonFieldsNameOfJobParameter = [1]string{
-	0: "Job",
-}
-
-// Decode decodes JobParameter from json.
-func (s *JobParameter) Decode(d *jx.Decoder) error {
+	{
+		if s.Mimetype.Set {
+			e.FieldStart("Mimetype")
+			s.Mimetype.Encode(e)
+		}
+	}
+	{
+		if s.Size.Set {
+			e.FieldStart("Size")
+			s.Size.Encode(e)
+		}
+	}
+	{
+		if s.Width.Set {
+			e.FieldStart("Width")
+			s.Width.Encode(e)
+		}
+	}
+	{
+		if s.Height.Set {
+			e.FieldStart("Height")
+			s.Height.Encode(e)
+		}
+	}
+	{
+		if s.Orientation.Set {
+			e.FieldStart("Orientation")
+			s.Orientation.Encode(e)
+		}
+	}
+	{
+		if s.DateTime.Set {
+			e.FieldStart("DateTime")
+			s.DateTime.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.GPS.Set {
+			e.FieldStart("GPS")
+			s.GPS.Encode(e)
+		}
+	}
+	{
+		if s.Tags.Set {
+			e.FieldStart("Tags")
+			s.Tags.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfImageMetadata = [9]string{
+	0: "Format",
+	1: "Mimetype",
+	2: "Size",
+	3: "Width",
+	4: "Height",
+	5: "Orientation",
+	6: "DateTime",
+	7: "GPS",
+	8: "Tags",
+}
+
+// Decode decodes ImageMetadata from json.
+func (s *ImageMetadata) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobParameter to nil")
+		return errors.New("invalid: unable to decode ImageMetadata to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Job":
+		case "Format":
 			if err := func() error {
-				s.Job.Reset()
-				if err := s.Job.Decode(d); err != nil {
+				s.Format.Reset()
+				if err := s.Format.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Job\"")
+				return errors.Wrap(err, "decode field \"Format\"")
+			}
+		case "Mimetype":
+			if err := func() error {
+				s.Mimetype.Reset()
+				if err := s.Mimetype.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Mimetype\"")
+			}
+		case "Size":
+			if err := func() error {
+				s.Size.Reset()
+				if err := s.Size.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Size\"")
+			}
+		case "Width":
+			if err := func() error {
+				s.Width.Reset()
+				if err := s.Width.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Width\"")
+			}
+		case "Height":
+			if err := func() error {
+				s.Height.Reset()
+				if err := s.Height.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Height\"")
+			}
+		case "Orientation":
+			if err := func() error {
+				s.Orientation.Reset()
+				if err := s.Orientation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Orientation\"")
+			}
+		case "DateTime":
+			if err := func() error {
+				s.DateTime.Reset()
+				if err := s.DateTime.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DateTime\"")
+			}
+		case "GPS":
+			if err := func() error {
+				s.GPS.Reset()
+				if err := s.GPS.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"GPS\"")
+			}
+		case "Tags":
+			if err := func() error {
+				s.Tags.Reset()
+				if err := s.Tags.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Tags\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobParameter")
+		return errors.Wrap(err, "decode ImageMetadata")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobParameter) MarshalJSON() ([]byte, error) {
+func (s *ImageMetadata) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobParameter) UnmarshalJSON(data []byte) error {
+func (s *ImageMetadata) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobParametersItem) Encode(e *jx.Encoder) {
+func (s ImageMetadataTags) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *JobParametersItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Parameter.Set {
-			e.FieldStart("Parameter")
-			s.Parameter.Encode(e)
-		}
-	}
-}
+// encodeFields implements json.Marshaler.
+func (s ImageMetadataTags) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
 
-var jsonFieldsNameOfJobParametersItem = [1]string{
-	0: "Parameter",
+		e.Str(elem)
+	}
 }
 
-// Decode decodes JobParametersItem from json.
-func (s *JobParametersItem) Decode(d *jx.Decoder) error {
+// Decode decodes ImageMetadataTags from json.
+func (s *ImageMetadataTags) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobParametersItem to nil")
+		return errors.New("invalid: unable to decode ImageMetadataTags to nil")
 	}
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "Parameter":
-			if err := func() error {
-				s.Parameter.Reset()
-				if err := s.Parameter.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Parameter\"")
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobParametersItem")
+		return errors.Wrap(err, "decode ImageMetadataTags")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobParametersItem) MarshalJSON() ([]byte, error) {
+func (s ImageMetadataTags) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobParametersItem) UnmarshalJSON(data []byte) error {
+func (s *ImageMetadataTags) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobResult) Encode(e *jx.Encoder) {
+func (s *ImportError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobResult) encodeFields(e *jx.Encoder) {
+func (s *ImportError) encodeFields(e *jx.Encoder) {
 	{
-		if s.JobResult.Set {
-			e.FieldStart("JobResult")
-			s.JobResult.Encode(e)
+		if s.Row.Set {
+			e.FieldStart("Row")
+			s.Row.Encode(e)
+		}
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfJobResult = [1]string{
-	0: "JobResult",
+var jsonFieldsNameOfImportError = [2]string{
+	0: "Row",
+	1: "Message",
 }
 
-// Decode decodes JobResult from json.
-func (s *JobResult) Decode(d *jx.Decoder) error {
+// Decode decodes ImportError from json.
+func (s *ImportError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobResult to nil")
+		return errors.New("invalid: unable to decode ImportError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "JobResult":
+		case "Row":
 			if err := func() error {
-				s.JobResult.Reset()
-				if err := s.JobResult.Decode(d); err != nil {
+				s.Row.Reset()
+				if err := s.Row.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"JobResult\"")
+				return errors.Wrap(err, "decode field \"Row\"")
+			}
+		case "Message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Message\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobResult")
+		return errors.Wrap(err, "decode ImportError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobResult) MarshalJSON() ([]byte, error) {
+func (s *ImportError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobResult) UnmarshalJSON(data []byte) error {
+func (s *ImportError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobResultJobResult) Encode(e *jx.Encoder) {
+func (s *ImportReport) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobResultJobResult) encodeFields(e *jx.Encoder) {
-	{
-		if s.Description.Set {
-			e.FieldStart("Description")
-			s.Description.Encode(e)
-		}
-	}
-	{
-		if s.Ended.Set {
-			e.FieldStart("Ended")
-			s.Ended.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.ExitCode.Set {
-			e.FieldStart("ExitCode")
-			s.ExitCode.Encode(e)
-		}
-	}
-	{
-		if s.ID.Set {
-			e.FieldStart("Id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Log.Set {
-			e.FieldStart("Log")
-			s.Log.Encode(e)
-		}
-	}
+func (s *ImportReport) encodeFields(e *jx.Encoder) {
 	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
+		if s.Accepted.Set {
+			e.FieldStart("Accepted")
+			s.Accepted.Encode(e)
 		}
 	}
 	{
-		if s.Scheduled.Set {
-			e.FieldStart("Scheduled")
-			s.Scheduled.Encode(e, json.EncodeDateTime)
+		if s.Rejected.Set {
+			e.FieldStart("Rejected")
+			s.Rejected.Encode(e)
 		}
 	}
 	{
-		if s.StartedBy.Set {
-			e.FieldStart("StartedBy")
-			s.StartedBy.Encode(e)
+		if s.Aborted.Set {
+			e.FieldStart("Aborted")
+			s.Aborted.Encode(e)
 		}
 	}
 	{
-		if s.Status.Set {
-			e.FieldStart("Status")
-			s.Status.Encode(e)
+		if s.Errors != nil {
+			e.FieldStart("Errors")
+			e.ArrStart()
+			for _, elem := range s.Errors {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfJobResultJobResult = [9]string{
-	0: "Description",
-	1: "Ended",
-	2: "ExitCode",
-	3: "Id",
-	4: "Log",
-	5: "Name",
-	6: "Scheduled",
-	7: "StartedBy",
-	8: "Status",
+var jsonFieldsNameOfImportReport = [4]string{
+	0: "Accepted",
+	1: "Rejected",
+	2: "Aborted",
+	3: "Errors",
 }
 
-// Decode decodes JobResultJobResult from json.
-func (s *JobResultJobResult) Decode(d *jx.Decoder) error {
+// Decode decodes ImportReport from json.
+func (s *ImportReport) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobResultJobResult to nil")
+		return errors.New("invalid: unable to decode ImportReport to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Description":
+		case "Accepted":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.Accepted.Reset()
+				if err := s.Accepted.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
+				return errors.Wrap(err, "decode field \"Accepted\"")
 			}
-		case "Ended":
+		case "Rejected":
 			if err := func() error {
-				s.Ended.Reset()
-				if err := s.Ended.Decode(d, json.DecodeDateTime); err != nil {
+				s.Rejected.Reset()
+				if err := s.Rejected.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Ended\"")
+				return errors.Wrap(err, "decode field \"Rejected\"")
 			}
-		case "ExitCode":
+		case "Aborted":
 			if err := func() error {
-				s.ExitCode.Reset()
-				if err := s.ExitCode.Decode(d); err != nil {
+				s.Aborted.Reset()
+				if err := s.Aborted.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ExitCode\"")
+				return errors.Wrap(err, "decode field \"Aborted\"")
 			}
-		case "Id":
+		case "Errors":
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				s.Errors = make([]ImportError, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ImportError
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Errors = append(s.Errors, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Id\"")
-			}
-		case "Log":
-			if err := func() error {
-				s.Log.Reset()
-				if err := s.Log.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Log\"")
-			}
-		case "Name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
-			}
-		case "Scheduled":
-			if err := func() error {
-				s.Scheduled.Reset()
-				if err := s.Scheduled.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Scheduled\"")
-			}
-		case "StartedBy":
-			if err := func() error {
-				s.StartedBy.Reset()
-				if err := s.StartedBy.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"StartedBy\"")
-			}
-		case "Status":
-			if err := func() error {
-				s.Status.Reset()
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Status\"")
+				return errors.Wrap(err, "decode field \"Errors\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobResultJobResult")
+		return errors.Wrap(err, "decode ImportReport")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobResultJobResult) MarshalJSON() ([]byte, error) {
+func (s *ImportReport) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobResultJobResult) UnmarshalJSON(data []byte) error {
+func (s *ImportReport) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *JobStatusResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes InsertMapFileRecordsBadRequest as json.
+func (s *InsertMapFileRecordsBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *JobStatusResponse) encodeFields(e *jx.Encoder) {
-	{
-		if s.Status.Set {
-			e.FieldStart("Status")
-			s.Status.Encode(e)
+// Decode decodes InsertMapFileRecordsBadRequest from json.
+func (s *InsertMapFileRecordsBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode InsertMapFileRecordsBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = InsertMapFileRecordsBadRequest(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfJobStatusResponse = [1]string{
-	0: "Status",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *InsertMapFileRecordsBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes JobStatusResponse from json.
-func (s *JobStatusResponse) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *InsertMapFileRecordsBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes InsertMapFileRecordsNotFound as json.
+func (s *InsertMapFileRecordsNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes InsertMapFileRecordsNotFound from json.
+func (s *InsertMapFileRecordsNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobStatusResponse to nil")
+		return errors.New("invalid: unable to decode InsertMapFileRecordsNotFound to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "Status":
-			if err := func() error {
-				s.Status.Reset()
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Status\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode JobStatusResponse")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = InsertMapFileRecordsNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobStatusResponse) MarshalJSON() ([]byte, error) {
+func (s *InsertMapFileRecordsNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobStatusResponse) UnmarshalJSON(data []byte) error {
+func (s *InsertMapFileRecordsNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobStatusResponseStatus) Encode(e *jx.Encoder) {
+func (s *InsertRecordReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobStatusResponseStatus) encodeFields(e *jx.Encoder) {
-	{
-		if s.Action.Set {
-			e.FieldStart("Action")
-			s.Action.Encode(e)
-		}
-	}
-	{
-		if s.ExecutionId.Set {
-			e.FieldStart("ExecutionId")
-			s.ExecutionId.Encode(e)
-		}
-	}
-	{
-		if s.Message.Set {
-			e.FieldStart("Message")
-			s.Message.Encode(e)
-		}
-	}
+func (s *InsertRecordReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
+		if s.Records != nil {
+			e.FieldStart("Records")
+			e.ArrStart()
+			for _, elem := range s.Records {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfJobStatusResponseStatus = [4]string{
-	0: "Action",
-	1: "ExecutionId",
-	2: "Message",
-	3: "Name",
+var jsonFieldsNameOfInsertRecordReq = [1]string{
+	0: "Records",
 }
 
-// Decode decodes JobStatusResponseStatus from json.
-func (s *JobStatusResponseStatus) Decode(d *jx.Decoder) error {
+// Decode decodes InsertRecordReq from json.
+func (s *InsertRecordReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobStatusResponseStatus to nil")
+		return errors.New("invalid: unable to decode InsertRecordReq to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Action":
-			if err := func() error {
-				s.Action.Reset()
-				if err := s.Action.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Action\"")
-			}
-		case "ExecutionId":
-			if err := func() error {
-				s.ExecutionId.Reset()
-				if err := s.ExecutionId.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ExecutionId\"")
-			}
-		case "Message":
-			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Message\"")
-			}
-		case "Name":
+		case "Records":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Records = make([]InsertRecordReqRecordsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem InsertRecordReqRecordsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Records = append(s.Records, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
+				return errors.Wrap(err, "decode field \"Records\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobStatusResponseStatus")
+		return errors.Wrap(err, "decode InsertRecordReq")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobStatusResponseStatus) MarshalJSON() ([]byte, error) {
+func (s *InsertRecordReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobStatusResponseStatus) UnmarshalJSON(data []byte) error {
+func (s *InsertRecordReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobStore) Encode(e *jx.Encoder) {
+func (s InsertRecordReqRecordsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *JobStore) encodeFields(e *jx.Encoder) {
-	{
-		if s.Config.Set {
-			e.FieldStart("config")
-			s.Config.Encode(e)
-		}
-	}
-	{
-		if s.Database.Set {
-			e.FieldStart("Database")
-			s.Database.Encode(e)
+// encodeFields implements json.Marshaler.
+func (s InsertRecordReqRecordsItem) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
 		}
 	}
 }
 
-var jsonFieldsNameOfJobStore = [2]string{
-	0: "config",
-	1: "Database",
-}
-
-// Decode decodes JobStore from json.
-func (s *JobStore) Decode(d *jx.Decoder) error {
+// Decode decodes InsertRecordReqRecordsItem from json.
+func (s *InsertRecordReqRecordsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobStore to nil")
+		return errors.New("invalid: unable to decode InsertRecordReqRecordsItem to nil")
 	}
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "config":
-			if err := func() error {
-				s.Config.Reset()
-				if err := s.Config.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"config\"")
-			}
-		case "Database":
-			if err := func() error {
-				s.Database.Reset()
-				if err := s.Database.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Database\"")
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobStore")
+		return errors.Wrap(err, "decode InsertRecordReqRecordsItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobStore) MarshalJSON() ([]byte, error) {
+func (s InsertRecordReqRecordsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobStore) UnmarshalJSON(data []byte) error {
+func (s *InsertRecordReqRecordsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobsList) Encode(e *jx.Encoder) {
+func (s *Job) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobsList) encodeFields(e *jx.Encoder) {
+func (s *Job) encodeFields(e *jx.Encoder) {
 	{
-		if s.JobDefinition != nil {
-			e.FieldStart("JobDefinition")
+		if s.Description.Set {
+			e.FieldStart("Description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.Environments != nil {
+			e.FieldStart("Environments")
 			e.ArrStart()
-			for _, elem := range s.JobDefinition {
+			for _, elem := range s.Environments {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Parameters != nil {
+			e.FieldStart("Parameters")
+			e.ArrStart()
+			for _, elem := range s.Parameters {
 				elem.Encode(e)
 			}
 			e.ArrEnd()
 		}
 	}
+	{
+		if s.Script.Set {
+			e.FieldStart("Script")
+			s.Script.Encode(e)
+		}
+	}
+	{
+		if s.User.Set {
+			e.FieldStart("User")
+			s.User.Encode(e)
+		}
+	}
+	{
+		if s.Utility.Set {
+			e.FieldStart("Utility")
+			s.Utility.Encode(e)
+		}
+	}
+	{
+		if s.CronSchedule.Set {
+			e.FieldStart("CronSchedule")
+			s.CronSchedule.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfJobsList = [1]string{
-	0: "JobDefinition",
+var jsonFieldsNameOfJob = [8]string{
+	0: "Description",
+	1: "Environments",
+	2: "Name",
+	3: "Parameters",
+	4: "Script",
+	5: "User",
+	6: "Utility",
+	7: "CronSchedule",
 }
 
-// Decode decodes JobsList from json.
-func (s *JobsList) Decode(d *jx.Decoder) error {
+// Decode decodes Job from json.
+func (s *Job) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobsList to nil")
+		return errors.New("invalid: unable to decode Job to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "JobDefinition":
+		case "Description":
 			if err := func() error {
-				s.JobDefinition = make([]JobDefinition, 0)
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "Environments":
+			if err := func() error {
+				s.Environments = make([]JobEnvironmentsItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem JobDefinition
+					var elem JobEnvironmentsItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.JobDefinition = append(s.JobDefinition, elem)
+					s.Environments = append(s.Environments, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"JobDefinition\"")
+				return errors.Wrap(err, "decode field \"Environments\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "Parameters":
+			if err := func() error {
+				s.Parameters = make([]JobParametersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem JobParametersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Parameters = append(s.Parameters, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Parameters\"")
+			}
+		case "Script":
+			if err := func() error {
+				s.Script.Reset()
+				if err := s.Script.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Script\"")
+			}
+		case "User":
+			if err := func() error {
+				s.User.Reset()
+				if err := s.User.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"User\"")
+			}
+		case "Utility":
+			if err := func() error {
+				s.Utility.Reset()
+				if err := s.Utility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Utility\"")
+			}
+		case "CronSchedule":
+			if err := func() error {
+				s.CronSchedule.Reset()
+				if err := s.CronSchedule.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"CronSchedule\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobsList")
+		return errors.Wrap(err, "decode Job")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobsList) MarshalJSON() ([]byte, error) {
+func (s *Job) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobsList) UnmarshalJSON(data []byte) error {
+func (s *Job) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListModellingBadRequest as json.
-func (s *ListModellingBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *JobDefinition) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes ListModellingBadRequest from json.
-func (s *ListModellingBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListModellingBadRequest to nil")
+// encodeFields encodes fields.
+func (s *JobDefinition) encodeFields(e *jx.Encoder) {
+	{
+		if s.Executions != nil {
+			e.FieldStart("Executions")
+			e.ArrStart()
+			for _, elem := range s.Executions {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.Job.Set {
+			e.FieldStart("Job")
+			s.Job.Encode(e)
+		}
+	}
+	{
+		if s.Status.Set {
+			e.FieldStart("Status")
+			s.Status.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = ListModellingBadRequest(unwrapped)
-	return nil
+}
+
+var jsonFieldsNameOfJobDefinition = [3]string{
+	0: "Executions",
+	1: "Job",
+	2: "Status",
+}
+
+// Decode decodes JobDefinition from json.
+func (s *JobDefinition) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobDefinition to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Executions":
+			if err := func() error {
+				s.Executions = make([]Executions, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Executions
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Executions = append(s.Executions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Executions\"")
+			}
+		case "Job":
+			if err := func() error {
+				s.Job.Reset()
+				if err := s.Job.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Job\"")
+			}
+		case "Status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Status\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobDefinition")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobDefinition) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobDefinition) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobDescription) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobDescription) encodeFields(e *jx.Encoder) {
+	{
+		if s.CronSchedule.Set {
+			e.FieldStart("CronSchedule")
+			s.CronSchedule.Encode(e)
+		}
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("Description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.Environments != nil {
+			e.FieldStart("Environments")
+			e.ArrStart()
+			for _, elem := range s.Environments {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Parameters != nil {
+			e.FieldStart("Parameters")
+			e.ArrStart()
+			for _, elem := range s.Parameters {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Script.Set {
+			e.FieldStart("Script")
+			s.Script.Encode(e)
+		}
+	}
+	{
+		if s.User.Set {
+			e.FieldStart("User")
+			s.User.Encode(e)
+		}
+	}
+	{
+		if s.Utility.Set {
+			e.FieldStart("Utility")
+			s.Utility.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobDescription = [8]string{
+	0: "CronSchedule",
+	1: "Description",
+	2: "Environments",
+	3: "Name",
+	4: "Parameters",
+	5: "Script",
+	6: "User",
+	7: "Utility",
+}
+
+// Decode decodes JobDescription from json.
+func (s *JobDescription) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobDescription to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "CronSchedule":
+			if err := func() error {
+				s.CronSchedule.Reset()
+				if err := s.CronSchedule.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"CronSchedule\"")
+			}
+		case "Description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "Environments":
+			if err := func() error {
+				s.Environments = make([]JobDescriptionEnvironmentsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem JobDescriptionEnvironmentsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Environments = append(s.Environments, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Environments\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "Parameters":
+			if err := func() error {
+				s.Parameters = make([]JobDescriptionParametersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem JobDescriptionParametersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Parameters = append(s.Parameters, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Parameters\"")
+			}
+		case "Script":
+			if err := func() error {
+				s.Script.Reset()
+				if err := s.Script.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Script\"")
+			}
+		case "User":
+			if err := func() error {
+				s.User.Reset()
+				if err := s.User.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"User\"")
+			}
+		case "Utility":
+			if err := func() error {
+				s.Utility.Reset()
+				if err := s.Utility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Utility\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobDescription")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobDescription) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobDescription) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobDescriptionEnvironmentsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobDescriptionEnvironmentsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Parameter.Set {
+			e.FieldStart("Parameter")
+			s.Parameter.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobDescriptionEnvironmentsItem = [1]string{
+	0: "Parameter",
+}
+
+// Decode decodes JobDescriptionEnvironmentsItem from json.
+func (s *JobDescriptionEnvironmentsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobDescriptionEnvironmentsItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Parameter":
+			if err := func() error {
+				s.Parameter.Reset()
+				if err := s.Parameter.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Parameter\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobDescriptionEnvironmentsItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobDescriptionEnvironmentsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobDescriptionEnvironmentsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobDescriptionParametersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobDescriptionParametersItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Parameter.Set {
+			e.FieldStart("Parameter")
+			s.Parameter.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobDescriptionParametersItem = [1]string{
+	0: "Parameter",
+}
+
+// Decode decodes JobDescriptionParametersItem from json.
+func (s *JobDescriptionParametersItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobDescriptionParametersItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Parameter":
+			if err := func() error {
+				s.Parameter.Reset()
+				if err := s.Parameter.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Parameter\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobDescriptionParametersItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobDescriptionParametersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobDescriptionParametersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobEnvironmentsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobEnvironmentsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Parameter.Set {
+			e.FieldStart("Parameter")
+			s.Parameter.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobEnvironmentsItem = [1]string{
+	0: "Parameter",
+}
+
+// Decode decodes JobEnvironmentsItem from json.
+func (s *JobEnvironmentsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobEnvironmentsItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Parameter":
+			if err := func() error {
+				s.Parameter.Reset()
+				if err := s.Parameter.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Parameter\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobEnvironmentsItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobEnvironmentsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobEnvironmentsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobFull) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobFull) encodeFields(e *jx.Encoder) {
+	{
+		if s.Job.Set {
+			e.FieldStart("Job")
+			s.Job.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobFull = [1]string{
+	0: "Job",
+}
+
+// Decode decodes JobFull from json.
+func (s *JobFull) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobFull to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Job":
+			if err := func() error {
+				s.Job.Reset()
+				if err := s.Job.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Job\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobFull")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobFull) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobFull) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobParameter) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobParameter) encodeFields(e *jx.Encoder) {
+	{
+		if s.Job.Set {
+			e.FieldStart("Job")
+			s.Job.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobParameter = [1]string{
+	0: "Job",
+}
+
+// Decode decodes JobParameter from json.
+func (s *JobParameter) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobParameter to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Job":
+			if err := func() error {
+				s.Job.Reset()
+				if err := s.Job.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Job\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobParameter")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobParameter) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobParameter) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobParametersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobParametersItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Parameter.Set {
+			e.FieldStart("Parameter")
+			s.Parameter.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobParametersItem = [1]string{
+	0: "Parameter",
+}
+
+// Decode decodes JobParametersItem from json.
+func (s *JobParametersItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobParametersItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Parameter":
+			if err := func() error {
+				s.Parameter.Reset()
+				if err := s.Parameter.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Parameter\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobParametersItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobParametersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobParametersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobResult) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobResult) encodeFields(e *jx.Encoder) {
+	{
+		if s.JobResult.Set {
+			e.FieldStart("JobResult")
+			s.JobResult.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobResult = [1]string{
+	0: "JobResult",
+}
+
+// Decode decodes JobResult from json.
+func (s *JobResult) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobResult to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "JobResult":
+			if err := func() error {
+				s.JobResult.Reset()
+				if err := s.JobResult.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"JobResult\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobResult")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobResult) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobResult) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobResultJobResult) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobResultJobResult) encodeFields(e *jx.Encoder) {
+	{
+		if s.Description.Set {
+			e.FieldStart("Description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.Ended.Set {
+			e.FieldStart("Ended")
+			s.Ended.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.ExitCode.Set {
+			e.FieldStart("ExitCode")
+			s.ExitCode.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("Id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Log.Set {
+			e.FieldStart("Log")
+			s.Log.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Scheduled.Set {
+			e.FieldStart("Scheduled")
+			s.Scheduled.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.StartedBy.Set {
+			e.FieldStart("StartedBy")
+			s.StartedBy.Encode(e)
+		}
+	}
+	{
+		if s.Status.Set {
+			e.FieldStart("Status")
+			s.Status.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobResultJobResult = [9]string{
+	0: "Description",
+	1: "Ended",
+	2: "ExitCode",
+	3: "Id",
+	4: "Log",
+	5: "Name",
+	6: "Scheduled",
+	7: "StartedBy",
+	8: "Status",
+}
+
+// Decode decodes JobResultJobResult from json.
+func (s *JobResultJobResult) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobResultJobResult to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "Ended":
+			if err := func() error {
+				s.Ended.Reset()
+				if err := s.Ended.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Ended\"")
+			}
+		case "ExitCode":
+			if err := func() error {
+				s.ExitCode.Reset()
+				if err := s.ExitCode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ExitCode\"")
+			}
+		case "Id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Id\"")
+			}
+		case "Log":
+			if err := func() error {
+				s.Log.Reset()
+				if err := s.Log.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Log\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "Scheduled":
+			if err := func() error {
+				s.Scheduled.Reset()
+				if err := s.Scheduled.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Scheduled\"")
+			}
+		case "StartedBy":
+			if err := func() error {
+				s.StartedBy.Reset()
+				if err := s.StartedBy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"StartedBy\"")
+			}
+		case "Status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Status\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobResultJobResult")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobResultJobResult) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobResultJobResult) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobStatusResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobStatusResponse) encodeFields(e *jx.Encoder) {
+	{
+		if s.Status.Set {
+			e.FieldStart("Status")
+			s.Status.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobStatusResponse = [1]string{
+	0: "Status",
+}
+
+// Decode decodes JobStatusResponse from json.
+func (s *JobStatusResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobStatusResponse to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Status\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobStatusResponse")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobStatusResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobStatusResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobStatusResponseStatus) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobStatusResponseStatus) encodeFields(e *jx.Encoder) {
+	{
+		if s.Action.Set {
+			e.FieldStart("Action")
+			s.Action.Encode(e)
+		}
+	}
+	{
+		if s.ExecutionId.Set {
+			e.FieldStart("ExecutionId")
+			s.ExecutionId.Encode(e)
+		}
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobStatusResponseStatus = [4]string{
+	0: "Action",
+	1: "ExecutionId",
+	2: "Message",
+	3: "Name",
+}
+
+// Decode decodes JobStatusResponseStatus from json.
+func (s *JobStatusResponseStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobStatusResponseStatus to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Action":
+			if err := func() error {
+				s.Action.Reset()
+				if err := s.Action.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Action\"")
+			}
+		case "ExecutionId":
+			if err := func() error {
+				s.ExecutionId.Reset()
+				if err := s.ExecutionId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ExecutionId\"")
+			}
+		case "Message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Message\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobStatusResponseStatus")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobStatusResponseStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobStatusResponseStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobStore) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobStore) encodeFields(e *jx.Encoder) {
+	{
+		if s.Config.Set {
+			e.FieldStart("config")
+			s.Config.Encode(e)
+		}
+	}
+	{
+		if s.Database.Set {
+			e.FieldStart("Database")
+			s.Database.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfJobStore = [2]string{
+	0: "config",
+	1: "Database",
+}
+
+// Decode decodes JobStore from json.
+func (s *JobStore) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobStore to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "config":
+			if err := func() error {
+				s.Config.Reset()
+				if err := s.Config.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"config\"")
+			}
+		case "Database":
+			if err := func() error {
+				s.Database.Reset()
+				if err := s.Database.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Database\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobStore")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobStore) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobStore) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *JobsList) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobsList) encodeFields(e *jx.Encoder) {
+	{
+		if s.JobDefinition != nil {
+			e.FieldStart("JobDefinition")
+			e.ArrStart()
+			for _, elem := range s.JobDefinition {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfJobsList = [1]string{
+	0: "JobDefinition",
+}
+
+// Decode decodes JobsList from json.
+func (s *JobsList) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobsList to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "JobDefinition":
+			if err := func() error {
+				s.JobDefinition = make([]JobDefinition, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem JobDefinition
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.JobDefinition = append(s.JobDefinition, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"JobDefinition\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobsList")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *JobsList) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *JobsList) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListModellingBadRequest as json.
+func (s *ListModellingBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ListModellingBadRequest from json.
+func (s *ListModellingBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListModellingBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListModellingBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListModellingBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListModellingBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListModellingNotFound as json.
+func (s *ListModellingNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ListModellingNotFound from json.
+func (s *ListModellingNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListModellingNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListModellingNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListModellingNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListModellingNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListTablesBadRequest as json.
+func (s *ListTablesBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ListTablesBadRequest from json.
+func (s *ListTablesBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListTablesBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListTablesBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListTablesBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListTablesBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListTablesNotFound as json.
+func (s *ListTablesNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ListTablesNotFound from json.
+func (s *ListTablesNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListTablesNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListTablesNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListTablesNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListTablesNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LobUpload) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LobUpload) encodeFields(e *jx.Encoder) {
+	{
+		if s.Table.Set {
+			e.FieldStart("Table")
+			s.Table.Encode(e)
+		}
+	}
+	{
+		if s.Field.Set {
+			e.FieldStart("Field")
+			s.Field.Encode(e)
+		}
+	}
+	{
+		if s.Mimetype.Set {
+			e.FieldStart("Mimetype")
+			s.Mimetype.Encode(e)
+		}
+	}
+	{
+		if s.Size.Set {
+			e.FieldStart("Size")
+			s.Size.Encode(e)
+		}
+	}
+	{
+		if s.Checksum.Set {
+			e.FieldStart("Checksum")
+			s.Checksum.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfLobUpload = [5]string{
+	0: "Table",
+	1: "Field",
+	2: "Mimetype",
+	3: "Size",
+	4: "Checksum",
+}
+
+// Decode decodes LobUpload from json.
+func (s *LobUpload) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LobUpload to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Table":
+			if err := func() error {
+				s.Table.Reset()
+				if err := s.Table.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Table\"")
+			}
+		case "Field":
+			if err := func() error {
+				s.Field.Reset()
+				if err := s.Field.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Field\"")
+			}
+		case "Mimetype":
+			if err := func() error {
+				s.Mimetype.Reset()
+				if err := s.Mimetype.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Mimetype\"")
+			}
+		case "Size":
+			if err := func() error {
+				s.Size.Reset()
+				if err := s.Size.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Size\"")
+			}
+		case "Checksum":
+			if err := func() error {
+				s.Checksum.Reset()
+				if err := s.Checksum.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Checksum\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LobUpload")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LobUpload) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LobUpload) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LogoutSessionCompatBadRequest as json.
+func (s *LogoutSessionCompatBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes LogoutSessionCompatBadRequest from json.
+func (s *LogoutSessionCompatBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LogoutSessionCompatBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = LogoutSessionCompatBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LogoutSessionCompatBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LogoutSessionCompatBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LogoutSessionCompatNotFound as json.
+func (s *LogoutSessionCompatNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes LogoutSessionCompatNotFound from json.
+func (s *LogoutSessionCompatNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LogoutSessionCompatNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = LogoutSessionCompatNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LogoutSessionCompatNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LogoutSessionCompatNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Map as json.
+func (s Map) Encode(e *jx.Encoder) {
+	unwrapped := string(s)
+
+	e.Str(unwrapped)
+}
+
+// Decode decodes Map from json.
+func (s *Map) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Map to nil")
+	}
+	var unwrapped string
+	if err := func() error {
+		v, err := d.Str()
+		unwrapped = string(v)
+		if err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = Map(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s Map) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Map) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Maps) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Maps) encodeFields(e *jx.Encoder) {
+	{
+		if s.Maps != nil {
+			e.FieldStart("Maps")
+			e.ArrStart()
+			for _, elem := range s.Maps {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfMaps = [1]string{
+	0: "Maps",
+}
+
+// Decode decodes Maps from json.
+func (s *Maps) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Maps to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Maps":
+			if err := func() error {
+				s.Maps = make([]Map, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Map
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Maps = append(s.Maps, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Maps\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Maps")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Maps) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Maps) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ClusterConfig as json.
+func (o OptClusterConfig) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ClusterConfig from json.
+func (o *OptClusterConfig) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptClusterConfig to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptClusterConfig) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptClusterConfig) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ConfigDatabaseAccess as json.
+func (o OptConfigDatabaseAccess) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ConfigDatabaseAccess from json.
+func (o *OptConfigDatabaseAccess) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptConfigDatabaseAccess to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ListModellingBadRequest) MarshalJSON() ([]byte, error) {
+func (s OptConfigDatabaseAccess) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListModellingBadRequest) UnmarshalJSON(data []byte) error {
+func (s *OptConfigDatabaseAccess) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListModellingNotFound as json.
-func (s *ListModellingNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode encodes ConfigMapping as json.
+func (o OptConfigMapping) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
 
-	unwrapped.Encode(e)
+// Decode decodes ConfigMapping from json.
+func (o *OptConfigMapping) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptConfigMapping to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Decode decodes ListModellingNotFound from json.
-func (s *ListModellingNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListModellingNotFound to nil")
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptConfigMapping) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptConfigMapping) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ConfigMetrics as json.
+func (o OptConfigMetrics) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	o.Value.Encode(e)
+}
+
+// Decode decodes ConfigMetrics from json.
+func (o *OptConfigMetrics) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptConfigMetrics to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	*s = ListModellingNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ListModellingNotFound) MarshalJSON() ([]byte, error) {
+func (s OptConfigMetrics) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListModellingNotFound) UnmarshalJSON(data []byte) error {
+func (s *OptConfigMetrics) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListTablesBadRequest as json.
-func (s *ListTablesBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode encodes ConfigModule as json.
+func (o OptConfigModule) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
 
-	unwrapped.Encode(e)
+// Decode decodes ConfigModule from json.
+func (o *OptConfigModule) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptConfigModule to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Decode decodes ListTablesBadRequest from json.
-func (s *ListTablesBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListTablesBadRequest to nil")
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptConfigModule) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptConfigModule) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ConfigServer as json.
+func (o OptConfigServer) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	o.Value.Encode(e)
+}
+
+// Decode decodes ConfigServer from json.
+func (o *OptConfigServer) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptConfigServer to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	*s = ListTablesBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ListTablesBadRequest) MarshalJSON() ([]byte, error) {
+func (s OptConfigServer) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListTablesBadRequest) UnmarshalJSON(data []byte) error {
+func (s *OptConfigServer) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListTablesNotFound as json.
-func (s *ListTablesNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode encodes ConfigServerLogLocation as json.
+func (o OptConfigServerLogLocation) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
 
-	unwrapped.Encode(e)
+// Decode decodes ConfigServerLogLocation from json.
+func (o *OptConfigServerLogLocation) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptConfigServerLogLocation to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Decode decodes ListTablesNotFound from json.
-func (s *ListTablesNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListTablesNotFound to nil")
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptConfigServerLogLocation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptConfigServerLogLocation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DatabaseConfig as json.
+func (o OptDatabaseConfig) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	o.Value.Encode(e)
+}
+
+// Decode decodes DatabaseConfig from json.
+func (o *OptDatabaseConfig) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDatabaseConfig to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	*s = ListTablesNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ListTablesNotFound) MarshalJSON() ([]byte, error) {
+func (s OptDatabaseConfig) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListTablesNotFound) UnmarshalJSON(data []byte) error {
+func (s *OptDatabaseConfig) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes LogoutSessionCompatBadRequest as json.
-func (s *LogoutSessionCompatBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode encodes time.Time as json.
+func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
+}
 
-	unwrapped.Encode(e)
+// Decode decodes time.Time from json.
+func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDateTime to nil")
+	}
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
 }
 
-// Decode decodes LogoutSessionCompatBadRequest from json.
-func (s *LogoutSessionCompatBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode LogoutSessionCompatBadRequest to nil")
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes Error as json.
+func (o OptError) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes Error from json.
+func (o *OptError) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptError to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	*s = LogoutSessionCompatBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LogoutSessionCompatBadRequest) MarshalJSON() ([]byte, error) {
+func (s OptError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LogoutSessionCompatBadRequest) UnmarshalJSON(data []byte) error {
+func (s *OptError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes LogoutSessionCompatNotFound as json.
-func (s *LogoutSessionCompatNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes ErrorError as json.
+func (o OptErrorError) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// Decode decodes LogoutSessionCompatNotFound from json.
-func (s *LogoutSessionCompatNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode LogoutSessionCompatNotFound to nil")
+// Decode decodes ErrorError from json.
+func (o *OptErrorError) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptErrorError to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	*s = LogoutSessionCompatNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LogoutSessionCompatNotFound) MarshalJSON() ([]byte, error) {
+func (s OptErrorError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LogoutSessionCompatNotFound) UnmarshalJSON(data []byte) error {
+func (s *OptErrorError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes Map as json.
-func (s Map) Encode(e *jx.Encoder) {
-	unwrapped := string(s)
-
-	e.Str(unwrapped)
+// Encode encodes float64 as json.
+func (o OptFloat64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Float64(float64(o.Value))
 }
 
-// Decode decodes Map from json.
-func (s *Map) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Map to nil")
+// Decode decodes float64 from json.
+func (o *OptFloat64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFloat64 to nil")
 	}
-	var unwrapped string
-	if err := func() error {
-		v, err := d.Str()
-		unwrapped = string(v)
-		if err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	o.Set = true
+	v, err := d.Float64()
+	if err != nil {
+		return err
 	}
-	*s = Map(unwrapped)
+	o.Value = float64(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s Map) MarshalJSON() ([]byte, error) {
+func (s OptFloat64) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Map) UnmarshalJSON(data []byte) error {
+func (s *OptFloat64) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MapField) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MapField) encodeFields(e *jx.Encoder) {
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
-		}
-	}
-	{
-		if s.ShortName.Set {
-			e.FieldStart("shortName")
-			s.ShortName.Encode(e)
-		}
-	}
-	{
-		if s.ContentType.Set {
-			e.FieldStart("contentType")
-			s.ContentType.Encode(e)
-		}
-	}
-	{
-		if s.Charset.Set {
-			e.FieldStart("charset")
-			s.Charset.Encode(e)
-		}
-	}
-	{
-		if s.File.Set {
-			e.FieldStart("file")
-			s.File.Encode(e)
-		}
-	}
-	{
-		if s.FormatType.Set {
-			e.FieldStart("formatType")
-			s.FormatType.Encode(e)
-		}
-	}
-	{
-		if s.Length.Set {
-			e.FieldStart("length")
-			s.Length.Encode(e)
-		}
-	}
-	{
-		if s.FieldType.Set {
-			e.FieldStart("fieldType")
-			s.FieldType.Encode(e)
-		}
+// Encode encodes ImageGPS as json.
+func (o OptImageGPS) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
-var jsonFieldsNameOfMapField = [8]string{
-	0: "name",
-	1: "shortName",
-	2: "contentType",
-	3: "charset",
-	4: "file",
-	5: "formatType",
-	6: "length",
-	7: "fieldType",
-}
-
-// Decode decodes MapField from json.
-func (s *MapField) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MapField to nil")
+// Decode decodes ImageGPS from json.
+func (o *OptImageGPS) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptImageGPS to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "shortName":
-			if err := func() error {
-				s.ShortName.Reset()
-				if err := s.ShortName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"shortName\"")
-			}
-		case "contentType":
-			if err := func() error {
-				s.ContentType.Reset()
-				if err := s.ContentType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"contentType\"")
-			}
-		case "charset":
-			if err := func() error {
-				s.Charset.Reset()
-				if err := s.Charset.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"charset\"")
-			}
-		case "file":
-			if err := func() error {
-				s.File.Reset()
-				if err := s.File.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"file\"")
-			}
-		case "formatType":
-			if err := func() error {
-				s.FormatType.Reset()
-				if err := s.FormatType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"formatType\"")
-			}
-		case "length":
-			if err := func() error {
-				s.Length.Reset()
-				if err := s.Length.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"length\"")
-			}
-		case "fieldType":
-			if err := func() error {
-				s.FieldType.Reset()
-				if err := s.FieldType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fieldType\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MapField")
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MapField) MarshalJSON() ([]byte, error) {
+func (s OptImageGPS) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MapField) UnmarshalJSON(data []byte) error {
+func (s *OptImageGPS) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MapFieldCharset) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes ImageMetadataTags as json.
+func (o OptImageMetadataTags) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *MapFieldCharset) encodeFields(e *jx.Encoder) {
-	{
-		if s.Format.Set {
-			e.FieldStart("format")
-			s.Format.Encode(e)
-		}
+// Decode decodes ImageMetadataTags from json.
+func (o *OptImageMetadataTags) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptImageMetadataTags to nil")
+	}
+	o.Set = true
+	o.Value = make(ImageMetadataTags)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfMapFieldCharset = [1]string{
-	0: "format",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptImageMetadataTags) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes MapFieldCharset from json.
-func (s *MapFieldCharset) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MapFieldCharset to nil")
-	}
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptImageMetadataTags) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "format":
-			if err := func() error {
-				s.Format.Reset()
-				if err := s.Format.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MapFieldCharset")
+// Encode encodes InsertRecordReq as json.
+func (o OptInsertRecordReq) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
+}
 
+// Decode decodes InsertRecordReq from json.
+func (o *OptInsertRecordReq) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInsertRecordReq to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MapFieldCharset) MarshalJSON() ([]byte, error) {
+func (s OptInsertRecordReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MapFieldCharset) UnmarshalJSON(data []byte) error {
+func (s *OptInsertRecordReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MapFieldContentType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MapFieldContentType) encodeFields(e *jx.Encoder) {
-	{
-		if s.Format.Set {
-			e.FieldStart("format")
-			s.Format.Encode(e)
-		}
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	e.Int(int(o.Value))
 }
 
-var jsonFieldsNameOfMapFieldContentType = [1]string{
-	0: "format",
-}
-
-// Decode decodes MapFieldContentType from json.
-func (s *MapFieldContentType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MapFieldContentType to nil")
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "format":
-			if err := func() error {
-				s.Format.Reset()
-				if err := s.Format.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MapFieldContentType")
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
 	}
-
+	o.Value = int(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MapFieldContentType) MarshalJSON() ([]byte, error) {
+func (s OptInt) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MapFieldContentType) UnmarshalJSON(data []byte) error {
+func (s *OptInt) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MapFieldFieldType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MapFieldFieldType) encodeFields(e *jx.Encoder) {
-	{
-		if s.Format.Set {
-			e.FieldStart("format")
-			s.Format.Encode(e)
-		}
+// Encode encodes int64 as json.
+func (o OptInt64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	e.Int64(int64(o.Value))
 }
 
-var jsonFieldsNameOfMapFieldFieldType = [1]string{
-	0: "format",
-}
-
-// Decode decodes MapFieldFieldType from json.
-func (s *MapFieldFieldType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MapFieldFieldType to nil")
+// Decode decodes int64 from json.
+func (o *OptInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt64 to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "format":
-			if err := func() error {
-				s.Format.Reset()
-				if err := s.Format.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MapFieldFieldType")
+	o.Set = true
+	v, err := d.Int64()
+	if err != nil {
+		return err
 	}
-
+	o.Value = int64(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MapFieldFieldType) MarshalJSON() ([]byte, error) {
+func (s OptInt64) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MapFieldFieldType) UnmarshalJSON(data []byte) error {
+func (s *OptInt64) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MapFieldFile) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MapFieldFile) encodeFields(e *jx.Encoder) {
-	{
-		if s.Format.Set {
-			e.FieldStart("format")
-			s.Format.Encode(e)
-		}
+// Encode encodes Job as json.
+func (o OptJob) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
-var jsonFieldsNameOfMapFieldFile = [1]string{
-	0: "format",
-}
-
-// Decode decodes MapFieldFile from json.
-func (s *MapFieldFile) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MapFieldFile to nil")
+// Decode decodes Job from json.
+func (o *OptJob) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptJob to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "format":
-			if err := func() error {
-				s.Format.Reset()
-				if err := s.Format.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MapFieldFile")
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MapFieldFile) MarshalJSON() ([]byte, error) {
+func (s OptJob) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MapFieldFile) UnmarshalJSON(data []byte) error {
+func (s *OptJob) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MapFieldFormatType) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MapFieldFormatType) encodeFields(e *jx.Encoder) {
-	{
-		if s.Format.Set {
-			e.FieldStart("format")
-			s.Format.Encode(e)
-		}
+// Encode encodes JobDefinition as json.
+func (o OptJobDefinition) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
-var jsonFieldsNameOfMapFieldFormatType = [1]string{
-	0: "format",
-}
-
-// Decode decodes MapFieldFormatType from json.
-func (s *MapFieldFormatType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MapFieldFormatType to nil")
+// Decode decodes JobDefinition from json.
+func (o *OptJobDefinition) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptJobDefinition to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "format":
-			if err := func() error {
-				s.Format.Reset()
-				if err := s.Format.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MapFieldFormatType")
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MapFieldFormatType) MarshalJSON() ([]byte, error) {
+func (s OptJobDefinition) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MapFieldFormatType) UnmarshalJSON(data []byte) error {
+func (s *OptJobDefinition) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MapFieldLength) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes JobDescription as json.
+func (o OptJobDescription) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *MapFieldLength) encodeFields(e *jx.Encoder) {
-	{
-		if s.Format.Set {
-			e.FieldStart("format")
-			s.Format.Encode(e)
-		}
+// Decode decodes JobDescription from json.
+func (o *OptJobDescription) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptJobDescription to nil")
 	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptJobDescription) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-var jsonFieldsNameOfMapFieldLength = [1]string{
-	0: "format",
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptJobDescription) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
 }
 
-// Decode decodes MapFieldLength from json.
-func (s *MapFieldLength) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MapFieldLength to nil")
+// Encode encodes JobResultJobResult as json.
+func (o OptJobResultJobResult) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "format":
-			if err := func() error {
-				s.Format.Reset()
-				if err := s.Format.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MapFieldLength")
+// Decode decodes JobResultJobResult from json.
+func (o *OptJobResultJobResult) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptJobResultJobResult to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MapFieldLength) MarshalJSON() ([]byte, error) {
+func (s OptJobResultJobResult) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MapFieldLength) UnmarshalJSON(data []byte) error {
+func (s *OptJobResultJobResult) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MapFieldName) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MapFieldName) encodeFields(e *jx.Encoder) {
-	{
-		if s.Format.Set {
-			e.FieldStart("format")
-			s.Format.Encode(e)
-		}
+// Encode encodes JobStatusResponseStatus as json.
+func (o OptJobStatusResponseStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
-var jsonFieldsNameOfMapFieldName = [1]string{
-	0: "format",
-}
-
-// Decode decodes MapFieldName from json.
-func (s *MapFieldName) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MapFieldName to nil")
+// Decode decodes JobStatusResponseStatus from json.
+func (o *OptJobStatusResponseStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptJobStatusResponseStatus to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "format":
-			if err := func() error {
-				s.Format.Reset()
-				if err := s.Format.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MapFieldName")
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MapFieldName) MarshalJSON() ([]byte, error) {
+func (s OptJobStatusResponseStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MapFieldName) UnmarshalJSON(data []byte) error {
+func (s *OptJobStatusResponseStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MapFieldShortName) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MapFieldShortName) encodeFields(e *jx.Encoder) {
-	{
-		if s.Format.Set {
-			e.FieldStart("format")
-			s.Format.Encode(e)
-		}
+// Encode encodes JobStore as json.
+func (o OptJobStore) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
-var jsonFieldsNameOfMapFieldShortName = [1]string{
-	0: "format",
-}
-
-// Decode decodes MapFieldShortName from json.
-func (s *MapFieldShortName) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MapFieldShortName to nil")
+// Decode decodes JobStore from json.
+func (o *OptJobStore) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptJobStore to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "format":
-			if err := func() error {
-				s.Format.Reset()
-				if err := s.Format.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MapFieldShortName")
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MapFieldShortName) MarshalJSON() ([]byte, error) {
+func (s OptJobStore) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MapFieldShortName) UnmarshalJSON(data []byte) error {
+func (s *OptJobStore) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *Mapping) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes RecordChangeAfter as json.
+func (o OptRecordChangeAfter) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *Mapping) encodeFields(e *jx.Encoder) {
-	{
-		if s.Map.Set {
-			e.FieldStart("Map")
-			s.Map.Encode(e)
-		}
+// Decode decodes RecordChangeAfter from json.
+func (o *OptRecordChangeAfter) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptRecordChangeAfter to nil")
 	}
-	{
-		if s.Format != nil {
-			e.FieldStart("format")
-			s.Format.Encode(e)
-		}
+	o.Set = true
+	o.Value = make(RecordChangeAfter)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfMapping = [2]string{
-	0: "Map",
-	1: "format",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptRecordChangeAfter) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes Mapping from json.
-func (s *Mapping) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Mapping to nil")
-	}
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptRecordChangeAfter) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "Map":
-			if err := func() error {
-				s.Map.Reset()
-				if err := s.Map.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Map\"")
-			}
-		case "format":
-			if err := func() error {
-				s.Format = nil
-				var elem MappingFormat
-				if err := elem.Decode(d); err != nil {
-					return err
-				}
-				s.Format = &elem
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"format\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Mapping")
+// Encode encodes RecordChangeBefore as json.
+func (o OptRecordChangeBefore) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
+}
 
+// Decode decodes RecordChangeBefore from json.
+func (o *OptRecordChangeBefore) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptRecordChangeBefore to nil")
+	}
+	o.Set = true
+	o.Value = make(RecordChangeBefore)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Mapping) MarshalJSON() ([]byte, error) {
+func (s OptRecordChangeBefore) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Mapping) UnmarshalJSON(data []byte) error {
+func (s *OptRecordChangeBefore) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MappingFormat) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MappingFormat) encodeFields(e *jx.Encoder) {
+// Encode encodes RecordChangeOperation as json.
+func (o OptRecordChangeOperation) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
 }
 
-var jsonFieldsNameOfMappingFormat = [0]string{}
-
-// Decode decodes MappingFormat from json.
-func (s *MappingFormat) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MappingFormat to nil")
+// Decode decodes RecordChangeOperation from json.
+func (o *OptRecordChangeOperation) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptRecordChangeOperation to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
-		}
-	}); err != nil {
-		return errors.Wrap(err, "decode MappingFormat")
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MappingFormat) MarshalJSON() ([]byte, error) {
+func (s OptRecordChangeOperation) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MappingFormat) UnmarshalJSON(data []byte) error {
+func (s *OptRecordChangeOperation) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MappingLocation) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes SQLQueryBatch as json.
+func (o OptSQLQueryBatch) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *MappingLocation) encodeFields(e *jx.Encoder) {
-	{
-		if s.Target.Set {
-			e.FieldStart("target")
-			s.Target.Encode(e)
-		}
+// Decode decodes SQLQueryBatch from json.
+func (o *OptSQLQueryBatch) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptSQLQueryBatch to nil")
 	}
-	{
-		if s.File.Set {
-			e.FieldStart("file")
-			s.File.Encode(e)
-		}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfMappingLocation = [2]string{
-	0: "target",
-	1: "file",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptSQLQueryBatch) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes MappingLocation from json.
-func (s *MappingLocation) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MappingLocation to nil")
-	}
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptSQLQueryBatch) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "target":
-			if err := func() error {
-				s.Target.Reset()
-				if err := s.Target.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"target\"")
-			}
-		case "file":
-			if err := func() error {
-				s.File.Reset()
-				if err := s.File.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"file\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MappingLocation")
+// Encode encodes StatusResponseStatus as json.
+func (o OptStatusResponseStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
+}
 
+// Decode decodes StatusResponseStatus from json.
+func (o *OptStatusResponseStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptStatusResponseStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MappingLocation) MarshalJSON() ([]byte, error) {
+func (s OptStatusResponseStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MappingLocation) UnmarshalJSON(data []byte) error {
+func (s *OptStatusResponseStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MappingMap) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *MappingMap) encodeFields(e *jx.Encoder) {
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
-		}
-	}
-	{
-		if s.LastModified.Set {
-			e.FieldStart("lastModified")
-			s.LastModified.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.Isn.Set {
-			e.FieldStart("isn")
-			s.Isn.Encode(e)
-		}
-	}
-	{
-		if s.Definition.Set {
-			e.FieldStart("definition")
-			s.Definition.Encode(e)
-		}
-	}
-	{
-		if s.Data.Set {
-			e.FieldStart("data")
-			s.Data.Encode(e)
-		}
-	}
-	{
-		if s.Fields != nil {
-			e.FieldStart("fields")
-			e.ArrStart()
-			for _, elem := range s.Fields {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	e.Str(string(o.Value))
 }
 
-var jsonFieldsNameOfMappingMap = [6]string{
-	0: "name",
-	1: "lastModified",
-	2: "isn",
-	3: "definition",
-	4: "data",
-	5: "fields",
-}
-
-// Decode decodes MappingMap from json.
-func (s *MappingMap) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode MappingMap to nil")
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "lastModified":
-			if err := func() error {
-				s.LastModified.Reset()
-				if err := s.LastModified.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lastModified\"")
-			}
-		case "isn":
-			if err := func() error {
-				s.Isn.Reset()
-				if err := s.Isn.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"isn\"")
-			}
-		case "definition":
-			if err := func() error {
-				s.Definition.Reset()
-				if err := s.Definition.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"definition\"")
-			}
-		case "data":
-			if err := func() error {
-				s.Data.Reset()
-				if err := s.Data.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
-			}
-		case "fields":
-			if err := func() error {
-				s.Fields = make([]MapField, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem MapField
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Fields = append(s.Fields, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fields\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MappingMap")
+	o.Set = true
+	v, err := d.Str()
+	if err != nil {
+		return err
 	}
-
+	o.Value = string(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MappingMap) MarshalJSON() ([]byte, error) {
+func (s OptString) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MappingMap) UnmarshalJSON(data []byte) error {
+func (s *OptString) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *Maps) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *Maps) encodeFields(e *jx.Encoder) {
-	{
-		if s.Maps != nil {
-			e.FieldStart("Maps")
-			e.ArrStart()
-			for _, elem := range s.Maps {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+// Encode encodes TableReference as json.
+func (o OptTableReference) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
 }
 
-var jsonFieldsNameOfMaps = [1]string{
-	0: "Maps",
-}
-
-// Decode decodes Maps from json.
-func (s *Maps) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Maps to nil")
+// Decode decodes TableReference from json.
+func (o *OptTableReference) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptTableReference to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "Maps":
-			if err := func() error {
-				s.Maps = make([]Map, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Map
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Maps = append(s.Maps, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Maps\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Maps")
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Maps) MarshalJSON() ([]byte, error) {
+func (s OptTableReference) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Maps) UnmarshalJSON(data []byte) error {
+func (s *OptTableReference) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes bool as json.
-func (o OptBool) Encode(e *jx.Encoder) {
+// Encode encodes uuid.UUID as json.
+func (o OptUUID) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
-	e.Bool(bool(o.Value))
+	json.EncodeUUID(e, o.Value)
 }
 
-// Decode decodes bool from json.
-func (o *OptBool) Decode(d *jx.Decoder) error {
+// Decode decodes uuid.UUID from json.
+func (o *OptUUID) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptBool to nil")
+		return errors.New("invalid: unable to decode OptUUID to nil")
 	}
 	o.Set = true
-	v, err := d.Bool()
+	v, err := json.DecodeUUID(d)
 	if err != nil {
 		return err
 	}
-	o.Value = bool(v)
+	o.Value = v
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptBool) MarshalJSON() ([]byte, error) {
+func (s OptUUID) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBool) UnmarshalJSON(data []byte) error {
+func (s *OptUUID) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ClusterConfig as json.
-func (o OptClusterConfig) Encode(e *jx.Encoder) {
+// Encode encodes UpdateRecordsByFieldsReq as json.
+func (o OptUpdateRecordsByFieldsReq) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes ClusterConfig from json.
-func (o *OptClusterConfig) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateRecordsByFieldsReq from json.
+func (o *OptUpdateRecordsByFieldsReq) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptClusterConfig to nil")
+		return errors.New("invalid: unable to decode OptUpdateRecordsByFieldsReq to nil")
 	}
 	o.Set = true
 	if err := o.Value.Decode(d); err != nil {
@@ -7094,30 +9030,30 @@ func (o *OptClusterConfig) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptClusterConfig) MarshalJSON() ([]byte, error) {
+func (s OptUpdateRecordsByFieldsReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptClusterConfig) UnmarshalJSON(data []byte) error {
+func (s *OptUpdateRecordsByFieldsReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ConfigDatabaseAccess as json.
-func (o OptConfigDatabaseAccess) Encode(e *jx.Encoder) {
+// Encode encodes User as json.
+func (o OptUser) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes ConfigDatabaseAccess from json.
-func (o *OptConfigDatabaseAccess) Decode(d *jx.Decoder) error {
+// Decode decodes User from json.
+func (o *OptUser) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptConfigDatabaseAccess to nil")
+		return errors.New("invalid: unable to decode OptUser to nil")
 	}
 	o.Set = true
 	if err := o.Value.Decode(d); err != nil {
@@ -7127,1323 +9063,2634 @@ func (o *OptConfigDatabaseAccess) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptConfigDatabaseAccess) MarshalJSON() ([]byte, error) {
+func (s OptUser) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptConfigDatabaseAccess) UnmarshalJSON(data []byte) error {
+func (s *OptUser) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ConfigMapping as json.
-func (o OptConfigMapping) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes PatchRecordByKeyBadRequest as json.
+func (s *PatchRecordByKeyBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes ConfigMapping from json.
-func (o *OptConfigMapping) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptConfigMapping to nil")
+// Decode decodes PatchRecordByKeyBadRequest from json.
+func (s *PatchRecordByKeyBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchRecordByKeyBadRequest to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = PatchRecordByKeyBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptConfigMapping) MarshalJSON() ([]byte, error) {
+func (s *PatchRecordByKeyBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptConfigMapping) UnmarshalJSON(data []byte) error {
+func (s *PatchRecordByKeyBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ConfigMetrics as json.
-func (o OptConfigMetrics) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes PatchRecordByKeyNotFound as json.
+func (s *PatchRecordByKeyNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes ConfigMetrics from json.
-func (o *OptConfigMetrics) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptConfigMetrics to nil")
+// Decode decodes PatchRecordByKeyNotFound from json.
+func (s *PatchRecordByKeyNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchRecordByKeyNotFound to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = PatchRecordByKeyNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptConfigMetrics) MarshalJSON() ([]byte, error) {
+func (s *PatchRecordByKeyNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptConfigMetrics) UnmarshalJSON(data []byte) error {
+func (s *PatchRecordByKeyNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ConfigModule as json.
-func (o OptConfigModule) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s PatchRecordByKeyReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s PatchRecordByKeyReq) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes ConfigModule from json.
-func (o *OptConfigModule) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptConfigModule to nil")
+// Decode decodes PatchRecordByKeyReq from json.
+func (s *PatchRecordByKeyReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchRecordByKeyReq to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PatchRecordByKeyReq")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptConfigModule) MarshalJSON() ([]byte, error) {
+func (s PatchRecordByKeyReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptConfigModule) UnmarshalJSON(data []byte) error {
+func (s *PatchRecordByKeyReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ConfigServer as json.
-func (o OptConfigServer) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes PostJobBadRequest as json.
+func (s *PostJobBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes ConfigServer from json.
-func (o *OptConfigServer) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptConfigServer to nil")
+// Decode decodes PostJobBadRequest from json.
+func (s *PostJobBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PostJobBadRequest to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = PostJobBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptConfigServer) MarshalJSON() ([]byte, error) {
+func (s *PostJobBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptConfigServer) UnmarshalJSON(data []byte) error {
+func (s *PostJobBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ConfigServerLogLocation as json.
-func (o OptConfigServerLogLocation) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes PostJobNotFound as json.
+func (s *PostJobNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes ConfigServerLogLocation from json.
-func (o *OptConfigServerLogLocation) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptConfigServerLogLocation to nil")
+// Decode decodes PostJobNotFound from json.
+func (s *PostJobNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PostJobNotFound to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = PostJobNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptConfigServerLogLocation) MarshalJSON() ([]byte, error) {
+func (s *PostJobNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptConfigServerLogLocation) UnmarshalJSON(data []byte) error {
+func (s *PostJobNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DatabaseConfig as json.
-func (o OptDatabaseConfig) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *RecordChange) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes DatabaseConfig from json.
-func (o *OptDatabaseConfig) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptDatabaseConfig to nil")
+// encodeFields encodes fields.
+func (s *RecordChange) encodeFields(e *jx.Encoder) {
+	{
+		if s.Operation.Set {
+			e.FieldStart("Operation")
+			s.Operation.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.User.Set {
+			e.FieldStart("User")
+			s.User.Encode(e)
+		}
+	}
+	{
+		if s.UUID.Set {
+			e.FieldStart("UUID")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		if s.Modified.Set {
+			e.FieldStart("Modified")
+			s.Modified.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Before.Set {
+			e.FieldStart("Before")
+			s.Before.Encode(e)
+		}
+	}
+	{
+		if s.After.Set {
+			e.FieldStart("After")
+			s.After.Encode(e)
+		}
 	}
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptDatabaseConfig) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
+var jsonFieldsNameOfRecordChange = [6]string{
+	0: "Operation",
+	1: "User",
+	2: "UUID",
+	3: "Modified",
+	4: "Before",
+	5: "After",
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDatabaseConfig) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
+// Decode decodes RecordChange from json.
+func (s *RecordChange) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RecordChange to nil")
+	}
 
-// Encode encodes time.Time as json.
-func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Operation":
+			if err := func() error {
+				s.Operation.Reset()
+				if err := s.Operation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Operation\"")
+			}
+		case "User":
+			if err := func() error {
+				s.User.Reset()
+				if err := s.User.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"User\"")
+			}
+		case "UUID":
+			if err := func() error {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"UUID\"")
+			}
+		case "Modified":
+			if err := func() error {
+				s.Modified.Reset()
+				if err := s.Modified.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Modified\"")
+			}
+		case "Before":
+			if err := func() error {
+				s.Before.Reset()
+				if err := s.Before.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Before\"")
+			}
+		case "After":
+			if err := func() error {
+				s.After.Reset()
+				if err := s.After.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"After\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RecordChange")
 	}
-	format(e, o.Value)
-}
 
-// Decode decodes time.Time from json.
-func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptDateTime to nil")
-	}
-	o.Set = true
-	v, err := format(d)
-	if err != nil {
-		return err
-	}
-	o.Value = v
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDateTime) MarshalJSON() ([]byte, error) {
+func (s *RecordChange) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
+	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+func (s *RecordChange) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
+	return s.Decode(d)
 }
 
-// Encode encodes ErrorError as json.
-func (o OptErrorError) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s RecordChangeAfter) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s RecordChangeAfter) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes ErrorError from json.
-func (o *OptErrorError) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptErrorError to nil")
+// Decode decodes RecordChangeAfter from json.
+func (s *RecordChangeAfter) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RecordChangeAfter to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RecordChangeAfter")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptErrorError) MarshalJSON() ([]byte, error) {
+func (s RecordChangeAfter) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptErrorError) UnmarshalJSON(data []byte) error {
+func (s *RecordChangeAfter) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes float64 as json.
-func (o OptFloat64) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s RecordChangeBefore) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s RecordChangeBefore) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	e.Float64(float64(o.Value))
 }
 
-// Decode decodes float64 from json.
-func (o *OptFloat64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptFloat64 to nil")
+// Decode decodes RecordChangeBefore from json.
+func (s *RecordChangeBefore) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RecordChangeBefore to nil")
 	}
-	o.Set = true
-	v, err := d.Float64()
-	if err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RecordChangeBefore")
 	}
-	o.Value = float64(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptFloat64) MarshalJSON() ([]byte, error) {
+func (s RecordChangeBefore) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptFloat64) UnmarshalJSON(data []byte) error {
+func (s *RecordChangeBefore) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes InsertRecordReq as json.
-func (o OptInsertRecordReq) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes RecordChangeOperation as json.
+func (s RecordChangeOperation) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes InsertRecordReq from json.
-func (o *OptInsertRecordReq) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInsertRecordReq to nil")
+// Decode decodes RecordChangeOperation from json.
+func (s *RecordChangeOperation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RecordChangeOperation to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.StrBytes()
+	if err != nil {
 		return err
 	}
+	// Try to use constant string.
+	switch RecordChangeOperation(v) {
+	case RecordChangeOperationInsert:
+		*s = RecordChangeOperationInsert
+	case RecordChangeOperationUpdate:
+		*s = RecordChangeOperationUpdate
+	case RecordChangeOperationDelete:
+		*s = RecordChangeOperationDelete
+	default:
+		*s = RecordChangeOperation(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptInsertRecordReq) MarshalJSON() ([]byte, error) {
+func (s RecordChangeOperation) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInsertRecordReq) UnmarshalJSON(data []byte) error {
+func (s *RecordChangeOperation) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes int as json.
-func (o OptInt) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Int(int(o.Value))
+// Encode implements json.Marshaler.
+func (s *RecordHistory) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes int from json.
-func (o *OptInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt to nil")
+// encodeFields encodes fields.
+func (s *RecordHistory) encodeFields(e *jx.Encoder) {
+	{
+		if s.Table.Set {
+			e.FieldStart("Table")
+			s.Table.Encode(e)
+		}
 	}
-	o.Set = true
-	v, err := d.Int()
-	if err != nil {
-		return err
+	{
+		if s.Key.Set {
+			e.FieldStart("Key")
+			s.Key.Encode(e)
+		}
+	}
+	{
+		if s.Changes != nil {
+			e.FieldStart("Changes")
+			e.ArrStart()
+			for _, elem := range s.Changes {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	o.Value = int(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptInt) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfRecordHistory = [3]string{
+	0: "Table",
+	1: "Key",
+	2: "Changes",
 }
 
-// Encode encodes int64 as json.
-func (o OptInt64) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes RecordHistory from json.
+func (s *RecordHistory) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RecordHistory to nil")
 	}
-	e.Int64(int64(o.Value))
-}
 
-// Decode decodes int64 from json.
-func (o *OptInt64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt64 to nil")
-	}
-	o.Set = true
-	v, err := d.Int64()
-	if err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Table":
+			if err := func() error {
+				s.Table.Reset()
+				if err := s.Table.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Table\"")
+			}
+		case "Key":
+			if err := func() error {
+				s.Key.Reset()
+				if err := s.Key.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Key\"")
+			}
+		case "Changes":
+			if err := func() error {
+				s.Changes = make([]RecordChange, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RecordChange
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Changes = append(s.Changes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Changes\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RecordHistory")
 	}
-	o.Value = int64(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptInt64) MarshalJSON() ([]byte, error) {
+func (s *RecordHistory) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt64) UnmarshalJSON(data []byte) error {
+func (s *RecordHistory) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes Job as json.
-func (o OptJob) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes RemoveSessionCompatBadRequest as json.
+func (s *RemoveSessionCompatBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes Job from json.
-func (o *OptJob) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptJob to nil")
+// Decode decodes RemoveSessionCompatBadRequest from json.
+func (s *RemoveSessionCompatBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RemoveSessionCompatBadRequest to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = RemoveSessionCompatBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptJob) MarshalJSON() ([]byte, error) {
+func (s *RemoveSessionCompatBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptJob) UnmarshalJSON(data []byte) error {
+func (s *RemoveSessionCompatBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes JobDefinition as json.
-func (o OptJobDefinition) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes RemoveSessionCompatNotFound as json.
+func (s *RemoveSessionCompatNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes JobDefinition from json.
-func (o *OptJobDefinition) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptJobDefinition to nil")
+// Decode decodes RemoveSessionCompatNotFound from json.
+func (s *RemoveSessionCompatNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RemoveSessionCompatNotFound to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = RemoveSessionCompatNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptJobDefinition) MarshalJSON() ([]byte, error) {
+func (s *RemoveSessionCompatNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptJobDefinition) UnmarshalJSON(data []byte) error {
+func (s *RemoveSessionCompatNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes JobDescription as json.
-func (o OptJobDescription) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *Response) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes JobDescription from json.
-func (o *OptJobDescription) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptJobDescription to nil")
+// encodeFields encodes fields.
+func (s *Response) encodeFields(e *jx.Encoder) {
+	{
+		if s.MapName.Set {
+			e.FieldStart("MapName")
+			s.MapName.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.FileRecords.Set {
+			e.FieldStart("FileRecords")
+			s.FileRecords.Encode(e)
+		}
+	}
+	{
+		if s.NrRecords.Set {
+			e.FieldStart("NrRecords")
+			s.NrRecords.Encode(e)
+		}
+	}
+	{
+		if s.FieldNames != nil {
+			e.FieldStart("FieldNames")
+			e.ArrStart()
+			for _, elem := range s.FieldNames {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Records != nil {
+			e.FieldStart("Records")
+			e.ArrStart()
+			for _, elem := range s.Records {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Next.Set {
+			e.FieldStart("Next")
+			s.Next.Encode(e)
+		}
+	}
+	{
+		if s.Prev.Set {
+			e.FieldStart("Prev")
+			s.Prev.Encode(e)
+		}
+	}
+	{
+		if s.NrInserted.Set {
+			e.FieldStart("NrInserted")
+			s.NrInserted.Encode(e)
+		}
+	}
+	{
+		if s.NrUpdated.Set {
+			e.FieldStart("NrUpdated")
+			s.NrUpdated.Encode(e)
+		}
+	}
+	{
+		if s.DryRun.Set {
+			e.FieldStart("DryRun")
+			s.DryRun.Encode(e)
+		}
+	}
+	{
+		if s.ConfirmToken.Set {
+			e.FieldStart("ConfirmToken")
+			s.ConfirmToken.Encode(e)
+		}
+	}
+	{
+		if s.MaxAffectedRows.Set {
+			e.FieldStart("MaxAffectedRows")
+			s.MaxAffectedRows.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptJobDescription) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptJobDescription) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfResponse = [12]string{
+	0:  "MapName",
+	1:  "FileRecords",
+	2:  "NrRecords",
+	3:  "FieldNames",
+	4:  "Records",
+	5:  "Next",
+	6:  "Prev",
+	7:  "NrInserted",
+	8:  "NrUpdated",
+	9:  "DryRun",
+	10: "ConfirmToken",
+	11: "MaxAffectedRows",
 }
 
-// Encode encodes JobResultJobResult as json.
-func (o OptJobResultJobResult) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes Response from json.
+func (s *Response) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Response to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes JobResultJobResult from json.
-func (o *OptJobResultJobResult) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptJobResultJobResult to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "MapName":
+			if err := func() error {
+				s.MapName.Reset()
+				if err := s.MapName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MapName\"")
+			}
+		case "FileRecords":
+			if err := func() error {
+				s.FileRecords.Reset()
+				if err := s.FileRecords.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"FileRecords\"")
+			}
+		case "NrRecords":
+			if err := func() error {
+				s.NrRecords.Reset()
+				if err := s.NrRecords.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"NrRecords\"")
+			}
+		case "FieldNames":
+			if err := func() error {
+				s.FieldNames = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.FieldNames = append(s.FieldNames, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"FieldNames\"")
+			}
+		case "Records":
+			if err := func() error {
+				s.Records = make([]ResponseRecordsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ResponseRecordsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Records = append(s.Records, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Records\"")
+			}
+		case "Next":
+			if err := func() error {
+				s.Next.Reset()
+				if err := s.Next.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Next\"")
+			}
+		case "Prev":
+			if err := func() error {
+				s.Prev.Reset()
+				if err := s.Prev.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Prev\"")
+			}
+		case "NrInserted":
+			if err := func() error {
+				s.NrInserted.Reset()
+				if err := s.NrInserted.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"NrInserted\"")
+			}
+		case "NrUpdated":
+			if err := func() error {
+				s.NrUpdated.Reset()
+				if err := s.NrUpdated.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"NrUpdated\"")
+			}
+		case "DryRun":
+			if err := func() error {
+				s.DryRun.Reset()
+				if err := s.DryRun.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DryRun\"")
+			}
+		case "ConfirmToken":
+			if err := func() error {
+				s.ConfirmToken.Reset()
+				if err := s.ConfirmToken.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ConfirmToken\"")
+			}
+		case "MaxAffectedRows":
+			if err := func() error {
+				s.MaxAffectedRows.Reset()
+				if err := s.MaxAffectedRows.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaxAffectedRows\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Response")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptJobResultJobResult) MarshalJSON() ([]byte, error) {
+func (s *Response) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptJobResultJobResult) UnmarshalJSON(data []byte) error {
+func (s *Response) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes JobStatusResponseStatus as json.
-func (o OptJobStatusResponseStatus) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s ResponseRaw) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ResponseRaw) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes JobStatusResponseStatus from json.
-func (o *OptJobStatusResponseStatus) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptJobStatusResponseStatus to nil")
+// Decode decodes ResponseRaw from json.
+func (s *ResponseRaw) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ResponseRaw to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ResponseRaw")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptJobStatusResponseStatus) MarshalJSON() ([]byte, error) {
+func (s ResponseRaw) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptJobStatusResponseStatus) UnmarshalJSON(data []byte) error {
+func (s *ResponseRaw) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes JobStore as json.
-func (o OptJobStore) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s ResponseRecordsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ResponseRecordsItem) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes JobStore from json.
-func (o *OptJobStore) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptJobStore to nil")
+// Decode decodes ResponseRecordsItem from json.
+func (s *ResponseRecordsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ResponseRecordsItem to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ResponseRecordsItem")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptJobStore) MarshalJSON() ([]byte, error) {
+func (s ResponseRecordsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptJobStore) UnmarshalJSON(data []byte) error {
+func (s *ResponseRecordsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MapFieldCharset as json.
-func (o OptMapFieldCharset) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *SQLQuery) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes MapFieldCharset from json.
-func (o *OptMapFieldCharset) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMapFieldCharset to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+// encodeFields encodes fields.
+func (s *SQLQuery) encodeFields(e *jx.Encoder) {
+	{
+		if s.Batch.Set {
+			e.FieldStart("Batch")
+			s.Batch.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptMapFieldCharset) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMapFieldCharset) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfSQLQuery = [1]string{
+	0: "Batch",
 }
 
-// Encode encodes MapFieldContentType as json.
-func (o OptMapFieldContentType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes SQLQuery from json.
+func (s *SQLQuery) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SQLQuery to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes MapFieldContentType from json.
-func (o *OptMapFieldContentType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMapFieldContentType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Batch":
+			if err := func() error {
+				s.Batch.Reset()
+				if err := s.Batch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Batch\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SQLQuery")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMapFieldContentType) MarshalJSON() ([]byte, error) {
+func (s *SQLQuery) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMapFieldContentType) UnmarshalJSON(data []byte) error {
+func (s *SQLQuery) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MapFieldFieldType as json.
-func (o OptMapFieldFieldType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *SQLQueryBatch) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SQLQueryBatch) encodeFields(e *jx.Encoder) {
+	{
+		if s.SQL.Set {
+			e.FieldStart("SQL")
+			s.SQL.Encode(e)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes MapFieldFieldType from json.
-func (o *OptMapFieldFieldType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMapFieldFieldType to nil")
+var jsonFieldsNameOfSQLQueryBatch = [1]string{
+	0: "SQL",
+}
+
+// Decode decodes SQLQueryBatch from json.
+func (s *SQLQueryBatch) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SQLQueryBatch to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "SQL":
+			if err := func() error {
+				s.SQL.Reset()
+				if err := s.SQL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SQL\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SQLQueryBatch")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMapFieldFieldType) MarshalJSON() ([]byte, error) {
+func (s *SQLQueryBatch) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMapFieldFieldType) UnmarshalJSON(data []byte) error {
+func (s *SQLQueryBatch) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MapFieldFile as json.
-func (o OptMapFieldFile) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes SearchModellingBadRequest as json.
+func (s *SearchModellingBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes MapFieldFile from json.
-func (o *OptMapFieldFile) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMapFieldFile to nil")
+// Decode decodes SearchModellingBadRequest from json.
+func (s *SearchModellingBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SearchModellingBadRequest to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = SearchModellingBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMapFieldFile) MarshalJSON() ([]byte, error) {
+func (s *SearchModellingBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMapFieldFile) UnmarshalJSON(data []byte) error {
+func (s *SearchModellingBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MapFieldFormatType as json.
-func (o OptMapFieldFormatType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes SearchModellingNotFound as json.
+func (s *SearchModellingNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes MapFieldFormatType from json.
-func (o *OptMapFieldFormatType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMapFieldFormatType to nil")
+// Decode decodes SearchModellingNotFound from json.
+func (s *SearchModellingNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SearchModellingNotFound to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = SearchModellingNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMapFieldFormatType) MarshalJSON() ([]byte, error) {
+func (s *SearchModellingNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMapFieldFormatType) UnmarshalJSON(data []byte) error {
+func (s *SearchModellingNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MapFieldLength as json.
-func (o OptMapFieldLength) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes SearchTableBadRequest as json.
+func (s *SearchTableBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes MapFieldLength from json.
-func (o *OptMapFieldLength) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMapFieldLength to nil")
+// Decode decodes SearchTableBadRequest from json.
+func (s *SearchTableBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SearchTableBadRequest to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = SearchTableBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMapFieldLength) MarshalJSON() ([]byte, error) {
+func (s *SearchTableBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMapFieldLength) UnmarshalJSON(data []byte) error {
+func (s *SearchTableBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MapFieldName as json.
-func (o OptMapFieldName) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes SearchTableNotFound as json.
+func (s *SearchTableNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes MapFieldName from json.
-func (o *OptMapFieldName) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMapFieldName to nil")
+// Decode decodes SearchTableNotFound from json.
+func (s *SearchTableNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SearchTableNotFound to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = SearchTableNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMapFieldName) MarshalJSON() ([]byte, error) {
+func (s *SearchTableNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMapFieldName) UnmarshalJSON(data []byte) error {
+func (s *SearchTableNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MapFieldShortName as json.
-func (o OptMapFieldShortName) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *StatusResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatusResponse) encodeFields(e *jx.Encoder) {
+	{
+		if s.Status.Set {
+			e.FieldStart("Status")
+			s.Status.Encode(e)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes MapFieldShortName from json.
-func (o *OptMapFieldShortName) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMapFieldShortName to nil")
+var jsonFieldsNameOfStatusResponse = [1]string{
+	0: "Status",
+}
+
+// Decode decodes StatusResponse from json.
+func (s *StatusResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatusResponse to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Status\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatusResponse")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMapFieldShortName) MarshalJSON() ([]byte, error) {
+func (s *StatusResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMapFieldShortName) UnmarshalJSON(data []byte) error {
+func (s *StatusResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MappingLocation as json.
-func (o OptMappingLocation) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *StatusResponseStatus) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatusResponseStatus) encodeFields(e *jx.Encoder) {
+	{
+		if s.Action.Set {
+			e.FieldStart("Action")
+			s.Action.Encode(e)
+		}
+	}
+	{
+		if s.Code.Set {
+			e.FieldStart("Code")
+			s.Code.Encode(e)
+		}
+	}
+	{
+		if s.Dbid.Set {
+			e.FieldStart("Dbid")
+			s.Dbid.Encode(e)
+		}
+	}
+	{
+		if s.Target.Set {
+			e.FieldStart("Target")
+			s.Target.Encode(e)
+		}
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes MappingLocation from json.
-func (o *OptMappingLocation) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMappingLocation to nil")
+var jsonFieldsNameOfStatusResponseStatus = [5]string{
+	0: "Action",
+	1: "Code",
+	2: "Dbid",
+	3: "Target",
+	4: "Message",
+}
+
+// Decode decodes StatusResponseStatus from json.
+func (s *StatusResponseStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatusResponseStatus to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Action":
+			if err := func() error {
+				s.Action.Reset()
+				if err := s.Action.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Action\"")
+			}
+		case "Code":
+			if err := func() error {
+				s.Code.Reset()
+				if err := s.Code.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Code\"")
+			}
+		case "Dbid":
+			if err := func() error {
+				s.Dbid.Reset()
+				if err := s.Dbid.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Dbid\"")
+			}
+		case "Target":
+			if err := func() error {
+				s.Target.Reset()
+				if err := s.Target.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Target\"")
+			}
+		case "Message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatusResponseStatus")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptMappingLocation) MarshalJSON() ([]byte, error) {
+func (s *StatusResponseStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMappingLocation) UnmarshalJSON(data []byte) error {
+func (s *StatusResponseStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes MappingMap as json.
-func (o OptMappingMap) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *StoreResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes MappingMap from json.
-func (o *OptMappingMap) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptMappingMap to nil")
+// encodeFields encodes fields.
+func (s *StoreResponse) encodeFields(e *jx.Encoder) {
+	{
+		if s.NrStored.Set {
+			e.FieldStart("NrStored")
+			s.NrStored.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.NrDeleted.Set {
+			e.FieldStart("NrDeleted")
+			s.NrDeleted.Encode(e)
+		}
+	}
+	{
+		if s.Stored != nil {
+			e.FieldStart("Stored")
+			e.ArrStart()
+			for _, elem := range s.Stored {
+				e.Int(elem)
+			}
+			e.ArrEnd()
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptMappingMap) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptMappingMap) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfStoreResponse = [3]string{
+	0: "NrStored",
+	1: "NrDeleted",
+	2: "Stored",
 }
 
-// Encode encodes SQLQueryBatch as json.
-func (o OptSQLQueryBatch) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes StoreResponse from json.
+func (s *StoreResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StoreResponse to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes SQLQueryBatch from json.
-func (o *OptSQLQueryBatch) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptSQLQueryBatch to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "NrStored":
+			if err := func() error {
+				s.NrStored.Reset()
+				if err := s.NrStored.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"NrStored\"")
+			}
+		case "NrDeleted":
+			if err := func() error {
+				s.NrDeleted.Reset()
+				if err := s.NrDeleted.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"NrDeleted\"")
+			}
+		case "Stored":
+			if err := func() error {
+				s.Stored = make([]int, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int
+					v, err := d.Int()
+					elem = int(v)
+					if err != nil {
+						return err
+					}
+					s.Stored = append(s.Stored, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Stored\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StoreResponse")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptSQLQueryBatch) MarshalJSON() ([]byte, error) {
+func (s *StoreResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptSQLQueryBatch) UnmarshalJSON(data []byte) error {
+func (s *StoreResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes StatusResponseStatus as json.
-func (o OptStatusResponseStatus) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *TableColumn) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TableColumn) encodeFields(e *jx.Encoder) {
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("Type")
+			s.Type.Encode(e)
+		}
+	}
+	{
+		if s.Length.Set {
+			e.FieldStart("Length")
+			s.Length.Encode(e)
+		}
+	}
+	{
+		if s.Nullable.Set {
+			e.FieldStart("Nullable")
+			s.Nullable.Encode(e)
+		}
+	}
+	{
+		if s.Default.Set {
+			e.FieldStart("Default")
+			s.Default.Encode(e)
+		}
+	}
+	{
+		if s.PrimaryKey.Set {
+			e.FieldStart("PrimaryKey")
+			s.PrimaryKey.Encode(e)
+		}
+	}
+	{
+		if s.Generated.Set {
+			e.FieldStart("Generated")
+			s.Generated.Encode(e)
+		}
+	}
+	{
+		if s.ForeignKey.Set {
+			e.FieldStart("ForeignKey")
+			s.ForeignKey.Encode(e)
+		}
+	}
+	{
+		if s.Lob.Set {
+			e.FieldStart("Lob")
+			s.Lob.Encode(e)
+		}
+	}
+	{
+		if s.MimetypeField.Set {
+			e.FieldStart("MimetypeField")
+			s.MimetypeField.Encode(e)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes StatusResponseStatus from json.
-func (o *OptStatusResponseStatus) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptStatusResponseStatus to nil")
+var jsonFieldsNameOfTableColumn = [10]string{
+	0: "Name",
+	1: "Type",
+	2: "Length",
+	3: "Nullable",
+	4: "Default",
+	5: "PrimaryKey",
+	6: "Generated",
+	7: "ForeignKey",
+	8: "Lob",
+	9: "MimetypeField",
+}
+
+// Decode decodes TableColumn from json.
+func (s *TableColumn) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TableColumn to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "Type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Type\"")
+			}
+		case "Length":
+			if err := func() error {
+				s.Length.Reset()
+				if err := s.Length.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Length\"")
+			}
+		case "Nullable":
+			if err := func() error {
+				s.Nullable.Reset()
+				if err := s.Nullable.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Nullable\"")
+			}
+		case "Default":
+			if err := func() error {
+				s.Default.Reset()
+				if err := s.Default.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Default\"")
+			}
+		case "PrimaryKey":
+			if err := func() error {
+				s.PrimaryKey.Reset()
+				if err := s.PrimaryKey.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PrimaryKey\"")
+			}
+		case "Generated":
+			if err := func() error {
+				s.Generated.Reset()
+				if err := s.Generated.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Generated\"")
+			}
+		case "ForeignKey":
+			if err := func() error {
+				s.ForeignKey.Reset()
+				if err := s.ForeignKey.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ForeignKey\"")
+			}
+		case "Lob":
+			if err := func() error {
+				s.Lob.Reset()
+				if err := s.Lob.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Lob\"")
+			}
+		case "MimetypeField":
+			if err := func() error {
+				s.MimetypeField.Reset()
+				if err := s.MimetypeField.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MimetypeField\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TableColumn")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptStatusResponseStatus) MarshalJSON() ([]byte, error) {
+func (s *TableColumn) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptStatusResponseStatus) UnmarshalJSON(data []byte) error {
+func (s *TableColumn) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *TableMetadata) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
+// encodeFields encodes fields.
+func (s *TableMetadata) encodeFields(e *jx.Encoder) {
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
 	}
-	o.Set = true
-	v, err := d.Str()
-	if err != nil {
-		return err
+	{
+		if s.Driver.Set {
+			e.FieldStart("Driver")
+			s.Driver.Encode(e)
+		}
+	}
+	{
+		if s.Columns != nil {
+			e.FieldStart("Columns")
+			e.ArrStart()
+			for _, elem := range s.Columns {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	o.Value = string(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfTableMetadata = [3]string{
+	0: "Name",
+	1: "Driver",
+	2: "Columns",
 }
 
-// Encode encodes uuid.UUID as json.
-func (o OptUUID) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes TableMetadata from json.
+func (s *TableMetadata) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TableMetadata to nil")
 	}
-	json.EncodeUUID(e, o.Value)
-}
 
-// Decode decodes uuid.UUID from json.
-func (o *OptUUID) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUUID to nil")
-	}
-	o.Set = true
-	v, err := json.DecodeUUID(d)
-	if err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "Driver":
+			if err := func() error {
+				s.Driver.Reset()
+				if err := s.Driver.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Driver\"")
+			}
+		case "Columns":
+			if err := func() error {
+				s.Columns = make([]TableColumn, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TableColumn
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Columns = append(s.Columns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Columns\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TableMetadata")
 	}
-	o.Value = v
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptUUID) MarshalJSON() ([]byte, error) {
+func (s *TableMetadata) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUUID) UnmarshalJSON(data []byte) error {
+func (s *TableMetadata) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UpdateRecordsByFieldsReq as json.
-func (o OptUpdateRecordsByFieldsReq) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *TableReference) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TableReference) encodeFields(e *jx.Encoder) {
+	{
+		if s.Table.Set {
+			e.FieldStart("Table")
+			s.Table.Encode(e)
+		}
+	}
+	{
+		if s.Column.Set {
+			e.FieldStart("Column")
+			s.Column.Encode(e)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes UpdateRecordsByFieldsReq from json.
-func (o *OptUpdateRecordsByFieldsReq) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUpdateRecordsByFieldsReq to nil")
+var jsonFieldsNameOfTableReference = [2]string{
+	0: "Table",
+	1: "Column",
+}
+
+// Decode decodes TableReference from json.
+func (s *TableReference) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TableReference to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Table":
+			if err := func() error {
+				s.Table.Reset()
+				if err := s.Table.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Table\"")
+			}
+		case "Column":
+			if err := func() error {
+				s.Column.Reset()
+				if err := s.Column.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Column\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TableReference")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptUpdateRecordsByFieldsReq) MarshalJSON() ([]byte, error) {
+func (s *TableReference) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUpdateRecordsByFieldsReq) UnmarshalJSON(data []byte) error {
+func (s *TableReference) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes User as json.
-func (o OptUser) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *TablesMetadata) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TablesMetadata) encodeFields(e *jx.Encoder) {
+	{
+		if s.Tables != nil {
+			e.FieldStart("Tables")
+			e.ArrStart()
+			for _, elem := range s.Tables {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes User from json.
-func (o *OptUser) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUser to nil")
+var jsonFieldsNameOfTablesMetadata = [1]string{
+	0: "Tables",
+}
+
+// Decode decodes TablesMetadata from json.
+func (s *TablesMetadata) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TablesMetadata to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Tables":
+			if err := func() error {
+				s.Tables = make([]TableMetadata, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TableMetadata
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Tables = append(s.Tables, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Tables\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TablesMetadata")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptUser) MarshalJSON() ([]byte, error) {
+func (s *TablesMetadata) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUser) UnmarshalJSON(data []byte) error {
+func (s *TablesMetadata) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes PostJobBadRequest as json.
-func (s *PostJobBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode implements json.Marshaler.
+func (s *Transaction) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *Transaction) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("Operations")
+		e.ArrStart()
+		for _, elem := range s.Operations {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.DryRun.Set {
+			e.FieldStart("DryRun")
+			s.DryRun.Encode(e)
+		}
+	}
 }
 
-// Decode decodes PostJobBadRequest from json.
-func (s *PostJobBadRequest) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfTransaction = [2]string{
+	0: "Operations",
+	1: "DryRun",
+}
+
+// Decode decodes Transaction from json.
+func (s *Transaction) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PostJobBadRequest to nil")
+		return errors.New("invalid: unable to decode Transaction to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Operations":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Operations = make([]TransactionOperation, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TransactionOperation
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Operations = append(s.Operations, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Operations\"")
+			}
+		case "DryRun":
+			if err := func() error {
+				s.DryRun.Reset()
+				if err := s.DryRun.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DryRun\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode Transaction")
 	}
-	*s = PostJobBadRequest(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTransaction) {
+					name = jsonFieldsNameOfTransaction[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PostJobBadRequest) MarshalJSON() ([]byte, error) {
+func (s *Transaction) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PostJobBadRequest) UnmarshalJSON(data []byte) error {
+func (s *Transaction) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes PostJobNotFound as json.
-func (s *PostJobNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode implements json.Marshaler.
+func (s *TransactionOperation) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *TransactionOperation) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("Action")
+		s.Action.Encode(e)
+	}
+	{
+		e.FieldStart("Table")
+		e.Str(s.Table)
+	}
+	{
+		if s.Records != nil {
+			e.FieldStart("Records")
+			e.ArrStart()
+			for _, elem := range s.Records {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Update.Set {
+			e.FieldStart("Update")
+			s.Update.Encode(e)
+		}
+	}
+	{
+		if s.Search.Set {
+			e.FieldStart("Search")
+			s.Search.Encode(e)
+		}
+	}
+	{
+		if s.Returning.Set {
+			e.FieldStart("Returning")
+			s.Returning.Encode(e)
+		}
+	}
+	{
+		if s.IfMatch.Set {
+			e.FieldStart("IfMatch")
+			s.IfMatch.Encode(e)
+		}
+	}
+	{
+		if s.Confirm.Set {
+			e.FieldStart("Confirm")
+			s.Confirm.Encode(e)
+		}
+	}
 }
 
-// Decode decodes PostJobNotFound from json.
-func (s *PostJobNotFound) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfTransactionOperation = [8]string{
+	0: "Action",
+	1: "Table",
+	2: "Records",
+	3: "Update",
+	4: "Search",
+	5: "Returning",
+	6: "IfMatch",
+	7: "Confirm",
+}
+
+// Decode decodes TransactionOperation from json.
+func (s *TransactionOperation) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PostJobNotFound to nil")
+		return errors.New("invalid: unable to decode TransactionOperation to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Action":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Action.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Action\"")
+			}
+		case "Table":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Table = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Table\"")
+			}
+		case "Records":
+			if err := func() error {
+				s.Records = make([]TransactionOperationRecordsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TransactionOperationRecordsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Records = append(s.Records, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Records\"")
+			}
+		case "Update":
+			if err := func() error {
+				s.Update.Reset()
+				if err := s.Update.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Update\"")
+			}
+		case "Search":
+			if err := func() error {
+				s.Search.Reset()
+				if err := s.Search.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Search\"")
+			}
+		case "Returning":
+			if err := func() error {
+				s.Returning.Reset()
+				if err := s.Returning.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Returning\"")
+			}
+		case "IfMatch":
+			if err := func() error {
+				s.IfMatch.Reset()
+				if err := s.IfMatch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"IfMatch\"")
+			}
+		case "Confirm":
+			if err := func() error {
+				s.Confirm.Reset()
+				if err := s.Confirm.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Confirm\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode TransactionOperation")
 	}
-	*s = PostJobNotFound(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTransactionOperation) {
+					name = jsonFieldsNameOfTransactionOperation[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PostJobNotFound) MarshalJSON() ([]byte, error) {
+func (s *TransactionOperation) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PostJobNotFound) UnmarshalJSON(data []byte) error {
+func (s *TransactionOperation) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes RemoveSessionCompatBadRequest as json.
-func (s *RemoveSessionCompatBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes TransactionOperationAction as json.
+func (s TransactionOperationAction) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes RemoveSessionCompatBadRequest from json.
-func (s *RemoveSessionCompatBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TransactionOperationAction from json.
+func (s *TransactionOperationAction) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RemoveSessionCompatBadRequest to nil")
+		return errors.New("invalid: unable to decode TransactionOperationAction to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = RemoveSessionCompatBadRequest(unwrapped)
+	// Try to use constant string.
+	switch TransactionOperationAction(v) {
+	case TransactionOperationActionInsert:
+		*s = TransactionOperationActionInsert
+	case TransactionOperationActionUpdate:
+		*s = TransactionOperationActionUpdate
+	case TransactionOperationActionDelete:
+		*s = TransactionOperationActionDelete
+	default:
+		*s = TransactionOperationAction(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RemoveSessionCompatBadRequest) MarshalJSON() ([]byte, error) {
+func (s TransactionOperationAction) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RemoveSessionCompatBadRequest) UnmarshalJSON(data []byte) error {
+func (s *TransactionOperationAction) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes RemoveSessionCompatNotFound as json.
-func (s *RemoveSessionCompatNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode implements json.Marshaler.
+func (s TransactionOperationRecordsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields implements json.Marshaler.
+func (s TransactionOperationRecordsItem) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
 }
 
-// Decode decodes RemoveSessionCompatNotFound from json.
-func (s *RemoveSessionCompatNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes TransactionOperationRecordsItem from json.
+func (s *TransactionOperationRecordsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RemoveSessionCompatNotFound to nil")
+		return errors.New("invalid: unable to decode TransactionOperationRecordsItem to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode TransactionOperationRecordsItem")
 	}
-	*s = RemoveSessionCompatNotFound(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RemoveSessionCompatNotFound) MarshalJSON() ([]byte, error) {
+func (s TransactionOperationRecordsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RemoveSessionCompatNotFound) UnmarshalJSON(data []byte) error {
+func (s *TransactionOperationRecordsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Response) Encode(e *jx.Encoder) {
+func (s *TransactionOperationResult) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Response) encodeFields(e *jx.Encoder) {
+func (s *TransactionOperationResult) encodeFields(e *jx.Encoder) {
 	{
-		if s.MapName.Set {
-			e.FieldStart("MapName")
-			s.MapName.Encode(e)
+		if s.Action.Set {
+			e.FieldStart("Action")
+			s.Action.Encode(e)
 		}
 	}
 	{
-		if s.FileRecords.Set {
-			e.FieldStart("FileRecords")
-			s.FileRecords.Encode(e)
+		if s.Table.Set {
+			e.FieldStart("Table")
+			s.Table.Encode(e)
 		}
 	}
 	{
@@ -8453,13 +11700,15 @@ func (s *Response) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.FieldNames != nil {
-			e.FieldStart("FieldNames")
-			e.ArrStart()
-			for _, elem := range s.FieldNames {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.ConfirmToken.Set {
+			e.FieldStart("ConfirmToken")
+			s.ConfirmToken.Encode(e)
+		}
+	}
+	{
+		if s.MaxAffectedRows.Set {
+			e.FieldStart("MaxAffectedRows")
+			s.MaxAffectedRows.Encode(e)
 		}
 	}
 	{
@@ -8474,41 +11723,42 @@ func (s *Response) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfResponse = [5]string{
-	0: "MapName",
-	1: "FileRecords",
+var jsonFieldsNameOfTransactionOperationResult = [6]string{
+	0: "Action",
+	1: "Table",
 	2: "NrRecords",
-	3: "FieldNames",
-	4: "Records",
+	3: "ConfirmToken",
+	4: "MaxAffectedRows",
+	5: "Records",
 }
 
-// Decode decodes Response from json.
-func (s *Response) Decode(d *jx.Decoder) error {
+// Decode decodes TransactionOperationResult from json.
+func (s *TransactionOperationResult) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Response to nil")
+		return errors.New("invalid: unable to decode TransactionOperationResult to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "MapName":
+		case "Action":
 			if err := func() error {
-				s.MapName.Reset()
-				if err := s.MapName.Decode(d); err != nil {
+				s.Action.Reset()
+				if err := s.Action.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MapName\"")
+				return errors.Wrap(err, "decode field \"Action\"")
 			}
-		case "FileRecords":
+		case "Table":
 			if err := func() error {
-				s.FileRecords.Reset()
-				if err := s.FileRecords.Decode(d); err != nil {
+				s.Table.Reset()
+				if err := s.Table.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"FileRecords\"")
+				return errors.Wrap(err, "decode field \"Table\"")
 			}
 		case "NrRecords":
 			if err := func() error {
@@ -8520,30 +11770,31 @@ func (s *Response) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"NrRecords\"")
 			}
-		case "FieldNames":
+		case "ConfirmToken":
+			if err := func() error {
+				s.ConfirmToken.Reset()
+				if err := s.ConfirmToken.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ConfirmToken\"")
+			}
+		case "MaxAffectedRows":
 			if err := func() error {
-				s.FieldNames = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.FieldNames = append(s.FieldNames, elem)
-					return nil
-				}); err != nil {
+				s.MaxAffectedRows.Reset()
+				if err := s.MaxAffectedRows.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"FieldNames\"")
+				return errors.Wrap(err, "decode field \"MaxAffectedRows\"")
 			}
 		case "Records":
 			if err := func() error {
-				s.Records = make([]ResponseRecordsItem, 0)
+				s.Records = make([]TransactionOperationResultRecordsItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem ResponseRecordsItem
+					var elem TransactionOperationResultRecordsItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -8561,34 +11812,34 @@ func (s *Response) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Response")
+		return errors.Wrap(err, "decode TransactionOperationResult")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Response) MarshalJSON() ([]byte, error) {
+func (s *TransactionOperationResult) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Response) UnmarshalJSON(data []byte) error {
+func (s *TransactionOperationResult) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s ResponseRaw) Encode(e *jx.Encoder) {
+func (s TransactionOperationResultRecordsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields implements json.Marshaler.
-func (s ResponseRaw) encodeFields(e *jx.Encoder) {
+func (s TransactionOperationResultRecordsItem) encodeFields(e *jx.Encoder) {
 	for k, elem := range s {
 		e.FieldStart(k)
 
@@ -8598,10 +11849,10 @@ func (s ResponseRaw) encodeFields(e *jx.Encoder) {
 	}
 }
 
-// Decode decodes ResponseRaw from json.
-func (s *ResponseRaw) Decode(d *jx.Decoder) error {
+// Decode decodes TransactionOperationResultRecordsItem from json.
+func (s *TransactionOperationResultRecordsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ResponseRaw to nil")
+		return errors.New("invalid: unable to decode TransactionOperationResultRecordsItem to nil")
 	}
 	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -8619,220 +11870,216 @@ func (s *ResponseRaw) Decode(d *jx.Decoder) error {
 		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ResponseRaw")
+		return errors.Wrap(err, "decode TransactionOperationResultRecordsItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ResponseRaw) MarshalJSON() ([]byte, error) {
+func (s TransactionOperationResultRecordsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ResponseRaw) UnmarshalJSON(data []byte) error {
+func (s *TransactionOperationResultRecordsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s ResponseRecordsItem) Encode(e *jx.Encoder) {
+func (s *TransactionResult) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s ResponseRecordsItem) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
+// encodeFields encodes fields.
+func (s *TransactionResult) encodeFields(e *jx.Encoder) {
+	{
+		if s.Committed.Set {
+			e.FieldStart("Committed")
+			s.Committed.Encode(e)
 		}
 	}
-}
-
-// Decode decodes ResponseRecordsItem from json.
-func (s *ResponseRecordsItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ResponseRecordsItem to nil")
+	{
+		if s.DryRun.Set {
+			e.FieldStart("DryRun")
+			s.DryRun.Encode(e)
+		}
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+	{
+		if s.FailedOperation.Set {
+			e.FieldStart("FailedOperation")
+			s.FailedOperation.Encode(e)
 		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ResponseRecordsItem")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ResponseRecordsItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ResponseRecordsItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *SQLQuery) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *SQLQuery) encodeFields(e *jx.Encoder) {
 	{
-		if s.Batch.Set {
-			e.FieldStart("Batch")
-			s.Batch.Encode(e)
+		if s.Error.Set {
+			e.FieldStart("Error")
+			s.Error.Encode(e)
+		}
+	}
+	{
+		if s.Results != nil {
+			e.FieldStart("Results")
+			e.ArrStart()
+			for _, elem := range s.Results {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfSQLQuery = [1]string{
-	0: "Batch",
+var jsonFieldsNameOfTransactionResult = [5]string{
+	0: "Committed",
+	1: "DryRun",
+	2: "FailedOperation",
+	3: "Error",
+	4: "Results",
 }
 
-// Decode decodes SQLQuery from json.
-func (s *SQLQuery) Decode(d *jx.Decoder) error {
+// Decode decodes TransactionResult from json.
+func (s *TransactionResult) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SQLQuery to nil")
+		return errors.New("invalid: unable to decode TransactionResult to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Batch":
+		case "Committed":
 			if err := func() error {
-				s.Batch.Reset()
-				if err := s.Batch.Decode(d); err != nil {
+				s.Committed.Reset()
+				if err := s.Committed.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Batch\"")
+				return errors.Wrap(err, "decode field \"Committed\"")
+			}
+		case "DryRun":
+			if err := func() error {
+				s.DryRun.Reset()
+				if err := s.DryRun.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DryRun\"")
+			}
+		case "FailedOperation":
+			if err := func() error {
+				s.FailedOperation.Reset()
+				if err := s.FailedOperation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"FailedOperation\"")
+			}
+		case "Error":
+			if err := func() error {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Error\"")
+			}
+		case "Results":
+			if err := func() error {
+				s.Results = make([]TransactionOperationResult, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TransactionOperationResult
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Results = append(s.Results, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Results\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SQLQuery")
+		return errors.Wrap(err, "decode TransactionResult")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SQLQuery) MarshalJSON() ([]byte, error) {
+func (s *TransactionResult) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SQLQuery) UnmarshalJSON(data []byte) error {
+func (s *TransactionResult) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *SQLQueryBatch) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *SQLQueryBatch) encodeFields(e *jx.Encoder) {
-	{
-		if s.SQL.Set {
-			e.FieldStart("SQL")
-			s.SQL.Encode(e)
-		}
-	}
-}
+// Encode encodes TriggerExtendBadRequest as json.
+func (s *TriggerExtendBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-var jsonFieldsNameOfSQLQueryBatch = [1]string{
-	0: "SQL",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes SQLQueryBatch from json.
-func (s *SQLQueryBatch) Decode(d *jx.Decoder) error {
+// Decode decodes TriggerExtendBadRequest from json.
+func (s *TriggerExtendBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SQLQueryBatch to nil")
+		return errors.New("invalid: unable to decode TriggerExtendBadRequest to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "SQL":
-			if err := func() error {
-				s.SQL.Reset()
-				if err := s.SQL.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SQL\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode SQLQueryBatch")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = TriggerExtendBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SQLQueryBatch) MarshalJSON() ([]byte, error) {
+func (s *TriggerExtendBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SQLQueryBatch) UnmarshalJSON(data []byte) error {
+func (s *TriggerExtendBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes SearchModellingBadRequest as json.
-func (s *SearchModellingBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes TriggerExtendNotFound as json.
+func (s *TriggerExtendNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes SearchModellingBadRequest from json.
-func (s *SearchModellingBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TriggerExtendNotFound from json.
+func (s *TriggerExtendNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SearchModellingBadRequest to nil")
+		return errors.New("invalid: unable to decode TriggerExtendNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -8843,34 +12090,34 @@ func (s *SearchModellingBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = SearchModellingBadRequest(unwrapped)
+	*s = TriggerExtendNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SearchModellingBadRequest) MarshalJSON() ([]byte, error) {
+func (s *TriggerExtendNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SearchModellingBadRequest) UnmarshalJSON(data []byte) error {
+func (s *TriggerExtendNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes SearchModellingNotFound as json.
-func (s *SearchModellingNotFound) Encode(e *jx.Encoder) {
+// Encode encodes TriggerJobBadRequest as json.
+func (s *TriggerJobBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes SearchModellingNotFound from json.
-func (s *SearchModellingNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes TriggerJobBadRequest from json.
+func (s *TriggerJobBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SearchModellingNotFound to nil")
+		return errors.New("invalid: unable to decode TriggerJobBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -8881,34 +12128,34 @@ func (s *SearchModellingNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = SearchModellingNotFound(unwrapped)
+	*s = TriggerJobBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SearchModellingNotFound) MarshalJSON() ([]byte, error) {
+func (s *TriggerJobBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SearchModellingNotFound) UnmarshalJSON(data []byte) error {
+func (s *TriggerJobBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes SearchTableBadRequest as json.
-func (s *SearchTableBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes TriggerJobNotFound as json.
+func (s *TriggerJobNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes SearchTableBadRequest from json.
-func (s *SearchTableBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TriggerJobNotFound from json.
+func (s *TriggerJobNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SearchTableBadRequest to nil")
+		return errors.New("invalid: unable to decode TriggerJobNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -8919,34 +12166,34 @@ func (s *SearchTableBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = SearchTableBadRequest(unwrapped)
+	*s = TriggerJobNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SearchTableBadRequest) MarshalJSON() ([]byte, error) {
+func (s *TriggerJobNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SearchTableBadRequest) UnmarshalJSON(data []byte) error {
+func (s *TriggerJobNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes SearchTableNotFound as json.
-func (s *SearchTableNotFound) Encode(e *jx.Encoder) {
+// Encode encodes UpdateLobByMapBadRequest as json.
+func (s *UpdateLobByMapBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
-
-// Decode decodes SearchTableNotFound from json.
-func (s *SearchTableNotFound) Decode(d *jx.Decoder) error {
+
+// Decode decodes UpdateLobByMapBadRequest from json.
+func (s *UpdateLobByMapBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SearchTableNotFound to nil")
+		return errors.New("invalid: unable to decode UpdateLobByMapBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -8957,338 +12204,148 @@ func (s *SearchTableNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = SearchTableNotFound(unwrapped)
+	*s = UpdateLobByMapBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SearchTableNotFound) MarshalJSON() ([]byte, error) {
+func (s *UpdateLobByMapBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SearchTableNotFound) UnmarshalJSON(data []byte) error {
+func (s *UpdateLobByMapBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *StatusResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *StatusResponse) encodeFields(e *jx.Encoder) {
-	{
-		if s.Status.Set {
-			e.FieldStart("Status")
-			s.Status.Encode(e)
-		}
-	}
-}
+// Encode encodes UpdateLobByMapConflict as json.
+func (s *UpdateLobByMapConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-var jsonFieldsNameOfStatusResponse = [1]string{
-	0: "Status",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes StatusResponse from json.
-func (s *StatusResponse) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateLobByMapConflict from json.
+func (s *UpdateLobByMapConflict) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatusResponse to nil")
+		return errors.New("invalid: unable to decode UpdateLobByMapConflict to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "Status":
-			if err := func() error {
-				s.Status.Reset()
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Status\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode StatusResponse")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = UpdateLobByMapConflict(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatusResponse) MarshalJSON() ([]byte, error) {
+func (s *UpdateLobByMapConflict) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatusResponse) UnmarshalJSON(data []byte) error {
+func (s *UpdateLobByMapConflict) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *StatusResponseStatus) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *StatusResponseStatus) encodeFields(e *jx.Encoder) {
-	{
-		if s.Action.Set {
-			e.FieldStart("Action")
-			s.Action.Encode(e)
-		}
-	}
-	{
-		if s.Code.Set {
-			e.FieldStart("Code")
-			s.Code.Encode(e)
-		}
-	}
-	{
-		if s.Dbid.Set {
-			e.FieldStart("Dbid")
-			s.Dbid.Encode(e)
-		}
-	}
-	{
-		if s.Target.Set {
-			e.FieldStart("Target")
-			s.Target.Encode(e)
-		}
-	}
-	{
-		if s.Message.Set {
-			e.FieldStart("Message")
-			s.Message.Encode(e)
-		}
-	}
-}
+// Encode encodes UpdateLobByMapNotFound as json.
+func (s *UpdateLobByMapNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-var jsonFieldsNameOfStatusResponseStatus = [5]string{
-	0: "Action",
-	1: "Code",
-	2: "Dbid",
-	3: "Target",
-	4: "Message",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes StatusResponseStatus from json.
-func (s *StatusResponseStatus) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateLobByMapNotFound from json.
+func (s *UpdateLobByMapNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatusResponseStatus to nil")
+		return errors.New("invalid: unable to decode UpdateLobByMapNotFound to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "Action":
-			if err := func() error {
-				s.Action.Reset()
-				if err := s.Action.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Action\"")
-			}
-		case "Code":
-			if err := func() error {
-				s.Code.Reset()
-				if err := s.Code.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Code\"")
-			}
-		case "Dbid":
-			if err := func() error {
-				s.Dbid.Reset()
-				if err := s.Dbid.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Dbid\"")
-			}
-		case "Target":
-			if err := func() error {
-				s.Target.Reset()
-				if err := s.Target.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Target\"")
-			}
-		case "Message":
-			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Message\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode StatusResponseStatus")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = UpdateLobByMapNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatusResponseStatus) MarshalJSON() ([]byte, error) {
+func (s *UpdateLobByMapNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatusResponseStatus) UnmarshalJSON(data []byte) error {
+func (s *UpdateLobByMapNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *StoreResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *StoreResponse) encodeFields(e *jx.Encoder) {
-	{
-		if s.NrStored.Set {
-			e.FieldStart("NrStored")
-			s.NrStored.Encode(e)
-		}
-	}
-	{
-		if s.NrDeleted.Set {
-			e.FieldStart("NrDeleted")
-			s.NrDeleted.Encode(e)
-		}
-	}
-	{
-		if s.Stored != nil {
-			e.FieldStart("Stored")
-			e.ArrStart()
-			for _, elem := range s.Stored {
-				e.Int(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-}
+// Encode encodes UpdateLobByMapRequestEntityTooLarge as json.
+func (s *UpdateLobByMapRequestEntityTooLarge) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-var jsonFieldsNameOfStoreResponse = [3]string{
-	0: "NrStored",
-	1: "NrDeleted",
-	2: "Stored",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes StoreResponse from json.
-func (s *StoreResponse) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateLobByMapRequestEntityTooLarge from json.
+func (s *UpdateLobByMapRequestEntityTooLarge) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StoreResponse to nil")
+		return errors.New("invalid: unable to decode UpdateLobByMapRequestEntityTooLarge to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "NrStored":
-			if err := func() error {
-				s.NrStored.Reset()
-				if err := s.NrStored.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"NrStored\"")
-			}
-		case "NrDeleted":
-			if err := func() error {
-				s.NrDeleted.Reset()
-				if err := s.NrDeleted.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"NrDeleted\"")
-			}
-		case "Stored":
-			if err := func() error {
-				s.Stored = make([]int, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem int
-					v, err := d.Int()
-					elem = int(v)
-					if err != nil {
-						return err
-					}
-					s.Stored = append(s.Stored, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Stored\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode StoreResponse")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = UpdateLobByMapRequestEntityTooLarge(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StoreResponse) MarshalJSON() ([]byte, error) {
+func (s *UpdateLobByMapRequestEntityTooLarge) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StoreResponse) UnmarshalJSON(data []byte) error {
+func (s *UpdateLobByMapRequestEntityTooLarge) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TriggerExtendBadRequest as json.
-func (s *TriggerExtendBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes UpdateRecordsByFieldsBadRequest as json.
+func (s *UpdateRecordsByFieldsBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes TriggerExtendBadRequest from json.
-func (s *TriggerExtendBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateRecordsByFieldsBadRequest from json.
+func (s *UpdateRecordsByFieldsBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TriggerExtendBadRequest to nil")
+		return errors.New("invalid: unable to decode UpdateRecordsByFieldsBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -9299,36 +12356,36 @@ func (s *TriggerExtendBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = TriggerExtendBadRequest(unwrapped)
+	*s = UpdateRecordsByFieldsBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TriggerExtendBadRequest) MarshalJSON() ([]byte, error) {
+func (s *UpdateRecordsByFieldsBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TriggerExtendBadRequest) UnmarshalJSON(data []byte) error {
+func (s *UpdateRecordsByFieldsBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TriggerExtendNotFound as json.
-func (s *TriggerExtendNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode encodes UpdateRecordsByFieldsConflict as json.
+func (s *UpdateRecordsByFieldsConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*Response)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes TriggerExtendNotFound from json.
-func (s *TriggerExtendNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateRecordsByFieldsConflict from json.
+func (s *UpdateRecordsByFieldsConflict) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TriggerExtendNotFound to nil")
+		return errors.New("invalid: unable to decode UpdateRecordsByFieldsConflict to nil")
 	}
-	var unwrapped Error
+	var unwrapped Response
 	if err := func() error {
 		if err := unwrapped.Decode(d); err != nil {
 			return err
@@ -9337,34 +12394,34 @@ func (s *TriggerExtendNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = TriggerExtendNotFound(unwrapped)
+	*s = UpdateRecordsByFieldsConflict(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TriggerExtendNotFound) MarshalJSON() ([]byte, error) {
+func (s *UpdateRecordsByFieldsConflict) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TriggerExtendNotFound) UnmarshalJSON(data []byte) error {
+func (s *UpdateRecordsByFieldsConflict) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TriggerJobBadRequest as json.
-func (s *TriggerJobBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes UpdateRecordsByFieldsNotFound as json.
+func (s *UpdateRecordsByFieldsNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes TriggerJobBadRequest from json.
-func (s *TriggerJobBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateRecordsByFieldsNotFound from json.
+func (s *UpdateRecordsByFieldsNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TriggerJobBadRequest to nil")
+		return errors.New("invalid: unable to decode UpdateRecordsByFieldsNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -9375,36 +12432,36 @@ func (s *TriggerJobBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = TriggerJobBadRequest(unwrapped)
+	*s = UpdateRecordsByFieldsNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TriggerJobBadRequest) MarshalJSON() ([]byte, error) {
+func (s *UpdateRecordsByFieldsNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TriggerJobBadRequest) UnmarshalJSON(data []byte) error {
+func (s *UpdateRecordsByFieldsNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TriggerJobNotFound as json.
-func (s *TriggerJobNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode encodes UpdateRecordsByFieldsPreconditionFailed as json.
+func (s *UpdateRecordsByFieldsPreconditionFailed) Encode(e *jx.Encoder) {
+	unwrapped := (*Response)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes TriggerJobNotFound from json.
-func (s *TriggerJobNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateRecordsByFieldsPreconditionFailed from json.
+func (s *UpdateRecordsByFieldsPreconditionFailed) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TriggerJobNotFound to nil")
+		return errors.New("invalid: unable to decode UpdateRecordsByFieldsPreconditionFailed to nil")
 	}
-	var unwrapped Error
+	var unwrapped Response
 	if err := func() error {
 		if err := unwrapped.Decode(d); err != nil {
 			return err
@@ -9413,19 +12470,19 @@ func (s *TriggerJobNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = TriggerJobNotFound(unwrapped)
+	*s = UpdateRecordsByFieldsPreconditionFailed(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TriggerJobNotFound) MarshalJSON() ([]byte, error) {
+func (s *UpdateRecordsByFieldsPreconditionFailed) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TriggerJobNotFound) UnmarshalJSON(data []byte) error {
+func (s *UpdateRecordsByFieldsPreconditionFailed) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -9562,6 +12619,120 @@ func (s *UpdateRecordsByFieldsReqRecordsItem) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes UploadChunkBadRequest as json.
+func (s *UploadChunkBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UploadChunkBadRequest from json.
+func (s *UploadChunkBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadChunkBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UploadChunkBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UploadChunkBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UploadChunkBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UploadChunkConflict as json.
+func (s *UploadChunkConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UploadChunkConflict from json.
+func (s *UploadChunkConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadChunkConflict to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UploadChunkConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UploadChunkConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UploadChunkConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UploadChunkNotFound as json.
+func (s *UploadChunkNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UploadChunkNotFound from json.
+func (s *UploadChunkNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadChunkNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UploadChunkNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UploadChunkNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UploadChunkNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes UploadFileBadRequest as json.
 func (s *UploadFileBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
@@ -9638,6 +12809,171 @@ func (s *UploadFileNotFound) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *UploadStatus) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UploadStatus) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("ID")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Location.Set {
+			e.FieldStart("Location")
+			s.Location.Encode(e)
+		}
+	}
+	{
+		if s.File.Set {
+			e.FieldStart("File")
+			s.File.Encode(e)
+		}
+	}
+	{
+		if s.Length.Set {
+			e.FieldStart("Length")
+			s.Length.Encode(e)
+		}
+	}
+	{
+		if s.Offset.Set {
+			e.FieldStart("Offset")
+			s.Offset.Encode(e)
+		}
+	}
+	{
+		if s.Complete.Set {
+			e.FieldStart("Complete")
+			s.Complete.Encode(e)
+		}
+	}
+	{
+		if s.Expires.Set {
+			e.FieldStart("Expires")
+			s.Expires.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfUploadStatus = [7]string{
+	0: "ID",
+	1: "Location",
+	2: "File",
+	3: "Length",
+	4: "Offset",
+	5: "Complete",
+	6: "Expires",
+}
+
+// Decode decodes UploadStatus from json.
+func (s *UploadStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadStatus to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ID":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ID\"")
+			}
+		case "Location":
+			if err := func() error {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Location\"")
+			}
+		case "File":
+			if err := func() error {
+				s.File.Reset()
+				if err := s.File.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"File\"")
+			}
+		case "Length":
+			if err := func() error {
+				s.Length.Reset()
+				if err := s.Length.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Length\"")
+			}
+		case "Offset":
+			if err := func() error {
+				s.Offset.Reset()
+				if err := s.Offset.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Offset\"")
+			}
+		case "Complete":
+			if err := func() error {
+				s.Complete.Reset()
+				if err := s.Complete.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Complete\"")
+			}
+		case "Expires":
+			if err := func() error {
+				s.Expires.Reset()
+				if err := s.Expires.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Expires\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UploadStatus")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UploadStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UploadStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *User) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -7,6 +7,7 @@ type OperationName = string
 
 const (
 	AddViewOperation               OperationName = "AddView"
+	AggregateRecordsOperation      OperationName = "AggregateRecords"
 	BatchParameterQueryOperation   OperationName = "BatchParameterQuery"
 	BatchQueryOperation            OperationName = "BatchQuery"
 	BatchSelectOperation           OperationName = "BatchSelect"
@@ -14,17 +15,26 @@ const (
 	BrowseLocationOperation        OperationName = "BrowseLocation"
 	CallExtendOperation            OperationName = "CallExtend"
 	CallPostExtendOperation        OperationName = "CallPostExtend"
+	CancelUploadOperation          OperationName = "CancelUpload"
 	CreateDirectoryOperation       OperationName = "CreateDirectory"
+	CreateUploadOperation          OperationName = "CreateUpload"
 	DeleteExtendOperation          OperationName = "DeleteExtend"
 	DeleteFileLocationOperation    OperationName = "DeleteFileLocation"
 	DeleteJobResultOperation       OperationName = "DeleteJobResult"
+	DeleteRecordByKeyOperation     OperationName = "DeleteRecordByKey"
 	DeleteRecordsSearchedOperation OperationName = "DeleteRecordsSearched"
 	DeleteViewOperation            OperationName = "DeleteView"
 	DownloadFileOperation          OperationName = "DownloadFile"
+	ExecuteTransactionOperation    OperationName = "ExecuteTransaction"
+	GetArchiveOperation            OperationName = "GetArchive"
 	GetConfigOperation             OperationName = "GetConfig"
 	GetDatabasesOperation          OperationName = "GetDatabases"
 	GetFieldsOperation             OperationName = "GetFields"
 	GetImageOperation              OperationName = "GetImage"
+	GetImageCacheStatsOperation    OperationName = "GetImageCacheStats"
+	GetImageDuplicatesOperation    OperationName = "GetImageDuplicates"
+	GetImageHashOperation          OperationName = "GetImageHash"
+	GetImageMetadataOperation      OperationName = "GetImageMetadata"
 	GetJobExecutionResultOperation OperationName = "GetJobExecutionResult"
 	GetJobFullInfoOperation        OperationName = "GetJobFullInfo"
 	GetJobResultOperation          OperationName = "GetJobResult"
@@ -35,18 +45,25 @@ const (
 	GetMapMetadataOperation        OperationName = "GetMapMetadata"
 	GetMapRecordsFieldsOperation   OperationName = "GetMapRecordsFields"
 	GetMapsOperation               OperationName = "GetMaps"
+	GetRecordByKeyOperation        OperationName = "GetRecordByKey"
+	GetRecordHistoryOperation      OperationName = "GetRecordHistory"
+	GetUploadOperation             OperationName = "GetUpload"
 	GetUserInfoOperation           OperationName = "GetUserInfo"
 	GetVersionOperation            OperationName = "GetVersion"
 	GetVideoOperation              OperationName = "GetVideo"
 	GetViewsOperation              OperationName = "GetViews"
+	HeadMapRecordsFieldsOperation  OperationName = "HeadMapRecordsFields"
+	ImportRecordsOperation         OperationName = "ImportRecords"
 	InsertMapFileRecordsOperation  OperationName = "InsertMapFileRecords"
 	InsertRecordOperation          OperationName = "InsertRecord"
 	ListModellingOperation         OperationName = "ListModelling"
 	ListTablesOperation            OperationName = "ListTables"
 	LoginSessionOperation          OperationName = "LoginSession"
 	LogoutSessionCompatOperation   OperationName = "LogoutSessionCompat"
+	PatchRecordByKeyOperation      OperationName = "PatchRecordByKey"
 	PostDatabaseOperation          OperationName = "PostDatabase"
 	PostJobOperation               OperationName = "PostJob"
+	PurgeImageCacheOperation       OperationName = "PurgeImageCache"
 	PushLoginSessionOperation      OperationName = "PushLoginSession"
 	RemoveSessionCompatOperation   OperationName = "RemoveSessionCompat"
 	SearchModellingOperation       OperationName = "SearchModelling"
@@ -58,7 +75,9 @@ const (
 	StoreConfigOperation           OperationName = "StoreConfig"
 	TriggerExtendOperation         OperationName = "TriggerExtend"
 	TriggerJobOperation            OperationName = "TriggerJob"
+	UpdateImageHashesOperation     OperationName = "UpdateImageHashes"
 	UpdateLobByMapOperation        OperationName = "UpdateLobByMap"
 	UpdateRecordsByFieldsOperation OperationName = "UpdateRecordsByFields"
+	UploadChunkOperation           OperationName = "UploadChunk"
 	UploadFileOperation            OperationName = "UploadFile"
 )
@@ -117,64 +117,100 @@ func decodeAddViewParams(args [0]string, argsEscaped bool, r *http.Request) (par
 	return params, nil
 }
 
-// BatchParameterQueryParams is parameters of batchParameterQuery operation.
-type BatchParameterQueryParams struct {
-	// Batch name.
+// AggregateRecordsParams is parameters of aggregateRecords operation.
+type AggregateRecordsParams struct {
+	// Comma separated list of aggregate functions like count(),sum(price). The result field name is the
+	// function name followed by the field name, like sum_price, or count for count().
+	Metrics string
+	// Comma separated list of fields to group by.
+	Groupby OptString `json:",omitempty,omitzero"`
+	// Filter of the records aggregated.
+	Filter OptString `json:",omitempty,omitzero"`
+	// Filter on the aggregated result using the metric names, like gt(count,10).
+	Having OptString `json:",omitempty,omitzero"`
+	// Order by criterias.
+	Orderby OptString `json:",omitempty,omitzero"`
+	// Maximal number of records retrieved.
+	Limit OptString `json:",omitempty,omitzero"`
+	// SQL table.
 	Table string
-	// SQL statement.
-	Query string
-	// Check for validator additional information needed to be given to validator plugin.
-	Validate OptString `json:",omitempty,omitzero"`
 }
 
-func unpackBatchParameterQueryParams(packed middleware.Parameters) (params BatchParameterQueryParams) {
+func unpackAggregateRecordsParams(packed middleware.Parameters) (params AggregateRecordsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "table",
-			In:   "path",
+			Name: "metrics",
+			In:   "query",
 		}
-		params.Table = packed[key].(string)
+		params.Metrics = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "query",
-			In:   "path",
+			Name: "groupby",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Groupby = v.(OptString)
 		}
-		params.Query = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "validate",
+			Name: "filter",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Validate = v.(OptString)
+			params.Filter = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "having",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Having = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "orderby",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Orderby = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
 		}
+		params.Table = packed[key].(string)
 	}
 	return params
 }
 
-func decodeBatchParameterQueryParams(args [2]string, argsEscaped bool, r *http.Request) (params BatchParameterQueryParams, _ error) {
+func decodeAggregateRecordsParams(args [1]string, argsEscaped bool, r *http.Request) (params AggregateRecordsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: table.
+	// Decode query: metrics.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "metrics",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -185,78 +221,74 @@ func decodeBatchParameterQueryParams(args [2]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.Table = c
+				params.Metrics = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
-			In:   "path",
+			Name: "metrics",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: query.
+	// Decode query: groupby.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "groupby",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "query",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotGroupbyVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotGroupbyVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Query = c
+				params.Groupby.SetTo(paramsDotGroupbyVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "query",
-			In:   "path",
+			Name: "groupby",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: validate.
+	// Decode query: filter.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "validate",
+			Name:    "filter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotValidateVal string
+				var paramsDotFilterVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -268,12 +300,12 @@ func decodeBatchParameterQueryParams(args [2]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotValidateVal = c
+					paramsDotFilterVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Validate.SetTo(paramsDotValidateVal)
+				params.Filter.SetTo(paramsDotFilterVal)
 				return nil
 			}); err != nil {
 				return err
@@ -282,100 +314,63 @@ func decodeBatchParameterQueryParams(args [2]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "validate",
+			Name: "filter",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// BatchQueryParams is parameters of batchQuery operation.
-type BatchQueryParams struct {
-	// Batch name.
-	Table string
-	// Check for validator additional information needed to be given to validator plugin.
-	Validate OptString `json:",omitempty,omitzero"`
-}
-
-func unpackBatchQueryParams(packed middleware.Parameters) (params BatchQueryParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "table",
-			In:   "path",
-		}
-		params.Table = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "validate",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Validate = v.(OptString)
-		}
-	}
-	return params
-}
-
-func decodeBatchQueryParams(args [1]string, argsEscaped bool, r *http.Request) (params BatchQueryParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: table.
+	// Decode query: having.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "having",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotHavingVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotHavingVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Table = c
+				params.Having.SetTo(paramsDotHavingVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
-			In:   "path",
+			Name: "having",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: validate.
+	// Decode query: orderby.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "validate",
+			Name:    "orderby",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotValidateVal string
+				var paramsDotOrderbyVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -387,12 +382,12 @@ func decodeBatchQueryParams(args [1]string, argsEscaped bool, r *http.Request) (
 						return err
 					}
 
-					paramsDotValidateVal = c
+					paramsDotOrderbyVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Validate.SetTo(paramsDotValidateVal)
+				params.Orderby.SetTo(paramsDotOrderbyVal)
 				return nil
 			}); err != nil {
 				return err
@@ -401,86 +396,40 @@ func decodeBatchQueryParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "validate",
+			Name: "orderby",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// BatchSelectParams is parameters of batchSelect operation.
-type BatchSelectParams struct {
-	// Query parameter.
-	Param []string `json:",omitempty"`
-	// Batch name.
-	Table string
-	// Check for validator additional information needed to be given to validator plugin.
-	Validate OptString `json:",omitempty,omitzero"`
-}
-
-func unpackBatchSelectParams(packed middleware.Parameters) (params BatchSelectParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "param",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Param = v.([]string)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "table",
-			In:   "path",
-		}
-		params.Table = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "validate",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Validate = v.(OptString)
-		}
-	}
-	return params
-}
-
-func decodeBatchSelectParams(args [1]string, argsEscaped bool, r *http.Request) (params BatchSelectParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: param.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "param",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotParamVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+				var paramsDotLimitVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						paramsDotParamVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
-					params.Param = append(params.Param, paramsDotParamVal)
+
+					paramsDotLimitVal = c
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
 			}); err != nil {
 				return err
 			}
@@ -488,7 +437,7 @@ func decodeBatchSelectParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "param",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
@@ -538,136 +487,166 @@ func decodeBatchSelectParams(args [1]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	// Decode query: validate.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "validate",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotValidateVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+// BatchParameterQueryParams is parameters of batchParameterQuery operation.
+type BatchParameterQueryParams struct {
+	// CSV field delimiter, default is comma.
+	Delimiter OptString `json:",omitempty,omitzero"`
+	// CSV quote character, default is double quote.
+	Quote OptString `json:",omitempty,omitzero"`
+	// CSV header line with the field names.
+	Header OptBool `json:",omitempty,omitzero"`
+	// CSV representation of NULL values, default is empty.
+	Null OptString `json:",omitempty,omitzero"`
+	// CSV date format in Go time layout, default is 2006-01-02 15:04:05.
+	Dateformat OptString `json:",omitempty,omitzero"`
+	// Write UTF-8 byte order mark at start of CSV output.
+	Bom OptBool `json:",omitempty,omitzero"`
+	// Batch name.
+	Table string
+	// SQL statement.
+	Query string
+	// Check for validator additional information needed to be given to validator plugin.
+	Validate OptString `json:",omitempty,omitzero"`
+}
 
-					paramsDotValidateVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Validate.SetTo(paramsDotValidateVal)
-				return nil
-			}); err != nil {
-				return err
-			}
+func unpackBatchParameterQueryParams(packed middleware.Parameters) (params BatchParameterQueryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "delimiter",
+			In:   "query",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "validate",
+		if v, ok := packed[key]; ok {
+			params.Delimiter = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "quote",
 			In:   "query",
-			Err:  err,
+		}
+		if v, ok := packed[key]; ok {
+			params.Quote = v.(OptString)
 		}
 	}
-	return params, nil
-}
-
-// BrowseLocationParams is parameters of browseLocation operation.
-type BrowseLocationParams struct {
-	// Identifier of the file location.
-	Path string
-	// Filter the result set.
-	Filter OptString `json:",omitempty,omitzero"`
-}
-
-func unpackBrowseLocationParams(packed middleware.Parameters) (params BrowseLocationParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "path",
+			Name: "header",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Header = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "null",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Null = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "dateformat",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Dateformat = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "bom",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Bom = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
 			In:   "path",
 		}
-		params.Path = packed[key].(string)
+		params.Table = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "filter",
+			Name: "query",
+			In:   "path",
+		}
+		params.Query = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "validate",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Filter = v.(OptString)
+			params.Validate = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeBrowseLocationParams(args [1]string, argsEscaped bool, r *http.Request) (params BrowseLocationParams, _ error) {
+func decodeBatchParameterQueryParams(args [2]string, argsEscaped bool, r *http.Request) (params BatchParameterQueryParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: path.
+	// Decode query: delimiter.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "delimiter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "path",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDelimiterVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDelimiterVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Path = c
+				params.Delimiter.SetTo(paramsDotDelimiterVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
-			In:   "path",
+			Name: "delimiter",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: filter.
+	// Decode query: quote.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "filter",
+			Name:    "quote",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFilterVal string
+				var paramsDotQuoteVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -679,12 +658,12 @@ func decodeBrowseLocationParams(args [1]string, argsEscaped bool, r *http.Reques
 						return err
 					}
 
-					paramsDotFilterVal = c
+					paramsDotQuoteVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Filter.SetTo(paramsDotFilterVal)
+				params.Quote.SetTo(paramsDotQuoteVal)
 				return nil
 			}); err != nil {
 				return err
@@ -693,105 +672,126 @@ func decodeBrowseLocationParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "filter",
+			Name: "quote",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Set default value for query: header.
+	{
+		val := bool(true)
+		params.Header.SetTo(val)
+	}
+	// Decode query: header.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "header",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// CallExtendParams is parameters of callExtend operation.
-type CallExtendParams struct {
-	// Identifier of the file location.
-	Path   string
-	Params *CallExtendParams
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotHeaderVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackCallExtendParams(packed middleware.Parameters) (params CallExtendParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "path",
-			In:   "path",
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotHeaderVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Header.SetTo(paramsDotHeaderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
-		params.Path = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "params",
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "header",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Params = v.(*CallExtendParams)
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeCallExtendParams(args [1]string, argsEscaped bool, r *http.Request) (params CallExtendParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: path.
+	// Decode query: null.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "null",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "path",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNullVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNullVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Path = c
+				params.Null.SetTo(paramsDotNullVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
-			In:   "path",
+			Name: "null",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: params.
+	// Decode query: dateformat.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "params",
+			Name:    "dateformat",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotParamsVal CallExtendParams
+				var paramsDotDateformatVal string
 				if err := func() error {
-					return paramsDotParamsVal.DecodeURI(d)
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDateformatVal = c
+					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Params = &paramsDotParamsVal
+				params.Dateformat.SetTo(paramsDotDateformatVal)
 				return nil
 			}); err != nil {
 				return err
@@ -800,43 +800,58 @@ func decodeCallExtendParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "params",
+			Name: "dateformat",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Set default value for query: bom.
+	{
+		val := bool(false)
+		params.Bom.SetTo(val)
+	}
+	// Decode query: bom.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "bom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// CallPostExtendParams is parameters of callPostExtend operation.
-type CallPostExtendParams struct {
-	// Identifier of the file location.
-	Path string
-	// Identifier of the file location.
-	File string
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBomVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackCallPostExtendParams(packed middleware.Parameters) (params CallPostExtendParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "path",
-			In:   "path",
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBomVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Bom.SetTo(paramsDotBomVal)
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
-		params.Path = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "file",
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "bom",
 			In:   "query",
+			Err:  err,
 		}
-		params.File = packed[key].(string)
 	}
-	return params
-}
-
-func decodeCallPostExtendParams(args [1]string, argsEscaped bool, r *http.Request) (params CallPostExtendParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: path.
+	// Decode path: table.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -848,7 +863,7 @@ func decodeCallPostExtendParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "path",
+				Param:   "table",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -865,7 +880,7 @@ func decodeCallPostExtendParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.Path = c
+				params.Table = c
 				return nil
 			}(); err != nil {
 				return err
@@ -876,73 +891,16 @@ func decodeCallPostExtendParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
+			Name: "table",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: file.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "file",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.File = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "file",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// CreateDirectoryParams is parameters of createDirectory operation.
-type CreateDirectoryParams struct {
-	// Identifier of the file location.
-	Path string
-}
-
-func unpackCreateDirectoryParams(packed middleware.Parameters) (params CreateDirectoryParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "path",
-			In:   "path",
-		}
-		params.Path = packed[key].(string)
-	}
-	return params
-}
-
-func decodeCreateDirectoryParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateDirectoryParams, _ error) {
-	// Decode path: path.
+	// Decode path: query.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -950,7 +908,7 @@ func decodeCreateDirectoryParams(args [1]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "path",
+				Param:   "query",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -967,7 +925,7 @@ func decodeCreateDirectoryParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.Path = c
+				params.Query = c
 				return nil
 			}(); err != nil {
 				return err
@@ -978,119 +936,48 @@ func decodeCreateDirectoryParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
+			Name: "query",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// DeleteExtendParams is parameters of deleteExtend operation.
-type DeleteExtendParams struct {
-	// Identifier of the file location.
-	Path string
-	// Identifier of the file location.
-	File string
-}
-
-func unpackDeleteExtendParams(packed middleware.Parameters) (params DeleteExtendParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "path",
-			In:   "path",
-		}
-		params.Path = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "file",
-			In:   "query",
-		}
-		params.File = packed[key].(string)
-	}
-	return params
-}
-
-func decodeDeleteExtendParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteExtendParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: path.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "path",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.Path = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	// Decode query: file.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "file",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	// Decode query: validate.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "validate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotValidateVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotValidateVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.File = c
+				params.Validate.SetTo(paramsDotValidateVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "file",
+			Name: "validate",
 			In:   "query",
 			Err:  err,
 		}
@@ -1098,92 +985,154 @@ func decodeDeleteExtendParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// DeleteFileLocationParams is parameters of deleteFileLocation operation.
-type DeleteFileLocationParams struct {
-	// Identifier of the file location.
-	Path string
-	// Identifier of the file location.
-	File OptString `json:",omitempty,omitzero"`
+// BatchQueryParams is parameters of batchQuery operation.
+type BatchQueryParams struct {
+	// CSV field delimiter, default is comma.
+	Delimiter OptString `json:",omitempty,omitzero"`
+	// CSV quote character, default is double quote.
+	Quote OptString `json:",omitempty,omitzero"`
+	// CSV header line with the field names.
+	Header OptBool `json:",omitempty,omitzero"`
+	// CSV representation of NULL values, default is empty.
+	Null OptString `json:",omitempty,omitzero"`
+	// CSV date format in Go time layout, default is 2006-01-02 15:04:05.
+	Dateformat OptString `json:",omitempty,omitzero"`
+	// Write UTF-8 byte order mark at start of CSV output.
+	Bom OptBool `json:",omitempty,omitzero"`
+	// Batch name.
+	Table string
+	// Check for validator additional information needed to be given to validator plugin.
+	Validate OptString `json:",omitempty,omitzero"`
 }
 
-func unpackDeleteFileLocationParams(packed middleware.Parameters) (params DeleteFileLocationParams) {
+func unpackBatchQueryParams(packed middleware.Parameters) (params BatchQueryParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "path",
+			Name: "delimiter",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Delimiter = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "quote",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Quote = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "header",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Header = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "null",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Null = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "dateformat",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Dateformat = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "bom",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Bom = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
 			In:   "path",
 		}
-		params.Path = packed[key].(string)
+		params.Table = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "file",
+			Name: "validate",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.File = v.(OptString)
+			params.Validate = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeDeleteFileLocationParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteFileLocationParams, _ error) {
+func decodeBatchQueryParams(args [1]string, argsEscaped bool, r *http.Request) (params BatchQueryParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: path.
+	// Decode query: delimiter.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "delimiter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "path",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDelimiterVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDelimiterVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Path = c
+				params.Delimiter.SetTo(paramsDotDelimiterVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
-			In:   "path",
+			Name: "delimiter",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: file.
+	// Decode query: quote.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "file",
+			Name:    "quote",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFileVal string
+				var paramsDotQuoteVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1195,12 +1144,12 @@ func decodeDeleteFileLocationParams(args [1]string, argsEscaped bool, r *http.Re
 						return err
 					}
 
-					paramsDotFileVal = c
+					paramsDotQuoteVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.File.SetTo(paramsDotFileVal)
+				params.Quote.SetTo(paramsDotQuoteVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1209,91 +1158,190 @@ func decodeDeleteFileLocationParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "file",
+			Name: "quote",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// DeleteJobResultParams is parameters of deleteJobResult operation.
-type DeleteJobResultParams struct {
-	// Job name to be requested.
-	JobName string
-	// Job id of execution result to be requested.
-	JobId string
-}
-
-func unpackDeleteJobResultParams(packed middleware.Parameters) (params DeleteJobResultParams) {
+	// Set default value for query: header.
 	{
-		key := middleware.ParameterKey{
-			Name: "jobName",
-			In:   "path",
-		}
-		params.JobName = packed[key].(string)
+		val := bool(true)
+		params.Header.SetTo(val)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "jobId",
-			In:   "path",
+	// Decode query: header.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "header",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		params.JobId = packed[key].(string)
-	}
-	return params
-}
 
-func decodeDeleteJobResultParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteJobResultParams, _ error) {
-	// Decode path: jobName.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotHeaderVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotHeaderVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Header.SetTo(paramsDotHeaderVal)
+				return nil
+			}); err != nil {
+				return err
 			}
-			param = unescaped
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "jobName",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "header",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: null.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "null",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNullVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNullVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.Null.SetTo(paramsDotNullVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "null",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: dateformat.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "dateformat",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDateformatVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDateformatVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.Dateformat.SetTo(paramsDotDateformatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "dateformat",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: bom.
+	{
+		val := bool(false)
+		params.Bom.SetTo(val)
+	}
+	// Decode query: bom.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "bom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-				params.JobName = c
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBomVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBomVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Bom.SetTo(paramsDotBomVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "jobName",
-			In:   "path",
+			Name: "bom",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: jobId.
+	// Decode path: table.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -1301,7 +1349,7 @@ func decodeDeleteJobResultParams(args [2]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "jobId",
+				Param:   "table",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1318,7 +1366,7 @@ func decodeDeleteJobResultParams(args [2]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.JobId = c
+				params.Table = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1329,218 +1377,217 @@ func decodeDeleteJobResultParams(args [2]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "jobId",
+			Name: "table",
 			In:   "path",
 			Err:  err,
 		}
 	}
+	// Decode query: validate.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "validate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotValidateVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotValidateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Validate.SetTo(paramsDotValidateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "validate",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// DeleteRecordsSearchedParams is parameters of deleteRecordsSearched operation.
-type DeleteRecordsSearchedParams struct {
-	// Start offset where the read will start from.
-	Start OptFloat64 `json:",omitempty,omitzero"`
-	// Maximal number of records retrieved.
-	Limit OptString `json:",omitempty,omitzero"`
-	// Sort criterium.
-	SortedBy OptString `json:",omitempty,omitzero"`
-	// Search criterium.
-	Sqlsearch OptString `json:",omitempty,omitzero"`
-	// Return result in compact structure.
-	Compact OptBool `json:",omitempty,omitzero"`
-	// Remove database group tree entries in result records.
-	Flatten OptBool `json:",omitempty,omitzero"`
-	// Read a descriptor read with the given field entry.
-	Descriptor OptBool `json:",omitempty,omitzero"`
-	// Order by criterias.
-	Orderby OptString `json:",omitempty,omitzero"`
-	// Use XML notation namespace.
-	Xmlnotation OptBool `json:",omitempty,omitzero"`
-	// SQL table.
+// BatchSelectParams is parameters of batchSelect operation.
+type BatchSelectParams struct {
+	// Query parameter.
+	Param []string `json:",omitempty"`
+	// CSV field delimiter, default is comma.
+	Delimiter OptString `json:",omitempty,omitzero"`
+	// CSV quote character, default is double quote.
+	Quote OptString `json:",omitempty,omitzero"`
+	// CSV header line with the field names.
+	Header OptBool `json:",omitempty,omitzero"`
+	// CSV representation of NULL values, default is empty.
+	Null OptString `json:",omitempty,omitzero"`
+	// CSV date format in Go time layout, default is 2006-01-02 15:04:05.
+	Dateformat OptString `json:",omitempty,omitzero"`
+	// Write UTF-8 byte order mark at start of CSV output.
+	Bom OptBool `json:",omitempty,omitzero"`
+	// Batch name.
 	Table string
-	// Specific SQL query string.
-	Search string
+	// Check for validator additional information needed to be given to validator plugin.
+	Validate OptString `json:",omitempty,omitzero"`
 }
 
-func unpackDeleteRecordsSearchedParams(packed middleware.Parameters) (params DeleteRecordsSearchedParams) {
+func unpackBatchSelectParams(packed middleware.Parameters) (params BatchSelectParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "start",
+			Name: "param",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Start = v.(OptFloat64)
+			params.Param = v.([]string)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "delimiter",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptString)
+			params.Delimiter = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sorted_by",
+			Name: "quote",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.SortedBy = v.(OptString)
+			params.Quote = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sqlsearch",
+			Name: "header",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Sqlsearch = v.(OptString)
+			params.Header = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "compact",
+			Name: "null",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Compact = v.(OptBool)
+			params.Null = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "flatten",
+			Name: "dateformat",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Flatten = v.(OptBool)
+			params.Dateformat = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "descriptor",
+			Name: "bom",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Descriptor = v.(OptBool)
+			params.Bom = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "orderby",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Orderby = v.(OptString)
+			Name: "table",
+			In:   "path",
 		}
+		params.Table = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "xmlnotation",
+			Name: "validate",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Xmlnotation = v.(OptBool)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "table",
-			In:   "path",
-		}
-		params.Table = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "path",
+			params.Validate = v.(OptString)
 		}
-		params.Search = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteRecordsSearchedParams, _ error) {
+func decodeBatchSelectParams(args [1]string, argsEscaped bool, r *http.Request) (params BatchSelectParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: start.
-	{
-		val := float64(0)
-		params.Start.SetTo(val)
-	}
-	// Decode query: start.
+	// Decode query: param.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start",
+			Name:    "param",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartVal float64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToFloat64(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStartVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Start.SetTo(paramsDotStartVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Start.Get(); ok {
+				params.Param = nil
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotParamVal string
 					if err := func() error {
-						if err := (validate.Float{}).Validate(float64(value)); err != nil {
-							return errors.Wrap(err, "float")
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
 						}
+
+						paramsDotParamVal = c
 						return nil
 					}(); err != nil {
 						return err
 					}
-				}
-				return nil
-			}(); err != nil {
+					params.Param = append(params.Param, paramsDotParamVal)
+					return nil
+				})
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start",
+			Name: "param",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := string("15")
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode query: delimiter.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "delimiter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal string
+				var paramsDotDelimiterVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1552,12 +1599,12 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotDelimiterVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.Delimiter.SetTo(paramsDotDelimiterVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1566,22 +1613,22 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "delimiter",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: sorted_by.
+	// Decode query: quote.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sorted_by",
+			Name:    "quote",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortedByVal string
+				var paramsDotQuoteVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1593,12 +1640,12 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 						return err
 					}
 
-					paramsDotSortedByVal = c
+					paramsDotQuoteVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.SortedBy.SetTo(paramsDotSortedByVal)
+				params.Quote.SetTo(paramsDotQuoteVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1607,39 +1654,44 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sorted_by",
+			Name: "quote",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: sqlsearch.
+	// Set default value for query: header.
+	{
+		val := bool(true)
+		params.Header.SetTo(val)
+	}
+	// Decode query: header.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sqlsearch",
+			Name:    "header",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSqlsearchVal string
+				var paramsDotHeaderVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSqlsearchVal = c
+					paramsDotHeaderVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				params.Header.SetTo(paramsDotHeaderVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1648,44 +1700,39 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sqlsearch",
+			Name: "header",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: compact.
-	{
-		val := bool(false)
-		params.Compact.SetTo(val)
-	}
-	// Decode query: compact.
+	// Decode query: null.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "compact",
+			Name:    "null",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCompactVal bool
+				var paramsDotNullVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotCompactVal = c
+					paramsDotNullVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Compact.SetTo(paramsDotCompactVal)
+				params.Null.SetTo(paramsDotNullVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1694,44 +1741,39 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "compact",
+			Name: "null",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: flatten.
-	{
-		val := bool(false)
-		params.Flatten.SetTo(val)
-	}
-	// Decode query: flatten.
+	// Decode query: dateformat.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "flatten",
+			Name:    "dateformat",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFlattenVal bool
+				var paramsDotDateformatVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotFlattenVal = c
+					paramsDotDateformatVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Flatten.SetTo(paramsDotFlattenVal)
+				params.Dateformat.SetTo(paramsDotDateformatVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1740,22 +1782,27 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "flatten",
+			Name: "dateformat",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: descriptor.
+	// Set default value for query: bom.
+	{
+		val := bool(false)
+		params.Bom.SetTo(val)
+	}
+	// Decode query: bom.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "descriptor",
+			Name:    "bom",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDescriptorVal bool
+				var paramsDotBomVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1767,12 +1814,12 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 						return err
 					}
 
-					paramsDotDescriptorVal = c
+					paramsDotBomVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Descriptor.SetTo(paramsDotDescriptorVal)
+				params.Bom.SetTo(paramsDotBomVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1781,80 +1828,84 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "descriptor",
+			Name: "bom",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: orderby.
+	// Decode path: table.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "orderby",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderbyVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOrderbyVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Orderby.SetTo(paramsDotOrderbyVal)
+
+				params.Table = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "orderby",
-			In:   "query",
+			Name: "table",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: xmlnotation.
+	// Decode query: validate.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "xmlnotation",
+			Name:    "validate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotXmlnotationVal bool
+				var paramsDotValidateVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotXmlnotationVal = c
+					paramsDotValidateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Xmlnotation.SetTo(paramsDotXmlnotationVal)
+				params.Validate.SetTo(paramsDotValidateVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1863,12 +1914,45 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "xmlnotation",
+			Name: "validate",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: table.
+	return params, nil
+}
+
+// BrowseLocationParams is parameters of browseLocation operation.
+type BrowseLocationParams struct {
+	// Identifier of the file location.
+	Path string
+	// Filter the result set.
+	Filter OptString `json:",omitempty,omitzero"`
+}
+
+func unpackBrowseLocationParams(packed middleware.Parameters) (params BrowseLocationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "path",
+		}
+		params.Path = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "filter",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Filter = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeBrowseLocationParams(args [1]string, argsEscaped bool, r *http.Request) (params BrowseLocationParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: path.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1880,7 +1964,7 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
+				Param:   "path",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1897,7 +1981,7 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.Table = c
+				params.Path = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1908,24 +1992,97 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
+			Name: "path",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: search.
+	// Decode query: filter.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "filter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFilterVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFilterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Filter.SetTo(paramsDotFilterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// CallExtendParams is parameters of callExtend operation.
+type CallExtendParams struct {
+	// Identifier of the file location.
+	Path   string
+	Params *CallExtendParams
+}
+
+func unpackCallExtendParams(packed middleware.Parameters) (params CallExtendParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "path",
+		}
+		params.Path = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "params",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Params = v.(*CallExtendParams)
+		}
+	}
+	return params
+}
+
+func decodeCallExtendParams(args [1]string, argsEscaped bool, r *http.Request) (params CallExtendParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: path.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "search",
+				Param:   "path",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1942,7 +2099,7 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.Search = c
+				params.Path = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1953,52 +2110,91 @@ func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
+			Name: "path",
 			In:   "path",
 			Err:  err,
 		}
 	}
+	// Decode query: params.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "params",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotParamsVal CallExtendParams
+				if err := func() error {
+					return paramsDotParamsVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Params = &paramsDotParamsVal
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "params",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// DeleteViewParams is parameters of deleteView operation.
-type DeleteViewParams struct {
-	// Database URL.
-	URL string
-	// Database file number.
-	File int
+// CallPostExtendParams is parameters of callPostExtend operation.
+type CallPostExtendParams struct {
+	// Identifier of the file location.
+	Path string
+	// Identifier of the file location.
+	File string
 }
 
-func unpackDeleteViewParams(packed middleware.Parameters) (params DeleteViewParams) {
+func unpackCallPostExtendParams(packed middleware.Parameters) (params CallPostExtendParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "url",
-			In:   "query",
+			Name: "path",
+			In:   "path",
 		}
-		params.URL = packed[key].(string)
+		params.Path = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
 			Name: "file",
 			In:   "query",
 		}
-		params.File = packed[key].(int)
+		params.File = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeleteViewParams(args [0]string, argsEscaped bool, r *http.Request) (params DeleteViewParams, _ error) {
+func decodeCallPostExtendParams(args [1]string, argsEscaped bool, r *http.Request) (params CallPostExtendParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: url.
+	// Decode path: path.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "url",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "path",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -2009,19 +2205,19 @@ func decodeDeleteViewParams(args [0]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.URL = c
+				params.Path = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "url",
-			In:   "query",
+			Name: "path",
+			In:   "path",
 			Err:  err,
 		}
 	}
@@ -2040,7 +2236,7 @@ func decodeDeleteViewParams(args [0]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -2064,25 +2260,25 @@ func decodeDeleteViewParams(args [0]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// DownloadFileParams is parameters of downloadFile operation.
-type DownloadFileParams struct {
-	// Identifier of the file location.
-	Path string
+// CancelUploadParams is parameters of cancelUpload operation.
+type CancelUploadParams struct {
+	// Identifier of the upload.
+	UploadId string
 }
 
-func unpackDownloadFileParams(packed middleware.Parameters) (params DownloadFileParams) {
+func unpackCancelUploadParams(packed middleware.Parameters) (params CancelUploadParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "path",
+			Name: "uploadId",
 			In:   "path",
 		}
-		params.Path = packed[key].(string)
+		params.UploadId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDownloadFileParams(args [1]string, argsEscaped bool, r *http.Request) (params DownloadFileParams, _ error) {
-	// Decode path: path.
+func decodeCancelUploadParams(args [1]string, argsEscaped bool, r *http.Request) (params CancelUploadParams, _ error) {
+	// Decode path: uploadId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2094,7 +2290,7 @@ func decodeDownloadFileParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "path",
+				Param:   "uploadId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2111,7 +2307,7 @@ func decodeDownloadFileParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.Path = c
+				params.UploadId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2122,7 +2318,7 @@ func decodeDownloadFileParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
+			Name: "uploadId",
 			In:   "path",
 			Err:  err,
 		}
@@ -2130,25 +2326,25 @@ func decodeDownloadFileParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetFieldsParams is parameters of getFields operation.
-type GetFieldsParams struct {
-	// SQL table.
-	Table string
+// CreateDirectoryParams is parameters of createDirectory operation.
+type CreateDirectoryParams struct {
+	// Identifier of the file location.
+	Path string
 }
 
-func unpackGetFieldsParams(packed middleware.Parameters) (params GetFieldsParams) {
+func unpackCreateDirectoryParams(packed middleware.Parameters) (params CreateDirectoryParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "table",
+			Name: "path",
 			In:   "path",
 		}
-		params.Table = packed[key].(string)
+		params.Path = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetFieldsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetFieldsParams, _ error) {
-	// Decode path: table.
+func decodeCreateDirectoryParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateDirectoryParams, _ error) {
+	// Decode path: path.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2160,7 +2356,7 @@ func decodeGetFieldsParams(args [1]string, argsEscaped bool, r *http.Request) (p
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
+				Param:   "path",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2177,7 +2373,7 @@ func decodeGetFieldsParams(args [1]string, argsEscaped bool, r *http.Request) (p
 					return err
 				}
 
-				params.Table = c
+				params.Path = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2188,7 +2384,7 @@ func decodeGetFieldsParams(args [1]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
+			Name: "path",
 			In:   "path",
 			Err:  err,
 		}
@@ -2196,86 +2392,59 @@ func decodeGetFieldsParams(args [1]string, argsEscaped bool, r *http.Request) (p
 	return params, nil
 }
 
-// GetImageParams is parameters of getImage operation.
-type GetImageParams struct {
-	// SQL table.
-	Table string
-	// Remote node reference.
-	Reference OptString `json:",omitempty,omitzero"`
-	// Specific search.
-	Search string
-	// Specific the data MIME type.
-	Mimetype OptString `json:",omitempty,omitzero"`
-	// Specific the field containing the mimetype.
-	MimetypeField string
-	// Specific the field to be.
-	Field string
-	// Search criterium.
-	Sqlsearch OptString `json:",omitempty,omitzero"`
+// CreateUploadParams is parameters of createUpload operation.
+type CreateUploadParams struct {
+	// Identifier of the file location.
+	Path string
+	// Name of the file in the location.
+	File OptString `json:",omitempty,omitzero"`
+	// Size of the complete file in bytes.
+	UploadLength int64
+	// Checksum of the complete file as algorithm and base64 value, like 'sha256
+	// n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg='. Supported are sha256, sha1 and md5.
+	UploadChecksum OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetImageParams(packed middleware.Parameters) (params GetImageParams) {
+func unpackCreateUploadParams(packed middleware.Parameters) (params CreateUploadParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "table",
+			Name: "path",
 			In:   "path",
 		}
-		params.Table = packed[key].(string)
+		params.Path = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "reference",
+			Name: "file",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Reference = v.(OptString)
+			params.File = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "path",
+			Name: "Upload-Length",
+			In:   "header",
 		}
-		params.Search = packed[key].(string)
+		params.UploadLength = packed[key].(int64)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "mimetype",
-			In:   "query",
+			Name: "Upload-Checksum",
+			In:   "header",
 		}
 		if v, ok := packed[key]; ok {
-			params.Mimetype = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "mimetypeField",
-			In:   "query",
-		}
-		params.MimetypeField = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "field",
-			In:   "path",
-		}
-		params.Field = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "sqlsearch",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Sqlsearch = v.(OptString)
+			params.UploadChecksum = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (params GetImageParams, _ error) {
+func decodeCreateUploadParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateUploadParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: table.
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: path.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2287,7 +2456,7 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
+				Param:   "path",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2304,7 +2473,7 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.Table = c
+				params.Path = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2315,22 +2484,22 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
+			Name: "path",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: reference.
+	// Decode query: file.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "reference",
+			Name:    "file",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotReferenceVal string
+				var paramsDotFileVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -2342,12 +2511,12 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 						return err
 					}
 
-					paramsDotReferenceVal = c
+					paramsDotFileVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Reference.SetTo(paramsDotReferenceVal)
+				params.File.SetTo(paramsDotFileVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2356,67 +2525,72 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "reference",
+			Name: "file",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: search.
+	// Decode header: Upload-Length.
 	if err := func() error {
-		param := args[2]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Upload-Length",
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "search",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt64(val)
 				if err != nil {
 					return err
 				}
 
-				params.Search = c
+				params.UploadLength = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           0,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+					Pattern:       nil,
+				}).Validate(int64(params.UploadLength)); err != nil {
+					return errors.Wrap(err, "int")
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
-			In:   "path",
+			Name: "Upload-Length",
+			In:   "header",
 			Err:  err,
 		}
 	}
-	// Decode query: mimetype.
+	// Decode header: Upload-Checksum.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mimetype",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Upload-Checksum",
+			Explode: false,
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotMimetypeVal string
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUploadChecksumVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -2428,12 +2602,12 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 						return err
 					}
 
-					paramsDotMimetypeVal = c
+					paramsDotUploadChecksumVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Mimetype.SetTo(paramsDotMimetypeVal)
+				params.UploadChecksum.SetTo(paramsDotUploadChecksumVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2442,15 +2616,91 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "mimetype",
+			Name: "Upload-Checksum",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteExtendParams is parameters of deleteExtend operation.
+type DeleteExtendParams struct {
+	// Identifier of the file location.
+	Path string
+	// Identifier of the file location.
+	File string
+}
+
+func unpackDeleteExtendParams(packed middleware.Parameters) (params DeleteExtendParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "path",
+		}
+		params.Path = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "file",
 			In:   "query",
+		}
+		params.File = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDeleteExtendParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteExtendParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: path.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "path",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Path = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "path",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: mimetypeField.
+	// Decode query: file.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mimetypeField",
+			Name:    "file",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
@@ -2467,7 +2717,7 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.MimetypeField = c
+				params.File = c
 				return nil
 			}); err != nil {
 				return err
@@ -2478,16 +2728,49 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "mimetypeField",
+			Name: "file",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: field.
+	return params, nil
+}
+
+// DeleteFileLocationParams is parameters of deleteFileLocation operation.
+type DeleteFileLocationParams struct {
+	// Identifier of the file location.
+	Path string
+	// Identifier of the file location.
+	File OptString `json:",omitempty,omitzero"`
+}
+
+func unpackDeleteFileLocationParams(packed middleware.Parameters) (params DeleteFileLocationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "path",
+		}
+		params.Path = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "file",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.File = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeDeleteFileLocationParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteFileLocationParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: path.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2495,7 +2778,7 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "field",
+				Param:   "path",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2512,7 +2795,7 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.Field = c
+				params.Path = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2523,22 +2806,22 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "field",
+			Name: "path",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: sqlsearch.
+	// Decode query: file.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sqlsearch",
+			Name:    "file",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSqlsearchVal string
+				var paramsDotFileVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -2550,12 +2833,12 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 						return err
 					}
 
-					paramsDotSqlsearchVal = c
+					paramsDotFileVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				params.File.SetTo(paramsDotFileVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2564,7 +2847,7 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sqlsearch",
+			Name: "file",
 			In:   "query",
 			Err:  err,
 		}
@@ -2572,146 +2855,83 @@ func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// GetJobExecutionResultParams is parameters of getJobExecutionResult operation.
-type GetJobExecutionResultParams struct {
-	// Start time from.
-	From OptString `json:",omitempty,omitzero"`
-	// End time to.
-	To OptString `json:",omitempty,omitzero"`
+// DeleteJobResultParams is parameters of deleteJobResult operation.
+type DeleteJobResultParams struct {
+	// Job name to be requested.
+	JobName string
+	// Job id of execution result to be requested.
+	JobId string
 }
 
-func unpackGetJobExecutionResultParams(packed middleware.Parameters) (params GetJobExecutionResultParams) {
+func unpackDeleteJobResultParams(packed middleware.Parameters) (params DeleteJobResultParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "from",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.From = v.(OptString)
+			Name: "jobName",
+			In:   "path",
 		}
+		params.JobName = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "to",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.To = v.(OptString)
+			Name: "jobId",
+			In:   "path",
 		}
+		params.JobId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetJobExecutionResultParams(args [0]string, argsEscaped bool, r *http.Request) (params GetJobExecutionResultParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: from.
+func decodeDeleteJobResultParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteJobResultParams, _ error) {
+	// Decode path: jobName.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "from",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFromVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotFromVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.From.SetTo(paramsDotFromVal)
-				return nil
-			}); err != nil {
-				return err
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "from",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: to.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "to",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotToVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "jobName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotToVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.To.SetTo(paramsDotToVal)
+
+				params.JobName = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "to",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetJobFullInfoParams is parameters of getJobFullInfo operation.
-type GetJobFullInfoParams struct {
-	// Job Name to be requested.
-	JobName string
-}
-
-func unpackGetJobFullInfoParams(packed middleware.Parameters) (params GetJobFullInfoParams) {
-	{
-		key := middleware.ParameterKey{
 			Name: "jobName",
 			In:   "path",
+			Err:  err,
 		}
-		params.JobName = packed[key].(string)
 	}
-	return params
-}
-
-func decodeGetJobFullInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetJobFullInfoParams, _ error) {
-	// Decode path: jobName.
+	// Decode path: jobId.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2719,7 +2939,7 @@ func decodeGetJobFullInfoParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "jobName",
+				Param:   "jobId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2736,7 +2956,7 @@ func decodeGetJobFullInfoParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.JobName = c
+				params.JobId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2747,7 +2967,7 @@ func decodeGetJobFullInfoParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "jobName",
+			Name: "jobId",
 			In:   "path",
 			Err:  err,
 		}
@@ -2755,34 +2975,35 @@ func decodeGetJobFullInfoParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetJobResultParams is parameters of getJobResult operation.
-type GetJobResultParams struct {
-	// Job name to be requested.
-	JobName string
-	// Job id of execution result to be requested.
-	JobId string
+// DeleteRecordByKeyParams is parameters of deleteRecordByKey operation.
+type DeleteRecordByKeyParams struct {
+	// SQL table.
+	Table string
+	// Primary key value, values of composite keys are separated by comma in key column order, values
+	// containing a comma are quoted like 'a,b',2.
+	Key string
 }
 
-func unpackGetJobResultParams(packed middleware.Parameters) (params GetJobResultParams) {
+func unpackDeleteRecordByKeyParams(packed middleware.Parameters) (params DeleteRecordByKeyParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "jobName",
+			Name: "table",
 			In:   "path",
 		}
-		params.JobName = packed[key].(string)
+		params.Table = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "jobId",
+			Name: "key",
 			In:   "path",
 		}
-		params.JobId = packed[key].(string)
+		params.Key = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetJobResultParams(args [2]string, argsEscaped bool, r *http.Request) (params GetJobResultParams, _ error) {
-	// Decode path: jobName.
+func decodeDeleteRecordByKeyParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteRecordByKeyParams, _ error) {
+	// Decode path: table.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2794,7 +3015,7 @@ func decodeGetJobResultParams(args [2]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "jobName",
+				Param:   "table",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2811,7 +3032,7 @@ func decodeGetJobResultParams(args [2]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.JobName = c
+				params.Table = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2822,12 +3043,12 @@ func decodeGetJobResultParams(args [2]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "jobName",
+			Name: "table",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: jobId.
+	// Decode path: key.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -2839,7 +3060,7 @@ func decodeGetJobResultParams(args [2]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "jobId",
+				Param:   "key",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2856,7 +3077,7 @@ func decodeGetJobResultParams(args [2]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.JobId = c
+				params.Key = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2867,7 +3088,7 @@ func decodeGetJobResultParams(args [2]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "jobId",
+			Name: "key",
 			In:   "path",
 			Err:  err,
 		}
@@ -2875,221 +3096,199 @@ func decodeGetJobResultParams(args [2]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetJobsParams is parameters of getJobs operation.
-type GetJobsParams struct {
-	// Timestamp of first job entry.
-	StartTime OptString `json:",omitempty,omitzero"`
-	// Timestamp of last job entry.
-	EndTime OptString `json:",omitempty,omitzero"`
+// DeleteRecordsSearchedParams is parameters of deleteRecordsSearched operation.
+type DeleteRecordsSearchedParams struct {
+	// Count the affected records without committing the operation.
+	DryRun OptBool `json:",omitempty,omitzero"`
+	// Number of affected records returned by a dry run.
+	Sample OptInt `json:",omitempty,omitzero"`
+	// Confirm token of a dry run to exceed the affected records limit of the table.
+	Confirm OptString `json:",omitempty,omitzero"`
+	// Start offset where the read will start from.
+	Start OptFloat64 `json:",omitempty,omitzero"`
+	// Maximal number of records retrieved.
+	Limit OptString `json:",omitempty,omitzero"`
+	// Sort criterium.
+	SortedBy OptString `json:",omitempty,omitzero"`
+	// Search criterium.
+	Sqlsearch OptString `json:",omitempty,omitzero"`
+	// Return result in compact structure.
+	Compact OptBool `json:",omitempty,omitzero"`
+	// Remove database group tree entries in result records.
+	Flatten OptBool `json:",omitempty,omitzero"`
+	// Read a descriptor read with the given field entry.
+	Descriptor OptBool `json:",omitempty,omitzero"`
+	// Order by criterias.
+	Orderby OptString `json:",omitempty,omitzero"`
+	// Use XML notation namespace.
+	Xmlnotation OptBool `json:",omitempty,omitzero"`
+	// SQL table.
+	Table string
+	// Specific SQL query string.
+	Search string
 }
 
-func unpackGetJobsParams(packed middleware.Parameters) (params GetJobsParams) {
+func unpackDeleteRecordsSearchedParams(packed middleware.Parameters) (params DeleteRecordsSearchedParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "start_time",
+			Name: "dryRun",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.StartTime = v.(OptString)
+			params.DryRun = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "end_time",
+			Name: "sample",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.EndTime = v.(OptString)
+			params.Sample = v.(OptInt)
 		}
 	}
-	return params
-}
-
-func decodeGetJobsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetJobsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: start_time.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start_time",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	{
+		key := middleware.ParameterKey{
+			Name: "confirm",
+			In:   "query",
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartTimeVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStartTimeVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.StartTime.SetTo(paramsDotStartTimeVal)
-				return nil
-			}); err != nil {
-				return err
-			}
+		if v, ok := packed[key]; ok {
+			params.Confirm = v.(OptString)
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "start_time",
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
 			In:   "query",
-			Err:  err,
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptFloat64)
 		}
 	}
-	// Decode query: end_time.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end_time",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndTimeVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotEndTimeVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.EndTime.SetTo(paramsDotEndTimeVal)
-				return nil
-			}); err != nil {
-				return err
-			}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptString)
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "end_time",
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sorted_by",
 			In:   "query",
-			Err:  err,
+		}
+		if v, ok := packed[key]; ok {
+			params.SortedBy = v.(OptString)
 		}
 	}
-	return params, nil
-}
-
-// GetLobByMapParams is parameters of getLobByMap operation.
-type GetLobByMapParams struct {
-	// Specific the field containing the mimetype.
-	MimetypeField OptString `json:",omitempty,omitzero"`
-	// Specific the data MIME type.
-	Mimetype OptString `json:",omitempty,omitzero"`
-	// Search criterium.
-	Sqlsearch OptString `json:",omitempty,omitzero"`
-	// SQL table.
-	Table string
-	// Specific table record.
-	Search string
-	// Specific the field to be.
-	Field string
-}
-
-func unpackGetLobByMapParams(packed middleware.Parameters) (params GetLobByMapParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "mimetypeField",
+			Name: "sqlsearch",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.MimetypeField = v.(OptString)
+			params.Sqlsearch = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "mimetype",
+			Name: "compact",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Mimetype = v.(OptString)
+			params.Compact = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sqlsearch",
+			Name: "flatten",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Sqlsearch = v.(OptString)
+			params.Flatten = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "table",
-			In:   "path",
+			Name: "descriptor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Descriptor = v.(OptBool)
 		}
-		params.Table = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "search",
+			Name: "orderby",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Orderby = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "xmlnotation",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Xmlnotation = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
 			In:   "path",
 		}
-		params.Search = packed[key].(string)
+		params.Table = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "field",
+			Name: "search",
 			In:   "path",
 		}
-		params.Field = packed[key].(string)
+		params.Search = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetLobByMapParams(args [3]string, argsEscaped bool, r *http.Request) (params GetLobByMapParams, _ error) {
+func decodeDeleteRecordsSearchedParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteRecordsSearchedParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: mimetypeField.
+	// Set default value for query: dryRun.
+	{
+		val := bool(false)
+		params.DryRun.SetTo(val)
+	}
+	// Decode query: dryRun.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mimetypeField",
+			Name:    "dryRun",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotMimetypeFieldVal string
+				var paramsDotDryRunVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotMimetypeFieldVal = c
+					paramsDotDryRunVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.MimetypeField.SetTo(paramsDotMimetypeFieldVal)
+				params.DryRun.SetTo(paramsDotDryRunVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3098,39 +3297,44 @@ func decodeGetLobByMapParams(args [3]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "mimetypeField",
+			Name: "dryRun",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: mimetype.
+	// Set default value for query: sample.
+	{
+		val := int(0)
+		params.Sample.SetTo(val)
+	}
+	// Decode query: sample.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mimetype",
+			Name:    "sample",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotMimetypeVal string
+				var paramsDotSampleVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotMimetypeVal = c
+					paramsDotSampleVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Mimetype.SetTo(paramsDotMimetypeVal)
+				params.Sample.SetTo(paramsDotSampleVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3139,22 +3343,22 @@ func decodeGetLobByMapParams(args [3]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "mimetype",
+			Name: "sample",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: sqlsearch.
+	// Decode query: confirm.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sqlsearch",
+			Name:    "confirm",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSqlsearchVal string
+				var paramsDotConfirmVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -3166,12 +3370,12 @@ func decodeGetLobByMapParams(args [3]string, argsEscaped bool, r *http.Request)
 						return err
 					}
 
-					paramsDotSqlsearchVal = c
+					paramsDotConfirmVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				params.Confirm.SetTo(paramsDotConfirmVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3180,114 +3384,6717 @@ func decodeGetLobByMapParams(args [3]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sqlsearch",
+			Name: "confirm",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: table.
+	// Set default value for query: start.
+	{
+		val := float64(0)
+		params.Start.SetTo(val)
+	}
+	// Decode query: start.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Table = c
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Start.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
-			In:   "path",
+			Name: "start",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: search.
+	// Set default value for query: limit.
+	{
+		val := string("15")
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
-		param := args[2]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "search",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Search = c
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: field.
+	// Decode query: sorted_by.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sorted_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortedByVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortedByVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortedBy.SetTo(paramsDotSortedByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sorted_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sqlsearch.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSqlsearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSqlsearchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sqlsearch",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: compact.
+	{
+		val := bool(false)
+		params.Compact.SetTo(val)
+	}
+	// Decode query: compact.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "compact",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCompactVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCompactVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Compact.SetTo(paramsDotCompactVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "compact",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: flatten.
+	{
+		val := bool(false)
+		params.Flatten.SetTo(val)
+	}
+	// Decode query: flatten.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "flatten",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFlattenVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFlattenVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Flatten.SetTo(paramsDotFlattenVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "flatten",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: descriptor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "descriptor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDescriptorVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDescriptorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Descriptor.SetTo(paramsDotDescriptorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "descriptor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: orderby.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "orderby",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderbyVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderbyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Orderby.SetTo(paramsDotOrderbyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "orderby",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: xmlnotation.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "xmlnotation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotXmlnotationVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotXmlnotationVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Xmlnotation.SetTo(paramsDotXmlnotationVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "xmlnotation",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: search.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "search",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Search = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteViewParams is parameters of deleteView operation.
+type DeleteViewParams struct {
+	// Database URL.
+	URL string
+	// Database file number.
+	File int
+}
+
+func unpackDeleteViewParams(packed middleware.Parameters) (params DeleteViewParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "url",
+			In:   "query",
+		}
+		params.URL = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "file",
+			In:   "query",
+		}
+		params.File = packed[key].(int)
+	}
+	return params
+}
+
+func decodeDeleteViewParams(args [0]string, argsEscaped bool, r *http.Request) (params DeleteViewParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: url.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "url",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.URL = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "url",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: file.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "file",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.File = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "file",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DownloadFileParams is parameters of downloadFile operation.
+type DownloadFileParams struct {
+	// Identifier of the file location.
+	Path string
+}
+
+func unpackDownloadFileParams(packed middleware.Parameters) (params DownloadFileParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "path",
+		}
+		params.Path = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDownloadFileParams(args [1]string, argsEscaped bool, r *http.Request) (params DownloadFileParams, _ error) {
+	// Decode path: path.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "path",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Path = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "path",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetArchiveParams is parameters of getArchive operation.
+type GetArchiveParams struct {
+	// SQL table.
+	Table string
+	// Specific the field containing the large object.
+	Field string
+	// Specific search.
+	Search string
+	// Specific the field containing the mimetype used for the file extension of the entries.
+	MimetypeField OptString `json:",omitempty,omitzero"`
+	// Specific the field containing the file name of the entries.
+	NameField OptString `json:",omitempty,omitzero"`
+	// File name pattern of the entries, fields are referenced with {field} and the record number with {#}.
+	NamePattern OptString `json:",omitempty,omitzero"`
+	// Maximum number of records in the archive, can only lower the configured maximum.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Maximum number of large object bytes in the archive, can only lower the configured maximum.
+	MaxSize OptInt64 `json:",omitempty,omitzero"`
+}
+
+func unpackGetArchiveParams(packed middleware.Parameters) (params GetArchiveParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "field",
+			In:   "path",
+		}
+		params.Field = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetypeField",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MimetypeField = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "nameField",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.NameField = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "namePattern",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.NamePattern = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "maxSize",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MaxSize = v.(OptInt64)
+		}
+	}
+	return params
+}
+
+func decodeGetArchiveParams(args [3]string, argsEscaped bool, r *http.Request) (params GetArchiveParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: field.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Field = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "field",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: search.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "search",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Search = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: mimetypeField.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMimetypeFieldVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMimetypeFieldVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MimetypeField.SetTo(paramsDotMimetypeFieldVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mimetypeField",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: nameField.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "nameField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNameFieldVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNameFieldVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.NameField.SetTo(paramsDotNameFieldVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "nameField",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: namePattern.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "namePattern",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNamePatternVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNamePatternVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.NamePattern.SetTo(paramsDotNamePatternVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "namePattern",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: maxSize.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "maxSize",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMaxSizeVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMaxSizeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MaxSize.SetTo(paramsDotMaxSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.MaxSize.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "maxSize",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetFieldsParams is parameters of getFields operation.
+type GetFieldsParams struct {
+	// SQL table.
+	Table string
+}
+
+func unpackGetFieldsParams(packed middleware.Parameters) (params GetFieldsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetFieldsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetFieldsParams, _ error) {
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetImageParams is parameters of getImage operation.
+type GetImageParams struct {
+	// SQL table.
+	Table string
+	// Remote node reference.
+	Reference OptString `json:",omitempty,omitzero"`
+	// Specific search.
+	Search string
+	// Specific the data MIME type.
+	Mimetype OptString `json:",omitempty,omitzero"`
+	// Specific the field containing the mimetype.
+	MimetypeField string
+	// Specific the field to be.
+	Field string
+	// Search criterium.
+	Sqlsearch OptString `json:",omitempty,omitzero"`
+	// Width of the returned image in pixels.
+	Width OptInt `json:",omitempty,omitzero"`
+	// Height of the returned image in pixels.
+	Height OptInt `json:",omitempty,omitzero"`
+	// Fit of the image into width and height. contain keeps the whole image inside, cover fills the area
+	// keeping the aspect ratio, crop fills the area and cuts off the overlapping parts.
+	Fit OptGetImageFit `json:",omitempty,omitzero"`
+	// JPEG quality of the returned image.
+	Quality OptInt `json:",omitempty,omitzero"`
+	// Image format of the returned image.
+	Format OptGetImageFormat `json:",omitempty,omitzero"`
+}
+
+func unpackGetImageParams(packed middleware.Parameters) (params GetImageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "reference",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Reference = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetype",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Mimetype = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetypeField",
+			In:   "query",
+		}
+		params.MimetypeField = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "field",
+			In:   "path",
+		}
+		params.Field = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sqlsearch",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sqlsearch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "width",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Width = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "height",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Height = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "fit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Fit = v.(OptGetImageFit)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "quality",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Quality = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptGetImageFormat)
+		}
+	}
+	return params
+}
+
+func decodeGetImageParams(args [3]string, argsEscaped bool, r *http.Request) (params GetImageParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: reference.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "reference",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReferenceVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotReferenceVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Reference.SetTo(paramsDotReferenceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "reference",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode path: search.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "search",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Search = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: mimetype.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mimetype",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMimetypeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMimetypeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Mimetype.SetTo(paramsDotMimetypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mimetype",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: mimetypeField.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.MimetypeField = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mimetypeField",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode path: field.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Field = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "field",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: sqlsearch.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSqlsearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSqlsearchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sqlsearch",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: width.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "width",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotWidthVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotWidthVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Width.SetTo(paramsDotWidthVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Width.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "width",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: height.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "height",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotHeightVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotHeightVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Height.SetTo(paramsDotHeightVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Height.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "height",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: fit.
+	{
+		val := GetImageFit("contain")
+		params.Fit.SetTo(val)
+	}
+	// Decode query: fit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "fit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFitVal GetImageFit
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFitVal = GetImageFit(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Fit.SetTo(paramsDotFitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Fit.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "fit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: quality.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "quality",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQualityVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQualityVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Quality.SetTo(paramsDotQualityVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Quality.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "quality",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal GetImageFormat
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = GetImageFormat(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetImageDuplicatesParams is parameters of getImageDuplicates operation.
+type GetImageDuplicatesParams struct {
+	// SQL table.
+	Table string
+	// Field containing the perceptual hash.
+	HashField string
+	// Maximum Hamming distance of the hashes of near-duplicate images.
+	Distance OptInt `json:",omitempty,omitzero"`
+	// Search of the records, all records if not given.
+	Search OptString `json:",omitempty,omitzero"`
+	// Maximum number of hashes compared, can only lower the configured maximum.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetImageDuplicatesParams(packed middleware.Parameters) (params GetImageDuplicatesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "hashField",
+			In:   "path",
+		}
+		params.HashField = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "distance",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Distance = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Search = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetImageDuplicatesParams(args [2]string, argsEscaped bool, r *http.Request) (params GetImageDuplicatesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: hashField.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hashField",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.HashField = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hashField",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: distance.
+	{
+		val := int(4)
+		params.Distance.SetTo(val)
+	}
+	// Decode query: distance.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "distance",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDistanceVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDistanceVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Distance.SetTo(paramsDotDistanceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Distance.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           64,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "distance",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: search.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "search",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSearchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Search.SetTo(paramsDotSearchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetImageHashParams is parameters of getImageHash operation.
+type GetImageHashParams struct {
+	// SQL table.
+	Table string
+	// Specific the field containing the image.
+	Field string
+	// Specific search.
+	Search string
+	// Specific the field containing the mimetype.
+	MimetypeField OptString `json:",omitempty,omitzero"`
+	// Perceptual hash algorithm, average (ahash), difference (dhash) or DCT based (phash).
+	Algorithm OptGetImageHashAlgorithm `json:",omitempty,omitzero"`
+}
+
+func unpackGetImageHashParams(packed middleware.Parameters) (params GetImageHashParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "field",
+			In:   "path",
+		}
+		params.Field = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetypeField",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MimetypeField = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "algorithm",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Algorithm = v.(OptGetImageHashAlgorithm)
+		}
+	}
+	return params
+}
+
+func decodeGetImageHashParams(args [3]string, argsEscaped bool, r *http.Request) (params GetImageHashParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: field.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Field = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "field",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: search.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "search",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Search = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: mimetypeField.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMimetypeFieldVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMimetypeFieldVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MimetypeField.SetTo(paramsDotMimetypeFieldVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mimetypeField",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: algorithm.
+	{
+		val := GetImageHashAlgorithm("phash")
+		params.Algorithm.SetTo(val)
+	}
+	// Decode query: algorithm.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "algorithm",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAlgorithmVal GetImageHashAlgorithm
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAlgorithmVal = GetImageHashAlgorithm(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Algorithm.SetTo(paramsDotAlgorithmVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Algorithm.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "algorithm",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetImageMetadataParams is parameters of getImageMetadata operation.
+type GetImageMetadataParams struct {
+	// SQL table.
+	Table string
+	// Specific the field containing the image.
+	Field string
+	// Specific search.
+	Search string
+	// Specific the field containing the mimetype.
+	MimetypeField OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetImageMetadataParams(packed middleware.Parameters) (params GetImageMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "field",
+			In:   "path",
+		}
+		params.Field = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetypeField",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MimetypeField = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetImageMetadataParams(args [3]string, argsEscaped bool, r *http.Request) (params GetImageMetadataParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: field.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Field = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "field",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: search.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "search",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Search = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: mimetypeField.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMimetypeFieldVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMimetypeFieldVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MimetypeField.SetTo(paramsDotMimetypeFieldVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mimetypeField",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetJobExecutionResultParams is parameters of getJobExecutionResult operation.
+type GetJobExecutionResultParams struct {
+	// Start time from.
+	From OptString `json:",omitempty,omitzero"`
+	// End time to.
+	To OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetJobExecutionResultParams(packed middleware.Parameters) (params GetJobExecutionResultParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetJobExecutionResultParams(args [0]string, argsEscaped bool, r *http.Request) (params GetJobExecutionResultParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetJobFullInfoParams is parameters of getJobFullInfo operation.
+type GetJobFullInfoParams struct {
+	// Job Name to be requested.
+	JobName string
+}
+
+func unpackGetJobFullInfoParams(packed middleware.Parameters) (params GetJobFullInfoParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "jobName",
+			In:   "path",
+		}
+		params.JobName = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetJobFullInfoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetJobFullInfoParams, _ error) {
+	// Decode path: jobName.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "jobName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.JobName = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "jobName",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetJobResultParams is parameters of getJobResult operation.
+type GetJobResultParams struct {
+	// Job name to be requested.
+	JobName string
+	// Job id of execution result to be requested.
+	JobId string
+}
+
+func unpackGetJobResultParams(packed middleware.Parameters) (params GetJobResultParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "jobName",
+			In:   "path",
+		}
+		params.JobName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "jobId",
+			In:   "path",
+		}
+		params.JobId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetJobResultParams(args [2]string, argsEscaped bool, r *http.Request) (params GetJobResultParams, _ error) {
+	// Decode path: jobName.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "jobName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.JobName = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "jobName",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: jobId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "jobId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.JobId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "jobId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetJobsParams is parameters of getJobs operation.
+type GetJobsParams struct {
+	// Timestamp of first job entry.
+	StartTime OptString `json:",omitempty,omitzero"`
+	// Timestamp of last job entry.
+	EndTime OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetJobsParams(packed middleware.Parameters) (params GetJobsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "start_time",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartTime = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end_time",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EndTime = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetJobsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetJobsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: start_time.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start_time",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartTimeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartTimeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StartTime.SetTo(paramsDotStartTimeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start_time",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end_time.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end_time",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndTimeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndTimeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EndTime.SetTo(paramsDotEndTimeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end_time",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetLobByMapParams is parameters of getLobByMap operation.
+type GetLobByMapParams struct {
+	// Specific the field containing the mimetype.
+	MimetypeField OptString `json:",omitempty,omitzero"`
+	// Specific the data MIME type.
+	Mimetype OptString `json:",omitempty,omitzero"`
+	// Search criterium.
+	Sqlsearch OptString `json:",omitempty,omitzero"`
+	// Byte ranges of the large object to be read.
+	Range OptString `json:",omitempty,omitzero"`
+	// Read the ranges only if the ETag of the large object matches.
+	IfRange OptString `json:",omitempty,omitzero"`
+	// SQL table.
+	Table string
+	// Specific table record.
+	Search string
+	// Specific the field to be.
+	Field string
+}
+
+func unpackGetLobByMapParams(packed middleware.Parameters) (params GetLobByMapParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetypeField",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MimetypeField = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetype",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Mimetype = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sqlsearch",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sqlsearch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Range",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.Range = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Range",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfRange = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "field",
+			In:   "path",
+		}
+		params.Field = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetLobByMapParams(args [3]string, argsEscaped bool, r *http.Request) (params GetLobByMapParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode query: mimetypeField.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMimetypeFieldVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMimetypeFieldVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MimetypeField.SetTo(paramsDotMimetypeFieldVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mimetypeField",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: mimetype.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mimetype",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMimetypeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMimetypeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Mimetype.SetTo(paramsDotMimetypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mimetype",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sqlsearch.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSqlsearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSqlsearchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sqlsearch",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode header: Range.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Range",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRangeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRangeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Range.SetTo(paramsDotRangeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Range",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode header: If-Range.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Range",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfRangeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfRangeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfRange.SetTo(paramsDotIfRangeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Range",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: search.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "search",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Search = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: field.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Field = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "field",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMapMetadataParams is parameters of getMapMetadata operation.
+type GetMapMetadataParams struct {
+	// SQL table.
+	Table string
+}
+
+func unpackGetMapMetadataParams(packed middleware.Parameters) (params GetMapMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetMapMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMapMetadataParams, _ error) {
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMapRecordsFieldsParams is parameters of getMapRecordsFields operation.
+type GetMapRecordsFieldsParams struct {
+	// SQL table.
+	Table string
+	// Search.
+	Search string
+	// Specific a comma separated list of fields to be part of the result record.
+	Fields string
+	// Start offset where the read will start from.
+	Start OptFloat64 `json:",omitempty,omitzero"`
+	// Maximal number of records retrieved.
+	Limit OptString `json:",omitempty,omitzero"`
+	// Sort criterium.
+	SortedBy OptString `json:",omitempty,omitzero"`
+	// Search criterium.
+	Sqlsearch OptString `json:",omitempty,omitzero"`
+	// Return result in compact structure.
+	Compact OptBool `json:",omitempty,omitzero"`
+	// Remove database group tree entries in result records.
+	Flatten OptBool `json:",omitempty,omitzero"`
+	// Read a descriptor read with the given field entry.
+	Descriptor OptBool `json:",omitempty,omitzero"`
+	// Number of records skipped before the first record is returned.
+	Offset OptInt `json:",omitempty,omitzero"`
+	// Opaque keyset cursor of a previous page (Next or Prev) continuing the read based on the orderby
+	// fields.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// Return the total number of matching records in NrRecords instead of the page size.
+	Count OptBool `json:",omitempty,omitzero"`
+	// Order by criterias.
+	Orderby OptString `json:",omitempty,omitzero"`
+	// Use XML notation namespace.
+	Xmlnotation OptBool `json:",omitempty,omitzero"`
+	// CSV field delimiter, default is comma.
+	Delimiter OptString `json:",omitempty,omitzero"`
+	// CSV quote character, default is double quote.
+	Quote OptString `json:",omitempty,omitzero"`
+	// CSV header line with the field names.
+	Header OptBool `json:",omitempty,omitzero"`
+	// CSV representation of NULL values, default is empty.
+	Null OptString `json:",omitempty,omitzero"`
+	// CSV date format in Go time layout, default is 2006-01-02 15:04:05.
+	Dateformat OptString `json:",omitempty,omitzero"`
+	// Write UTF-8 byte order mark at start of CSV output.
+	Bom OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackGetMapRecordsFieldsParams(packed middleware.Parameters) (params GetMapRecordsFieldsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "fields",
+			In:   "path",
+		}
+		params.Fields = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sorted_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SortedBy = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sqlsearch",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sqlsearch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "compact",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Compact = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "flatten",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Flatten = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "descriptor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Descriptor = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "count",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Count = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "orderby",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Orderby = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "xmlnotation",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Xmlnotation = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "delimiter",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Delimiter = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "quote",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Quote = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "header",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Header = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "null",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Null = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "dateformat",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Dateformat = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "bom",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Bom = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.Request) (params GetMapRecordsFieldsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: search.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "search",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Search = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: fields.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "fields",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Fields = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "fields",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: start.
+	{
+		val := float64(0)
+		params.Start.SetTo(val)
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Start.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := string("100")
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sorted_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sorted_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortedByVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortedByVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortedBy.SetTo(paramsDotSortedByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sorted_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sqlsearch.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSqlsearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSqlsearchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sqlsearch",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: compact.
+	{
+		val := bool(false)
+		params.Compact.SetTo(val)
+	}
+	// Decode query: compact.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "compact",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCompactVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCompactVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Compact.SetTo(paramsDotCompactVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "compact",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: flatten.
+	{
+		val := bool(false)
+		params.Flatten.SetTo(val)
+	}
+	// Decode query: flatten.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "flatten",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFlattenVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFlattenVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Flatten.SetTo(paramsDotFlattenVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "flatten",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: descriptor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "descriptor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDescriptorVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDescriptorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Descriptor.SetTo(paramsDotDescriptorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "descriptor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: count.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCountVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Count.SetTo(paramsDotCountVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "count",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: orderby.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "orderby",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderbyVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderbyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Orderby.SetTo(paramsDotOrderbyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "orderby",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: xmlnotation.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "xmlnotation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotXmlnotationVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotXmlnotationVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Xmlnotation.SetTo(paramsDotXmlnotationVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "xmlnotation",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: delimiter.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "delimiter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDelimiterVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDelimiterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Delimiter.SetTo(paramsDotDelimiterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "delimiter",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: quote.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "quote",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQuoteVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQuoteVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Quote.SetTo(paramsDotQuoteVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "quote",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: header.
+	{
+		val := bool(true)
+		params.Header.SetTo(val)
+	}
+	// Decode query: header.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "header",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotHeaderVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotHeaderVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Header.SetTo(paramsDotHeaderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "header",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: null.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "null",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNullVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNullVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Null.SetTo(paramsDotNullVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "null",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: dateformat.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "dateformat",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDateformatVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDateformatVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Dateformat.SetTo(paramsDotDateformatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "dateformat",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: bom.
+	{
+		val := bool(false)
+		params.Bom.SetTo(val)
+	}
+	// Decode query: bom.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "bom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBomVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBomVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Bom.SetTo(paramsDotBomVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "bom",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRecordByKeyParams is parameters of getRecordByKey operation.
+type GetRecordByKeyParams struct {
+	// SQL table.
+	Table string
+	// Primary key value, values of composite keys are separated by comma in key column order, values
+	// containing a comma are quoted like 'a,b',2.
+	Key string
+}
+
+func unpackGetRecordByKeyParams(packed middleware.Parameters) (params GetRecordByKeyParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "key",
+			In:   "path",
+		}
+		params.Key = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetRecordByKeyParams(args [2]string, argsEscaped bool, r *http.Request) (params GetRecordByKeyParams, _ error) {
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: key.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "key",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Key = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "key",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRecordHistoryParams is parameters of getRecordHistory operation.
+type GetRecordHistoryParams struct {
+	// SQL table.
+	Table string
+	// Primary key value, values of composite keys are separated by comma in key column order, values
+	// containing a comma are quoted like 'a,b',2.
+	Key string
+}
+
+func unpackGetRecordHistoryParams(packed middleware.Parameters) (params GetRecordHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "key",
+			In:   "path",
+		}
+		params.Key = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetRecordHistoryParams(args [2]string, argsEscaped bool, r *http.Request) (params GetRecordHistoryParams, _ error) {
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: key.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "key",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Key = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "key",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUploadParams is parameters of getUpload operation.
+type GetUploadParams struct {
+	// Identifier of the upload.
+	UploadId string
+}
+
+func unpackGetUploadParams(packed middleware.Parameters) (params GetUploadParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "uploadId",
+			In:   "path",
+		}
+		params.UploadId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetUploadParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUploadParams, _ error) {
+	// Decode path: uploadId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "uploadId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UploadId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "uploadId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetVideoParams is parameters of getVideo operation.
+type GetVideoParams struct {
+	// SQL table.
+	Table string
+	// Specific table record number.
+	Search string
+	// Remote node reference.
+	Reference OptString `json:",omitempty,omitzero"`
+	// Specific the field to be streamed.
+	Field string
+	// Specific the field containing the mimetype.
+	MimetypeField string
+	// MIMEType the result should be of (preferred for some image formats only).
+	Mimetype OptString `json:",omitempty,omitzero"`
+	// Search criterium.
+	Sqlsearch OptString `json:",omitempty,omitzero"`
+	// Byte ranges of the large object to be read.
+	Range OptString `json:",omitempty,omitzero"`
+	// Read the ranges only if the ETag of the large object matches.
+	IfRange OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetVideoParams(packed middleware.Parameters) (params GetVideoParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "reference",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Reference = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "field",
+			In:   "path",
+		}
+		params.Field = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetypeField",
+			In:   "query",
+		}
+		params.MimetypeField = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetype",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Mimetype = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sqlsearch",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sqlsearch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Range",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.Range = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Range",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfRange = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (params GetVideoParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: search.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "search",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Search = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: reference.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "reference",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReferenceVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotReferenceVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Reference.SetTo(paramsDotReferenceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "reference",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode path: field.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Field = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "field",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: mimetypeField.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mimetypeField",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.MimetypeField = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mimetypeField",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: mimetype.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mimetype",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMimetypeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMimetypeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Mimetype.SetTo(paramsDotMimetypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mimetype",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sqlsearch.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSqlsearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSqlsearchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sqlsearch",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode header: Range.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Range",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRangeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRangeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Range.SetTo(paramsDotRangeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Range",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode header: If-Range.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Range",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfRangeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfRangeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfRange.SetTo(paramsDotIfRangeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Range",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// HeadMapRecordsFieldsParams is parameters of headMapRecordsFields operation.
+type HeadMapRecordsFieldsParams struct {
+	// SQL table.
+	Table string
+	// Search.
+	Search string
+	// Specific a comma separated list of fields to be part of the result record.
+	Fields string
+	// Count distinct values of the given fields.
+	Descriptor OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackHeadMapRecordsFieldsParams(packed middleware.Parameters) (params HeadMapRecordsFieldsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "fields",
+			In:   "path",
+		}
+		params.Fields = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "descriptor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Descriptor = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeHeadMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.Request) (params HeadMapRecordsFieldsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: search.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "search",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Search = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: fields.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "fields",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Fields = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "fields",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: descriptor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "descriptor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDescriptorVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDescriptorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Descriptor.SetTo(paramsDotDescriptorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "descriptor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ImportRecordsParams is parameters of importRecords operation.
+type ImportRecordsParams struct {
+	// SQL table.
+	Table string
+	// Number of records committed together, larger values than 10000 are reduced to 10000.
+	BatchSize OptInt `json:",omitempty,omitzero"`
+	// Abort the import on the first error or skip bad records.
+	OnError OptImportRecordsOnError `json:",omitempty,omitzero"`
+	// CSV field delimiter, default is comma.
+	Delimiter OptString `json:",omitempty,omitzero"`
+	// CSV header line with the field names.
+	Header OptBool `json:",omitempty,omitzero"`
+	// CSV representation of NULL values, default is empty.
+	Null OptString `json:",omitempty,omitzero"`
+	// CSV date format in Go time layout, default is 2006-01-02 15:04:05.
+	Dateformat OptString `json:",omitempty,omitzero"`
+}
+
+func unpackImportRecordsParams(packed middleware.Parameters) (params ImportRecordsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "batchSize",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BatchSize = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "onError",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OnError = v.(OptImportRecordsOnError)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "delimiter",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Delimiter = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "header",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Header = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "null",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Null = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "dateformat",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Dateformat = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeImportRecordsParams(args [1]string, argsEscaped bool, r *http.Request) (params ImportRecordsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: batchSize.
+	{
+		val := int(1000)
+		params.BatchSize.SetTo(val)
+	}
+	// Decode query: batchSize.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "batchSize",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBatchSizeVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBatchSizeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BatchSize.SetTo(paramsDotBatchSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "batchSize",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: onError.
+	{
+		val := ImportRecordsOnError("abort")
+		params.OnError.SetTo(val)
+	}
+	// Decode query: onError.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "onError",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOnErrorVal ImportRecordsOnError
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOnErrorVal = ImportRecordsOnError(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OnError.SetTo(paramsDotOnErrorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.OnError.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "onError",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: delimiter.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "delimiter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDelimiterVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDelimiterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Delimiter.SetTo(paramsDotDelimiterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "delimiter",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: header.
+	{
+		val := bool(true)
+		params.Header.SetTo(val)
+	}
+	// Decode query: header.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "header",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotHeaderVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotHeaderVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Header.SetTo(paramsDotHeaderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "header",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: null.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "null",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNullVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNullVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Null.SetTo(paramsDotNullVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "null",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: dateformat.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "dateformat",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDateformatVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDateformatVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Dateformat.SetTo(paramsDotDateformatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "dateformat",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// InsertRecordParams is parameters of insertRecord operation.
+type InsertRecordParams struct {
+	// SQL table.
+	Table string
+	// Return field result.
+	Returning OptString `json:",omitempty,omitzero"`
+	// Handling of records conflicting with existing records.
+	OnConflict OptInsertRecordOnConflict `json:",omitempty,omitzero"`
+	// Comma separated conflict fields, default is the primary key. Not supported by MySQL, which checks
+	// all unique keys.
+	ConflictFields OptString `json:",omitempty,omitzero"`
+	// Comma separated fields updated with update-listed-fields.
+	UpdateFields OptString `json:",omitempty,omitzero"`
+}
+
+func unpackInsertRecordParams(packed middleware.Parameters) (params InsertRecordParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "returning",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Returning = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "onConflict",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OnConflict = v.(OptInsertRecordOnConflict)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "conflictFields",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ConflictFields = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "updateFields",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UpdateFields = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeInsertRecordParams(args [1]string, argsEscaped bool, r *http.Request) (params InsertRecordParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Table = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: returning.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "returning",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReturningVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotReturningVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Returning.SetTo(paramsDotReturningVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "returning",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: onConflict.
+	{
+		val := InsertRecordOnConflict("error")
+		params.OnConflict.SetTo(val)
+	}
+	// Decode query: onConflict.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "onConflict",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOnConflictVal InsertRecordOnConflict
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOnConflictVal = InsertRecordOnConflict(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OnConflict.SetTo(paramsDotOnConflictVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.OnConflict.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "onConflict",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: conflictFields.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "conflictFields",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotConflictFieldsVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotConflictFieldsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ConflictFields.SetTo(paramsDotConflictFieldsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "conflictFields",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: updateFields.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "updateFields",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUpdateFieldsVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUpdateFieldsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UpdateFields.SetTo(paramsDotUpdateFieldsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "updateFields",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PatchRecordByKeyParams is parameters of patchRecordByKey operation.
+type PatchRecordByKeyParams struct {
+	// Update only if the record version matches the ETag.
+	IfMatch OptString `json:",omitempty,omitzero"`
+	// SQL table.
+	Table string
+	// Primary key value, values of composite keys are separated by comma in key column order, values
+	// containing a comma are quoted like 'a,b',2.
+	Key string
+}
+
+func unpackPatchRecordByKeyParams(packed middleware.Parameters) (params PatchRecordByKeyParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "key",
+			In:   "path",
+		}
+		params.Key = packed[key].(string)
+	}
+	return params
+}
+
+func decodePatchRecordByKeyParams(args [2]string, argsEscaped bool, r *http.Request) (params PatchRecordByKeyParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
 			param = unescaped
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "field",
+				Param:   "table",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3304,7 +10111,7 @@ func decodeGetLobByMapParams(args [3]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.Field = c
+				params.Table = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3315,7 +10122,52 @@ func decodeGetLobByMapParams(args [3]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "field",
+			Name: "table",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: key.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "key",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Key = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "key",
 			In:   "path",
 			Err:  err,
 		}
@@ -3323,25 +10175,90 @@ func decodeGetLobByMapParams(args [3]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetMapMetadataParams is parameters of getMapMetadata operation.
-type GetMapMetadataParams struct {
-	// SQL table.
-	Table string
+// PurgeImageCacheParams is parameters of purgeImageCache operation.
+type PurgeImageCacheParams struct {
+	// Purge only the images of the table.
+	Table OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetMapMetadataParams(packed middleware.Parameters) (params GetMapMetadataParams) {
+func unpackPurgeImageCacheParams(packed middleware.Parameters) (params PurgeImageCacheParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "table",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Table = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodePurgeImageCacheParams(args [0]string, argsEscaped bool, r *http.Request) (params PurgeImageCacheParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: table.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "table",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTableVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTableVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Table.SetTo(paramsDotTableVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "table",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchModellingParams is parameters of searchModelling operation.
+type SearchModellingParams struct {
+	// Modelling map and paramters.
+	Path string
+}
+
+func unpackSearchModellingParams(packed middleware.Parameters) (params SearchModellingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
 			In:   "path",
 		}
-		params.Table = packed[key].(string)
+		params.Path = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetMapMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMapMetadataParams, _ error) {
-	// Decode path: table.
+func decodeSearchModellingParams(args [1]string, argsEscaped bool, r *http.Request) (params SearchModellingParams, _ error) {
+	// Decode path: path.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3353,7 +10270,7 @@ func decodeGetMapMetadataParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
+				Param:   "path",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3370,7 +10287,7 @@ func decodeGetMapMetadataParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.Table = c
+				params.Path = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3381,7 +10298,7 @@ func decodeGetMapMetadataParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
+			Name: "path",
 			In:   "path",
 			Err:  err,
 		}
@@ -3389,14 +10306,8 @@ func decodeGetMapMetadataParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetMapRecordsFieldsParams is parameters of getMapRecordsFields operation.
-type GetMapRecordsFieldsParams struct {
-	// SQL table.
-	Table string
-	// Search.
-	Search string
-	// Specific a comma separated list of fields to be part of the result record.
-	Fields string
+// SearchRecordsFieldsParams is parameters of searchRecordsFields operation.
+type SearchRecordsFieldsParams struct {
 	// Start offset where the read will start from.
 	Start OptFloat64 `json:",omitempty,omitzero"`
 	// Maximal number of records retrieved.
@@ -3411,332 +10322,673 @@ type GetMapRecordsFieldsParams struct {
 	Flatten OptBool `json:",omitempty,omitzero"`
 	// Read a descriptor read with the given field entry.
 	Descriptor OptBool `json:",omitempty,omitzero"`
+	// Number of records skipped before the first record is returned.
+	Offset OptInt `json:",omitempty,omitzero"`
+	// Opaque keyset cursor of a previous page (Next or Prev) continuing the read based on the orderby
+	// fields.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// Return the total number of matching records in NrRecords instead of the page size.
+	Count OptBool `json:",omitempty,omitzero"`
 	// Order by criterias.
 	Orderby OptString `json:",omitempty,omitzero"`
 	// Use XML notation namespace.
 	Xmlnotation OptBool `json:",omitempty,omitzero"`
+	// CSV field delimiter, default is comma.
+	Delimiter OptString `json:",omitempty,omitzero"`
+	// CSV quote character, default is double quote.
+	Quote OptString `json:",omitempty,omitzero"`
+	// CSV header line with the field names.
+	Header OptBool `json:",omitempty,omitzero"`
+	// CSV representation of NULL values, default is empty.
+	Null OptString `json:",omitempty,omitzero"`
+	// CSV date format in Go time layout, default is 2006-01-02 15:04:05.
+	Dateformat OptString `json:",omitempty,omitzero"`
+	// Write UTF-8 byte order mark at start of CSV output.
+	Bom OptBool `json:",omitempty,omitzero"`
+	// SQL table.
+	Table string
+	// Specific SQL query string.
+	Search string
 }
 
-func unpackGetMapRecordsFieldsParams(packed middleware.Parameters) (params GetMapRecordsFieldsParams) {
+func unpackSearchRecordsFieldsParams(packed middleware.Parameters) (params SearchRecordsFieldsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "table",
-			In:   "path",
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptFloat64)
 		}
-		params.Table = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sorted_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SortedBy = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sqlsearch",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sqlsearch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "compact",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Compact = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "flatten",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Flatten = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "descriptor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Descriptor = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "count",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Count = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "orderby",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Orderby = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "xmlnotation",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Xmlnotation = v.(OptBool)
 		}
-		params.Search = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "fields",
-			In:   "path",
+			Name: "delimiter",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Delimiter = v.(OptString)
 		}
-		params.Fields = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "start",
+			Name: "quote",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Start = v.(OptFloat64)
+			params.Quote = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "header",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptString)
+			params.Header = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sorted_by",
+			Name: "null",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.SortedBy = v.(OptString)
+			params.Null = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sqlsearch",
+			Name: "dateformat",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Sqlsearch = v.(OptString)
+			params.Dateformat = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "compact",
+			Name: "bom",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Compact = v.(OptBool)
+			params.Bom = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "flatten",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Flatten = v.(OptBool)
+			Name: "table",
+			In:   "path",
 		}
+		params.Table = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "descriptor",
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSearchRecordsFieldsParams(args [2]string, argsEscaped bool, r *http.Request) (params SearchRecordsFieldsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: start.
+	{
+		val := float64(0)
+		params.Start.SetTo(val)
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Start.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
 			In:   "query",
+			Err:  err,
 		}
-		if v, ok := packed[key]; ok {
-			params.Descriptor = v.(OptBool)
+	}
+	// Set default value for query: limit.
+	{
+		val := string("15")
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sorted_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sorted_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortedByVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortedByVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortedBy.SetTo(paramsDotSortedByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sorted_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sqlsearch.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sqlsearch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSqlsearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSqlsearchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sqlsearch",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: compact.
+	{
+		val := bool(false)
+		params.Compact.SetTo(val)
+	}
+	// Decode query: compact.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "compact",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCompactVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCompactVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Compact.SetTo(paramsDotCompactVal)
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "orderby",
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "compact",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Orderby = v.(OptString)
+			Err:  err,
 		}
 	}
+	// Set default value for query: flatten.
 	{
-		key := middleware.ParameterKey{
-			Name: "xmlnotation",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Xmlnotation = v.(OptBool)
-		}
+		val := bool(false)
+		params.Flatten.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.Request) (params GetMapRecordsFieldsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: table.
+	// Decode query: flatten.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "flatten",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFlattenVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFlattenVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Table = c
+				params.Flatten.SetTo(paramsDotFlattenVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
-			In:   "path",
+			Name: "flatten",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: search.
+	// Decode query: descriptor.
 	if err := func() error {
-		param := args[2]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "descriptor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "search",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDescriptorVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDescriptorVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Search = c
+				params.Descriptor.SetTo(paramsDotDescriptorVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
-			In:   "path",
+			Name: "descriptor",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: fields.
+	// Decode query: offset.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "fields",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Fields = c
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "fields",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: start.
-	{
-		val := float64(0)
-		params.Start.SetTo(val)
-	}
-	// Decode query: start.
+	// Decode query: cursor.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start",
+			Name:    "cursor",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartVal float64
+				var paramsDotCursorVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToFloat64(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotStartVal = c
+					paramsDotCursorVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Start.SetTo(paramsDotStartVal)
+				params.Cursor.SetTo(paramsDotCursorVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Start.Get(); ok {
-					if err := func() error {
-						if err := (validate.Float{}).Validate(float64(value)); err != nil {
-							return errors.Wrap(err, "float")
-						}
-						return nil
-					}(); err != nil {
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: count.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
 						return err
 					}
+
+					paramsDotCountVal = c
+					return nil
+				}(); err != nil {
+					return err
 				}
+				params.Count.SetTo(paramsDotCountVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start",
+			Name: "count",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := string("100")
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode query: orderby.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "orderby",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal string
+				var paramsDotOrderbyVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -3748,12 +11000,12 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotOrderbyVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.Orderby.SetTo(paramsDotOrderbyVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3762,39 +11014,39 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "orderby",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: sorted_by.
+	// Decode query: xmlnotation.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sorted_by",
+			Name:    "xmlnotation",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortedByVal string
+				var paramsDotXmlnotationVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSortedByVal = c
+					paramsDotXmlnotationVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.SortedBy.SetTo(paramsDotSortedByVal)
+				params.Xmlnotation.SetTo(paramsDotXmlnotationVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3803,22 +11055,22 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sorted_by",
+			Name: "xmlnotation",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: sqlsearch.
+	// Decode query: delimiter.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sqlsearch",
+			Name:    "delimiter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSqlsearchVal string
+				var paramsDotDelimiterVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -3830,12 +11082,12 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotSqlsearchVal = c
+					paramsDotDelimiterVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				params.Delimiter.SetTo(paramsDotDelimiterVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3844,44 +11096,39 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sqlsearch",
+			Name: "delimiter",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: compact.
-	{
-		val := bool(false)
-		params.Compact.SetTo(val)
-	}
-	// Decode query: compact.
+	// Decode query: quote.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "compact",
+			Name:    "quote",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCompactVal bool
+				var paramsDotQuoteVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotCompactVal = c
+					paramsDotQuoteVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Compact.SetTo(paramsDotCompactVal)
+				params.Quote.SetTo(paramsDotQuoteVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3890,27 +11137,27 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "compact",
+			Name: "quote",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: flatten.
+	// Set default value for query: header.
 	{
-		val := bool(false)
-		params.Flatten.SetTo(val)
+		val := bool(true)
+		params.Header.SetTo(val)
 	}
-	// Decode query: flatten.
+	// Decode query: header.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "flatten",
+			Name:    "header",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFlattenVal bool
+				var paramsDotHeaderVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -3922,12 +11169,12 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotFlattenVal = c
+					paramsDotHeaderVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Flatten.SetTo(paramsDotFlattenVal)
+				params.Header.SetTo(paramsDotHeaderVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3936,39 +11183,39 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "flatten",
+			Name: "header",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: descriptor.
+	// Decode query: null.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "descriptor",
+			Name:    "null",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDescriptorVal bool
+				var paramsDotNullVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotDescriptorVal = c
+					paramsDotNullVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Descriptor.SetTo(paramsDotDescriptorVal)
+				params.Null.SetTo(paramsDotNullVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3977,22 +11224,22 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "descriptor",
+			Name: "null",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: orderby.
+	// Decode query: dateformat.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "orderby",
+			Name:    "dateformat",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderbyVal string
+				var paramsDotDateformatVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4004,12 +11251,12 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotOrderbyVal = c
+					paramsDotDateformatVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Orderby.SetTo(paramsDotOrderbyVal)
+				params.Dateformat.SetTo(paramsDotDateformatVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4018,22 +11265,27 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "orderby",
+			Name: "dateformat",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: xmlnotation.
+	// Set default value for query: bom.
+	{
+		val := bool(false)
+		params.Bom.SetTo(val)
+	}
+	// Decode query: bom.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "xmlnotation",
+			Name:    "bom",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotXmlnotationVal bool
+				var paramsDotBomVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4045,12 +11297,12 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotXmlnotationVal = c
+					paramsDotBomVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Xmlnotation.SetTo(paramsDotXmlnotationVal)
+				params.Bom.SetTo(paramsDotBomVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4059,93 +11311,11 @@ func decodeGetMapRecordsFieldsParams(args [3]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "xmlnotation",
+			Name: "bom",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetVideoParams is parameters of getVideo operation.
-type GetVideoParams struct {
-	// SQL table.
-	Table string
-	// Specific table record number.
-	Search string
-	// Remote node reference.
-	Reference OptString `json:",omitempty,omitzero"`
-	// Specific the field to be streamed.
-	Field string
-	// Specific the field containing the mimetype.
-	MimetypeField string
-	// MIMEType the result should be of (preferred for some image formats only).
-	Mimetype OptString `json:",omitempty,omitzero"`
-	// Search criterium.
-	Sqlsearch OptString `json:",omitempty,omitzero"`
-}
-
-func unpackGetVideoParams(packed middleware.Parameters) (params GetVideoParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "table",
-			In:   "path",
-		}
-		params.Table = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "path",
-		}
-		params.Search = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "reference",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Reference = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "field",
-			In:   "path",
-		}
-		params.Field = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "mimetypeField",
-			In:   "query",
-		}
-		params.MimetypeField = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "mimetype",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Mimetype = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "sqlsearch",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Sqlsearch = v.(OptString)
-		}
-	}
-	return params
-}
-
-func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (params GetVideoParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: table.
 	if err := func() error {
 		param := args[0]
@@ -4193,9 +11363,9 @@ func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 	}
 	// Decode path: search.
 	if err := func() error {
-		param := args[2]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -4236,48 +11406,103 @@ func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 			Err:  err,
 		}
 	}
-	// Decode query: reference.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "reference",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// SearchTableParams is parameters of searchTable operation.
+type SearchTableParams struct {
+	// SQL table.
+	Table string
+	// Fields to search for.
+	Fields string
+	// Search criterium.
+	Search string
+	// Order by criterias.
+	Orderby OptString `json:",omitempty,omitzero"`
+}
+
+func unpackSearchTableParams(packed middleware.Parameters) (params SearchTableParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "fields",
+			In:   "path",
+		}
+		params.Fields = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
 		}
+		params.Search = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "orderby",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Orderby = v.(OptString)
+		}
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotReferenceVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeSearchTableParams(args [3]string, argsEscaped bool, r *http.Request) (params SearchTableParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: table.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotReferenceVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Reference.SetTo(paramsDotReferenceVal)
+
+				params.Table = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "reference",
-			In:   "query",
+			Name: "table",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: field.
+	// Decode path: fields.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -4289,7 +11514,7 @@ func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "field",
+				Param:   "fields",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4306,7 +11531,7 @@ func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.Field = c
+				params.Fields = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4317,21 +11542,30 @@ func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "field",
+			Name: "fields",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: mimetypeField.
+	// Decode path: search.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mimetypeField",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "search",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -4342,74 +11576,33 @@ func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.MimetypeField = c
+				params.Search = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "mimetypeField",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: mimetype.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "mimetype",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotMimetypeVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotMimetypeVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Mimetype.SetTo(paramsDotMimetypeVal)
-				return nil
-			}); err != nil {
-				return err
-			}
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "mimetype",
-			In:   "query",
+			Name: "search",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: sqlsearch.
+	// Decode query: orderby.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sqlsearch",
+			Name:    "orderby",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSqlsearchVal string
+				var paramsDotOrderbyVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4421,12 +11614,12 @@ func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 						return err
 					}
 
-					paramsDotSqlsearchVal = c
+					paramsDotOrderbyVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				params.Orderby.SetTo(paramsDotOrderbyVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4435,7 +11628,7 @@ func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sqlsearch",
+			Name: "orderby",
 			In:   "query",
 			Err:  err,
 		}
@@ -4443,37 +11636,25 @@ func decodeGetVideoParams(args [3]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// InsertRecordParams is parameters of insertRecord operation.
-type InsertRecordParams struct {
-	// SQL table.
-	Table string
-	// Return field result.
-	Returning OptString `json:",omitempty,omitzero"`
+// ShutdownServerParams is parameters of shutdownServer operation.
+type ShutdownServerParams struct {
+	// Shutdown validation hash.
+	Hash string
 }
 
-func unpackInsertRecordParams(packed middleware.Parameters) (params InsertRecordParams) {
+func unpackShutdownServerParams(packed middleware.Parameters) (params ShutdownServerParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "table",
+			Name: "hash",
 			In:   "path",
 		}
-		params.Table = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "returning",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Returning = v.(OptString)
-		}
+		params.Hash = packed[key].(string)
 	}
 	return params
 }
 
-func decodeInsertRecordParams(args [1]string, argsEscaped bool, r *http.Request) (params InsertRecordParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: table.
+func decodeShutdownServerParams(args [1]string, argsEscaped bool, r *http.Request) (params ShutdownServerParams, _ error) {
+	// Decode path: hash.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4485,7 +11666,7 @@ func decodeInsertRecordParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
+				Param:   "hash",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4502,7 +11683,7 @@ func decodeInsertRecordParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.Table = c
+				params.Hash = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4513,74 +11694,99 @@ func decodeInsertRecordParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
+			Name: "hash",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: returning.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "returning",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// TriggerExtendParams is parameters of triggerExtend operation.
+type TriggerExtendParams struct {
+	// Identifier of the file location.
+	Path string
+}
+
+func unpackTriggerExtendParams(packed middleware.Parameters) (params TriggerExtendParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "path",
 		}
+		params.Path = packed[key].(string)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotReturningVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeTriggerExtendParams(args [1]string, argsEscaped bool, r *http.Request) (params TriggerExtendParams, _ error) {
+	// Decode path: path.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "path",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotReturningVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Returning.SetTo(paramsDotReturningVal)
+
+				params.Path = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "returning",
-			In:   "query",
+			Name: "path",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SearchModellingParams is parameters of searchModelling operation.
-type SearchModellingParams struct {
-	// Modelling map and paramters.
-	Path string
+// TriggerJobParams is parameters of triggerJob operation.
+type TriggerJobParams struct {
+	// Job Name to be requested.
+	JobName string
 }
 
-func unpackSearchModellingParams(packed middleware.Parameters) (params SearchModellingParams) {
+func unpackTriggerJobParams(packed middleware.Parameters) (params TriggerJobParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "path",
+			Name: "jobName",
 			In:   "path",
 		}
-		params.Path = packed[key].(string)
+		params.JobName = packed[key].(string)
 	}
 	return params
 }
 
-func decodeSearchModellingParams(args [1]string, argsEscaped bool, r *http.Request) (params SearchModellingParams, _ error) {
-	// Decode path: path.
+func decodeTriggerJobParams(args [1]string, argsEscaped bool, r *http.Request) (params TriggerJobParams, _ error) {
+	// Decode path: jobName.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4592,7 +11798,7 @@ func decodeSearchModellingParams(args [1]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "path",
+				Param:   "jobName",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4609,7 +11815,7 @@ func decodeSearchModellingParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.Path = c
+				params.JobName = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4620,300 +11826,232 @@ func decodeSearchModellingParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
+			Name: "jobName",
 			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// SearchRecordsFieldsParams is parameters of searchRecordsFields operation.
-type SearchRecordsFieldsParams struct {
-	// Start offset where the read will start from.
-	Start OptFloat64 `json:",omitempty,omitzero"`
-	// Maximal number of records retrieved.
-	Limit OptString `json:",omitempty,omitzero"`
-	// Sort criterium.
-	SortedBy OptString `json:",omitempty,omitzero"`
-	// Search criterium.
-	Sqlsearch OptString `json:",omitempty,omitzero"`
-	// Return result in compact structure.
-	Compact OptBool `json:",omitempty,omitzero"`
-	// Remove database group tree entries in result records.
-	Flatten OptBool `json:",omitempty,omitzero"`
-	// Read a descriptor read with the given field entry.
-	Descriptor OptBool `json:",omitempty,omitzero"`
-	// Order by criterias.
-	Orderby OptString `json:",omitempty,omitzero"`
-	// Use XML notation namespace.
-	Xmlnotation OptBool `json:",omitempty,omitzero"`
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UpdateImageHashesParams is parameters of updateImageHashes operation.
+type UpdateImageHashesParams struct {
 	// SQL table.
 	Table string
-	// Specific SQL query string.
-	Search string
+	// Specific the field containing the image.
+	Field string
+	// Field the hash is stored in.
+	HashField string
+	// Search of the records, all records if not given.
+	Search OptString `json:",omitempty,omitzero"`
+	// Specific the field containing the mimetype.
+	MimetypeField OptString `json:",omitempty,omitzero"`
+	// Perceptual hash algorithm, average (ahash), difference (dhash) or DCT based (phash).
+	Algorithm OptUpdateImageHashesAlgorithm `json:",omitempty,omitzero"`
+	// Calculate the hash of records already containing a hash.
+	Overwrite OptBool `json:",omitempty,omitzero"`
 }
 
-func unpackSearchRecordsFieldsParams(packed middleware.Parameters) (params SearchRecordsFieldsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "start",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Start = v.(OptFloat64)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptString)
-		}
-	}
+func unpackUpdateImageHashesParams(packed middleware.Parameters) (params UpdateImageHashesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "sorted_by",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.SortedBy = v.(OptString)
+			Name: "table",
+			In:   "path",
 		}
+		params.Table = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sqlsearch",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Sqlsearch = v.(OptString)
+			Name: "field",
+			In:   "path",
 		}
+		params.Field = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "compact",
+			Name: "hashField",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Compact = v.(OptBool)
-		}
+		params.HashField = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "flatten",
+			Name: "search",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Flatten = v.(OptBool)
+			params.Search = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "descriptor",
+			Name: "mimetypeField",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Descriptor = v.(OptBool)
+			params.MimetypeField = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "orderby",
+			Name: "algorithm",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Orderby = v.(OptString)
+			params.Algorithm = v.(OptUpdateImageHashesAlgorithm)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "xmlnotation",
+			Name: "overwrite",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Xmlnotation = v.(OptBool)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "table",
-			In:   "path",
-		}
-		params.Table = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "path",
+			params.Overwrite = v.(OptBool)
 		}
-		params.Search = packed[key].(string)
 	}
 	return params
 }
 
-func decodeSearchRecordsFieldsParams(args [2]string, argsEscaped bool, r *http.Request) (params SearchRecordsFieldsParams, _ error) {
+func decodeUpdateImageHashesParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateImageHashesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: start.
-	{
-		val := float64(0)
-		params.Start.SetTo(val)
-	}
-	// Decode query: start.
+	// Decode path: table.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "table",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartVal float64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToFloat64(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStartVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Start.SetTo(paramsDotStartVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Start.Get(); ok {
-					if err := func() error {
-						if err := (validate.Float{}).Validate(float64(value)); err != nil {
-							return errors.Wrap(err, "float")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.Table = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start",
-			In:   "query",
+			Name: "table",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := string("15")
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode path: field.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.Field = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "field",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: sorted_by.
+	// Decode query: hashField.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sorted_by",
+			Name:    "hashField",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortedByVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotSortedByVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.SortedBy.SetTo(paramsDotSortedByVal)
+
+				params.HashField = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sorted_by",
+			Name: "hashField",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: sqlsearch.
+	// Decode query: search.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sqlsearch",
+			Name:    "search",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSqlsearchVal string
+				var paramsDotSearchVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4925,12 +12063,12 @@ func decodeSearchRecordsFieldsParams(args [2]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotSqlsearchVal = c
+					paramsDotSearchVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Sqlsearch.SetTo(paramsDotSqlsearchVal)
+				params.Search.SetTo(paramsDotSearchVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4939,44 +12077,39 @@ func decodeSearchRecordsFieldsParams(args [2]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sqlsearch",
+			Name: "search",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: compact.
-	{
-		val := bool(false)
-		params.Compact.SetTo(val)
-	}
-	// Decode query: compact.
+	// Decode query: mimetypeField.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "compact",
+			Name:    "mimetypeField",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCompactVal bool
+				var paramsDotMimetypeFieldVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotCompactVal = c
+					paramsDotMimetypeFieldVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Compact.SetTo(paramsDotCompactVal)
+				params.MimetypeField.SetTo(paramsDotMimetypeFieldVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4985,68 +12118,88 @@ func decodeSearchRecordsFieldsParams(args [2]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "compact",
+			Name: "mimetypeField",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: flatten.
+	// Set default value for query: algorithm.
 	{
-		val := bool(false)
-		params.Flatten.SetTo(val)
+		val := UpdateImageHashesAlgorithm("phash")
+		params.Algorithm.SetTo(val)
 	}
-	// Decode query: flatten.
+	// Decode query: algorithm.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "flatten",
+			Name:    "algorithm",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFlattenVal bool
+				var paramsDotAlgorithmVal UpdateImageHashesAlgorithm
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotFlattenVal = c
+					paramsDotAlgorithmVal = UpdateImageHashesAlgorithm(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Flatten.SetTo(paramsDotFlattenVal)
+				params.Algorithm.SetTo(paramsDotAlgorithmVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Algorithm.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "flatten",
+			Name: "algorithm",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: descriptor.
+	// Set default value for query: overwrite.
+	{
+		val := bool(false)
+		params.Overwrite.SetTo(val)
+	}
+	// Decode query: overwrite.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "descriptor",
+			Name:    "overwrite",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDescriptorVal bool
+				var paramsDotOverwriteVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -5058,12 +12211,12 @@ func decodeSearchRecordsFieldsParams(args [2]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotDescriptorVal = c
+					paramsDotOverwriteVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Descriptor.SetTo(paramsDotDescriptorVal)
+				params.Overwrite.SetTo(paramsDotOverwriteVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5072,22 +12225,95 @@ func decodeSearchRecordsFieldsParams(args [2]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "descriptor",
+			Name: "overwrite",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: orderby.
+	return params, nil
+}
+
+// UpdateLobByMapParams is parameters of updateLobByMap operation.
+type UpdateLobByMapParams struct {
+	// Specific the field containing the mimetype.
+	MimetypeField OptString `json:",omitempty,omitzero"`
+	// Mimetype stored in the mimetype field.
+	Mimetype OptString `json:",omitempty,omitzero"`
+	// Maximum size of the large object in bytes, can only lower the server limit.
+	MaxSize OptInt64 `json:",omitempty,omitzero"`
+	// SQL table.
+	Table string
+	// Specific table record.
+	Search string
+	// Specific the field to be.
+	Field string
+}
+
+func unpackUpdateLobByMapParams(packed middleware.Parameters) (params UpdateLobByMapParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetypeField",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MimetypeField = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mimetype",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Mimetype = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "maxSize",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MaxSize = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "field",
+			In:   "path",
+		}
+		params.Field = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUpdateLobByMapParams(args [3]string, argsEscaped bool, r *http.Request) (params UpdateLobByMapParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: mimetypeField.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "orderby",
+			Name:    "mimetypeField",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderbyVal string
+				var paramsDotMimetypeFieldVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -5099,12 +12325,12 @@ func decodeSearchRecordsFieldsParams(args [2]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotOrderbyVal = c
+					paramsDotMimetypeFieldVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Orderby.SetTo(paramsDotOrderbyVal)
+				params.MimetypeField.SetTo(paramsDotMimetypeFieldVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5113,39 +12339,39 @@ func decodeSearchRecordsFieldsParams(args [2]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "orderby",
+			Name: "mimetypeField",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: xmlnotation.
+	// Decode query: mimetype.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "xmlnotation",
+			Name:    "mimetype",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotXmlnotationVal bool
+				var paramsDotMimetypeVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotXmlnotationVal = c
+					paramsDotMimetypeVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Xmlnotation.SetTo(paramsDotXmlnotationVal)
+				params.Mimetype.SetTo(paramsDotMimetypeVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5154,152 +12380,52 @@ func decodeSearchRecordsFieldsParams(args [2]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "xmlnotation",
+			Name: "mimetype",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: table.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.Table = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	// Decode path: search.
+	// Decode query: maxSize.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "search",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.Search = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// SearchTableParams is parameters of searchTable operation.
-type SearchTableParams struct {
-	// SQL table.
-	Table string
-	// Fields to search for.
-	Fields string
-	// Search criterium.
-	Search string
-	// Order by criterias.
-	Orderby OptString `json:",omitempty,omitzero"`
-}
-
-func unpackSearchTableParams(packed middleware.Parameters) (params SearchTableParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "table",
-			In:   "path",
-		}
-		params.Table = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "fields",
-			In:   "path",
-		}
-		params.Fields = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "path",
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "maxSize",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		params.Search = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "orderby",
-			In:   "query",
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMaxSizeVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMaxSizeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MaxSize.SetTo(paramsDotMaxSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
-		if v, ok := packed[key]; ok {
-			params.Orderby = v.(OptString)
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "maxSize",
+			In:   "query",
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeSearchTableParams(args [3]string, argsEscaped bool, r *http.Request) (params SearchTableParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: table.
 	if err := func() error {
 		param := args[0]
@@ -5345,11 +12471,11 @@ func decodeSearchTableParams(args [3]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	// Decode path: fields.
+	// Decode path: search.
 	if err := func() error {
-		param := args[1]
+		param := args[2]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[2])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -5357,7 +12483,7 @@ func decodeSearchTableParams(args [3]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "fields",
+				Param:   "search",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5374,7 +12500,7 @@ func decodeSearchTableParams(args [3]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.Fields = c
+				params.Search = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5385,16 +12511,16 @@ func decodeSearchTableParams(args [3]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "fields",
+			Name: "search",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: search.
+	// Decode path: field.
 	if err := func() error {
-		param := args[2]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -5402,7 +12528,7 @@ func decodeSearchTableParams(args [3]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "search",
+				Param:   "field",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5419,7 +12545,7 @@ func decodeSearchTableParams(args [3]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.Search = c
+				params.Field = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5430,39 +12556,120 @@ func decodeSearchTableParams(args [3]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
+			Name: "field",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: orderby.
+	return params, nil
+}
+
+// UpdateRecordsByFieldsParams is parameters of updateRecordsByFields operation.
+type UpdateRecordsByFieldsParams struct {
+	// Count the affected records without committing the operation.
+	DryRun OptBool `json:",omitempty,omitzero"`
+	// Number of affected records returned by a dry run.
+	Sample OptInt `json:",omitempty,omitzero"`
+	// Confirm token of a dry run to exceed the affected records limit of the table.
+	Confirm OptString `json:",omitempty,omitzero"`
+	// Update only if the record version matches the ETag.
+	IfMatch OptString `json:",omitempty,omitzero"`
+	// SQL table.
+	Table string
+	// Specific SQL query string.
+	Search string
+}
+
+func unpackUpdateRecordsByFieldsParams(packed middleware.Parameters) (params UpdateRecordsByFieldsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "dryRun",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.DryRun = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sample",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sample = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "confirm",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Confirm = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "table",
+			In:   "path",
+		}
+		params.Table = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "path",
+		}
+		params.Search = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUpdateRecordsByFieldsParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateRecordsByFieldsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Set default value for query: dryRun.
+	{
+		val := bool(false)
+		params.DryRun.SetTo(val)
+	}
+	// Decode query: dryRun.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "orderby",
+			Name:    "dryRun",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderbyVal string
+				var paramsDotDryRunVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOrderbyVal = c
+					paramsDotDryRunVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Orderby.SetTo(paramsDotOrderbyVal)
+				params.DryRun.SetTo(paramsDotDryRunVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5471,298 +12678,142 @@ func decodeSearchTableParams(args [3]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "orderby",
+			Name: "dryRun",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ShutdownServerParams is parameters of shutdownServer operation.
-type ShutdownServerParams struct {
-	// Shutdown validation hash.
-	Hash string
-}
-
-func unpackShutdownServerParams(packed middleware.Parameters) (params ShutdownServerParams) {
+	// Set default value for query: sample.
 	{
-		key := middleware.ParameterKey{
-			Name: "hash",
-			In:   "path",
-		}
-		params.Hash = packed[key].(string)
+		val := int(0)
+		params.Sample.SetTo(val)
 	}
-	return params
-}
-
-func decodeShutdownServerParams(args [1]string, argsEscaped bool, r *http.Request) (params ShutdownServerParams, _ error) {
-	// Decode path: hash.
+	// Decode query: sample.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "hash",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.Hash = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "hash",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// TriggerExtendParams is parameters of triggerExtend operation.
-type TriggerExtendParams struct {
-	// Identifier of the file location.
-	Path string
-}
-
-func unpackTriggerExtendParams(packed middleware.Parameters) (params TriggerExtendParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "path",
-			In:   "path",
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sample",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		params.Path = packed[key].(string)
-	}
-	return params
-}
 
-func decodeTriggerExtendParams(args [1]string, argsEscaped bool, r *http.Request) (params TriggerExtendParams, _ error) {
-	// Decode path: path.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "path",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSampleVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					paramsDotSampleVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Path = c
+				params.Sample.SetTo(paramsDotSampleVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
-			In:   "path",
+			Name: "sample",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// TriggerJobParams is parameters of triggerJob operation.
-type TriggerJobParams struct {
-	// Job Name to be requested.
-	JobName string
-}
-
-func unpackTriggerJobParams(packed middleware.Parameters) (params TriggerJobParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "jobName",
-			In:   "path",
-		}
-		params.JobName = packed[key].(string)
-	}
-	return params
-}
-
-func decodeTriggerJobParams(args [1]string, argsEscaped bool, r *http.Request) (params TriggerJobParams, _ error) {
-	// Decode path: jobName.
+	// Decode query: confirm.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "confirm",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "jobName",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotConfirmVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotConfirmVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.JobName = c
+				params.Confirm.SetTo(paramsDotConfirmVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "jobName",
-			In:   "path",
+			Name: "confirm",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// UpdateLobByMapParams is parameters of updateLobByMap operation.
-type UpdateLobByMapParams struct {
-	// SQL table.
-	Table string
-	// Specific table record.
-	Search string
-	// Specific the field to be.
-	Field string
-}
-
-func unpackUpdateLobByMapParams(packed middleware.Parameters) (params UpdateLobByMapParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "table",
-			In:   "path",
-		}
-		params.Table = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "path",
-		}
-		params.Search = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "field",
-			In:   "path",
-		}
-		params.Field = packed[key].(string)
-	}
-	return params
-}
-
-func decodeUpdateLobByMapParams(args [3]string, argsEscaped bool, r *http.Request) (params UpdateLobByMapParams, _ error) {
-	// Decode path: table.
+	// Decode header: If-Match.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Table = c
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
-			In:   "path",
+			Name: "If-Match",
+			In:   "header",
 			Err:  err,
 		}
 	}
-	// Decode path: search.
+	// Decode path: table.
 	if err := func() error {
-		param := args[2]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -5770,7 +12821,7 @@ func decodeUpdateLobByMapParams(args [3]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "search",
+				Param:   "table",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5787,7 +12838,7 @@ func decodeUpdateLobByMapParams(args [3]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.Search = c
+				params.Table = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5798,12 +12849,12 @@ func decodeUpdateLobByMapParams(args [3]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
+			Name: "table",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: field.
+	// Decode path: search.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -5815,7 +12866,7 @@ func decodeUpdateLobByMapParams(args [3]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "field",
+				Param:   "search",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5832,7 +12883,7 @@ func decodeUpdateLobByMapParams(args [3]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.Field = c
+				params.Search = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5843,7 +12894,7 @@ func decodeUpdateLobByMapParams(args [3]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "field",
+			Name: "search",
 			In:   "path",
 			Err:  err,
 		}
@@ -5851,34 +12902,47 @@ func decodeUpdateLobByMapParams(args [3]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// UpdateRecordsByFieldsParams is parameters of updateRecordsByFields operation.
-type UpdateRecordsByFieldsParams struct {
-	// SQL table.
-	Table string
-	// Specific SQL query string.
-	Search string
+// UploadChunkParams is parameters of uploadChunk operation.
+type UploadChunkParams struct {
+	// Identifier of the upload.
+	UploadId string
+	// Offset of the chunk, must be the number of bytes already received.
+	UploadOffset int64
+	// Checksum of the data as algorithm and base64 value, like 'sha256
+	// n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg='. Supported are sha256, sha1 and md5.
+	UploadChecksum OptString `json:",omitempty,omitzero"`
 }
 
-func unpackUpdateRecordsByFieldsParams(packed middleware.Parameters) (params UpdateRecordsByFieldsParams) {
+func unpackUploadChunkParams(packed middleware.Parameters) (params UploadChunkParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "table",
+			Name: "uploadId",
 			In:   "path",
 		}
-		params.Table = packed[key].(string)
+		params.UploadId = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "path",
+			Name: "Upload-Offset",
+			In:   "header",
+		}
+		params.UploadOffset = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Upload-Checksum",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.UploadChecksum = v.(OptString)
 		}
-		params.Search = packed[key].(string)
 	}
 	return params
 }
 
-func decodeUpdateRecordsByFieldsParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateRecordsByFieldsParams, _ error) {
-	// Decode path: table.
+func decodeUploadChunkParams(args [1]string, argsEscaped bool, r *http.Request) (params UploadChunkParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: uploadId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5890,7 +12954,7 @@ func decodeUpdateRecordsByFieldsParams(args [2]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "table",
+				Param:   "uploadId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5907,7 +12971,7 @@ func decodeUpdateRecordsByFieldsParams(args [2]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.Table = c
+				params.UploadId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5918,53 +12982,99 @@ func decodeUpdateRecordsByFieldsParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "table",
+			Name: "uploadId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: search.
+	// Decode header: Upload-Offset.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Upload-Offset",
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "search",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt64(val)
 				if err != nil {
 					return err
 				}
 
-				params.Search = c
+				params.UploadOffset = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           0,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+					Pattern:       nil,
+				}).Validate(int64(params.UploadOffset)); err != nil {
+					return errors.Wrap(err, "int")
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
-			In:   "path",
+			Name: "Upload-Offset",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode header: Upload-Checksum.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Upload-Checksum",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUploadChecksumVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUploadChecksumVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UploadChecksum.SetTo(paramsDotUploadChecksumVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Upload-Checksum",
+			In:   "header",
 			Err:  err,
 		}
 	}
@@ -64,6 +64,40 @@ func encodeCallPostExtendRequest(
 	return nil
 }
 
+func encodeExecuteTransactionRequest(
+	req *Transaction,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeImportRecordsRequest(
+	req ImportRecordsReq,
+	r *http.Request,
+) error {
+	switch req := req.(type) {
+	case *ImportRecordsReqApplicationXNdjson:
+		const contentType = "application/x-ndjson"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	case *ImportRecordsReqTextCsv:
+		const contentType = "text/csv"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	default:
+		return errors.Errorf("unexpected request type: %T", req)
+	}
+}
+
 func encodeInsertMapFileRecordsRequest(
 	req OptInsertMapFileRecordsReq,
 	r *http.Request,
@@ -111,6 +145,20 @@ func encodeInsertRecordRequest(
 	return nil
 }
 
+func encodePatchRecordByKeyRequest(
+	req PatchRecordByKeyReq,
+	r *http.Request,
+) error {
+	const contentType = "application/merge-patch+json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePostDatabaseRequest(
 	req *Database,
 	r *http.Request,
@@ -249,6 +297,16 @@ func encodeUpdateRecordsByFieldsRequest(
 	return nil
 }
 
+func encodeUploadChunkRequest(
+	req UploadChunkReq,
+	r *http.Request,
+) error {
+	const contentType = "application/offset+octet-stream"
+	body := req
+	ht.SetBody(r, body, contentType)
+	return nil
+}
+
 func encodeUploadFileRequest(
 	req *UploadFileReq,
 	r *http.Request,
@@ -109,7 +109,7 @@ func decodeAddViewResponse(resp *http.Response) (res AddViewRes, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeBatchParameterQueryResponse(resp *http.Response) (res BatchParameterQueryRes, _ error) {
+func decodeAggregateRecordsResponse(resp *http.Response) (res AggregateRecordsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -188,10 +188,10 @@ func decodeBatchParameterQueryResponse(resp *http.Response) (res BatchParameterQ
 		}
 	case 401:
 		// Code 401.
-		return &BatchParameterQueryUnauthorized{}, nil
+		return &AggregateRecordsUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &BatchParameterQueryForbidden{}, nil
+		return &AggregateRecordsForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -273,7 +273,7 @@ func decodeBatchParameterQueryResponse(resp *http.Response) (res BatchParameterQ
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeBatchQueryResponse(resp *http.Response) (res BatchQueryRes, _ error) {
+func decodeBatchParameterQueryResponse(resp *http.Response) (res BatchParameterQueryRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -347,130 +347,113 @@ func decodeBatchQueryResponse(resp *http.Response) (res BatchQueryRes, _ error)
 				}
 			}
 			return &wrapper, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 401:
-		// Code 401.
-		return &BatchQueryUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &BatchQueryForbidden{}, nil
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
+		case ct == "application/x-json-stream":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
-			d := jx.DecodeBytes(buf)
 
-			var response Error
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
+			response := BatchParameterQueryOKApplicationXJSONStream{Data: bytes.NewReader(b)}
+			var wrapper BatchParameterQueryOKApplicationXJSONStreamHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
 				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
 				}
-				return res, err
 			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
+			return &wrapper, nil
+		case ct == "application/x-ndjson":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
-			d := jx.DecodeBytes(buf)
 
-			var response Error
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
+			response := BatchParameterQueryOKApplicationXNdjson{Data: bytes.NewReader(b)}
+			var wrapper BatchParameterQueryOKApplicationXNdjsonHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
 				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
 				}
-				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeBatchSelectResponse(resp *http.Response) (res BatchSelectRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
+			return &wrapper, nil
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
-			d := jx.DecodeBytes(buf)
 
-			var response Response
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			var wrapper ResponseHeaders
+			response := BatchParameterQueryOKTextCsv{Data: bytes.NewReader(b)}
+			var wrapper BatchParameterQueryOKTextCsvHeaders
 			wrapper.Response = response
 			h := uri.NewHeaderDecoder(resp.Header)
 			// Parse "X-Token" header.
@@ -516,10 +499,10 @@ func decodeBatchSelectResponse(resp *http.Response) (res BatchSelectRes, _ error
 		}
 	case 401:
 		// Code 401.
-		return &BatchSelectUnauthorized{}, nil
+		return &BatchParameterQueryUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &BatchSelectForbidden{}, nil
+		return &BatchParameterQueryForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -601,7 +584,7 @@ func decodeBatchSelectResponse(resp *http.Response) (res BatchSelectRes, _ error
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeBrowseListResponse(resp *http.Response) (res BrowseListRes, _ error) {
+func decodeBatchQueryResponse(resp *http.Response) (res BatchQueryRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -617,7 +600,7 @@ func decodeBrowseListResponse(resp *http.Response) (res BrowseListRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Directories
+			var response Response
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -634,51 +617,203 @@ func decodeBrowseListResponse(resp *http.Response) (res BrowseListRes, _ error)
 				}
 				return res, err
 			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 400:
-		// Code 400.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
+			var wrapper ResponseHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "application/x-json-stream":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
-			d := jx.DecodeBytes(buf)
 
-			var response BrowseListBadRequest
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
+			response := BatchQueryOKApplicationXJSONStream{Data: bytes.NewReader(b)}
+			var wrapper BatchQueryOKApplicationXJSONStreamHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
 				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
 				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
+			}
+			return &wrapper, nil
+		case ct == "application/x-ndjson":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := BatchQueryOKApplicationXNdjson{Data: bytes.NewReader(b)}
+			var wrapper BatchQueryOKApplicationXNdjsonHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
 				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
 				return res, err
 			}
-			return &response, nil
+
+			response := BatchQueryOKTextCsv{Data: bytes.NewReader(b)}
+			var wrapper BatchQueryOKTextCsvHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
 	case 401:
 		// Code 401.
-		return &BrowseListUnauthorized{}, nil
+		return &BatchQueryUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &BrowseListForbidden{}, nil
+		return &BatchQueryForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -693,7 +828,7 @@ func decodeBrowseListResponse(resp *http.Response) (res BrowseListRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response BrowseListNotFound
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -760,7 +895,7 @@ func decodeBrowseListResponse(resp *http.Response) (res BrowseListRes, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeBrowseLocationResponse(resp *http.Response) (res BrowseLocationRes, _ error) {
+func decodeBatchSelectResponse(resp *http.Response) (res BatchSelectRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -776,7 +911,7 @@ func decodeBrowseLocationResponse(resp *http.Response) (res BrowseLocationRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response BrowseLocationOK
+			var response Response
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -793,79 +928,222 @@ func decodeBrowseLocationResponse(resp *http.Response) (res BrowseLocationRes, _
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+			var wrapper ResponseHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
 				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 400:
-		// Code 400.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
 
-			var response BrowseLocationBadRequest
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
 				}
-				return res, err
 			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 401:
-		// Code 401.
-		return &BrowseLocationUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &BrowseLocationForbidden{}, nil
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
+			return &wrapper, nil
+		case ct == "application/x-json-stream":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
-			d := jx.DecodeBytes(buf)
 
-			var response BrowseLocationNotFound
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
+			response := BatchSelectOKApplicationXJSONStream{Data: bytes.NewReader(b)}
+			var wrapper BatchSelectOKApplicationXJSONStreamHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "application/x-ndjson":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := BatchSelectOKApplicationXNdjson{Data: bytes.NewReader(b)}
+			var wrapper BatchSelectOKApplicationXNdjsonHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := BatchSelectOKTextCsv{Data: bytes.NewReader(b)}
+			var wrapper BatchSelectOKTextCsvHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &BatchSelectUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &BatchSelectForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
 				if err := d.Skip(); err != io.EOF {
 					return errors.New("unexpected trailing data")
 				}
@@ -928,7 +1206,7 @@ func decodeBrowseLocationResponse(resp *http.Response) (res BrowseLocationRes, _
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeCallExtendResponse(resp *http.Response) (res CallExtendRes, _ error) {
+func decodeBrowseListResponse(resp *http.Response) (res BrowseListRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -944,7 +1222,7 @@ func decodeCallExtendResponse(resp *http.Response) (res CallExtendRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ResponseRaw
+			var response Directories
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -962,15 +1240,6 @@ func decodeCallExtendResponse(resp *http.Response) (res CallExtendRes, _ error)
 				return res, err
 			}
 			return &response, nil
-		case ct == "application/octet-stream":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := CallExtendOKApplicationOctetStream{Data: bytes.NewReader(b)}
-			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -988,7 +1257,7 @@ func decodeCallExtendResponse(resp *http.Response) (res CallExtendRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CallExtendBadRequest
+			var response BrowseListBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1011,10 +1280,10 @@ func decodeCallExtendResponse(resp *http.Response) (res CallExtendRes, _ error)
 		}
 	case 401:
 		// Code 401.
-		return &CallExtendUnauthorized{}, nil
+		return &BrowseListUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &CallExtendForbidden{}, nil
+		return &BrowseListForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -1029,7 +1298,7 @@ func decodeCallExtendResponse(resp *http.Response) (res CallExtendRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CallExtendNotFound
+			var response BrowseListNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1096,7 +1365,7 @@ func decodeCallExtendResponse(resp *http.Response) (res CallExtendRes, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeCallPostExtendResponse(resp *http.Response) (res CallPostExtendRes, _ error) {
+func decodeBrowseLocationResponse(resp *http.Response) (res BrowseLocationRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1112,7 +1381,7 @@ func decodeCallPostExtendResponse(resp *http.Response) (res CallPostExtendRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ResponseRaw
+			var response BrowseLocationOK
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1129,15 +1398,15 @@ func decodeCallPostExtendResponse(resp *http.Response) (res CallPostExtendRes, _
 				}
 				return res, err
 			}
-			return &response, nil
-		case ct == "application/octet-stream":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
 			}
-
-			response := CallPostExtendOKApplicationOctetStream{Data: bytes.NewReader(b)}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -1156,7 +1425,7 @@ func decodeCallPostExtendResponse(resp *http.Response) (res CallPostExtendRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CallPostExtendBadRequest
+			var response BrowseLocationBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1179,10 +1448,10 @@ func decodeCallPostExtendResponse(resp *http.Response) (res CallPostExtendRes, _
 		}
 	case 401:
 		// Code 401.
-		return &CallPostExtendUnauthorized{}, nil
+		return &BrowseLocationUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &CallPostExtendForbidden{}, nil
+		return &BrowseLocationForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -1197,7 +1466,7 @@ func decodeCallPostExtendResponse(resp *http.Response) (res CallPostExtendRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CallPostExtendNotFound
+			var response BrowseLocationNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1264,7 +1533,7 @@ func decodeCallPostExtendResponse(resp *http.Response) (res CallPostExtendRes, _
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeCreateDirectoryResponse(resp *http.Response) (res CreateDirectoryRes, _ error) {
+func decodeCallExtendResponse(resp *http.Response) (res CallExtendRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1280,7 +1549,7 @@ func decodeCreateDirectoryResponse(resp *http.Response) (res CreateDirectoryRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StatusResponse
+			var response ResponseRaw
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1297,15 +1566,15 @@ func decodeCreateDirectoryResponse(resp *http.Response) (res CreateDirectoryRes,
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+			return &response, nil
+		case ct == "application/octet-stream":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
 			}
+
+			response := CallExtendOKApplicationOctetStream{Data: bytes.NewReader(b)}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -1324,7 +1593,7 @@ func decodeCreateDirectoryResponse(resp *http.Response) (res CreateDirectoryRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreateDirectoryBadRequest
+			var response CallExtendBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1347,10 +1616,10 @@ func decodeCreateDirectoryResponse(resp *http.Response) (res CreateDirectoryRes,
 		}
 	case 401:
 		// Code 401.
-		return &CreateDirectoryUnauthorized{}, nil
+		return &CallExtendUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &CreateDirectoryForbidden{}, nil
+		return &CallExtendForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -1365,7 +1634,7 @@ func decodeCreateDirectoryResponse(resp *http.Response) (res CreateDirectoryRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreateDirectoryNotFound
+			var response CallExtendNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1432,7 +1701,7 @@ func decodeCreateDirectoryResponse(resp *http.Response) (res CreateDirectoryRes,
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDeleteExtendResponse(resp *http.Response) (res DeleteExtendRes, _ error) {
+func decodeCallPostExtendResponse(resp *http.Response) (res CallPostExtendRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1473,7 +1742,7 @@ func decodeDeleteExtendResponse(resp *http.Response) (res DeleteExtendRes, _ err
 				return res, err
 			}
 
-			response := DeleteExtendOKApplicationOctetStream{Data: bytes.NewReader(b)}
+			response := CallPostExtendOKApplicationOctetStream{Data: bytes.NewReader(b)}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -1492,7 +1761,7 @@ func decodeDeleteExtendResponse(resp *http.Response) (res DeleteExtendRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteExtendBadRequest
+			var response CallPostExtendBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1515,10 +1784,10 @@ func decodeDeleteExtendResponse(resp *http.Response) (res DeleteExtendRes, _ err
 		}
 	case 401:
 		// Code 401.
-		return &DeleteExtendUnauthorized{}, nil
+		return &CallPostExtendUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &DeleteExtendForbidden{}, nil
+		return &CallPostExtendForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -1533,7 +1802,7 @@ func decodeDeleteExtendResponse(resp *http.Response) (res DeleteExtendRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteExtendNotFound
+			var response CallPostExtendNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1600,7 +1869,7 @@ func decodeDeleteExtendResponse(resp *http.Response) (res DeleteExtendRes, _ err
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDeleteFileLocationResponse(resp *http.Response) (res DeleteFileLocationRes, _ error) {
+func decodeCancelUploadResponse(resp *http.Response) (res CancelUploadRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1646,47 +1915,12 @@ func decodeDeleteFileLocationResponse(resp *http.Response) (res DeleteFileLocati
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response DeleteFileLocationBadRequest
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
 	case 401:
 		// Code 401.
-		return &DeleteFileLocationUnauthorized{}, nil
+		return &CancelUploadUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &DeleteFileLocationForbidden{}, nil
+		return &CancelUploadForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -1701,7 +1935,7 @@ func decodeDeleteFileLocationResponse(resp *http.Response) (res DeleteFileLocati
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteFileLocationNotFound
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1768,7 +2002,7 @@ func decodeDeleteFileLocationResponse(resp *http.Response) (res DeleteFileLocati
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDeleteJobResultResponse(resp *http.Response) (res DeleteJobResultRes, _ error) {
+func decodeCreateDirectoryResponse(resp *http.Response) (res CreateDirectoryRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1784,7 +2018,7 @@ func decodeDeleteJobResultResponse(resp *http.Response) (res DeleteJobResultRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response JobStatusResponse
+			var response StatusResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1801,6 +2035,15 @@ func decodeDeleteJobResultResponse(resp *http.Response) (res DeleteJobResultRes,
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -1819,7 +2062,7 @@ func decodeDeleteJobResultResponse(resp *http.Response) (res DeleteJobResultRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteJobResultBadRequest
+			var response CreateDirectoryBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1842,10 +2085,10 @@ func decodeDeleteJobResultResponse(resp *http.Response) (res DeleteJobResultRes,
 		}
 	case 401:
 		// Code 401.
-		return &DeleteJobResultUnauthorized{}, nil
+		return &CreateDirectoryUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &DeleteJobResultForbidden{}, nil
+		return &CreateDirectoryForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -1860,7 +2103,7 @@ func decodeDeleteJobResultResponse(resp *http.Response) (res DeleteJobResultRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteJobResultNotFound
+			var response CreateDirectoryNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1927,10 +2170,10 @@ func decodeDeleteJobResultResponse(resp *http.Response) (res DeleteJobResultRes,
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDeleteRecordsSearchedResponse(resp *http.Response) (res DeleteRecordsSearchedRes, _ error) {
+func decodeCreateUploadResponse(resp *http.Response) (res CreateUploadRes, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -1943,7 +2186,7 @@ func decodeDeleteRecordsSearchedResponse(resp *http.Response) (res DeleteRecords
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Response
+			var response UploadStatus
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1960,19 +2203,19 @@ func decodeDeleteRecordsSearchedResponse(resp *http.Response) (res DeleteRecords
 				}
 				return res, err
 			}
-			var wrapper ResponseHeaders
+			var wrapper UploadStatusHeaders
 			wrapper.Response = response
 			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
+			// Parse "Location" header.
 			{
 				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
+					Name:    "Location",
 					Explode: false,
 				}
 				if err := func() error {
 					if err := h.HasParam(cfg); err == nil {
 						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
+							var wrapperDotLocationVal string
 							if err := func() error {
 								val, err := d.DecodeValue()
 								if err != nil {
@@ -1984,12 +2227,12 @@ func decodeDeleteRecordsSearchedResponse(resp *http.Response) (res DeleteRecords
 									return err
 								}
 
-								wrapperDotXTokenVal = c
+								wrapperDotLocationVal = c
 								return nil
 							}(); err != nil {
 								return err
 							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							wrapper.Location.SetTo(wrapperDotLocationVal)
 							return nil
 						}); err != nil {
 							return err
@@ -1997,21 +2240,52 @@ func decodeDeleteRecordsSearchedResponse(resp *http.Response) (res DeleteRecords
 					}
 					return nil
 				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
+					return res, errors.Wrap(err, "parse Location header")
+				}
+			}
+			// Parse "Upload-Offset" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Upload-Offset",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotUploadOffsetVal int64
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToInt64(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotUploadOffsetVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.UploadOffset.SetTo(wrapperDotUploadOffsetVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Upload-Offset header")
 				}
 			}
 			return &wrapper, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &DeleteRecordsSearchedUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &DeleteRecordsSearchedForbidden{}, nil
-	case 404:
-		// Code 404.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2024,7 +2298,7 @@ func decodeDeleteRecordsSearchedResponse(resp *http.Response) (res DeleteRecords
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response CreateUploadBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2045,9 +2319,14 @@ func decodeDeleteRecordsSearchedResponse(resp *http.Response) (res DeleteRecords
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	case 401:
+		// Code 401.
+		return &CreateUploadUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &CreateUploadForbidden{}, nil
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2060,7 +2339,7 @@ func decodeDeleteRecordsSearchedResponse(resp *http.Response) (res DeleteRecords
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response CreateUploadNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2077,27 +2356,12 @@ func decodeDeleteRecordsSearchedResponse(resp *http.Response) (res DeleteRecords
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeDeleteViewResponse(resp *http.Response) (res DeleteViewRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		return &DeleteViewOK{}, nil
-	case 400:
-		// Code 400.
+	case 409:
+		// Code 409.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2110,7 +2374,7 @@ func decodeDeleteViewResponse(resp *http.Response) (res DeleteViewRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response CreateUploadConflict
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2131,12 +2395,6 @@ func decodeDeleteViewResponse(resp *http.Response) (res DeleteViewRes, _ error)
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &DeleteViewUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &DeleteViewForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -2183,7 +2441,7 @@ func decodeDeleteViewResponse(resp *http.Response) (res DeleteViewRes, _ error)
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeDownloadFileResponse(resp *http.Response) (res DownloadFileRes, _ error) {
+func decodeDeleteExtendResponse(resp *http.Response) (res DeleteExtendRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2192,6 +2450,31 @@ func decodeDownloadFileResponse(resp *http.Response) (res DownloadFileRes, _ err
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ResponseRaw
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
 		case ct == "application/octet-stream":
 			reader := resp.Body
 			b, err := io.ReadAll(reader)
@@ -2199,7 +2482,7 @@ func decodeDownloadFileResponse(resp *http.Response) (res DownloadFileRes, _ err
 				return res, err
 			}
 
-			response := DownloadFileOK{Data: bytes.NewReader(b)}
+			response := DeleteExtendOKApplicationOctetStream{Data: bytes.NewReader(b)}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -2218,7 +2501,7 @@ func decodeDownloadFileResponse(resp *http.Response) (res DownloadFileRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DownloadFileBadRequest
+			var response DeleteExtendBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2241,10 +2524,10 @@ func decodeDownloadFileResponse(resp *http.Response) (res DownloadFileRes, _ err
 		}
 	case 401:
 		// Code 401.
-		return &DownloadFileUnauthorized{}, nil
+		return &DeleteExtendUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &DownloadFileForbidden{}, nil
+		return &DeleteExtendForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -2259,7 +2542,7 @@ func decodeDownloadFileResponse(resp *http.Response) (res DownloadFileRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DownloadFileNotFound
+			var response DeleteExtendNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2326,7 +2609,7 @@ func decodeDownloadFileResponse(resp *http.Response) (res DownloadFileRes, _ err
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetConfigResponse(resp *http.Response) (res GetConfigRes, _ error) {
+func decodeDeleteFileLocationResponse(resp *http.Response) (res DeleteFileLocationRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2342,7 +2625,7 @@ func decodeGetConfigResponse(resp *http.Response) (res GetConfigRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Config
+			var response StatusResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2386,7 +2669,7 @@ func decodeGetConfigResponse(resp *http.Response) (res GetConfigRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response DeleteFileLocationBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2409,10 +2692,45 @@ func decodeGetConfigResponse(resp *http.Response) (res GetConfigRes, _ error) {
 		}
 	case 401:
 		// Code 401.
-		return &GetConfigUnauthorized{}, nil
+		return &DeleteFileLocationUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &GetConfigForbidden{}, nil
+		return &DeleteFileLocationForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response DeleteFileLocationNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -2459,7 +2777,7 @@ func decodeGetConfigResponse(resp *http.Response) (res GetConfigRes, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetDatabasesResponse(resp *http.Response) (res GetDatabasesRes, _ error) {
+func decodeDeleteJobResultResponse(resp *http.Response) (res DeleteJobResultRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2475,7 +2793,7 @@ func decodeGetDatabasesResponse(resp *http.Response) (res GetDatabasesRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Databases
+			var response JobStatusResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2510,7 +2828,7 @@ func decodeGetDatabasesResponse(resp *http.Response) (res GetDatabasesRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response DeleteJobResultBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2533,13 +2851,12 @@ func decodeGetDatabasesResponse(resp *http.Response) (res GetDatabasesRes, _ err
 		}
 	case 401:
 		// Code 401.
-		return &GetDatabasesUnauthorized{}, nil
+		return &DeleteJobResultUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &GetDatabasesForbidden{}, nil
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+		return &DeleteJobResultForbidden{}, nil
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2552,7 +2869,7 @@ func decodeGetDatabasesResponse(resp *http.Response) (res GetDatabasesRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response DeleteJobResultNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2569,24 +2886,13 @@ func decodeGetDatabasesResponse(resp *http.Response) (res GetDatabasesRes, _ err
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
 	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeGetFieldsResponse(resp *http.Response) (res GetFieldsRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2599,7 +2905,7 @@ func decodeGetFieldsResponse(resp *http.Response) (res GetFieldsRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Fields
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2616,65 +2922,54 @@ func decodeGetFieldsResponse(resp *http.Response) (res GetFieldsRes, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeDeleteRecordByKeyResponse(resp *http.Response) (res DeleteRecordByKeyRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Response
 			if err := func() error {
-				if err := response.Validate(); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			var wrapper FieldsHeaders
-			wrapper.Response = response
-			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
-			{
-				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
-					Explode: false,
-				}
-				if err := func() error {
-					if err := h.HasParam(cfg); err == nil {
-						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
-							if err := func() error {
-								val, err := d.DecodeValue()
-								if err != nil {
-									return err
-								}
-
-								c, err := conv.ToString(val)
-								if err != nil {
-									return err
-								}
-
-								wrapperDotXTokenVal = c
-								return nil
-							}(); err != nil {
-								return err
-							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
-							return nil
-						}); err != nil {
-							return err
-						}
-					}
-					return nil
-				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
 				}
-			}
-			return &wrapper, nil
-		case ct == "text/csv":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
 				return res, err
 			}
-
-			response := GetFieldsOKTextCsv{Data: bytes.NewReader(b)}
-			var wrapper GetFieldsOKTextCsvHeaders
+			var wrapper ResponseHeaders
 			wrapper.Response = response
 			h := uri.NewHeaderDecoder(resp.Header)
 			// Parse "X-Token" header.
@@ -2732,7 +3027,7 @@ func decodeGetFieldsResponse(resp *http.Response) (res GetFieldsRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetFieldsBadRequest
+			var response DeleteRecordByKeyBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2755,10 +3050,10 @@ func decodeGetFieldsResponse(resp *http.Response) (res GetFieldsRes, _ error) {
 		}
 	case 401:
 		// Code 401.
-		return &GetFieldsUnauthorized{}, nil
+		return &DeleteRecordByKeyUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &GetFieldsForbidden{}, nil
+		return &DeleteRecordByKeyForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -2773,7 +3068,7 @@ func decodeGetFieldsResponse(resp *http.Response) (res GetFieldsRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetFieldsNotFound
+			var response DeleteRecordByKeyNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2840,7 +3135,7 @@ func decodeGetFieldsResponse(resp *http.Response) (res GetFieldsRes, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetImageResponse(resp *http.Response) (res GetImageRes, _ error) {
+func decodeDeleteRecordsSearchedResponse(resp *http.Response) (res DeleteRecordsSearchedRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -2849,47 +3144,68 @@ func decodeGetImageResponse(resp *http.Response) (res GetImageRes, _ error) {
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ht.MatchContentType("image/*", ct):
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
 			if err != nil {
 				return res, err
 			}
+			d := jx.DecodeBytes(buf)
 
-			response := GetImageOK{Data: bytes.NewReader(b)}
-			var wrapper GetImageOKHeaders
+			var response Response
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper ResponseHeaders
 			wrapper.Response = response
 			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "Content-Type" header.
+			// Parse "X-Token" header.
 			{
 				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "Content-Type",
+					Name:    "X-Token",
 					Explode: false,
 				}
 				if err := func() error {
 					if err := h.HasParam(cfg); err == nil {
 						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
 
-							c, err := conv.ToString(val)
-							if err != nil {
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
 								return err
 							}
-
-							wrapper.ContentType = c
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
 							return nil
 						}); err != nil {
 							return err
 						}
-					} else {
-						return err
 					}
 					return nil
 				}(); err != nil {
-					return res, errors.Wrap(err, "parse Content-Type header")
+					return res, errors.Wrap(err, "parse X-Token header")
 				}
 			}
 			return &wrapper, nil
@@ -2898,49 +3214,10 @@ func decodeGetImageResponse(resp *http.Response) (res GetImageRes, _ error) {
 		}
 	case 401:
 		// Code 401.
-		var wrapper GetImageUnauthorized
-		h := uri.NewHeaderDecoder(resp.Header)
-		// Parse "Www_authenticate" header.
-		{
-			cfg := uri.HeaderParameterDecodingConfig{
-				Name:    "Www_authenticate",
-				Explode: false,
-			}
-			if err := func() error {
-				if err := h.HasParam(cfg); err == nil {
-					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-						var wrapperDotWwwAuthenticateVal string
-						if err := func() error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
-
-							c, err := conv.ToString(val)
-							if err != nil {
-								return err
-							}
-
-							wrapperDotWwwAuthenticateVal = c
-							return nil
-						}(); err != nil {
-							return err
-						}
-						wrapper.WwwAuthenticate.SetTo(wrapperDotWwwAuthenticateVal)
-						return nil
-					}); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "parse Www_authenticate header")
-			}
-		}
-		return &wrapper, nil
+		return &DeleteRecordsSearchedUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &GetImageForbidden{}, nil
+		return &DeleteRecordsSearchedForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -2976,9 +3253,8 @@ func decodeGetImageResponse(resp *http.Response) (res GetImageRes, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	case 409:
+		// Code 409.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2991,7 +3267,7 @@ func decodeGetImageResponse(resp *http.Response) (res GetImageRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response Response
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3008,24 +3284,13 @@ func decodeGetImageResponse(resp *http.Response) (res GetImageRes, _ error) {
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
 	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeGetJobExecutionResultResponse(resp *http.Response) (res GetJobExecutionResultRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3038,7 +3303,7 @@ func decodeGetJobExecutionResultResponse(resp *http.Response) (res GetJobExecuti
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response JobResult
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3055,19 +3320,25 @@ func decodeGetJobExecutionResultResponse(resp *http.Response) (res GetJobExecuti
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			return &response, nil
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeDeleteViewResponse(resp *http.Response) (res DeleteViewRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &DeleteViewOK{}, nil
 	case 400:
 		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -3082,7 +3353,7 @@ func decodeGetJobExecutionResultResponse(resp *http.Response) (res GetJobExecuti
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetJobExecutionResultBadRequest
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3105,45 +3376,10 @@ func decodeGetJobExecutionResultResponse(resp *http.Response) (res GetJobExecuti
 		}
 	case 401:
 		// Code 401.
-		return &GetJobExecutionResultUnauthorized{}, nil
+		return &DeleteViewUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &GetJobExecutionResultForbidden{}, nil
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response GetJobExecutionResultNotFound
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
+		return &DeleteViewForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -3190,7 +3426,7 @@ func decodeGetJobExecutionResultResponse(resp *http.Response) (res GetJobExecuti
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetJobFullInfoResponse(resp *http.Response) (res GetJobFullInfoRes, _ error) {
+func decodeDownloadFileResponse(resp *http.Response) (res DownloadFileRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3199,39 +3435,14 @@ func decodeGetJobFullInfoResponse(resp *http.Response) (res GetJobFullInfoRes, _
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
+		case ct == "application/octet-stream":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
-			d := jx.DecodeBytes(buf)
 
-			var response JobFull
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
+			response := DownloadFileOK{Data: bytes.NewReader(b)}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -3250,7 +3461,7 @@ func decodeGetJobFullInfoResponse(resp *http.Response) (res GetJobFullInfoRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetJobFullInfoBadRequest
+			var response DownloadFileBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3273,10 +3484,10 @@ func decodeGetJobFullInfoResponse(resp *http.Response) (res GetJobFullInfoRes, _
 		}
 	case 401:
 		// Code 401.
-		return &GetJobFullInfoUnauthorized{}, nil
+		return &DownloadFileUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &GetJobFullInfoForbidden{}, nil
+		return &DownloadFileForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -3291,7 +3502,7 @@ func decodeGetJobFullInfoResponse(resp *http.Response) (res GetJobFullInfoRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetJobFullInfoNotFound
+			var response DownloadFileNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3358,7 +3569,7 @@ func decodeGetJobFullInfoResponse(resp *http.Response) (res GetJobFullInfoRes, _
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetJobResultResponse(resp *http.Response) (res GetJobResultRes, _ error) {
+func decodeExecuteTransactionResponse(resp *http.Response) (res ExecuteTransactionRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3374,7 +3585,7 @@ func decodeGetJobResultResponse(resp *http.Response) (res GetJobResultRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response JobResult
+			var response TransactionResult
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3391,16 +3602,47 @@ func decodeGetJobResultResponse(resp *http.Response) (res GetJobResultRes, _ err
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+			var wrapper ExecuteTransactionOK
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
 				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
 			}
-			return &response, nil
+			return &wrapper, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -3418,7 +3660,7 @@ func decodeGetJobResultResponse(resp *http.Response) (res GetJobResultRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetJobResultBadRequest
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3441,12 +3683,12 @@ func decodeGetJobResultResponse(resp *http.Response) (res GetJobResultRes, _ err
 		}
 	case 401:
 		// Code 401.
-		return &GetJobResultUnauthorized{}, nil
+		return &ExecuteTransactionUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &GetJobResultForbidden{}, nil
-	case 404:
-		// Code 404.
+		return &ExecuteTransactionForbidden{}, nil
+	case 422:
+		// Code 422.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3459,7 +3701,7 @@ func decodeGetJobResultResponse(resp *http.Response) (res GetJobResultRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetJobResultNotFound
+			var response TransactionResult
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3476,7 +3718,47 @@ func decodeGetJobResultResponse(resp *http.Response) (res GetJobResultRes, _ err
 				}
 				return res, err
 			}
-			return &response, nil
+			var wrapper ExecuteTransactionUnprocessableEntity
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -3526,7 +3808,7 @@ func decodeGetJobResultResponse(resp *http.Response) (res GetJobResultRes, _ err
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetJobsResponse(resp *http.Response) (res GetJobsRes, _ error) {
+func decodeGetArchiveResponse(resp *http.Response) (res GetArchiveRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3535,55 +3817,60 @@ func decodeGetJobsResponse(resp *http.Response) (res GetJobsRes, _ error) {
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
+		case ct == "application/zip":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
-			d := jx.DecodeBytes(buf)
 
-			var response JobsList
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
+			response := GetArchiveOK{Data: bytes.NewReader(b)}
+			var wrapper GetArchiveOKHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "Content-Disposition" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Content-Disposition",
+					Explode: false,
 				}
-				return res, err
-			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotContentDispositionVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotContentDispositionVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ContentDisposition.SetTo(wrapperDotContentDispositionVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Content-Disposition header")
 				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
 			}
-			return &response, nil
+			return &wrapper, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &GetJobsUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &GetJobsForbidden{}, nil
-	case 404:
-		// Code 404.
-		return &GetJobsNotFound{}, nil
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3596,7 +3883,7 @@ func decodeGetJobsResponse(resp *http.Response) (res GetJobsRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response GetArchiveBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3613,24 +3900,57 @@ func decodeGetJobsResponse(resp *http.Response) (res GetJobsRes, _ error) {
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
+	case 401:
+		// Code 401.
+		var wrapper GetArchiveUnauthorized
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "Www_authenticate" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "Www_authenticate",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotWwwAuthenticateVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
 
-func decodeGetJobsConfigResponse(resp *http.Response) (res GetJobsConfigRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotWwwAuthenticateVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.WwwAuthenticate.SetTo(wrapperDotWwwAuthenticateVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse Www_authenticate header")
+			}
+		}
+		return &wrapper, nil
+	case 403:
+		// Code 403.
+		return &GetArchiveForbidden{}, nil
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3643,7 +3963,7 @@ func decodeGetJobsConfigResponse(resp *http.Response) (res GetJobsConfigRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response JobStore
+			var response GetArchiveNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3660,21 +3980,12 @@ func decodeGetJobsConfigResponse(resp *http.Response) (res GetJobsConfigRes, _ e
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	case 413:
+		// Code 413.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3687,7 +3998,7 @@ func decodeGetJobsConfigResponse(resp *http.Response) (res GetJobsConfigRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response GetArchiveRequestEntityTooLarge
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3708,12 +4019,6 @@ func decodeGetJobsConfigResponse(resp *http.Response) (res GetJobsConfigRes, _ e
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &GetJobsConfigUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &GetJobsConfigForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -3760,7 +4065,7 @@ func decodeGetJobsConfigResponse(resp *http.Response) (res GetJobsConfigRes, _ e
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetLobByMapResponse(resp *http.Response) (res GetLobByMapRes, _ error) {
+func decodeGetConfigResponse(resp *http.Response) (res GetConfigRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3769,26 +4074,45 @@ func decodeGetLobByMapResponse(resp *http.Response) (res GetLobByMapRes, _ error
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ct == "application/octet-stream":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
 			if err != nil {
 				return res, err
 			}
+			d := jx.DecodeBytes(buf)
 
-			response := GetLobByMapOK{Data: bytes.NewReader(b)}
+			var response Config
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &GetLobByMapUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &GetLobByMapForbidden{}, nil
-	case 404:
-		// Code 404.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3822,6 +4146,12 @@ func decodeGetLobByMapResponse(resp *http.Response) (res GetLobByMapRes, _ error
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 401:
+		// Code 401.
+		return &GetConfigUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetConfigForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -3868,7 +4198,7 @@ func decodeGetLobByMapResponse(resp *http.Response) (res GetLobByMapRes, _ error
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetLoginSessionResponse(resp *http.Response) (res GetLoginSessionRes, _ error) {
+func decodeGetDatabasesResponse(resp *http.Response) (res GetDatabasesRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3884,7 +4214,7 @@ func decodeGetLoginSessionResponse(resp *http.Response) (res GetLoginSessionRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response AuthorizationToken
+			var response Databases
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3901,67 +4231,12 @@ func decodeGetLoginSessionResponse(resp *http.Response) (res GetLoginSessionRes,
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			var wrapper AuthorizationTokenHeaders
-			wrapper.Response = response
-			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
-			{
-				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
-					Explode: false,
-				}
-				if err := func() error {
-					if err := h.HasParam(cfg); err == nil {
-						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
-							if err := func() error {
-								val, err := d.DecodeValue()
-								if err != nil {
-									return err
-								}
-
-								c, err := conv.ToString(val)
-								if err != nil {
-									return err
-								}
-
-								wrapperDotXTokenVal = c
-								return nil
-							}(); err != nil {
-								return err
-							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
-							return nil
-						}); err != nil {
-							return err
-						}
-					}
-					return nil
-				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
-				}
-			}
-			return &wrapper, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &GetLoginSessionUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &GetLoginSessionForbidden{}, nil
-	case 404:
-		// Code 404.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3995,6 +4270,12 @@ func decodeGetLoginSessionResponse(resp *http.Response) (res GetLoginSessionRes,
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 401:
+		// Code 401.
+		return &GetDatabasesUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetDatabasesForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -4041,7 +4322,7 @@ func decodeGetLoginSessionResponse(resp *http.Response) (res GetLoginSessionRes,
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetMapMetadataResponse(resp *http.Response) (res GetMapMetadataRes, _ error) {
+func decodeGetFieldsResponse(resp *http.Response) (res GetFieldsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4057,7 +4338,7 @@ func decodeGetMapMetadataResponse(resp *http.Response) (res GetMapMetadataRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Mapping
+			var response TableMetadata
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4074,7 +4355,56 @@ func decodeGetMapMetadataResponse(resp *http.Response) (res GetMapMetadataRes, _
 				}
 				return res, err
 			}
-			var wrapper MappingHeaders
+			var wrapper TableMetadataHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := GetFieldsOKTextCsv{Data: bytes.NewReader(b)}
+			var wrapper GetFieldsOKTextCsvHeaders
 			wrapper.Response = response
 			h := uri.NewHeaderDecoder(resp.Header)
 			// Parse "X-Token" header.
@@ -4118,14 +4448,8 @@ func decodeGetMapMetadataResponse(resp *http.Response) (res GetMapMetadataRes, _
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &GetMapMetadataUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &GetMapMetadataForbidden{}, nil
-	case 404:
-		// Code 404.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4138,7 +4462,7 @@ func decodeGetMapMetadataResponse(resp *http.Response) (res GetMapMetadataRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response GetFieldsBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4159,9 +4483,14 @@ func decodeGetMapMetadataResponse(resp *http.Response) (res GetMapMetadataRes, _
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	case 401:
+		// Code 401.
+		return &GetFieldsUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetFieldsForbidden{}, nil
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4174,7 +4503,7 @@ func decodeGetMapMetadataResponse(resp *http.Response) (res GetMapMetadataRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response GetFieldsNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4191,24 +4520,13 @@ func decodeGetMapMetadataResponse(resp *http.Response) (res GetMapMetadataRes, _
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
 	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeGetMapRecordsFieldsResponse(resp *http.Response) (res GetMapRecordsFieldsRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4221,7 +4539,7 @@ func decodeGetMapRecordsFieldsResponse(resp *http.Response) (res GetMapRecordsFi
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Response
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4238,93 +4556,70 @@ func decodeGetMapRecordsFieldsResponse(resp *http.Response) (res GetMapRecordsFi
 				}
 				return res, err
 			}
-			var wrapper ResponseHeaders
-			wrapper.Response = response
-			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
-			{
-				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
-					Explode: false,
-				}
-				if err := func() error {
-					if err := h.HasParam(cfg); err == nil {
-						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
-							if err := func() error {
-								val, err := d.DecodeValue()
-								if err != nil {
-									return err
-								}
-
-								c, err := conv.ToString(val)
-								if err != nil {
-									return err
-								}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
 
-								wrapperDotXTokenVal = c
-								return nil
-							}(); err != nil {
-								return err
-							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
-							return nil
-						}); err != nil {
-							return err
-						}
-					}
-					return nil
-				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
-				}
-			}
-			return &wrapper, nil
-		case ct == "text/csv":
+func decodeGetImageResponse(resp *http.Response) (res GetImageRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ht.MatchContentType("image/*", ct):
 			reader := resp.Body
 			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
 
-			response := GetMapRecordsFieldsOKTextCsv{Data: bytes.NewReader(b)}
-			var wrapper GetMapRecordsFieldsOKTextCsvHeaders
+			response := GetImageOK{Data: bytes.NewReader(b)}
+			var wrapper GetImageOKHeaders
 			wrapper.Response = response
 			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
+			// Parse "Content-Type" header.
 			{
 				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
+					Name:    "Content-Type",
 					Explode: false,
 				}
 				if err := func() error {
 					if err := h.HasParam(cfg); err == nil {
 						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
-							if err := func() error {
-								val, err := d.DecodeValue()
-								if err != nil {
-									return err
-								}
-
-								c, err := conv.ToString(val)
-								if err != nil {
-									return err
-								}
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
 
-								wrapperDotXTokenVal = c
-								return nil
-							}(); err != nil {
+							c, err := conv.ToString(val)
+							if err != nil {
 								return err
 							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+
+							wrapper.ContentType = c
 							return nil
 						}); err != nil {
 							return err
 						}
+					} else {
+						return err
 					}
 					return nil
 				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
+					return res, errors.Wrap(err, "parse Content-Type header")
 				}
 			}
 			return &wrapper, nil
@@ -4333,10 +4628,49 @@ func decodeGetMapRecordsFieldsResponse(resp *http.Response) (res GetMapRecordsFi
 		}
 	case 401:
 		// Code 401.
-		return &GetMapRecordsFieldsUnauthorized{}, nil
+		var wrapper GetImageUnauthorized
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "Www_authenticate" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "Www_authenticate",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotWwwAuthenticateVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotWwwAuthenticateVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.WwwAuthenticate.SetTo(wrapperDotWwwAuthenticateVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse Www_authenticate header")
+			}
+		}
+		return &wrapper, nil
 	case 403:
 		// Code 403.
-		return &GetMapRecordsFieldsForbidden{}, nil
+		return &GetImageForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -4418,7 +4752,7 @@ func decodeGetMapRecordsFieldsResponse(resp *http.Response) (res GetMapRecordsFi
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetMapsResponse(resp *http.Response) (res GetMapsRes, _ error) {
+func decodeGetImageCacheStatsResponse(resp *http.Response) (res GetImageCacheStatsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4434,7 +4768,7 @@ func decodeGetMapsResponse(resp *http.Response) (res GetMapsRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Maps
+			var response ImageCacheStats
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4457,45 +4791,10 @@ func decodeGetMapsResponse(resp *http.Response) (res GetMapsRes, _ error) {
 		}
 	case 401:
 		// Code 401.
-		return &GetMapsUnauthorized{}, nil
+		return &GetImageCacheStatsUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &GetMapsForbidden{}, nil
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response Error
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
+		return &GetImageCacheStatsForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -4542,7 +4841,7 @@ func decodeGetMapsResponse(resp *http.Response) (res GetMapsRes, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetUserInfoResponse(resp *http.Response) (res GetUserInfoRes, _ error) {
+func decodeGetImageDuplicatesResponse(resp *http.Response) (res GetImageDuplicatesRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4558,7 +4857,7 @@ func decodeGetUserInfoResponse(resp *http.Response) (res GetUserInfoRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response User
+			var response ImageDuplicates
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4575,15 +4874,6 @@ func decodeGetUserInfoResponse(resp *http.Response) (res GetUserInfoRes, _ error
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -4602,7 +4892,7 @@ func decodeGetUserInfoResponse(resp *http.Response) (res GetUserInfoRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response GetImageDuplicatesBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4625,60 +4915,51 @@ func decodeGetUserInfoResponse(resp *http.Response) (res GetUserInfoRes, _ error
 		}
 	case 401:
 		// Code 401.
-		return &GetUserInfoUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &GetUserInfoForbidden{}, nil
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
+		var wrapper GetImageDuplicatesUnauthorized
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "Www_authenticate" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "Www_authenticate",
+				Explode: false,
 			}
-			d := jx.DecodeBytes(buf)
-
-			var response Error
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotWwwAuthenticateVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotWwwAuthenticateVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.WwwAuthenticate.SetTo(wrapperDotWwwAuthenticateVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
+				return res, errors.Wrap(err, "parse Www_authenticate header")
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
-		default:
-			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeGetVersionResponse(resp *http.Response) (res GetVersionRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+		return &wrapper, nil
+	case 403:
+		// Code 403.
+		return &GetImageDuplicatesForbidden{}, nil
+	case 413:
+		// Code 413.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4691,7 +4972,7 @@ func decodeGetVersionResponse(resp *http.Response) (res GetVersionRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Versions
+			var response GetImageDuplicatesRequestEntityTooLarge
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4712,8 +4993,9 @@ func decodeGetVersionResponse(resp *http.Response) (res GetVersionRes, _ error)
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4743,13 +5025,24 @@ func decodeGetVersionResponse(resp *http.Response) (res GetVersionRes, _ error)
 				}
 				return res, err
 			}
-			return &response, nil
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
 	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetImageHashResponse(resp *http.Response) (res GetImageHashRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4762,7 +5055,7 @@ func decodeGetVersionResponse(resp *http.Response) (res GetVersionRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response ImageHash
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4779,79 +5072,48 @@ func decodeGetVersionResponse(resp *http.Response) (res GetVersionRes, _ error)
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeGetVideoResponse(resp *http.Response) (res GetVideoRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ht.MatchContentType("video/*", ct):
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
 			if err != nil {
 				return res, err
 			}
+			d := jx.DecodeBytes(buf)
 
-			response := GetVideoOK{Data: bytes.NewReader(b)}
-			var wrapper GetVideoOKHeaders
-			wrapper.Response = response
-			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "Content-Type" header.
-			{
-				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "Content-Type",
-					Explode: false,
+			var response GetImageHashBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
 				}
-				if err := func() error {
-					if err := h.HasParam(cfg); err == nil {
-						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
-
-							c, err := conv.ToString(val)
-							if err != nil {
-								return err
-							}
-
-							wrapper.ContentType = c
-							return nil
-						}); err != nil {
-							return err
-						}
-					} else {
-						return err
-					}
-					return nil
-				}(); err != nil {
-					return res, errors.Wrap(err, "parse Content-Type header")
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
 				}
+				return res, err
 			}
-			return &wrapper, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
 	case 401:
 		// Code 401.
-		var wrapper GetVideoUnauthorized
+		var wrapper GetImageHashUnauthorized
 		h := uri.NewHeaderDecoder(resp.Header)
 		// Parse "Www_authenticate" header.
 		{
@@ -4893,7 +5155,7 @@ func decodeGetVideoResponse(resp *http.Response) (res GetVideoRes, _ error) {
 		return &wrapper, nil
 	case 403:
 		// Code 403.
-		return &GetVideoForbidden{}, nil
+		return &GetImageHashForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -4908,7 +5170,7 @@ func decodeGetVideoResponse(resp *http.Response) (res GetVideoRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response GetImageHashNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4975,22 +5237,101 @@ func decodeGetVideoResponse(resp *http.Response) (res GetVideoRes, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeGetViewsResponse(resp *http.Response) (res GetViewsRes, _ error) {
+func decodeGetImageMetadataResponse(resp *http.Response) (res GetImageMetadataRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		var wrapper GetViewsOK
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ImageMetadata
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GetImageMetadataBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		var wrapper GetImageMetadataUnauthorized
 		h := uri.NewHeaderDecoder(resp.Header)
-		// Parse "X-Token" header.
+		// Parse "Www_authenticate" header.
 		{
 			cfg := uri.HeaderParameterDecodingConfig{
-				Name:    "X-Token",
+				Name:    "Www_authenticate",
 				Explode: false,
 			}
 			if err := func() error {
 				if err := h.HasParam(cfg); err == nil {
 					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-						var wrapperDotXTokenVal string
+						var wrapperDotWwwAuthenticateVal string
 						if err := func() error {
 							val, err := d.DecodeValue()
 							if err != nil {
@@ -5002,12 +5343,12 @@ func decodeGetViewsResponse(resp *http.Response) (res GetViewsRes, _ error) {
 								return err
 							}
 
-							wrapperDotXTokenVal = c
+							wrapperDotWwwAuthenticateVal = c
 							return nil
 						}(); err != nil {
 							return err
 						}
-						wrapper.XToken.SetTo(wrapperDotXTokenVal)
+						wrapper.WwwAuthenticate.SetTo(wrapperDotWwwAuthenticateVal)
 						return nil
 					}); err != nil {
 						return err
@@ -5015,12 +5356,15 @@ func decodeGetViewsResponse(resp *http.Response) (res GetViewsRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "parse X-Token header")
+				return res, errors.Wrap(err, "parse Www_authenticate header")
 			}
 		}
 		return &wrapper, nil
-	case 400:
-		// Code 400.
+	case 403:
+		// Code 403.
+		return &GetImageMetadataForbidden{}, nil
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5033,7 +5377,7 @@ func decodeGetViewsResponse(resp *http.Response) (res GetViewsRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response GetImageMetadataNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5054,12 +5398,6 @@ func decodeGetViewsResponse(resp *http.Response) (res GetViewsRes, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &GetViewsUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &GetViewsForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -5106,7 +5444,7 @@ func decodeGetViewsResponse(resp *http.Response) (res GetViewsRes, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeInsertMapFileRecordsResponse(resp *http.Response) (res InsertMapFileRecordsRes, _ error) {
+func decodeGetJobExecutionResultResponse(resp *http.Response) (res GetJobExecutionResultRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5122,7 +5460,7 @@ func decodeInsertMapFileRecordsResponse(resp *http.Response) (res InsertMapFileR
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StoreResponse
+			var response JobResult
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5139,47 +5477,16 @@ func decodeInsertMapFileRecordsResponse(resp *http.Response) (res InsertMapFileR
 				}
 				return res, err
 			}
-			var wrapper StoreResponseHeaders
-			wrapper.Response = response
-			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
-			{
-				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
-					Explode: false,
-				}
-				if err := func() error {
-					if err := h.HasParam(cfg); err == nil {
-						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
-							if err := func() error {
-								val, err := d.DecodeValue()
-								if err != nil {
-									return err
-								}
-
-								c, err := conv.ToString(val)
-								if err != nil {
-									return err
-								}
-
-								wrapperDotXTokenVal = c
-								return nil
-							}(); err != nil {
-								return err
-							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
-							return nil
-						}); err != nil {
-							return err
-						}
-					}
-					return nil
-				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
 				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
 			}
-			return &wrapper, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -5197,7 +5504,7 @@ func decodeInsertMapFileRecordsResponse(resp *http.Response) (res InsertMapFileR
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response InsertMapFileRecordsBadRequest
+			var response GetJobExecutionResultBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5220,10 +5527,10 @@ func decodeInsertMapFileRecordsResponse(resp *http.Response) (res InsertMapFileR
 		}
 	case 401:
 		// Code 401.
-		return &InsertMapFileRecordsUnauthorized{}, nil
+		return &GetJobExecutionResultUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &InsertMapFileRecordsForbidden{}, nil
+		return &GetJobExecutionResultForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -5238,7 +5545,7 @@ func decodeInsertMapFileRecordsResponse(resp *http.Response) (res InsertMapFileR
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response InsertMapFileRecordsNotFound
+			var response GetJobExecutionResultNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5305,7 +5612,7 @@ func decodeInsertMapFileRecordsResponse(resp *http.Response) (res InsertMapFileR
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeInsertRecordResponse(resp *http.Response) (res InsertRecordRes, _ error) {
+func decodeGetJobFullInfoResponse(resp *http.Response) (res GetJobFullInfoRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5321,7 +5628,7 @@ func decodeInsertRecordResponse(resp *http.Response) (res InsertRecordRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Response
+			var response JobFull
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5338,59 +5645,60 @@ func decodeInsertRecordResponse(resp *http.Response) (res InsertRecordRes, _ err
 				}
 				return res, err
 			}
-			var wrapper ResponseHeaders
-			wrapper.Response = response
-			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
-			{
-				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
-					Explode: false,
-				}
-				if err := func() error {
-					if err := h.HasParam(cfg); err == nil {
-						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
-							if err := func() error {
-								val, err := d.DecodeValue()
-								if err != nil {
-									return err
-								}
-
-								c, err := conv.ToString(val)
-								if err != nil {
-									return err
-								}
-
-								wrapperDotXTokenVal = c
-								return nil
-							}(); err != nil {
-								return err
-							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
-							return nil
-						}); err != nil {
-							return err
-						}
-					}
-					return nil
-				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
 				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
 			}
-			return &wrapper, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
 	case 400:
 		// Code 400.
-		return &InsertRecordBadRequest{}, nil
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GetJobFullInfoBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	case 401:
 		// Code 401.
-		return &InsertRecordUnauthorized{}, nil
+		return &GetJobFullInfoUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &InsertRecordForbidden{}, nil
+		return &GetJobFullInfoForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -5405,7 +5713,7 @@ func decodeInsertRecordResponse(resp *http.Response) (res InsertRecordRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response GetJobFullInfoNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5472,7 +5780,7 @@ func decodeInsertRecordResponse(resp *http.Response) (res InsertRecordRes, _ err
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeListModellingResponse(resp *http.Response) (res ListModellingRes, _ error) {
+func decodeGetJobResultResponse(resp *http.Response) (res GetJobResultRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5488,7 +5796,7 @@ func decodeListModellingResponse(resp *http.Response) (res ListModellingRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Maps
+			var response JobResult
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5505,15 +5813,15 @@ func decodeListModellingResponse(resp *http.Response) (res ListModellingRes, _ e
 				}
 				return res, err
 			}
-			return &response, nil
-		case ct == "text/csv":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
 			}
-
-			response := ListModellingOKTextCsv{Data: bytes.NewReader(b)}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -5532,7 +5840,7 @@ func decodeListModellingResponse(resp *http.Response) (res ListModellingRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListModellingBadRequest
+			var response GetJobResultBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5555,10 +5863,10 @@ func decodeListModellingResponse(resp *http.Response) (res ListModellingRes, _ e
 		}
 	case 401:
 		// Code 401.
-		return &ListModellingUnauthorized{}, nil
+		return &GetJobResultUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &ListModellingForbidden{}, nil
+		return &GetJobResultForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -5573,7 +5881,7 @@ func decodeListModellingResponse(resp *http.Response) (res ListModellingRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListModellingNotFound
+			var response GetJobResultNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5640,7 +5948,7 @@ func decodeListModellingResponse(resp *http.Response) (res ListModellingRes, _ e
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeListTablesResponse(resp *http.Response) (res ListTablesRes, _ error) {
+func decodeGetJobsResponse(resp *http.Response) (res GetJobsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5656,7 +5964,7 @@ func decodeListTablesResponse(resp *http.Response) (res ListTablesRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Maps
+			var response JobsList
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5673,21 +5981,31 @@ func decodeListTablesResponse(resp *http.Response) (res ListTablesRes, _ error)
 				}
 				return res, err
 			}
-			return &response, nil
-		case ct == "text/csv":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
 			}
-
-			response := ListTablesOKTextCsv{Data: bytes.NewReader(b)}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	case 401:
+		// Code 401.
+		return &GetJobsUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetJobsForbidden{}, nil
+	case 404:
+		// Code 404.
+		return &GetJobsNotFound{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5700,7 +6018,7 @@ func decodeListTablesResponse(resp *http.Response) (res ListTablesRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListTablesBadRequest
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5717,18 +6035,24 @@ func decodeListTablesResponse(resp *http.Response) (res ListTablesRes, _ error)
 				}
 				return res, err
 			}
-			return &response, nil
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &ListTablesUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &ListTablesForbidden{}, nil
-	case 404:
-		// Code 404.
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetJobsConfigResponse(resp *http.Response) (res GetJobsConfigRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5741,7 +6065,7 @@ func decodeListTablesResponse(resp *http.Response) (res ListTablesRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListTablesNotFound
+			var response JobStore
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5758,13 +6082,21 @@ func decodeListTablesResponse(resp *http.Response) (res ListTablesRes, _ error)
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5794,24 +6126,19 @@ func decodeListTablesResponse(resp *http.Response) (res ListTablesRes, _ error)
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	case 401:
+		// Code 401.
+		return &GetJobsConfigUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetJobsConfigForbidden{}, nil
 	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeLoginSessionResponse(resp *http.Response) (res LoginSessionRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5824,7 +6151,7 @@ func decodeLoginSessionResponse(resp *http.Response) (res LoginSessionRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response AuthorizationToken
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5841,28 +6168,50 @@ func decodeLoginSessionResponse(resp *http.Response) (res LoginSessionRes, _ err
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetLobByMapResponse(resp *http.Response) (res GetLobByMapRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/octet-stream":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
 			}
-			var wrapper AuthorizationTokenHeaders
+
+			response := GetLobByMapOK{Data: bytes.NewReader(b)}
+			var wrapper GetLobByMapOKHeaders
 			wrapper.Response = response
 			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
+			// Parse "Accept-Ranges" header.
 			{
 				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
+					Name:    "Accept-Ranges",
 					Explode: false,
 				}
 				if err := func() error {
 					if err := h.HasParam(cfg); err == nil {
 						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
+							var wrapperDotAcceptRangesVal string
 							if err := func() error {
 								val, err := d.DecodeValue()
 								if err != nil {
@@ -5874,12 +6223,12 @@ func decodeLoginSessionResponse(resp *http.Response) (res LoginSessionRes, _ err
 									return err
 								}
 
-								wrapperDotXTokenVal = c
+								wrapperDotAcceptRangesVal = c
 								return nil
 							}(); err != nil {
 								return err
 							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							wrapper.AcceptRanges.SetTo(wrapperDotAcceptRangesVal)
 							return nil
 						}); err != nil {
 							return err
@@ -5887,140 +6236,295 @@ func decodeLoginSessionResponse(resp *http.Response) (res LoginSessionRes, _ err
 					}
 					return nil
 				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
+					return res, errors.Wrap(err, "parse Accept-Ranges header")
 				}
 			}
-			return &wrapper, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 401:
-		// Code 401.
-		return &LoginSessionUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &LoginSessionForbidden{}, nil
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
+			// Parse "Content-Length" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Content-Length",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotContentLengthVal int64
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
 
-			var response Error
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
+								c, err := conv.ToInt64(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotContentLengthVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ContentLength.SetTo(wrapperDotContentLengthVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Content-Length header")
 				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
+			}
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
 				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
 				}
-				return res, err
 			}
-			return &response, nil
+			return &wrapper, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	case 206:
+		// Code 206.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
+		case ht.MatchContentType("*/*", ct):
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
-			d := jx.DecodeBytes(buf)
 
-			var response Error
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
+			response := GetLobByMapPartialContent{Data: bytes.NewReader(b)}
+			var wrapper GetLobByMapPartialContentHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "Accept-Ranges" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Accept-Ranges",
+					Explode: false,
 				}
-				return res, err
-			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotAcceptRangesVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
 
-func decodeLogoutSessionCompatResponse(resp *http.Response) (res LogoutSessionCompatRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		return &LogoutSessionCompatOK{}, nil
-	case 400:
-		// Code 400.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
 
-			var response LogoutSessionCompatBadRequest
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
+								wrapperDotAcceptRangesVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.AcceptRanges.SetTo(wrapperDotAcceptRangesVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Accept-Ranges header")
 				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
+			}
+			// Parse "Content-Length" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Content-Length",
+					Explode: false,
 				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotContentLengthVal int64
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToInt64(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotContentLengthVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ContentLength.SetTo(wrapperDotContentLengthVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Content-Length header")
 				}
-				return res, err
 			}
-			return &response, nil
+			// Parse "Content-Range" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Content-Range",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotContentRangeVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotContentRangeVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ContentRange.SetTo(wrapperDotContentRangeVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Content-Range header")
+				}
+			}
+			// Parse "Content-Type" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Content-Type",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapper.ContentType = c
+							return nil
+						}); err != nil {
+							return err
+						}
+					} else {
+						return err
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Content-Type header")
+				}
+			}
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			return &wrapper, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 401:
+		// Code 401.
+		return &GetLobByMapUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetLobByMapForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -6035,7 +6539,7 @@ func decodeLogoutSessionCompatResponse(resp *http.Response) (res LogoutSessionCo
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response LogoutSessionCompatNotFound
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6056,6 +6560,48 @@ func decodeLogoutSessionCompatResponse(resp *http.Response) (res LogoutSessionCo
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 416:
+		// Code 416.
+		var wrapper GetLobByMapRequestedRangeNotSatisfiable
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "Content-Range" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "Content-Range",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotContentRangeVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotContentRangeVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.ContentRange.SetTo(wrapperDotContentRangeVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse Content-Range header")
+			}
+		}
+		return &wrapper, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -6102,7 +6648,7 @@ func decodeLogoutSessionCompatResponse(resp *http.Response) (res LogoutSessionCo
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodePostDatabaseResponse(resp *http.Response) (res PostDatabaseRes, _ error) {
+func decodeGetLoginSessionResponse(resp *http.Response) (res GetLoginSessionRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6116,40 +6662,5499 @@ func decodePostDatabaseResponse(resp *http.Response) (res PostDatabaseRes, _ err
 			if err != nil {
 				return res, err
 			}
-			d := jx.DecodeBytes(buf)
+			d := jx.DecodeBytes(buf)
+
+			var response AuthorizationToken
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			var wrapper AuthorizationTokenHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &GetLoginSessionUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetLoginSessionForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetMapMetadataResponse(resp *http.Response) (res GetMapMetadataRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response TableMetadata
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper TableMetadataHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &GetMapMetadataUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetMapMetadataForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetMapRecordsFieldsResponse(resp *http.Response) (res GetMapRecordsFieldsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Response
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper GetMapRecordsFieldsOKApplicationJSONHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Link" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLinkVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLinkVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.Link.SetTo(wrapperDotLinkVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Link header")
+				}
+			}
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "application/x-json-stream":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := GetMapRecordsFieldsOKApplicationXJSONStream{Data: bytes.NewReader(b)}
+			var wrapper GetMapRecordsFieldsOKApplicationXJSONStreamHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Link" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLinkVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLinkVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.Link.SetTo(wrapperDotLinkVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Link header")
+				}
+			}
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "application/x-ndjson":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := GetMapRecordsFieldsOKApplicationXNdjson{Data: bytes.NewReader(b)}
+			var wrapper GetMapRecordsFieldsOKApplicationXNdjsonHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Link" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLinkVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLinkVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.Link.SetTo(wrapperDotLinkVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Link header")
+				}
+			}
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := GetMapRecordsFieldsOKTextCsv{Data: bytes.NewReader(b)}
+			var wrapper GetMapRecordsFieldsOKTextCsvHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Link" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLinkVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLinkVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.Link.SetTo(wrapperDotLinkVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Link header")
+				}
+			}
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &GetMapRecordsFieldsUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetMapRecordsFieldsForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetMapsResponse(resp *http.Response) (res GetMapsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Maps
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &GetMapsUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetMapsForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetRecordByKeyResponse(resp *http.Response) (res GetRecordByKeyRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Response
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper GetRecordByKeyOKHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GetRecordByKeyBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &GetRecordByKeyUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetRecordByKeyForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GetRecordByKeyNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetRecordHistoryResponse(resp *http.Response) (res GetRecordHistoryRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RecordHistory
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			var wrapper RecordHistoryHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &GetRecordHistoryUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetRecordHistoryForbidden{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetUploadResponse(resp *http.Response) (res GetUploadRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UploadStatus
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper GetUploadOKHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "Upload-Offset" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Upload-Offset",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotUploadOffsetVal int64
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToInt64(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotUploadOffsetVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.UploadOffset.SetTo(wrapperDotUploadOffsetVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Upload-Offset header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &GetUploadUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetUploadForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetUserInfoResponse(resp *http.Response) (res GetUserInfoRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response User
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &GetUserInfoUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetUserInfoForbidden{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetVersionResponse(resp *http.Response) (res GetVersionRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Versions
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetVideoResponse(resp *http.Response) (res GetVideoRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ht.MatchContentType("video/*", ct):
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := GetVideoOK{Data: bytes.NewReader(b)}
+			var wrapper GetVideoOKHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "Accept-Ranges" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Accept-Ranges",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotAcceptRangesVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotAcceptRangesVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.AcceptRanges.SetTo(wrapperDotAcceptRangesVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Accept-Ranges header")
+				}
+			}
+			// Parse "Content-Length" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Content-Length",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotContentLengthVal int64
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToInt64(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotContentLengthVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ContentLength.SetTo(wrapperDotContentLengthVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Content-Length header")
+				}
+			}
+			// Parse "Content-Type" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Content-Type",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapper.ContentType = c
+							return nil
+						}); err != nil {
+							return err
+						}
+					} else {
+						return err
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Content-Type header")
+				}
+			}
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 206:
+		// Code 206.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ht.MatchContentType("*/*", ct):
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := GetVideoPartialContent{Data: bytes.NewReader(b)}
+			var wrapper GetVideoPartialContentHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "Accept-Ranges" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Accept-Ranges",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotAcceptRangesVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotAcceptRangesVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.AcceptRanges.SetTo(wrapperDotAcceptRangesVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Accept-Ranges header")
+				}
+			}
+			// Parse "Content-Length" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Content-Length",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotContentLengthVal int64
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToInt64(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotContentLengthVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ContentLength.SetTo(wrapperDotContentLengthVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Content-Length header")
+				}
+			}
+			// Parse "Content-Range" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Content-Range",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotContentRangeVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotContentRangeVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ContentRange.SetTo(wrapperDotContentRangeVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Content-Range header")
+				}
+			}
+			// Parse "Content-Type" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Content-Type",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapper.ContentType = c
+							return nil
+						}); err != nil {
+							return err
+						}
+					} else {
+						return err
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Content-Type header")
+				}
+			}
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		var wrapper GetVideoUnauthorized
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "Www_authenticate" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "Www_authenticate",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotWwwAuthenticateVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotWwwAuthenticateVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.WwwAuthenticate.SetTo(wrapperDotWwwAuthenticateVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse Www_authenticate header")
+			}
+		}
+		return &wrapper, nil
+	case 403:
+		// Code 403.
+		return &GetVideoForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 416:
+		// Code 416.
+		var wrapper GetVideoRequestedRangeNotSatisfiable
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "Content-Range" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "Content-Range",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotContentRangeVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotContentRangeVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.ContentRange.SetTo(wrapperDotContentRangeVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse Content-Range header")
+			}
+		}
+		return &wrapper, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeGetViewsResponse(resp *http.Response) (res GetViewsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		var wrapper GetViewsOK
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "X-Token" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "X-Token",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotXTokenVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotXTokenVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.XToken.SetTo(wrapperDotXTokenVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse X-Token header")
+			}
+		}
+		return &wrapper, nil
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &GetViewsUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &GetViewsForbidden{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeHeadMapRecordsFieldsResponse(resp *http.Response) (res HeadMapRecordsFieldsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		var wrapper HeadMapRecordsFieldsOK
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "X-Token" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "X-Token",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotXTokenVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotXTokenVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.XToken.SetTo(wrapperDotXTokenVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse X-Token header")
+			}
+		}
+		// Parse "X-Total-Count" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "X-Total-Count",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotXTotalCountVal int64
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToInt64(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotXTotalCountVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.XTotalCount.SetTo(wrapperDotXTotalCountVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse X-Total-Count header")
+			}
+		}
+		return &wrapper, nil
+	case 401:
+		// Code 401.
+		return &HeadMapRecordsFieldsUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &HeadMapRecordsFieldsForbidden{}, nil
+	case 404:
+		// Code 404.
+		return &HeadMapRecordsFieldsNotFound{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeImportRecordsResponse(resp *http.Response) (res ImportRecordsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ImportReport
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper ImportReportHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &ImportRecordsUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &ImportRecordsForbidden{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeInsertMapFileRecordsResponse(resp *http.Response) (res InsertMapFileRecordsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response StoreResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper StoreResponseHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response InsertMapFileRecordsBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &InsertMapFileRecordsUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &InsertMapFileRecordsForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response InsertMapFileRecordsNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeInsertRecordResponse(resp *http.Response) (res InsertRecordRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Response
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper ResponseHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		return &InsertRecordBadRequest{}, nil
+	case 401:
+		// Code 401.
+		return &InsertRecordUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &InsertRecordForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeListModellingResponse(resp *http.Response) (res ListModellingRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response TablesMetadata
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := ListModellingOKTextCsv{Data: bytes.NewReader(b)}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListModellingBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &ListModellingUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &ListModellingForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListModellingNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeListTablesResponse(resp *http.Response) (res ListTablesRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response TablesMetadata
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := ListTablesOKTextCsv{Data: bytes.NewReader(b)}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListTablesBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &ListTablesUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &ListTablesForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListTablesNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeLoginSessionResponse(resp *http.Response) (res LoginSessionRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response AuthorizationToken
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			var wrapper AuthorizationTokenHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &LoginSessionUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &LoginSessionForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeLogoutSessionCompatResponse(resp *http.Response) (res LogoutSessionCompatRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &LogoutSessionCompatOK{}, nil
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response LogoutSessionCompatBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response LogoutSessionCompatNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePatchRecordByKeyResponse(resp *http.Response) (res PatchRecordByKeyRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Response
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper GetRecordByKeyOKHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PatchRecordByKeyBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &PatchRecordByKeyUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &PatchRecordByKeyForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PatchRecordByKeyNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 412:
+		// Code 412.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Response
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePostDatabaseResponse(resp *http.Response) (res PostDatabaseRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response StatusResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &PostDatabaseUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &PostDatabaseForbidden{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePostJobResponse(resp *http.Response) (res PostJobRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response StatusResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PostJobBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &PostJobUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &PostJobForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PostJobNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePurgeImageCacheResponse(resp *http.Response) (res PurgeImageCacheRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ImageCacheStats
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &PurgeImageCacheUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &PurgeImageCacheForbidden{}, nil
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodePushLoginSessionResponse(resp *http.Response) (res PushLoginSessionRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response AuthorizationToken
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			var wrapper AuthorizationTokenHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &PushLoginSessionUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &PushLoginSessionForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeRemoveSessionCompatResponse(resp *http.Response) (res RemoveSessionCompatRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		return &RemoveSessionCompatOK{}, nil
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemoveSessionCompatBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemoveSessionCompatNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeSearchModellingResponse(resp *http.Response) (res SearchModellingRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Response
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := SearchModellingOKTextCsv{Data: bytes.NewReader(b)}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response SearchModellingBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		return &SearchModellingUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &SearchModellingForbidden{}, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response SearchModellingNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	// Convenient error response.
+	defRes, err := func() (res *ErrorStatusCode, err error) {
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Error
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &ErrorStatusCode{
+				StatusCode: resp.StatusCode,
+				Response:   response,
+			}, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}()
+	if err != nil {
+		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeSearchRecordsFieldsResponse(resp *http.Response) (res SearchRecordsFieldsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Response
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper GetMapRecordsFieldsOKApplicationJSONHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Link" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLinkVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLinkVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.Link.SetTo(wrapperDotLinkVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Link header")
+				}
+			}
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "application/x-json-stream":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := SearchRecordsFieldsOKApplicationXJSONStream{Data: bytes.NewReader(b)}
+			var wrapper SearchRecordsFieldsOKApplicationXJSONStreamHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Link" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLinkVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLinkVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.Link.SetTo(wrapperDotLinkVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Link header")
+				}
+			}
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "application/x-ndjson":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := SearchRecordsFieldsOKApplicationXNdjson{Data: bytes.NewReader(b)}
+			var wrapper SearchRecordsFieldsOKApplicationXNdjsonHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Link" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLinkVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLinkVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.Link.SetTo(wrapperDotLinkVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Link header")
+				}
+			}
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
 
-			var response StatusResponse
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
+			response := SearchRecordsFieldsOKTextCsv{Data: bytes.NewReader(b)}
+			var wrapper SearchRecordsFieldsOKTextCsvHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
 				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
 				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
+			}
+			// Parse "Link" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLinkVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLinkVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.Link.SetTo(wrapperDotLinkVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Link header")
 				}
-				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
 				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
 			}
-			return &response, nil
+			return &wrapper, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	case 401:
+		// Code 401.
+		return &SearchRecordsFieldsUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &SearchRecordsFieldsForbidden{}, nil
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6183,12 +12188,6 @@ func decodePostDatabaseResponse(resp *http.Response) (res PostDatabaseRes, _ err
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &PostDatabaseUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &PostDatabaseForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -6235,7 +12234,7 @@ func decodePostDatabaseResponse(resp *http.Response) (res PostDatabaseRes, _ err
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodePostJobResponse(resp *http.Response) (res PostJobRes, _ error) {
+func decodeSearchTableResponse(resp *http.Response) (res SearchTableRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6251,7 +12250,7 @@ func decodePostJobResponse(resp *http.Response) (res PostJobRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StatusResponse
+			var response Response
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6268,15 +12267,15 @@ func decodePostJobResponse(resp *http.Response) (res PostJobRes, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+			return &response, nil
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
 			}
+
+			response := SearchTableOKTextCsv{Data: bytes.NewReader(b)}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -6295,7 +12294,7 @@ func decodePostJobResponse(resp *http.Response) (res PostJobRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PostJobBadRequest
+			var response SearchTableBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6318,10 +12317,10 @@ func decodePostJobResponse(resp *http.Response) (res PostJobRes, _ error) {
 		}
 	case 401:
 		// Code 401.
-		return &PostJobUnauthorized{}, nil
+		return &SearchTableUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &PostJobForbidden{}, nil
+		return &SearchTableForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -6336,7 +12335,7 @@ func decodePostJobResponse(resp *http.Response) (res PostJobRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PostJobNotFound
+			var response SearchTableNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6403,100 +12402,52 @@ func decodePostJobResponse(resp *http.Response) (res PostJobRes, _ error) {
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodePushLoginSessionResponse(resp *http.Response) (res PushLoginSessionRes, _ error) {
+func decodeSetConfigResponse(resp *http.Response) (res SetConfigRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response AuthorizationToken
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
+		var wrapper SetConfigOK
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "X-Token" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "X-Token",
+				Explode: false,
 			}
-			// Validate response.
 			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			var wrapper AuthorizationTokenHeaders
-			wrapper.Response = response
-			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
-			{
-				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
-					Explode: false,
-				}
-				if err := func() error {
-					if err := h.HasParam(cfg); err == nil {
-						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
-							if err := func() error {
-								val, err := d.DecodeValue()
-								if err != nil {
-									return err
-								}
-
-								c, err := conv.ToString(val)
-								if err != nil {
-									return err
-								}
-
-								wrapperDotXTokenVal = c
-								return nil
-							}(); err != nil {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotXTokenVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
 								return err
 							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+
+							wrapperDotXTokenVal = c
 							return nil
-						}); err != nil {
+						}(); err != nil {
 							return err
 						}
+						wrapper.XToken.SetTo(wrapperDotXTokenVal)
+						return nil
+					}); err != nil {
+						return err
 					}
-					return nil
-				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
 				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse X-Token header")
 			}
-			return &wrapper, nil
-		default:
-			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &PushLoginSessionUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &PushLoginSessionForbidden{}, nil
-	case 404:
-		// Code 404.
+		return &wrapper, nil
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6530,6 +12481,12 @@ func decodePushLoginSessionResponse(resp *http.Response) (res PushLoginSessionRe
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 401:
+		// Code 401.
+		return &SetConfigUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &SetConfigForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -6576,11 +12533,11 @@ func decodePushLoginSessionResponse(resp *http.Response) (res PushLoginSessionRe
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeRemoveSessionCompatResponse(resp *http.Response) (res RemoveSessionCompatRes, _ error) {
+func decodeSetJobsConfigResponse(resp *http.Response) (res SetJobsConfigRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		return &RemoveSessionCompatOK{}, nil
+		return &SetJobsConfigOK{}, nil
 	case 400:
 		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -6595,42 +12552,7 @@ func decodeRemoveSessionCompatResponse(resp *http.Response) (res RemoveSessionCo
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveSessionCompatBadRequest
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response RemoveSessionCompatNotFound
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6651,6 +12573,12 @@ func decodeRemoveSessionCompatResponse(resp *http.Response) (res RemoveSessionCo
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 401:
+		// Code 401.
+		return &SetJobsConfigUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &SetJobsConfigForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -6697,7 +12625,7 @@ func decodeRemoveSessionCompatResponse(resp *http.Response) (res RemoveSessionCo
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSearchModellingResponse(resp *http.Response) (res SearchModellingRes, _ error) {
+func decodeShutdownServerResponse(resp *http.Response) (res ShutdownServerRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6713,7 +12641,7 @@ func decodeSearchModellingResponse(resp *http.Response) (res SearchModellingRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Response
+			var response StatusResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6730,49 +12658,14 @@ func decodeSearchModellingResponse(resp *http.Response) (res SearchModellingRes,
 				}
 				return res, err
 			}
-			return &response, nil
-		case ct == "text/csv":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := SearchModellingOKTextCsv{Data: bytes.NewReader(b)}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 400:
-		// Code 400.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response SearchModellingBadRequest
+			// Validate response.
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				if err := response.Validate(); err != nil {
 					return err
 				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
 				return nil
 			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
+				return res, errors.Wrap(err, "validate")
 			}
 			return &response, nil
 		default:
@@ -6780,10 +12673,10 @@ func decodeSearchModellingResponse(resp *http.Response) (res SearchModellingRes,
 		}
 	case 401:
 		// Code 401.
-		return &SearchModellingUnauthorized{}, nil
+		return &ShutdownServerUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &SearchModellingForbidden{}, nil
+		return &ShutdownServerForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -6798,7 +12691,7 @@ func decodeSearchModellingResponse(resp *http.Response) (res SearchModellingRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SearchModellingNotFound
+			var response Error
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6865,140 +12758,52 @@ func decodeSearchModellingResponse(resp *http.Response) (res SearchModellingRes,
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSearchRecordsFieldsResponse(resp *http.Response) (res SearchRecordsFieldsRes, _ error) {
+func decodeStoreConfigResponse(resp *http.Response) (res StoreConfigRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
+		var wrapper StoreConfigOK
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "X-Token" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "X-Token",
+				Explode: false,
 			}
-			d := jx.DecodeBytes(buf)
-
-			var response Response
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			var wrapper ResponseHeaders
-			wrapper.Response = response
-			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
-			{
-				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
-					Explode: false,
-				}
-				if err := func() error {
-					if err := h.HasParam(cfg); err == nil {
-						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
-							if err := func() error {
-								val, err := d.DecodeValue()
-								if err != nil {
-									return err
-								}
-
-								c, err := conv.ToString(val)
-								if err != nil {
-									return err
-								}
-
-								wrapperDotXTokenVal = c
-								return nil
-							}(); err != nil {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotXTokenVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
 								return err
 							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
-							return nil
-						}); err != nil {
-							return err
-						}
-					}
-					return nil
-				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
-				}
-			}
-			return &wrapper, nil
-		case ct == "text/csv":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := SearchRecordsFieldsOKTextCsv{Data: bytes.NewReader(b)}
-			var wrapper SearchRecordsFieldsOKTextCsvHeaders
-			wrapper.Response = response
-			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
-			{
-				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
-					Explode: false,
-				}
-				if err := func() error {
-					if err := h.HasParam(cfg); err == nil {
-						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
-							if err := func() error {
-								val, err := d.DecodeValue()
-								if err != nil {
-									return err
-								}
-
-								c, err := conv.ToString(val)
-								if err != nil {
-									return err
-								}
 
-								wrapperDotXTokenVal = c
-								return nil
-							}(); err != nil {
+							c, err := conv.ToString(val)
+							if err != nil {
 								return err
 							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+
+							wrapperDotXTokenVal = c
 							return nil
-						}); err != nil {
+						}(); err != nil {
 							return err
 						}
+						wrapper.XToken.SetTo(wrapperDotXTokenVal)
+						return nil
+					}); err != nil {
+						return err
 					}
-					return nil
-				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
 				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse X-Token header")
 			}
-			return &wrapper, nil
-		default:
-			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &SearchRecordsFieldsUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &SearchRecordsFieldsForbidden{}, nil
-	case 404:
-		// Code 404.
+		return &wrapper, nil
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7032,6 +12837,12 @@ func decodeSearchRecordsFieldsResponse(resp *http.Response) (res SearchRecordsFi
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 401:
+		// Code 401.
+		return &StoreConfigUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &StoreConfigForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -7078,7 +12889,7 @@ func decodeSearchRecordsFieldsResponse(resp *http.Response) (res SearchRecordsFi
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSearchTableResponse(resp *http.Response) (res SearchTableRes, _ error) {
+func decodeTriggerExtendResponse(resp *http.Response) (res TriggerExtendRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7094,7 +12905,7 @@ func decodeSearchTableResponse(resp *http.Response) (res SearchTableRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Response
+			var response ResponseRaw
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7112,14 +12923,14 @@ func decodeSearchTableResponse(resp *http.Response) (res SearchTableRes, _ error
 				return res, err
 			}
 			return &response, nil
-		case ct == "text/csv":
+		case ct == "application/octet-stream":
 			reader := resp.Body
 			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
 
-			response := SearchTableOKTextCsv{Data: bytes.NewReader(b)}
+			response := TriggerExtendOKApplicationOctetStream{Data: bytes.NewReader(b)}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -7138,7 +12949,7 @@ func decodeSearchTableResponse(resp *http.Response) (res SearchTableRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SearchTableBadRequest
+			var response TriggerExtendBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7161,10 +12972,10 @@ func decodeSearchTableResponse(resp *http.Response) (res SearchTableRes, _ error
 		}
 	case 401:
 		// Code 401.
-		return &SearchTableUnauthorized{}, nil
+		return &TriggerExtendUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &SearchTableForbidden{}, nil
+		return &TriggerExtendForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -7179,7 +12990,7 @@ func decodeSearchTableResponse(resp *http.Response) (res SearchTableRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SearchTableNotFound
+			var response TriggerExtendNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7246,52 +13057,10 @@ func decodeSearchTableResponse(resp *http.Response) (res SearchTableRes, _ error
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeSetConfigResponse(resp *http.Response) (res SetConfigRes, _ error) {
+func decodeTriggerJobResponse(resp *http.Response) (res TriggerJobRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
-		var wrapper SetConfigOK
-		h := uri.NewHeaderDecoder(resp.Header)
-		// Parse "X-Token" header.
-		{
-			cfg := uri.HeaderParameterDecodingConfig{
-				Name:    "X-Token",
-				Explode: false,
-			}
-			if err := func() error {
-				if err := h.HasParam(cfg); err == nil {
-					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-						var wrapperDotXTokenVal string
-						if err := func() error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
-
-							c, err := conv.ToString(val)
-							if err != nil {
-								return err
-							}
-
-							wrapperDotXTokenVal = c
-							return nil
-						}(); err != nil {
-							return err
-						}
-						wrapper.XToken.SetTo(wrapperDotXTokenVal)
-						return nil
-					}); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "parse X-Token header")
-			}
-		}
-		return &wrapper, nil
-	case 400:
-		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7304,7 +13073,7 @@ func decodeSetConfigResponse(resp *http.Response) (res SetConfigRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response Response
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7325,15 +13094,8 @@ func decodeSetConfigResponse(resp *http.Response) (res SetConfigRes, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &SetConfigUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &SetConfigForbidden{}, nil
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7346,7 +13108,7 @@ func decodeSetConfigResponse(resp *http.Response) (res SetConfigRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response TriggerJobBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7363,27 +13125,18 @@ func decodeSetConfigResponse(resp *http.Response) (res SetConfigRes, _ error) {
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeSetJobsConfigResponse(resp *http.Response) (res SetJobsConfigRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		return &SetJobsConfigOK{}, nil
-	case 400:
-		// Code 400.
+	case 401:
+		// Code 401.
+		return &TriggerJobUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &TriggerJobForbidden{}, nil
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7396,7 +13149,7 @@ func decodeSetJobsConfigResponse(resp *http.Response) (res SetJobsConfigRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response TriggerJobNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7417,12 +13170,6 @@ func decodeSetJobsConfigResponse(resp *http.Response) (res SetJobsConfigRes, _ e
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &SetJobsConfigUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &SetJobsConfigForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -7469,7 +13216,7 @@ func decodeSetJobsConfigResponse(resp *http.Response) (res SetJobsConfigRes, _ e
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeShutdownServerResponse(resp *http.Response) (res ShutdownServerRes, _ error) {
+func decodeUpdateImageHashesResponse(resp *http.Response) (res UpdateImageHashesRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7485,7 +13232,7 @@ func decodeShutdownServerResponse(resp *http.Response) (res ShutdownServerRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StatusResponse
+			var response ImageHashReport
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7502,27 +13249,12 @@ func decodeShutdownServerResponse(resp *http.Response) (res ShutdownServerRes, _
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &ShutdownServerUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &ShutdownServerForbidden{}, nil
-	case 404:
-		// Code 404.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7556,6 +13288,51 @@ func decodeShutdownServerResponse(resp *http.Response) (res ShutdownServerRes, _
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 401:
+		// Code 401.
+		var wrapper UpdateImageHashesUnauthorized
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "Www_authenticate" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "Www_authenticate",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotWwwAuthenticateVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotWwwAuthenticateVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.WwwAuthenticate.SetTo(wrapperDotWwwAuthenticateVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse Www_authenticate header")
+			}
+		}
+		return &wrapper, nil
+	case 403:
+		// Code 403.
+		return &UpdateImageHashesForbidden{}, nil
 	}
 	// Convenient error response.
 	defRes, err := func() (res *ErrorStatusCode, err error) {
@@ -7598,56 +13375,14 @@ func decodeShutdownServerResponse(resp *http.Response) (res ShutdownServerRes, _
 	}()
 	if err != nil {
 		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeStoreConfigResponse(resp *http.Response) (res StoreConfigRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		var wrapper StoreConfigOK
-		h := uri.NewHeaderDecoder(resp.Header)
-		// Parse "X-Token" header.
-		{
-			cfg := uri.HeaderParameterDecodingConfig{
-				Name:    "X-Token",
-				Explode: false,
-			}
-			if err := func() error {
-				if err := h.HasParam(cfg); err == nil {
-					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-						var wrapperDotXTokenVal string
-						if err := func() error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
-
-							c, err := conv.ToString(val)
-							if err != nil {
-								return err
-							}
-
-							wrapperDotXTokenVal = c
-							return nil
-						}(); err != nil {
-							return err
-						}
-						wrapper.XToken.SetTo(wrapperDotXTokenVal)
-						return nil
-					}); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "parse X-Token header")
-			}
-		}
-		return &wrapper, nil
-	case 400:
-		// Code 400.
+	}
+	return res, errors.Wrap(defRes, "error")
+}
+
+func decodeUpdateLobByMapResponse(resp *http.Response) (res UpdateLobByMapRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7660,7 +13395,7 @@ func decodeStoreConfigResponse(resp *http.Response) (res StoreConfigRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response LobUpload
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7681,15 +13416,8 @@ func decodeStoreConfigResponse(resp *http.Response) (res StoreConfigRes, _ error
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &StoreConfigUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &StoreConfigForbidden{}, nil
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7702,7 +13430,7 @@ func decodeStoreConfigResponse(resp *http.Response) (res StoreConfigRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response UpdateLobByMapBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7719,24 +13447,18 @@ func decodeStoreConfigResponse(resp *http.Response) (res StoreConfigRes, _ error
 				}
 				return res, err
 			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeTriggerExtendResponse(resp *http.Response) (res TriggerExtendRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 401:
+		// Code 401.
+		return &UpdateLobByMapUnauthorized{}, nil
+	case 403:
+		// Code 403.
+		return &UpdateLobByMapForbidden{}, nil
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7749,7 +13471,7 @@ func decodeTriggerExtendResponse(resp *http.Response) (res TriggerExtendRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ResponseRaw
+			var response UpdateLobByMapNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7767,20 +13489,11 @@ func decodeTriggerExtendResponse(resp *http.Response) (res TriggerExtendRes, _ e
 				return res, err
 			}
 			return &response, nil
-		case ct == "application/octet-stream":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := TriggerExtendOKApplicationOctetStream{Data: bytes.NewReader(b)}
-			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	case 409:
+		// Code 409.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7793,7 +13506,7 @@ func decodeTriggerExtendResponse(resp *http.Response) (res TriggerExtendRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response TriggerExtendBadRequest
+			var response UpdateLobByMapConflict
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7814,14 +13527,8 @@ func decodeTriggerExtendResponse(resp *http.Response) (res TriggerExtendRes, _ e
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &TriggerExtendUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &TriggerExtendForbidden{}, nil
-	case 404:
-		// Code 404.
+	case 413:
+		// Code 413.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7834,7 +13541,7 @@ func decodeTriggerExtendResponse(resp *http.Response) (res TriggerExtendRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response TriggerExtendNotFound
+			var response UpdateLobByMapRequestEntityTooLarge
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7901,7 +13608,7 @@ func decodeTriggerExtendResponse(resp *http.Response) (res TriggerExtendRes, _ e
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeTriggerJobResponse(resp *http.Response) (res TriggerJobRes, _ error) {
+func decodeUpdateRecordsByFieldsResponse(resp *http.Response) (res UpdateRecordsByFieldsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7934,7 +13641,47 @@ func decodeTriggerJobResponse(resp *http.Response) (res TriggerJobRes, _ error)
 				}
 				return res, err
 			}
-			return &response, nil
+			var wrapper ResponseHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "X-Token" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotXTokenVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotXTokenVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse X-Token header")
+				}
+			}
+			return &wrapper, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -7952,7 +13699,7 @@ func decodeTriggerJobResponse(resp *http.Response) (res TriggerJobRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response TriggerJobBadRequest
+			var response UpdateRecordsByFieldsBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7975,10 +13722,10 @@ func decodeTriggerJobResponse(resp *http.Response) (res TriggerJobRes, _ error)
 		}
 	case 401:
 		// Code 401.
-		return &TriggerJobUnauthorized{}, nil
+		return &UpdateRecordsByFieldsUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &TriggerJobForbidden{}, nil
+		return &UpdateRecordsByFieldsForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -7993,7 +13740,7 @@ func decodeTriggerJobResponse(resp *http.Response) (res TriggerJobRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response TriggerJobNotFound
+			var response UpdateRecordsByFieldsNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8014,56 +13761,8 @@ func decodeTriggerJobResponse(resp *http.Response) (res TriggerJobRes, _ error)
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	// Convenient error response.
-	defRes, err := func() (res *ErrorStatusCode, err error) {
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response Error
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &ErrorStatusCode{
-				StatusCode: resp.StatusCode,
-				Response:   response,
-			}, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}()
-	if err != nil {
-		return res, errors.Wrapf(err, "default (code %d)", resp.StatusCode)
-	}
-	return res, errors.Wrap(defRes, "error")
-}
-
-func decodeUpdateLobByMapResponse(resp *http.Response) (res UpdateLobByMapRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 409:
+		// Code 409.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8076,7 +13775,7 @@ func decodeUpdateLobByMapResponse(resp *http.Response) (res UpdateLobByMapRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response StoreResponse
+			var response UpdateRecordsByFieldsConflict
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8097,14 +13796,8 @@ func decodeUpdateLobByMapResponse(resp *http.Response) (res UpdateLobByMapRes, _
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
-		return &UpdateLobByMapUnauthorized{}, nil
-	case 403:
-		// Code 403.
-		return &UpdateLobByMapForbidden{}, nil
-	case 404:
-		// Code 404.
+	case 412:
+		// Code 412.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8117,7 +13810,7 @@ func decodeUpdateLobByMapResponse(resp *http.Response) (res UpdateLobByMapRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response UpdateRecordsByFieldsPreconditionFailed
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8184,7 +13877,7 @@ func decodeUpdateLobByMapResponse(resp *http.Response) (res UpdateLobByMapRes, _
 	return res, errors.Wrap(defRes, "error")
 }
 
-func decodeUpdateRecordsByFieldsResponse(resp *http.Response) (res UpdateRecordsByFieldsRes, _ error) {
+func decodeUploadChunkResponse(resp *http.Response) (res UploadChunkRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -8200,7 +13893,7 @@ func decodeUpdateRecordsByFieldsResponse(resp *http.Response) (res UpdateRecords
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Response
+			var response UploadStatus
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8217,36 +13910,36 @@ func decodeUpdateRecordsByFieldsResponse(resp *http.Response) (res UpdateRecords
 				}
 				return res, err
 			}
-			var wrapper ResponseHeaders
+			var wrapper GetUploadOKHeaders
 			wrapper.Response = response
 			h := uri.NewHeaderDecoder(resp.Header)
-			// Parse "X-Token" header.
+			// Parse "Upload-Offset" header.
 			{
 				cfg := uri.HeaderParameterDecodingConfig{
-					Name:    "X-Token",
+					Name:    "Upload-Offset",
 					Explode: false,
 				}
 				if err := func() error {
 					if err := h.HasParam(cfg); err == nil {
 						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-							var wrapperDotXTokenVal string
+							var wrapperDotUploadOffsetVal int64
 							if err := func() error {
 								val, err := d.DecodeValue()
 								if err != nil {
 									return err
 								}
 
-								c, err := conv.ToString(val)
+								c, err := conv.ToInt64(val)
 								if err != nil {
 									return err
 								}
 
-								wrapperDotXTokenVal = c
+								wrapperDotUploadOffsetVal = c
 								return nil
 							}(); err != nil {
 								return err
 							}
-							wrapper.XToken.SetTo(wrapperDotXTokenVal)
+							wrapper.UploadOffset.SetTo(wrapperDotUploadOffsetVal)
 							return nil
 						}); err != nil {
 							return err
@@ -8254,19 +13947,54 @@ func decodeUpdateRecordsByFieldsResponse(resp *http.Response) (res UpdateRecords
 					}
 					return nil
 				}(); err != nil {
-					return res, errors.Wrap(err, "parse X-Token header")
+					return res, errors.Wrap(err, "parse Upload-Offset header")
 				}
 			}
 			return &wrapper, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UploadChunkBadRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	case 401:
 		// Code 401.
-		return &UpdateRecordsByFieldsUnauthorized{}, nil
+		return &UploadChunkUnauthorized{}, nil
 	case 403:
 		// Code 403.
-		return &UpdateRecordsByFieldsForbidden{}, nil
+		return &UploadChunkForbidden{}, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -8281,7 +14009,42 @@ func decodeUpdateRecordsByFieldsResponse(resp *http.Response) (res UpdateRecords
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Error
+			var response UploadChunkNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 409:
+		// Code 409.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response UploadChunkConflict
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
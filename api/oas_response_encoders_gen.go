@@ -19,14 +19,12 @@ func encodeAddViewResponse(response AddViewRes, w http.ResponseWriter, span trac
 	switch response := response.(type) {
 	case *AddViewOK:
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -38,13 +36,11 @@ func encodeAddViewResponse(response AddViewRes, w http.ResponseWriter, span trac
 
 	case *AddViewUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *AddViewForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
@@ -53,10 +49,11 @@ func encodeAddViewResponse(response AddViewRes, w http.ResponseWriter, span trac
 	}
 }
 
-func encodeBatchParameterQueryResponse(response BatchParameterQueryRes, w http.ResponseWriter, span trace.Span) error {
+func encodeAggregateRecordsResponse(response AggregateRecordsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *ResponseHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -77,7 +74,6 @@ func encodeBatchParameterQueryResponse(response BatchParameterQueryRes, w http.R
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Response.Encode(e)
@@ -87,22 +83,19 @@ func encodeBatchParameterQueryResponse(response BatchParameterQueryRes, w http.R
 
 		return nil
 
-	case *BatchParameterQueryUnauthorized:
+	case *AggregateRecordsUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *BatchParameterQueryForbidden:
+	case *AggregateRecordsForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -117,10 +110,11 @@ func encodeBatchParameterQueryResponse(response BatchParameterQueryRes, w http.R
 	}
 }
 
-func encodeBatchQueryResponse(response BatchQueryRes, w http.ResponseWriter, span trace.Span) error {
+func encodeBatchParameterQueryResponse(response BatchParameterQueryRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *ResponseHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -141,7 +135,6 @@ func encodeBatchQueryResponse(response BatchQueryRes, w http.ResponseWriter, spa
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Response.Encode(e)
@@ -151,40 +144,77 @@ func encodeBatchQueryResponse(response BatchQueryRes, w http.ResponseWriter, spa
 
 		return nil
 
-	case *BatchQueryUnauthorized:
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
-
-		return nil
+	case *BatchParameterQueryOKApplicationXJSONStreamHeaders:
+		w.Header().Set("Content-Type", "application/x-json-stream")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
 
-	case *BatchQueryForbidden:
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
-	case *Error:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+	case *BatchParameterQueryOKApplicationXNdjsonHeaders:
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeBatchSelectResponse(response BatchSelectRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *ResponseHeaders:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	case *BatchParameterQueryOKTextCsvHeaders:
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -205,32 +235,30 @@ func encodeBatchSelectResponse(response BatchSelectRes, w http.ResponseWriter, s
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		e := new(jx.Encoder)
-		response.Response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *BatchSelectUnauthorized:
+	case *BatchParameterQueryUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *BatchSelectForbidden:
+	case *BatchParameterQueryForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -245,108 +273,155 @@ func encodeBatchSelectResponse(response BatchSelectRes, w http.ResponseWriter, s
 	}
 }
 
-func encodeBrowseListResponse(response BrowseListRes, w http.ResponseWriter, span trace.Span) error {
+func encodeBatchQueryResponse(response BatchQueryRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Directories:
+	case *ResponseHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
-		response.Encode(e)
+		response.Response.Encode(e)
 		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *BrowseListBadRequest:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
+	case *BatchQueryOKApplicationXJSONStreamHeaders:
+		w.Header().Set("Content-Type", "application/x-json-stream")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
 		}
+		w.WriteHeader(200)
 
-		return nil
-
-	case *BrowseListUnauthorized:
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
-
-		return nil
-
-	case *BrowseListForbidden:
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
-
-		return nil
-
-	case *BrowseListNotFound:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeBrowseLocationResponse(response BrowseLocationRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *BrowseLocationOK:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	case *BatchQueryOKApplicationXNdjsonHeaders:
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *BrowseLocationBadRequest:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+	case *BatchQueryOKTextCsvHeaders:
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *BrowseLocationUnauthorized:
+	case *BatchQueryUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *BrowseLocationForbidden:
+	case *BatchQueryForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *BrowseLocationNotFound:
+	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -361,65 +436,155 @@ func encodeBrowseLocationResponse(response BrowseLocationRes, w http.ResponseWri
 	}
 }
 
-func encodeCallExtendResponse(response CallExtendRes, w http.ResponseWriter, span trace.Span) error {
+func encodeBatchSelectResponse(response BatchSelectRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ResponseRaw:
+	case *ResponseHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *BatchSelectOKApplicationXJSONStreamHeaders:
+		w.Header().Set("Content-Type", "application/x-json-stream")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
-
-		return nil
-
-	case *CallExtendOKApplicationOctetStream:
-		w.Header().Set("Content-Type", "application/octet-stream")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
+		if closer, ok := response.Response.Data.(io.Closer); ok {
 			defer closer.Close()
 		}
-		if _, err := io.Copy(writer, response); err != nil {
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *CallExtendBadRequest:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+	case *BatchSelectOKApplicationXNdjsonHeaders:
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *CallExtendUnauthorized:
+	case *BatchSelectOKTextCsvHeaders:
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *BatchSelectUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *CallExtendForbidden:
+	case *BatchSelectForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *CallExtendNotFound:
+	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -434,12 +599,11 @@ func encodeCallExtendResponse(response CallExtendRes, w http.ResponseWriter, spa
 	}
 }
 
-func encodeCallPostExtendResponse(response CallPostExtendRes, w http.ResponseWriter, span trace.Span) error {
+func encodeBrowseListResponse(response BrowseListRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ResponseRaw:
+	case *Directories:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -449,25 +613,9 @@ func encodeCallPostExtendResponse(response CallPostExtendRes, w http.ResponseWri
 
 		return nil
 
-	case *CallPostExtendOKApplicationOctetStream:
-		w.Header().Set("Content-Type", "application/octet-stream")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
-
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response); err != nil {
-			return errors.Wrap(err, "write")
-		}
-
-		return nil
-
-	case *CallPostExtendBadRequest:
+	case *BrowseListBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -477,22 +625,19 @@ func encodeCallPostExtendResponse(response CallPostExtendRes, w http.ResponseWri
 
 		return nil
 
-	case *CallPostExtendUnauthorized:
+	case *BrowseListUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *CallPostExtendForbidden:
+	case *BrowseListForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *CallPostExtendNotFound:
+	case *BrowseListNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -507,12 +652,11 @@ func encodeCallPostExtendResponse(response CallPostExtendRes, w http.ResponseWri
 	}
 }
 
-func encodeCreateDirectoryResponse(response CreateDirectoryRes, w http.ResponseWriter, span trace.Span) error {
+func encodeBrowseLocationResponse(response BrowseLocationRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *StatusResponse:
+	case *BrowseLocationOK:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -522,10 +666,9 @@ func encodeCreateDirectoryResponse(response CreateDirectoryRes, w http.ResponseW
 
 		return nil
 
-	case *CreateDirectoryBadRequest:
+	case *BrowseLocationBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -535,22 +678,19 @@ func encodeCreateDirectoryResponse(response CreateDirectoryRes, w http.ResponseW
 
 		return nil
 
-	case *CreateDirectoryUnauthorized:
+	case *BrowseLocationUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *CreateDirectoryForbidden:
+	case *BrowseLocationForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *CreateDirectoryNotFound:
+	case *BrowseLocationNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -565,12 +705,11 @@ func encodeCreateDirectoryResponse(response CreateDirectoryRes, w http.ResponseW
 	}
 }
 
-func encodeDeleteExtendResponse(response DeleteExtendRes, w http.ResponseWriter, span trace.Span) error {
+func encodeCallExtendResponse(response CallExtendRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *ResponseRaw:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -580,10 +719,9 @@ func encodeDeleteExtendResponse(response DeleteExtendRes, w http.ResponseWriter,
 
 		return nil
 
-	case *DeleteExtendOKApplicationOctetStream:
+	case *CallExtendOKApplicationOctetStream:
 		w.Header().Set("Content-Type", "application/octet-stream")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		writer := w
 		if closer, ok := response.Data.(io.Closer); ok {
@@ -595,10 +733,9 @@ func encodeDeleteExtendResponse(response DeleteExtendRes, w http.ResponseWriter,
 
 		return nil
 
-	case *DeleteExtendBadRequest:
+	case *CallExtendBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -608,22 +745,19 @@ func encodeDeleteExtendResponse(response DeleteExtendRes, w http.ResponseWriter,
 
 		return nil
 
-	case *DeleteExtendUnauthorized:
+	case *CallExtendUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *DeleteExtendForbidden:
+	case *CallExtendForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *DeleteExtendNotFound:
+	case *CallExtendNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -638,12 +772,11 @@ func encodeDeleteExtendResponse(response DeleteExtendRes, w http.ResponseWriter,
 	}
 }
 
-func encodeDeleteFileLocationResponse(response DeleteFileLocationRes, w http.ResponseWriter, span trace.Span) error {
+func encodeCallPostExtendResponse(response CallPostExtendRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *StatusResponse:
+	case *ResponseRaw:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -653,10 +786,23 @@ func encodeDeleteFileLocationResponse(response DeleteFileLocationRes, w http.Res
 
 		return nil
 
-	case *DeleteFileLocationBadRequest:
+	case *CallPostExtendOKApplicationOctetStream:
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *CallPostExtendBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -666,22 +812,19 @@ func encodeDeleteFileLocationResponse(response DeleteFileLocationRes, w http.Res
 
 		return nil
 
-	case *DeleteFileLocationUnauthorized:
+	case *CallPostExtendUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *DeleteFileLocationForbidden:
+	case *CallPostExtendForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *DeleteFileLocationNotFound:
+	case *CallPostExtendNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -696,12 +839,11 @@ func encodeDeleteFileLocationResponse(response DeleteFileLocationRes, w http.Res
 	}
 }
 
-func encodeDeleteJobResultResponse(response DeleteJobResultRes, w http.ResponseWriter, span trace.Span) error {
+func encodeCancelUploadResponse(response CancelUploadRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *JobStatusResponse:
+	case *StatusResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -711,10 +853,50 @@ func encodeDeleteJobResultResponse(response DeleteJobResultRes, w http.ResponseW
 
 		return nil
 
-	case *DeleteJobResultBadRequest:
+	case *CancelUploadUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *CancelUploadForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeCreateDirectoryResponse(response CreateDirectoryRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *StatusResponse:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *CreateDirectoryBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -724,22 +906,19 @@ func encodeDeleteJobResultResponse(response DeleteJobResultRes, w http.ResponseW
 
 		return nil
 
-	case *DeleteJobResultUnauthorized:
+	case *CreateDirectoryUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *DeleteJobResultForbidden:
+	case *CreateDirectoryForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *DeleteJobResultNotFound:
+	case *CreateDirectoryNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -754,31 +933,46 @@ func encodeDeleteJobResultResponse(response DeleteJobResultRes, w http.ResponseW
 	}
 }
 
-func encodeDeleteRecordsSearchedResponse(response DeleteRecordsSearchedRes, w http.ResponseWriter, span trace.Span) error {
+func encodeCreateUploadResponse(response CreateUploadRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ResponseHeaders:
+	case *UploadStatusHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "Location,Upload-Offset")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
-			// Encode "X-Token" header.
+			// Encode "Location" header.
 			{
 				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "X-Token",
+					Name:    "Location",
 					Explode: false,
 				}
 				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					if val, ok := response.XToken.Get(); ok {
+					if val, ok := response.Location.Get(); ok {
 						return e.EncodeValue(conv.StringToString(val))
 					}
 					return nil
 				}); err != nil {
-					return errors.Wrap(err, "encode X-Token header")
+					return errors.Wrap(err, "encode Location header")
 				}
 			}
-		}
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+			// Encode "Upload-Offset" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Upload-Offset",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.UploadOffset.Get(); ok {
+						return e.EncodeValue(conv.Int64ToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Upload-Offset header")
+				}
+			}
+		}
+		w.WriteHeader(201)
 
 		e := new(jx.Encoder)
 		response.Response.Encode(e)
@@ -788,22 +982,31 @@ func encodeDeleteRecordsSearchedResponse(response DeleteRecordsSearchedRes, w ht
 
 		return nil
 
-	case *DeleteRecordsSearchedUnauthorized:
+	case *CreateUploadBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *CreateUploadUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *DeleteRecordsSearchedForbidden:
+	case *CreateUploadForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *Error:
+	case *CreateUploadNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -813,23 +1016,9 @@ func encodeDeleteRecordsSearchedResponse(response DeleteRecordsSearchedRes, w ht
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeDeleteViewResponse(response DeleteViewRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *DeleteViewOK:
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
-
-		return nil
-
-	case *Error:
+	case *CreateUploadConflict:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+		w.WriteHeader(409)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -839,29 +1028,28 @@ func encodeDeleteViewResponse(response DeleteViewRes, w http.ResponseWriter, spa
 
 		return nil
 
-	case *DeleteViewUnauthorized:
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
-
-		return nil
-
-	case *DeleteViewForbidden:
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
-
-		return nil
-
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeDownloadFileResponse(response DownloadFileRes, w http.ResponseWriter, span trace.Span) error {
+func encodeDeleteExtendResponse(response DeleteExtendRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *DownloadFileOK:
+	case *ResponseRaw:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *DeleteExtendOKApplicationOctetStream:
 		w.Header().Set("Content-Type", "application/octet-stream")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		writer := w
 		if closer, ok := response.Data.(io.Closer); ok {
@@ -873,10 +1061,9 @@ func encodeDownloadFileResponse(response DownloadFileRes, w http.ResponseWriter,
 
 		return nil
 
-	case *DownloadFileBadRequest:
+	case *DeleteExtendBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -886,22 +1073,19 @@ func encodeDownloadFileResponse(response DownloadFileRes, w http.ResponseWriter,
 
 		return nil
 
-	case *DownloadFileUnauthorized:
+	case *DeleteExtendUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *DownloadFileForbidden:
+	case *DeleteExtendForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *DownloadFileNotFound:
+	case *DeleteExtendNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -916,12 +1100,11 @@ func encodeDownloadFileResponse(response DownloadFileRes, w http.ResponseWriter,
 	}
 }
 
-func encodeGetConfigResponse(response GetConfigRes, w http.ResponseWriter, span trace.Span) error {
+func encodeDeleteFileLocationResponse(response DeleteFileLocationRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Config:
+	case *StatusResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -931,10 +1114,9 @@ func encodeGetConfigResponse(response GetConfigRes, w http.ResponseWriter, span
 
 		return nil
 
-	case *Error:
+	case *DeleteFileLocationBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -944,15 +1126,25 @@ func encodeGetConfigResponse(response GetConfigRes, w http.ResponseWriter, span
 
 		return nil
 
-	case *GetConfigUnauthorized:
+	case *DeleteFileLocationUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetConfigForbidden:
+	case *DeleteFileLocationForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+
+		return nil
+
+	case *DeleteFileLocationNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
@@ -961,12 +1153,11 @@ func encodeGetConfigResponse(response GetConfigRes, w http.ResponseWriter, span
 	}
 }
 
-func encodeGetDatabasesResponse(response GetDatabasesRes, w http.ResponseWriter, span trace.Span) error {
+func encodeDeleteJobResultResponse(response DeleteJobResultRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Databases:
+	case *JobStatusResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -976,10 +1167,9 @@ func encodeGetDatabasesResponse(response GetDatabasesRes, w http.ResponseWriter,
 
 		return nil
 
-	case *Error:
+	case *DeleteJobResultBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -989,15 +1179,25 @@ func encodeGetDatabasesResponse(response GetDatabasesRes, w http.ResponseWriter,
 
 		return nil
 
-	case *GetDatabasesUnauthorized:
+	case *DeleteJobResultUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetDatabasesForbidden:
+	case *DeleteJobResultForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+
+		return nil
+
+	case *DeleteJobResultNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
@@ -1006,10 +1206,11 @@ func encodeGetDatabasesResponse(response GetDatabasesRes, w http.ResponseWriter,
 	}
 }
 
-func encodeGetFieldsResponse(response GetFieldsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeDeleteRecordByKeyResponse(response DeleteRecordByKeyRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *FieldsHeaders:
+	case *ResponseHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -1030,7 +1231,6 @@ func encodeGetFieldsResponse(response GetFieldsRes, w http.ResponseWriter, span
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Response.Encode(e)
@@ -1040,44 +1240,9 @@ func encodeGetFieldsResponse(response GetFieldsRes, w http.ResponseWriter, span
 
 		return nil
 
-	case *GetFieldsOKTextCsvHeaders:
-		w.Header().Set("Content-Type", "text/csv")
-		// Encoding response headers.
-		{
-			h := uri.NewHeaderEncoder(w.Header())
-			// Encode "X-Token" header.
-			{
-				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "X-Token",
-					Explode: false,
-				}
-				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					if val, ok := response.XToken.Get(); ok {
-						return e.EncodeValue(conv.StringToString(val))
-					}
-					return nil
-				}); err != nil {
-					return errors.Wrap(err, "encode X-Token header")
-				}
-			}
-		}
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
-
-		writer := w
-		if closer, ok := response.Response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response.Response); err != nil {
-			return errors.Wrap(err, "write")
-		}
-
-		return nil
-
-	case *GetFieldsBadRequest:
+	case *DeleteRecordByKeyBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1087,22 +1252,19 @@ func encodeGetFieldsResponse(response GetFieldsRes, w http.ResponseWriter, span
 
 		return nil
 
-	case *GetFieldsUnauthorized:
+	case *DeleteRecordByKeyUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetFieldsForbidden:
+	case *DeleteRecordByKeyForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *GetFieldsNotFound:
+	case *DeleteRecordByKeyNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1117,73 +1279,53 @@ func encodeGetFieldsResponse(response GetFieldsRes, w http.ResponseWriter, span
 	}
 }
 
-func encodeGetImageResponse(response GetImageRes, w http.ResponseWriter, span trace.Span) error {
+func encodeDeleteRecordsSearchedResponse(response DeleteRecordsSearchedRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetImageOKHeaders:
+	case *ResponseHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
-			// Encode "Content-Type" header.
+			// Encode "X-Token" header.
 			{
 				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "Content-Type",
+					Name:    "X-Token",
 					Explode: false,
 				}
 				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					return e.EncodeValue(conv.StringToString(response.ContentType))
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
 				}); err != nil {
-					return errors.Wrap(err, "encode Content-Type header")
+					return errors.Wrap(err, "encode X-Token header")
 				}
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		writer := w
-		if closer, ok := response.Response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response.Response); err != nil {
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetImageUnauthorized:
-		// Encoding response headers.
-		{
-			h := uri.NewHeaderEncoder(w.Header())
-			// Encode "Www_authenticate" header.
-			{
-				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "Www_authenticate",
-					Explode: false,
-				}
-				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					if val, ok := response.WwwAuthenticate.Get(); ok {
-						return e.EncodeValue(conv.StringToString(val))
-					}
-					return nil
-				}); err != nil {
-					return errors.Wrap(err, "encode Www_authenticate header")
-				}
-			}
-		}
+	case *DeleteRecordsSearchedUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetImageForbidden:
+	case *DeleteRecordsSearchedForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1193,17 +1335,9 @@ func encodeGetImageResponse(response GetImageRes, w http.ResponseWriter, span tr
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeGetJobExecutionResultResponse(response GetJobExecutionResultRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *JobResult:
+	case *Response:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		w.WriteHeader(409)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1213,10 +1347,21 @@ func encodeGetJobExecutionResultResponse(response GetJobExecutionResultRes, w ht
 
 		return nil
 
-	case *GetJobExecutionResultBadRequest:
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeDeleteViewResponse(response DeleteViewRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *DeleteViewOK:
+		w.WriteHeader(200)
+
+		return nil
+
+	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1226,22 +1371,62 @@ func encodeGetJobExecutionResultResponse(response GetJobExecutionResultRes, w ht
 
 		return nil
 
-	case *GetJobExecutionResultUnauthorized:
+	case *DeleteViewUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetJobExecutionResultForbidden:
+	case *DeleteViewForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *GetJobExecutionResultNotFound:
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeDownloadFileResponse(response DownloadFileRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *DownloadFileOK:
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *DownloadFileBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *DownloadFileUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *DownloadFileForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *DownloadFileNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1256,25 +1441,43 @@ func encodeGetJobExecutionResultResponse(response GetJobExecutionResultRes, w ht
 	}
 }
 
-func encodeGetJobFullInfoResponse(response GetJobFullInfoRes, w http.ResponseWriter, span trace.Span) error {
+func encodeExecuteTransactionResponse(response ExecuteTransactionRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *JobFull:
+	case *ExecuteTransactionOK:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
-		response.Encode(e)
+		response.Response.Encode(e)
 		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetJobFullInfoBadRequest:
+	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1284,25 +1487,42 @@ func encodeGetJobFullInfoResponse(response GetJobFullInfoRes, w http.ResponseWri
 
 		return nil
 
-	case *GetJobFullInfoUnauthorized:
+	case *ExecuteTransactionUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetJobFullInfoForbidden:
+	case *ExecuteTransactionForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *GetJobFullInfoNotFound:
+	case *ExecuteTransactionUnprocessableEntity:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(422)
 
 		e := new(jx.Encoder)
-		response.Encode(e)
+		response.Response.Encode(e)
 		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
@@ -1314,25 +1534,45 @@ func encodeGetJobFullInfoResponse(response GetJobFullInfoRes, w http.ResponseWri
 	}
 }
 
-func encodeGetJobResultResponse(response GetJobResultRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetArchiveResponse(response GetArchiveRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *JobResult:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	case *GetArchiveOKHeaders:
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Access-Control-Expose-Headers", "Content-Disposition")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Content-Disposition" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Disposition",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ContentDisposition.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Disposition header")
+				}
+			}
+		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetJobResultBadRequest:
+	case *GetArchiveBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1342,22 +1582,39 @@ func encodeGetJobResultResponse(response GetJobResultRes, w http.ResponseWriter,
 
 		return nil
 
-	case *GetJobResultUnauthorized:
+	case *GetArchiveUnauthorized:
+		w.Header().Set("Access-Control-Expose-Headers", "Www_authenticate")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Www_authenticate" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Www_authenticate",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.WwwAuthenticate.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Www_authenticate header")
+				}
+			}
+		}
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetJobResultForbidden:
+	case *GetArchiveForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *GetJobResultNotFound:
+	case *GetArchiveNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1367,17 +1624,9 @@ func encodeGetJobResultResponse(response GetJobResultRes, w http.ResponseWriter,
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeGetJobsResponse(response GetJobsRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *JobsList:
+	case *GetArchiveRequestEntityTooLarge:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		w.WriteHeader(413)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1387,35 +1636,16 @@ func encodeGetJobsResponse(response GetJobsRes, w http.ResponseWriter, span trac
 
 		return nil
 
-	case *GetJobsUnauthorized:
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
-
-		return nil
-
-	case *GetJobsForbidden:
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
-
-		return nil
-
-	case *GetJobsNotFound:
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
-
-		return nil
-
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeGetJobsConfigResponse(response GetJobsConfigRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetConfigResponse(response GetConfigRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *JobStore:
+	case *Config:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1428,7 +1658,6 @@ func encodeGetJobsConfigResponse(response GetJobsConfigRes, w http.ResponseWrite
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1438,15 +1667,13 @@ func encodeGetJobsConfigResponse(response GetJobsConfigRes, w http.ResponseWrite
 
 		return nil
 
-	case *GetJobsConfigUnauthorized:
+	case *GetConfigUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetJobsConfigForbidden:
+	case *GetConfigForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
@@ -1455,39 +1682,23 @@ func encodeGetJobsConfigResponse(response GetJobsConfigRes, w http.ResponseWrite
 	}
 }
 
-func encodeGetLobByMapResponse(response GetLobByMapRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetDatabasesResponse(response GetDatabasesRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetLobByMapOK:
-		w.Header().Set("Content-Type", "application/octet-stream")
+	case *Databases:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response); err != nil {
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetLobByMapUnauthorized:
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
-
-		return nil
-
-	case *GetLobByMapForbidden:
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
-
-		return nil
-
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(400)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1497,15 +1708,26 @@ func encodeGetLobByMapResponse(response GetLobByMapRes, w http.ResponseWriter, s
 
 		return nil
 
+	case *GetDatabasesUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetDatabasesForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeGetLoginSessionResponse(response GetLoginSessionRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetFieldsResponse(response GetFieldsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *AuthorizationTokenHeaders:
+	case *TableMetadataHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -1526,7 +1748,6 @@ func encodeGetLoginSessionResponse(response GetLoginSessionRes, w http.ResponseW
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Response.Encode(e)
@@ -1536,40 +1757,9 @@ func encodeGetLoginSessionResponse(response GetLoginSessionRes, w http.ResponseW
 
 		return nil
 
-	case *GetLoginSessionUnauthorized:
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
-
-		return nil
-
-	case *GetLoginSessionForbidden:
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
-
-		return nil
-
-	case *Error:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
-
-		return nil
-
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeGetMapMetadataResponse(response GetMapMetadataRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *MappingHeaders:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	case *GetFieldsOKTextCsvHeaders:
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -1590,32 +1780,42 @@ func encodeGetMapMetadataResponse(response GetMapMetadataRes, w http.ResponseWri
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetFieldsBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
 
 		e := new(jx.Encoder)
-		response.Response.Encode(e)
+		response.Encode(e)
 		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetMapMetadataUnauthorized:
+	case *GetFieldsUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetMapMetadataForbidden:
+	case *GetFieldsForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *Error:
+	case *GetFieldsNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1630,90 +1830,172 @@ func encodeGetMapMetadataResponse(response GetMapMetadataRes, w http.ResponseWri
 	}
 }
 
-func encodeGetMapRecordsFieldsResponse(response GetMapRecordsFieldsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetImageResponse(response GetImageRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ResponseHeaders:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	case *GetImageOKHeaders:
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
-			// Encode "X-Token" header.
+			// Encode "Content-Type" header.
 			{
 				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "X-Token",
+					Name:    "Content-Type",
 					Explode: false,
 				}
 				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					if val, ok := response.XToken.Get(); ok {
-						return e.EncodeValue(conv.StringToString(val))
-					}
-					return nil
+					return e.EncodeValue(conv.StringToString(response.ContentType))
 				}); err != nil {
-					return errors.Wrap(err, "encode X-Token header")
+					return errors.Wrap(err, "encode Content-Type header")
 				}
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		e := new(jx.Encoder)
-		response.Response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetMapRecordsFieldsOKTextCsvHeaders:
-		w.Header().Set("Content-Type", "text/csv")
+	case *GetImageUnauthorized:
+		w.Header().Set("Access-Control-Expose-Headers", "Www_authenticate")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
-			// Encode "X-Token" header.
+			// Encode "Www_authenticate" header.
 			{
 				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "X-Token",
+					Name:    "Www_authenticate",
 					Explode: false,
 				}
 				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					if val, ok := response.XToken.Get(); ok {
+					if val, ok := response.WwwAuthenticate.Get(); ok {
 						return e.EncodeValue(conv.StringToString(val))
 					}
 					return nil
 				}); err != nil {
-					return errors.Wrap(err, "encode X-Token header")
+					return errors.Wrap(err, "encode Www_authenticate header")
 				}
 			}
 		}
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetImageForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetImageCacheStatsResponse(response GetImageCacheStatsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ImageCacheStats:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		writer := w
-		if closer, ok := response.Response.Data.(io.Closer); ok {
-			defer closer.Close()
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
 		}
-		if _, err := io.Copy(writer, response.Response); err != nil {
+
+		return nil
+
+	case *GetImageCacheStatsUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetImageCacheStatsForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetImageDuplicatesResponse(response GetImageDuplicatesRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ImageDuplicates:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetMapRecordsFieldsUnauthorized:
+	case *GetImageDuplicatesBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetImageDuplicatesUnauthorized:
+		w.Header().Set("Access-Control-Expose-Headers", "Www_authenticate")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Www_authenticate" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Www_authenticate",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.WwwAuthenticate.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Www_authenticate header")
+				}
+			}
+		}
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetMapRecordsFieldsForbidden:
+	case *GetImageDuplicatesForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *Error:
+	case *GetImageDuplicatesRequestEntityTooLarge:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(413)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1728,12 +2010,11 @@ func encodeGetMapRecordsFieldsResponse(response GetMapRecordsFieldsRes, w http.R
 	}
 }
 
-func encodeGetMapsResponse(response GetMapsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetImageHashResponse(response GetImageHashRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Maps:
+	case *ImageHash:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1743,22 +2024,51 @@ func encodeGetMapsResponse(response GetMapsRes, w http.ResponseWriter, span trac
 
 		return nil
 
-	case *GetMapsUnauthorized:
+	case *GetImageHashBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetImageHashUnauthorized:
+		w.Header().Set("Access-Control-Expose-Headers", "Www_authenticate")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Www_authenticate" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Www_authenticate",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.WwwAuthenticate.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Www_authenticate header")
+				}
+			}
+		}
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetMapsForbidden:
+	case *GetImageHashForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *Error:
+	case *GetImageHashNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1773,12 +2083,11 @@ func encodeGetMapsResponse(response GetMapsRes, w http.ResponseWriter, span trac
 	}
 }
 
-func encodeGetUserInfoResponse(response GetUserInfoRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetImageMetadataResponse(response GetImageMetadataRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *User:
+	case *ImageMetadata:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1788,10 +2097,9 @@ func encodeGetUserInfoResponse(response GetUserInfoRes, w http.ResponseWriter, s
 
 		return nil
 
-	case *Error:
+	case *GetImageMetadataBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1801,15 +2109,45 @@ func encodeGetUserInfoResponse(response GetUserInfoRes, w http.ResponseWriter, s
 
 		return nil
 
-	case *GetUserInfoUnauthorized:
+	case *GetImageMetadataUnauthorized:
+		w.Header().Set("Access-Control-Expose-Headers", "Www_authenticate")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Www_authenticate" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Www_authenticate",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.WwwAuthenticate.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Www_authenticate header")
+				}
+			}
+		}
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetUserInfoForbidden:
+	case *GetImageMetadataForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+
+		return nil
+
+	case *GetImageMetadataNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
@@ -1818,12 +2156,11 @@ func encodeGetUserInfoResponse(response GetUserInfoRes, w http.ResponseWriter, s
 	}
 }
 
-func encodeGetVersionResponse(response GetVersionRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetJobExecutionResultResponse(response GetJobExecutionResultRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Versions:
+	case *JobResult:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1833,10 +2170,31 @@ func encodeGetVersionResponse(response GetVersionRes, w http.ResponseWriter, spa
 
 		return nil
 
-	case *Error:
+	case *GetJobExecutionResultBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetJobExecutionResultUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetJobExecutionResultForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *GetJobExecutionResultNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1851,79 +2209,1470 @@ func encodeGetVersionResponse(response GetVersionRes, w http.ResponseWriter, spa
 	}
 }
 
-func encodeGetVideoResponse(response GetVideoRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetJobFullInfoResponse(response GetJobFullInfoRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetVideoOKHeaders:
-		// Encoding response headers.
-		{
-			h := uri.NewHeaderEncoder(w.Header())
-			// Encode "Content-Type" header.
-			{
-				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "Content-Type",
-					Explode: false,
-				}
-				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					return e.EncodeValue(conv.StringToString(response.ContentType))
-				}); err != nil {
-					return errors.Wrap(err, "encode Content-Type header")
-				}
-			}
-		}
+	case *JobFull:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		writer := w
-		if closer, ok := response.Response.Data.(io.Closer); ok {
-			defer closer.Close()
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
 		}
-		if _, err := io.Copy(writer, response.Response); err != nil {
+
+		return nil
+
+	case *GetJobFullInfoBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetVideoUnauthorized:
-		// Encoding response headers.
+	case *GetJobFullInfoUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetJobFullInfoForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *GetJobFullInfoNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetJobResultResponse(response GetJobResultRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *JobResult:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetJobResultBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetJobResultUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetJobResultForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *GetJobResultNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetJobsResponse(response GetJobsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *JobsList:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetJobsUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetJobsForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *GetJobsNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetJobsConfigResponse(response GetJobsConfigRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *JobStore:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetJobsConfigUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetJobsConfigForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetLobByMapResponse(response GetLobByMapRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetLobByMapOKHeaders:
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Access-Control-Expose-Headers", "Accept-Ranges,Etag")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Accept-Ranges" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Accept-Ranges",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.AcceptRanges.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Accept-Ranges header")
+				}
+			}
+			// Encode "Content-Length" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Length",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ContentLength.Get(); ok {
+						return e.EncodeValue(conv.Int64ToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Length header")
+				}
+			}
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetLobByMapPartialContentHeaders:
+		w.Header().Set("Access-Control-Expose-Headers", "Accept-Ranges,Content-Range,Etag")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Accept-Ranges" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Accept-Ranges",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.AcceptRanges.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Accept-Ranges header")
+				}
+			}
+			// Encode "Content-Length" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Length",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ContentLength.Get(); ok {
+						return e.EncodeValue(conv.Int64ToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Length header")
+				}
+			}
+			// Encode "Content-Range" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Range",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ContentRange.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Range header")
+				}
+			}
+			// Encode "Content-Type" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Type",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					return e.EncodeValue(conv.StringToString(response.ContentType))
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Type header")
+				}
+			}
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(206)
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetLobByMapUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetLobByMapForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetLobByMapRequestedRangeNotSatisfiable:
+		w.Header().Set("Access-Control-Expose-Headers", "Content-Range")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Content-Range" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Range",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ContentRange.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Range header")
+				}
+			}
+		}
+		w.WriteHeader(416)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetLoginSessionResponse(response GetLoginSessionRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *AuthorizationTokenHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetLoginSessionUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetLoginSessionForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetMapMetadataResponse(response GetMapMetadataRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *TableMetadataHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetMapMetadataUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetMapMetadataForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetMapRecordsFieldsResponse(response GetMapRecordsFieldsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetMapRecordsFieldsOKApplicationJSONHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "Etag,Link,X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Link" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.Link.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Link header")
+				}
+			}
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetMapRecordsFieldsOKApplicationXJSONStreamHeaders:
+		w.Header().Set("Content-Type", "application/x-json-stream")
+		w.Header().Set("Access-Control-Expose-Headers", "Etag,Link,X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Link" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.Link.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Link header")
+				}
+			}
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetMapRecordsFieldsOKApplicationXNdjsonHeaders:
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Access-Control-Expose-Headers", "Etag,Link,X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Link" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.Link.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Link header")
+				}
+			}
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetMapRecordsFieldsOKTextCsvHeaders:
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Access-Control-Expose-Headers", "Etag,Link,X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Link" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.Link.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Link header")
+				}
+			}
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetMapRecordsFieldsUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetMapRecordsFieldsForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetMapsResponse(response GetMapsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *Maps:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetMapsUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetMapsForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetRecordByKeyResponse(response GetRecordByKeyRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetRecordByKeyOKHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "Etag,X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetRecordByKeyBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetRecordByKeyUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetRecordByKeyForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *GetRecordByKeyNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetRecordHistoryResponse(response GetRecordHistoryRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *RecordHistoryHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetRecordHistoryUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetRecordHistoryForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetUploadResponse(response GetUploadRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetUploadOKHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "Upload-Offset")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Upload-Offset" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Upload-Offset",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.UploadOffset.Get(); ok {
+						return e.EncodeValue(conv.Int64ToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Upload-Offset header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetUploadUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetUploadForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetUserInfoResponse(response GetUserInfoRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *User:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetUserInfoUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetUserInfoForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetVersionResponse(response GetVersionRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *Versions:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetVideoResponse(response GetVideoRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetVideoOKHeaders:
+		w.Header().Set("Access-Control-Expose-Headers", "Accept-Ranges,Etag")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Accept-Ranges" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Accept-Ranges",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.AcceptRanges.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Accept-Ranges header")
+				}
+			}
+			// Encode "Content-Length" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Length",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ContentLength.Get(); ok {
+						return e.EncodeValue(conv.Int64ToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Length header")
+				}
+			}
+			// Encode "Content-Type" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Type",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					return e.EncodeValue(conv.StringToString(response.ContentType))
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Type header")
+				}
+			}
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetVideoPartialContentHeaders:
+		w.Header().Set("Access-Control-Expose-Headers", "Accept-Ranges,Content-Range,Etag")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Accept-Ranges" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Accept-Ranges",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.AcceptRanges.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Accept-Ranges header")
+				}
+			}
+			// Encode "Content-Length" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Length",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ContentLength.Get(); ok {
+						return e.EncodeValue(conv.Int64ToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Length header")
+				}
+			}
+			// Encode "Content-Range" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Range",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ContentRange.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Range header")
+				}
+			}
+			// Encode "Content-Type" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Type",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					return e.EncodeValue(conv.StringToString(response.ContentType))
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Type header")
+				}
+			}
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(206)
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetVideoUnauthorized:
+		w.Header().Set("Access-Control-Expose-Headers", "Www_authenticate")
+		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
 			// Encode "Www_authenticate" header.
 			{
 				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "Www_authenticate",
+					Name:    "Www_authenticate",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.WwwAuthenticate.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Www_authenticate header")
+				}
+			}
+		}
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetVideoForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetVideoRequestedRangeNotSatisfiable:
+		w.Header().Set("Access-Control-Expose-Headers", "Content-Range")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Content-Range" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Content-Range",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ContentRange.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Content-Range header")
+				}
+			}
+		}
+		w.WriteHeader(416)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetViewsResponse(response GetViewsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetViewsOK:
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetViewsUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetViewsForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeHeadMapRecordsFieldsResponse(response HeadMapRecordsFieldsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *HeadMapRecordsFieldsOK:
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token,X-Total-Count")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+			// Encode "X-Total-Count" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Total-Count",
 					Explode: false,
 				}
 				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					if val, ok := response.WwwAuthenticate.Get(); ok {
-						return e.EncodeValue(conv.StringToString(val))
+					if val, ok := response.XTotalCount.Get(); ok {
+						return e.EncodeValue(conv.Int64ToString(val))
 					}
 					return nil
 				}); err != nil {
-					return errors.Wrap(err, "encode Www_authenticate header")
+					return errors.Wrap(err, "encode X-Total-Count header")
 				}
 			}
 		}
+		w.WriteHeader(200)
+
+		return nil
+
+	case *HeadMapRecordsFieldsUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetVideoForbidden:
+	case *HeadMapRecordsFieldsForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *Error:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	case *HeadMapRecordsFieldsNotFound:
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
 
 		return nil
 
@@ -1932,9 +3681,11 @@ func encodeGetVideoResponse(response GetVideoRes, w http.ResponseWriter, span tr
 	}
 }
 
-func encodeGetViewsResponse(response GetViewsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeImportRecordsResponse(response ImportRecordsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetViewsOK:
+	case *ImportReportHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -1955,14 +3706,18 @@ func encodeGetViewsResponse(response GetViewsRes, w http.ResponseWriter, span tr
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1972,15 +3727,13 @@ func encodeGetViewsResponse(response GetViewsRes, w http.ResponseWriter, span tr
 
 		return nil
 
-	case *GetViewsUnauthorized:
+	case *ImportRecordsUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
-	case *GetViewsForbidden:
+	case *ImportRecordsForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
@@ -1993,6 +3746,7 @@ func encodeInsertMapFileRecordsResponse(response InsertMapFileRecordsRes, w http
 	switch response := response.(type) {
 	case *StoreResponseHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -2013,7 +3767,6 @@ func encodeInsertMapFileRecordsResponse(response InsertMapFileRecordsRes, w http
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Response.Encode(e)
@@ -2026,7 +3779,6 @@ func encodeInsertMapFileRecordsResponse(response InsertMapFileRecordsRes, w http
 	case *InsertMapFileRecordsBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2038,20 +3790,17 @@ func encodeInsertMapFileRecordsResponse(response InsertMapFileRecordsRes, w http
 
 	case *InsertMapFileRecordsUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *InsertMapFileRecordsForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *InsertMapFileRecordsNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2070,6 +3819,7 @@ func encodeInsertRecordResponse(response InsertRecordRes, w http.ResponseWriter,
 	switch response := response.(type) {
 	case *ResponseHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -2090,7 +3840,6 @@ func encodeInsertRecordResponse(response InsertRecordRes, w http.ResponseWriter,
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Response.Encode(e)
@@ -2102,26 +3851,22 @@ func encodeInsertRecordResponse(response InsertRecordRes, w http.ResponseWriter,
 
 	case *InsertRecordBadRequest:
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		return nil
 
 	case *InsertRecordUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *InsertRecordForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2138,10 +3883,9 @@ func encodeInsertRecordResponse(response InsertRecordRes, w http.ResponseWriter,
 
 func encodeListModellingResponse(response ListModellingRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Maps:
+	case *TablesMetadata:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2154,7 +3898,6 @@ func encodeListModellingResponse(response ListModellingRes, w http.ResponseWrite
 	case *ListModellingOKTextCsv:
 		w.Header().Set("Content-Type", "text/csv")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		writer := w
 		if closer, ok := response.Data.(io.Closer); ok {
@@ -2169,7 +3912,6 @@ func encodeListModellingResponse(response ListModellingRes, w http.ResponseWrite
 	case *ListModellingBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2181,20 +3923,17 @@ func encodeListModellingResponse(response ListModellingRes, w http.ResponseWrite
 
 	case *ListModellingUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *ListModellingForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *ListModellingNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2211,10 +3950,9 @@ func encodeListModellingResponse(response ListModellingRes, w http.ResponseWrite
 
 func encodeListTablesResponse(response ListTablesRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Maps:
+	case *TablesMetadata:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2227,7 +3965,6 @@ func encodeListTablesResponse(response ListTablesRes, w http.ResponseWriter, spa
 	case *ListTablesOKTextCsv:
 		w.Header().Set("Content-Type", "text/csv")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		writer := w
 		if closer, ok := response.Data.(io.Closer); ok {
@@ -2242,7 +3979,6 @@ func encodeListTablesResponse(response ListTablesRes, w http.ResponseWriter, spa
 	case *ListTablesBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2254,20 +3990,17 @@ func encodeListTablesResponse(response ListTablesRes, w http.ResponseWriter, spa
 
 	case *ListTablesUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *ListTablesForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *ListTablesNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2286,6 +4019,7 @@ func encodeLoginSessionResponse(response LoginSessionRes, w http.ResponseWriter,
 	switch response := response.(type) {
 	case *AuthorizationTokenHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -2306,7 +4040,6 @@ func encodeLoginSessionResponse(response LoginSessionRes, w http.ResponseWriter,
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Response.Encode(e)
@@ -2318,20 +4051,17 @@ func encodeLoginSessionResponse(response LoginSessionRes, w http.ResponseWriter,
 
 	case *LoginSessionUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *LoginSessionForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2350,14 +4080,12 @@ func encodeLogoutSessionCompatResponse(response LogoutSessionCompatRes, w http.R
 	switch response := response.(type) {
 	case *LogoutSessionCompatOK:
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		return nil
 
 	case *LogoutSessionCompatBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2370,7 +4098,106 @@ func encodeLogoutSessionCompatResponse(response LogoutSessionCompatRes, w http.R
 	case *LogoutSessionCompatNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodePatchRecordByKeyResponse(response PatchRecordByKeyRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetRecordByKeyOKHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "Etag,X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *PatchRecordByKeyBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *PatchRecordByKeyUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *PatchRecordByKeyForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *PatchRecordByKeyNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(412)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2390,7 +4217,6 @@ func encodePostDatabaseResponse(response PostDatabaseRes, w http.ResponseWriter,
 	case *StatusResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2403,7 +4229,6 @@ func encodePostDatabaseResponse(response PostDatabaseRes, w http.ResponseWriter,
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2415,13 +4240,11 @@ func encodePostDatabaseResponse(response PostDatabaseRes, w http.ResponseWriter,
 
 	case *PostDatabaseUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *PostDatabaseForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
@@ -2435,7 +4258,6 @@ func encodePostJobResponse(response PostJobRes, w http.ResponseWriter, span trac
 	case *StatusResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2448,7 +4270,6 @@ func encodePostJobResponse(response PostJobRes, w http.ResponseWriter, span trac
 	case *PostJobBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2460,20 +4281,17 @@ func encodePostJobResponse(response PostJobRes, w http.ResponseWriter, span trac
 
 	case *PostJobUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *PostJobForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *PostJobNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2488,10 +4306,40 @@ func encodePostJobResponse(response PostJobRes, w http.ResponseWriter, span trac
 	}
 }
 
+func encodePurgeImageCacheResponse(response PurgeImageCacheRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ImageCacheStats:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *PurgeImageCacheUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *PurgeImageCacheForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
 func encodePushLoginSessionResponse(response PushLoginSessionRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *AuthorizationTokenHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -2512,7 +4360,6 @@ func encodePushLoginSessionResponse(response PushLoginSessionRes, w http.Respons
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Response.Encode(e)
@@ -2524,20 +4371,17 @@ func encodePushLoginSessionResponse(response PushLoginSessionRes, w http.Respons
 
 	case *PushLoginSessionUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *PushLoginSessionForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2556,14 +4400,12 @@ func encodeRemoveSessionCompatResponse(response RemoveSessionCompatRes, w http.R
 	switch response := response.(type) {
 	case *RemoveSessionCompatOK:
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		return nil
 
 	case *RemoveSessionCompatBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2576,7 +4418,6 @@ func encodeRemoveSessionCompatResponse(response RemoveSessionCompatRes, w http.R
 	case *RemoveSessionCompatNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2596,7 +4437,6 @@ func encodeSearchModellingResponse(response SearchModellingRes, w http.ResponseW
 	case *Response:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2609,7 +4449,6 @@ func encodeSearchModellingResponse(response SearchModellingRes, w http.ResponseW
 	case *SearchModellingOKTextCsv:
 		w.Header().Set("Content-Type", "text/csv")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		writer := w
 		if closer, ok := response.Data.(io.Closer); ok {
@@ -2624,7 +4463,6 @@ func encodeSearchModellingResponse(response SearchModellingRes, w http.ResponseW
 	case *SearchModellingBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2636,20 +4474,17 @@ func encodeSearchModellingResponse(response SearchModellingRes, w http.ResponseW
 
 	case *SearchModellingUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *SearchModellingForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *SearchModellingNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2659,18 +4494,175 @@ func encodeSearchModellingResponse(response SearchModellingRes, w http.ResponseW
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeSearchRecordsFieldsResponse(response SearchRecordsFieldsRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *ResponseHeaders:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeSearchRecordsFieldsResponse(response SearchRecordsFieldsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetMapRecordsFieldsOKApplicationJSONHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "Etag,Link,X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Link" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.Link.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Link header")
+				}
+			}
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *SearchRecordsFieldsOKApplicationXJSONStreamHeaders:
+		w.Header().Set("Content-Type", "application/x-json-stream")
+		w.Header().Set("Access-Control-Expose-Headers", "Etag,Link,X-Token")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Link" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.Link.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Link header")
+				}
+			}
+			// Encode "X-Token" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Token",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XToken.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Token header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *SearchRecordsFieldsOKApplicationXNdjsonHeaders:
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Access-Control-Expose-Headers", "Etag,Link,X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Link" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.Link.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Link header")
+				}
+			}
 			// Encode "X-Token" header.
 			{
 				cfg := uri.HeaderParameterEncodingConfig{
@@ -2688,11 +4680,12 @@ func encodeSearchRecordsFieldsResponse(response SearchRecordsFieldsRes, w http.R
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		e := new(jx.Encoder)
-		response.Response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
@@ -2700,9 +4693,40 @@ func encodeSearchRecordsFieldsResponse(response SearchRecordsFieldsRes, w http.R
 
 	case *SearchRecordsFieldsOKTextCsvHeaders:
 		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Access-Control-Expose-Headers", "Etag,Link,X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Link" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Link",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.Link.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Link header")
+				}
+			}
 			// Encode "X-Token" header.
 			{
 				cfg := uri.HeaderParameterEncodingConfig{
@@ -2720,7 +4744,6 @@ func encodeSearchRecordsFieldsResponse(response SearchRecordsFieldsRes, w http.R
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		writer := w
 		if closer, ok := response.Response.Data.(io.Closer); ok {
@@ -2734,20 +4757,17 @@ func encodeSearchRecordsFieldsResponse(response SearchRecordsFieldsRes, w http.R
 
 	case *SearchRecordsFieldsUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *SearchRecordsFieldsForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2767,7 +4787,6 @@ func encodeSearchTableResponse(response SearchTableRes, w http.ResponseWriter, s
 	case *Response:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2780,7 +4799,6 @@ func encodeSearchTableResponse(response SearchTableRes, w http.ResponseWriter, s
 	case *SearchTableOKTextCsv:
 		w.Header().Set("Content-Type", "text/csv")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		writer := w
 		if closer, ok := response.Data.(io.Closer); ok {
@@ -2795,7 +4813,6 @@ func encodeSearchTableResponse(response SearchTableRes, w http.ResponseWriter, s
 	case *SearchTableBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2807,20 +4824,17 @@ func encodeSearchTableResponse(response SearchTableRes, w http.ResponseWriter, s
 
 	case *SearchTableUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *SearchTableForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *SearchTableNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2838,6 +4852,7 @@ func encodeSearchTableResponse(response SearchTableRes, w http.ResponseWriter, s
 func encodeSetConfigResponse(response SetConfigRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *SetConfigOK:
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -2858,14 +4873,12 @@ func encodeSetConfigResponse(response SetConfigRes, w http.ResponseWriter, span
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2877,13 +4890,11 @@ func encodeSetConfigResponse(response SetConfigRes, w http.ResponseWriter, span
 
 	case *SetConfigUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *SetConfigForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
@@ -2896,14 +4907,12 @@ func encodeSetJobsConfigResponse(response SetJobsConfigRes, w http.ResponseWrite
 	switch response := response.(type) {
 	case *SetJobsConfigOK:
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2915,13 +4924,11 @@ func encodeSetJobsConfigResponse(response SetJobsConfigRes, w http.ResponseWrite
 
 	case *SetJobsConfigUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *SetJobsConfigForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
@@ -2935,7 +4942,6 @@ func encodeShutdownServerResponse(response ShutdownServerRes, w http.ResponseWri
 	case *StatusResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2947,20 +4953,17 @@ func encodeShutdownServerResponse(response ShutdownServerRes, w http.ResponseWri
 
 	case *ShutdownServerUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *ShutdownServerForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2978,6 +4981,7 @@ func encodeShutdownServerResponse(response ShutdownServerRes, w http.ResponseWri
 func encodeStoreConfigResponse(response StoreConfigRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *StoreConfigOK:
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -2998,14 +5002,12 @@ func encodeStoreConfigResponse(response StoreConfigRes, w http.ResponseWriter, s
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		return nil
 
 	case *Error:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3017,13 +5019,11 @@ func encodeStoreConfigResponse(response StoreConfigRes, w http.ResponseWriter, s
 
 	case *StoreConfigUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *StoreConfigForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
@@ -3037,7 +5037,6 @@ func encodeTriggerExtendResponse(response TriggerExtendRes, w http.ResponseWrite
 	case *ResponseRaw:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3050,7 +5049,6 @@ func encodeTriggerExtendResponse(response TriggerExtendRes, w http.ResponseWrite
 	case *TriggerExtendOKApplicationOctetStream:
 		w.Header().Set("Content-Type", "application/octet-stream")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		writer := w
 		if closer, ok := response.Data.(io.Closer); ok {
@@ -3065,7 +5063,6 @@ func encodeTriggerExtendResponse(response TriggerExtendRes, w http.ResponseWrite
 	case *TriggerExtendBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3077,20 +5074,17 @@ func encodeTriggerExtendResponse(response TriggerExtendRes, w http.ResponseWrite
 
 	case *TriggerExtendUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *TriggerExtendForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *TriggerExtendNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3110,7 +5104,6 @@ func encodeTriggerJobResponse(response TriggerJobRes, w http.ResponseWriter, spa
 	case *Response:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3123,7 +5116,6 @@ func encodeTriggerJobResponse(response TriggerJobRes, w http.ResponseWriter, spa
 	case *TriggerJobBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3135,20 +5127,17 @@ func encodeTriggerJobResponse(response TriggerJobRes, w http.ResponseWriter, spa
 
 	case *TriggerJobUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *TriggerJobForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *TriggerJobNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3163,12 +5152,84 @@ func encodeTriggerJobResponse(response TriggerJobRes, w http.ResponseWriter, spa
 	}
 }
 
+func encodeUpdateImageHashesResponse(response UpdateImageHashesRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ImageHashReport:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateImageHashesUnauthorized:
+		w.Header().Set("Access-Control-Expose-Headers", "Www_authenticate")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Www_authenticate" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Www_authenticate",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.WwwAuthenticate.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Www_authenticate header")
+				}
+			}
+		}
+		w.WriteHeader(401)
+
+		return nil
+
+	case *UpdateImageHashesForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
 func encodeUpdateLobByMapResponse(response UpdateLobByMapRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *StoreResponse:
+	case *LobUpload:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateLobByMapBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3180,20 +5241,41 @@ func encodeUpdateLobByMapResponse(response UpdateLobByMapRes, w http.ResponseWri
 
 	case *UpdateLobByMapUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *UpdateLobByMapForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *Error:
+	case *UpdateLobByMapNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateLobByMapConflict:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(409)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateLobByMapRequestEntityTooLarge:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(413)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3212,6 +5294,7 @@ func encodeUpdateRecordsByFieldsResponse(response UpdateRecordsByFieldsRes, w ht
 	switch response := response.(type) {
 	case *ResponseHeaders:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Token")
 		// Encoding response headers.
 		{
 			h := uri.NewHeaderEncoder(w.Header())
@@ -3232,7 +5315,6 @@ func encodeUpdateRecordsByFieldsResponse(response UpdateRecordsByFieldsRes, w ht
 			}
 		}
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Response.Encode(e)
@@ -3242,22 +5324,140 @@ func encodeUpdateRecordsByFieldsResponse(response UpdateRecordsByFieldsRes, w ht
 
 		return nil
 
+	case *UpdateRecordsByFieldsBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
 	case *UpdateRecordsByFieldsUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *UpdateRecordsByFieldsForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
-	case *Error:
+	case *UpdateRecordsByFieldsNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateRecordsByFieldsConflict:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(409)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateRecordsByFieldsPreconditionFailed:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(412)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeUploadChunkResponse(response UploadChunkRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetUploadOKHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "Upload-Offset")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Upload-Offset" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Upload-Offset",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.UploadOffset.Get(); ok {
+						return e.EncodeValue(conv.Int64ToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Upload-Offset header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UploadChunkBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UploadChunkUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *UploadChunkForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *UploadChunkNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UploadChunkConflict:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(409)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3277,7 +5477,6 @@ func encodeUploadFileResponse(response UploadFileRes, w http.ResponseWriter, spa
 	case *StatusResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3290,7 +5489,6 @@ func encodeUploadFileResponse(response UploadFileRes, w http.ResponseWriter, spa
 	case *UploadFileOKTextPlain:
 		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		writer := w
 		if closer, ok := response.Data.(io.Closer); ok {
@@ -3305,7 +5503,6 @@ func encodeUploadFileResponse(response UploadFileRes, w http.ResponseWriter, spa
 	case *UploadFileBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3317,20 +5514,17 @@ func encodeUploadFileResponse(response UploadFileRes, w http.ResponseWriter, spa
 
 	case *UploadFileUnauthorized:
 		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
 
 		return nil
 
 	case *UploadFileForbidden:
 		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
 
 		return nil
 
 	case *UploadFileNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3353,10 +5547,8 @@ func encodeErrorResponse(response *ErrorStatusCode, w http.ResponseWriter, span
 		code = http.StatusOK
 	}
 	w.WriteHeader(code)
-	if st := http.StatusText(code); code >= http.StatusBadRequest {
-		span.SetStatus(codes.Error, st)
-	} else {
-		span.SetStatus(codes.Ok, st)
+	if code >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(code))
 	}
 
 	e := new(jx.Encoder)
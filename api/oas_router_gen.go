@@ -10,6 +10,169 @@ import (
 	"github.com/ogen-go/ogen/uri"
 )
 
+var (
+	rn38AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn69AllowedHeaders = map[string]string{
+		"GET": "Authorization,If-Range,Range,X-Tokencheck",
+		"PUT": "Authorization,Content-Type,X-Tokencheck",
+	}
+	rn39AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Tokencheck",
+		"POST": "Authorization,X-Tokencheck",
+		"PUT":  "Authorization,Content-Type,X-Tokencheck",
+	}
+	rn52AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Tokencheck",
+		"GET":    "Authorization,X-Tokencheck",
+	}
+	rn63AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+		"PUT": "Authorization,Content-Type,X-Tokencheck",
+	}
+	rn1AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Tokencheck",
+		"GET":    "Authorization,X-Tokencheck",
+		"POST":   "Authorization,X-Tokencheck",
+	}
+	rn56AllowedHeaders = map[string]string{
+		"PUT": "Authorization,X-Tokencheck",
+	}
+	rn57AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn50AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn58AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn60AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn70AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Tokencheck",
+		"POST": "Authorization,X-Tokencheck",
+		"PUT":  "Authorization,X-Tokencheck",
+	}
+	rn98AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn96AllowedHeaders = map[string]string{
+		"PUT": "Authorization,X-Tokencheck",
+	}
+	rn4AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn7AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Tokencheck",
+		"POST": "Authorization,Content-Type,X-Tokencheck",
+	}
+	rn9AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn40AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Tokencheck",
+		"POST": "Authorization,Content-Type,X-Tokencheck",
+	}
+	rn14AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Tokencheck",
+		"GET":    "Authorization,X-Tokencheck",
+		"POST":   "Authorization,Content-Type,X-Tokencheck",
+		"PUT":    "Authorization,X-Tokencheck",
+	}
+	rn10AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn12AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn18AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Tokencheck",
+		"GET":    "Authorization,X-Tokencheck",
+		"POST":   "Authorization,Content-Type,X-Tokencheck",
+		"PUT":    "Authorization,X-Tokencheck",
+	}
+	rn79AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn91AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type,X-Tokencheck",
+	}
+	rn93AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn100AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn72AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn106AllowedHeaders = map[string]string{
+		"PUT": "Authorization,X-Tokencheck",
+	}
+	rn94AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn44AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn104AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn32AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type,X-Tokencheck",
+	}
+	rn21AllowedHeaders = map[string]string{
+		"POST": "Authorization,Upload-Checksum,Upload-Length,X-Tokencheck",
+	}
+	rn16AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Tokencheck",
+		"GET":    "Authorization,X-Tokencheck",
+		"PATCH":  "Authorization,Content-Type,Upload-Checksum,Upload-Offset,X-Tokencheck",
+	}
+	rn75AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Tokencheck",
+		"POST": "Authorization,Content-Type,X-Tokencheck",
+	}
+	rn27AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type,X-Tokencheck",
+	}
+	rn29AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Tokencheck",
+		"GET":    "Authorization,X-Tokencheck",
+		"PATCH":  "Authorization,Content-Type,If-Match,X-Tokencheck",
+	}
+	rn31AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Tokencheck",
+		"GET":    "Authorization,X-Tokencheck",
+		"PUT":    "Authorization,Content-Type,If-Match,X-Tokencheck",
+	}
+	rn74AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Tokencheck",
+		"HEAD": "Authorization,X-Tokencheck",
+	}
+	rn62AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Tokencheck",
+		"POST": "Authorization,Content-Type,X-Tokencheck",
+	}
+	rn61AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+	}
+	rn23AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Tokencheck",
+		"PUT": "Authorization,X-Tokencheck",
+	}
+	rn25AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Tokencheck",
+		"GET":    "Authorization,X-Tokencheck",
+	}
+	rn89AllowedHeaders = map[string]string{
+		"GET": "Authorization,If-Range,Range,X-Tokencheck",
+	}
+)
+
 func (s *Server) cutPrefix(path string) (string, bool) {
 	prefix := s.cfg.Prefix
 	if prefix == "" {
@@ -61,6 +224,90 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
+			case 'a': // Prefix: "archive/"
+
+				if l := len("archive/"); len(elem) >= l && elem[0:l] == "archive/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "table"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "field"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[1] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "search"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[2] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetArchiveRequest([3]string{
+									args[0],
+									args[1],
+									args[2],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn38AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					}
+
+				}
+
 			case 'b': // Prefix: "binary/"
 
 				if l := len("binary/"); len(elem) >= l && elem[0:l] == "binary/" {
@@ -136,7 +383,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									args[2],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET,PUT")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET,PUT",
+									allowedHeaders: rn69AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -163,7 +415,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					case "PUT":
 						s.handleSetConfigRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET,POST,PUT")
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET,POST,PUT",
+							allowedHeaders: rn39AllowedHeaders,
+							acceptPost:     "",
+							acceptPatch:    "",
+						})
 					}
 
 					return
@@ -181,6 +438,33 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
+					case 'i': // Prefix: "images/cache"
+
+						if l := len("images/cache"); len(elem) >= l && elem[0:l] == "images/cache" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handlePurgeImageCacheRequest([0]string{}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetImageCacheStatsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "DELETE,GET",
+									allowedHeaders: rn52AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
 					case 'j': // Prefix: "jobs"
 
 						if l := len("jobs"); len(elem) >= l && elem[0:l] == "jobs" {
@@ -197,7 +481,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							case "PUT":
 								s.handleSetJobsConfigRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET,PUT")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET,PUT",
+									allowedHeaders: rn63AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -221,7 +510,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							case "POST":
 								s.handleAddViewRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "DELETE,GET,POST")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "DELETE,GET,POST",
+									allowedHeaders: rn1AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -231,9 +525,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				}
 
-			case 'i': // Prefix: "image/"
+			case 'h': // Prefix: "hash/"
 
-				if l := len("image/"); len(elem) >= l && elem[0:l] == "image/" {
+				if l := len("hash/"); len(elem) >= l && elem[0:l] == "hash/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -260,7 +554,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 
-					// Param: "field"
+					// Param: "hashField"
 					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
 					if idx < 0 {
@@ -270,37 +564,47 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						break
+						switch r.Method {
+						case "PUT":
+							s.handleUpdateImageHashesRequest([2]string{
+								args[0],
+								args[1],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "PUT",
+								allowedHeaders: rn56AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case '/': // Prefix: "/duplicates"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("/duplicates"); len(elem) >= l && elem[0:l] == "/duplicates" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "search"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[2] = elem
-						elem = ""
-
 						if len(elem) == 0 {
 							// Leaf node.
 							switch r.Method {
 							case "GET":
-								s.handleGetImageRequest([3]string{
+								s.handleGetImageDuplicatesRequest([2]string{
 									args[0],
 									args[1],
-									args[2],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn57AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -310,93 +614,265 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				}
 
-			case 'l': // Prefix: "log"
+			case 'i': // Prefix: "image/"
 
-				if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+				if l := len("image/"); len(elem) >= l && elem[0:l] == "image/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
+				// Param: "table"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
 				if len(elem) == 0 {
 					break
 				}
 				switch elem[0] {
-				case 'i': // Prefix: "in"
+				case '/': // Prefix: "/"
 
-					if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetLoginSessionRequest([0]string{}, elemIsEscaped, w, r)
-						case "POST":
-							s.handlePushLoginSessionRequest([0]string{}, elemIsEscaped, w, r)
-						case "PUT":
-							s.handleLoginSessionRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET,POST,PUT")
-						}
-
-						return
-					}
-
-				case 'o': // Prefix: "o"
-
-					if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
-						elem = elem[l:]
-					} else {
-						break
+					// Param: "field"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
 					}
+					args[1] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
 						break
 					}
 					switch elem[0] {
-					case 'f': // Prefix: "ff"
+					case '/': // Prefix: "/"
 
-						if l := len("ff"); len(elem) >= l && elem[0:l] == "ff" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "search"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[2] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
 							case "GET":
-								s.handleRemoveSessionCompatRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleGetImageRequest([3]string{
+									args[0],
+									args[1],
+									args[2],
+								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn50AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-					case 'u': // Prefix: "ut"
-
-						if l := len("ut"); len(elem) >= l && elem[0:l] == "ut" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "PUT":
-								s.handleLogoutSessionCompatRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "PUT")
+							if len(elem) == 0 {
+								break
 							}
+							switch elem[0] {
+							case 'h': // Prefix: "hash"
 
-							return
-						}
+								if l := len("hash"); len(elem) >= l && elem[0:l] == "hash" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetImageHashRequest([3]string{
+											args[0],
+											args[1],
+											args[2],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn58AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'm': // Prefix: "metadata"
+
+								if l := len("metadata"); len(elem) >= l && elem[0:l] == "metadata" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetImageMetadataRequest([3]string{
+											args[0],
+											args[1],
+											args[2],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn60AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'l': // Prefix: "log"
+
+				if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'i': // Prefix: "in"
+
+					if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetLoginSessionRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handlePushLoginSessionRequest([0]string{}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleLoginSessionRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET,POST,PUT",
+								allowedHeaders: rn70AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				case 'o': // Prefix: "o"
+
+					if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'f': // Prefix: "ff"
+
+						if l := len("ff"); len(elem) >= l && elem[0:l] == "ff" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleRemoveSessionCompatRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn98AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					case 'u': // Prefix: "ut"
+
+						if l := len("ut"); len(elem) >= l && elem[0:l] == "ut" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "PUT":
+								s.handleLogoutSessionCompatRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "PUT",
+									allowedHeaders: rn96AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
 
 					}
 
@@ -414,6 +890,42 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
+				case 'a': // Prefix: "aggregate/"
+
+					if l := len("aggregate/"); len(elem) >= l && elem[0:l] == "aggregate/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "table"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAggregateRecordsRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET",
+								allowedHeaders: rn4AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
 				case 'b': // Prefix: "batch/"
 
 					if l := len("batch/"); len(elem) >= l && elem[0:l] == "batch/" {
@@ -442,7 +954,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET,POST")
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET,POST",
+								allowedHeaders: rn7AllowedHeaders,
+								acceptPost:     "application/json,text/plain",
+								acceptPatch:    "",
+							})
 						}
 
 						return
@@ -474,7 +991,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									args[1],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn9AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -498,7 +1020,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						case "POST":
 							s.handlePostDatabaseRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET,POST")
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET,POST",
+								allowedHeaders: rn40AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
 						}
 
 						return
@@ -541,7 +1068,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE,GET,POST,PUT")
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "DELETE,GET,POST,PUT",
+								allowedHeaders: rn14AllowedHeaders,
+								acceptPost:     "multipart/form-data",
+								acceptPatch:    "",
+							})
 						}
 
 						return
@@ -572,7 +1104,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							case "GET":
 								s.handleBrowseListRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn10AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -603,7 +1140,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn12AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
 								}
 
 								return
@@ -642,7 +1184,108 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE,GET,POST,PUT")
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "DELETE,GET,POST,PUT",
+								allowedHeaders: rn18AllowedHeaders,
+								acceptPost:     "multipart/form-data",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				case 'h': // Prefix: "history/"
+
+					if l := len("history/"); len(elem) >= l && elem[0:l] == "history/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "table"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "key"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[1] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetRecordHistoryRequest([2]string{
+									args[0],
+									args[1],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn79AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					}
+
+				case 'i': // Prefix: "import/"
+
+					if l := len("import/"); len(elem) >= l && elem[0:l] == "import/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "table"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleImportRecordsRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "POST",
+								allowedHeaders: rn91AllowedHeaders,
+								acceptPost:     "application/x-ndjson,text/csv",
+								acceptPatch:    "",
+							})
 						}
 
 						return
@@ -673,7 +1316,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							case "GET":
 								s.handleListModellingRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn93AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -704,7 +1352,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn100AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
 								}
 
 								return
@@ -737,7 +1390,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn72AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -770,50 +1428,51 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "PUT")
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "PUT",
+								allowedHeaders: rn106AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
 						}
 
 						return
 					}
 
-				case 't': // Prefix: "tables"
+				case 't': // Prefix: "t"
 
-					if l := len("tables"); len(elem) >= l && elem[0:l] == "tables" {
+					if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleListTablesRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
-
-						return
+						break
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 'a': // Prefix: "ables"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("ables"); len(elem) >= l && elem[0:l] == "ables" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "table"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
-							break
+							switch r.Method {
+							case "GET":
+								s.handleListTablesRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn94AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
 						}
 						switch elem[0] {
 						case '/': // Prefix: "/"
@@ -824,41 +1483,13 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'f': // Prefix: "fields"
-								origElem := elem
-								if l := len("fields"); len(elem) >= l && elem[0:l] == "fields" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetFieldsRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
-
-									return
-								}
-
-								elem = origElem
-							}
-							// Param: "fields"
+							// Param: "table"
 							// Match until "/"
 							idx := strings.IndexByte(elem, '/')
 							if idx < 0 {
 								idx = len(elem)
 							}
-							args[1] = elem[:idx]
+							args[0] = elem[:idx]
 							elem = elem[idx:]
 
 							if len(elem) == 0 {
@@ -873,55 +1504,254 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 
-								// Param: "search"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
+								if len(elem) == 0 {
 									break
 								}
-								args[2] = elem
-								elem = ""
+								switch elem[0] {
+								case 'f': // Prefix: "fields"
+									origElem := elem
+									if l := len("fields"); len(elem) >= l && elem[0:l] == "fields" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetFieldsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "GET",
+												allowedHeaders: rn44AllowedHeaders,
+												acceptPost:     "",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+								// Param: "fields"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
 
 								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleSearchTableRequest([3]string{
-											args[0],
-											args[1],
-											args[2],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "search"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[2] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleSearchTableRequest([3]string{
+												args[0],
+												args[1],
+												args[2],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "GET",
+												allowedHeaders: rn104AllowedHeaders,
+												acceptPost:     "",
+												acceptPatch:    "",
+											})
+										}
+
+										return
 									}
 
-									return
 								}
 
 							}
 
 						}
 
+					case 'r': // Prefix: "ransaction"
+
+						if l := len("ransaction"); len(elem) >= l && elem[0:l] == "ransaction" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleExecuteTransactionRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "POST",
+									allowedHeaders: rn32AllowedHeaders,
+									acceptPost:     "application/json",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
 					}
 
-				case 'u': // Prefix: "user"
+				case 'u': // Prefix: "u"
 
-					if l := len("user"); len(elem) >= l && elem[0:l] == "user" {
+					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetUserInfoRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
+						break
+					}
+					switch elem[0] {
+					case 'p': // Prefix: "pload"
+
+						if l := len("pload"); len(elem) >= l && elem[0:l] == "pload" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "path"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCreateUploadRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "POST",
+										allowedHeaders: rn21AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 's': // Prefix: "s/"
+
+							if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "uploadId"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleCancelUploadRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleGetUploadRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleUploadChunkRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "DELETE,GET,PATCH",
+										allowedHeaders: rn16AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "application/offset+octet-stream",
+									})
+								}
+
+								return
+							}
+
+						}
+
+					case 's': // Prefix: "ser"
+
+						if l := len("ser"); len(elem) >= l && elem[0:l] == "ser" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetUserInfoRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: nil,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
 						}
 
-						return
 					}
 
 				case 'v': // Prefix: "view"
@@ -939,7 +1769,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						case "POST":
 							s.handleInsertMapFileRecordsRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET,POST")
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET,POST",
+								allowedHeaders: rn75AllowedHeaders,
+								acceptPost:     "multipart/form-data",
+								acceptPatch:    "",
+							})
 						}
 
 						return
@@ -969,7 +1804,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "POST")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "POST",
+									allowedHeaders: rn27AllowedHeaders,
+									acceptPost:     "application/json",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -983,6 +1823,59 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'p': // Prefix: "pk/"
+								origElem := elem
+								if l := len("pk/"); len(elem) >= l && elem[0:l] == "pk/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "key"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleDeleteRecordByKeyRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleGetRecordByKeyRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									case "PATCH":
+										s.handlePatchRecordByKeyRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "DELETE,GET,PATCH",
+											allowedHeaders: rn29AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "application/merge-patch+json",
+										})
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
 							// Param: "search"
 							// Match until "/"
 							idx := strings.IndexByte(elem, '/')
@@ -1010,7 +1903,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										args[1],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "DELETE,GET,PUT")
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "DELETE,GET,PUT",
+										allowedHeaders: rn31AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
 								}
 
 								return
@@ -1042,8 +1940,19 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 											args[1],
 											args[2],
 										}, elemIsEscaped, w, r)
+									case "HEAD":
+										s.handleHeadMapRecordsFieldsRequest([3]string{
+											args[0],
+											args[1],
+											args[2],
+										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "GET")
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET,HEAD",
+											allowedHeaders: rn74AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
 									}
 
 									return
@@ -1072,7 +1981,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					case "POST":
 						s.handlePostJobRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET,POST")
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET,POST",
+							allowedHeaders: rn62AllowedHeaders,
+							acceptPost:     "application/json,text/plain",
+							acceptPatch:    "",
+						})
 					}
 
 					return
@@ -1104,7 +2018,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							case "GET":
 								s.handleGetJobExecutionResultRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn61AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -1132,7 +2051,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET,PUT")
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET,PUT",
+								allowedHeaders: rn23AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
 						}
 
 						return
@@ -1169,7 +2093,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									args[1],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "DELETE,GET")
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "DELETE,GET",
+									allowedHeaders: rn25AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
 							return
@@ -1205,7 +2134,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						case "GET":
 							s.handleGetVersionRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET")
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET",
+								allowedHeaders: nil,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
 						}
 
 						return
@@ -1280,7 +2214,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										args[2],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn89AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
 								}
 
 								return
@@ -1392,9 +2331,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
-			case 'b': // Prefix: "binary/"
+			case 'a': // Prefix: "archive/"
 
-				if l := len("binary/"); len(elem) >= l && elem[0:l] == "binary/" {
+				if l := len("archive/"); len(elem) >= l && elem[0:l] == "archive/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -1455,20 +2394,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							// Leaf node.
 							switch method {
 							case "GET":
-								r.name = GetLobByMapOperation
-								r.summary = ""
-								r.operationID = "getLobByMap"
-								r.operationGroup = ""
-								r.pathPattern = "/binary/{table}/{field}/{search}"
-								r.args = args
-								r.count = 3
-								return r, true
-							case "PUT":
-								r.name = UpdateLobByMapOperation
+								r.name = GetArchiveOperation
 								r.summary = ""
-								r.operationID = "updateLobByMap"
+								r.operationID = "getArchive"
 								r.operationGroup = ""
-								r.pathPattern = "/binary/{table}/{field}/{search}"
+								r.pathPattern = "/archive/{table}/{field}/{search}"
 								r.args = args
 								r.count = 3
 								return r, true
@@ -1481,35 +2411,124 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
-			case 'c': // Prefix: "config"
+			case 'b': // Prefix: "binary/"
 
-				if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
+				if l := len("binary/"); len(elem) >= l && elem[0:l] == "binary/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
+				// Param: "table"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
 				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = GetConfigOperation
-						r.summary = ""
-						r.operationID = "getConfig"
-						r.operationGroup = ""
-						r.pathPattern = "/config"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "POST":
-						r.name = StoreConfigOperation
-						r.summary = ""
-						r.operationID = "storeConfig"
-						r.operationGroup = ""
-						r.pathPattern = "/config"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "PUT":
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "field"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[1] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "search"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[2] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetLobByMapOperation
+								r.summary = ""
+								r.operationID = "getLobByMap"
+								r.operationGroup = ""
+								r.pathPattern = "/binary/{table}/{field}/{search}"
+								r.args = args
+								r.count = 3
+								return r, true
+							case "PUT":
+								r.name = UpdateLobByMapOperation
+								r.summary = ""
+								r.operationID = "updateLobByMap"
+								r.operationGroup = ""
+								r.pathPattern = "/binary/{table}/{field}/{search}"
+								r.args = args
+								r.count = 3
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 'c': // Prefix: "config"
+
+				if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = GetConfigOperation
+						r.summary = ""
+						r.operationID = "getConfig"
+						r.operationGroup = ""
+						r.pathPattern = "/config"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = StoreConfigOperation
+						r.summary = ""
+						r.operationID = "storeConfig"
+						r.operationGroup = ""
+						r.pathPattern = "/config"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "PUT":
 						r.name = SetConfigOperation
 						r.summary = ""
 						r.operationID = "setConfig"
@@ -1535,6 +2554,40 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
+					case 'i': // Prefix: "images/cache"
+
+						if l := len("images/cache"); len(elem) >= l && elem[0:l] == "images/cache" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = PurgeImageCacheOperation
+								r.summary = ""
+								r.operationID = "purgeImageCache"
+								r.operationGroup = ""
+								r.pathPattern = "/config/images/cache"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "GET":
+								r.name = GetImageCacheStatsOperation
+								r.summary = ""
+								r.operationID = "getImageCacheStats"
+								r.operationGroup = ""
+								r.pathPattern = "/config/images/cache"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
 					case 'j': // Prefix: "jobs"
 
 						if l := len("jobs"); len(elem) >= l && elem[0:l] == "jobs" {
@@ -1616,6 +2669,89 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
+			case 'h': // Prefix: "hash/"
+
+				if l := len("hash/"); len(elem) >= l && elem[0:l] == "hash/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "table"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "hashField"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[1] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "PUT":
+							r.name = UpdateImageHashesOperation
+							r.summary = ""
+							r.operationID = "updateImageHashes"
+							r.operationGroup = ""
+							r.pathPattern = "/hash/{table}/{field}"
+							r.args = args
+							r.count = 2
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/duplicates"
+
+						if l := len("/duplicates"); len(elem) >= l && elem[0:l] == "/duplicates" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetImageDuplicatesOperation
+								r.summary = ""
+								r.operationID = "getImageDuplicates"
+								r.operationGroup = ""
+								r.pathPattern = "/hash/{table}/{hashField}/duplicates"
+								r.args = args
+								r.count = 2
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
 			case 'i': // Prefix: "image/"
 
 				if l := len("image/"); len(elem) >= l && elem[0:l] == "image/" {
@@ -1667,16 +2803,15 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						// Param: "search"
-						// Leaf parameter, slashes are prohibited
+						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
+						if idx < 0 {
+							idx = len(elem)
 						}
-						args[2] = elem
-						elem = ""
+						args[2] = elem[:idx]
+						elem = elem[idx:]
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch method {
 							case "GET":
 								r.name = GetImageOperation
@@ -1691,6 +2826,72 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'h': // Prefix: "hash"
+
+								if l := len("hash"); len(elem) >= l && elem[0:l] == "hash" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetImageHashOperation
+										r.summary = ""
+										r.operationID = "getImageHash"
+										r.operationGroup = ""
+										r.pathPattern = "/image/{table}/{field}/{search}/hash"
+										r.args = args
+										r.count = 3
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'm': // Prefix: "metadata"
+
+								if l := len("metadata"); len(elem) >= l && elem[0:l] == "metadata" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetImageMetadataOperation
+										r.summary = ""
+										r.operationID = "getImageMetadata"
+										r.operationGroup = ""
+										r.pathPattern = "/image/{table}/{field}/{search}/metadata"
+										r.args = args
+										r.count = 3
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
 
 					}
 
@@ -1812,23 +3013,57 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
-
+
+					}
+
+				}
+
+			case 'r': // Prefix: "rest/"
+
+				if l := len("rest/"); len(elem) >= l && elem[0:l] == "rest/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "aggregate/"
+
+					if l := len("aggregate/"); len(elem) >= l && elem[0:l] == "aggregate/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "table"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = AggregateRecordsOperation
+							r.summary = ""
+							r.operationID = "aggregateRecords"
+							r.operationGroup = ""
+							r.pathPattern = "/rest/aggregate/{table}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
 					}
 
-				}
-
-			case 'r': // Prefix: "rest/"
-
-				if l := len("rest/"); len(elem) >= l && elem[0:l] == "rest/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
 				case 'b': // Prefix: "batch/"
 
 					if l := len("batch/"); len(elem) >= l && elem[0:l] == "batch/" {
@@ -2129,6 +3364,97 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 
+				case 'h': // Prefix: "history/"
+
+					if l := len("history/"); len(elem) >= l && elem[0:l] == "history/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "table"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "key"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[1] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetRecordHistoryOperation
+								r.summary = ""
+								r.operationID = "getRecordHistory"
+								r.operationGroup = ""
+								r.pathPattern = "/rest/history/{table}/{key}"
+								r.args = args
+								r.count = 2
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'i': // Prefix: "import/"
+
+					if l := len("import/"); len(elem) >= l && elem[0:l] == "import/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "table"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = ImportRecordsOperation
+							r.summary = ""
+							r.operationID = "importRecords"
+							r.operationGroup = ""
+							r.pathPattern = "/rest/import/{table}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
 				case 'm': // Prefix: "m"
 
 					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
@@ -2203,32 +3529,242 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					case 'e': // Prefix: "etadata/view/"
 
-						if l := len("etadata/view/"); len(elem) >= l && elem[0:l] == "etadata/view/" {
+						if l := len("etadata/view/"); len(elem) >= l && elem[0:l] == "etadata/view/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "table"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetMapMetadataOperation
+								r.summary = ""
+								r.operationID = "getMapMetadata"
+								r.operationGroup = ""
+								r.pathPattern = "/rest/metadata/view/{table}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 's': // Prefix: "shutdown/"
+
+					if l := len("shutdown/"); len(elem) >= l && elem[0:l] == "shutdown/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "hash"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "PUT":
+							r.name = ShutdownServerOperation
+							r.summary = ""
+							r.operationID = "shutdownServer"
+							r.operationGroup = ""
+							r.pathPattern = "/rest/shutdown/{hash}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 't': // Prefix: "t"
+
+					if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "ables"
+
+						if l := len("ables"); len(elem) >= l && elem[0:l] == "ables" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListTablesOperation
+								r.summary = ""
+								r.operationID = "listTables"
+								r.operationGroup = ""
+								r.pathPattern = "/rest/tables"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "table"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'f': // Prefix: "fields"
+									origElem := elem
+									if l := len("fields"); len(elem) >= l && elem[0:l] == "fields" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetFieldsOperation
+											r.summary = ""
+											r.operationID = "getFields"
+											r.operationGroup = ""
+											r.pathPattern = "/rest/tables/{table}/fields"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+								// Param: "fields"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "search"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[2] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = SearchTableOperation
+											r.summary = ""
+											r.operationID = "searchTable"
+											r.operationGroup = ""
+											r.pathPattern = "/rest/tables/{table}/{fields}/{search}"
+											r.args = args
+											r.count = 3
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'r': // Prefix: "ransaction"
+
+						if l := len("ransaction"); len(elem) >= l && elem[0:l] == "ransaction" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "table"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
-
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "GET":
-								r.name = GetMapMetadataOperation
+							case "POST":
+								r.name = ExecuteTransactionOperation
 								r.summary = ""
-								r.operationID = "getMapMetadata"
+								r.operationID = "executeTransaction"
 								r.operationGroup = ""
-								r.pathPattern = "/rest/metadata/view/{table}"
+								r.pathPattern = "/rest/transaction"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
@@ -2237,81 +3773,26 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
-				case 's': // Prefix: "shutdown/"
+				case 'u': // Prefix: "u"
 
-					if l := len("shutdown/"); len(elem) >= l && elem[0:l] == "shutdown/" {
+					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "hash"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
-
 					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "PUT":
-							r.name = ShutdownServerOperation
-							r.summary = ""
-							r.operationID = "shutdownServer"
-							r.operationGroup = ""
-							r.pathPattern = "/rest/shutdown/{hash}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
-						}
-					}
-
-				case 't': // Prefix: "tables"
-
-					if l := len("tables"); len(elem) >= l && elem[0:l] == "tables" {
-						elem = elem[l:]
-					} else {
 						break
 					}
-
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = ListTablesOperation
-							r.summary = ""
-							r.operationID = "listTables"
-							r.operationGroup = ""
-							r.pathPattern = "/rest/tables"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 'p': // Prefix: "pload"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("pload"); len(elem) >= l && elem[0:l] == "pload" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "table"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
 							break
 						}
@@ -2324,113 +3805,111 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 
-							if len(elem) == 0 {
+							// Param: "path"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
 								break
 							}
-							switch elem[0] {
-							case 'f': // Prefix: "fields"
-								origElem := elem
-								if l := len("fields"); len(elem) >= l && elem[0:l] == "fields" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+							args[0] = elem
+							elem = ""
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetFieldsOperation
-										r.summary = ""
-										r.operationID = "getFields"
-										r.operationGroup = ""
-										r.pathPattern = "/rest/tables/{table}/fields"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = CreateUploadOperation
+									r.summary = ""
+									r.operationID = "createUpload"
+									r.operationGroup = ""
+									r.pathPattern = "/rest/upload/{path}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
 								}
-
-								elem = origElem
-							}
-							// Param: "fields"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
 							}
-							args[1] = elem[:idx]
-							elem = elem[idx:]
 
-							if len(elem) == 0 {
+						case 's': // Prefix: "s/"
+
+							if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
+								elem = elem[l:]
+							} else {
 								break
 							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								// Param: "search"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[2] = elem
-								elem = ""
+							// Param: "uploadId"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = SearchTableOperation
-										r.summary = ""
-										r.operationID = "searchTable"
-										r.operationGroup = ""
-										r.pathPattern = "/rest/tables/{table}/{fields}/{search}"
-										r.args = args
-										r.count = 3
-										return r, true
-									default:
-										return
-									}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = CancelUploadOperation
+									r.summary = ""
+									r.operationID = "cancelUpload"
+									r.operationGroup = ""
+									r.pathPattern = "/rest/uploads/{uploadId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = GetUploadOperation
+									r.summary = ""
+									r.operationID = "getUpload"
+									r.operationGroup = ""
+									r.pathPattern = "/rest/uploads/{uploadId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PATCH":
+									r.name = UploadChunkOperation
+									r.summary = ""
+									r.operationID = "uploadChunk"
+									r.operationGroup = ""
+									r.pathPattern = "/rest/uploads/{uploadId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
 								}
-
 							}
 
 						}
 
-					}
-
-				case 'u': // Prefix: "user"
+					case 's': // Prefix: "ser"
 
-					if l := len("user"); len(elem) >= l && elem[0:l] == "user" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+						if l := len("ser"); len(elem) >= l && elem[0:l] == "ser" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = GetUserInfoOperation
-							r.summary = ""
-							r.operationID = "getUserInfo"
-							r.operationGroup = ""
-							r.pathPattern = "/rest/user"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetUserInfoOperation
+								r.summary = ""
+								r.operationID = "getUserInfo"
+								r.operationGroup = ""
+								r.pathPattern = "/rest/user"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
+
 					}
 
 				case 'v': // Prefix: "view"
@@ -2507,6 +3986,64 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'p': // Prefix: "pk/"
+								origElem := elem
+								if l := len("pk/"); len(elem) >= l && elem[0:l] == "pk/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "key"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = DeleteRecordByKeyOperation
+										r.summary = ""
+										r.operationID = "deleteRecordByKey"
+										r.operationGroup = ""
+										r.pathPattern = "/rest/view/{table}/pk/{key}"
+										r.args = args
+										r.count = 2
+										return r, true
+									case "GET":
+										r.name = GetRecordByKeyOperation
+										r.summary = ""
+										r.operationID = "getRecordByKey"
+										r.operationGroup = ""
+										r.pathPattern = "/rest/view/{table}/pk/{key}"
+										r.args = args
+										r.count = 2
+										return r, true
+									case "PATCH":
+										r.name = PatchRecordByKeyOperation
+										r.summary = ""
+										r.operationID = "patchRecordByKey"
+										r.operationGroup = ""
+										r.pathPattern = "/rest/view/{table}/pk/{key}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
 							// Param: "search"
 							// Match until "/"
 							idx := strings.IndexByte(elem, '/')
@@ -2579,6 +4116,15 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										r.args = args
 										r.count = 3
 										return r, true
+									case "HEAD":
+										r.name = HeadMapRecordsFieldsOperation
+										r.summary = ""
+										r.operationID = "headMapRecordsFields"
+										r.operationGroup = ""
+										r.pathPattern = "/rest/view/{table}/{fields}/{search}"
+										r.args = args
+										r.count = 3
+										return r, true
 									default:
 										return
 									}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
 	"github.com/google/uuid"
 	ht "github.com/ogen-go/ogen/http"
@@ -57,6 +58,16 @@ type AddViewUnauthorized struct{}
 
 func (*AddViewUnauthorized) addViewRes() {}
 
+// AggregateRecordsForbidden is response for AggregateRecords operation.
+type AggregateRecordsForbidden struct{}
+
+func (*AggregateRecordsForbidden) aggregateRecordsRes() {}
+
+// AggregateRecordsUnauthorized is response for AggregateRecords operation.
+type AggregateRecordsUnauthorized struct{}
+
+func (*AggregateRecordsUnauthorized) aggregateRecordsRes() {}
+
 // Ref: #/components/schemas/AuthorizationToken
 type AuthorizationToken struct {
 	Token     OptString `json:"token"`
@@ -165,3003 +176,5757 @@ type BatchParameterQueryForbidden struct{}
 
 func (*BatchParameterQueryForbidden) batchParameterQueryRes() {}
 
-// BatchParameterQueryUnauthorized is response for BatchParameterQuery operation.
-type BatchParameterQueryUnauthorized struct{}
-
-func (*BatchParameterQueryUnauthorized) batchParameterQueryRes() {}
-
-// BatchQueryForbidden is response for BatchQuery operation.
-type BatchQueryForbidden struct{}
-
-func (*BatchQueryForbidden) batchQueryRes() {}
-
-type BatchQueryReqEmptyBody struct{}
-
-func (*BatchQueryReqEmptyBody) batchQueryReq() {}
-
-type BatchQueryReqTextPlain struct {
+type BatchParameterQueryOKApplicationXJSONStream struct {
 	Data io.Reader
 }
 
 // Read reads data from the Data reader.
 //
 // Kept to satisfy the io.Reader interface.
-func (s BatchQueryReqTextPlain) Read(p []byte) (n int, err error) {
+func (s BatchParameterQueryOKApplicationXJSONStream) Read(p []byte) (n int, err error) {
 	if s.Data == nil {
 		return 0, io.EOF
 	}
 	return s.Data.Read(p)
 }
 
-func (*BatchQueryReqTextPlain) batchQueryReq() {}
-
-// BatchQueryUnauthorized is response for BatchQuery operation.
-type BatchQueryUnauthorized struct{}
+// BatchParameterQueryOKApplicationXJSONStreamHeaders wraps BatchParameterQueryOKApplicationXJSONStream with response headers.
+type BatchParameterQueryOKApplicationXJSONStreamHeaders struct {
+	XToken   OptString
+	Response BatchParameterQueryOKApplicationXJSONStream
+}
 
-func (*BatchQueryUnauthorized) batchQueryRes() {}
+// GetXToken returns the value of XToken.
+func (s *BatchParameterQueryOKApplicationXJSONStreamHeaders) GetXToken() OptString {
+	return s.XToken
+}
 
-// BatchSelectForbidden is response for BatchSelect operation.
-type BatchSelectForbidden struct{}
+// GetResponse returns the value of Response.
+func (s *BatchParameterQueryOKApplicationXJSONStreamHeaders) GetResponse() BatchParameterQueryOKApplicationXJSONStream {
+	return s.Response
+}
 
-func (*BatchSelectForbidden) batchSelectRes() {}
+// SetXToken sets the value of XToken.
+func (s *BatchParameterQueryOKApplicationXJSONStreamHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
 
-// BatchSelectUnauthorized is response for BatchSelect operation.
-type BatchSelectUnauthorized struct{}
+// SetResponse sets the value of Response.
+func (s *BatchParameterQueryOKApplicationXJSONStreamHeaders) SetResponse(val BatchParameterQueryOKApplicationXJSONStream) {
+	s.Response = val
+}
 
-func (*BatchSelectUnauthorized) batchSelectRes() {}
+func (*BatchParameterQueryOKApplicationXJSONStreamHeaders) batchParameterQueryRes() {}
 
-type BearerAuth struct {
-	Token string
-	Roles []string
+type BatchParameterQueryOKApplicationXNdjson struct {
+	Data io.Reader
 }
 
-// GetToken returns the value of Token.
-func (s *BearerAuth) GetToken() string {
-	return s.Token
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s BatchParameterQueryOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetRoles returns the value of Roles.
-func (s *BearerAuth) GetRoles() []string {
-	return s.Roles
+// BatchParameterQueryOKApplicationXNdjsonHeaders wraps BatchParameterQueryOKApplicationXNdjson with response headers.
+type BatchParameterQueryOKApplicationXNdjsonHeaders struct {
+	XToken   OptString
+	Response BatchParameterQueryOKApplicationXNdjson
 }
 
-// SetToken sets the value of Token.
-func (s *BearerAuth) SetToken(val string) {
-	s.Token = val
+// GetXToken returns the value of XToken.
+func (s *BatchParameterQueryOKApplicationXNdjsonHeaders) GetXToken() OptString {
+	return s.XToken
 }
 
-// SetRoles sets the value of Roles.
-func (s *BearerAuth) SetRoles(val []string) {
-	s.Roles = val
+// GetResponse returns the value of Response.
+func (s *BatchParameterQueryOKApplicationXNdjsonHeaders) GetResponse() BatchParameterQueryOKApplicationXNdjson {
+	return s.Response
 }
 
-type BrowseListBadRequest Error
-
-func (*BrowseListBadRequest) browseListRes() {}
-
-// BrowseListForbidden is response for BrowseList operation.
-type BrowseListForbidden struct{}
-
-func (*BrowseListForbidden) browseListRes() {}
-
-type BrowseListNotFound Error
-
-func (*BrowseListNotFound) browseListRes() {}
+// SetXToken sets the value of XToken.
+func (s *BatchParameterQueryOKApplicationXNdjsonHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
 
-// BrowseListUnauthorized is response for BrowseList operation.
-type BrowseListUnauthorized struct{}
+// SetResponse sets the value of Response.
+func (s *BatchParameterQueryOKApplicationXNdjsonHeaders) SetResponse(val BatchParameterQueryOKApplicationXNdjson) {
+	s.Response = val
+}
 
-func (*BrowseListUnauthorized) browseListRes() {}
+func (*BatchParameterQueryOKApplicationXNdjsonHeaders) batchParameterQueryRes() {}
 
-type BrowseLocationBadRequest Error
+type BatchParameterQueryOKTextCsv struct {
+	Data io.Reader
+}
 
-func (*BrowseLocationBadRequest) browseLocationRes() {}
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s BatchParameterQueryOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
 
-// BrowseLocationForbidden is response for BrowseLocation operation.
-type BrowseLocationForbidden struct{}
+// BatchParameterQueryOKTextCsvHeaders wraps BatchParameterQueryOKTextCsv with response headers.
+type BatchParameterQueryOKTextCsvHeaders struct {
+	XToken   OptString
+	Response BatchParameterQueryOKTextCsv
+}
 
-func (*BrowseLocationForbidden) browseLocationRes() {}
+// GetXToken returns the value of XToken.
+func (s *BatchParameterQueryOKTextCsvHeaders) GetXToken() OptString {
+	return s.XToken
+}
 
-type BrowseLocationNotFound Error
+// GetResponse returns the value of Response.
+func (s *BatchParameterQueryOKTextCsvHeaders) GetResponse() BatchParameterQueryOKTextCsv {
+	return s.Response
+}
 
-func (*BrowseLocationNotFound) browseLocationRes() {}
+// SetXToken sets the value of XToken.
+func (s *BatchParameterQueryOKTextCsvHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
 
-// BrowseLocationOK represents sum type.
-type BrowseLocationOK struct {
-	Type           BrowseLocationOKType // switch on this field
-	DirectoryFiles DirectoryFiles
-	File           File
+// SetResponse sets the value of Response.
+func (s *BatchParameterQueryOKTextCsvHeaders) SetResponse(val BatchParameterQueryOKTextCsv) {
+	s.Response = val
 }
 
-// BrowseLocationOKType is oneOf type of BrowseLocationOK.
-type BrowseLocationOKType string
+func (*BatchParameterQueryOKTextCsvHeaders) batchParameterQueryRes() {}
 
-// Possible values for BrowseLocationOKType.
-const (
-	DirectoryFilesBrowseLocationOK BrowseLocationOKType = "DirectoryFiles"
-	FileBrowseLocationOK           BrowseLocationOKType = "File"
-)
+// BatchParameterQueryUnauthorized is response for BatchParameterQuery operation.
+type BatchParameterQueryUnauthorized struct{}
 
-// IsDirectoryFiles reports whether BrowseLocationOK is DirectoryFiles.
-func (s BrowseLocationOK) IsDirectoryFiles() bool { return s.Type == DirectoryFilesBrowseLocationOK }
+func (*BatchParameterQueryUnauthorized) batchParameterQueryRes() {}
 
-// IsFile reports whether BrowseLocationOK is File.
-func (s BrowseLocationOK) IsFile() bool { return s.Type == FileBrowseLocationOK }
+// BatchQueryForbidden is response for BatchQuery operation.
+type BatchQueryForbidden struct{}
 
-// SetDirectoryFiles sets BrowseLocationOK to DirectoryFiles.
-func (s *BrowseLocationOK) SetDirectoryFiles(v DirectoryFiles) {
-	s.Type = DirectoryFilesBrowseLocationOK
-	s.DirectoryFiles = v
+func (*BatchQueryForbidden) batchQueryRes() {}
+
+type BatchQueryOKApplicationXJSONStream struct {
+	Data io.Reader
 }
 
-// GetDirectoryFiles returns DirectoryFiles and true boolean if BrowseLocationOK is DirectoryFiles.
-func (s BrowseLocationOK) GetDirectoryFiles() (v DirectoryFiles, ok bool) {
-	if !s.IsDirectoryFiles() {
-		return v, false
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s BatchQueryOKApplicationXJSONStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
-	return s.DirectoryFiles, true
+	return s.Data.Read(p)
 }
 
-// NewDirectoryFilesBrowseLocationOK returns new BrowseLocationOK from DirectoryFiles.
-func NewDirectoryFilesBrowseLocationOK(v DirectoryFiles) BrowseLocationOK {
-	var s BrowseLocationOK
-	s.SetDirectoryFiles(v)
-	return s
+// BatchQueryOKApplicationXJSONStreamHeaders wraps BatchQueryOKApplicationXJSONStream with response headers.
+type BatchQueryOKApplicationXJSONStreamHeaders struct {
+	XToken   OptString
+	Response BatchQueryOKApplicationXJSONStream
 }
 
-// SetFile sets BrowseLocationOK to File.
-func (s *BrowseLocationOK) SetFile(v File) {
-	s.Type = FileBrowseLocationOK
-	s.File = v
+// GetXToken returns the value of XToken.
+func (s *BatchQueryOKApplicationXJSONStreamHeaders) GetXToken() OptString {
+	return s.XToken
 }
 
-// GetFile returns File and true boolean if BrowseLocationOK is File.
-func (s BrowseLocationOK) GetFile() (v File, ok bool) {
-	if !s.IsFile() {
-		return v, false
-	}
-	return s.File, true
+// GetResponse returns the value of Response.
+func (s *BatchQueryOKApplicationXJSONStreamHeaders) GetResponse() BatchQueryOKApplicationXJSONStream {
+	return s.Response
 }
 
-// NewFileBrowseLocationOK returns new BrowseLocationOK from File.
-func NewFileBrowseLocationOK(v File) BrowseLocationOK {
-	var s BrowseLocationOK
-	s.SetFile(v)
-	return s
+// SetXToken sets the value of XToken.
+func (s *BatchQueryOKApplicationXJSONStreamHeaders) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-func (*BrowseLocationOK) browseLocationRes() {}
-
-// BrowseLocationUnauthorized is response for BrowseLocation operation.
-type BrowseLocationUnauthorized struct{}
-
-func (*BrowseLocationUnauthorized) browseLocationRes() {}
-
-type CallExtendBadRequest Error
-
-func (*CallExtendBadRequest) callExtendRes() {}
-
-// CallExtendForbidden is response for CallExtend operation.
-type CallExtendForbidden struct{}
-
-func (*CallExtendForbidden) callExtendRes() {}
-
-type CallExtendNotFound Error
+// SetResponse sets the value of Response.
+func (s *BatchQueryOKApplicationXJSONStreamHeaders) SetResponse(val BatchQueryOKApplicationXJSONStream) {
+	s.Response = val
+}
 
-func (*CallExtendNotFound) callExtendRes() {}
+func (*BatchQueryOKApplicationXJSONStreamHeaders) batchQueryRes() {}
 
-type CallExtendOKApplicationOctetStream struct {
+type BatchQueryOKApplicationXNdjson struct {
 	Data io.Reader
 }
 
 // Read reads data from the Data reader.
 //
 // Kept to satisfy the io.Reader interface.
-func (s CallExtendOKApplicationOctetStream) Read(p []byte) (n int, err error) {
+func (s BatchQueryOKApplicationXNdjson) Read(p []byte) (n int, err error) {
 	if s.Data == nil {
 		return 0, io.EOF
 	}
 	return s.Data.Read(p)
 }
 
-func (*CallExtendOKApplicationOctetStream) callExtendRes() {}
-
-// CallExtendUnauthorized is response for CallExtend operation.
-type CallExtendUnauthorized struct{}
-
-func (*CallExtendUnauthorized) callExtendRes() {}
-
-type CallPostExtendBadRequest Error
+// BatchQueryOKApplicationXNdjsonHeaders wraps BatchQueryOKApplicationXNdjson with response headers.
+type BatchQueryOKApplicationXNdjsonHeaders struct {
+	XToken   OptString
+	Response BatchQueryOKApplicationXNdjson
+}
 
-func (*CallPostExtendBadRequest) callPostExtendRes() {}
+// GetXToken returns the value of XToken.
+func (s *BatchQueryOKApplicationXNdjsonHeaders) GetXToken() OptString {
+	return s.XToken
+}
 
-// CallPostExtendForbidden is response for CallPostExtend operation.
-type CallPostExtendForbidden struct{}
+// GetResponse returns the value of Response.
+func (s *BatchQueryOKApplicationXNdjsonHeaders) GetResponse() BatchQueryOKApplicationXNdjson {
+	return s.Response
+}
 
-func (*CallPostExtendForbidden) callPostExtendRes() {}
+// SetXToken sets the value of XToken.
+func (s *BatchQueryOKApplicationXNdjsonHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
 
-type CallPostExtendNotFound Error
+// SetResponse sets the value of Response.
+func (s *BatchQueryOKApplicationXNdjsonHeaders) SetResponse(val BatchQueryOKApplicationXNdjson) {
+	s.Response = val
+}
 
-func (*CallPostExtendNotFound) callPostExtendRes() {}
+func (*BatchQueryOKApplicationXNdjsonHeaders) batchQueryRes() {}
 
-type CallPostExtendOKApplicationOctetStream struct {
+type BatchQueryOKTextCsv struct {
 	Data io.Reader
 }
 
 // Read reads data from the Data reader.
 //
 // Kept to satisfy the io.Reader interface.
-func (s CallPostExtendOKApplicationOctetStream) Read(p []byte) (n int, err error) {
+func (s BatchQueryOKTextCsv) Read(p []byte) (n int, err error) {
 	if s.Data == nil {
 		return 0, io.EOF
 	}
 	return s.Data.Read(p)
 }
 
-func (*CallPostExtendOKApplicationOctetStream) callPostExtendRes() {}
+// BatchQueryOKTextCsvHeaders wraps BatchQueryOKTextCsv with response headers.
+type BatchQueryOKTextCsvHeaders struct {
+	XToken   OptString
+	Response BatchQueryOKTextCsv
+}
 
-type CallPostExtendReq struct {
-	// Upload data.
-	UploadFile OptMultipartFile `json:"uploadFile"`
+// GetXToken returns the value of XToken.
+func (s *BatchQueryOKTextCsvHeaders) GetXToken() OptString {
+	return s.XToken
 }
 
-// GetUploadFile returns the value of UploadFile.
-func (s *CallPostExtendReq) GetUploadFile() OptMultipartFile {
-	return s.UploadFile
+// GetResponse returns the value of Response.
+func (s *BatchQueryOKTextCsvHeaders) GetResponse() BatchQueryOKTextCsv {
+	return s.Response
 }
 
-// SetUploadFile sets the value of UploadFile.
-func (s *CallPostExtendReq) SetUploadFile(val OptMultipartFile) {
-	s.UploadFile = val
+// SetXToken sets the value of XToken.
+func (s *BatchQueryOKTextCsvHeaders) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-// CallPostExtendUnauthorized is response for CallPostExtend operation.
-type CallPostExtendUnauthorized struct{}
-
-func (*CallPostExtendUnauthorized) callPostExtendRes() {}
-
-// Ref: #/components/schemas/ClusterConfig
-type ClusterConfig struct {
-	Nodes []ClusterConfigNodesItem `json:"Nodes"`
+// SetResponse sets the value of Response.
+func (s *BatchQueryOKTextCsvHeaders) SetResponse(val BatchQueryOKTextCsv) {
+	s.Response = val
 }
 
-// GetNodes returns the value of Nodes.
-func (s *ClusterConfig) GetNodes() []ClusterConfigNodesItem {
-	return s.Nodes
-}
+func (*BatchQueryOKTextCsvHeaders) batchQueryRes() {}
 
-// SetNodes sets the value of Nodes.
-func (s *ClusterConfig) SetNodes(val []ClusterConfigNodesItem) {
-	s.Nodes = val
-}
+type BatchQueryReqEmptyBody struct{}
 
-type ClusterConfigNodesItem struct {
-	Name  OptString `json:"Name"`
-	Web   OptString `json:"Web"`
-	Group OptString `json:"Group"`
-}
+func (*BatchQueryReqEmptyBody) batchQueryReq() {}
 
-// GetName returns the value of Name.
-func (s *ClusterConfigNodesItem) GetName() OptString {
-	return s.Name
+type BatchQueryReqTextPlain struct {
+	Data io.Reader
 }
 
-// GetWeb returns the value of Web.
-func (s *ClusterConfigNodesItem) GetWeb() OptString {
-	return s.Web
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s BatchQueryReqTextPlain) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetGroup returns the value of Group.
-func (s *ClusterConfigNodesItem) GetGroup() OptString {
-	return s.Group
-}
+func (*BatchQueryReqTextPlain) batchQueryReq() {}
 
-// SetName sets the value of Name.
-func (s *ClusterConfigNodesItem) SetName(val OptString) {
-	s.Name = val
-}
+// BatchQueryUnauthorized is response for BatchQuery operation.
+type BatchQueryUnauthorized struct{}
 
-// SetWeb sets the value of Web.
-func (s *ClusterConfigNodesItem) SetWeb(val OptString) {
-	s.Web = val
-}
+func (*BatchQueryUnauthorized) batchQueryRes() {}
 
-// SetGroup sets the value of Group.
-func (s *ClusterConfigNodesItem) SetGroup(val OptString) {
-	s.Group = val
-}
+// BatchSelectForbidden is response for BatchSelect operation.
+type BatchSelectForbidden struct{}
 
-// Ref: #/components/schemas/Config
-type Config struct {
-	StructureLevel OptInt                  `json:"StructureLevel"`
-	Version        OptString               `json:"Version"`
-	Server         OptConfigServer         `json:"Server"`
-	JobStore       OptJobStore             `json:"JobStore"`
-	Cluster        OptClusterConfig        `json:"Cluster"`
-	Mapping        OptConfigMapping        `json:"Mapping"`
-	DatabaseAccess OptConfigDatabaseAccess `json:"DatabaseAccess"`
-	Module         OptConfigModule         `json:"Module"`
-	Metrics        OptConfigMetrics        `json:"Metrics"`
-}
+func (*BatchSelectForbidden) batchSelectRes() {}
 
-// GetStructureLevel returns the value of StructureLevel.
-func (s *Config) GetStructureLevel() OptInt {
-	return s.StructureLevel
+type BatchSelectOKApplicationXJSONStream struct {
+	Data io.Reader
 }
 
-// GetVersion returns the value of Version.
-func (s *Config) GetVersion() OptString {
-	return s.Version
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s BatchSelectOKApplicationXJSONStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetServer returns the value of Server.
-func (s *Config) GetServer() OptConfigServer {
-	return s.Server
+// BatchSelectOKApplicationXJSONStreamHeaders wraps BatchSelectOKApplicationXJSONStream with response headers.
+type BatchSelectOKApplicationXJSONStreamHeaders struct {
+	XToken   OptString
+	Response BatchSelectOKApplicationXJSONStream
 }
 
-// GetJobStore returns the value of JobStore.
-func (s *Config) GetJobStore() OptJobStore {
-	return s.JobStore
+// GetXToken returns the value of XToken.
+func (s *BatchSelectOKApplicationXJSONStreamHeaders) GetXToken() OptString {
+	return s.XToken
 }
 
-// GetCluster returns the value of Cluster.
-func (s *Config) GetCluster() OptClusterConfig {
-	return s.Cluster
+// GetResponse returns the value of Response.
+func (s *BatchSelectOKApplicationXJSONStreamHeaders) GetResponse() BatchSelectOKApplicationXJSONStream {
+	return s.Response
 }
 
-// GetMapping returns the value of Mapping.
-func (s *Config) GetMapping() OptConfigMapping {
-	return s.Mapping
+// SetXToken sets the value of XToken.
+func (s *BatchSelectOKApplicationXJSONStreamHeaders) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-// GetDatabaseAccess returns the value of DatabaseAccess.
-func (s *Config) GetDatabaseAccess() OptConfigDatabaseAccess {
-	return s.DatabaseAccess
+// SetResponse sets the value of Response.
+func (s *BatchSelectOKApplicationXJSONStreamHeaders) SetResponse(val BatchSelectOKApplicationXJSONStream) {
+	s.Response = val
 }
 
-// GetModule returns the value of Module.
-func (s *Config) GetModule() OptConfigModule {
-	return s.Module
-}
+func (*BatchSelectOKApplicationXJSONStreamHeaders) batchSelectRes() {}
 
-// GetMetrics returns the value of Metrics.
-func (s *Config) GetMetrics() OptConfigMetrics {
-	return s.Metrics
+type BatchSelectOKApplicationXNdjson struct {
+	Data io.Reader
 }
 
-// SetStructureLevel sets the value of StructureLevel.
-func (s *Config) SetStructureLevel(val OptInt) {
-	s.StructureLevel = val
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s BatchSelectOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetVersion sets the value of Version.
-func (s *Config) SetVersion(val OptString) {
-	s.Version = val
+// BatchSelectOKApplicationXNdjsonHeaders wraps BatchSelectOKApplicationXNdjson with response headers.
+type BatchSelectOKApplicationXNdjsonHeaders struct {
+	XToken   OptString
+	Response BatchSelectOKApplicationXNdjson
 }
 
-// SetServer sets the value of Server.
-func (s *Config) SetServer(val OptConfigServer) {
-	s.Server = val
+// GetXToken returns the value of XToken.
+func (s *BatchSelectOKApplicationXNdjsonHeaders) GetXToken() OptString {
+	return s.XToken
 }
 
-// SetJobStore sets the value of JobStore.
-func (s *Config) SetJobStore(val OptJobStore) {
-	s.JobStore = val
+// GetResponse returns the value of Response.
+func (s *BatchSelectOKApplicationXNdjsonHeaders) GetResponse() BatchSelectOKApplicationXNdjson {
+	return s.Response
 }
 
-// SetCluster sets the value of Cluster.
-func (s *Config) SetCluster(val OptClusterConfig) {
-	s.Cluster = val
+// SetXToken sets the value of XToken.
+func (s *BatchSelectOKApplicationXNdjsonHeaders) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-// SetMapping sets the value of Mapping.
-func (s *Config) SetMapping(val OptConfigMapping) {
-	s.Mapping = val
+// SetResponse sets the value of Response.
+func (s *BatchSelectOKApplicationXNdjsonHeaders) SetResponse(val BatchSelectOKApplicationXNdjson) {
+	s.Response = val
 }
 
-// SetDatabaseAccess sets the value of DatabaseAccess.
-func (s *Config) SetDatabaseAccess(val OptConfigDatabaseAccess) {
-	s.DatabaseAccess = val
-}
+func (*BatchSelectOKApplicationXNdjsonHeaders) batchSelectRes() {}
 
-// SetModule sets the value of Module.
-func (s *Config) SetModule(val OptConfigModule) {
-	s.Module = val
+type BatchSelectOKTextCsv struct {
+	Data io.Reader
 }
 
-// SetMetrics sets the value of Metrics.
-func (s *Config) SetMetrics(val OptConfigMetrics) {
-	s.Metrics = val
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s BatchSelectOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-func (*Config) getConfigRes() {}
-func (*Config) setConfigReq() {}
-
-type ConfigDatabaseAccess struct {
-	Global   OptBool          `json:"Global"`
-	Database []DatabaseConfig `json:"Database"`
+// BatchSelectOKTextCsvHeaders wraps BatchSelectOKTextCsv with response headers.
+type BatchSelectOKTextCsvHeaders struct {
+	XToken   OptString
+	Response BatchSelectOKTextCsv
 }
 
-// GetGlobal returns the value of Global.
-func (s *ConfigDatabaseAccess) GetGlobal() OptBool {
-	return s.Global
+// GetXToken returns the value of XToken.
+func (s *BatchSelectOKTextCsvHeaders) GetXToken() OptString {
+	return s.XToken
 }
 
-// GetDatabase returns the value of Database.
-func (s *ConfigDatabaseAccess) GetDatabase() []DatabaseConfig {
-	return s.Database
+// GetResponse returns the value of Response.
+func (s *BatchSelectOKTextCsvHeaders) GetResponse() BatchSelectOKTextCsv {
+	return s.Response
 }
 
-// SetGlobal sets the value of Global.
-func (s *ConfigDatabaseAccess) SetGlobal(val OptBool) {
-	s.Global = val
+// SetXToken sets the value of XToken.
+func (s *BatchSelectOKTextCsvHeaders) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-// SetDatabase sets the value of Database.
-func (s *ConfigDatabaseAccess) SetDatabase(val []DatabaseConfig) {
-	s.Database = val
+// SetResponse sets the value of Response.
+func (s *BatchSelectOKTextCsvHeaders) SetResponse(val BatchSelectOKTextCsv) {
+	s.Response = val
 }
 
-type ConfigMapping struct {
-	Directory OptString        `json:"Directory"`
-	Database  []DatabaseConfig `json:"Database"`
-}
+func (*BatchSelectOKTextCsvHeaders) batchSelectRes() {}
 
-// GetDirectory returns the value of Directory.
-func (s *ConfigMapping) GetDirectory() OptString {
-	return s.Directory
-}
+// BatchSelectUnauthorized is response for BatchSelect operation.
+type BatchSelectUnauthorized struct{}
 
-// GetDatabase returns the value of Database.
-func (s *ConfigMapping) GetDatabase() []DatabaseConfig {
-	return s.Database
-}
+func (*BatchSelectUnauthorized) batchSelectRes() {}
 
-// SetDirectory sets the value of Directory.
-func (s *ConfigMapping) SetDirectory(val OptString) {
-	s.Directory = val
+type BearerAuth struct {
+	Token string
+	Roles []string
 }
 
-// SetDatabase sets the value of Database.
-func (s *ConfigMapping) SetDatabase(val []DatabaseConfig) {
-	s.Database = val
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
 }
 
-type ConfigMetrics struct {
-	Database []DatabaseConfig `json:"Database"`
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
 }
 
-// GetDatabase returns the value of Database.
-func (s *ConfigMetrics) GetDatabase() []DatabaseConfig {
-	return s.Database
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
 }
 
-// SetDatabase sets the value of Database.
-func (s *ConfigMetrics) SetDatabase(val []DatabaseConfig) {
-	s.Database = val
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
 }
 
-type ConfigModule struct {
-	Data         OptString                      `json:"Data"`
-	Installation []ConfigModuleInstallationItem `json:"Installation"`
-	Directories  []ConfigModuleDirectoriesItem  `json:"Directories"`
-}
+type BrowseListBadRequest Error
 
-// GetData returns the value of Data.
-func (s *ConfigModule) GetData() OptString {
-	return s.Data
-}
-
-// GetInstallation returns the value of Installation.
-func (s *ConfigModule) GetInstallation() []ConfigModuleInstallationItem {
-	return s.Installation
-}
-
-// GetDirectories returns the value of Directories.
-func (s *ConfigModule) GetDirectories() []ConfigModuleDirectoriesItem {
-	return s.Directories
-}
+func (*BrowseListBadRequest) browseListRes() {}
 
-// SetData sets the value of Data.
-func (s *ConfigModule) SetData(val OptString) {
-	s.Data = val
-}
+// BrowseListForbidden is response for BrowseList operation.
+type BrowseListForbidden struct{}
 
-// SetInstallation sets the value of Installation.
-func (s *ConfigModule) SetInstallation(val []ConfigModuleInstallationItem) {
-	s.Installation = val
-}
+func (*BrowseListForbidden) browseListRes() {}
 
-// SetDirectories sets the value of Directories.
-func (s *ConfigModule) SetDirectories(val []ConfigModuleDirectoriesItem) {
-	s.Directories = val
-}
+type BrowseListNotFound Error
 
-type ConfigModuleDirectoriesItem struct {
-	Name     OptString `json:"name"`
-	Location OptString `json:"location"`
-}
+func (*BrowseListNotFound) browseListRes() {}
 
-// GetName returns the value of Name.
-func (s *ConfigModuleDirectoriesItem) GetName() OptString {
-	return s.Name
-}
+// BrowseListUnauthorized is response for BrowseList operation.
+type BrowseListUnauthorized struct{}
 
-// GetLocation returns the value of Location.
-func (s *ConfigModuleDirectoriesItem) GetLocation() OptString {
-	return s.Location
-}
+func (*BrowseListUnauthorized) browseListRes() {}
 
-// SetName sets the value of Name.
-func (s *ConfigModuleDirectoriesItem) SetName(val OptString) {
-	s.Name = val
-}
+type BrowseLocationBadRequest Error
 
-// SetLocation sets the value of Location.
-func (s *ConfigModuleDirectoriesItem) SetLocation(val OptString) {
-	s.Location = val
-}
+func (*BrowseLocationBadRequest) browseLocationRes() {}
 
-type ConfigModuleInstallationItem struct {
-	Location       OptString `json:"Location"`
-	Version        OptString `json:"Version"`
-	StructureLevel OptInt    `json:"StructureLevel"`
-	Active         OptBool   `json:"Active"`
-}
+// BrowseLocationForbidden is response for BrowseLocation operation.
+type BrowseLocationForbidden struct{}
 
-// GetLocation returns the value of Location.
-func (s *ConfigModuleInstallationItem) GetLocation() OptString {
-	return s.Location
-}
+func (*BrowseLocationForbidden) browseLocationRes() {}
 
-// GetVersion returns the value of Version.
-func (s *ConfigModuleInstallationItem) GetVersion() OptString {
-	return s.Version
-}
+type BrowseLocationNotFound Error
 
-// GetStructureLevel returns the value of StructureLevel.
-func (s *ConfigModuleInstallationItem) GetStructureLevel() OptInt {
-	return s.StructureLevel
-}
+func (*BrowseLocationNotFound) browseLocationRes() {}
 
-// GetActive returns the value of Active.
-func (s *ConfigModuleInstallationItem) GetActive() OptBool {
-	return s.Active
+// BrowseLocationOK represents sum type.
+type BrowseLocationOK struct {
+	// Type selects the active sum variant, switch on this field.
+	Type           BrowseLocationOKType
+	DirectoryFiles DirectoryFiles
+	File           File
 }
 
-// SetLocation sets the value of Location.
-func (s *ConfigModuleInstallationItem) SetLocation(val OptString) {
-	s.Location = val
-}
+// BrowseLocationOKType is oneOf type of BrowseLocationOK.
+type BrowseLocationOKType string
 
-// SetVersion sets the value of Version.
-func (s *ConfigModuleInstallationItem) SetVersion(val OptString) {
-	s.Version = val
-}
+// Possible values for BrowseLocationOKType.
+const (
+	DirectoryFilesBrowseLocationOK BrowseLocationOKType = "DirectoryFiles"
+	FileBrowseLocationOK           BrowseLocationOKType = "File"
+)
 
-// SetStructureLevel sets the value of StructureLevel.
-func (s *ConfigModuleInstallationItem) SetStructureLevel(val OptInt) {
-	s.StructureLevel = val
-}
+// IsDirectoryFiles reports whether BrowseLocationOK is DirectoryFiles.
+func (s BrowseLocationOK) IsDirectoryFiles() bool { return s.Type == DirectoryFilesBrowseLocationOK }
 
-// SetActive sets the value of Active.
-func (s *ConfigModuleInstallationItem) SetActive(val OptBool) {
-	s.Active = val
-}
+// IsFile reports whether BrowseLocationOK is File.
+func (s BrowseLocationOK) IsFile() bool { return s.Type == FileBrowseLocationOK }
 
-type ConfigServer struct {
-	Service     []ConfigServerServiceItem  `json:"Service"`
-	LogLocation OptConfigServerLogLocation `json:"LogLocation"`
-	Content     OptString                  `json:"Content"`
+// SetDirectoryFiles sets BrowseLocationOK to DirectoryFiles.
+func (s *BrowseLocationOK) SetDirectoryFiles(v DirectoryFiles) {
+	s.Type = DirectoryFilesBrowseLocationOK
+	s.DirectoryFiles = v
 }
 
-// GetService returns the value of Service.
-func (s *ConfigServer) GetService() []ConfigServerServiceItem {
-	return s.Service
+// GetDirectoryFiles returns DirectoryFiles and true boolean if BrowseLocationOK is DirectoryFiles.
+func (s BrowseLocationOK) GetDirectoryFiles() (v DirectoryFiles, ok bool) {
+	if !s.IsDirectoryFiles() {
+		return v, false
+	}
+	return s.DirectoryFiles, true
 }
 
-// GetLogLocation returns the value of LogLocation.
-func (s *ConfigServer) GetLogLocation() OptConfigServerLogLocation {
-	return s.LogLocation
+// NewDirectoryFilesBrowseLocationOK returns new BrowseLocationOK from DirectoryFiles.
+func NewDirectoryFilesBrowseLocationOK(v DirectoryFiles) BrowseLocationOK {
+	var s BrowseLocationOK
+	s.SetDirectoryFiles(v)
+	return s
 }
 
-// GetContent returns the value of Content.
-func (s *ConfigServer) GetContent() OptString {
-	return s.Content
+// SetFile sets BrowseLocationOK to File.
+func (s *BrowseLocationOK) SetFile(v File) {
+	s.Type = FileBrowseLocationOK
+	s.File = v
 }
 
-// SetService sets the value of Service.
-func (s *ConfigServer) SetService(val []ConfigServerServiceItem) {
-	s.Service = val
+// GetFile returns File and true boolean if BrowseLocationOK is File.
+func (s BrowseLocationOK) GetFile() (v File, ok bool) {
+	if !s.IsFile() {
+		return v, false
+	}
+	return s.File, true
 }
 
-// SetLogLocation sets the value of LogLocation.
-func (s *ConfigServer) SetLogLocation(val OptConfigServerLogLocation) {
-	s.LogLocation = val
+// NewFileBrowseLocationOK returns new BrowseLocationOK from File.
+func NewFileBrowseLocationOK(v File) BrowseLocationOK {
+	var s BrowseLocationOK
+	s.SetFile(v)
+	return s
 }
 
-// SetContent sets the value of Content.
-func (s *ConfigServer) SetContent(val OptString) {
-	s.Content = val
-}
+func (*BrowseLocationOK) browseLocationRes() {}
 
-type ConfigServerLogLocation struct {
-	Directory OptString `json:"directory"`
-	Level     OptString `json:"level"`
-}
+// BrowseLocationUnauthorized is response for BrowseLocation operation.
+type BrowseLocationUnauthorized struct{}
 
-// GetDirectory returns the value of Directory.
-func (s *ConfigServerLogLocation) GetDirectory() OptString {
-	return s.Directory
-}
+func (*BrowseLocationUnauthorized) browseLocationRes() {}
 
-// GetLevel returns the value of Level.
-func (s *ConfigServerLogLocation) GetLevel() OptString {
-	return s.Level
-}
+type CallExtendBadRequest Error
 
-// SetDirectory sets the value of Directory.
-func (s *ConfigServerLogLocation) SetDirectory(val OptString) {
-	s.Directory = val
-}
+func (*CallExtendBadRequest) callExtendRes() {}
 
-// SetLevel sets the value of Level.
-func (s *ConfigServerLogLocation) SetLevel(val OptString) {
-	s.Level = val
-}
+// CallExtendForbidden is response for CallExtend operation.
+type CallExtendForbidden struct{}
 
-type ConfigServerServiceItem struct {
-	Port OptFloat64 `json:"port"`
-	Type OptString  `json:"type"`
-}
+func (*CallExtendForbidden) callExtendRes() {}
 
-// GetPort returns the value of Port.
-func (s *ConfigServerServiceItem) GetPort() OptFloat64 {
-	return s.Port
-}
+type CallExtendNotFound Error
 
-// GetType returns the value of Type.
-func (s *ConfigServerServiceItem) GetType() OptString {
-	return s.Type
-}
+func (*CallExtendNotFound) callExtendRes() {}
 
-// SetPort sets the value of Port.
-func (s *ConfigServerServiceItem) SetPort(val OptFloat64) {
-	s.Port = val
+type CallExtendOKApplicationOctetStream struct {
+	Data io.Reader
 }
 
-// SetType sets the value of Type.
-func (s *ConfigServerServiceItem) SetType(val OptString) {
-	s.Type = val
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s CallExtendOKApplicationOctetStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-type CreateDirectoryBadRequest Error
+func (*CallExtendOKApplicationOctetStream) callExtendRes() {}
 
-func (*CreateDirectoryBadRequest) createDirectoryRes() {}
+// CallExtendUnauthorized is response for CallExtend operation.
+type CallExtendUnauthorized struct{}
 
-// CreateDirectoryForbidden is response for CreateDirectory operation.
-type CreateDirectoryForbidden struct{}
+func (*CallExtendUnauthorized) callExtendRes() {}
 
-func (*CreateDirectoryForbidden) createDirectoryRes() {}
+type CallPostExtendBadRequest Error
 
-type CreateDirectoryNotFound Error
+func (*CallPostExtendBadRequest) callPostExtendRes() {}
 
-func (*CreateDirectoryNotFound) createDirectoryRes() {}
+// CallPostExtendForbidden is response for CallPostExtend operation.
+type CallPostExtendForbidden struct{}
 
-// CreateDirectoryUnauthorized is response for CreateDirectory operation.
-type CreateDirectoryUnauthorized struct{}
+func (*CallPostExtendForbidden) callPostExtendRes() {}
 
-func (*CreateDirectoryUnauthorized) createDirectoryRes() {}
+type CallPostExtendNotFound Error
 
-// Ref: #/components/schemas/Database
-type Database struct{}
+func (*CallPostExtendNotFound) callPostExtendRes() {}
 
-// Ref: #/components/schemas/DatabaseConfig
-type DatabaseConfig struct {
-	URL     OptString  `json:"url"`
-	File    OptFloat64 `json:"file"`
-	Changed OptBool    `json:"changed"`
-	Deleted OptBool    `json:"deleted"`
+type CallPostExtendOKApplicationOctetStream struct {
+	Data io.Reader
 }
 
-// GetURL returns the value of URL.
-func (s *DatabaseConfig) GetURL() OptString {
-	return s.URL
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s CallPostExtendOKApplicationOctetStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetFile returns the value of File.
-func (s *DatabaseConfig) GetFile() OptFloat64 {
-	return s.File
+func (*CallPostExtendOKApplicationOctetStream) callPostExtendRes() {}
+
+type CallPostExtendReq struct {
+	// Upload data.
+	UploadFile OptMultipartFile `json:"uploadFile"`
 }
 
-// GetChanged returns the value of Changed.
-func (s *DatabaseConfig) GetChanged() OptBool {
-	return s.Changed
+// GetUploadFile returns the value of UploadFile.
+func (s *CallPostExtendReq) GetUploadFile() OptMultipartFile {
+	return s.UploadFile
 }
 
-// GetDeleted returns the value of Deleted.
-func (s *DatabaseConfig) GetDeleted() OptBool {
-	return s.Deleted
+// SetUploadFile sets the value of UploadFile.
+func (s *CallPostExtendReq) SetUploadFile(val OptMultipartFile) {
+	s.UploadFile = val
 }
 
-// SetURL sets the value of URL.
-func (s *DatabaseConfig) SetURL(val OptString) {
-	s.URL = val
+// CallPostExtendUnauthorized is response for CallPostExtend operation.
+type CallPostExtendUnauthorized struct{}
+
+func (*CallPostExtendUnauthorized) callPostExtendRes() {}
+
+// CancelUploadForbidden is response for CancelUpload operation.
+type CancelUploadForbidden struct{}
+
+func (*CancelUploadForbidden) cancelUploadRes() {}
+
+// CancelUploadUnauthorized is response for CancelUpload operation.
+type CancelUploadUnauthorized struct{}
+
+func (*CancelUploadUnauthorized) cancelUploadRes() {}
+
+// Ref: #/components/schemas/ClusterConfig
+type ClusterConfig struct {
+	Nodes []ClusterConfigNodesItem `json:"Nodes"`
 }
 
-// SetFile sets the value of File.
-func (s *DatabaseConfig) SetFile(val OptFloat64) {
-	s.File = val
+// GetNodes returns the value of Nodes.
+func (s *ClusterConfig) GetNodes() []ClusterConfigNodesItem {
+	return s.Nodes
 }
 
-// SetChanged sets the value of Changed.
-func (s *DatabaseConfig) SetChanged(val OptBool) {
-	s.Changed = val
+// SetNodes sets the value of Nodes.
+func (s *ClusterConfig) SetNodes(val []ClusterConfigNodesItem) {
+	s.Nodes = val
 }
 
-// SetDeleted sets the value of Deleted.
-func (s *DatabaseConfig) SetDeleted(val OptBool) {
-	s.Deleted = val
+type ClusterConfigNodesItem struct {
+	Name  OptString `json:"Name"`
+	Web   OptString `json:"Web"`
+	Group OptString `json:"Group"`
 }
 
-// Ref: #/components/schemas/DatabaseInformation
-type DatabaseInformation struct {
-	Active         OptBool   `json:"Active"`
-	Dbid           OptInt    `json:"Dbid"`
-	Name           OptString `json:"Name"`
-	ServiceVersion OptString `json:"ServiceVersion"`
-	StructureLevel OptInt    `json:"StructureLevel"`
-	Version        OptString `json:"Version"`
-	Location       OptString `json:"Location"`
+// GetName returns the value of Name.
+func (s *ClusterConfigNodesItem) GetName() OptString {
+	return s.Name
 }
 
-// GetActive returns the value of Active.
-func (s *DatabaseInformation) GetActive() OptBool {
-	return s.Active
+// GetWeb returns the value of Web.
+func (s *ClusterConfigNodesItem) GetWeb() OptString {
+	return s.Web
 }
 
-// GetDbid returns the value of Dbid.
-func (s *DatabaseInformation) GetDbid() OptInt {
-	return s.Dbid
+// GetGroup returns the value of Group.
+func (s *ClusterConfigNodesItem) GetGroup() OptString {
+	return s.Group
 }
 
-// GetName returns the value of Name.
-func (s *DatabaseInformation) GetName() OptString {
-	return s.Name
+// SetName sets the value of Name.
+func (s *ClusterConfigNodesItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetServiceVersion returns the value of ServiceVersion.
-func (s *DatabaseInformation) GetServiceVersion() OptString {
-	return s.ServiceVersion
+// SetWeb sets the value of Web.
+func (s *ClusterConfigNodesItem) SetWeb(val OptString) {
+	s.Web = val
+}
+
+// SetGroup sets the value of Group.
+func (s *ClusterConfigNodesItem) SetGroup(val OptString) {
+	s.Group = val
+}
+
+// Ref: #/components/schemas/Config
+type Config struct {
+	StructureLevel OptInt                  `json:"StructureLevel"`
+	Version        OptString               `json:"Version"`
+	Server         OptConfigServer         `json:"Server"`
+	JobStore       OptJobStore             `json:"JobStore"`
+	Cluster        OptClusterConfig        `json:"Cluster"`
+	Mapping        OptConfigMapping        `json:"Mapping"`
+	DatabaseAccess OptConfigDatabaseAccess `json:"DatabaseAccess"`
+	Module         OptConfigModule         `json:"Module"`
+	Metrics        OptConfigMetrics        `json:"Metrics"`
 }
 
 // GetStructureLevel returns the value of StructureLevel.
-func (s *DatabaseInformation) GetStructureLevel() OptInt {
+func (s *Config) GetStructureLevel() OptInt {
 	return s.StructureLevel
 }
 
 // GetVersion returns the value of Version.
-func (s *DatabaseInformation) GetVersion() OptString {
+func (s *Config) GetVersion() OptString {
 	return s.Version
 }
 
-// GetLocation returns the value of Location.
-func (s *DatabaseInformation) GetLocation() OptString {
-	return s.Location
+// GetServer returns the value of Server.
+func (s *Config) GetServer() OptConfigServer {
+	return s.Server
 }
 
-// SetActive sets the value of Active.
-func (s *DatabaseInformation) SetActive(val OptBool) {
-	s.Active = val
+// GetJobStore returns the value of JobStore.
+func (s *Config) GetJobStore() OptJobStore {
+	return s.JobStore
 }
 
-// SetDbid sets the value of Dbid.
-func (s *DatabaseInformation) SetDbid(val OptInt) {
-	s.Dbid = val
+// GetCluster returns the value of Cluster.
+func (s *Config) GetCluster() OptClusterConfig {
+	return s.Cluster
 }
 
-// SetName sets the value of Name.
-func (s *DatabaseInformation) SetName(val OptString) {
-	s.Name = val
+// GetMapping returns the value of Mapping.
+func (s *Config) GetMapping() OptConfigMapping {
+	return s.Mapping
 }
 
-// SetServiceVersion sets the value of ServiceVersion.
-func (s *DatabaseInformation) SetServiceVersion(val OptString) {
-	s.ServiceVersion = val
+// GetDatabaseAccess returns the value of DatabaseAccess.
+func (s *Config) GetDatabaseAccess() OptConfigDatabaseAccess {
+	return s.DatabaseAccess
+}
+
+// GetModule returns the value of Module.
+func (s *Config) GetModule() OptConfigModule {
+	return s.Module
+}
+
+// GetMetrics returns the value of Metrics.
+func (s *Config) GetMetrics() OptConfigMetrics {
+	return s.Metrics
 }
 
 // SetStructureLevel sets the value of StructureLevel.
-func (s *DatabaseInformation) SetStructureLevel(val OptInt) {
+func (s *Config) SetStructureLevel(val OptInt) {
 	s.StructureLevel = val
 }
 
 // SetVersion sets the value of Version.
-func (s *DatabaseInformation) SetVersion(val OptString) {
+func (s *Config) SetVersion(val OptString) {
 	s.Version = val
 }
 
-// SetLocation sets the value of Location.
-func (s *DatabaseInformation) SetLocation(val OptString) {
-	s.Location = val
+// SetServer sets the value of Server.
+func (s *Config) SetServer(val OptConfigServer) {
+	s.Server = val
 }
 
-// Database entry in database list.
-// Ref: #/components/schemas/Databases
-type Databases struct {
-	Database []DatabaseInformation `json:"Database"`
+// SetJobStore sets the value of JobStore.
+func (s *Config) SetJobStore(val OptJobStore) {
+	s.JobStore = val
 }
 
-// GetDatabase returns the value of Database.
-func (s *Databases) GetDatabase() []DatabaseInformation {
-	return s.Database
+// SetCluster sets the value of Cluster.
+func (s *Config) SetCluster(val OptClusterConfig) {
+	s.Cluster = val
 }
 
-// SetDatabase sets the value of Database.
-func (s *Databases) SetDatabase(val []DatabaseInformation) {
-	s.Database = val
+// SetMapping sets the value of Mapping.
+func (s *Config) SetMapping(val OptConfigMapping) {
+	s.Mapping = val
 }
 
-func (*Databases) getDatabasesRes() {}
-
-type DeleteExtendBadRequest Error
+// SetDatabaseAccess sets the value of DatabaseAccess.
+func (s *Config) SetDatabaseAccess(val OptConfigDatabaseAccess) {
+	s.DatabaseAccess = val
+}
 
-func (*DeleteExtendBadRequest) deleteExtendRes() {}
+// SetModule sets the value of Module.
+func (s *Config) SetModule(val OptConfigModule) {
+	s.Module = val
+}
 
-// DeleteExtendForbidden is response for DeleteExtend operation.
-type DeleteExtendForbidden struct{}
+// SetMetrics sets the value of Metrics.
+func (s *Config) SetMetrics(val OptConfigMetrics) {
+	s.Metrics = val
+}
 
-func (*DeleteExtendForbidden) deleteExtendRes() {}
+func (*Config) getConfigRes() {}
+func (*Config) setConfigReq() {}
 
-type DeleteExtendNotFound Error
+type ConfigDatabaseAccess struct {
+	Global   OptBool          `json:"Global"`
+	Database []DatabaseConfig `json:"Database"`
+}
 
-func (*DeleteExtendNotFound) deleteExtendRes() {}
+// GetGlobal returns the value of Global.
+func (s *ConfigDatabaseAccess) GetGlobal() OptBool {
+	return s.Global
+}
 
-type DeleteExtendOKApplicationOctetStream struct {
-	Data io.Reader
+// GetDatabase returns the value of Database.
+func (s *ConfigDatabaseAccess) GetDatabase() []DatabaseConfig {
+	return s.Database
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s DeleteExtendOKApplicationOctetStream) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetGlobal sets the value of Global.
+func (s *ConfigDatabaseAccess) SetGlobal(val OptBool) {
+	s.Global = val
 }
 
-func (*DeleteExtendOKApplicationOctetStream) deleteExtendRes() {}
+// SetDatabase sets the value of Database.
+func (s *ConfigDatabaseAccess) SetDatabase(val []DatabaseConfig) {
+	s.Database = val
+}
 
-// DeleteExtendUnauthorized is response for DeleteExtend operation.
-type DeleteExtendUnauthorized struct{}
+type ConfigMapping struct {
+	Directory OptString        `json:"Directory"`
+	Database  []DatabaseConfig `json:"Database"`
+}
 
-func (*DeleteExtendUnauthorized) deleteExtendRes() {}
+// GetDirectory returns the value of Directory.
+func (s *ConfigMapping) GetDirectory() OptString {
+	return s.Directory
+}
 
-type DeleteFileLocationBadRequest Error
-
-func (*DeleteFileLocationBadRequest) deleteFileLocationRes() {}
-
-// DeleteFileLocationForbidden is response for DeleteFileLocation operation.
-type DeleteFileLocationForbidden struct{}
-
-func (*DeleteFileLocationForbidden) deleteFileLocationRes() {}
-
-type DeleteFileLocationNotFound Error
-
-func (*DeleteFileLocationNotFound) deleteFileLocationRes() {}
-
-// DeleteFileLocationUnauthorized is response for DeleteFileLocation operation.
-type DeleteFileLocationUnauthorized struct{}
-
-func (*DeleteFileLocationUnauthorized) deleteFileLocationRes() {}
-
-type DeleteJobResultBadRequest Error
-
-func (*DeleteJobResultBadRequest) deleteJobResultRes() {}
-
-// DeleteJobResultForbidden is response for DeleteJobResult operation.
-type DeleteJobResultForbidden struct{}
-
-func (*DeleteJobResultForbidden) deleteJobResultRes() {}
-
-type DeleteJobResultNotFound Error
-
-func (*DeleteJobResultNotFound) deleteJobResultRes() {}
-
-// DeleteJobResultUnauthorized is response for DeleteJobResult operation.
-type DeleteJobResultUnauthorized struct{}
-
-func (*DeleteJobResultUnauthorized) deleteJobResultRes() {}
-
-// DeleteRecordsSearchedForbidden is response for DeleteRecordsSearched operation.
-type DeleteRecordsSearchedForbidden struct{}
-
-func (*DeleteRecordsSearchedForbidden) deleteRecordsSearchedRes() {}
-
-// DeleteRecordsSearchedUnauthorized is response for DeleteRecordsSearched operation.
-type DeleteRecordsSearchedUnauthorized struct{}
+// GetDatabase returns the value of Database.
+func (s *ConfigMapping) GetDatabase() []DatabaseConfig {
+	return s.Database
+}
 
-func (*DeleteRecordsSearchedUnauthorized) deleteRecordsSearchedRes() {}
+// SetDirectory sets the value of Directory.
+func (s *ConfigMapping) SetDirectory(val OptString) {
+	s.Directory = val
+}
 
-// DeleteViewForbidden is response for DeleteView operation.
-type DeleteViewForbidden struct{}
+// SetDatabase sets the value of Database.
+func (s *ConfigMapping) SetDatabase(val []DatabaseConfig) {
+	s.Database = val
+}
 
-func (*DeleteViewForbidden) deleteViewRes() {}
+type ConfigMetrics struct {
+	Database []DatabaseConfig `json:"Database"`
+}
 
-// DeleteViewOK is response for DeleteView operation.
-type DeleteViewOK struct{}
+// GetDatabase returns the value of Database.
+func (s *ConfigMetrics) GetDatabase() []DatabaseConfig {
+	return s.Database
+}
 
-func (*DeleteViewOK) deleteViewRes() {}
+// SetDatabase sets the value of Database.
+func (s *ConfigMetrics) SetDatabase(val []DatabaseConfig) {
+	s.Database = val
+}
 
-// DeleteViewUnauthorized is response for DeleteView operation.
-type DeleteViewUnauthorized struct{}
+type ConfigModule struct {
+	Data         OptString                      `json:"Data"`
+	Installation []ConfigModuleInstallationItem `json:"Installation"`
+	Directories  []ConfigModuleDirectoriesItem  `json:"Directories"`
+}
 
-func (*DeleteViewUnauthorized) deleteViewRes() {}
+// GetData returns the value of Data.
+func (s *ConfigModule) GetData() OptString {
+	return s.Data
+}
 
-// Ref: #/components/schemas/Directories
-type Directories struct {
-	Directories []Directory `json:"Directories"`
-	System      OptString   `json:"system"`
+// GetInstallation returns the value of Installation.
+func (s *ConfigModule) GetInstallation() []ConfigModuleInstallationItem {
+	return s.Installation
 }
 
 // GetDirectories returns the value of Directories.
-func (s *Directories) GetDirectories() []Directory {
+func (s *ConfigModule) GetDirectories() []ConfigModuleDirectoriesItem {
 	return s.Directories
 }
 
-// GetSystem returns the value of System.
-func (s *Directories) GetSystem() OptString {
-	return s.System
+// SetData sets the value of Data.
+func (s *ConfigModule) SetData(val OptString) {
+	s.Data = val
+}
+
+// SetInstallation sets the value of Installation.
+func (s *ConfigModule) SetInstallation(val []ConfigModuleInstallationItem) {
+	s.Installation = val
 }
 
 // SetDirectories sets the value of Directories.
-func (s *Directories) SetDirectories(val []Directory) {
+func (s *ConfigModule) SetDirectories(val []ConfigModuleDirectoriesItem) {
 	s.Directories = val
 }
 
-// SetSystem sets the value of System.
-func (s *Directories) SetSystem(val OptString) {
-	s.System = val
+type ConfigModuleDirectoriesItem struct {
+	Name     OptString `json:"name"`
+	Location OptString `json:"location"`
 }
 
-func (*Directories) browseListRes() {}
-
-// Ref: #/components/schemas/Directory
-type Directory struct {
-	Location OptString `json:"Location"`
-	Name     OptString `json:"Name"`
+// GetName returns the value of Name.
+func (s *ConfigModuleDirectoriesItem) GetName() OptString {
+	return s.Name
 }
 
 // GetLocation returns the value of Location.
-func (s *Directory) GetLocation() OptString {
+func (s *ConfigModuleDirectoriesItem) GetLocation() OptString {
 	return s.Location
 }
 
-// GetName returns the value of Name.
-func (s *Directory) GetName() OptString {
-	return s.Name
+// SetName sets the value of Name.
+func (s *ConfigModuleDirectoriesItem) SetName(val OptString) {
+	s.Name = val
 }
 
 // SetLocation sets the value of Location.
-func (s *Directory) SetLocation(val OptString) {
+func (s *ConfigModuleDirectoriesItem) SetLocation(val OptString) {
 	s.Location = val
 }
 
-// SetName sets the value of Name.
-func (s *Directory) SetName(val OptString) {
-	s.Name = val
-}
-
-// Ref: #/components/schemas/DirectoryFiles
-type DirectoryFiles struct {
-	Location OptString `json:"Location"`
-	Path     OptString `json:"Path"`
-	Files    []File    `json:"Files"`
-	System   OptString `json:"system"`
+type ConfigModuleInstallationItem struct {
+	Location       OptString `json:"Location"`
+	Version        OptString `json:"Version"`
+	StructureLevel OptInt    `json:"StructureLevel"`
+	Active         OptBool   `json:"Active"`
 }
 
 // GetLocation returns the value of Location.
-func (s *DirectoryFiles) GetLocation() OptString {
+func (s *ConfigModuleInstallationItem) GetLocation() OptString {
 	return s.Location
 }
 
-// GetPath returns the value of Path.
-func (s *DirectoryFiles) GetPath() OptString {
-	return s.Path
+// GetVersion returns the value of Version.
+func (s *ConfigModuleInstallationItem) GetVersion() OptString {
+	return s.Version
 }
 
-// GetFiles returns the value of Files.
-func (s *DirectoryFiles) GetFiles() []File {
-	return s.Files
+// GetStructureLevel returns the value of StructureLevel.
+func (s *ConfigModuleInstallationItem) GetStructureLevel() OptInt {
+	return s.StructureLevel
 }
 
-// GetSystem returns the value of System.
-func (s *DirectoryFiles) GetSystem() OptString {
-	return s.System
+// GetActive returns the value of Active.
+func (s *ConfigModuleInstallationItem) GetActive() OptBool {
+	return s.Active
 }
 
 // SetLocation sets the value of Location.
-func (s *DirectoryFiles) SetLocation(val OptString) {
+func (s *ConfigModuleInstallationItem) SetLocation(val OptString) {
 	s.Location = val
 }
 
-// SetPath sets the value of Path.
-func (s *DirectoryFiles) SetPath(val OptString) {
-	s.Path = val
+// SetVersion sets the value of Version.
+func (s *ConfigModuleInstallationItem) SetVersion(val OptString) {
+	s.Version = val
 }
 
-// SetFiles sets the value of Files.
-func (s *DirectoryFiles) SetFiles(val []File) {
-	s.Files = val
+// SetStructureLevel sets the value of StructureLevel.
+func (s *ConfigModuleInstallationItem) SetStructureLevel(val OptInt) {
+	s.StructureLevel = val
 }
 
-// SetSystem sets the value of System.
-func (s *DirectoryFiles) SetSystem(val OptString) {
-	s.System = val
+// SetActive sets the value of Active.
+func (s *ConfigModuleInstallationItem) SetActive(val OptBool) {
+	s.Active = val
 }
 
-func (*DirectoryFiles) browseLocationRes() {}
+type ConfigServer struct {
+	Service     []ConfigServerServiceItem  `json:"Service"`
+	LogLocation OptConfigServerLogLocation `json:"LogLocation"`
+	Content     OptString                  `json:"Content"`
+}
 
-type DownloadFileBadRequest Error
+// GetService returns the value of Service.
+func (s *ConfigServer) GetService() []ConfigServerServiceItem {
+	return s.Service
+}
 
-func (*DownloadFileBadRequest) downloadFileRes() {}
+// GetLogLocation returns the value of LogLocation.
+func (s *ConfigServer) GetLogLocation() OptConfigServerLogLocation {
+	return s.LogLocation
+}
 
-// DownloadFileForbidden is response for DownloadFile operation.
-type DownloadFileForbidden struct{}
+// GetContent returns the value of Content.
+func (s *ConfigServer) GetContent() OptString {
+	return s.Content
+}
 
-func (*DownloadFileForbidden) downloadFileRes() {}
+// SetService sets the value of Service.
+func (s *ConfigServer) SetService(val []ConfigServerServiceItem) {
+	s.Service = val
+}
 
-type DownloadFileNotFound Error
+// SetLogLocation sets the value of LogLocation.
+func (s *ConfigServer) SetLogLocation(val OptConfigServerLogLocation) {
+	s.LogLocation = val
+}
 
-func (*DownloadFileNotFound) downloadFileRes() {}
+// SetContent sets the value of Content.
+func (s *ConfigServer) SetContent(val OptString) {
+	s.Content = val
+}
 
-type DownloadFileOK struct {
-	Data io.Reader
+type ConfigServerLogLocation struct {
+	Directory OptString `json:"directory"`
+	Level     OptString `json:"level"`
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s DownloadFileOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// GetDirectory returns the value of Directory.
+func (s *ConfigServerLogLocation) GetDirectory() OptString {
+	return s.Directory
 }
 
-func (*DownloadFileOK) downloadFileRes() {}
+// GetLevel returns the value of Level.
+func (s *ConfigServerLogLocation) GetLevel() OptString {
+	return s.Level
+}
 
-// DownloadFileUnauthorized is response for DownloadFile operation.
-type DownloadFileUnauthorized struct{}
+// SetDirectory sets the value of Directory.
+func (s *ConfigServerLogLocation) SetDirectory(val OptString) {
+	s.Directory = val
+}
 
-func (*DownloadFileUnauthorized) downloadFileRes() {}
+// SetLevel sets the value of Level.
+func (s *ConfigServerLogLocation) SetLevel(val OptString) {
+	s.Level = val
+}
 
-// Ref: #/components/schemas/Error
-type Error struct {
-	Code    OptString     `json:"code"`
-	Message OptString     `json:"message"`
-	Target  OptString     `json:"target"`
-	Error   OptErrorError `json:"Error"`
+type ConfigServerServiceItem struct {
+	Port OptFloat64 `json:"port"`
+	Type OptString  `json:"type"`
 }
 
-// GetCode returns the value of Code.
-func (s *Error) GetCode() OptString {
-	return s.Code
+// GetPort returns the value of Port.
+func (s *ConfigServerServiceItem) GetPort() OptFloat64 {
+	return s.Port
 }
 
-// GetMessage returns the value of Message.
-func (s *Error) GetMessage() OptString {
-	return s.Message
+// GetType returns the value of Type.
+func (s *ConfigServerServiceItem) GetType() OptString {
+	return s.Type
 }
 
-// GetTarget returns the value of Target.
-func (s *Error) GetTarget() OptString {
-	return s.Target
+// SetPort sets the value of Port.
+func (s *ConfigServerServiceItem) SetPort(val OptFloat64) {
+	s.Port = val
 }
 
-// GetError returns the value of Error.
-func (s *Error) GetError() OptErrorError {
-	return s.Error
+// SetType sets the value of Type.
+func (s *ConfigServerServiceItem) SetType(val OptString) {
+	s.Type = val
 }
 
-// SetCode sets the value of Code.
-func (s *Error) SetCode(val OptString) {
-	s.Code = val
-}
+type CreateDirectoryBadRequest Error
 
-// SetMessage sets the value of Message.
-func (s *Error) SetMessage(val OptString) {
-	s.Message = val
-}
+func (*CreateDirectoryBadRequest) createDirectoryRes() {}
 
-// SetTarget sets the value of Target.
-func (s *Error) SetTarget(val OptString) {
-	s.Target = val
-}
+// CreateDirectoryForbidden is response for CreateDirectory operation.
+type CreateDirectoryForbidden struct{}
 
-// SetError sets the value of Error.
-func (s *Error) SetError(val OptErrorError) {
-	s.Error = val
-}
+func (*CreateDirectoryForbidden) createDirectoryRes() {}
 
-func (*Error) addViewRes()               {}
-func (*Error) batchParameterQueryRes()   {}
-func (*Error) batchQueryRes()            {}
-func (*Error) batchSelectRes()           {}
-func (*Error) deleteRecordsSearchedRes() {}
-func (*Error) deleteViewRes()            {}
-func (*Error) getConfigRes()             {}
-func (*Error) getDatabasesRes()          {}
-func (*Error) getImageRes()              {}
-func (*Error) getJobsConfigRes()         {}
-func (*Error) getLobByMapRes()           {}
-func (*Error) getLoginSessionRes()       {}
-func (*Error) getMapMetadataRes()        {}
-func (*Error) getMapRecordsFieldsRes()   {}
-func (*Error) getMapsRes()               {}
-func (*Error) getUserInfoRes()           {}
-func (*Error) getVersionRes()            {}
-func (*Error) getVideoRes()              {}
-func (*Error) getViewsRes()              {}
-func (*Error) insertRecordRes()          {}
-func (*Error) loginSessionRes()          {}
-func (*Error) postDatabaseRes()          {}
-func (*Error) pushLoginSessionRes()      {}
-func (*Error) searchRecordsFieldsRes()   {}
-func (*Error) setConfigRes()             {}
-func (*Error) setJobsConfigRes()         {}
-func (*Error) shutdownServerRes()        {}
-func (*Error) storeConfigRes()           {}
-func (*Error) updateLobByMapRes()        {}
-func (*Error) updateRecordsByFieldsRes() {}
+type CreateDirectoryNotFound Error
 
-type ErrorError struct {
-	Code    OptString `json:"code"`
-	Message OptString `json:"message"`
-	Target  OptString `json:"target"`
-}
+func (*CreateDirectoryNotFound) createDirectoryRes() {}
 
-// GetCode returns the value of Code.
-func (s *ErrorError) GetCode() OptString {
-	return s.Code
-}
+// CreateDirectoryUnauthorized is response for CreateDirectory operation.
+type CreateDirectoryUnauthorized struct{}
 
-// GetMessage returns the value of Message.
-func (s *ErrorError) GetMessage() OptString {
-	return s.Message
-}
+func (*CreateDirectoryUnauthorized) createDirectoryRes() {}
 
-// GetTarget returns the value of Target.
-func (s *ErrorError) GetTarget() OptString {
-	return s.Target
-}
+type CreateUploadBadRequest Error
 
-// SetCode sets the value of Code.
-func (s *ErrorError) SetCode(val OptString) {
-	s.Code = val
-}
+func (*CreateUploadBadRequest) createUploadRes() {}
 
-// SetMessage sets the value of Message.
-func (s *ErrorError) SetMessage(val OptString) {
-	s.Message = val
-}
+type CreateUploadConflict Error
 
-// SetTarget sets the value of Target.
-func (s *ErrorError) SetTarget(val OptString) {
-	s.Target = val
-}
+func (*CreateUploadConflict) createUploadRes() {}
 
-// ErrorStatusCode wraps Error with StatusCode.
-type ErrorStatusCode struct {
-	StatusCode int
-	Response   Error
-}
+// CreateUploadForbidden is response for CreateUpload operation.
+type CreateUploadForbidden struct{}
 
-// GetStatusCode returns the value of StatusCode.
-func (s *ErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
-}
+func (*CreateUploadForbidden) createUploadRes() {}
 
-// GetResponse returns the value of Response.
-func (s *ErrorStatusCode) GetResponse() Error {
-	return s.Response
-}
+type CreateUploadNotFound Error
 
-// SetStatusCode sets the value of StatusCode.
-func (s *ErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
-}
+func (*CreateUploadNotFound) createUploadRes() {}
 
-// SetResponse sets the value of Response.
-func (s *ErrorStatusCode) SetResponse(val Error) {
-	s.Response = val
-}
+// CreateUploadUnauthorized is response for CreateUpload operation.
+type CreateUploadUnauthorized struct{}
 
-// Ref: #/components/schemas/Executions
-type Executions struct {
-	Database  OptInt      `json:"Database"`
-	Ended     OptDateTime `json:"Ended"`
-	ExitCode  OptFloat64  `json:"ExitCode"`
-	ID        OptInt      `json:"Id"`
-	Log       OptString   `json:"Log"`
-	StartedBy OptString   `json:"StartedBy"`
-	Scheduled OptDateTime `json:"Scheduled"`
-}
+func (*CreateUploadUnauthorized) createUploadRes() {}
 
-// GetDatabase returns the value of Database.
-func (s *Executions) GetDatabase() OptInt {
-	return s.Database
-}
+// Ref: #/components/schemas/Database
+type Database struct{}
 
-// GetEnded returns the value of Ended.
-func (s *Executions) GetEnded() OptDateTime {
-	return s.Ended
+// Ref: #/components/schemas/DatabaseConfig
+type DatabaseConfig struct {
+	URL     OptString  `json:"url"`
+	File    OptFloat64 `json:"file"`
+	Changed OptBool    `json:"changed"`
+	Deleted OptBool    `json:"deleted"`
 }
 
-// GetExitCode returns the value of ExitCode.
-func (s *Executions) GetExitCode() OptFloat64 {
-	return s.ExitCode
+// GetURL returns the value of URL.
+func (s *DatabaseConfig) GetURL() OptString {
+	return s.URL
 }
 
-// GetID returns the value of ID.
-func (s *Executions) GetID() OptInt {
-	return s.ID
+// GetFile returns the value of File.
+func (s *DatabaseConfig) GetFile() OptFloat64 {
+	return s.File
 }
 
-// GetLog returns the value of Log.
-func (s *Executions) GetLog() OptString {
-	return s.Log
+// GetChanged returns the value of Changed.
+func (s *DatabaseConfig) GetChanged() OptBool {
+	return s.Changed
 }
 
-// GetStartedBy returns the value of StartedBy.
-func (s *Executions) GetStartedBy() OptString {
-	return s.StartedBy
+// GetDeleted returns the value of Deleted.
+func (s *DatabaseConfig) GetDeleted() OptBool {
+	return s.Deleted
 }
 
-// GetScheduled returns the value of Scheduled.
-func (s *Executions) GetScheduled() OptDateTime {
-	return s.Scheduled
+// SetURL sets the value of URL.
+func (s *DatabaseConfig) SetURL(val OptString) {
+	s.URL = val
 }
 
-// SetDatabase sets the value of Database.
-func (s *Executions) SetDatabase(val OptInt) {
-	s.Database = val
+// SetFile sets the value of File.
+func (s *DatabaseConfig) SetFile(val OptFloat64) {
+	s.File = val
 }
 
-// SetEnded sets the value of Ended.
-func (s *Executions) SetEnded(val OptDateTime) {
-	s.Ended = val
+// SetChanged sets the value of Changed.
+func (s *DatabaseConfig) SetChanged(val OptBool) {
+	s.Changed = val
 }
 
-// SetExitCode sets the value of ExitCode.
-func (s *Executions) SetExitCode(val OptFloat64) {
-	s.ExitCode = val
+// SetDeleted sets the value of Deleted.
+func (s *DatabaseConfig) SetDeleted(val OptBool) {
+	s.Deleted = val
 }
 
-// SetID sets the value of ID.
-func (s *Executions) SetID(val OptInt) {
-	s.ID = val
+// Ref: #/components/schemas/DatabaseInformation
+type DatabaseInformation struct {
+	Active         OptBool   `json:"Active"`
+	Dbid           OptInt    `json:"Dbid"`
+	Name           OptString `json:"Name"`
+	ServiceVersion OptString `json:"ServiceVersion"`
+	StructureLevel OptInt    `json:"StructureLevel"`
+	Version        OptString `json:"Version"`
+	Location       OptString `json:"Location"`
 }
 
-// SetLog sets the value of Log.
-func (s *Executions) SetLog(val OptString) {
-	s.Log = val
+// GetActive returns the value of Active.
+func (s *DatabaseInformation) GetActive() OptBool {
+	return s.Active
 }
 
-// SetStartedBy sets the value of StartedBy.
-func (s *Executions) SetStartedBy(val OptString) {
-	s.StartedBy = val
+// GetDbid returns the value of Dbid.
+func (s *DatabaseInformation) GetDbid() OptInt {
+	return s.Dbid
 }
 
-// SetScheduled sets the value of Scheduled.
-func (s *Executions) SetScheduled(val OptDateTime) {
-	s.Scheduled = val
+// GetName returns the value of Name.
+func (s *DatabaseInformation) GetName() OptString {
+	return s.Name
 }
 
-// Ref: #/components/schemas/FieldItem
-type FieldItem struct {
-	Level      OptInt    `json:"level"`
-	Value      OptString `json:"value"`
-	Format     OptString `json:"format"`
-	Length     OptInt    `json:"length"`
-	Options    OptString `json:"options"`
-	Identifier OptString `json:"identifier"`
-	Label      OptString `json:"label"`
+// GetServiceVersion returns the value of ServiceVersion.
+func (s *DatabaseInformation) GetServiceVersion() OptString {
+	return s.ServiceVersion
 }
 
-// GetLevel returns the value of Level.
-func (s *FieldItem) GetLevel() OptInt {
-	return s.Level
+// GetStructureLevel returns the value of StructureLevel.
+func (s *DatabaseInformation) GetStructureLevel() OptInt {
+	return s.StructureLevel
 }
 
-// GetValue returns the value of Value.
-func (s *FieldItem) GetValue() OptString {
-	return s.Value
+// GetVersion returns the value of Version.
+func (s *DatabaseInformation) GetVersion() OptString {
+	return s.Version
 }
 
-// GetFormat returns the value of Format.
-func (s *FieldItem) GetFormat() OptString {
-	return s.Format
+// GetLocation returns the value of Location.
+func (s *DatabaseInformation) GetLocation() OptString {
+	return s.Location
 }
 
-// GetLength returns the value of Length.
-func (s *FieldItem) GetLength() OptInt {
-	return s.Length
-}
-
-// GetOptions returns the value of Options.
-func (s *FieldItem) GetOptions() OptString {
-	return s.Options
+// SetActive sets the value of Active.
+func (s *DatabaseInformation) SetActive(val OptBool) {
+	s.Active = val
 }
 
-// GetIdentifier returns the value of Identifier.
-func (s *FieldItem) GetIdentifier() OptString {
-	return s.Identifier
+// SetDbid sets the value of Dbid.
+func (s *DatabaseInformation) SetDbid(val OptInt) {
+	s.Dbid = val
 }
 
-// GetLabel returns the value of Label.
-func (s *FieldItem) GetLabel() OptString {
-	return s.Label
+// SetName sets the value of Name.
+func (s *DatabaseInformation) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetLevel sets the value of Level.
-func (s *FieldItem) SetLevel(val OptInt) {
-	s.Level = val
+// SetServiceVersion sets the value of ServiceVersion.
+func (s *DatabaseInformation) SetServiceVersion(val OptString) {
+	s.ServiceVersion = val
 }
 
-// SetValue sets the value of Value.
-func (s *FieldItem) SetValue(val OptString) {
-	s.Value = val
+// SetStructureLevel sets the value of StructureLevel.
+func (s *DatabaseInformation) SetStructureLevel(val OptInt) {
+	s.StructureLevel = val
 }
 
-// SetFormat sets the value of Format.
-func (s *FieldItem) SetFormat(val OptString) {
-	s.Format = val
+// SetVersion sets the value of Version.
+func (s *DatabaseInformation) SetVersion(val OptString) {
+	s.Version = val
 }
 
-// SetLength sets the value of Length.
-func (s *FieldItem) SetLength(val OptInt) {
-	s.Length = val
+// SetLocation sets the value of Location.
+func (s *DatabaseInformation) SetLocation(val OptString) {
+	s.Location = val
 }
 
-// SetOptions sets the value of Options.
-func (s *FieldItem) SetOptions(val OptString) {
-	s.Options = val
+// Database entry in database list.
+// Ref: #/components/schemas/Databases
+type Databases struct {
+	Database []DatabaseInformation `json:"Database"`
 }
 
-// SetIdentifier sets the value of Identifier.
-func (s *FieldItem) SetIdentifier(val OptString) {
-	s.Identifier = val
+// GetDatabase returns the value of Database.
+func (s *Databases) GetDatabase() []DatabaseInformation {
+	return s.Database
 }
 
-// SetLabel sets the value of Label.
-func (s *FieldItem) SetLabel(val OptString) {
-	s.Label = val
+// SetDatabase sets the value of Database.
+func (s *Databases) SetDatabase(val []DatabaseInformation) {
+	s.Database = val
 }
 
-type Fields []FieldItem
+func (*Databases) getDatabasesRes() {}
 
-// FieldsHeaders wraps Fields with response headers.
-type FieldsHeaders struct {
-	XToken   OptString
-	Response Fields
-}
+type DeleteExtendBadRequest Error
 
-// GetXToken returns the value of XToken.
-func (s *FieldsHeaders) GetXToken() OptString {
-	return s.XToken
-}
+func (*DeleteExtendBadRequest) deleteExtendRes() {}
 
-// GetResponse returns the value of Response.
-func (s *FieldsHeaders) GetResponse() Fields {
-	return s.Response
-}
+// DeleteExtendForbidden is response for DeleteExtend operation.
+type DeleteExtendForbidden struct{}
 
-// SetXToken sets the value of XToken.
-func (s *FieldsHeaders) SetXToken(val OptString) {
-	s.XToken = val
-}
+func (*DeleteExtendForbidden) deleteExtendRes() {}
 
-// SetResponse sets the value of Response.
-func (s *FieldsHeaders) SetResponse(val Fields) {
-	s.Response = val
-}
+type DeleteExtendNotFound Error
 
-func (*FieldsHeaders) getFieldsRes() {}
+func (*DeleteExtendNotFound) deleteExtendRes() {}
 
-// Ref: #/components/schemas/File
-type File struct {
-	Name     OptString   `json:"Name"`
-	Type     OptString   `json:"Type"`
-	Modified OptDateTime `json:"Modified"`
-	Size     OptInt64    `json:"Size"`
+type DeleteExtendOKApplicationOctetStream struct {
+	Data io.Reader
 }
 
-// GetName returns the value of Name.
-func (s *File) GetName() OptString {
-	return s.Name
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s DeleteExtendOKApplicationOctetStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetType returns the value of Type.
-func (s *File) GetType() OptString {
-	return s.Type
-}
+func (*DeleteExtendOKApplicationOctetStream) deleteExtendRes() {}
 
-// GetModified returns the value of Modified.
-func (s *File) GetModified() OptDateTime {
-	return s.Modified
-}
+// DeleteExtendUnauthorized is response for DeleteExtend operation.
+type DeleteExtendUnauthorized struct{}
 
-// GetSize returns the value of Size.
-func (s *File) GetSize() OptInt64 {
-	return s.Size
-}
+func (*DeleteExtendUnauthorized) deleteExtendRes() {}
 
-// SetName sets the value of Name.
-func (s *File) SetName(val OptString) {
-	s.Name = val
-}
+type DeleteFileLocationBadRequest Error
 
-// SetType sets the value of Type.
-func (s *File) SetType(val OptString) {
-	s.Type = val
-}
+func (*DeleteFileLocationBadRequest) deleteFileLocationRes() {}
 
-// SetModified sets the value of Modified.
-func (s *File) SetModified(val OptDateTime) {
-	s.Modified = val
-}
+// DeleteFileLocationForbidden is response for DeleteFileLocation operation.
+type DeleteFileLocationForbidden struct{}
 
-// SetSize sets the value of Size.
-func (s *File) SetSize(val OptInt64) {
-	s.Size = val
-}
+func (*DeleteFileLocationForbidden) deleteFileLocationRes() {}
 
-// GetConfigForbidden is response for GetConfig operation.
-type GetConfigForbidden struct{}
+type DeleteFileLocationNotFound Error
 
-func (*GetConfigForbidden) getConfigRes() {}
+func (*DeleteFileLocationNotFound) deleteFileLocationRes() {}
 
-// GetConfigUnauthorized is response for GetConfig operation.
-type GetConfigUnauthorized struct{}
+// DeleteFileLocationUnauthorized is response for DeleteFileLocation operation.
+type DeleteFileLocationUnauthorized struct{}
 
-func (*GetConfigUnauthorized) getConfigRes() {}
+func (*DeleteFileLocationUnauthorized) deleteFileLocationRes() {}
 
-// GetDatabasesForbidden is response for GetDatabases operation.
-type GetDatabasesForbidden struct{}
+type DeleteJobResultBadRequest Error
 
-func (*GetDatabasesForbidden) getDatabasesRes() {}
+func (*DeleteJobResultBadRequest) deleteJobResultRes() {}
 
-// GetDatabasesUnauthorized is response for GetDatabases operation.
-type GetDatabasesUnauthorized struct{}
+// DeleteJobResultForbidden is response for DeleteJobResult operation.
+type DeleteJobResultForbidden struct{}
 
-func (*GetDatabasesUnauthorized) getDatabasesRes() {}
+func (*DeleteJobResultForbidden) deleteJobResultRes() {}
 
-type GetFieldsBadRequest Error
+type DeleteJobResultNotFound Error
 
-func (*GetFieldsBadRequest) getFieldsRes() {}
+func (*DeleteJobResultNotFound) deleteJobResultRes() {}
 
-// GetFieldsForbidden is response for GetFields operation.
-type GetFieldsForbidden struct{}
+// DeleteJobResultUnauthorized is response for DeleteJobResult operation.
+type DeleteJobResultUnauthorized struct{}
 
-func (*GetFieldsForbidden) getFieldsRes() {}
+func (*DeleteJobResultUnauthorized) deleteJobResultRes() {}
 
-type GetFieldsNotFound Error
+type DeleteRecordByKeyBadRequest Error
 
-func (*GetFieldsNotFound) getFieldsRes() {}
+func (*DeleteRecordByKeyBadRequest) deleteRecordByKeyRes() {}
 
-type GetFieldsOKTextCsv struct {
-	Data io.Reader
-}
+// DeleteRecordByKeyForbidden is response for DeleteRecordByKey operation.
+type DeleteRecordByKeyForbidden struct{}
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetFieldsOKTextCsv) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
-}
+func (*DeleteRecordByKeyForbidden) deleteRecordByKeyRes() {}
 
-// GetFieldsOKTextCsvHeaders wraps GetFieldsOKTextCsv with response headers.
-type GetFieldsOKTextCsvHeaders struct {
-	XToken   OptString
-	Response GetFieldsOKTextCsv
-}
+type DeleteRecordByKeyNotFound Error
 
-// GetXToken returns the value of XToken.
-func (s *GetFieldsOKTextCsvHeaders) GetXToken() OptString {
-	return s.XToken
-}
+func (*DeleteRecordByKeyNotFound) deleteRecordByKeyRes() {}
 
-// GetResponse returns the value of Response.
-func (s *GetFieldsOKTextCsvHeaders) GetResponse() GetFieldsOKTextCsv {
-	return s.Response
-}
+// DeleteRecordByKeyUnauthorized is response for DeleteRecordByKey operation.
+type DeleteRecordByKeyUnauthorized struct{}
 
-// SetXToken sets the value of XToken.
-func (s *GetFieldsOKTextCsvHeaders) SetXToken(val OptString) {
-	s.XToken = val
-}
+func (*DeleteRecordByKeyUnauthorized) deleteRecordByKeyRes() {}
 
-// SetResponse sets the value of Response.
-func (s *GetFieldsOKTextCsvHeaders) SetResponse(val GetFieldsOKTextCsv) {
-	s.Response = val
-}
+// DeleteRecordsSearchedForbidden is response for DeleteRecordsSearched operation.
+type DeleteRecordsSearchedForbidden struct{}
 
-func (*GetFieldsOKTextCsvHeaders) getFieldsRes() {}
+func (*DeleteRecordsSearchedForbidden) deleteRecordsSearchedRes() {}
 
-// GetFieldsUnauthorized is response for GetFields operation.
-type GetFieldsUnauthorized struct{}
+// DeleteRecordsSearchedUnauthorized is response for DeleteRecordsSearched operation.
+type DeleteRecordsSearchedUnauthorized struct{}
 
-func (*GetFieldsUnauthorized) getFieldsRes() {}
+func (*DeleteRecordsSearchedUnauthorized) deleteRecordsSearchedRes() {}
 
-// GetImageForbidden is response for GetImage operation.
-type GetImageForbidden struct{}
+// DeleteViewForbidden is response for DeleteView operation.
+type DeleteViewForbidden struct{}
 
-func (*GetImageForbidden) getImageRes() {}
+func (*DeleteViewForbidden) deleteViewRes() {}
 
-type GetImageOK struct {
-	Data io.Reader
-}
+// DeleteViewOK is response for DeleteView operation.
+type DeleteViewOK struct{}
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetImageOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
-}
+func (*DeleteViewOK) deleteViewRes() {}
 
-// GetImageOKHeaders wraps GetImageOK with response headers.
-type GetImageOKHeaders struct {
-	ContentType string
-	Response    GetImageOK
+// DeleteViewUnauthorized is response for DeleteView operation.
+type DeleteViewUnauthorized struct{}
+
+func (*DeleteViewUnauthorized) deleteViewRes() {}
+
+// Ref: #/components/schemas/Directories
+type Directories struct {
+	Directories []Directory `json:"Directories"`
+	System      OptString   `json:"system"`
 }
 
-// GetContentType returns the value of ContentType.
-func (s *GetImageOKHeaders) GetContentType() string {
-	return s.ContentType
+// GetDirectories returns the value of Directories.
+func (s *Directories) GetDirectories() []Directory {
+	return s.Directories
 }
 
-// GetResponse returns the value of Response.
-func (s *GetImageOKHeaders) GetResponse() GetImageOK {
-	return s.Response
+// GetSystem returns the value of System.
+func (s *Directories) GetSystem() OptString {
+	return s.System
 }
 
-// SetContentType sets the value of ContentType.
-func (s *GetImageOKHeaders) SetContentType(val string) {
-	s.ContentType = val
+// SetDirectories sets the value of Directories.
+func (s *Directories) SetDirectories(val []Directory) {
+	s.Directories = val
 }
 
-// SetResponse sets the value of Response.
-func (s *GetImageOKHeaders) SetResponse(val GetImageOK) {
-	s.Response = val
+// SetSystem sets the value of System.
+func (s *Directories) SetSystem(val OptString) {
+	s.System = val
 }
 
-func (*GetImageOKHeaders) getImageRes() {}
+func (*Directories) browseListRes() {}
 
-// GetImageUnauthorized is response for GetImage operation.
-type GetImageUnauthorized struct {
-	WwwAuthenticate OptString
+// Ref: #/components/schemas/Directory
+type Directory struct {
+	Location OptString `json:"Location"`
+	Name     OptString `json:"Name"`
 }
 
-// GetWwwAuthenticate returns the value of WwwAuthenticate.
-func (s *GetImageUnauthorized) GetWwwAuthenticate() OptString {
-	return s.WwwAuthenticate
+// GetLocation returns the value of Location.
+func (s *Directory) GetLocation() OptString {
+	return s.Location
 }
 
-// SetWwwAuthenticate sets the value of WwwAuthenticate.
-func (s *GetImageUnauthorized) SetWwwAuthenticate(val OptString) {
-	s.WwwAuthenticate = val
+// GetName returns the value of Name.
+func (s *Directory) GetName() OptString {
+	return s.Name
 }
 
-func (*GetImageUnauthorized) getImageRes() {}
-
-type GetJobExecutionResultBadRequest Error
-
-func (*GetJobExecutionResultBadRequest) getJobExecutionResultRes() {}
-
-// GetJobExecutionResultForbidden is response for GetJobExecutionResult operation.
-type GetJobExecutionResultForbidden struct{}
-
-func (*GetJobExecutionResultForbidden) getJobExecutionResultRes() {}
-
-type GetJobExecutionResultNotFound Error
-
-func (*GetJobExecutionResultNotFound) getJobExecutionResultRes() {}
-
-// GetJobExecutionResultUnauthorized is response for GetJobExecutionResult operation.
-type GetJobExecutionResultUnauthorized struct{}
-
-func (*GetJobExecutionResultUnauthorized) getJobExecutionResultRes() {}
-
-type GetJobFullInfoBadRequest Error
-
-func (*GetJobFullInfoBadRequest) getJobFullInfoRes() {}
-
-// GetJobFullInfoForbidden is response for GetJobFullInfo operation.
-type GetJobFullInfoForbidden struct{}
-
-func (*GetJobFullInfoForbidden) getJobFullInfoRes() {}
-
-type GetJobFullInfoNotFound Error
-
-func (*GetJobFullInfoNotFound) getJobFullInfoRes() {}
-
-// GetJobFullInfoUnauthorized is response for GetJobFullInfo operation.
-type GetJobFullInfoUnauthorized struct{}
-
-func (*GetJobFullInfoUnauthorized) getJobFullInfoRes() {}
-
-type GetJobResultBadRequest Error
-
-func (*GetJobResultBadRequest) getJobResultRes() {}
-
-// GetJobResultForbidden is response for GetJobResult operation.
-type GetJobResultForbidden struct{}
-
-func (*GetJobResultForbidden) getJobResultRes() {}
+// SetLocation sets the value of Location.
+func (s *Directory) SetLocation(val OptString) {
+	s.Location = val
+}
 
-type GetJobResultNotFound Error
+// SetName sets the value of Name.
+func (s *Directory) SetName(val OptString) {
+	s.Name = val
+}
 
-func (*GetJobResultNotFound) getJobResultRes() {}
+// Ref: #/components/schemas/DirectoryFiles
+type DirectoryFiles struct {
+	Location OptString `json:"Location"`
+	Path     OptString `json:"Path"`
+	Files    []File    `json:"Files"`
+	System   OptString `json:"system"`
+}
 
-// GetJobResultUnauthorized is response for GetJobResult operation.
-type GetJobResultUnauthorized struct{}
+// GetLocation returns the value of Location.
+func (s *DirectoryFiles) GetLocation() OptString {
+	return s.Location
+}
 
-func (*GetJobResultUnauthorized) getJobResultRes() {}
+// GetPath returns the value of Path.
+func (s *DirectoryFiles) GetPath() OptString {
+	return s.Path
+}
 
-// GetJobsConfigForbidden is response for GetJobsConfig operation.
-type GetJobsConfigForbidden struct{}
+// GetFiles returns the value of Files.
+func (s *DirectoryFiles) GetFiles() []File {
+	return s.Files
+}
 
-func (*GetJobsConfigForbidden) getJobsConfigRes() {}
+// GetSystem returns the value of System.
+func (s *DirectoryFiles) GetSystem() OptString {
+	return s.System
+}
 
-// GetJobsConfigUnauthorized is response for GetJobsConfig operation.
-type GetJobsConfigUnauthorized struct{}
+// SetLocation sets the value of Location.
+func (s *DirectoryFiles) SetLocation(val OptString) {
+	s.Location = val
+}
 
-func (*GetJobsConfigUnauthorized) getJobsConfigRes() {}
+// SetPath sets the value of Path.
+func (s *DirectoryFiles) SetPath(val OptString) {
+	s.Path = val
+}
 
-// GetJobsForbidden is response for GetJobs operation.
-type GetJobsForbidden struct{}
+// SetFiles sets the value of Files.
+func (s *DirectoryFiles) SetFiles(val []File) {
+	s.Files = val
+}
 
-func (*GetJobsForbidden) getJobsRes() {}
+// SetSystem sets the value of System.
+func (s *DirectoryFiles) SetSystem(val OptString) {
+	s.System = val
+}
 
-// GetJobsNotFound is response for GetJobs operation.
-type GetJobsNotFound struct{}
+type DownloadFileBadRequest Error
 
-func (*GetJobsNotFound) getJobsRes() {}
+func (*DownloadFileBadRequest) downloadFileRes() {}
 
-// GetJobsUnauthorized is response for GetJobs operation.
-type GetJobsUnauthorized struct{}
+// DownloadFileForbidden is response for DownloadFile operation.
+type DownloadFileForbidden struct{}
 
-func (*GetJobsUnauthorized) getJobsRes() {}
+func (*DownloadFileForbidden) downloadFileRes() {}
 
-// GetLobByMapForbidden is response for GetLobByMap operation.
-type GetLobByMapForbidden struct{}
+type DownloadFileNotFound Error
 
-func (*GetLobByMapForbidden) getLobByMapRes() {}
+func (*DownloadFileNotFound) downloadFileRes() {}
 
-type GetLobByMapOK struct {
+type DownloadFileOK struct {
 	Data io.Reader
 }
 
 // Read reads data from the Data reader.
 //
 // Kept to satisfy the io.Reader interface.
-func (s GetLobByMapOK) Read(p []byte) (n int, err error) {
+func (s DownloadFileOK) Read(p []byte) (n int, err error) {
 	if s.Data == nil {
 		return 0, io.EOF
 	}
 	return s.Data.Read(p)
 }
 
-func (*GetLobByMapOK) getLobByMapRes() {}
-
-// GetLobByMapUnauthorized is response for GetLobByMap operation.
-type GetLobByMapUnauthorized struct{}
-
-func (*GetLobByMapUnauthorized) getLobByMapRes() {}
-
-// GetLoginSessionForbidden is response for GetLoginSession operation.
-type GetLoginSessionForbidden struct{}
-
-func (*GetLoginSessionForbidden) getLoginSessionRes() {}
-
-// GetLoginSessionUnauthorized is response for GetLoginSession operation.
-type GetLoginSessionUnauthorized struct{}
-
-func (*GetLoginSessionUnauthorized) getLoginSessionRes() {}
-
-// GetMapMetadataForbidden is response for GetMapMetadata operation.
-type GetMapMetadataForbidden struct{}
-
-func (*GetMapMetadataForbidden) getMapMetadataRes() {}
-
-// GetMapMetadataUnauthorized is response for GetMapMetadata operation.
-type GetMapMetadataUnauthorized struct{}
-
-func (*GetMapMetadataUnauthorized) getMapMetadataRes() {}
+func (*DownloadFileOK) downloadFileRes() {}
 
-// GetMapRecordsFieldsForbidden is response for GetMapRecordsFields operation.
-type GetMapRecordsFieldsForbidden struct{}
+// DownloadFileUnauthorized is response for DownloadFile operation.
+type DownloadFileUnauthorized struct{}
 
-func (*GetMapRecordsFieldsForbidden) getMapRecordsFieldsRes() {}
+func (*DownloadFileUnauthorized) downloadFileRes() {}
 
-type GetMapRecordsFieldsOKTextCsv struct {
-	Data io.Reader
+// Ref: #/components/schemas/Error
+type Error struct {
+	Code    OptString     `json:"code"`
+	Message OptString     `json:"message"`
+	Target  OptString     `json:"target"`
+	Error   OptErrorError `json:"Error"`
+	// Invalid fields of the request.
+	Details []ErrorDetail `json:"details"`
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetMapRecordsFieldsOKTextCsv) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// GetCode returns the value of Code.
+func (s *Error) GetCode() OptString {
+	return s.Code
 }
 
-// GetMapRecordsFieldsOKTextCsvHeaders wraps GetMapRecordsFieldsOKTextCsv with response headers.
-type GetMapRecordsFieldsOKTextCsvHeaders struct {
-	XToken   OptString
-	Response GetMapRecordsFieldsOKTextCsv
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() OptString {
+	return s.Message
 }
 
-// GetXToken returns the value of XToken.
-func (s *GetMapRecordsFieldsOKTextCsvHeaders) GetXToken() OptString {
-	return s.XToken
+// GetTarget returns the value of Target.
+func (s *Error) GetTarget() OptString {
+	return s.Target
 }
 
-// GetResponse returns the value of Response.
-func (s *GetMapRecordsFieldsOKTextCsvHeaders) GetResponse() GetMapRecordsFieldsOKTextCsv {
-	return s.Response
+// GetError returns the value of Error.
+func (s *Error) GetError() OptErrorError {
+	return s.Error
 }
 
-// SetXToken sets the value of XToken.
-func (s *GetMapRecordsFieldsOKTextCsvHeaders) SetXToken(val OptString) {
-	s.XToken = val
+// GetDetails returns the value of Details.
+func (s *Error) GetDetails() []ErrorDetail {
+	return s.Details
 }
 
-// SetResponse sets the value of Response.
-func (s *GetMapRecordsFieldsOKTextCsvHeaders) SetResponse(val GetMapRecordsFieldsOKTextCsv) {
-	s.Response = val
+// SetCode sets the value of Code.
+func (s *Error) SetCode(val OptString) {
+	s.Code = val
 }
 
-func (*GetMapRecordsFieldsOKTextCsvHeaders) getMapRecordsFieldsRes() {}
-
-// GetMapRecordsFieldsUnauthorized is response for GetMapRecordsFields operation.
-type GetMapRecordsFieldsUnauthorized struct{}
-
-func (*GetMapRecordsFieldsUnauthorized) getMapRecordsFieldsRes() {}
-
-// GetMapsForbidden is response for GetMaps operation.
-type GetMapsForbidden struct{}
-
-func (*GetMapsForbidden) getMapsRes() {}
-
-// GetMapsUnauthorized is response for GetMaps operation.
-type GetMapsUnauthorized struct{}
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val OptString) {
+	s.Message = val
+}
 
-func (*GetMapsUnauthorized) getMapsRes() {}
+// SetTarget sets the value of Target.
+func (s *Error) SetTarget(val OptString) {
+	s.Target = val
+}
 
-// GetUserInfoForbidden is response for GetUserInfo operation.
-type GetUserInfoForbidden struct{}
+// SetError sets the value of Error.
+func (s *Error) SetError(val OptErrorError) {
+	s.Error = val
+}
 
-func (*GetUserInfoForbidden) getUserInfoRes() {}
+// SetDetails sets the value of Details.
+func (s *Error) SetDetails(val []ErrorDetail) {
+	s.Details = val
+}
 
-// GetUserInfoUnauthorized is response for GetUserInfo operation.
-type GetUserInfoUnauthorized struct{}
-
-func (*GetUserInfoUnauthorized) getUserInfoRes() {}
-
-// GetVideoForbidden is response for GetVideo operation.
-type GetVideoForbidden struct{}
-
-func (*GetVideoForbidden) getVideoRes() {}
+func (*Error) addViewRes()               {}
+func (*Error) aggregateRecordsRes()      {}
+func (*Error) batchParameterQueryRes()   {}
+func (*Error) batchQueryRes()            {}
+func (*Error) batchSelectRes()           {}
+func (*Error) cancelUploadRes()          {}
+func (*Error) deleteRecordsSearchedRes() {}
+func (*Error) deleteViewRes()            {}
+func (*Error) executeTransactionRes()    {}
+func (*Error) getConfigRes()             {}
+func (*Error) getDatabasesRes()          {}
+func (*Error) getImageRes()              {}
+func (*Error) getJobsConfigRes()         {}
+func (*Error) getLobByMapRes()           {}
+func (*Error) getLoginSessionRes()       {}
+func (*Error) getMapMetadataRes()        {}
+func (*Error) getMapRecordsFieldsRes()   {}
+func (*Error) getMapsRes()               {}
+func (*Error) getRecordHistoryRes()      {}
+func (*Error) getUploadRes()             {}
+func (*Error) getUserInfoRes()           {}
+func (*Error) getVersionRes()            {}
+func (*Error) getVideoRes()              {}
+func (*Error) getViewsRes()              {}
+func (*Error) importRecordsRes()         {}
+func (*Error) insertRecordRes()          {}
+func (*Error) loginSessionRes()          {}
+func (*Error) postDatabaseRes()          {}
+func (*Error) pushLoginSessionRes()      {}
+func (*Error) searchRecordsFieldsRes()   {}
+func (*Error) setConfigRes()             {}
+func (*Error) setJobsConfigRes()         {}
+func (*Error) shutdownServerRes()        {}
+func (*Error) storeConfigRes()           {}
+func (*Error) updateImageHashesRes()     {}
 
-type GetVideoOK struct {
-	Data io.Reader
+// Ref: #/components/schemas/ErrorDetail
+type ErrorDetail struct {
+	// JSON pointer of the invalid field in the request body.
+	Pointer OptString `json:"pointer"`
+	Message OptString `json:"message"`
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetVideoOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// GetPointer returns the value of Pointer.
+func (s *ErrorDetail) GetPointer() OptString {
+	return s.Pointer
 }
 
-// GetVideoOKHeaders wraps GetVideoOK with response headers.
-type GetVideoOKHeaders struct {
-	ContentType string
-	Response    GetVideoOK
+// GetMessage returns the value of Message.
+func (s *ErrorDetail) GetMessage() OptString {
+	return s.Message
 }
 
-// GetContentType returns the value of ContentType.
-func (s *GetVideoOKHeaders) GetContentType() string {
-	return s.ContentType
+// SetPointer sets the value of Pointer.
+func (s *ErrorDetail) SetPointer(val OptString) {
+	s.Pointer = val
 }
 
-// GetResponse returns the value of Response.
-func (s *GetVideoOKHeaders) GetResponse() GetVideoOK {
-	return s.Response
+// SetMessage sets the value of Message.
+func (s *ErrorDetail) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// SetContentType sets the value of ContentType.
-func (s *GetVideoOKHeaders) SetContentType(val string) {
-	s.ContentType = val
+type ErrorError struct {
+	Code    OptString `json:"code"`
+	Message OptString `json:"message"`
+	Target  OptString `json:"target"`
 }
 
-// SetResponse sets the value of Response.
-func (s *GetVideoOKHeaders) SetResponse(val GetVideoOK) {
-	s.Response = val
+// GetCode returns the value of Code.
+func (s *ErrorError) GetCode() OptString {
+	return s.Code
 }
 
-func (*GetVideoOKHeaders) getVideoRes() {}
-
-// GetVideoUnauthorized is response for GetVideo operation.
-type GetVideoUnauthorized struct {
-	WwwAuthenticate OptString
+// GetMessage returns the value of Message.
+func (s *ErrorError) GetMessage() OptString {
+	return s.Message
 }
 
-// GetWwwAuthenticate returns the value of WwwAuthenticate.
-func (s *GetVideoUnauthorized) GetWwwAuthenticate() OptString {
-	return s.WwwAuthenticate
+// GetTarget returns the value of Target.
+func (s *ErrorError) GetTarget() OptString {
+	return s.Target
 }
 
-// SetWwwAuthenticate sets the value of WwwAuthenticate.
-func (s *GetVideoUnauthorized) SetWwwAuthenticate(val OptString) {
-	s.WwwAuthenticate = val
+// SetCode sets the value of Code.
+func (s *ErrorError) SetCode(val OptString) {
+	s.Code = val
 }
 
-func (*GetVideoUnauthorized) getVideoRes() {}
+// SetMessage sets the value of Message.
+func (s *ErrorError) SetMessage(val OptString) {
+	s.Message = val
+}
 
-// GetViewsForbidden is response for GetViews operation.
-type GetViewsForbidden struct{}
+// SetTarget sets the value of Target.
+func (s *ErrorError) SetTarget(val OptString) {
+	s.Target = val
+}
 
-func (*GetViewsForbidden) getViewsRes() {}
+// ErrorStatusCode wraps Error with StatusCode.
+type ErrorStatusCode struct {
+	StatusCode int
+	Response   Error
+}
 
-// GetViewsOK is response for GetViews operation.
-type GetViewsOK struct {
-	XToken OptString
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
 }
 
-// GetXToken returns the value of XToken.
-func (s *GetViewsOK) GetXToken() OptString {
-	return s.XToken
+// GetResponse returns the value of Response.
+func (s *ErrorStatusCode) GetResponse() Error {
+	return s.Response
 }
 
-// SetXToken sets the value of XToken.
-func (s *GetViewsOK) SetXToken(val OptString) {
-	s.XToken = val
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
 }
 
-func (*GetViewsOK) getViewsRes() {}
+// SetResponse sets the value of Response.
+func (s *ErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
+}
 
-// GetViewsUnauthorized is response for GetViews operation.
-type GetViewsUnauthorized struct{}
+// ExecuteTransactionForbidden is response for ExecuteTransaction operation.
+type ExecuteTransactionForbidden struct{}
 
-func (*GetViewsUnauthorized) getViewsRes() {}
+func (*ExecuteTransactionForbidden) executeTransactionRes() {}
 
-type InsertMapFileRecordsBadRequest Error
+type ExecuteTransactionOK TransactionResultHeaders
 
-func (*InsertMapFileRecordsBadRequest) insertMapFileRecordsRes() {}
+func (*ExecuteTransactionOK) executeTransactionRes() {}
 
-// InsertMapFileRecordsForbidden is response for InsertMapFileRecords operation.
-type InsertMapFileRecordsForbidden struct{}
+// ExecuteTransactionUnauthorized is response for ExecuteTransaction operation.
+type ExecuteTransactionUnauthorized struct{}
 
-func (*InsertMapFileRecordsForbidden) insertMapFileRecordsRes() {}
+func (*ExecuteTransactionUnauthorized) executeTransactionRes() {}
 
-type InsertMapFileRecordsNotFound Error
+type ExecuteTransactionUnprocessableEntity TransactionResultHeaders
 
-func (*InsertMapFileRecordsNotFound) insertMapFileRecordsRes() {}
+func (*ExecuteTransactionUnprocessableEntity) executeTransactionRes() {}
 
-type InsertMapFileRecordsReq struct {
-	// The file to upload.
-	Data OptMultipartFile `json:"data"`
+// Ref: #/components/schemas/Executions
+type Executions struct {
+	Database  OptInt      `json:"Database"`
+	Ended     OptDateTime `json:"Ended"`
+	ExitCode  OptFloat64  `json:"ExitCode"`
+	ID        OptInt      `json:"Id"`
+	Log       OptString   `json:"Log"`
+	StartedBy OptString   `json:"StartedBy"`
+	Scheduled OptDateTime `json:"Scheduled"`
 }
 
-// GetData returns the value of Data.
-func (s *InsertMapFileRecordsReq) GetData() OptMultipartFile {
-	return s.Data
+// GetDatabase returns the value of Database.
+func (s *Executions) GetDatabase() OptInt {
+	return s.Database
 }
 
-// SetData sets the value of Data.
-func (s *InsertMapFileRecordsReq) SetData(val OptMultipartFile) {
-	s.Data = val
+// GetEnded returns the value of Ended.
+func (s *Executions) GetEnded() OptDateTime {
+	return s.Ended
 }
 
-// InsertMapFileRecordsUnauthorized is response for InsertMapFileRecords operation.
-type InsertMapFileRecordsUnauthorized struct{}
-
-func (*InsertMapFileRecordsUnauthorized) insertMapFileRecordsRes() {}
-
-// InsertRecordBadRequest is response for InsertRecord operation.
-type InsertRecordBadRequest struct{}
-
-func (*InsertRecordBadRequest) insertRecordRes() {}
-
-// InsertRecordForbidden is response for InsertRecord operation.
-type InsertRecordForbidden struct{}
-
-func (*InsertRecordForbidden) insertRecordRes() {}
-
-type InsertRecordReq struct {
-	Records []InsertRecordReqRecordsItem `json:"Records"`
+// GetExitCode returns the value of ExitCode.
+func (s *Executions) GetExitCode() OptFloat64 {
+	return s.ExitCode
 }
 
-// GetRecords returns the value of Records.
-func (s *InsertRecordReq) GetRecords() []InsertRecordReqRecordsItem {
-	return s.Records
+// GetID returns the value of ID.
+func (s *Executions) GetID() OptInt {
+	return s.ID
 }
 
-// SetRecords sets the value of Records.
-func (s *InsertRecordReq) SetRecords(val []InsertRecordReqRecordsItem) {
-	s.Records = val
+// GetLog returns the value of Log.
+func (s *Executions) GetLog() OptString {
+	return s.Log
 }
 
-type InsertRecordReqRecordsItem map[string]jx.Raw
-
-func (s *InsertRecordReqRecordsItem) init() InsertRecordReqRecordsItem {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// GetStartedBy returns the value of StartedBy.
+func (s *Executions) GetStartedBy() OptString {
+	return s.StartedBy
 }
 
-// InsertRecordUnauthorized is response for InsertRecord operation.
-type InsertRecordUnauthorized struct{}
-
-func (*InsertRecordUnauthorized) insertRecordRes() {}
+// GetScheduled returns the value of Scheduled.
+func (s *Executions) GetScheduled() OptDateTime {
+	return s.Scheduled
+}
 
-// Ref: #/components/schemas/Job
-type Job struct {
-	Description  OptString             `json:"Description"`
-	Environments []JobEnvironmentsItem `json:"Environments"`
-	Name         OptString             `json:"Name"`
-	Parameters   []JobParametersItem   `json:"Parameters"`
-	Script       OptString             `json:"Script"`
-	User         OptString             `json:"User"`
-	Utility      OptString             `json:"Utility"`
-	CronSchedule OptString             `json:"CronSchedule"`
+// SetDatabase sets the value of Database.
+func (s *Executions) SetDatabase(val OptInt) {
+	s.Database = val
 }
 
-// GetDescription returns the value of Description.
-func (s *Job) GetDescription() OptString {
-	return s.Description
+// SetEnded sets the value of Ended.
+func (s *Executions) SetEnded(val OptDateTime) {
+	s.Ended = val
 }
 
-// GetEnvironments returns the value of Environments.
-func (s *Job) GetEnvironments() []JobEnvironmentsItem {
-	return s.Environments
+// SetExitCode sets the value of ExitCode.
+func (s *Executions) SetExitCode(val OptFloat64) {
+	s.ExitCode = val
 }
 
-// GetName returns the value of Name.
-func (s *Job) GetName() OptString {
-	return s.Name
+// SetID sets the value of ID.
+func (s *Executions) SetID(val OptInt) {
+	s.ID = val
 }
 
-// GetParameters returns the value of Parameters.
-func (s *Job) GetParameters() []JobParametersItem {
-	return s.Parameters
+// SetLog sets the value of Log.
+func (s *Executions) SetLog(val OptString) {
+	s.Log = val
 }
 
-// GetScript returns the value of Script.
-func (s *Job) GetScript() OptString {
-	return s.Script
+// SetStartedBy sets the value of StartedBy.
+func (s *Executions) SetStartedBy(val OptString) {
+	s.StartedBy = val
 }
 
-// GetUser returns the value of User.
-func (s *Job) GetUser() OptString {
-	return s.User
+// SetScheduled sets the value of Scheduled.
+func (s *Executions) SetScheduled(val OptDateTime) {
+	s.Scheduled = val
 }
 
-// GetUtility returns the value of Utility.
-func (s *Job) GetUtility() OptString {
-	return s.Utility
+// Ref: #/components/schemas/File
+type File struct {
+	Name     OptString   `json:"Name"`
+	Type     OptString   `json:"Type"`
+	Modified OptDateTime `json:"Modified"`
+	Size     OptInt64    `json:"Size"`
 }
 
-// GetCronSchedule returns the value of CronSchedule.
-func (s *Job) GetCronSchedule() OptString {
-	return s.CronSchedule
+// GetName returns the value of Name.
+func (s *File) GetName() OptString {
+	return s.Name
 }
 
-// SetDescription sets the value of Description.
-func (s *Job) SetDescription(val OptString) {
-	s.Description = val
+// GetType returns the value of Type.
+func (s *File) GetType() OptString {
+	return s.Type
 }
 
-// SetEnvironments sets the value of Environments.
-func (s *Job) SetEnvironments(val []JobEnvironmentsItem) {
-	s.Environments = val
+// GetModified returns the value of Modified.
+func (s *File) GetModified() OptDateTime {
+	return s.Modified
+}
+
+// GetSize returns the value of Size.
+func (s *File) GetSize() OptInt64 {
+	return s.Size
 }
 
 // SetName sets the value of Name.
-func (s *Job) SetName(val OptString) {
+func (s *File) SetName(val OptString) {
 	s.Name = val
 }
 
-// SetParameters sets the value of Parameters.
-func (s *Job) SetParameters(val []JobParametersItem) {
-	s.Parameters = val
+// SetType sets the value of Type.
+func (s *File) SetType(val OptString) {
+	s.Type = val
 }
 
-// SetScript sets the value of Script.
-func (s *Job) SetScript(val OptString) {
-	s.Script = val
+// SetModified sets the value of Modified.
+func (s *File) SetModified(val OptDateTime) {
+	s.Modified = val
 }
 
-// SetUser sets the value of User.
-func (s *Job) SetUser(val OptString) {
-	s.User = val
+// SetSize sets the value of Size.
+func (s *File) SetSize(val OptInt64) {
+	s.Size = val
 }
 
-// SetUtility sets the value of Utility.
-func (s *Job) SetUtility(val OptString) {
-	s.Utility = val
-}
+type GetArchiveBadRequest Error
 
-// SetCronSchedule sets the value of CronSchedule.
-func (s *Job) SetCronSchedule(val OptString) {
-	s.CronSchedule = val
-}
+func (*GetArchiveBadRequest) getArchiveRes() {}
 
-// Ref: #/components/schemas/JobDefinition
-type JobDefinition struct {
-	Executions []Executions `json:"Executions"`
-	Job        OptJob       `json:"Job"`
-	Status     OptString    `json:"Status"`
-}
+// GetArchiveForbidden is response for GetArchive operation.
+type GetArchiveForbidden struct{}
 
-// GetExecutions returns the value of Executions.
-func (s *JobDefinition) GetExecutions() []Executions {
-	return s.Executions
-}
+func (*GetArchiveForbidden) getArchiveRes() {}
 
-// GetJob returns the value of Job.
-func (s *JobDefinition) GetJob() OptJob {
-	return s.Job
-}
+type GetArchiveNotFound Error
 
-// GetStatus returns the value of Status.
-func (s *JobDefinition) GetStatus() OptString {
-	return s.Status
-}
+func (*GetArchiveNotFound) getArchiveRes() {}
 
-// SetExecutions sets the value of Executions.
-func (s *JobDefinition) SetExecutions(val []Executions) {
-	s.Executions = val
+type GetArchiveOK struct {
+	Data io.Reader
 }
 
-// SetJob sets the value of Job.
-func (s *JobDefinition) SetJob(val OptJob) {
-	s.Job = val
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetArchiveOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetStatus sets the value of Status.
-func (s *JobDefinition) SetStatus(val OptString) {
-	s.Status = val
+// GetArchiveOKHeaders wraps GetArchiveOK with response headers.
+type GetArchiveOKHeaders struct {
+	ContentDisposition OptString
+	Response           GetArchiveOK
 }
 
-// Ref: #/components/schemas/JobDescription
-type JobDescription struct {
-	CronSchedule OptString                        `json:"CronSchedule"`
-	Description  OptString                        `json:"Description"`
-	Environments []JobDescriptionEnvironmentsItem `json:"Environments"`
-	Name         OptString                        `json:"Name"`
-	Parameters   []JobDescriptionParametersItem   `json:"Parameters"`
-	Script       OptString                        `json:"Script"`
-	User         OptString                        `json:"User"`
-	Utility      OptString                        `json:"Utility"`
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *GetArchiveOKHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
-// GetCronSchedule returns the value of CronSchedule.
-func (s *JobDescription) GetCronSchedule() OptString {
-	return s.CronSchedule
+// GetResponse returns the value of Response.
+func (s *GetArchiveOKHeaders) GetResponse() GetArchiveOK {
+	return s.Response
 }
 
-// GetDescription returns the value of Description.
-func (s *JobDescription) GetDescription() OptString {
-	return s.Description
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *GetArchiveOKHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
 }
 
-// GetEnvironments returns the value of Environments.
-func (s *JobDescription) GetEnvironments() []JobDescriptionEnvironmentsItem {
-	return s.Environments
+// SetResponse sets the value of Response.
+func (s *GetArchiveOKHeaders) SetResponse(val GetArchiveOK) {
+	s.Response = val
 }
 
-// GetName returns the value of Name.
-func (s *JobDescription) GetName() OptString {
-	return s.Name
-}
+func (*GetArchiveOKHeaders) getArchiveRes() {}
 
-// GetParameters returns the value of Parameters.
-func (s *JobDescription) GetParameters() []JobDescriptionParametersItem {
-	return s.Parameters
-}
+type GetArchiveRequestEntityTooLarge Error
 
-// GetScript returns the value of Script.
-func (s *JobDescription) GetScript() OptString {
-	return s.Script
-}
+func (*GetArchiveRequestEntityTooLarge) getArchiveRes() {}
 
-// GetUser returns the value of User.
-func (s *JobDescription) GetUser() OptString {
-	return s.User
+// GetArchiveUnauthorized is response for GetArchive operation.
+type GetArchiveUnauthorized struct {
+	WwwAuthenticate OptString
 }
 
-// GetUtility returns the value of Utility.
-func (s *JobDescription) GetUtility() OptString {
-	return s.Utility
+// GetWwwAuthenticate returns the value of WwwAuthenticate.
+func (s *GetArchiveUnauthorized) GetWwwAuthenticate() OptString {
+	return s.WwwAuthenticate
 }
 
-// SetCronSchedule sets the value of CronSchedule.
-func (s *JobDescription) SetCronSchedule(val OptString) {
-	s.CronSchedule = val
+// SetWwwAuthenticate sets the value of WwwAuthenticate.
+func (s *GetArchiveUnauthorized) SetWwwAuthenticate(val OptString) {
+	s.WwwAuthenticate = val
 }
 
-// SetDescription sets the value of Description.
-func (s *JobDescription) SetDescription(val OptString) {
-	s.Description = val
-}
+func (*GetArchiveUnauthorized) getArchiveRes() {}
 
-// SetEnvironments sets the value of Environments.
-func (s *JobDescription) SetEnvironments(val []JobDescriptionEnvironmentsItem) {
-	s.Environments = val
-}
+// GetConfigForbidden is response for GetConfig operation.
+type GetConfigForbidden struct{}
 
-// SetName sets the value of Name.
-func (s *JobDescription) SetName(val OptString) {
-	s.Name = val
-}
+func (*GetConfigForbidden) getConfigRes() {}
 
-// SetParameters sets the value of Parameters.
-func (s *JobDescription) SetParameters(val []JobDescriptionParametersItem) {
-	s.Parameters = val
-}
+// GetConfigUnauthorized is response for GetConfig operation.
+type GetConfigUnauthorized struct{}
 
-// SetScript sets the value of Script.
-func (s *JobDescription) SetScript(val OptString) {
-	s.Script = val
-}
+func (*GetConfigUnauthorized) getConfigRes() {}
 
-// SetUser sets the value of User.
-func (s *JobDescription) SetUser(val OptString) {
-	s.User = val
-}
+// GetDatabasesForbidden is response for GetDatabases operation.
+type GetDatabasesForbidden struct{}
 
-// SetUtility sets the value of Utility.
-func (s *JobDescription) SetUtility(val OptString) {
-	s.Utility = val
-}
+func (*GetDatabasesForbidden) getDatabasesRes() {}
 
-type JobDescriptionEnvironmentsItem struct {
-	Parameter OptString `json:"Parameter"`
-}
+// GetDatabasesUnauthorized is response for GetDatabases operation.
+type GetDatabasesUnauthorized struct{}
 
-// GetParameter returns the value of Parameter.
-func (s *JobDescriptionEnvironmentsItem) GetParameter() OptString {
-	return s.Parameter
-}
+func (*GetDatabasesUnauthorized) getDatabasesRes() {}
 
-// SetParameter sets the value of Parameter.
-func (s *JobDescriptionEnvironmentsItem) SetParameter(val OptString) {
-	s.Parameter = val
-}
+type GetFieldsBadRequest Error
 
-type JobDescriptionParametersItem struct {
-	Parameter OptString `json:"Parameter"`
-}
+func (*GetFieldsBadRequest) getFieldsRes() {}
 
-// GetParameter returns the value of Parameter.
-func (s *JobDescriptionParametersItem) GetParameter() OptString {
-	return s.Parameter
-}
+// GetFieldsForbidden is response for GetFields operation.
+type GetFieldsForbidden struct{}
 
-// SetParameter sets the value of Parameter.
-func (s *JobDescriptionParametersItem) SetParameter(val OptString) {
-	s.Parameter = val
-}
+func (*GetFieldsForbidden) getFieldsRes() {}
 
-type JobEnvironmentsItem struct {
-	Parameter OptString `json:"Parameter"`
-}
+type GetFieldsNotFound Error
 
-// GetParameter returns the value of Parameter.
-func (s *JobEnvironmentsItem) GetParameter() OptString {
-	return s.Parameter
-}
+func (*GetFieldsNotFound) getFieldsRes() {}
 
-// SetParameter sets the value of Parameter.
-func (s *JobEnvironmentsItem) SetParameter(val OptString) {
-	s.Parameter = val
+type GetFieldsOKTextCsv struct {
+	Data io.Reader
 }
 
-// Ref: #/components/schemas/JobFull
-type JobFull struct {
-	Job OptJobDefinition `json:"Job"`
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetFieldsOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetJob returns the value of Job.
-func (s *JobFull) GetJob() OptJobDefinition {
-	return s.Job
+// GetFieldsOKTextCsvHeaders wraps GetFieldsOKTextCsv with response headers.
+type GetFieldsOKTextCsvHeaders struct {
+	XToken   OptString
+	Response GetFieldsOKTextCsv
 }
 
-// SetJob sets the value of Job.
-func (s *JobFull) SetJob(val OptJobDefinition) {
-	s.Job = val
+// GetXToken returns the value of XToken.
+func (s *GetFieldsOKTextCsvHeaders) GetXToken() OptString {
+	return s.XToken
 }
 
-func (*JobFull) getJobFullInfoRes() {}
-
-// Ref: #/components/schemas/JobParameter
-type JobParameter struct {
-	Job OptJobDescription `json:"Job"`
+// GetResponse returns the value of Response.
+func (s *GetFieldsOKTextCsvHeaders) GetResponse() GetFieldsOKTextCsv {
+	return s.Response
 }
 
-// GetJob returns the value of Job.
-func (s *JobParameter) GetJob() OptJobDescription {
-	return s.Job
+// SetXToken sets the value of XToken.
+func (s *GetFieldsOKTextCsvHeaders) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-// SetJob sets the value of Job.
-func (s *JobParameter) SetJob(val OptJobDescription) {
-	s.Job = val
+// SetResponse sets the value of Response.
+func (s *GetFieldsOKTextCsvHeaders) SetResponse(val GetFieldsOKTextCsv) {
+	s.Response = val
 }
 
-func (*JobParameter) postJobReq() {}
-
-type JobParametersItem struct {
-	Parameter OptString `json:"Parameter"`
-}
+func (*GetFieldsOKTextCsvHeaders) getFieldsRes() {}
 
-// GetParameter returns the value of Parameter.
-func (s *JobParametersItem) GetParameter() OptString {
-	return s.Parameter
-}
+// GetFieldsUnauthorized is response for GetFields operation.
+type GetFieldsUnauthorized struct{}
 
-// SetParameter sets the value of Parameter.
-func (s *JobParametersItem) SetParameter(val OptString) {
-	s.Parameter = val
-}
+func (*GetFieldsUnauthorized) getFieldsRes() {}
 
-// Ref: #/components/schemas/JobResult
-type JobResult struct {
-	JobResult OptJobResultJobResult `json:"JobResult"`
-}
+// GetImageCacheStatsForbidden is response for GetImageCacheStats operation.
+type GetImageCacheStatsForbidden struct{}
 
-// GetJobResult returns the value of JobResult.
-func (s *JobResult) GetJobResult() OptJobResultJobResult {
-	return s.JobResult
-}
+func (*GetImageCacheStatsForbidden) getImageCacheStatsRes() {}
 
-// SetJobResult sets the value of JobResult.
-func (s *JobResult) SetJobResult(val OptJobResultJobResult) {
-	s.JobResult = val
-}
+// GetImageCacheStatsUnauthorized is response for GetImageCacheStats operation.
+type GetImageCacheStatsUnauthorized struct{}
 
-func (*JobResult) getJobExecutionResultRes() {}
-func (*JobResult) getJobResultRes()          {}
+func (*GetImageCacheStatsUnauthorized) getImageCacheStatsRes() {}
 
-type JobResultJobResult struct {
-	Description OptString   `json:"Description"`
-	Ended       OptDateTime `json:"Ended"`
-	ExitCode    OptFloat64  `json:"ExitCode"`
-	ID          OptFloat64  `json:"Id"`
-	Log         OptString   `json:"Log"`
-	Name        OptString   `json:"Name"`
-	Scheduled   OptDateTime `json:"Scheduled"`
-	StartedBy   OptString   `json:"StartedBy"`
-	Status      OptString   `json:"Status"`
-}
+type GetImageDuplicatesBadRequest Error
 
-// GetDescription returns the value of Description.
-func (s *JobResultJobResult) GetDescription() OptString {
-	return s.Description
-}
+func (*GetImageDuplicatesBadRequest) getImageDuplicatesRes() {}
 
-// GetEnded returns the value of Ended.
-func (s *JobResultJobResult) GetEnded() OptDateTime {
-	return s.Ended
-}
+// GetImageDuplicatesForbidden is response for GetImageDuplicates operation.
+type GetImageDuplicatesForbidden struct{}
 
-// GetExitCode returns the value of ExitCode.
-func (s *JobResultJobResult) GetExitCode() OptFloat64 {
-	return s.ExitCode
-}
+func (*GetImageDuplicatesForbidden) getImageDuplicatesRes() {}
 
-// GetID returns the value of ID.
-func (s *JobResultJobResult) GetID() OptFloat64 {
-	return s.ID
-}
+type GetImageDuplicatesRequestEntityTooLarge Error
 
-// GetLog returns the value of Log.
-func (s *JobResultJobResult) GetLog() OptString {
-	return s.Log
-}
+func (*GetImageDuplicatesRequestEntityTooLarge) getImageDuplicatesRes() {}
 
-// GetName returns the value of Name.
-func (s *JobResultJobResult) GetName() OptString {
-	return s.Name
+// GetImageDuplicatesUnauthorized is response for GetImageDuplicates operation.
+type GetImageDuplicatesUnauthorized struct {
+	WwwAuthenticate OptString
 }
 
-// GetScheduled returns the value of Scheduled.
-func (s *JobResultJobResult) GetScheduled() OptDateTime {
-	return s.Scheduled
+// GetWwwAuthenticate returns the value of WwwAuthenticate.
+func (s *GetImageDuplicatesUnauthorized) GetWwwAuthenticate() OptString {
+	return s.WwwAuthenticate
 }
 
-// GetStartedBy returns the value of StartedBy.
-func (s *JobResultJobResult) GetStartedBy() OptString {
-	return s.StartedBy
+// SetWwwAuthenticate sets the value of WwwAuthenticate.
+func (s *GetImageDuplicatesUnauthorized) SetWwwAuthenticate(val OptString) {
+	s.WwwAuthenticate = val
 }
 
-// GetStatus returns the value of Status.
-func (s *JobResultJobResult) GetStatus() OptString {
-	return s.Status
-}
+func (*GetImageDuplicatesUnauthorized) getImageDuplicatesRes() {}
 
-// SetDescription sets the value of Description.
-func (s *JobResultJobResult) SetDescription(val OptString) {
-	s.Description = val
-}
+type GetImageFit string
 
-// SetEnded sets the value of Ended.
-func (s *JobResultJobResult) SetEnded(val OptDateTime) {
-	s.Ended = val
-}
+const (
+	GetImageFitContain GetImageFit = "contain"
+	GetImageFitCover   GetImageFit = "cover"
+	GetImageFitCrop    GetImageFit = "crop"
+)
 
-// SetExitCode sets the value of ExitCode.
-func (s *JobResultJobResult) SetExitCode(val OptFloat64) {
-	s.ExitCode = val
+// AllValues returns all GetImageFit values.
+func (GetImageFit) AllValues() []GetImageFit {
+	return []GetImageFit{
+		GetImageFitContain,
+		GetImageFitCover,
+		GetImageFitCrop,
+	}
 }
 
-// SetID sets the value of ID.
-func (s *JobResultJobResult) SetID(val OptFloat64) {
-	s.ID = val
+// MarshalText implements encoding.TextMarshaler.
+func (s GetImageFit) MarshalText() ([]byte, error) {
+	switch s {
+	case GetImageFitContain:
+		return []byte(s), nil
+	case GetImageFitCover:
+		return []byte(s), nil
+	case GetImageFitCrop:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetLog sets the value of Log.
-func (s *JobResultJobResult) SetLog(val OptString) {
-	s.Log = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetImageFit) UnmarshalText(data []byte) error {
+	switch GetImageFit(data) {
+	case GetImageFitContain:
+		*s = GetImageFitContain
+		return nil
+	case GetImageFitCover:
+		*s = GetImageFitCover
+		return nil
+	case GetImageFitCrop:
+		*s = GetImageFitCrop
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetName sets the value of Name.
-func (s *JobResultJobResult) SetName(val OptString) {
-	s.Name = val
-}
+// GetImageForbidden is response for GetImage operation.
+type GetImageForbidden struct{}
 
-// SetScheduled sets the value of Scheduled.
-func (s *JobResultJobResult) SetScheduled(val OptDateTime) {
-	s.Scheduled = val
-}
+func (*GetImageForbidden) getImageRes() {}
 
-// SetStartedBy sets the value of StartedBy.
-func (s *JobResultJobResult) SetStartedBy(val OptString) {
-	s.StartedBy = val
-}
+type GetImageFormat string
 
-// SetStatus sets the value of Status.
-func (s *JobResultJobResult) SetStatus(val OptString) {
-	s.Status = val
-}
+const (
+	GetImageFormatJpeg GetImageFormat = "jpeg"
+	GetImageFormatPNG  GetImageFormat = "png"
+	GetImageFormatGIF  GetImageFormat = "gif"
+)
 
-// Ref: #/components/schemas/JobStatusResponse
-type JobStatusResponse struct {
-	Status OptJobStatusResponseStatus `json:"Status"`
+// AllValues returns all GetImageFormat values.
+func (GetImageFormat) AllValues() []GetImageFormat {
+	return []GetImageFormat{
+		GetImageFormatJpeg,
+		GetImageFormatPNG,
+		GetImageFormatGIF,
+	}
 }
 
-// GetStatus returns the value of Status.
-func (s *JobStatusResponse) GetStatus() OptJobStatusResponseStatus {
-	return s.Status
+// MarshalText implements encoding.TextMarshaler.
+func (s GetImageFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case GetImageFormatJpeg:
+		return []byte(s), nil
+	case GetImageFormatPNG:
+		return []byte(s), nil
+	case GetImageFormatGIF:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetStatus sets the value of Status.
-func (s *JobStatusResponse) SetStatus(val OptJobStatusResponseStatus) {
-	s.Status = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetImageFormat) UnmarshalText(data []byte) error {
+	switch GetImageFormat(data) {
+	case GetImageFormatJpeg:
+		*s = GetImageFormatJpeg
+		return nil
+	case GetImageFormatPNG:
+		*s = GetImageFormatPNG
+		return nil
+	case GetImageFormatGIF:
+		*s = GetImageFormatGIF
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-func (*JobStatusResponse) deleteJobResultRes() {}
-
-type JobStatusResponseStatus struct {
-	Action      OptString `json:"Action"`
-	ExecutionId OptInt    `json:"ExecutionId"`
-	Message     OptString `json:"Message"`
-	Name        OptString `json:"Name"`
-}
+type GetImageHashAlgorithm string
 
-// GetAction returns the value of Action.
-func (s *JobStatusResponseStatus) GetAction() OptString {
-	return s.Action
-}
+const (
+	GetImageHashAlgorithmAhash GetImageHashAlgorithm = "ahash"
+	GetImageHashAlgorithmDhash GetImageHashAlgorithm = "dhash"
+	GetImageHashAlgorithmPhash GetImageHashAlgorithm = "phash"
+)
 
-// GetExecutionId returns the value of ExecutionId.
-func (s *JobStatusResponseStatus) GetExecutionId() OptInt {
-	return s.ExecutionId
+// AllValues returns all GetImageHashAlgorithm values.
+func (GetImageHashAlgorithm) AllValues() []GetImageHashAlgorithm {
+	return []GetImageHashAlgorithm{
+		GetImageHashAlgorithmAhash,
+		GetImageHashAlgorithmDhash,
+		GetImageHashAlgorithmPhash,
+	}
 }
 
-// GetMessage returns the value of Message.
-func (s *JobStatusResponseStatus) GetMessage() OptString {
-	return s.Message
+// MarshalText implements encoding.TextMarshaler.
+func (s GetImageHashAlgorithm) MarshalText() ([]byte, error) {
+	switch s {
+	case GetImageHashAlgorithmAhash:
+		return []byte(s), nil
+	case GetImageHashAlgorithmDhash:
+		return []byte(s), nil
+	case GetImageHashAlgorithmPhash:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetName returns the value of Name.
-func (s *JobStatusResponseStatus) GetName() OptString {
-	return s.Name
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetImageHashAlgorithm) UnmarshalText(data []byte) error {
+	switch GetImageHashAlgorithm(data) {
+	case GetImageHashAlgorithmAhash:
+		*s = GetImageHashAlgorithmAhash
+		return nil
+	case GetImageHashAlgorithmDhash:
+		*s = GetImageHashAlgorithmDhash
+		return nil
+	case GetImageHashAlgorithmPhash:
+		*s = GetImageHashAlgorithmPhash
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetAction sets the value of Action.
-func (s *JobStatusResponseStatus) SetAction(val OptString) {
-	s.Action = val
-}
+type GetImageHashBadRequest Error
 
-// SetExecutionId sets the value of ExecutionId.
-func (s *JobStatusResponseStatus) SetExecutionId(val OptInt) {
-	s.ExecutionId = val
-}
+func (*GetImageHashBadRequest) getImageHashRes() {}
 
-// SetMessage sets the value of Message.
-func (s *JobStatusResponseStatus) SetMessage(val OptString) {
-	s.Message = val
-}
+// GetImageHashForbidden is response for GetImageHash operation.
+type GetImageHashForbidden struct{}
 
-// SetName sets the value of Name.
-func (s *JobStatusResponseStatus) SetName(val OptString) {
-	s.Name = val
-}
+func (*GetImageHashForbidden) getImageHashRes() {}
 
-// Ref: #/components/schemas/JobStore
-type JobStore struct {
-	Config   OptString         `json:"config"`
-	Database OptDatabaseConfig `json:"Database"`
-}
+type GetImageHashNotFound Error
 
-// GetConfig returns the value of Config.
-func (s *JobStore) GetConfig() OptString {
-	return s.Config
-}
+func (*GetImageHashNotFound) getImageHashRes() {}
 
-// GetDatabase returns the value of Database.
-func (s *JobStore) GetDatabase() OptDatabaseConfig {
-	return s.Database
+// GetImageHashUnauthorized is response for GetImageHash operation.
+type GetImageHashUnauthorized struct {
+	WwwAuthenticate OptString
 }
 
-// SetConfig sets the value of Config.
-func (s *JobStore) SetConfig(val OptString) {
-	s.Config = val
+// GetWwwAuthenticate returns the value of WwwAuthenticate.
+func (s *GetImageHashUnauthorized) GetWwwAuthenticate() OptString {
+	return s.WwwAuthenticate
 }
 
-// SetDatabase sets the value of Database.
-func (s *JobStore) SetDatabase(val OptDatabaseConfig) {
-	s.Database = val
+// SetWwwAuthenticate sets the value of WwwAuthenticate.
+func (s *GetImageHashUnauthorized) SetWwwAuthenticate(val OptString) {
+	s.WwwAuthenticate = val
 }
 
-func (*JobStore) getJobsConfigRes() {}
+func (*GetImageHashUnauthorized) getImageHashRes() {}
 
-// Ref: #/components/schemas/JobsList
-type JobsList struct {
-	JobDefinition []JobDefinition `json:"JobDefinition"`
-}
+type GetImageMetadataBadRequest Error
 
-// GetJobDefinition returns the value of JobDefinition.
-func (s *JobsList) GetJobDefinition() []JobDefinition {
-	return s.JobDefinition
-}
+func (*GetImageMetadataBadRequest) getImageMetadataRes() {}
 
-// SetJobDefinition sets the value of JobDefinition.
-func (s *JobsList) SetJobDefinition(val []JobDefinition) {
-	s.JobDefinition = val
-}
+// GetImageMetadataForbidden is response for GetImageMetadata operation.
+type GetImageMetadataForbidden struct{}
 
-func (*JobsList) getJobsRes() {}
+func (*GetImageMetadataForbidden) getImageMetadataRes() {}
 
-type ListModellingBadRequest Error
+type GetImageMetadataNotFound Error
 
-func (*ListModellingBadRequest) listModellingRes() {}
+func (*GetImageMetadataNotFound) getImageMetadataRes() {}
 
-// ListModellingForbidden is response for ListModelling operation.
-type ListModellingForbidden struct{}
+// GetImageMetadataUnauthorized is response for GetImageMetadata operation.
+type GetImageMetadataUnauthorized struct {
+	WwwAuthenticate OptString
+}
 
-func (*ListModellingForbidden) listModellingRes() {}
+// GetWwwAuthenticate returns the value of WwwAuthenticate.
+func (s *GetImageMetadataUnauthorized) GetWwwAuthenticate() OptString {
+	return s.WwwAuthenticate
+}
 
-type ListModellingNotFound Error
+// SetWwwAuthenticate sets the value of WwwAuthenticate.
+func (s *GetImageMetadataUnauthorized) SetWwwAuthenticate(val OptString) {
+	s.WwwAuthenticate = val
+}
 
-func (*ListModellingNotFound) listModellingRes() {}
+func (*GetImageMetadataUnauthorized) getImageMetadataRes() {}
 
-type ListModellingOKTextCsv struct {
+type GetImageOK struct {
 	Data io.Reader
 }
 
 // Read reads data from the Data reader.
 //
 // Kept to satisfy the io.Reader interface.
-func (s ListModellingOKTextCsv) Read(p []byte) (n int, err error) {
+func (s GetImageOK) Read(p []byte) (n int, err error) {
 	if s.Data == nil {
 		return 0, io.EOF
 	}
 	return s.Data.Read(p)
 }
 
-func (*ListModellingOKTextCsv) listModellingRes() {}
+// GetImageOKHeaders wraps GetImageOK with response headers.
+type GetImageOKHeaders struct {
+	ContentType string
+	Response    GetImageOK
+}
 
-// ListModellingUnauthorized is response for ListModelling operation.
-type ListModellingUnauthorized struct{}
+// GetContentType returns the value of ContentType.
+func (s *GetImageOKHeaders) GetContentType() string {
+	return s.ContentType
+}
 
-func (*ListModellingUnauthorized) listModellingRes() {}
+// GetResponse returns the value of Response.
+func (s *GetImageOKHeaders) GetResponse() GetImageOK {
+	return s.Response
+}
 
-type ListTablesBadRequest Error
+// SetContentType sets the value of ContentType.
+func (s *GetImageOKHeaders) SetContentType(val string) {
+	s.ContentType = val
+}
 
-func (*ListTablesBadRequest) listTablesRes() {}
+// SetResponse sets the value of Response.
+func (s *GetImageOKHeaders) SetResponse(val GetImageOK) {
+	s.Response = val
+}
 
-// ListTablesForbidden is response for ListTables operation.
-type ListTablesForbidden struct{}
+func (*GetImageOKHeaders) getImageRes() {}
 
-func (*ListTablesForbidden) listTablesRes() {}
+// GetImageUnauthorized is response for GetImage operation.
+type GetImageUnauthorized struct {
+	WwwAuthenticate OptString
+}
 
-type ListTablesNotFound Error
+// GetWwwAuthenticate returns the value of WwwAuthenticate.
+func (s *GetImageUnauthorized) GetWwwAuthenticate() OptString {
+	return s.WwwAuthenticate
+}
 
-func (*ListTablesNotFound) listTablesRes() {}
+// SetWwwAuthenticate sets the value of WwwAuthenticate.
+func (s *GetImageUnauthorized) SetWwwAuthenticate(val OptString) {
+	s.WwwAuthenticate = val
+}
 
-type ListTablesOKTextCsv struct {
+func (*GetImageUnauthorized) getImageRes() {}
+
+type GetJobExecutionResultBadRequest Error
+
+func (*GetJobExecutionResultBadRequest) getJobExecutionResultRes() {}
+
+// GetJobExecutionResultForbidden is response for GetJobExecutionResult operation.
+type GetJobExecutionResultForbidden struct{}
+
+func (*GetJobExecutionResultForbidden) getJobExecutionResultRes() {}
+
+type GetJobExecutionResultNotFound Error
+
+func (*GetJobExecutionResultNotFound) getJobExecutionResultRes() {}
+
+// GetJobExecutionResultUnauthorized is response for GetJobExecutionResult operation.
+type GetJobExecutionResultUnauthorized struct{}
+
+func (*GetJobExecutionResultUnauthorized) getJobExecutionResultRes() {}
+
+type GetJobFullInfoBadRequest Error
+
+func (*GetJobFullInfoBadRequest) getJobFullInfoRes() {}
+
+// GetJobFullInfoForbidden is response for GetJobFullInfo operation.
+type GetJobFullInfoForbidden struct{}
+
+func (*GetJobFullInfoForbidden) getJobFullInfoRes() {}
+
+type GetJobFullInfoNotFound Error
+
+func (*GetJobFullInfoNotFound) getJobFullInfoRes() {}
+
+// GetJobFullInfoUnauthorized is response for GetJobFullInfo operation.
+type GetJobFullInfoUnauthorized struct{}
+
+func (*GetJobFullInfoUnauthorized) getJobFullInfoRes() {}
+
+type GetJobResultBadRequest Error
+
+func (*GetJobResultBadRequest) getJobResultRes() {}
+
+// GetJobResultForbidden is response for GetJobResult operation.
+type GetJobResultForbidden struct{}
+
+func (*GetJobResultForbidden) getJobResultRes() {}
+
+type GetJobResultNotFound Error
+
+func (*GetJobResultNotFound) getJobResultRes() {}
+
+// GetJobResultUnauthorized is response for GetJobResult operation.
+type GetJobResultUnauthorized struct{}
+
+func (*GetJobResultUnauthorized) getJobResultRes() {}
+
+// GetJobsConfigForbidden is response for GetJobsConfig operation.
+type GetJobsConfigForbidden struct{}
+
+func (*GetJobsConfigForbidden) getJobsConfigRes() {}
+
+// GetJobsConfigUnauthorized is response for GetJobsConfig operation.
+type GetJobsConfigUnauthorized struct{}
+
+func (*GetJobsConfigUnauthorized) getJobsConfigRes() {}
+
+// GetJobsForbidden is response for GetJobs operation.
+type GetJobsForbidden struct{}
+
+func (*GetJobsForbidden) getJobsRes() {}
+
+// GetJobsNotFound is response for GetJobs operation.
+type GetJobsNotFound struct{}
+
+func (*GetJobsNotFound) getJobsRes() {}
+
+// GetJobsUnauthorized is response for GetJobs operation.
+type GetJobsUnauthorized struct{}
+
+func (*GetJobsUnauthorized) getJobsRes() {}
+
+// GetLobByMapForbidden is response for GetLobByMap operation.
+type GetLobByMapForbidden struct{}
+
+func (*GetLobByMapForbidden) getLobByMapRes() {}
+
+type GetLobByMapOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetLobByMapOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// GetLobByMapOKHeaders wraps GetLobByMapOK with response headers.
+type GetLobByMapOKHeaders struct {
+	AcceptRanges  OptString
+	ContentLength OptInt64
+	ETag          OptString
+	Response      GetLobByMapOK
+}
+
+// GetAcceptRanges returns the value of AcceptRanges.
+func (s *GetLobByMapOKHeaders) GetAcceptRanges() OptString {
+	return s.AcceptRanges
+}
+
+// GetContentLength returns the value of ContentLength.
+func (s *GetLobByMapOKHeaders) GetContentLength() OptInt64 {
+	return s.ContentLength
+}
+
+// GetETag returns the value of ETag.
+func (s *GetLobByMapOKHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *GetLobByMapOKHeaders) GetResponse() GetLobByMapOK {
+	return s.Response
+}
+
+// SetAcceptRanges sets the value of AcceptRanges.
+func (s *GetLobByMapOKHeaders) SetAcceptRanges(val OptString) {
+	s.AcceptRanges = val
+}
+
+// SetContentLength sets the value of ContentLength.
+func (s *GetLobByMapOKHeaders) SetContentLength(val OptInt64) {
+	s.ContentLength = val
+}
+
+// SetETag sets the value of ETag.
+func (s *GetLobByMapOKHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetLobByMapOKHeaders) SetResponse(val GetLobByMapOK) {
+	s.Response = val
+}
+
+func (*GetLobByMapOKHeaders) getLobByMapRes() {}
+
+type GetLobByMapPartialContent struct {
 	Data io.Reader
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s ListTablesOKTextCsv) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetLobByMapPartialContent) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// GetLobByMapPartialContentHeaders wraps GetLobByMapPartialContent with response headers.
+type GetLobByMapPartialContentHeaders struct {
+	AcceptRanges  OptString
+	ContentLength OptInt64
+	ContentRange  OptString
+	ContentType   string
+	ETag          OptString
+	Response      GetLobByMapPartialContent
+}
+
+// GetAcceptRanges returns the value of AcceptRanges.
+func (s *GetLobByMapPartialContentHeaders) GetAcceptRanges() OptString {
+	return s.AcceptRanges
+}
+
+// GetContentLength returns the value of ContentLength.
+func (s *GetLobByMapPartialContentHeaders) GetContentLength() OptInt64 {
+	return s.ContentLength
+}
+
+// GetContentRange returns the value of ContentRange.
+func (s *GetLobByMapPartialContentHeaders) GetContentRange() OptString {
+	return s.ContentRange
+}
+
+// GetContentType returns the value of ContentType.
+func (s *GetLobByMapPartialContentHeaders) GetContentType() string {
+	return s.ContentType
+}
+
+// GetETag returns the value of ETag.
+func (s *GetLobByMapPartialContentHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *GetLobByMapPartialContentHeaders) GetResponse() GetLobByMapPartialContent {
+	return s.Response
+}
+
+// SetAcceptRanges sets the value of AcceptRanges.
+func (s *GetLobByMapPartialContentHeaders) SetAcceptRanges(val OptString) {
+	s.AcceptRanges = val
+}
+
+// SetContentLength sets the value of ContentLength.
+func (s *GetLobByMapPartialContentHeaders) SetContentLength(val OptInt64) {
+	s.ContentLength = val
+}
+
+// SetContentRange sets the value of ContentRange.
+func (s *GetLobByMapPartialContentHeaders) SetContentRange(val OptString) {
+	s.ContentRange = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetLobByMapPartialContentHeaders) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetETag sets the value of ETag.
+func (s *GetLobByMapPartialContentHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetLobByMapPartialContentHeaders) SetResponse(val GetLobByMapPartialContent) {
+	s.Response = val
+}
+
+func (*GetLobByMapPartialContentHeaders) getLobByMapRes() {}
+
+// GetLobByMapRequestedRangeNotSatisfiable is response for GetLobByMap operation.
+type GetLobByMapRequestedRangeNotSatisfiable struct {
+	ContentRange OptString
+}
+
+// GetContentRange returns the value of ContentRange.
+func (s *GetLobByMapRequestedRangeNotSatisfiable) GetContentRange() OptString {
+	return s.ContentRange
+}
+
+// SetContentRange sets the value of ContentRange.
+func (s *GetLobByMapRequestedRangeNotSatisfiable) SetContentRange(val OptString) {
+	s.ContentRange = val
+}
+
+func (*GetLobByMapRequestedRangeNotSatisfiable) getLobByMapRes() {}
+
+// GetLobByMapUnauthorized is response for GetLobByMap operation.
+type GetLobByMapUnauthorized struct{}
+
+func (*GetLobByMapUnauthorized) getLobByMapRes() {}
+
+// GetLoginSessionForbidden is response for GetLoginSession operation.
+type GetLoginSessionForbidden struct{}
+
+func (*GetLoginSessionForbidden) getLoginSessionRes() {}
+
+// GetLoginSessionUnauthorized is response for GetLoginSession operation.
+type GetLoginSessionUnauthorized struct{}
+
+func (*GetLoginSessionUnauthorized) getLoginSessionRes() {}
+
+// GetMapMetadataForbidden is response for GetMapMetadata operation.
+type GetMapMetadataForbidden struct{}
+
+func (*GetMapMetadataForbidden) getMapMetadataRes() {}
+
+// GetMapMetadataUnauthorized is response for GetMapMetadata operation.
+type GetMapMetadataUnauthorized struct{}
+
+func (*GetMapMetadataUnauthorized) getMapMetadataRes() {}
+
+// GetMapRecordsFieldsForbidden is response for GetMapRecordsFields operation.
+type GetMapRecordsFieldsForbidden struct{}
+
+func (*GetMapRecordsFieldsForbidden) getMapRecordsFieldsRes() {}
+
+// GetMapRecordsFieldsOKApplicationJSONHeaders wraps Response with response headers.
+type GetMapRecordsFieldsOKApplicationJSONHeaders struct {
+	ETag     OptString
+	Link     OptString
+	XToken   OptString
+	Response Response
+}
+
+// GetETag returns the value of ETag.
+func (s *GetMapRecordsFieldsOKApplicationJSONHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetLink returns the value of Link.
+func (s *GetMapRecordsFieldsOKApplicationJSONHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXToken returns the value of XToken.
+func (s *GetMapRecordsFieldsOKApplicationJSONHeaders) GetXToken() OptString {
+	return s.XToken
+}
+
+// GetResponse returns the value of Response.
+func (s *GetMapRecordsFieldsOKApplicationJSONHeaders) GetResponse() Response {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *GetMapRecordsFieldsOKApplicationJSONHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetLink sets the value of Link.
+func (s *GetMapRecordsFieldsOKApplicationJSONHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXToken sets the value of XToken.
+func (s *GetMapRecordsFieldsOKApplicationJSONHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetMapRecordsFieldsOKApplicationJSONHeaders) SetResponse(val Response) {
+	s.Response = val
+}
+
+func (*GetMapRecordsFieldsOKApplicationJSONHeaders) getMapRecordsFieldsRes() {}
+func (*GetMapRecordsFieldsOKApplicationJSONHeaders) searchRecordsFieldsRes() {}
+
+type GetMapRecordsFieldsOKApplicationXJSONStream struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetMapRecordsFieldsOKApplicationXJSONStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// GetMapRecordsFieldsOKApplicationXJSONStreamHeaders wraps GetMapRecordsFieldsOKApplicationXJSONStream with response headers.
+type GetMapRecordsFieldsOKApplicationXJSONStreamHeaders struct {
+	ETag     OptString
+	Link     OptString
+	XToken   OptString
+	Response GetMapRecordsFieldsOKApplicationXJSONStream
+}
+
+// GetETag returns the value of ETag.
+func (s *GetMapRecordsFieldsOKApplicationXJSONStreamHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetLink returns the value of Link.
+func (s *GetMapRecordsFieldsOKApplicationXJSONStreamHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXToken returns the value of XToken.
+func (s *GetMapRecordsFieldsOKApplicationXJSONStreamHeaders) GetXToken() OptString {
+	return s.XToken
+}
+
+// GetResponse returns the value of Response.
+func (s *GetMapRecordsFieldsOKApplicationXJSONStreamHeaders) GetResponse() GetMapRecordsFieldsOKApplicationXJSONStream {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *GetMapRecordsFieldsOKApplicationXJSONStreamHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetLink sets the value of Link.
+func (s *GetMapRecordsFieldsOKApplicationXJSONStreamHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXToken sets the value of XToken.
+func (s *GetMapRecordsFieldsOKApplicationXJSONStreamHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetMapRecordsFieldsOKApplicationXJSONStreamHeaders) SetResponse(val GetMapRecordsFieldsOKApplicationXJSONStream) {
+	s.Response = val
+}
+
+func (*GetMapRecordsFieldsOKApplicationXJSONStreamHeaders) getMapRecordsFieldsRes() {}
+
+type GetMapRecordsFieldsOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetMapRecordsFieldsOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// GetMapRecordsFieldsOKApplicationXNdjsonHeaders wraps GetMapRecordsFieldsOKApplicationXNdjson with response headers.
+type GetMapRecordsFieldsOKApplicationXNdjsonHeaders struct {
+	ETag     OptString
+	Link     OptString
+	XToken   OptString
+	Response GetMapRecordsFieldsOKApplicationXNdjson
+}
+
+// GetETag returns the value of ETag.
+func (s *GetMapRecordsFieldsOKApplicationXNdjsonHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetLink returns the value of Link.
+func (s *GetMapRecordsFieldsOKApplicationXNdjsonHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXToken returns the value of XToken.
+func (s *GetMapRecordsFieldsOKApplicationXNdjsonHeaders) GetXToken() OptString {
+	return s.XToken
+}
+
+// GetResponse returns the value of Response.
+func (s *GetMapRecordsFieldsOKApplicationXNdjsonHeaders) GetResponse() GetMapRecordsFieldsOKApplicationXNdjson {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *GetMapRecordsFieldsOKApplicationXNdjsonHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetLink sets the value of Link.
+func (s *GetMapRecordsFieldsOKApplicationXNdjsonHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXToken sets the value of XToken.
+func (s *GetMapRecordsFieldsOKApplicationXNdjsonHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetMapRecordsFieldsOKApplicationXNdjsonHeaders) SetResponse(val GetMapRecordsFieldsOKApplicationXNdjson) {
+	s.Response = val
+}
+
+func (*GetMapRecordsFieldsOKApplicationXNdjsonHeaders) getMapRecordsFieldsRes() {}
+
+type GetMapRecordsFieldsOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetMapRecordsFieldsOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// GetMapRecordsFieldsOKTextCsvHeaders wraps GetMapRecordsFieldsOKTextCsv with response headers.
+type GetMapRecordsFieldsOKTextCsvHeaders struct {
+	ETag     OptString
+	Link     OptString
+	XToken   OptString
+	Response GetMapRecordsFieldsOKTextCsv
+}
+
+// GetETag returns the value of ETag.
+func (s *GetMapRecordsFieldsOKTextCsvHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetLink returns the value of Link.
+func (s *GetMapRecordsFieldsOKTextCsvHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXToken returns the value of XToken.
+func (s *GetMapRecordsFieldsOKTextCsvHeaders) GetXToken() OptString {
+	return s.XToken
+}
+
+// GetResponse returns the value of Response.
+func (s *GetMapRecordsFieldsOKTextCsvHeaders) GetResponse() GetMapRecordsFieldsOKTextCsv {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *GetMapRecordsFieldsOKTextCsvHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetLink sets the value of Link.
+func (s *GetMapRecordsFieldsOKTextCsvHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXToken sets the value of XToken.
+func (s *GetMapRecordsFieldsOKTextCsvHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetMapRecordsFieldsOKTextCsvHeaders) SetResponse(val GetMapRecordsFieldsOKTextCsv) {
+	s.Response = val
+}
+
+func (*GetMapRecordsFieldsOKTextCsvHeaders) getMapRecordsFieldsRes() {}
+
+// GetMapRecordsFieldsUnauthorized is response for GetMapRecordsFields operation.
+type GetMapRecordsFieldsUnauthorized struct{}
+
+func (*GetMapRecordsFieldsUnauthorized) getMapRecordsFieldsRes() {}
+
+// GetMapsForbidden is response for GetMaps operation.
+type GetMapsForbidden struct{}
+
+func (*GetMapsForbidden) getMapsRes() {}
+
+// GetMapsUnauthorized is response for GetMaps operation.
+type GetMapsUnauthorized struct{}
+
+func (*GetMapsUnauthorized) getMapsRes() {}
+
+type GetRecordByKeyBadRequest Error
+
+func (*GetRecordByKeyBadRequest) getRecordByKeyRes() {}
+
+// GetRecordByKeyForbidden is response for GetRecordByKey operation.
+type GetRecordByKeyForbidden struct{}
+
+func (*GetRecordByKeyForbidden) getRecordByKeyRes() {}
+
+type GetRecordByKeyNotFound Error
+
+func (*GetRecordByKeyNotFound) getRecordByKeyRes() {}
+
+// GetRecordByKeyOKHeaders wraps Response with response headers.
+type GetRecordByKeyOKHeaders struct {
+	ETag     OptString
+	XToken   OptString
+	Response Response
+}
+
+// GetETag returns the value of ETag.
+func (s *GetRecordByKeyOKHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetXToken returns the value of XToken.
+func (s *GetRecordByKeyOKHeaders) GetXToken() OptString {
+	return s.XToken
+}
+
+// GetResponse returns the value of Response.
+func (s *GetRecordByKeyOKHeaders) GetResponse() Response {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *GetRecordByKeyOKHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetXToken sets the value of XToken.
+func (s *GetRecordByKeyOKHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetRecordByKeyOKHeaders) SetResponse(val Response) {
+	s.Response = val
+}
+
+func (*GetRecordByKeyOKHeaders) getRecordByKeyRes()   {}
+func (*GetRecordByKeyOKHeaders) patchRecordByKeyRes() {}
+
+// GetRecordByKeyUnauthorized is response for GetRecordByKey operation.
+type GetRecordByKeyUnauthorized struct{}
+
+func (*GetRecordByKeyUnauthorized) getRecordByKeyRes() {}
+
+// GetRecordHistoryForbidden is response for GetRecordHistory operation.
+type GetRecordHistoryForbidden struct{}
+
+func (*GetRecordHistoryForbidden) getRecordHistoryRes() {}
+
+// GetRecordHistoryUnauthorized is response for GetRecordHistory operation.
+type GetRecordHistoryUnauthorized struct{}
+
+func (*GetRecordHistoryUnauthorized) getRecordHistoryRes() {}
+
+// GetUploadForbidden is response for GetUpload operation.
+type GetUploadForbidden struct{}
+
+func (*GetUploadForbidden) getUploadRes() {}
+
+// GetUploadOKHeaders wraps UploadStatus with response headers.
+type GetUploadOKHeaders struct {
+	UploadOffset OptInt64
+	Response     UploadStatus
+}
+
+// GetUploadOffset returns the value of UploadOffset.
+func (s *GetUploadOKHeaders) GetUploadOffset() OptInt64 {
+	return s.UploadOffset
+}
+
+// GetResponse returns the value of Response.
+func (s *GetUploadOKHeaders) GetResponse() UploadStatus {
+	return s.Response
+}
+
+// SetUploadOffset sets the value of UploadOffset.
+func (s *GetUploadOKHeaders) SetUploadOffset(val OptInt64) {
+	s.UploadOffset = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetUploadOKHeaders) SetResponse(val UploadStatus) {
+	s.Response = val
+}
+
+func (*GetUploadOKHeaders) getUploadRes()   {}
+func (*GetUploadOKHeaders) uploadChunkRes() {}
+
+// GetUploadUnauthorized is response for GetUpload operation.
+type GetUploadUnauthorized struct{}
+
+func (*GetUploadUnauthorized) getUploadRes() {}
+
+// GetUserInfoForbidden is response for GetUserInfo operation.
+type GetUserInfoForbidden struct{}
+
+func (*GetUserInfoForbidden) getUserInfoRes() {}
+
+// GetUserInfoUnauthorized is response for GetUserInfo operation.
+type GetUserInfoUnauthorized struct{}
+
+func (*GetUserInfoUnauthorized) getUserInfoRes() {}
+
+// GetVideoForbidden is response for GetVideo operation.
+type GetVideoForbidden struct{}
+
+func (*GetVideoForbidden) getVideoRes() {}
+
+type GetVideoOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetVideoOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// GetVideoOKHeaders wraps GetVideoOK with response headers.
+type GetVideoOKHeaders struct {
+	AcceptRanges  OptString
+	ContentLength OptInt64
+	ContentType   string
+	ETag          OptString
+	Response      GetVideoOK
+}
+
+// GetAcceptRanges returns the value of AcceptRanges.
+func (s *GetVideoOKHeaders) GetAcceptRanges() OptString {
+	return s.AcceptRanges
+}
+
+// GetContentLength returns the value of ContentLength.
+func (s *GetVideoOKHeaders) GetContentLength() OptInt64 {
+	return s.ContentLength
+}
+
+// GetContentType returns the value of ContentType.
+func (s *GetVideoOKHeaders) GetContentType() string {
+	return s.ContentType
+}
+
+// GetETag returns the value of ETag.
+func (s *GetVideoOKHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *GetVideoOKHeaders) GetResponse() GetVideoOK {
+	return s.Response
+}
+
+// SetAcceptRanges sets the value of AcceptRanges.
+func (s *GetVideoOKHeaders) SetAcceptRanges(val OptString) {
+	s.AcceptRanges = val
+}
+
+// SetContentLength sets the value of ContentLength.
+func (s *GetVideoOKHeaders) SetContentLength(val OptInt64) {
+	s.ContentLength = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetVideoOKHeaders) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetETag sets the value of ETag.
+func (s *GetVideoOKHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetVideoOKHeaders) SetResponse(val GetVideoOK) {
+	s.Response = val
+}
+
+func (*GetVideoOKHeaders) getVideoRes() {}
+
+type GetVideoPartialContent struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetVideoPartialContent) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// GetVideoPartialContentHeaders wraps GetVideoPartialContent with response headers.
+type GetVideoPartialContentHeaders struct {
+	AcceptRanges  OptString
+	ContentLength OptInt64
+	ContentRange  OptString
+	ContentType   string
+	ETag          OptString
+	Response      GetVideoPartialContent
+}
+
+// GetAcceptRanges returns the value of AcceptRanges.
+func (s *GetVideoPartialContentHeaders) GetAcceptRanges() OptString {
+	return s.AcceptRanges
+}
+
+// GetContentLength returns the value of ContentLength.
+func (s *GetVideoPartialContentHeaders) GetContentLength() OptInt64 {
+	return s.ContentLength
+}
+
+// GetContentRange returns the value of ContentRange.
+func (s *GetVideoPartialContentHeaders) GetContentRange() OptString {
+	return s.ContentRange
+}
+
+// GetContentType returns the value of ContentType.
+func (s *GetVideoPartialContentHeaders) GetContentType() string {
+	return s.ContentType
+}
+
+// GetETag returns the value of ETag.
+func (s *GetVideoPartialContentHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *GetVideoPartialContentHeaders) GetResponse() GetVideoPartialContent {
+	return s.Response
+}
+
+// SetAcceptRanges sets the value of AcceptRanges.
+func (s *GetVideoPartialContentHeaders) SetAcceptRanges(val OptString) {
+	s.AcceptRanges = val
+}
+
+// SetContentLength sets the value of ContentLength.
+func (s *GetVideoPartialContentHeaders) SetContentLength(val OptInt64) {
+	s.ContentLength = val
+}
+
+// SetContentRange sets the value of ContentRange.
+func (s *GetVideoPartialContentHeaders) SetContentRange(val OptString) {
+	s.ContentRange = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetVideoPartialContentHeaders) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetETag sets the value of ETag.
+func (s *GetVideoPartialContentHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetVideoPartialContentHeaders) SetResponse(val GetVideoPartialContent) {
+	s.Response = val
+}
+
+func (*GetVideoPartialContentHeaders) getVideoRes() {}
+
+// GetVideoRequestedRangeNotSatisfiable is response for GetVideo operation.
+type GetVideoRequestedRangeNotSatisfiable struct {
+	ContentRange OptString
+}
+
+// GetContentRange returns the value of ContentRange.
+func (s *GetVideoRequestedRangeNotSatisfiable) GetContentRange() OptString {
+	return s.ContentRange
+}
+
+// SetContentRange sets the value of ContentRange.
+func (s *GetVideoRequestedRangeNotSatisfiable) SetContentRange(val OptString) {
+	s.ContentRange = val
+}
+
+func (*GetVideoRequestedRangeNotSatisfiable) getVideoRes() {}
+
+// GetVideoUnauthorized is response for GetVideo operation.
+type GetVideoUnauthorized struct {
+	WwwAuthenticate OptString
+}
+
+// GetWwwAuthenticate returns the value of WwwAuthenticate.
+func (s *GetVideoUnauthorized) GetWwwAuthenticate() OptString {
+	return s.WwwAuthenticate
+}
+
+// SetWwwAuthenticate sets the value of WwwAuthenticate.
+func (s *GetVideoUnauthorized) SetWwwAuthenticate(val OptString) {
+	s.WwwAuthenticate = val
+}
+
+func (*GetVideoUnauthorized) getVideoRes() {}
+
+// GetViewsForbidden is response for GetViews operation.
+type GetViewsForbidden struct{}
+
+func (*GetViewsForbidden) getViewsRes() {}
+
+// GetViewsOK is response for GetViews operation.
+type GetViewsOK struct {
+	XToken OptString
+}
+
+// GetXToken returns the value of XToken.
+func (s *GetViewsOK) GetXToken() OptString {
+	return s.XToken
+}
+
+// SetXToken sets the value of XToken.
+func (s *GetViewsOK) SetXToken(val OptString) {
+	s.XToken = val
+}
+
+func (*GetViewsOK) getViewsRes() {}
+
+// GetViewsUnauthorized is response for GetViews operation.
+type GetViewsUnauthorized struct{}
+
+func (*GetViewsUnauthorized) getViewsRes() {}
+
+// HeadMapRecordsFieldsForbidden is response for HeadMapRecordsFields operation.
+type HeadMapRecordsFieldsForbidden struct{}
+
+func (*HeadMapRecordsFieldsForbidden) headMapRecordsFieldsRes() {}
+
+// HeadMapRecordsFieldsNotFound is response for HeadMapRecordsFields operation.
+type HeadMapRecordsFieldsNotFound struct{}
+
+func (*HeadMapRecordsFieldsNotFound) headMapRecordsFieldsRes() {}
+
+// HeadMapRecordsFieldsOK is response for HeadMapRecordsFields operation.
+type HeadMapRecordsFieldsOK struct {
+	XToken      OptString
+	XTotalCount OptInt64
+}
+
+// GetXToken returns the value of XToken.
+func (s *HeadMapRecordsFieldsOK) GetXToken() OptString {
+	return s.XToken
+}
+
+// GetXTotalCount returns the value of XTotalCount.
+func (s *HeadMapRecordsFieldsOK) GetXTotalCount() OptInt64 {
+	return s.XTotalCount
+}
+
+// SetXToken sets the value of XToken.
+func (s *HeadMapRecordsFieldsOK) SetXToken(val OptString) {
+	s.XToken = val
+}
+
+// SetXTotalCount sets the value of XTotalCount.
+func (s *HeadMapRecordsFieldsOK) SetXTotalCount(val OptInt64) {
+	s.XTotalCount = val
+}
+
+func (*HeadMapRecordsFieldsOK) headMapRecordsFieldsRes() {}
+
+// HeadMapRecordsFieldsUnauthorized is response for HeadMapRecordsFields operation.
+type HeadMapRecordsFieldsUnauthorized struct{}
+
+func (*HeadMapRecordsFieldsUnauthorized) headMapRecordsFieldsRes() {}
+
+// Ref: #/components/schemas/ImageCacheStats
+type ImageCacheStats struct {
+	Enabled OptBool `json:"Enabled"`
+	// Memory or the directory of the cache.
+	Storage OptString `json:"Storage"`
+	Entries OptInt    `json:"Entries"`
+	// Bytes used by the cached images.
+	Size    OptInt64 `json:"Size"`
+	MaxSize OptInt64 `json:"MaxSize"`
+	Hits    OptInt64 `json:"Hits"`
+	Misses  OptInt64 `json:"Misses"`
+	// Entries removed by the size limit or expired.
+	Evictions OptInt64 `json:"Evictions"`
+	// Entries removed because the source record changed.
+	Invalidations OptInt64 `json:"Invalidations"`
+	// Entries removed by the purge request.
+	Purged OptInt `json:"Purged"`
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *ImageCacheStats) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// GetStorage returns the value of Storage.
+func (s *ImageCacheStats) GetStorage() OptString {
+	return s.Storage
+}
+
+// GetEntries returns the value of Entries.
+func (s *ImageCacheStats) GetEntries() OptInt {
+	return s.Entries
+}
+
+// GetSize returns the value of Size.
+func (s *ImageCacheStats) GetSize() OptInt64 {
+	return s.Size
+}
+
+// GetMaxSize returns the value of MaxSize.
+func (s *ImageCacheStats) GetMaxSize() OptInt64 {
+	return s.MaxSize
+}
+
+// GetHits returns the value of Hits.
+func (s *ImageCacheStats) GetHits() OptInt64 {
+	return s.Hits
+}
+
+// GetMisses returns the value of Misses.
+func (s *ImageCacheStats) GetMisses() OptInt64 {
+	return s.Misses
+}
+
+// GetEvictions returns the value of Evictions.
+func (s *ImageCacheStats) GetEvictions() OptInt64 {
+	return s.Evictions
+}
+
+// GetInvalidations returns the value of Invalidations.
+func (s *ImageCacheStats) GetInvalidations() OptInt64 {
+	return s.Invalidations
+}
+
+// GetPurged returns the value of Purged.
+func (s *ImageCacheStats) GetPurged() OptInt {
+	return s.Purged
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *ImageCacheStats) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// SetStorage sets the value of Storage.
+func (s *ImageCacheStats) SetStorage(val OptString) {
+	s.Storage = val
+}
+
+// SetEntries sets the value of Entries.
+func (s *ImageCacheStats) SetEntries(val OptInt) {
+	s.Entries = val
+}
+
+// SetSize sets the value of Size.
+func (s *ImageCacheStats) SetSize(val OptInt64) {
+	s.Size = val
+}
+
+// SetMaxSize sets the value of MaxSize.
+func (s *ImageCacheStats) SetMaxSize(val OptInt64) {
+	s.MaxSize = val
+}
+
+// SetHits sets the value of Hits.
+func (s *ImageCacheStats) SetHits(val OptInt64) {
+	s.Hits = val
+}
+
+// SetMisses sets the value of Misses.
+func (s *ImageCacheStats) SetMisses(val OptInt64) {
+	s.Misses = val
+}
+
+// SetEvictions sets the value of Evictions.
+func (s *ImageCacheStats) SetEvictions(val OptInt64) {
+	s.Evictions = val
+}
+
+// SetInvalidations sets the value of Invalidations.
+func (s *ImageCacheStats) SetInvalidations(val OptInt64) {
+	s.Invalidations = val
+}
+
+// SetPurged sets the value of Purged.
+func (s *ImageCacheStats) SetPurged(val OptInt) {
+	s.Purged = val
+}
+
+func (*ImageCacheStats) getImageCacheStatsRes() {}
+func (*ImageCacheStats) purgeImageCacheRes()    {}
+
+// Ref: #/components/schemas/ImageDuplicateGroup
+type ImageDuplicateGroup struct {
+	Records []ImageDuplicateRecord `json:"Records"`
+}
+
+// GetRecords returns the value of Records.
+func (s *ImageDuplicateGroup) GetRecords() []ImageDuplicateRecord {
+	return s.Records
+}
+
+// SetRecords sets the value of Records.
+func (s *ImageDuplicateGroup) SetRecords(val []ImageDuplicateRecord) {
+	s.Records = val
+}
+
+// Ref: #/components/schemas/ImageDuplicateRecord
+type ImageDuplicateRecord struct {
+	// Primary key of the record, values of composite keys separated by comma.
+	Key  OptString `json:"Key"`
+	Hash OptString `json:"Hash"`
+	// Hamming distance to the hash of the first record of the group.
+	Distance OptInt `json:"Distance"`
+}
+
+// GetKey returns the value of Key.
+func (s *ImageDuplicateRecord) GetKey() OptString {
+	return s.Key
+}
+
+// GetHash returns the value of Hash.
+func (s *ImageDuplicateRecord) GetHash() OptString {
+	return s.Hash
+}
+
+// GetDistance returns the value of Distance.
+func (s *ImageDuplicateRecord) GetDistance() OptInt {
+	return s.Distance
+}
+
+// SetKey sets the value of Key.
+func (s *ImageDuplicateRecord) SetKey(val OptString) {
+	s.Key = val
+}
+
+// SetHash sets the value of Hash.
+func (s *ImageDuplicateRecord) SetHash(val OptString) {
+	s.Hash = val
+}
+
+// SetDistance sets the value of Distance.
+func (s *ImageDuplicateRecord) SetDistance(val OptInt) {
+	s.Distance = val
+}
+
+// Ref: #/components/schemas/ImageDuplicates
+type ImageDuplicates struct {
+	Table    OptString             `json:"Table"`
+	Distance OptInt                `json:"Distance"`
+	Groups   []ImageDuplicateGroup `json:"Groups"`
+}
+
+// GetTable returns the value of Table.
+func (s *ImageDuplicates) GetTable() OptString {
+	return s.Table
+}
+
+// GetDistance returns the value of Distance.
+func (s *ImageDuplicates) GetDistance() OptInt {
+	return s.Distance
+}
+
+// GetGroups returns the value of Groups.
+func (s *ImageDuplicates) GetGroups() []ImageDuplicateGroup {
+	return s.Groups
+}
+
+// SetTable sets the value of Table.
+func (s *ImageDuplicates) SetTable(val OptString) {
+	s.Table = val
+}
+
+// SetDistance sets the value of Distance.
+func (s *ImageDuplicates) SetDistance(val OptInt) {
+	s.Distance = val
+}
+
+// SetGroups sets the value of Groups.
+func (s *ImageDuplicates) SetGroups(val []ImageDuplicateGroup) {
+	s.Groups = val
+}
+
+func (*ImageDuplicates) getImageDuplicatesRes() {}
+
+// Ref: #/components/schemas/ImageGPS
+type ImageGPS struct {
+	Latitude  OptFloat64 `json:"Latitude"`
+	Longitude OptFloat64 `json:"Longitude"`
+	// Altitude in meters above sea level.
+	Altitude OptFloat64 `json:"Altitude"`
+}
+
+// GetLatitude returns the value of Latitude.
+func (s *ImageGPS) GetLatitude() OptFloat64 {
+	return s.Latitude
+}
+
+// GetLongitude returns the value of Longitude.
+func (s *ImageGPS) GetLongitude() OptFloat64 {
+	return s.Longitude
+}
+
+// GetAltitude returns the value of Altitude.
+func (s *ImageGPS) GetAltitude() OptFloat64 {
+	return s.Altitude
+}
+
+// SetLatitude sets the value of Latitude.
+func (s *ImageGPS) SetLatitude(val OptFloat64) {
+	s.Latitude = val
+}
+
+// SetLongitude sets the value of Longitude.
+func (s *ImageGPS) SetLongitude(val OptFloat64) {
+	s.Longitude = val
+}
+
+// SetAltitude sets the value of Altitude.
+func (s *ImageGPS) SetAltitude(val OptFloat64) {
+	s.Altitude = val
+}
+
+// Ref: #/components/schemas/ImageHash
+type ImageHash struct {
+	Algorithm OptString `json:"Algorithm"`
+	// 64 bit perceptual hash in hex.
+	Hash   OptString `json:"Hash"`
+	Width  OptInt    `json:"Width"`
+	Height OptInt    `json:"Height"`
+}
+
+// GetAlgorithm returns the value of Algorithm.
+func (s *ImageHash) GetAlgorithm() OptString {
+	return s.Algorithm
+}
+
+// GetHash returns the value of Hash.
+func (s *ImageHash) GetHash() OptString {
+	return s.Hash
+}
+
+// GetWidth returns the value of Width.
+func (s *ImageHash) GetWidth() OptInt {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *ImageHash) GetHeight() OptInt {
+	return s.Height
+}
+
+// SetAlgorithm sets the value of Algorithm.
+func (s *ImageHash) SetAlgorithm(val OptString) {
+	s.Algorithm = val
+}
+
+// SetHash sets the value of Hash.
+func (s *ImageHash) SetHash(val OptString) {
+	s.Hash = val
+}
+
+// SetWidth sets the value of Width.
+func (s *ImageHash) SetWidth(val OptInt) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *ImageHash) SetHeight(val OptInt) {
+	s.Height = val
+}
+
+func (*ImageHash) getImageHashRes() {}
+
+// Ref: #/components/schemas/ImageHashReport
+type ImageHashReport struct {
+	Table     OptString `json:"Table"`
+	Algorithm OptString `json:"Algorithm"`
+	// Number of records read.
+	Processed OptInt `json:"Processed"`
+	// Number of records the hash is stored in.
+	Updated OptInt `json:"Updated"`
+	// Number of records already containing a hash.
+	Skipped OptInt `json:"Skipped"`
+	// Number of records the image could not be decoded.
+	Failed OptInt `json:"Failed"`
+}
+
+// GetTable returns the value of Table.
+func (s *ImageHashReport) GetTable() OptString {
+	return s.Table
+}
+
+// GetAlgorithm returns the value of Algorithm.
+func (s *ImageHashReport) GetAlgorithm() OptString {
+	return s.Algorithm
+}
+
+// GetProcessed returns the value of Processed.
+func (s *ImageHashReport) GetProcessed() OptInt {
+	return s.Processed
+}
+
+// GetUpdated returns the value of Updated.
+func (s *ImageHashReport) GetUpdated() OptInt {
+	return s.Updated
+}
+
+// GetSkipped returns the value of Skipped.
+func (s *ImageHashReport) GetSkipped() OptInt {
+	return s.Skipped
+}
+
+// GetFailed returns the value of Failed.
+func (s *ImageHashReport) GetFailed() OptInt {
+	return s.Failed
+}
+
+// SetTable sets the value of Table.
+func (s *ImageHashReport) SetTable(val OptString) {
+	s.Table = val
+}
+
+// SetAlgorithm sets the value of Algorithm.
+func (s *ImageHashReport) SetAlgorithm(val OptString) {
+	s.Algorithm = val
+}
+
+// SetProcessed sets the value of Processed.
+func (s *ImageHashReport) SetProcessed(val OptInt) {
+	s.Processed = val
+}
+
+// SetUpdated sets the value of Updated.
+func (s *ImageHashReport) SetUpdated(val OptInt) {
+	s.Updated = val
+}
+
+// SetSkipped sets the value of Skipped.
+func (s *ImageHashReport) SetSkipped(val OptInt) {
+	s.Skipped = val
+}
+
+// SetFailed sets the value of Failed.
+func (s *ImageHashReport) SetFailed(val OptInt) {
+	s.Failed = val
+}
+
+func (*ImageHashReport) updateImageHashesRes() {}
+
+// Ref: #/components/schemas/ImageMetadata
+type ImageMetadata struct {
+	// Detected image format like jpeg, png or heic.
+	Format   OptString `json:"Format"`
+	Mimetype OptString `json:"Mimetype"`
+	// Number of bytes of the image.
+	Size   OptInt64 `json:"Size"`
+	Width  OptInt   `json:"Width"`
+	Height OptInt   `json:"Height"`
+	// EXIF orientation, 1 if the image is not rotated.
+	Orientation OptInt `json:"Orientation"`
+	// Capture time of the image.
+	DateTime OptDateTime `json:"DateTime"`
+	GPS      OptImageGPS `json:"GPS"`
+	// EXIF and TIFF tags of the image.
+	Tags OptImageMetadataTags `json:"Tags"`
+}
+
+// GetFormat returns the value of Format.
+func (s *ImageMetadata) GetFormat() OptString {
+	return s.Format
+}
+
+// GetMimetype returns the value of Mimetype.
+func (s *ImageMetadata) GetMimetype() OptString {
+	return s.Mimetype
+}
+
+// GetSize returns the value of Size.
+func (s *ImageMetadata) GetSize() OptInt64 {
+	return s.Size
+}
+
+// GetWidth returns the value of Width.
+func (s *ImageMetadata) GetWidth() OptInt {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *ImageMetadata) GetHeight() OptInt {
+	return s.Height
+}
+
+// GetOrientation returns the value of Orientation.
+func (s *ImageMetadata) GetOrientation() OptInt {
+	return s.Orientation
+}
+
+// GetDateTime returns the value of DateTime.
+func (s *ImageMetadata) GetDateTime() OptDateTime {
+	return s.DateTime
+}
+
+// GetGPS returns the value of GPS.
+func (s *ImageMetadata) GetGPS() OptImageGPS {
+	return s.GPS
+}
+
+// GetTags returns the value of Tags.
+func (s *ImageMetadata) GetTags() OptImageMetadataTags {
+	return s.Tags
+}
+
+// SetFormat sets the value of Format.
+func (s *ImageMetadata) SetFormat(val OptString) {
+	s.Format = val
+}
+
+// SetMimetype sets the value of Mimetype.
+func (s *ImageMetadata) SetMimetype(val OptString) {
+	s.Mimetype = val
+}
+
+// SetSize sets the value of Size.
+func (s *ImageMetadata) SetSize(val OptInt64) {
+	s.Size = val
+}
+
+// SetWidth sets the value of Width.
+func (s *ImageMetadata) SetWidth(val OptInt) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *ImageMetadata) SetHeight(val OptInt) {
+	s.Height = val
+}
+
+// SetOrientation sets the value of Orientation.
+func (s *ImageMetadata) SetOrientation(val OptInt) {
+	s.Orientation = val
+}
+
+// SetDateTime sets the value of DateTime.
+func (s *ImageMetadata) SetDateTime(val OptDateTime) {
+	s.DateTime = val
+}
+
+// SetGPS sets the value of GPS.
+func (s *ImageMetadata) SetGPS(val OptImageGPS) {
+	s.GPS = val
+}
+
+// SetTags sets the value of Tags.
+func (s *ImageMetadata) SetTags(val OptImageMetadataTags) {
+	s.Tags = val
+}
+
+func (*ImageMetadata) getImageMetadataRes() {}
+
+// EXIF and TIFF tags of the image.
+type ImageMetadataTags map[string]string
+
+func (s *ImageMetadataTags) init() ImageMetadataTags {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/ImportError
+type ImportError struct {
+	// Row number in the input starting with 1.
+	Row     OptInt    `json:"Row"`
+	Message OptString `json:"Message"`
+}
+
+// GetRow returns the value of Row.
+func (s *ImportError) GetRow() OptInt {
+	return s.Row
+}
+
+// GetMessage returns the value of Message.
+func (s *ImportError) GetMessage() OptString {
+	return s.Message
+}
+
+// SetRow sets the value of Row.
+func (s *ImportError) SetRow(val OptInt) {
+	s.Row = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ImportError) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// ImportRecordsForbidden is response for ImportRecords operation.
+type ImportRecordsForbidden struct{}
+
+func (*ImportRecordsForbidden) importRecordsRes() {}
+
+type ImportRecordsOnError string
+
+const (
+	ImportRecordsOnErrorAbort ImportRecordsOnError = "abort"
+	ImportRecordsOnErrorSkip  ImportRecordsOnError = "skip"
+)
+
+// AllValues returns all ImportRecordsOnError values.
+func (ImportRecordsOnError) AllValues() []ImportRecordsOnError {
+	return []ImportRecordsOnError{
+		ImportRecordsOnErrorAbort,
+		ImportRecordsOnErrorSkip,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ImportRecordsOnError) MarshalText() ([]byte, error) {
+	switch s {
+	case ImportRecordsOnErrorAbort:
+		return []byte(s), nil
+	case ImportRecordsOnErrorSkip:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ImportRecordsOnError) UnmarshalText(data []byte) error {
+	switch ImportRecordsOnError(data) {
+	case ImportRecordsOnErrorAbort:
+		*s = ImportRecordsOnErrorAbort
+		return nil
+	case ImportRecordsOnErrorSkip:
+		*s = ImportRecordsOnErrorSkip
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ImportRecordsReqApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ImportRecordsReqApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ImportRecordsReqApplicationXNdjson) importRecordsReq() {}
+
+type ImportRecordsReqTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ImportRecordsReqTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ImportRecordsReqTextCsv) importRecordsReq() {}
+
+// ImportRecordsUnauthorized is response for ImportRecords operation.
+type ImportRecordsUnauthorized struct{}
+
+func (*ImportRecordsUnauthorized) importRecordsRes() {}
+
+// Ref: #/components/schemas/ImportReport
+type ImportReport struct {
+	// Number of records inserted.
+	Accepted OptInt `json:"Accepted"`
+	// Number of records rejected.
+	Rejected OptInt `json:"Rejected"`
+	// Import aborted on the first error.
+	Aborted OptBool       `json:"Aborted"`
+	Errors  []ImportError `json:"Errors"`
+}
+
+// GetAccepted returns the value of Accepted.
+func (s *ImportReport) GetAccepted() OptInt {
+	return s.Accepted
+}
+
+// GetRejected returns the value of Rejected.
+func (s *ImportReport) GetRejected() OptInt {
+	return s.Rejected
+}
+
+// GetAborted returns the value of Aborted.
+func (s *ImportReport) GetAborted() OptBool {
+	return s.Aborted
+}
+
+// GetErrors returns the value of Errors.
+func (s *ImportReport) GetErrors() []ImportError {
+	return s.Errors
+}
+
+// SetAccepted sets the value of Accepted.
+func (s *ImportReport) SetAccepted(val OptInt) {
+	s.Accepted = val
+}
+
+// SetRejected sets the value of Rejected.
+func (s *ImportReport) SetRejected(val OptInt) {
+	s.Rejected = val
+}
+
+// SetAborted sets the value of Aborted.
+func (s *ImportReport) SetAborted(val OptBool) {
+	s.Aborted = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *ImportReport) SetErrors(val []ImportError) {
+	s.Errors = val
+}
+
+// ImportReportHeaders wraps ImportReport with response headers.
+type ImportReportHeaders struct {
+	XToken   OptString
+	Response ImportReport
+}
+
+// GetXToken returns the value of XToken.
+func (s *ImportReportHeaders) GetXToken() OptString {
+	return s.XToken
+}
+
+// GetResponse returns the value of Response.
+func (s *ImportReportHeaders) GetResponse() ImportReport {
+	return s.Response
+}
+
+// SetXToken sets the value of XToken.
+func (s *ImportReportHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ImportReportHeaders) SetResponse(val ImportReport) {
+	s.Response = val
+}
+
+func (*ImportReportHeaders) importRecordsRes() {}
+
+type InsertMapFileRecordsBadRequest Error
+
+func (*InsertMapFileRecordsBadRequest) insertMapFileRecordsRes() {}
+
+// InsertMapFileRecordsForbidden is response for InsertMapFileRecords operation.
+type InsertMapFileRecordsForbidden struct{}
+
+func (*InsertMapFileRecordsForbidden) insertMapFileRecordsRes() {}
+
+type InsertMapFileRecordsNotFound Error
+
+func (*InsertMapFileRecordsNotFound) insertMapFileRecordsRes() {}
+
+type InsertMapFileRecordsReq struct {
+	// The file to upload.
+	Data OptMultipartFile `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *InsertMapFileRecordsReq) GetData() OptMultipartFile {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *InsertMapFileRecordsReq) SetData(val OptMultipartFile) {
+	s.Data = val
+}
+
+// InsertMapFileRecordsUnauthorized is response for InsertMapFileRecords operation.
+type InsertMapFileRecordsUnauthorized struct{}
+
+func (*InsertMapFileRecordsUnauthorized) insertMapFileRecordsRes() {}
+
+// InsertRecordBadRequest is response for InsertRecord operation.
+type InsertRecordBadRequest struct{}
+
+func (*InsertRecordBadRequest) insertRecordRes() {}
+
+// InsertRecordForbidden is response for InsertRecord operation.
+type InsertRecordForbidden struct{}
+
+func (*InsertRecordForbidden) insertRecordRes() {}
+
+type InsertRecordOnConflict string
+
+const (
+	InsertRecordOnConflictError              InsertRecordOnConflict = "error"
+	InsertRecordOnConflictIgnore             InsertRecordOnConflict = "ignore"
+	InsertRecordOnConflictUpdateAll          InsertRecordOnConflict = "update-all"
+	InsertRecordOnConflictUpdateListedFields InsertRecordOnConflict = "update-listed-fields"
+)
+
+// AllValues returns all InsertRecordOnConflict values.
+func (InsertRecordOnConflict) AllValues() []InsertRecordOnConflict {
+	return []InsertRecordOnConflict{
+		InsertRecordOnConflictError,
+		InsertRecordOnConflictIgnore,
+		InsertRecordOnConflictUpdateAll,
+		InsertRecordOnConflictUpdateListedFields,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s InsertRecordOnConflict) MarshalText() ([]byte, error) {
+	switch s {
+	case InsertRecordOnConflictError:
+		return []byte(s), nil
+	case InsertRecordOnConflictIgnore:
+		return []byte(s), nil
+	case InsertRecordOnConflictUpdateAll:
+		return []byte(s), nil
+	case InsertRecordOnConflictUpdateListedFields:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *InsertRecordOnConflict) UnmarshalText(data []byte) error {
+	switch InsertRecordOnConflict(data) {
+	case InsertRecordOnConflictError:
+		*s = InsertRecordOnConflictError
+		return nil
+	case InsertRecordOnConflictIgnore:
+		*s = InsertRecordOnConflictIgnore
+		return nil
+	case InsertRecordOnConflictUpdateAll:
+		*s = InsertRecordOnConflictUpdateAll
+		return nil
+	case InsertRecordOnConflictUpdateListedFields:
+		*s = InsertRecordOnConflictUpdateListedFields
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type InsertRecordReq struct {
+	Records []InsertRecordReqRecordsItem `json:"Records"`
+}
+
+// GetRecords returns the value of Records.
+func (s *InsertRecordReq) GetRecords() []InsertRecordReqRecordsItem {
+	return s.Records
+}
+
+// SetRecords sets the value of Records.
+func (s *InsertRecordReq) SetRecords(val []InsertRecordReqRecordsItem) {
+	s.Records = val
+}
+
+type InsertRecordReqRecordsItem map[string]jx.Raw
+
+func (s *InsertRecordReqRecordsItem) init() InsertRecordReqRecordsItem {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// InsertRecordUnauthorized is response for InsertRecord operation.
+type InsertRecordUnauthorized struct{}
+
+func (*InsertRecordUnauthorized) insertRecordRes() {}
+
+// Ref: #/components/schemas/Job
+type Job struct {
+	Description  OptString             `json:"Description"`
+	Environments []JobEnvironmentsItem `json:"Environments"`
+	Name         OptString             `json:"Name"`
+	Parameters   []JobParametersItem   `json:"Parameters"`
+	Script       OptString             `json:"Script"`
+	User         OptString             `json:"User"`
+	Utility      OptString             `json:"Utility"`
+	CronSchedule OptString             `json:"CronSchedule"`
+}
+
+// GetDescription returns the value of Description.
+func (s *Job) GetDescription() OptString {
+	return s.Description
+}
+
+// GetEnvironments returns the value of Environments.
+func (s *Job) GetEnvironments() []JobEnvironmentsItem {
+	return s.Environments
+}
+
+// GetName returns the value of Name.
+func (s *Job) GetName() OptString {
+	return s.Name
+}
+
+// GetParameters returns the value of Parameters.
+func (s *Job) GetParameters() []JobParametersItem {
+	return s.Parameters
+}
+
+// GetScript returns the value of Script.
+func (s *Job) GetScript() OptString {
+	return s.Script
+}
+
+// GetUser returns the value of User.
+func (s *Job) GetUser() OptString {
+	return s.User
+}
+
+// GetUtility returns the value of Utility.
+func (s *Job) GetUtility() OptString {
+	return s.Utility
+}
+
+// GetCronSchedule returns the value of CronSchedule.
+func (s *Job) GetCronSchedule() OptString {
+	return s.CronSchedule
+}
+
+// SetDescription sets the value of Description.
+func (s *Job) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetEnvironments sets the value of Environments.
+func (s *Job) SetEnvironments(val []JobEnvironmentsItem) {
+	s.Environments = val
+}
+
+// SetName sets the value of Name.
+func (s *Job) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetParameters sets the value of Parameters.
+func (s *Job) SetParameters(val []JobParametersItem) {
+	s.Parameters = val
+}
+
+// SetScript sets the value of Script.
+func (s *Job) SetScript(val OptString) {
+	s.Script = val
+}
+
+// SetUser sets the value of User.
+func (s *Job) SetUser(val OptString) {
+	s.User = val
+}
+
+// SetUtility sets the value of Utility.
+func (s *Job) SetUtility(val OptString) {
+	s.Utility = val
+}
+
+// SetCronSchedule sets the value of CronSchedule.
+func (s *Job) SetCronSchedule(val OptString) {
+	s.CronSchedule = val
+}
+
+// Ref: #/components/schemas/JobDefinition
+type JobDefinition struct {
+	Executions []Executions `json:"Executions"`
+	Job        OptJob       `json:"Job"`
+	Status     OptString    `json:"Status"`
+}
+
+// GetExecutions returns the value of Executions.
+func (s *JobDefinition) GetExecutions() []Executions {
+	return s.Executions
+}
+
+// GetJob returns the value of Job.
+func (s *JobDefinition) GetJob() OptJob {
+	return s.Job
+}
+
+// GetStatus returns the value of Status.
+func (s *JobDefinition) GetStatus() OptString {
+	return s.Status
+}
+
+// SetExecutions sets the value of Executions.
+func (s *JobDefinition) SetExecutions(val []Executions) {
+	s.Executions = val
+}
+
+// SetJob sets the value of Job.
+func (s *JobDefinition) SetJob(val OptJob) {
+	s.Job = val
+}
+
+// SetStatus sets the value of Status.
+func (s *JobDefinition) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// Ref: #/components/schemas/JobDescription
+type JobDescription struct {
+	CronSchedule OptString                        `json:"CronSchedule"`
+	Description  OptString                        `json:"Description"`
+	Environments []JobDescriptionEnvironmentsItem `json:"Environments"`
+	Name         OptString                        `json:"Name"`
+	Parameters   []JobDescriptionParametersItem   `json:"Parameters"`
+	Script       OptString                        `json:"Script"`
+	User         OptString                        `json:"User"`
+	Utility      OptString                        `json:"Utility"`
+}
+
+// GetCronSchedule returns the value of CronSchedule.
+func (s *JobDescription) GetCronSchedule() OptString {
+	return s.CronSchedule
+}
+
+// GetDescription returns the value of Description.
+func (s *JobDescription) GetDescription() OptString {
+	return s.Description
+}
+
+// GetEnvironments returns the value of Environments.
+func (s *JobDescription) GetEnvironments() []JobDescriptionEnvironmentsItem {
+	return s.Environments
+}
+
+// GetName returns the value of Name.
+func (s *JobDescription) GetName() OptString {
+	return s.Name
+}
+
+// GetParameters returns the value of Parameters.
+func (s *JobDescription) GetParameters() []JobDescriptionParametersItem {
+	return s.Parameters
+}
+
+// GetScript returns the value of Script.
+func (s *JobDescription) GetScript() OptString {
+	return s.Script
+}
+
+// GetUser returns the value of User.
+func (s *JobDescription) GetUser() OptString {
+	return s.User
+}
+
+// GetUtility returns the value of Utility.
+func (s *JobDescription) GetUtility() OptString {
+	return s.Utility
+}
+
+// SetCronSchedule sets the value of CronSchedule.
+func (s *JobDescription) SetCronSchedule(val OptString) {
+	s.CronSchedule = val
+}
+
+// SetDescription sets the value of Description.
+func (s *JobDescription) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetEnvironments sets the value of Environments.
+func (s *JobDescription) SetEnvironments(val []JobDescriptionEnvironmentsItem) {
+	s.Environments = val
+}
+
+// SetName sets the value of Name.
+func (s *JobDescription) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetParameters sets the value of Parameters.
+func (s *JobDescription) SetParameters(val []JobDescriptionParametersItem) {
+	s.Parameters = val
+}
+
+// SetScript sets the value of Script.
+func (s *JobDescription) SetScript(val OptString) {
+	s.Script = val
+}
+
+// SetUser sets the value of User.
+func (s *JobDescription) SetUser(val OptString) {
+	s.User = val
+}
+
+// SetUtility sets the value of Utility.
+func (s *JobDescription) SetUtility(val OptString) {
+	s.Utility = val
+}
+
+type JobDescriptionEnvironmentsItem struct {
+	Parameter OptString `json:"Parameter"`
+}
+
+// GetParameter returns the value of Parameter.
+func (s *JobDescriptionEnvironmentsItem) GetParameter() OptString {
+	return s.Parameter
+}
+
+// SetParameter sets the value of Parameter.
+func (s *JobDescriptionEnvironmentsItem) SetParameter(val OptString) {
+	s.Parameter = val
+}
+
+type JobDescriptionParametersItem struct {
+	Parameter OptString `json:"Parameter"`
+}
+
+// GetParameter returns the value of Parameter.
+func (s *JobDescriptionParametersItem) GetParameter() OptString {
+	return s.Parameter
+}
+
+// SetParameter sets the value of Parameter.
+func (s *JobDescriptionParametersItem) SetParameter(val OptString) {
+	s.Parameter = val
+}
+
+type JobEnvironmentsItem struct {
+	Parameter OptString `json:"Parameter"`
+}
+
+// GetParameter returns the value of Parameter.
+func (s *JobEnvironmentsItem) GetParameter() OptString {
+	return s.Parameter
+}
+
+// SetParameter sets the value of Parameter.
+func (s *JobEnvironmentsItem) SetParameter(val OptString) {
+	s.Parameter = val
+}
+
+// Ref: #/components/schemas/JobFull
+type JobFull struct {
+	Job OptJobDefinition `json:"Job"`
+}
+
+// GetJob returns the value of Job.
+func (s *JobFull) GetJob() OptJobDefinition {
+	return s.Job
+}
+
+// SetJob sets the value of Job.
+func (s *JobFull) SetJob(val OptJobDefinition) {
+	s.Job = val
+}
+
+func (*JobFull) getJobFullInfoRes() {}
+
+// Ref: #/components/schemas/JobParameter
+type JobParameter struct {
+	Job OptJobDescription `json:"Job"`
+}
+
+// GetJob returns the value of Job.
+func (s *JobParameter) GetJob() OptJobDescription {
+	return s.Job
+}
+
+// SetJob sets the value of Job.
+func (s *JobParameter) SetJob(val OptJobDescription) {
+	s.Job = val
+}
+
+func (*JobParameter) postJobReq() {}
+
+type JobParametersItem struct {
+	Parameter OptString `json:"Parameter"`
+}
+
+// GetParameter returns the value of Parameter.
+func (s *JobParametersItem) GetParameter() OptString {
+	return s.Parameter
+}
+
+// SetParameter sets the value of Parameter.
+func (s *JobParametersItem) SetParameter(val OptString) {
+	s.Parameter = val
+}
+
+// Ref: #/components/schemas/JobResult
+type JobResult struct {
+	JobResult OptJobResultJobResult `json:"JobResult"`
+}
+
+// GetJobResult returns the value of JobResult.
+func (s *JobResult) GetJobResult() OptJobResultJobResult {
+	return s.JobResult
+}
+
+// SetJobResult sets the value of JobResult.
+func (s *JobResult) SetJobResult(val OptJobResultJobResult) {
+	s.JobResult = val
+}
+
+func (*JobResult) getJobExecutionResultRes() {}
+func (*JobResult) getJobResultRes()          {}
+
+type JobResultJobResult struct {
+	Description OptString   `json:"Description"`
+	Ended       OptDateTime `json:"Ended"`
+	ExitCode    OptFloat64  `json:"ExitCode"`
+	ID          OptFloat64  `json:"Id"`
+	Log         OptString   `json:"Log"`
+	Name        OptString   `json:"Name"`
+	Scheduled   OptDateTime `json:"Scheduled"`
+	StartedBy   OptString   `json:"StartedBy"`
+	Status      OptString   `json:"Status"`
+}
+
+// GetDescription returns the value of Description.
+func (s *JobResultJobResult) GetDescription() OptString {
+	return s.Description
+}
+
+// GetEnded returns the value of Ended.
+func (s *JobResultJobResult) GetEnded() OptDateTime {
+	return s.Ended
+}
+
+// GetExitCode returns the value of ExitCode.
+func (s *JobResultJobResult) GetExitCode() OptFloat64 {
+	return s.ExitCode
+}
+
+// GetID returns the value of ID.
+func (s *JobResultJobResult) GetID() OptFloat64 {
+	return s.ID
+}
+
+// GetLog returns the value of Log.
+func (s *JobResultJobResult) GetLog() OptString {
+	return s.Log
+}
+
+// GetName returns the value of Name.
+func (s *JobResultJobResult) GetName() OptString {
+	return s.Name
+}
+
+// GetScheduled returns the value of Scheduled.
+func (s *JobResultJobResult) GetScheduled() OptDateTime {
+	return s.Scheduled
+}
+
+// GetStartedBy returns the value of StartedBy.
+func (s *JobResultJobResult) GetStartedBy() OptString {
+	return s.StartedBy
+}
+
+// GetStatus returns the value of Status.
+func (s *JobResultJobResult) GetStatus() OptString {
+	return s.Status
+}
+
+// SetDescription sets the value of Description.
+func (s *JobResultJobResult) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetEnded sets the value of Ended.
+func (s *JobResultJobResult) SetEnded(val OptDateTime) {
+	s.Ended = val
+}
+
+// SetExitCode sets the value of ExitCode.
+func (s *JobResultJobResult) SetExitCode(val OptFloat64) {
+	s.ExitCode = val
+}
+
+// SetID sets the value of ID.
+func (s *JobResultJobResult) SetID(val OptFloat64) {
+	s.ID = val
+}
+
+// SetLog sets the value of Log.
+func (s *JobResultJobResult) SetLog(val OptString) {
+	s.Log = val
+}
+
+// SetName sets the value of Name.
+func (s *JobResultJobResult) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetScheduled sets the value of Scheduled.
+func (s *JobResultJobResult) SetScheduled(val OptDateTime) {
+	s.Scheduled = val
+}
+
+// SetStartedBy sets the value of StartedBy.
+func (s *JobResultJobResult) SetStartedBy(val OptString) {
+	s.StartedBy = val
+}
+
+// SetStatus sets the value of Status.
+func (s *JobResultJobResult) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// Ref: #/components/schemas/JobStatusResponse
+type JobStatusResponse struct {
+	Status OptJobStatusResponseStatus `json:"Status"`
+}
+
+// GetStatus returns the value of Status.
+func (s *JobStatusResponse) GetStatus() OptJobStatusResponseStatus {
+	return s.Status
+}
+
+// SetStatus sets the value of Status.
+func (s *JobStatusResponse) SetStatus(val OptJobStatusResponseStatus) {
+	s.Status = val
+}
+
+func (*JobStatusResponse) deleteJobResultRes() {}
+
+type JobStatusResponseStatus struct {
+	Action      OptString `json:"Action"`
+	ExecutionId OptInt    `json:"ExecutionId"`
+	Message     OptString `json:"Message"`
+	Name        OptString `json:"Name"`
+}
+
+// GetAction returns the value of Action.
+func (s *JobStatusResponseStatus) GetAction() OptString {
+	return s.Action
+}
+
+// GetExecutionId returns the value of ExecutionId.
+func (s *JobStatusResponseStatus) GetExecutionId() OptInt {
+	return s.ExecutionId
+}
+
+// GetMessage returns the value of Message.
+func (s *JobStatusResponseStatus) GetMessage() OptString {
+	return s.Message
+}
+
+// GetName returns the value of Name.
+func (s *JobStatusResponseStatus) GetName() OptString {
+	return s.Name
+}
+
+// SetAction sets the value of Action.
+func (s *JobStatusResponseStatus) SetAction(val OptString) {
+	s.Action = val
+}
+
+// SetExecutionId sets the value of ExecutionId.
+func (s *JobStatusResponseStatus) SetExecutionId(val OptInt) {
+	s.ExecutionId = val
+}
+
+// SetMessage sets the value of Message.
+func (s *JobStatusResponseStatus) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetName sets the value of Name.
+func (s *JobStatusResponseStatus) SetName(val OptString) {
+	s.Name = val
+}
+
+// Ref: #/components/schemas/JobStore
+type JobStore struct {
+	Config   OptString         `json:"config"`
+	Database OptDatabaseConfig `json:"Database"`
+}
+
+// GetConfig returns the value of Config.
+func (s *JobStore) GetConfig() OptString {
+	return s.Config
+}
+
+// GetDatabase returns the value of Database.
+func (s *JobStore) GetDatabase() OptDatabaseConfig {
+	return s.Database
+}
+
+// SetConfig sets the value of Config.
+func (s *JobStore) SetConfig(val OptString) {
+	s.Config = val
+}
+
+// SetDatabase sets the value of Database.
+func (s *JobStore) SetDatabase(val OptDatabaseConfig) {
+	s.Database = val
+}
+
+func (*JobStore) getJobsConfigRes() {}
+
+// Ref: #/components/schemas/JobsList
+type JobsList struct {
+	JobDefinition []JobDefinition `json:"JobDefinition"`
+}
+
+// GetJobDefinition returns the value of JobDefinition.
+func (s *JobsList) GetJobDefinition() []JobDefinition {
+	return s.JobDefinition
+}
+
+// SetJobDefinition sets the value of JobDefinition.
+func (s *JobsList) SetJobDefinition(val []JobDefinition) {
+	s.JobDefinition = val
+}
+
+func (*JobsList) getJobsRes() {}
+
+type ListModellingBadRequest Error
+
+func (*ListModellingBadRequest) listModellingRes() {}
+
+// ListModellingForbidden is response for ListModelling operation.
+type ListModellingForbidden struct{}
+
+func (*ListModellingForbidden) listModellingRes() {}
+
+type ListModellingNotFound Error
+
+func (*ListModellingNotFound) listModellingRes() {}
+
+type ListModellingOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ListModellingOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ListModellingOKTextCsv) listModellingRes() {}
+
+// ListModellingUnauthorized is response for ListModelling operation.
+type ListModellingUnauthorized struct{}
+
+func (*ListModellingUnauthorized) listModellingRes() {}
+
+type ListTablesBadRequest Error
+
+func (*ListTablesBadRequest) listTablesRes() {}
+
+// ListTablesForbidden is response for ListTables operation.
+type ListTablesForbidden struct{}
+
+func (*ListTablesForbidden) listTablesRes() {}
+
+type ListTablesNotFound Error
+
+func (*ListTablesNotFound) listTablesRes() {}
+
+type ListTablesOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ListTablesOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ListTablesOKTextCsv) listTablesRes() {}
+
+// ListTablesUnauthorized is response for ListTables operation.
+type ListTablesUnauthorized struct{}
+
+func (*ListTablesUnauthorized) listTablesRes() {}
+
+// Ref: #/components/schemas/LobUpload
+type LobUpload struct {
+	Table    OptString `json:"Table"`
+	Field    OptString `json:"Field"`
+	Mimetype OptString `json:"Mimetype"`
+	// Number of bytes stored.
+	Size OptInt64 `json:"Size"`
+	// SHA-256 checksum of the stored large object in hex.
+	Checksum OptString `json:"Checksum"`
+}
+
+// GetTable returns the value of Table.
+func (s *LobUpload) GetTable() OptString {
+	return s.Table
+}
+
+// GetField returns the value of Field.
+func (s *LobUpload) GetField() OptString {
+	return s.Field
+}
+
+// GetMimetype returns the value of Mimetype.
+func (s *LobUpload) GetMimetype() OptString {
+	return s.Mimetype
+}
+
+// GetSize returns the value of Size.
+func (s *LobUpload) GetSize() OptInt64 {
+	return s.Size
+}
+
+// GetChecksum returns the value of Checksum.
+func (s *LobUpload) GetChecksum() OptString {
+	return s.Checksum
+}
+
+// SetTable sets the value of Table.
+func (s *LobUpload) SetTable(val OptString) {
+	s.Table = val
+}
+
+// SetField sets the value of Field.
+func (s *LobUpload) SetField(val OptString) {
+	s.Field = val
+}
+
+// SetMimetype sets the value of Mimetype.
+func (s *LobUpload) SetMimetype(val OptString) {
+	s.Mimetype = val
+}
+
+// SetSize sets the value of Size.
+func (s *LobUpload) SetSize(val OptInt64) {
+	s.Size = val
+}
+
+// SetChecksum sets the value of Checksum.
+func (s *LobUpload) SetChecksum(val OptString) {
+	s.Checksum = val
+}
+
+func (*LobUpload) updateLobByMapRes() {}
+
+// LoginSessionForbidden is response for LoginSession operation.
+type LoginSessionForbidden struct{}
+
+func (*LoginSessionForbidden) loginSessionRes() {}
+
+// LoginSessionUnauthorized is response for LoginSession operation.
+type LoginSessionUnauthorized struct{}
+
+func (*LoginSessionUnauthorized) loginSessionRes() {}
+
+type LogoutSessionCompatBadRequest Error
+
+func (*LogoutSessionCompatBadRequest) logoutSessionCompatRes() {}
+
+type LogoutSessionCompatNotFound Error
+
+func (*LogoutSessionCompatNotFound) logoutSessionCompatRes() {}
+
+// LogoutSessionCompatOK is response for LogoutSessionCompat operation.
+type LogoutSessionCompatOK struct{}
+
+func (*LogoutSessionCompatOK) logoutSessionCompatRes() {}
+
+type Map string
+
+// Maps definition.
+// Ref: #/components/schemas/Maps
+type Maps struct {
+	Maps []Map `json:"Maps"`
+}
+
+// GetMaps returns the value of Maps.
+func (s *Maps) GetMaps() []Map {
+	return s.Maps
+}
+
+// SetMaps sets the value of Maps.
+func (s *Maps) SetMaps(val []Map) {
+	s.Maps = val
+}
+
+func (*Maps) getMapsRes() {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptClusterConfig returns new OptClusterConfig with value set to v.
+func NewOptClusterConfig(v ClusterConfig) OptClusterConfig {
+	return OptClusterConfig{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptClusterConfig is optional ClusterConfig.
+type OptClusterConfig struct {
+	Value ClusterConfig
+	Set   bool
+}
+
+// IsSet returns true if OptClusterConfig was set.
+func (o OptClusterConfig) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptClusterConfig) Reset() {
+	var v ClusterConfig
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptClusterConfig) SetTo(v ClusterConfig) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptClusterConfig) Get() (v ClusterConfig, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptClusterConfig) Or(d ClusterConfig) ClusterConfig {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptConfigDatabaseAccess returns new OptConfigDatabaseAccess with value set to v.
+func NewOptConfigDatabaseAccess(v ConfigDatabaseAccess) OptConfigDatabaseAccess {
+	return OptConfigDatabaseAccess{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptConfigDatabaseAccess is optional ConfigDatabaseAccess.
+type OptConfigDatabaseAccess struct {
+	Value ConfigDatabaseAccess
+	Set   bool
+}
+
+// IsSet returns true if OptConfigDatabaseAccess was set.
+func (o OptConfigDatabaseAccess) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptConfigDatabaseAccess) Reset() {
+	var v ConfigDatabaseAccess
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptConfigDatabaseAccess) SetTo(v ConfigDatabaseAccess) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptConfigDatabaseAccess) Get() (v ConfigDatabaseAccess, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptConfigDatabaseAccess) Or(d ConfigDatabaseAccess) ConfigDatabaseAccess {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptConfigMapping returns new OptConfigMapping with value set to v.
+func NewOptConfigMapping(v ConfigMapping) OptConfigMapping {
+	return OptConfigMapping{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptConfigMapping is optional ConfigMapping.
+type OptConfigMapping struct {
+	Value ConfigMapping
+	Set   bool
+}
+
+// IsSet returns true if OptConfigMapping was set.
+func (o OptConfigMapping) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptConfigMapping) Reset() {
+	var v ConfigMapping
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptConfigMapping) SetTo(v ConfigMapping) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptConfigMapping) Get() (v ConfigMapping, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptConfigMapping) Or(d ConfigMapping) ConfigMapping {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptConfigMetrics returns new OptConfigMetrics with value set to v.
+func NewOptConfigMetrics(v ConfigMetrics) OptConfigMetrics {
+	return OptConfigMetrics{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptConfigMetrics is optional ConfigMetrics.
+type OptConfigMetrics struct {
+	Value ConfigMetrics
+	Set   bool
+}
+
+// IsSet returns true if OptConfigMetrics was set.
+func (o OptConfigMetrics) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptConfigMetrics) Reset() {
+	var v ConfigMetrics
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptConfigMetrics) SetTo(v ConfigMetrics) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptConfigMetrics) Get() (v ConfigMetrics, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptConfigMetrics) Or(d ConfigMetrics) ConfigMetrics {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptConfigModule returns new OptConfigModule with value set to v.
+func NewOptConfigModule(v ConfigModule) OptConfigModule {
+	return OptConfigModule{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptConfigModule is optional ConfigModule.
+type OptConfigModule struct {
+	Value ConfigModule
+	Set   bool
+}
+
+// IsSet returns true if OptConfigModule was set.
+func (o OptConfigModule) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptConfigModule) Reset() {
+	var v ConfigModule
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptConfigModule) SetTo(v ConfigModule) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptConfigModule) Get() (v ConfigModule, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptConfigModule) Or(d ConfigModule) ConfigModule {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptConfigServer returns new OptConfigServer with value set to v.
+func NewOptConfigServer(v ConfigServer) OptConfigServer {
+	return OptConfigServer{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptConfigServer is optional ConfigServer.
+type OptConfigServer struct {
+	Value ConfigServer
+	Set   bool
+}
+
+// IsSet returns true if OptConfigServer was set.
+func (o OptConfigServer) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptConfigServer) Reset() {
+	var v ConfigServer
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptConfigServer) SetTo(v ConfigServer) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptConfigServer) Get() (v ConfigServer, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptConfigServer) Or(d ConfigServer) ConfigServer {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptConfigServerLogLocation returns new OptConfigServerLogLocation with value set to v.
+func NewOptConfigServerLogLocation(v ConfigServerLogLocation) OptConfigServerLogLocation {
+	return OptConfigServerLogLocation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptConfigServerLogLocation is optional ConfigServerLogLocation.
+type OptConfigServerLogLocation struct {
+	Value ConfigServerLogLocation
+	Set   bool
+}
+
+// IsSet returns true if OptConfigServerLogLocation was set.
+func (o OptConfigServerLogLocation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptConfigServerLogLocation) Reset() {
+	var v ConfigServerLogLocation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptConfigServerLogLocation) SetTo(v ConfigServerLogLocation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptConfigServerLogLocation) Get() (v ConfigServerLogLocation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptConfigServerLogLocation) Or(d ConfigServerLogLocation) ConfigServerLogLocation {
+	if v, ok := o.Get(); ok {
+		return v
 	}
-	return s.Data.Read(p)
+	return d
 }
 
-func (*ListTablesOKTextCsv) listTablesRes() {}
-
-// ListTablesUnauthorized is response for ListTables operation.
-type ListTablesUnauthorized struct{}
-
-func (*ListTablesUnauthorized) listTablesRes() {}
-
-// LoginSessionForbidden is response for LoginSession operation.
-type LoginSessionForbidden struct{}
-
-func (*LoginSessionForbidden) loginSessionRes() {}
+// NewOptDatabaseConfig returns new OptDatabaseConfig with value set to v.
+func NewOptDatabaseConfig(v DatabaseConfig) OptDatabaseConfig {
+	return OptDatabaseConfig{
+		Value: v,
+		Set:   true,
+	}
+}
 
-// LoginSessionUnauthorized is response for LoginSession operation.
-type LoginSessionUnauthorized struct{}
+// OptDatabaseConfig is optional DatabaseConfig.
+type OptDatabaseConfig struct {
+	Value DatabaseConfig
+	Set   bool
+}
 
-func (*LoginSessionUnauthorized) loginSessionRes() {}
+// IsSet returns true if OptDatabaseConfig was set.
+func (o OptDatabaseConfig) IsSet() bool { return o.Set }
 
-type LogoutSessionCompatBadRequest Error
+// Reset unsets value.
+func (o *OptDatabaseConfig) Reset() {
+	var v DatabaseConfig
+	o.Value = v
+	o.Set = false
+}
 
-func (*LogoutSessionCompatBadRequest) logoutSessionCompatRes() {}
+// SetTo sets value to v.
+func (o *OptDatabaseConfig) SetTo(v DatabaseConfig) {
+	o.Set = true
+	o.Value = v
+}
 
-type LogoutSessionCompatNotFound Error
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDatabaseConfig) Get() (v DatabaseConfig, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
 
-func (*LogoutSessionCompatNotFound) logoutSessionCompatRes() {}
+// Or returns value if set, or given parameter if does not.
+func (o OptDatabaseConfig) Or(d DatabaseConfig) DatabaseConfig {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
-// LogoutSessionCompatOK is response for LogoutSessionCompat operation.
-type LogoutSessionCompatOK struct{}
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
 
-func (*LogoutSessionCompatOK) logoutSessionCompatRes() {}
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
 
-type Map string
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
 
-// Ref: #/components/schemas/MapField
-type MapField struct {
-	Name        OptMapFieldName        `json:"name"`
-	ShortName   OptMapFieldShortName   `json:"shortName"`
-	ContentType OptMapFieldContentType `json:"contentType"`
-	Charset     OptMapFieldCharset     `json:"charset"`
-	File        OptMapFieldFile        `json:"file"`
-	FormatType  OptMapFieldFormatType  `json:"formatType"`
-	Length      OptMapFieldLength      `json:"length"`
-	FieldType   OptMapFieldFieldType   `json:"fieldType"`
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
 }
 
-// GetName returns the value of Name.
-func (s *MapField) GetName() OptMapFieldName {
-	return s.Name
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetShortName returns the value of ShortName.
-func (s *MapField) GetShortName() OptMapFieldShortName {
-	return s.ShortName
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetContentType returns the value of ContentType.
-func (s *MapField) GetContentType() OptMapFieldContentType {
-	return s.ContentType
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetCharset returns the value of Charset.
-func (s *MapField) GetCharset() OptMapFieldCharset {
-	return s.Charset
+// NewOptError returns new OptError with value set to v.
+func NewOptError(v Error) OptError {
+	return OptError{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetFile returns the value of File.
-func (s *MapField) GetFile() OptMapFieldFile {
-	return s.File
+// OptError is optional Error.
+type OptError struct {
+	Value Error
+	Set   bool
 }
 
-// GetFormatType returns the value of FormatType.
-func (s *MapField) GetFormatType() OptMapFieldFormatType {
-	return s.FormatType
-}
+// IsSet returns true if OptError was set.
+func (o OptError) IsSet() bool { return o.Set }
 
-// GetLength returns the value of Length.
-func (s *MapField) GetLength() OptMapFieldLength {
-	return s.Length
+// Reset unsets value.
+func (o *OptError) Reset() {
+	var v Error
+	o.Value = v
+	o.Set = false
 }
 
-// GetFieldType returns the value of FieldType.
-func (s *MapField) GetFieldType() OptMapFieldFieldType {
-	return s.FieldType
+// SetTo sets value to v.
+func (o *OptError) SetTo(v Error) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetName sets the value of Name.
-func (s *MapField) SetName(val OptMapFieldName) {
-	s.Name = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptError) Get() (v Error, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetShortName sets the value of ShortName.
-func (s *MapField) SetShortName(val OptMapFieldShortName) {
-	s.ShortName = val
+// Or returns value if set, or given parameter if does not.
+func (o OptError) Or(d Error) Error {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetContentType sets the value of ContentType.
-func (s *MapField) SetContentType(val OptMapFieldContentType) {
-	s.ContentType = val
+// NewOptErrorError returns new OptErrorError with value set to v.
+func NewOptErrorError(v ErrorError) OptErrorError {
+	return OptErrorError{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetCharset sets the value of Charset.
-func (s *MapField) SetCharset(val OptMapFieldCharset) {
-	s.Charset = val
+// OptErrorError is optional ErrorError.
+type OptErrorError struct {
+	Value ErrorError
+	Set   bool
 }
 
-// SetFile sets the value of File.
-func (s *MapField) SetFile(val OptMapFieldFile) {
-	s.File = val
-}
+// IsSet returns true if OptErrorError was set.
+func (o OptErrorError) IsSet() bool { return o.Set }
 
-// SetFormatType sets the value of FormatType.
-func (s *MapField) SetFormatType(val OptMapFieldFormatType) {
-	s.FormatType = val
+// Reset unsets value.
+func (o *OptErrorError) Reset() {
+	var v ErrorError
+	o.Value = v
+	o.Set = false
 }
 
-// SetLength sets the value of Length.
-func (s *MapField) SetLength(val OptMapFieldLength) {
-	s.Length = val
+// SetTo sets value to v.
+func (o *OptErrorError) SetTo(v ErrorError) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetFieldType sets the value of FieldType.
-func (s *MapField) SetFieldType(val OptMapFieldFieldType) {
-	s.FieldType = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptErrorError) Get() (v ErrorError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-type MapFieldCharset struct {
-	Format OptString `json:"format"`
+// Or returns value if set, or given parameter if does not.
+func (o OptErrorError) Or(d ErrorError) ErrorError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetFormat returns the value of Format.
-func (s *MapFieldCharset) GetFormat() OptString {
-	return s.Format
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetFormat sets the value of Format.
-func (s *MapFieldCharset) SetFormat(val OptString) {
-	s.Format = val
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
 }
 
-type MapFieldContentType struct {
-	Format OptString `json:"format"`
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
 }
 
-// GetFormat returns the value of Format.
-func (s *MapFieldContentType) GetFormat() OptString {
-	return s.Format
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetFormat sets the value of Format.
-func (s *MapFieldContentType) SetFormat(val OptString) {
-	s.Format = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-type MapFieldFieldType struct {
-	Format OptString `json:"format"`
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetFormat returns the value of Format.
-func (s *MapFieldFieldType) GetFormat() OptString {
-	return s.Format
+// NewOptGetImageFit returns new OptGetImageFit with value set to v.
+func NewOptGetImageFit(v GetImageFit) OptGetImageFit {
+	return OptGetImageFit{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetFormat sets the value of Format.
-func (s *MapFieldFieldType) SetFormat(val OptString) {
-	s.Format = val
+// OptGetImageFit is optional GetImageFit.
+type OptGetImageFit struct {
+	Value GetImageFit
+	Set   bool
 }
 
-type MapFieldFile struct {
-	Format OptInt `json:"format"`
+// IsSet returns true if OptGetImageFit was set.
+func (o OptGetImageFit) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetImageFit) Reset() {
+	var v GetImageFit
+	o.Value = v
+	o.Set = false
 }
 
-// GetFormat returns the value of Format.
-func (s *MapFieldFile) GetFormat() OptInt {
-	return s.Format
+// SetTo sets value to v.
+func (o *OptGetImageFit) SetTo(v GetImageFit) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetFormat sets the value of Format.
-func (s *MapFieldFile) SetFormat(val OptInt) {
-	s.Format = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetImageFit) Get() (v GetImageFit, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-type MapFieldFormatType struct {
-	Format OptString `json:"format"`
+// Or returns value if set, or given parameter if does not.
+func (o OptGetImageFit) Or(d GetImageFit) GetImageFit {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetFormat returns the value of Format.
-func (s *MapFieldFormatType) GetFormat() OptString {
-	return s.Format
+// NewOptGetImageFormat returns new OptGetImageFormat with value set to v.
+func NewOptGetImageFormat(v GetImageFormat) OptGetImageFormat {
+	return OptGetImageFormat{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetFormat sets the value of Format.
-func (s *MapFieldFormatType) SetFormat(val OptString) {
-	s.Format = val
+// OptGetImageFormat is optional GetImageFormat.
+type OptGetImageFormat struct {
+	Value GetImageFormat
+	Set   bool
 }
 
-type MapFieldLength struct {
-	Format OptInt `json:"format"`
-}
+// IsSet returns true if OptGetImageFormat was set.
+func (o OptGetImageFormat) IsSet() bool { return o.Set }
 
-// GetFormat returns the value of Format.
-func (s *MapFieldLength) GetFormat() OptInt {
-	return s.Format
+// Reset unsets value.
+func (o *OptGetImageFormat) Reset() {
+	var v GetImageFormat
+	o.Value = v
+	o.Set = false
 }
 
-// SetFormat sets the value of Format.
-func (s *MapFieldLength) SetFormat(val OptInt) {
-	s.Format = val
+// SetTo sets value to v.
+func (o *OptGetImageFormat) SetTo(v GetImageFormat) {
+	o.Set = true
+	o.Value = v
 }
 
-type MapFieldName struct {
-	Format OptString `json:"format"`
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetImageFormat) Get() (v GetImageFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetFormat returns the value of Format.
-func (s *MapFieldName) GetFormat() OptString {
-	return s.Format
+// Or returns value if set, or given parameter if does not.
+func (o OptGetImageFormat) Or(d GetImageFormat) GetImageFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetFormat sets the value of Format.
-func (s *MapFieldName) SetFormat(val OptString) {
-	s.Format = val
+// NewOptGetImageHashAlgorithm returns new OptGetImageHashAlgorithm with value set to v.
+func NewOptGetImageHashAlgorithm(v GetImageHashAlgorithm) OptGetImageHashAlgorithm {
+	return OptGetImageHashAlgorithm{
+		Value: v,
+		Set:   true,
+	}
 }
 
-type MapFieldShortName struct {
-	Format OptString `json:"format"`
+// OptGetImageHashAlgorithm is optional GetImageHashAlgorithm.
+type OptGetImageHashAlgorithm struct {
+	Value GetImageHashAlgorithm
+	Set   bool
 }
 
-// GetFormat returns the value of Format.
-func (s *MapFieldShortName) GetFormat() OptString {
-	return s.Format
-}
+// IsSet returns true if OptGetImageHashAlgorithm was set.
+func (o OptGetImageHashAlgorithm) IsSet() bool { return o.Set }
 
-// SetFormat sets the value of Format.
-func (s *MapFieldShortName) SetFormat(val OptString) {
-	s.Format = val
+// Reset unsets value.
+func (o *OptGetImageHashAlgorithm) Reset() {
+	var v GetImageHashAlgorithm
+	o.Value = v
+	o.Set = false
 }
 
-// Ref: #/components/schemas/mapping
-type Mapping struct {
-	Map    OptMappingMap  `json:"Map"`
-	Format *MappingFormat `json:"format"`
+// SetTo sets value to v.
+func (o *OptGetImageHashAlgorithm) SetTo(v GetImageHashAlgorithm) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetMap returns the value of Map.
-func (s *Mapping) GetMap() OptMappingMap {
-	return s.Map
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetImageHashAlgorithm) Get() (v GetImageHashAlgorithm, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetFormat returns the value of Format.
-func (s *Mapping) GetFormat() *MappingFormat {
-	return s.Format
+// Or returns value if set, or given parameter if does not.
+func (o OptGetImageHashAlgorithm) Or(d GetImageHashAlgorithm) GetImageHashAlgorithm {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetMap sets the value of Map.
-func (s *Mapping) SetMap(val OptMappingMap) {
-	s.Map = val
+// NewOptImageGPS returns new OptImageGPS with value set to v.
+func NewOptImageGPS(v ImageGPS) OptImageGPS {
+	return OptImageGPS{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetFormat sets the value of Format.
-func (s *Mapping) SetFormat(val *MappingFormat) {
-	s.Format = val
+// OptImageGPS is optional ImageGPS.
+type OptImageGPS struct {
+	Value ImageGPS
+	Set   bool
 }
 
-type MappingFormat struct{}
+// IsSet returns true if OptImageGPS was set.
+func (o OptImageGPS) IsSet() bool { return o.Set }
 
-// MappingHeaders wraps Mapping with response headers.
-type MappingHeaders struct {
-	XToken   OptString
-	Response Mapping
+// Reset unsets value.
+func (o *OptImageGPS) Reset() {
+	var v ImageGPS
+	o.Value = v
+	o.Set = false
 }
 
-// GetXToken returns the value of XToken.
-func (s *MappingHeaders) GetXToken() OptString {
-	return s.XToken
+// SetTo sets value to v.
+func (o *OptImageGPS) SetTo(v ImageGPS) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetResponse returns the value of Response.
-func (s *MappingHeaders) GetResponse() Mapping {
-	return s.Response
+// Get returns value and boolean that denotes whether value was set.
+func (o OptImageGPS) Get() (v ImageGPS, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetXToken sets the value of XToken.
-func (s *MappingHeaders) SetXToken(val OptString) {
-	s.XToken = val
+// Or returns value if set, or given parameter if does not.
+func (o OptImageGPS) Or(d ImageGPS) ImageGPS {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetResponse sets the value of Response.
-func (s *MappingHeaders) SetResponse(val Mapping) {
-	s.Response = val
+// NewOptImageMetadataTags returns new OptImageMetadataTags with value set to v.
+func NewOptImageMetadataTags(v ImageMetadataTags) OptImageMetadataTags {
+	return OptImageMetadataTags{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptImageMetadataTags is optional ImageMetadataTags.
+type OptImageMetadataTags struct {
+	Value ImageMetadataTags
+	Set   bool
 }
 
-func (*MappingHeaders) getMapMetadataRes() {}
+// IsSet returns true if OptImageMetadataTags was set.
+func (o OptImageMetadataTags) IsSet() bool { return o.Set }
 
-// Ref: #/components/schemas/mappingLocation
-type MappingLocation struct {
-	Target OptString `json:"target"`
-	File   OptInt    `json:"file"`
+// Reset unsets value.
+func (o *OptImageMetadataTags) Reset() {
+	var v ImageMetadataTags
+	o.Value = v
+	o.Set = false
 }
 
-// GetTarget returns the value of Target.
-func (s *MappingLocation) GetTarget() OptString {
-	return s.Target
+// SetTo sets value to v.
+func (o *OptImageMetadataTags) SetTo(v ImageMetadataTags) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetFile returns the value of File.
-func (s *MappingLocation) GetFile() OptInt {
-	return s.File
+// Get returns value and boolean that denotes whether value was set.
+func (o OptImageMetadataTags) Get() (v ImageMetadataTags, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetTarget sets the value of Target.
-func (s *MappingLocation) SetTarget(val OptString) {
-	s.Target = val
+// Or returns value if set, or given parameter if does not.
+func (o OptImageMetadataTags) Or(d ImageMetadataTags) ImageMetadataTags {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetFile sets the value of File.
-func (s *MappingLocation) SetFile(val OptInt) {
-	s.File = val
+// NewOptImportRecordsOnError returns new OptImportRecordsOnError with value set to v.
+func NewOptImportRecordsOnError(v ImportRecordsOnError) OptImportRecordsOnError {
+	return OptImportRecordsOnError{
+		Value: v,
+		Set:   true,
+	}
 }
 
-type MappingMap struct {
-	Name         OptString          `json:"name"`
-	LastModified OptDateTime        `json:"lastModified"`
-	Isn          OptInt             `json:"isn"`
-	Definition   OptMappingLocation `json:"definition"`
-	Data         OptMappingLocation `json:"data"`
-	Fields       []MapField         `json:"fields"`
+// OptImportRecordsOnError is optional ImportRecordsOnError.
+type OptImportRecordsOnError struct {
+	Value ImportRecordsOnError
+	Set   bool
 }
 
-// GetName returns the value of Name.
-func (s *MappingMap) GetName() OptString {
-	return s.Name
+// IsSet returns true if OptImportRecordsOnError was set.
+func (o OptImportRecordsOnError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptImportRecordsOnError) Reset() {
+	var v ImportRecordsOnError
+	o.Value = v
+	o.Set = false
 }
 
-// GetLastModified returns the value of LastModified.
-func (s *MappingMap) GetLastModified() OptDateTime {
-	return s.LastModified
+// SetTo sets value to v.
+func (o *OptImportRecordsOnError) SetTo(v ImportRecordsOnError) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetIsn returns the value of Isn.
-func (s *MappingMap) GetIsn() OptInt {
-	return s.Isn
+// Get returns value and boolean that denotes whether value was set.
+func (o OptImportRecordsOnError) Get() (v ImportRecordsOnError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetDefinition returns the value of Definition.
-func (s *MappingMap) GetDefinition() OptMappingLocation {
-	return s.Definition
+// Or returns value if set, or given parameter if does not.
+func (o OptImportRecordsOnError) Or(d ImportRecordsOnError) ImportRecordsOnError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetData returns the value of Data.
-func (s *MappingMap) GetData() OptMappingLocation {
-	return s.Data
+// NewOptInsertMapFileRecordsReq returns new OptInsertMapFileRecordsReq with value set to v.
+func NewOptInsertMapFileRecordsReq(v InsertMapFileRecordsReq) OptInsertMapFileRecordsReq {
+	return OptInsertMapFileRecordsReq{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetFields returns the value of Fields.
-func (s *MappingMap) GetFields() []MapField {
-	return s.Fields
+// OptInsertMapFileRecordsReq is optional InsertMapFileRecordsReq.
+type OptInsertMapFileRecordsReq struct {
+	Value InsertMapFileRecordsReq
+	Set   bool
 }
 
-// SetName sets the value of Name.
-func (s *MappingMap) SetName(val OptString) {
-	s.Name = val
+// IsSet returns true if OptInsertMapFileRecordsReq was set.
+func (o OptInsertMapFileRecordsReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInsertMapFileRecordsReq) Reset() {
+	var v InsertMapFileRecordsReq
+	o.Value = v
+	o.Set = false
 }
 
-// SetLastModified sets the value of LastModified.
-func (s *MappingMap) SetLastModified(val OptDateTime) {
-	s.LastModified = val
+// SetTo sets value to v.
+func (o *OptInsertMapFileRecordsReq) SetTo(v InsertMapFileRecordsReq) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetIsn sets the value of Isn.
-func (s *MappingMap) SetIsn(val OptInt) {
-	s.Isn = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInsertMapFileRecordsReq) Get() (v InsertMapFileRecordsReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetDefinition sets the value of Definition.
-func (s *MappingMap) SetDefinition(val OptMappingLocation) {
-	s.Definition = val
+// Or returns value if set, or given parameter if does not.
+func (o OptInsertMapFileRecordsReq) Or(d InsertMapFileRecordsReq) InsertMapFileRecordsReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetData sets the value of Data.
-func (s *MappingMap) SetData(val OptMappingLocation) {
-	s.Data = val
+// NewOptInsertRecordOnConflict returns new OptInsertRecordOnConflict with value set to v.
+func NewOptInsertRecordOnConflict(v InsertRecordOnConflict) OptInsertRecordOnConflict {
+	return OptInsertRecordOnConflict{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetFields sets the value of Fields.
-func (s *MappingMap) SetFields(val []MapField) {
-	s.Fields = val
+// OptInsertRecordOnConflict is optional InsertRecordOnConflict.
+type OptInsertRecordOnConflict struct {
+	Value InsertRecordOnConflict
+	Set   bool
 }
 
-// Maps definition.
-// Ref: #/components/schemas/Maps
-type Maps struct {
-	Maps []Map `json:"Maps"`
+// IsSet returns true if OptInsertRecordOnConflict was set.
+func (o OptInsertRecordOnConflict) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInsertRecordOnConflict) Reset() {
+	var v InsertRecordOnConflict
+	o.Value = v
+	o.Set = false
 }
 
-// GetMaps returns the value of Maps.
-func (s *Maps) GetMaps() []Map {
-	return s.Maps
+// SetTo sets value to v.
+func (o *OptInsertRecordOnConflict) SetTo(v InsertRecordOnConflict) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetMaps sets the value of Maps.
-func (s *Maps) SetMaps(val []Map) {
-	s.Maps = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInsertRecordOnConflict) Get() (v InsertRecordOnConflict, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-func (*Maps) getMapsRes()       {}
-func (*Maps) listModellingRes() {}
-func (*Maps) listTablesRes()    {}
+// Or returns value if set, or given parameter if does not.
+func (o OptInsertRecordOnConflict) Or(d InsertRecordOnConflict) InsertRecordOnConflict {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
+// NewOptInsertRecordReq returns new OptInsertRecordReq with value set to v.
+func NewOptInsertRecordReq(v InsertRecordReq) OptInsertRecordReq {
+	return OptInsertRecordReq{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
+// OptInsertRecordReq is optional InsertRecordReq.
+type OptInsertRecordReq struct {
+	Value InsertRecordReq
 	Set   bool
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// IsSet returns true if OptInsertRecordReq was set.
+func (o OptInsertRecordReq) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
+func (o *OptInsertRecordReq) Reset() {
+	var v InsertRecordReq
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
+func (o *OptInsertRecordReq) SetTo(v InsertRecordReq) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
+func (o OptInsertRecordReq) Get() (v InsertRecordReq, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3169,45 +5934,45 @@ func (o OptBool) Get() (v bool, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
+func (o OptInsertRecordReq) Or(d InsertRecordReq) InsertRecordReq {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptClusterConfig returns new OptClusterConfig with value set to v.
-func NewOptClusterConfig(v ClusterConfig) OptClusterConfig {
-	return OptClusterConfig{
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptClusterConfig is optional ClusterConfig.
-type OptClusterConfig struct {
-	Value ClusterConfig
+// OptInt is optional int.
+type OptInt struct {
+	Value int
 	Set   bool
 }
 
-// IsSet returns true if OptClusterConfig was set.
-func (o OptClusterConfig) IsSet() bool { return o.Set }
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
 
-// Reset unsets value.
-func (o *OptClusterConfig) Reset() {
-	var v ClusterConfig
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptClusterConfig) SetTo(v ClusterConfig) {
+func (o *OptInt) SetTo(v int) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptClusterConfig) Get() (v ClusterConfig, ok bool) {
+func (o OptInt) Get() (v int, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3215,45 +5980,45 @@ func (o OptClusterConfig) Get() (v ClusterConfig, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptClusterConfig) Or(d ClusterConfig) ClusterConfig {
+func (o OptInt) Or(d int) int {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptConfigDatabaseAccess returns new OptConfigDatabaseAccess with value set to v.
-func NewOptConfigDatabaseAccess(v ConfigDatabaseAccess) OptConfigDatabaseAccess {
-	return OptConfigDatabaseAccess{
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptConfigDatabaseAccess is optional ConfigDatabaseAccess.
-type OptConfigDatabaseAccess struct {
-	Value ConfigDatabaseAccess
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
 	Set   bool
 }
 
-// IsSet returns true if OptConfigDatabaseAccess was set.
-func (o OptConfigDatabaseAccess) IsSet() bool { return o.Set }
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptConfigDatabaseAccess) Reset() {
-	var v ConfigDatabaseAccess
+func (o *OptInt64) Reset() {
+	var v int64
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptConfigDatabaseAccess) SetTo(v ConfigDatabaseAccess) {
+func (o *OptInt64) SetTo(v int64) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptConfigDatabaseAccess) Get() (v ConfigDatabaseAccess, ok bool) {
+func (o OptInt64) Get() (v int64, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3261,45 +6026,45 @@ func (o OptConfigDatabaseAccess) Get() (v ConfigDatabaseAccess, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptConfigDatabaseAccess) Or(d ConfigDatabaseAccess) ConfigDatabaseAccess {
+func (o OptInt64) Or(d int64) int64 {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptConfigMapping returns new OptConfigMapping with value set to v.
-func NewOptConfigMapping(v ConfigMapping) OptConfigMapping {
-	return OptConfigMapping{
+// NewOptJob returns new OptJob with value set to v.
+func NewOptJob(v Job) OptJob {
+	return OptJob{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptConfigMapping is optional ConfigMapping.
-type OptConfigMapping struct {
-	Value ConfigMapping
+// OptJob is optional Job.
+type OptJob struct {
+	Value Job
 	Set   bool
 }
 
-// IsSet returns true if OptConfigMapping was set.
-func (o OptConfigMapping) IsSet() bool { return o.Set }
+// IsSet returns true if OptJob was set.
+func (o OptJob) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptConfigMapping) Reset() {
-	var v ConfigMapping
+func (o *OptJob) Reset() {
+	var v Job
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptConfigMapping) SetTo(v ConfigMapping) {
+func (o *OptJob) SetTo(v Job) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptConfigMapping) Get() (v ConfigMapping, ok bool) {
+func (o OptJob) Get() (v Job, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3307,45 +6072,45 @@ func (o OptConfigMapping) Get() (v ConfigMapping, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptConfigMapping) Or(d ConfigMapping) ConfigMapping {
+func (o OptJob) Or(d Job) Job {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptConfigMetrics returns new OptConfigMetrics with value set to v.
-func NewOptConfigMetrics(v ConfigMetrics) OptConfigMetrics {
-	return OptConfigMetrics{
+// NewOptJobDefinition returns new OptJobDefinition with value set to v.
+func NewOptJobDefinition(v JobDefinition) OptJobDefinition {
+	return OptJobDefinition{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptConfigMetrics is optional ConfigMetrics.
-type OptConfigMetrics struct {
-	Value ConfigMetrics
+// OptJobDefinition is optional JobDefinition.
+type OptJobDefinition struct {
+	Value JobDefinition
 	Set   bool
 }
 
-// IsSet returns true if OptConfigMetrics was set.
-func (o OptConfigMetrics) IsSet() bool { return o.Set }
+// IsSet returns true if OptJobDefinition was set.
+func (o OptJobDefinition) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptConfigMetrics) Reset() {
-	var v ConfigMetrics
+func (o *OptJobDefinition) Reset() {
+	var v JobDefinition
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptConfigMetrics) SetTo(v ConfigMetrics) {
+func (o *OptJobDefinition) SetTo(v JobDefinition) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptConfigMetrics) Get() (v ConfigMetrics, ok bool) {
+func (o OptJobDefinition) Get() (v JobDefinition, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3353,45 +6118,45 @@ func (o OptConfigMetrics) Get() (v ConfigMetrics, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptConfigMetrics) Or(d ConfigMetrics) ConfigMetrics {
+func (o OptJobDefinition) Or(d JobDefinition) JobDefinition {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptConfigModule returns new OptConfigModule with value set to v.
-func NewOptConfigModule(v ConfigModule) OptConfigModule {
-	return OptConfigModule{
+// NewOptJobDescription returns new OptJobDescription with value set to v.
+func NewOptJobDescription(v JobDescription) OptJobDescription {
+	return OptJobDescription{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptConfigModule is optional ConfigModule.
-type OptConfigModule struct {
-	Value ConfigModule
+// OptJobDescription is optional JobDescription.
+type OptJobDescription struct {
+	Value JobDescription
 	Set   bool
 }
 
-// IsSet returns true if OptConfigModule was set.
-func (o OptConfigModule) IsSet() bool { return o.Set }
+// IsSet returns true if OptJobDescription was set.
+func (o OptJobDescription) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptConfigModule) Reset() {
-	var v ConfigModule
+func (o *OptJobDescription) Reset() {
+	var v JobDescription
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptConfigModule) SetTo(v ConfigModule) {
+func (o *OptJobDescription) SetTo(v JobDescription) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptConfigModule) Get() (v ConfigModule, ok bool) {
+func (o OptJobDescription) Get() (v JobDescription, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3399,45 +6164,45 @@ func (o OptConfigModule) Get() (v ConfigModule, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptConfigModule) Or(d ConfigModule) ConfigModule {
+func (o OptJobDescription) Or(d JobDescription) JobDescription {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptConfigServer returns new OptConfigServer with value set to v.
-func NewOptConfigServer(v ConfigServer) OptConfigServer {
-	return OptConfigServer{
+// NewOptJobResultJobResult returns new OptJobResultJobResult with value set to v.
+func NewOptJobResultJobResult(v JobResultJobResult) OptJobResultJobResult {
+	return OptJobResultJobResult{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptConfigServer is optional ConfigServer.
-type OptConfigServer struct {
-	Value ConfigServer
+// OptJobResultJobResult is optional JobResultJobResult.
+type OptJobResultJobResult struct {
+	Value JobResultJobResult
 	Set   bool
 }
 
-// IsSet returns true if OptConfigServer was set.
-func (o OptConfigServer) IsSet() bool { return o.Set }
+// IsSet returns true if OptJobResultJobResult was set.
+func (o OptJobResultJobResult) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptConfigServer) Reset() {
-	var v ConfigServer
+func (o *OptJobResultJobResult) Reset() {
+	var v JobResultJobResult
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptConfigServer) SetTo(v ConfigServer) {
+func (o *OptJobResultJobResult) SetTo(v JobResultJobResult) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptConfigServer) Get() (v ConfigServer, ok bool) {
+func (o OptJobResultJobResult) Get() (v JobResultJobResult, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3445,45 +6210,45 @@ func (o OptConfigServer) Get() (v ConfigServer, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptConfigServer) Or(d ConfigServer) ConfigServer {
+func (o OptJobResultJobResult) Or(d JobResultJobResult) JobResultJobResult {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptConfigServerLogLocation returns new OptConfigServerLogLocation with value set to v.
-func NewOptConfigServerLogLocation(v ConfigServerLogLocation) OptConfigServerLogLocation {
-	return OptConfigServerLogLocation{
+// NewOptJobStatusResponseStatus returns new OptJobStatusResponseStatus with value set to v.
+func NewOptJobStatusResponseStatus(v JobStatusResponseStatus) OptJobStatusResponseStatus {
+	return OptJobStatusResponseStatus{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptConfigServerLogLocation is optional ConfigServerLogLocation.
-type OptConfigServerLogLocation struct {
-	Value ConfigServerLogLocation
+// OptJobStatusResponseStatus is optional JobStatusResponseStatus.
+type OptJobStatusResponseStatus struct {
+	Value JobStatusResponseStatus
 	Set   bool
 }
 
-// IsSet returns true if OptConfigServerLogLocation was set.
-func (o OptConfigServerLogLocation) IsSet() bool { return o.Set }
+// IsSet returns true if OptJobStatusResponseStatus was set.
+func (o OptJobStatusResponseStatus) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptConfigServerLogLocation) Reset() {
-	var v ConfigServerLogLocation
+func (o *OptJobStatusResponseStatus) Reset() {
+	var v JobStatusResponseStatus
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptConfigServerLogLocation) SetTo(v ConfigServerLogLocation) {
+func (o *OptJobStatusResponseStatus) SetTo(v JobStatusResponseStatus) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptConfigServerLogLocation) Get() (v ConfigServerLogLocation, ok bool) {
+func (o OptJobStatusResponseStatus) Get() (v JobStatusResponseStatus, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3491,45 +6256,45 @@ func (o OptConfigServerLogLocation) Get() (v ConfigServerLogLocation, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptConfigServerLogLocation) Or(d ConfigServerLogLocation) ConfigServerLogLocation {
+func (o OptJobStatusResponseStatus) Or(d JobStatusResponseStatus) JobStatusResponseStatus {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptDatabaseConfig returns new OptDatabaseConfig with value set to v.
-func NewOptDatabaseConfig(v DatabaseConfig) OptDatabaseConfig {
-	return OptDatabaseConfig{
+// NewOptJobStore returns new OptJobStore with value set to v.
+func NewOptJobStore(v JobStore) OptJobStore {
+	return OptJobStore{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDatabaseConfig is optional DatabaseConfig.
-type OptDatabaseConfig struct {
-	Value DatabaseConfig
+// OptJobStore is optional JobStore.
+type OptJobStore struct {
+	Value JobStore
 	Set   bool
 }
 
-// IsSet returns true if OptDatabaseConfig was set.
-func (o OptDatabaseConfig) IsSet() bool { return o.Set }
+// IsSet returns true if OptJobStore was set.
+func (o OptJobStore) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDatabaseConfig) Reset() {
-	var v DatabaseConfig
+func (o *OptJobStore) Reset() {
+	var v JobStore
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDatabaseConfig) SetTo(v DatabaseConfig) {
+func (o *OptJobStore) SetTo(v JobStore) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDatabaseConfig) Get() (v DatabaseConfig, ok bool) {
+func (o OptJobStore) Get() (v JobStore, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3537,45 +6302,45 @@ func (o OptDatabaseConfig) Get() (v DatabaseConfig, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDatabaseConfig) Or(d DatabaseConfig) DatabaseConfig {
+func (o OptJobStore) Or(d JobStore) JobStore {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
+// NewOptMultipartFile returns new OptMultipartFile with value set to v.
+func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
+	return OptMultipartFile{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
+// OptMultipartFile is optional ht.MultipartFile.
+type OptMultipartFile struct {
+	Value ht.MultipartFile
 	Set   bool
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// IsSet returns true if OptMultipartFile was set.
+func (o OptMultipartFile) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
+func (o *OptMultipartFile) Reset() {
+	var v ht.MultipartFile
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
+func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
+func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3583,45 +6348,45 @@ func (o OptDateTime) Get() (v time.Time, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
+func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptErrorError returns new OptErrorError with value set to v.
-func NewOptErrorError(v ErrorError) OptErrorError {
-	return OptErrorError{
+// NewOptRecordChangeAfter returns new OptRecordChangeAfter with value set to v.
+func NewOptRecordChangeAfter(v RecordChangeAfter) OptRecordChangeAfter {
+	return OptRecordChangeAfter{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptErrorError is optional ErrorError.
-type OptErrorError struct {
-	Value ErrorError
+// OptRecordChangeAfter is optional RecordChangeAfter.
+type OptRecordChangeAfter struct {
+	Value RecordChangeAfter
 	Set   bool
 }
 
-// IsSet returns true if OptErrorError was set.
-func (o OptErrorError) IsSet() bool { return o.Set }
+// IsSet returns true if OptRecordChangeAfter was set.
+func (o OptRecordChangeAfter) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptErrorError) Reset() {
-	var v ErrorError
+func (o *OptRecordChangeAfter) Reset() {
+	var v RecordChangeAfter
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptErrorError) SetTo(v ErrorError) {
+func (o *OptRecordChangeAfter) SetTo(v RecordChangeAfter) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptErrorError) Get() (v ErrorError, ok bool) {
+func (o OptRecordChangeAfter) Get() (v RecordChangeAfter, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3629,45 +6394,45 @@ func (o OptErrorError) Get() (v ErrorError, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptErrorError) Or(d ErrorError) ErrorError {
+func (o OptRecordChangeAfter) Or(d RecordChangeAfter) RecordChangeAfter {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptFloat64 returns new OptFloat64 with value set to v.
-func NewOptFloat64(v float64) OptFloat64 {
-	return OptFloat64{
+// NewOptRecordChangeBefore returns new OptRecordChangeBefore with value set to v.
+func NewOptRecordChangeBefore(v RecordChangeBefore) OptRecordChangeBefore {
+	return OptRecordChangeBefore{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptFloat64 is optional float64.
-type OptFloat64 struct {
-	Value float64
+// OptRecordChangeBefore is optional RecordChangeBefore.
+type OptRecordChangeBefore struct {
+	Value RecordChangeBefore
 	Set   bool
 }
 
-// IsSet returns true if OptFloat64 was set.
-func (o OptFloat64) IsSet() bool { return o.Set }
+// IsSet returns true if OptRecordChangeBefore was set.
+func (o OptRecordChangeBefore) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptFloat64) Reset() {
-	var v float64
+func (o *OptRecordChangeBefore) Reset() {
+	var v RecordChangeBefore
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptFloat64) SetTo(v float64) {
+func (o *OptRecordChangeBefore) SetTo(v RecordChangeBefore) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptFloat64) Get() (v float64, ok bool) {
+func (o OptRecordChangeBefore) Get() (v RecordChangeBefore, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3675,45 +6440,45 @@ func (o OptFloat64) Get() (v float64, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptFloat64) Or(d float64) float64 {
+func (o OptRecordChangeBefore) Or(d RecordChangeBefore) RecordChangeBefore {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInsertMapFileRecordsReq returns new OptInsertMapFileRecordsReq with value set to v.
-func NewOptInsertMapFileRecordsReq(v InsertMapFileRecordsReq) OptInsertMapFileRecordsReq {
-	return OptInsertMapFileRecordsReq{
+// NewOptRecordChangeOperation returns new OptRecordChangeOperation with value set to v.
+func NewOptRecordChangeOperation(v RecordChangeOperation) OptRecordChangeOperation {
+	return OptRecordChangeOperation{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInsertMapFileRecordsReq is optional InsertMapFileRecordsReq.
-type OptInsertMapFileRecordsReq struct {
-	Value InsertMapFileRecordsReq
+// OptRecordChangeOperation is optional RecordChangeOperation.
+type OptRecordChangeOperation struct {
+	Value RecordChangeOperation
 	Set   bool
 }
 
-// IsSet returns true if OptInsertMapFileRecordsReq was set.
-func (o OptInsertMapFileRecordsReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptRecordChangeOperation was set.
+func (o OptRecordChangeOperation) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInsertMapFileRecordsReq) Reset() {
-	var v InsertMapFileRecordsReq
+func (o *OptRecordChangeOperation) Reset() {
+	var v RecordChangeOperation
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInsertMapFileRecordsReq) SetTo(v InsertMapFileRecordsReq) {
+func (o *OptRecordChangeOperation) SetTo(v RecordChangeOperation) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInsertMapFileRecordsReq) Get() (v InsertMapFileRecordsReq, ok bool) {
+func (o OptRecordChangeOperation) Get() (v RecordChangeOperation, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3721,45 +6486,45 @@ func (o OptInsertMapFileRecordsReq) Get() (v InsertMapFileRecordsReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInsertMapFileRecordsReq) Or(d InsertMapFileRecordsReq) InsertMapFileRecordsReq {
+func (o OptRecordChangeOperation) Or(d RecordChangeOperation) RecordChangeOperation {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInsertRecordReq returns new OptInsertRecordReq with value set to v.
-func NewOptInsertRecordReq(v InsertRecordReq) OptInsertRecordReq {
-	return OptInsertRecordReq{
+// NewOptSQLQueryBatch returns new OptSQLQueryBatch with value set to v.
+func NewOptSQLQueryBatch(v SQLQueryBatch) OptSQLQueryBatch {
+	return OptSQLQueryBatch{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInsertRecordReq is optional InsertRecordReq.
-type OptInsertRecordReq struct {
-	Value InsertRecordReq
+// OptSQLQueryBatch is optional SQLQueryBatch.
+type OptSQLQueryBatch struct {
+	Value SQLQueryBatch
 	Set   bool
 }
 
-// IsSet returns true if OptInsertRecordReq was set.
-func (o OptInsertRecordReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptSQLQueryBatch was set.
+func (o OptSQLQueryBatch) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInsertRecordReq) Reset() {
-	var v InsertRecordReq
+func (o *OptSQLQueryBatch) Reset() {
+	var v SQLQueryBatch
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInsertRecordReq) SetTo(v InsertRecordReq) {
+func (o *OptSQLQueryBatch) SetTo(v SQLQueryBatch) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInsertRecordReq) Get() (v InsertRecordReq, ok bool) {
+func (o OptSQLQueryBatch) Get() (v SQLQueryBatch, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3767,45 +6532,45 @@ func (o OptInsertRecordReq) Get() (v InsertRecordReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInsertRecordReq) Or(d InsertRecordReq) InsertRecordReq {
+func (o OptSQLQueryBatch) Or(d SQLQueryBatch) SQLQueryBatch {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
+// NewOptStatusResponseStatus returns new OptStatusResponseStatus with value set to v.
+func NewOptStatusResponseStatus(v StatusResponseStatus) OptStatusResponseStatus {
+	return OptStatusResponseStatus{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
+// OptStatusResponseStatus is optional StatusResponseStatus.
+type OptStatusResponseStatus struct {
+	Value StatusResponseStatus
 	Set   bool
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// IsSet returns true if OptStatusResponseStatus was set.
+func (o OptStatusResponseStatus) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
+func (o *OptStatusResponseStatus) Reset() {
+	var v StatusResponseStatus
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
+func (o *OptStatusResponseStatus) SetTo(v StatusResponseStatus) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
+func (o OptStatusResponseStatus) Get() (v StatusResponseStatus, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3813,45 +6578,45 @@ func (o OptInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o OptStatusResponseStatus) Or(d StatusResponseStatus) StatusResponseStatus {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt64 returns new OptInt64 with value set to v.
-func NewOptInt64(v int64) OptInt64 {
-	return OptInt64{
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt64 is optional int64.
-type OptInt64 struct {
-	Value int64
+// OptString is optional string.
+type OptString struct {
+	Value string
 	Set   bool
 }
 
-// IsSet returns true if OptInt64 was set.
-func (o OptInt64) IsSet() bool { return o.Set }
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt64) Reset() {
-	var v int64
+func (o *OptString) Reset() {
+	var v string
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt64) SetTo(v int64) {
+func (o *OptString) SetTo(v string) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt64) Get() (v int64, ok bool) {
+func (o OptString) Get() (v string, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3859,45 +6624,45 @@ func (o OptInt64) Get() (v int64, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt64) Or(d int64) int64 {
+func (o OptString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptJob returns new OptJob with value set to v.
-func NewOptJob(v Job) OptJob {
-	return OptJob{
+// NewOptTableReference returns new OptTableReference with value set to v.
+func NewOptTableReference(v TableReference) OptTableReference {
+	return OptTableReference{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptJob is optional Job.
-type OptJob struct {
-	Value Job
+// OptTableReference is optional TableReference.
+type OptTableReference struct {
+	Value TableReference
 	Set   bool
 }
 
-// IsSet returns true if OptJob was set.
-func (o OptJob) IsSet() bool { return o.Set }
+// IsSet returns true if OptTableReference was set.
+func (o OptTableReference) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptJob) Reset() {
-	var v Job
+func (o *OptTableReference) Reset() {
+	var v TableReference
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptJob) SetTo(v Job) {
+func (o *OptTableReference) SetTo(v TableReference) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptJob) Get() (v Job, ok bool) {
+func (o OptTableReference) Get() (v TableReference, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3905,45 +6670,45 @@ func (o OptJob) Get() (v Job, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptJob) Or(d Job) Job {
+func (o OptTableReference) Or(d TableReference) TableReference {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptJobDefinition returns new OptJobDefinition with value set to v.
-func NewOptJobDefinition(v JobDefinition) OptJobDefinition {
-	return OptJobDefinition{
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptJobDefinition is optional JobDefinition.
-type OptJobDefinition struct {
-	Value JobDefinition
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
 	Set   bool
 }
 
-// IsSet returns true if OptJobDefinition was set.
-func (o OptJobDefinition) IsSet() bool { return o.Set }
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptJobDefinition) Reset() {
-	var v JobDefinition
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptJobDefinition) SetTo(v JobDefinition) {
+func (o *OptUUID) SetTo(v uuid.UUID) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptJobDefinition) Get() (v JobDefinition, ok bool) {
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3951,45 +6716,45 @@ func (o OptJobDefinition) Get() (v JobDefinition, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptJobDefinition) Or(d JobDefinition) JobDefinition {
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptJobDescription returns new OptJobDescription with value set to v.
-func NewOptJobDescription(v JobDescription) OptJobDescription {
-	return OptJobDescription{
+// NewOptUpdateImageHashesAlgorithm returns new OptUpdateImageHashesAlgorithm with value set to v.
+func NewOptUpdateImageHashesAlgorithm(v UpdateImageHashesAlgorithm) OptUpdateImageHashesAlgorithm {
+	return OptUpdateImageHashesAlgorithm{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptJobDescription is optional JobDescription.
-type OptJobDescription struct {
-	Value JobDescription
+// OptUpdateImageHashesAlgorithm is optional UpdateImageHashesAlgorithm.
+type OptUpdateImageHashesAlgorithm struct {
+	Value UpdateImageHashesAlgorithm
 	Set   bool
 }
 
-// IsSet returns true if OptJobDescription was set.
-func (o OptJobDescription) IsSet() bool { return o.Set }
+// IsSet returns true if OptUpdateImageHashesAlgorithm was set.
+func (o OptUpdateImageHashesAlgorithm) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptJobDescription) Reset() {
-	var v JobDescription
+func (o *OptUpdateImageHashesAlgorithm) Reset() {
+	var v UpdateImageHashesAlgorithm
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptJobDescription) SetTo(v JobDescription) {
+func (o *OptUpdateImageHashesAlgorithm) SetTo(v UpdateImageHashesAlgorithm) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptJobDescription) Get() (v JobDescription, ok bool) {
+func (o OptUpdateImageHashesAlgorithm) Get() (v UpdateImageHashesAlgorithm, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3997,45 +6762,45 @@ func (o OptJobDescription) Get() (v JobDescription, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptJobDescription) Or(d JobDescription) JobDescription {
+func (o OptUpdateImageHashesAlgorithm) Or(d UpdateImageHashesAlgorithm) UpdateImageHashesAlgorithm {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptJobResultJobResult returns new OptJobResultJobResult with value set to v.
-func NewOptJobResultJobResult(v JobResultJobResult) OptJobResultJobResult {
-	return OptJobResultJobResult{
+// NewOptUpdateRecordsByFieldsReq returns new OptUpdateRecordsByFieldsReq with value set to v.
+func NewOptUpdateRecordsByFieldsReq(v UpdateRecordsByFieldsReq) OptUpdateRecordsByFieldsReq {
+	return OptUpdateRecordsByFieldsReq{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptJobResultJobResult is optional JobResultJobResult.
-type OptJobResultJobResult struct {
-	Value JobResultJobResult
+// OptUpdateRecordsByFieldsReq is optional UpdateRecordsByFieldsReq.
+type OptUpdateRecordsByFieldsReq struct {
+	Value UpdateRecordsByFieldsReq
 	Set   bool
 }
 
-// IsSet returns true if OptJobResultJobResult was set.
-func (o OptJobResultJobResult) IsSet() bool { return o.Set }
+// IsSet returns true if OptUpdateRecordsByFieldsReq was set.
+func (o OptUpdateRecordsByFieldsReq) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptJobResultJobResult) Reset() {
-	var v JobResultJobResult
+func (o *OptUpdateRecordsByFieldsReq) Reset() {
+	var v UpdateRecordsByFieldsReq
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptJobResultJobResult) SetTo(v JobResultJobResult) {
+func (o *OptUpdateRecordsByFieldsReq) SetTo(v UpdateRecordsByFieldsReq) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptJobResultJobResult) Get() (v JobResultJobResult, ok bool) {
+func (o OptUpdateRecordsByFieldsReq) Get() (v UpdateRecordsByFieldsReq, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -4043,45 +6808,45 @@ func (o OptJobResultJobResult) Get() (v JobResultJobResult, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptJobResultJobResult) Or(d JobResultJobResult) JobResultJobResult {
+func (o OptUpdateRecordsByFieldsReq) Or(d UpdateRecordsByFieldsReq) UpdateRecordsByFieldsReq {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptJobStatusResponseStatus returns new OptJobStatusResponseStatus with value set to v.
-func NewOptJobStatusResponseStatus(v JobStatusResponseStatus) OptJobStatusResponseStatus {
-	return OptJobStatusResponseStatus{
+// NewOptUser returns new OptUser with value set to v.
+func NewOptUser(v User) OptUser {
+	return OptUser{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptJobStatusResponseStatus is optional JobStatusResponseStatus.
-type OptJobStatusResponseStatus struct {
-	Value JobStatusResponseStatus
+// OptUser is optional User.
+type OptUser struct {
+	Value User
 	Set   bool
 }
 
-// IsSet returns true if OptJobStatusResponseStatus was set.
-func (o OptJobStatusResponseStatus) IsSet() bool { return o.Set }
+// IsSet returns true if OptUser was set.
+func (o OptUser) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptJobStatusResponseStatus) Reset() {
-	var v JobStatusResponseStatus
+func (o *OptUser) Reset() {
+	var v User
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptJobStatusResponseStatus) SetTo(v JobStatusResponseStatus) {
+func (o *OptUser) SetTo(v User) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptJobStatusResponseStatus) Get() (v JobStatusResponseStatus, ok bool) {
+func (o OptUser) Get() (v User, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -4089,1456 +6854,1698 @@ func (o OptJobStatusResponseStatus) Get() (v JobStatusResponseStatus, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptJobStatusResponseStatus) Or(d JobStatusResponseStatus) JobStatusResponseStatus {
+func (o OptUser) Or(d User) User {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptJobStore returns new OptJobStore with value set to v.
-func NewOptJobStore(v JobStore) OptJobStore {
-	return OptJobStore{
-		Value: v,
-		Set:   true,
+type PatchRecordByKeyBadRequest Error
+
+func (*PatchRecordByKeyBadRequest) patchRecordByKeyRes() {}
+
+// PatchRecordByKeyForbidden is response for PatchRecordByKey operation.
+type PatchRecordByKeyForbidden struct{}
+
+func (*PatchRecordByKeyForbidden) patchRecordByKeyRes() {}
+
+type PatchRecordByKeyNotFound Error
+
+func (*PatchRecordByKeyNotFound) patchRecordByKeyRes() {}
+
+type PatchRecordByKeyReq map[string]jx.Raw
+
+func (s *PatchRecordByKeyReq) init() PatchRecordByKeyReq {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// PatchRecordByKeyUnauthorized is response for PatchRecordByKey operation.
+type PatchRecordByKeyUnauthorized struct{}
+
+func (*PatchRecordByKeyUnauthorized) patchRecordByKeyRes() {}
+
+// PostDatabaseForbidden is response for PostDatabase operation.
+type PostDatabaseForbidden struct{}
+
+func (*PostDatabaseForbidden) postDatabaseRes() {}
+
+// PostDatabaseUnauthorized is response for PostDatabase operation.
+type PostDatabaseUnauthorized struct{}
+
+func (*PostDatabaseUnauthorized) postDatabaseRes() {}
+
+type PostJobBadRequest Error
+
+func (*PostJobBadRequest) postJobRes() {}
+
+// PostJobForbidden is response for PostJob operation.
+type PostJobForbidden struct{}
+
+func (*PostJobForbidden) postJobRes() {}
+
+type PostJobNotFound Error
+
+func (*PostJobNotFound) postJobRes() {}
+
+type PostJobReqEmptyBody struct{}
+
+func (*PostJobReqEmptyBody) postJobReq() {}
+
+type PostJobReqTextPlain struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s PostJobReqTextPlain) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
+	return s.Data.Read(p)
 }
 
-// OptJobStore is optional JobStore.
-type OptJobStore struct {
-	Value JobStore
-	Set   bool
-}
+func (*PostJobReqTextPlain) postJobReq() {}
+
+// PostJobUnauthorized is response for PostJob operation.
+type PostJobUnauthorized struct{}
+
+func (*PostJobUnauthorized) postJobRes() {}
+
+// PurgeImageCacheForbidden is response for PurgeImageCache operation.
+type PurgeImageCacheForbidden struct{}
+
+func (*PurgeImageCacheForbidden) purgeImageCacheRes() {}
+
+// PurgeImageCacheUnauthorized is response for PurgeImageCache operation.
+type PurgeImageCacheUnauthorized struct{}
+
+func (*PurgeImageCacheUnauthorized) purgeImageCacheRes() {}
+
+// PushLoginSessionForbidden is response for PushLoginSession operation.
+type PushLoginSessionForbidden struct{}
+
+func (*PushLoginSessionForbidden) pushLoginSessionRes() {}
 
-// IsSet returns true if OptJobStore was set.
-func (o OptJobStore) IsSet() bool { return o.Set }
+// PushLoginSessionUnauthorized is response for PushLoginSession operation.
+type PushLoginSessionUnauthorized struct{}
 
-// Reset unsets value.
-func (o *OptJobStore) Reset() {
-	var v JobStore
-	o.Value = v
-	o.Set = false
+func (*PushLoginSessionUnauthorized) pushLoginSessionRes() {}
+
+// Ref: #/components/schemas/RecordChange
+type RecordChange struct {
+	Operation OptRecordChangeOperation `json:"Operation"`
+	User      OptString                `json:"User"`
+	// Session UUID of the change.
+	UUID     OptString   `json:"UUID"`
+	Modified OptDateTime `json:"Modified"`
+	// Record before the change.
+	Before OptRecordChangeBefore `json:"Before"`
+	// Record after the change.
+	After OptRecordChangeAfter `json:"After"`
 }
 
-// SetTo sets value to v.
-func (o *OptJobStore) SetTo(v JobStore) {
-	o.Set = true
-	o.Value = v
+// GetOperation returns the value of Operation.
+func (s *RecordChange) GetOperation() OptRecordChangeOperation {
+	return s.Operation
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptJobStore) Get() (v JobStore, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetUser returns the value of User.
+func (s *RecordChange) GetUser() OptString {
+	return s.User
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptJobStore) Or(d JobStore) JobStore {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetUUID returns the value of UUID.
+func (s *RecordChange) GetUUID() OptString {
+	return s.UUID
 }
 
-// NewOptMapFieldCharset returns new OptMapFieldCharset with value set to v.
-func NewOptMapFieldCharset(v MapFieldCharset) OptMapFieldCharset {
-	return OptMapFieldCharset{
-		Value: v,
-		Set:   true,
-	}
+// GetModified returns the value of Modified.
+func (s *RecordChange) GetModified() OptDateTime {
+	return s.Modified
 }
 
-// OptMapFieldCharset is optional MapFieldCharset.
-type OptMapFieldCharset struct {
-	Value MapFieldCharset
-	Set   bool
+// GetBefore returns the value of Before.
+func (s *RecordChange) GetBefore() OptRecordChangeBefore {
+	return s.Before
 }
 
-// IsSet returns true if OptMapFieldCharset was set.
-func (o OptMapFieldCharset) IsSet() bool { return o.Set }
+// GetAfter returns the value of After.
+func (s *RecordChange) GetAfter() OptRecordChangeAfter {
+	return s.After
+}
 
-// Reset unsets value.
-func (o *OptMapFieldCharset) Reset() {
-	var v MapFieldCharset
-	o.Value = v
-	o.Set = false
+// SetOperation sets the value of Operation.
+func (s *RecordChange) SetOperation(val OptRecordChangeOperation) {
+	s.Operation = val
 }
 
-// SetTo sets value to v.
-func (o *OptMapFieldCharset) SetTo(v MapFieldCharset) {
-	o.Set = true
-	o.Value = v
+// SetUser sets the value of User.
+func (s *RecordChange) SetUser(val OptString) {
+	s.User = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMapFieldCharset) Get() (v MapFieldCharset, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetUUID sets the value of UUID.
+func (s *RecordChange) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMapFieldCharset) Or(d MapFieldCharset) MapFieldCharset {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetModified sets the value of Modified.
+func (s *RecordChange) SetModified(val OptDateTime) {
+	s.Modified = val
 }
 
-// NewOptMapFieldContentType returns new OptMapFieldContentType with value set to v.
-func NewOptMapFieldContentType(v MapFieldContentType) OptMapFieldContentType {
-	return OptMapFieldContentType{
-		Value: v,
-		Set:   true,
-	}
+// SetBefore sets the value of Before.
+func (s *RecordChange) SetBefore(val OptRecordChangeBefore) {
+	s.Before = val
 }
 
-// OptMapFieldContentType is optional MapFieldContentType.
-type OptMapFieldContentType struct {
-	Value MapFieldContentType
-	Set   bool
+// SetAfter sets the value of After.
+func (s *RecordChange) SetAfter(val OptRecordChangeAfter) {
+	s.After = val
 }
 
-// IsSet returns true if OptMapFieldContentType was set.
-func (o OptMapFieldContentType) IsSet() bool { return o.Set }
+// Record after the change.
+type RecordChangeAfter map[string]jx.Raw
 
-// Reset unsets value.
-func (o *OptMapFieldContentType) Reset() {
-	var v MapFieldContentType
-	o.Value = v
-	o.Set = false
+func (s *RecordChangeAfter) init() RecordChangeAfter {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetTo sets value to v.
-func (o *OptMapFieldContentType) SetTo(v MapFieldContentType) {
-	o.Set = true
-	o.Value = v
-}
+// Record before the change.
+type RecordChangeBefore map[string]jx.Raw
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMapFieldContentType) Get() (v MapFieldContentType, ok bool) {
-	if !o.Set {
-		return v, false
+func (s *RecordChangeBefore) init() RecordChangeBefore {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
-	return o.Value, true
+	return m
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMapFieldContentType) Or(d MapFieldContentType) MapFieldContentType {
-	if v, ok := o.Get(); ok {
-		return v
+type RecordChangeOperation string
+
+const (
+	RecordChangeOperationInsert RecordChangeOperation = "insert"
+	RecordChangeOperationUpdate RecordChangeOperation = "update"
+	RecordChangeOperationDelete RecordChangeOperation = "delete"
+)
+
+// AllValues returns all RecordChangeOperation values.
+func (RecordChangeOperation) AllValues() []RecordChangeOperation {
+	return []RecordChangeOperation{
+		RecordChangeOperationInsert,
+		RecordChangeOperationUpdate,
+		RecordChangeOperationDelete,
 	}
-	return d
 }
 
-// NewOptMapFieldFieldType returns new OptMapFieldFieldType with value set to v.
-func NewOptMapFieldFieldType(v MapFieldFieldType) OptMapFieldFieldType {
-	return OptMapFieldFieldType{
-		Value: v,
-		Set:   true,
+// MarshalText implements encoding.TextMarshaler.
+func (s RecordChangeOperation) MarshalText() ([]byte, error) {
+	switch s {
+	case RecordChangeOperationInsert:
+		return []byte(s), nil
+	case RecordChangeOperationUpdate:
+		return []byte(s), nil
+	case RecordChangeOperationDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
 }
 
-// OptMapFieldFieldType is optional MapFieldFieldType.
-type OptMapFieldFieldType struct {
-	Value MapFieldFieldType
-	Set   bool
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RecordChangeOperation) UnmarshalText(data []byte) error {
+	switch RecordChangeOperation(data) {
+	case RecordChangeOperationInsert:
+		*s = RecordChangeOperationInsert
+		return nil
+	case RecordChangeOperationUpdate:
+		*s = RecordChangeOperationUpdate
+		return nil
+	case RecordChangeOperationDelete:
+		*s = RecordChangeOperationDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// IsSet returns true if OptMapFieldFieldType was set.
-func (o OptMapFieldFieldType) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptMapFieldFieldType) Reset() {
-	var v MapFieldFieldType
-	o.Value = v
-	o.Set = false
+// Ref: #/components/schemas/RecordHistory
+type RecordHistory struct {
+	Table   OptString      `json:"Table"`
+	Key     OptString      `json:"Key"`
+	Changes []RecordChange `json:"Changes"`
 }
 
-// SetTo sets value to v.
-func (o *OptMapFieldFieldType) SetTo(v MapFieldFieldType) {
-	o.Set = true
-	o.Value = v
+// GetTable returns the value of Table.
+func (s *RecordHistory) GetTable() OptString {
+	return s.Table
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMapFieldFieldType) Get() (v MapFieldFieldType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetKey returns the value of Key.
+func (s *RecordHistory) GetKey() OptString {
+	return s.Key
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMapFieldFieldType) Or(d MapFieldFieldType) MapFieldFieldType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetChanges returns the value of Changes.
+func (s *RecordHistory) GetChanges() []RecordChange {
+	return s.Changes
 }
 
-// NewOptMapFieldFile returns new OptMapFieldFile with value set to v.
-func NewOptMapFieldFile(v MapFieldFile) OptMapFieldFile {
-	return OptMapFieldFile{
-		Value: v,
-		Set:   true,
-	}
+// SetTable sets the value of Table.
+func (s *RecordHistory) SetTable(val OptString) {
+	s.Table = val
 }
 
-// OptMapFieldFile is optional MapFieldFile.
-type OptMapFieldFile struct {
-	Value MapFieldFile
-	Set   bool
+// SetKey sets the value of Key.
+func (s *RecordHistory) SetKey(val OptString) {
+	s.Key = val
 }
 
-// IsSet returns true if OptMapFieldFile was set.
-func (o OptMapFieldFile) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptMapFieldFile) Reset() {
-	var v MapFieldFile
-	o.Value = v
-	o.Set = false
+// SetChanges sets the value of Changes.
+func (s *RecordHistory) SetChanges(val []RecordChange) {
+	s.Changes = val
 }
 
-// SetTo sets value to v.
-func (o *OptMapFieldFile) SetTo(v MapFieldFile) {
-	o.Set = true
-	o.Value = v
+// RecordHistoryHeaders wraps RecordHistory with response headers.
+type RecordHistoryHeaders struct {
+	XToken   OptString
+	Response RecordHistory
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMapFieldFile) Get() (v MapFieldFile, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetXToken returns the value of XToken.
+func (s *RecordHistoryHeaders) GetXToken() OptString {
+	return s.XToken
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMapFieldFile) Or(d MapFieldFile) MapFieldFile {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetResponse returns the value of Response.
+func (s *RecordHistoryHeaders) GetResponse() RecordHistory {
+	return s.Response
 }
 
-// NewOptMapFieldFormatType returns new OptMapFieldFormatType with value set to v.
-func NewOptMapFieldFormatType(v MapFieldFormatType) OptMapFieldFormatType {
-	return OptMapFieldFormatType{
-		Value: v,
-		Set:   true,
-	}
+// SetXToken sets the value of XToken.
+func (s *RecordHistoryHeaders) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-// OptMapFieldFormatType is optional MapFieldFormatType.
-type OptMapFieldFormatType struct {
-	Value MapFieldFormatType
-	Set   bool
+// SetResponse sets the value of Response.
+func (s *RecordHistoryHeaders) SetResponse(val RecordHistory) {
+	s.Response = val
 }
 
-// IsSet returns true if OptMapFieldFormatType was set.
-func (o OptMapFieldFormatType) IsSet() bool { return o.Set }
+func (*RecordHistoryHeaders) getRecordHistoryRes() {}
 
-// Reset unsets value.
-func (o *OptMapFieldFormatType) Reset() {
-	var v MapFieldFormatType
-	o.Value = v
-	o.Set = false
-}
+type RemoveSessionCompatBadRequest Error
 
-// SetTo sets value to v.
-func (o *OptMapFieldFormatType) SetTo(v MapFieldFormatType) {
-	o.Set = true
-	o.Value = v
-}
+func (*RemoveSessionCompatBadRequest) removeSessionCompatRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMapFieldFormatType) Get() (v MapFieldFormatType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
-}
+type RemoveSessionCompatNotFound Error
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMapFieldFormatType) Or(d MapFieldFormatType) MapFieldFormatType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+func (*RemoveSessionCompatNotFound) removeSessionCompatRes() {}
+
+// RemoveSessionCompatOK is response for RemoveSessionCompat operation.
+type RemoveSessionCompatOK struct{}
+
+func (*RemoveSessionCompatOK) removeSessionCompatRes() {}
+
+// Ref: #/components/schemas/Response
+type Response struct {
+	MapName     OptString             `json:"MapName"`
+	FileRecords OptInt                `json:"FileRecords"`
+	NrRecords   OptInt                `json:"NrRecords"`
+	FieldNames  []string              `json:"FieldNames"`
+	Records     []ResponseRecordsItem `json:"Records"`
+	// Cursor to read the next page.
+	Next OptString `json:"Next"`
+	// Cursor to read the previous page.
+	Prev OptString `json:"Prev"`
+	// Number of records inserted by an upsert.
+	NrInserted OptInt `json:"NrInserted"`
+	// Number of records updated by an upsert.
+	NrUpdated OptInt `json:"NrUpdated"`
+	// Operation was not committed.
+	DryRun OptBool `json:"DryRun"`
+	// Token to confirm the operation after a dry run.
+	ConfirmToken OptString `json:"ConfirmToken"`
+	// Limit of affected records of the table.
+	MaxAffectedRows OptInt `json:"MaxAffectedRows"`
 }
 
-// NewOptMapFieldLength returns new OptMapFieldLength with value set to v.
-func NewOptMapFieldLength(v MapFieldLength) OptMapFieldLength {
-	return OptMapFieldLength{
-		Value: v,
-		Set:   true,
-	}
+// GetMapName returns the value of MapName.
+func (s *Response) GetMapName() OptString {
+	return s.MapName
 }
 
-// OptMapFieldLength is optional MapFieldLength.
-type OptMapFieldLength struct {
-	Value MapFieldLength
-	Set   bool
+// GetFileRecords returns the value of FileRecords.
+func (s *Response) GetFileRecords() OptInt {
+	return s.FileRecords
 }
 
-// IsSet returns true if OptMapFieldLength was set.
-func (o OptMapFieldLength) IsSet() bool { return o.Set }
+// GetNrRecords returns the value of NrRecords.
+func (s *Response) GetNrRecords() OptInt {
+	return s.NrRecords
+}
 
-// Reset unsets value.
-func (o *OptMapFieldLength) Reset() {
-	var v MapFieldLength
-	o.Value = v
-	o.Set = false
+// GetFieldNames returns the value of FieldNames.
+func (s *Response) GetFieldNames() []string {
+	return s.FieldNames
 }
 
-// SetTo sets value to v.
-func (o *OptMapFieldLength) SetTo(v MapFieldLength) {
-	o.Set = true
-	o.Value = v
+// GetRecords returns the value of Records.
+func (s *Response) GetRecords() []ResponseRecordsItem {
+	return s.Records
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMapFieldLength) Get() (v MapFieldLength, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetNext returns the value of Next.
+func (s *Response) GetNext() OptString {
+	return s.Next
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMapFieldLength) Or(d MapFieldLength) MapFieldLength {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetPrev returns the value of Prev.
+func (s *Response) GetPrev() OptString {
+	return s.Prev
 }
 
-// NewOptMapFieldName returns new OptMapFieldName with value set to v.
-func NewOptMapFieldName(v MapFieldName) OptMapFieldName {
-	return OptMapFieldName{
-		Value: v,
-		Set:   true,
-	}
+// GetNrInserted returns the value of NrInserted.
+func (s *Response) GetNrInserted() OptInt {
+	return s.NrInserted
 }
 
-// OptMapFieldName is optional MapFieldName.
-type OptMapFieldName struct {
-	Value MapFieldName
-	Set   bool
+// GetNrUpdated returns the value of NrUpdated.
+func (s *Response) GetNrUpdated() OptInt {
+	return s.NrUpdated
 }
 
-// IsSet returns true if OptMapFieldName was set.
-func (o OptMapFieldName) IsSet() bool { return o.Set }
+// GetDryRun returns the value of DryRun.
+func (s *Response) GetDryRun() OptBool {
+	return s.DryRun
+}
 
-// Reset unsets value.
-func (o *OptMapFieldName) Reset() {
-	var v MapFieldName
-	o.Value = v
-	o.Set = false
+// GetConfirmToken returns the value of ConfirmToken.
+func (s *Response) GetConfirmToken() OptString {
+	return s.ConfirmToken
 }
 
-// SetTo sets value to v.
-func (o *OptMapFieldName) SetTo(v MapFieldName) {
-	o.Set = true
-	o.Value = v
+// GetMaxAffectedRows returns the value of MaxAffectedRows.
+func (s *Response) GetMaxAffectedRows() OptInt {
+	return s.MaxAffectedRows
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMapFieldName) Get() (v MapFieldName, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetMapName sets the value of MapName.
+func (s *Response) SetMapName(val OptString) {
+	s.MapName = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMapFieldName) Or(d MapFieldName) MapFieldName {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetFileRecords sets the value of FileRecords.
+func (s *Response) SetFileRecords(val OptInt) {
+	s.FileRecords = val
+}
+
+// SetNrRecords sets the value of NrRecords.
+func (s *Response) SetNrRecords(val OptInt) {
+	s.NrRecords = val
 }
 
-// NewOptMapFieldShortName returns new OptMapFieldShortName with value set to v.
-func NewOptMapFieldShortName(v MapFieldShortName) OptMapFieldShortName {
-	return OptMapFieldShortName{
-		Value: v,
-		Set:   true,
-	}
+// SetFieldNames sets the value of FieldNames.
+func (s *Response) SetFieldNames(val []string) {
+	s.FieldNames = val
 }
 
-// OptMapFieldShortName is optional MapFieldShortName.
-type OptMapFieldShortName struct {
-	Value MapFieldShortName
-	Set   bool
+// SetRecords sets the value of Records.
+func (s *Response) SetRecords(val []ResponseRecordsItem) {
+	s.Records = val
 }
 
-// IsSet returns true if OptMapFieldShortName was set.
-func (o OptMapFieldShortName) IsSet() bool { return o.Set }
+// SetNext sets the value of Next.
+func (s *Response) SetNext(val OptString) {
+	s.Next = val
+}
 
-// Reset unsets value.
-func (o *OptMapFieldShortName) Reset() {
-	var v MapFieldShortName
-	o.Value = v
-	o.Set = false
+// SetPrev sets the value of Prev.
+func (s *Response) SetPrev(val OptString) {
+	s.Prev = val
 }
 
-// SetTo sets value to v.
-func (o *OptMapFieldShortName) SetTo(v MapFieldShortName) {
-	o.Set = true
-	o.Value = v
+// SetNrInserted sets the value of NrInserted.
+func (s *Response) SetNrInserted(val OptInt) {
+	s.NrInserted = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMapFieldShortName) Get() (v MapFieldShortName, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetNrUpdated sets the value of NrUpdated.
+func (s *Response) SetNrUpdated(val OptInt) {
+	s.NrUpdated = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMapFieldShortName) Or(d MapFieldShortName) MapFieldShortName {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetDryRun sets the value of DryRun.
+func (s *Response) SetDryRun(val OptBool) {
+	s.DryRun = val
 }
 
-// NewOptMappingLocation returns new OptMappingLocation with value set to v.
-func NewOptMappingLocation(v MappingLocation) OptMappingLocation {
-	return OptMappingLocation{
-		Value: v,
-		Set:   true,
-	}
+// SetConfirmToken sets the value of ConfirmToken.
+func (s *Response) SetConfirmToken(val OptString) {
+	s.ConfirmToken = val
 }
 
-// OptMappingLocation is optional MappingLocation.
-type OptMappingLocation struct {
-	Value MappingLocation
-	Set   bool
+// SetMaxAffectedRows sets the value of MaxAffectedRows.
+func (s *Response) SetMaxAffectedRows(val OptInt) {
+	s.MaxAffectedRows = val
 }
 
-// IsSet returns true if OptMappingLocation was set.
-func (o OptMappingLocation) IsSet() bool { return o.Set }
+func (*Response) deleteRecordsSearchedRes() {}
+func (*Response) patchRecordByKeyRes()      {}
+func (*Response) searchModellingRes()       {}
+func (*Response) searchTableRes()           {}
+func (*Response) triggerJobRes()            {}
 
-// Reset unsets value.
-func (o *OptMappingLocation) Reset() {
-	var v MappingLocation
-	o.Value = v
-	o.Set = false
+// ResponseHeaders wraps Response with response headers.
+type ResponseHeaders struct {
+	XToken   OptString
+	Response Response
 }
 
-// SetTo sets value to v.
-func (o *OptMappingLocation) SetTo(v MappingLocation) {
-	o.Set = true
-	o.Value = v
+// GetXToken returns the value of XToken.
+func (s *ResponseHeaders) GetXToken() OptString {
+	return s.XToken
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMappingLocation) Get() (v MappingLocation, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetResponse returns the value of Response.
+func (s *ResponseHeaders) GetResponse() Response {
+	return s.Response
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMappingLocation) Or(d MappingLocation) MappingLocation {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetXToken sets the value of XToken.
+func (s *ResponseHeaders) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-// NewOptMappingMap returns new OptMappingMap with value set to v.
-func NewOptMappingMap(v MappingMap) OptMappingMap {
-	return OptMappingMap{
-		Value: v,
-		Set:   true,
-	}
+// SetResponse sets the value of Response.
+func (s *ResponseHeaders) SetResponse(val Response) {
+	s.Response = val
 }
 
-// OptMappingMap is optional MappingMap.
-type OptMappingMap struct {
-	Value MappingMap
-	Set   bool
-}
+func (*ResponseHeaders) aggregateRecordsRes()      {}
+func (*ResponseHeaders) batchParameterQueryRes()   {}
+func (*ResponseHeaders) batchQueryRes()            {}
+func (*ResponseHeaders) batchSelectRes()           {}
+func (*ResponseHeaders) deleteRecordByKeyRes()     {}
+func (*ResponseHeaders) deleteRecordsSearchedRes() {}
+func (*ResponseHeaders) insertRecordRes()          {}
+func (*ResponseHeaders) updateRecordsByFieldsRes() {}
 
-// IsSet returns true if OptMappingMap was set.
-func (o OptMappingMap) IsSet() bool { return o.Set }
+// Ref: #/components/schemas/ResponseRaw
+type ResponseRaw map[string]jx.Raw
 
-// Reset unsets value.
-func (o *OptMappingMap) Reset() {
-	var v MappingMap
-	o.Value = v
-	o.Set = false
+func (s *ResponseRaw) init() ResponseRaw {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetTo sets value to v.
-func (o *OptMappingMap) SetTo(v MappingMap) {
-	o.Set = true
-	o.Value = v
-}
+func (*ResponseRaw) callExtendRes()     {}
+func (*ResponseRaw) callPostExtendRes() {}
+func (*ResponseRaw) deleteExtendRes()   {}
+func (*ResponseRaw) triggerExtendRes()  {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMappingMap) Get() (v MappingMap, ok bool) {
-	if !o.Set {
-		return v, false
+type ResponseRecordsItem map[string]jx.Raw
+
+func (s *ResponseRecordsItem) init() ResponseRecordsItem {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
-	return o.Value, true
+	return m
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMappingMap) Or(d MappingMap) MappingMap {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/components/schemas/SQLQuery
+type SQLQuery struct {
+	Batch OptSQLQueryBatch `json:"Batch"`
 }
 
-// NewOptMultipartFile returns new OptMultipartFile with value set to v.
-func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
-	return OptMultipartFile{
-		Value: v,
-		Set:   true,
-	}
+// GetBatch returns the value of Batch.
+func (s *SQLQuery) GetBatch() OptSQLQueryBatch {
+	return s.Batch
 }
 
-// OptMultipartFile is optional ht.MultipartFile.
-type OptMultipartFile struct {
-	Value ht.MultipartFile
-	Set   bool
+// SetBatch sets the value of Batch.
+func (s *SQLQuery) SetBatch(val OptSQLQueryBatch) {
+	s.Batch = val
 }
 
-// IsSet returns true if OptMultipartFile was set.
-func (o OptMultipartFile) IsSet() bool { return o.Set }
+func (*SQLQuery) batchQueryReq() {}
 
-// Reset unsets value.
-func (o *OptMultipartFile) Reset() {
-	var v ht.MultipartFile
-	o.Value = v
-	o.Set = false
+type SQLQueryBatch struct {
+	SQL OptString `json:"SQL"`
 }
 
-// SetTo sets value to v.
-func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
-	o.Set = true
-	o.Value = v
+// GetSQL returns the value of SQL.
+func (s *SQLQueryBatch) GetSQL() OptString {
+	return s.SQL
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetSQL sets the value of SQL.
+func (s *SQLQueryBatch) SetSQL(val OptString) {
+	s.SQL = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+type SearchModellingBadRequest Error
+
+func (*SearchModellingBadRequest) searchModellingRes() {}
+
+// SearchModellingForbidden is response for SearchModelling operation.
+type SearchModellingForbidden struct{}
+
+func (*SearchModellingForbidden) searchModellingRes() {}
+
+type SearchModellingNotFound Error
+
+func (*SearchModellingNotFound) searchModellingRes() {}
+
+type SearchModellingOKTextCsv struct {
+	Data io.Reader
 }
 
-// NewOptSQLQueryBatch returns new OptSQLQueryBatch with value set to v.
-func NewOptSQLQueryBatch(v SQLQueryBatch) OptSQLQueryBatch {
-	return OptSQLQueryBatch{
-		Value: v,
-		Set:   true,
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s SearchModellingOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
+	return s.Data.Read(p)
 }
 
-// OptSQLQueryBatch is optional SQLQueryBatch.
-type OptSQLQueryBatch struct {
-	Value SQLQueryBatch
-	Set   bool
-}
+func (*SearchModellingOKTextCsv) searchModellingRes() {}
 
-// IsSet returns true if OptSQLQueryBatch was set.
-func (o OptSQLQueryBatch) IsSet() bool { return o.Set }
+// SearchModellingUnauthorized is response for SearchModelling operation.
+type SearchModellingUnauthorized struct{}
 
-// Reset unsets value.
-func (o *OptSQLQueryBatch) Reset() {
-	var v SQLQueryBatch
-	o.Value = v
-	o.Set = false
-}
+func (*SearchModellingUnauthorized) searchModellingRes() {}
 
-// SetTo sets value to v.
-func (o *OptSQLQueryBatch) SetTo(v SQLQueryBatch) {
-	o.Set = true
-	o.Value = v
+// SearchRecordsFieldsForbidden is response for SearchRecordsFields operation.
+type SearchRecordsFieldsForbidden struct{}
+
+func (*SearchRecordsFieldsForbidden) searchRecordsFieldsRes() {}
+
+type SearchRecordsFieldsOKApplicationXJSONStream struct {
+	Data io.Reader
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptSQLQueryBatch) Get() (v SQLQueryBatch, ok bool) {
-	if !o.Set {
-		return v, false
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s SearchRecordsFieldsOKApplicationXJSONStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
-	return o.Value, true
+	return s.Data.Read(p)
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptSQLQueryBatch) Or(d SQLQueryBatch) SQLQueryBatch {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SearchRecordsFieldsOKApplicationXJSONStreamHeaders wraps SearchRecordsFieldsOKApplicationXJSONStream with response headers.
+type SearchRecordsFieldsOKApplicationXJSONStreamHeaders struct {
+	ETag     OptString
+	Link     OptString
+	XToken   OptString
+	Response SearchRecordsFieldsOKApplicationXJSONStream
 }
 
-// NewOptStatusResponseStatus returns new OptStatusResponseStatus with value set to v.
-func NewOptStatusResponseStatus(v StatusResponseStatus) OptStatusResponseStatus {
-	return OptStatusResponseStatus{
-		Value: v,
-		Set:   true,
-	}
+// GetETag returns the value of ETag.
+func (s *SearchRecordsFieldsOKApplicationXJSONStreamHeaders) GetETag() OptString {
+	return s.ETag
 }
 
-// OptStatusResponseStatus is optional StatusResponseStatus.
-type OptStatusResponseStatus struct {
-	Value StatusResponseStatus
-	Set   bool
+// GetLink returns the value of Link.
+func (s *SearchRecordsFieldsOKApplicationXJSONStreamHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXToken returns the value of XToken.
+func (s *SearchRecordsFieldsOKApplicationXJSONStreamHeaders) GetXToken() OptString {
+	return s.XToken
+}
+
+// GetResponse returns the value of Response.
+func (s *SearchRecordsFieldsOKApplicationXJSONStreamHeaders) GetResponse() SearchRecordsFieldsOKApplicationXJSONStream {
+	return s.Response
 }
 
-// IsSet returns true if OptStatusResponseStatus was set.
-func (o OptStatusResponseStatus) IsSet() bool { return o.Set }
+// SetETag sets the value of ETag.
+func (s *SearchRecordsFieldsOKApplicationXJSONStreamHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
 
-// Reset unsets value.
-func (o *OptStatusResponseStatus) Reset() {
-	var v StatusResponseStatus
-	o.Value = v
-	o.Set = false
+// SetLink sets the value of Link.
+func (s *SearchRecordsFieldsOKApplicationXJSONStreamHeaders) SetLink(val OptString) {
+	s.Link = val
 }
 
-// SetTo sets value to v.
-func (o *OptStatusResponseStatus) SetTo(v StatusResponseStatus) {
-	o.Set = true
-	o.Value = v
+// SetXToken sets the value of XToken.
+func (s *SearchRecordsFieldsOKApplicationXJSONStreamHeaders) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptStatusResponseStatus) Get() (v StatusResponseStatus, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetResponse sets the value of Response.
+func (s *SearchRecordsFieldsOKApplicationXJSONStreamHeaders) SetResponse(val SearchRecordsFieldsOKApplicationXJSONStream) {
+	s.Response = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptStatusResponseStatus) Or(d StatusResponseStatus) StatusResponseStatus {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+func (*SearchRecordsFieldsOKApplicationXJSONStreamHeaders) searchRecordsFieldsRes() {}
+
+type SearchRecordsFieldsOKApplicationXNdjson struct {
+	Data io.Reader
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s SearchRecordsFieldsOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
+	return s.Data.Read(p)
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// SearchRecordsFieldsOKApplicationXNdjsonHeaders wraps SearchRecordsFieldsOKApplicationXNdjson with response headers.
+type SearchRecordsFieldsOKApplicationXNdjsonHeaders struct {
+	ETag     OptString
+	Link     OptString
+	XToken   OptString
+	Response SearchRecordsFieldsOKApplicationXNdjson
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// GetETag returns the value of ETag.
+func (s *SearchRecordsFieldsOKApplicationXNdjsonHeaders) GetETag() OptString {
+	return s.ETag
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// GetLink returns the value of Link.
+func (s *SearchRecordsFieldsOKApplicationXNdjsonHeaders) GetLink() OptString {
+	return s.Link
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetXToken returns the value of XToken.
+func (s *SearchRecordsFieldsOKApplicationXNdjsonHeaders) GetXToken() OptString {
+	return s.XToken
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetResponse returns the value of Response.
+func (s *SearchRecordsFieldsOKApplicationXNdjsonHeaders) GetResponse() SearchRecordsFieldsOKApplicationXNdjson {
+	return s.Response
 }
 
-// NewOptUUID returns new OptUUID with value set to v.
-func NewOptUUID(v uuid.UUID) OptUUID {
-	return OptUUID{
-		Value: v,
-		Set:   true,
-	}
+// SetETag sets the value of ETag.
+func (s *SearchRecordsFieldsOKApplicationXNdjsonHeaders) SetETag(val OptString) {
+	s.ETag = val
 }
 
-// OptUUID is optional uuid.UUID.
-type OptUUID struct {
-	Value uuid.UUID
-	Set   bool
+// SetLink sets the value of Link.
+func (s *SearchRecordsFieldsOKApplicationXNdjsonHeaders) SetLink(val OptString) {
+	s.Link = val
 }
 
-// IsSet returns true if OptUUID was set.
-func (o OptUUID) IsSet() bool { return o.Set }
+// SetXToken sets the value of XToken.
+func (s *SearchRecordsFieldsOKApplicationXNdjsonHeaders) SetXToken(val OptString) {
+	s.XToken = val
+}
 
-// Reset unsets value.
-func (o *OptUUID) Reset() {
-	var v uuid.UUID
-	o.Value = v
-	o.Set = false
+// SetResponse sets the value of Response.
+func (s *SearchRecordsFieldsOKApplicationXNdjsonHeaders) SetResponse(val SearchRecordsFieldsOKApplicationXNdjson) {
+	s.Response = val
 }
 
-// SetTo sets value to v.
-func (o *OptUUID) SetTo(v uuid.UUID) {
-	o.Set = true
-	o.Value = v
+func (*SearchRecordsFieldsOKApplicationXNdjsonHeaders) searchRecordsFieldsRes() {}
+
+type SearchRecordsFieldsOKTextCsv struct {
+	Data io.Reader
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUUID) Get() (v uuid.UUID, ok bool) {
-	if !o.Set {
-		return v, false
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s SearchRecordsFieldsOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
-	return o.Value, true
+	return s.Data.Read(p)
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SearchRecordsFieldsOKTextCsvHeaders wraps SearchRecordsFieldsOKTextCsv with response headers.
+type SearchRecordsFieldsOKTextCsvHeaders struct {
+	ETag     OptString
+	Link     OptString
+	XToken   OptString
+	Response SearchRecordsFieldsOKTextCsv
 }
 
-// NewOptUpdateRecordsByFieldsReq returns new OptUpdateRecordsByFieldsReq with value set to v.
-func NewOptUpdateRecordsByFieldsReq(v UpdateRecordsByFieldsReq) OptUpdateRecordsByFieldsReq {
-	return OptUpdateRecordsByFieldsReq{
-		Value: v,
-		Set:   true,
-	}
+// GetETag returns the value of ETag.
+func (s *SearchRecordsFieldsOKTextCsvHeaders) GetETag() OptString {
+	return s.ETag
 }
 
-// OptUpdateRecordsByFieldsReq is optional UpdateRecordsByFieldsReq.
-type OptUpdateRecordsByFieldsReq struct {
-	Value UpdateRecordsByFieldsReq
-	Set   bool
+// GetLink returns the value of Link.
+func (s *SearchRecordsFieldsOKTextCsvHeaders) GetLink() OptString {
+	return s.Link
 }
 
-// IsSet returns true if OptUpdateRecordsByFieldsReq was set.
-func (o OptUpdateRecordsByFieldsReq) IsSet() bool { return o.Set }
+// GetXToken returns the value of XToken.
+func (s *SearchRecordsFieldsOKTextCsvHeaders) GetXToken() OptString {
+	return s.XToken
+}
 
-// Reset unsets value.
-func (o *OptUpdateRecordsByFieldsReq) Reset() {
-	var v UpdateRecordsByFieldsReq
-	o.Value = v
-	o.Set = false
+// GetResponse returns the value of Response.
+func (s *SearchRecordsFieldsOKTextCsvHeaders) GetResponse() SearchRecordsFieldsOKTextCsv {
+	return s.Response
 }
 
-// SetTo sets value to v.
-func (o *OptUpdateRecordsByFieldsReq) SetTo(v UpdateRecordsByFieldsReq) {
-	o.Set = true
-	o.Value = v
+// SetETag sets the value of ETag.
+func (s *SearchRecordsFieldsOKTextCsvHeaders) SetETag(val OptString) {
+	s.ETag = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUpdateRecordsByFieldsReq) Get() (v UpdateRecordsByFieldsReq, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetLink sets the value of Link.
+func (s *SearchRecordsFieldsOKTextCsvHeaders) SetLink(val OptString) {
+	s.Link = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUpdateRecordsByFieldsReq) Or(d UpdateRecordsByFieldsReq) UpdateRecordsByFieldsReq {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetXToken sets the value of XToken.
+func (s *SearchRecordsFieldsOKTextCsvHeaders) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-// NewOptUser returns new OptUser with value set to v.
-func NewOptUser(v User) OptUser {
-	return OptUser{
-		Value: v,
-		Set:   true,
-	}
+// SetResponse sets the value of Response.
+func (s *SearchRecordsFieldsOKTextCsvHeaders) SetResponse(val SearchRecordsFieldsOKTextCsv) {
+	s.Response = val
 }
 
-// OptUser is optional User.
-type OptUser struct {
-	Value User
-	Set   bool
+func (*SearchRecordsFieldsOKTextCsvHeaders) searchRecordsFieldsRes() {}
+
+// SearchRecordsFieldsUnauthorized is response for SearchRecordsFields operation.
+type SearchRecordsFieldsUnauthorized struct{}
+
+func (*SearchRecordsFieldsUnauthorized) searchRecordsFieldsRes() {}
+
+type SearchTableBadRequest Error
+
+func (*SearchTableBadRequest) searchTableRes() {}
+
+// SearchTableForbidden is response for SearchTable operation.
+type SearchTableForbidden struct{}
+
+func (*SearchTableForbidden) searchTableRes() {}
+
+type SearchTableNotFound Error
+
+func (*SearchTableNotFound) searchTableRes() {}
+
+type SearchTableOKTextCsv struct {
+	Data io.Reader
 }
 
-// IsSet returns true if OptUser was set.
-func (o OptUser) IsSet() bool { return o.Set }
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s SearchTableOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
 
-// Reset unsets value.
-func (o *OptUser) Reset() {
-	var v User
-	o.Value = v
-	o.Set = false
+func (*SearchTableOKTextCsv) searchTableRes() {}
+
+// SearchTableUnauthorized is response for SearchTable operation.
+type SearchTableUnauthorized struct{}
+
+func (*SearchTableUnauthorized) searchTableRes() {}
+
+// SetConfigForbidden is response for SetConfig operation.
+type SetConfigForbidden struct{}
+
+func (*SetConfigForbidden) setConfigRes() {}
+
+// SetConfigOK is response for SetConfig operation.
+type SetConfigOK struct {
+	XToken OptString
 }
 
-// SetTo sets value to v.
-func (o *OptUser) SetTo(v User) {
-	o.Set = true
-	o.Value = v
+// GetXToken returns the value of XToken.
+func (s *SetConfigOK) GetXToken() OptString {
+	return s.XToken
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUser) Get() (v User, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetXToken sets the value of XToken.
+func (s *SetConfigOK) SetXToken(val OptString) {
+	s.XToken = val
+}
+
+func (*SetConfigOK) setConfigRes() {}
+
+type SetConfigReqTextPlain struct {
+	Data io.Reader
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUser) Or(d User) User {
-	if v, ok := o.Get(); ok {
-		return v
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s SetConfigReqTextPlain) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
-	return d
+	return s.Data.Read(p)
 }
 
-// PostDatabaseForbidden is response for PostDatabase operation.
-type PostDatabaseForbidden struct{}
+func (*SetConfigReqTextPlain) setConfigReq() {}
 
-func (*PostDatabaseForbidden) postDatabaseRes() {}
+// SetConfigUnauthorized is response for SetConfig operation.
+type SetConfigUnauthorized struct{}
 
-// PostDatabaseUnauthorized is response for PostDatabase operation.
-type PostDatabaseUnauthorized struct{}
+func (*SetConfigUnauthorized) setConfigRes() {}
 
-func (*PostDatabaseUnauthorized) postDatabaseRes() {}
+// SetJobsConfigForbidden is response for SetJobsConfig operation.
+type SetJobsConfigForbidden struct{}
 
-type PostJobBadRequest Error
+func (*SetJobsConfigForbidden) setJobsConfigRes() {}
 
-func (*PostJobBadRequest) postJobRes() {}
+// SetJobsConfigOK is response for SetJobsConfig operation.
+type SetJobsConfigOK struct{}
 
-// PostJobForbidden is response for PostJob operation.
-type PostJobForbidden struct{}
+func (*SetJobsConfigOK) setJobsConfigRes() {}
 
-func (*PostJobForbidden) postJobRes() {}
+// SetJobsConfigUnauthorized is response for SetJobsConfig operation.
+type SetJobsConfigUnauthorized struct{}
 
-type PostJobNotFound Error
+func (*SetJobsConfigUnauthorized) setJobsConfigRes() {}
 
-func (*PostJobNotFound) postJobRes() {}
+// ShutdownServerForbidden is response for ShutdownServer operation.
+type ShutdownServerForbidden struct{}
 
-type PostJobReqEmptyBody struct{}
+func (*ShutdownServerForbidden) shutdownServerRes() {}
 
-func (*PostJobReqEmptyBody) postJobReq() {}
+// ShutdownServerUnauthorized is response for ShutdownServer operation.
+type ShutdownServerUnauthorized struct{}
 
-type PostJobReqTextPlain struct {
-	Data io.Reader
-}
+func (*ShutdownServerUnauthorized) shutdownServerRes() {}
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s PostJobReqTextPlain) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// Ref: #/components/schemas/StatusResponse
+type StatusResponse struct {
+	Status OptStatusResponseStatus `json:"Status"`
 }
 
-func (*PostJobReqTextPlain) postJobReq() {}
-
-// PostJobUnauthorized is response for PostJob operation.
-type PostJobUnauthorized struct{}
+// GetStatus returns the value of Status.
+func (s *StatusResponse) GetStatus() OptStatusResponseStatus {
+	return s.Status
+}
 
-func (*PostJobUnauthorized) postJobRes() {}
+// SetStatus sets the value of Status.
+func (s *StatusResponse) SetStatus(val OptStatusResponseStatus) {
+	s.Status = val
+}
 
-// PushLoginSessionForbidden is response for PushLoginSession operation.
-type PushLoginSessionForbidden struct{}
+func (*StatusResponse) cancelUploadRes()       {}
+func (*StatusResponse) createDirectoryRes()    {}
+func (*StatusResponse) deleteFileLocationRes() {}
+func (*StatusResponse) postDatabaseRes()       {}
+func (*StatusResponse) postJobRes()            {}
+func (*StatusResponse) shutdownServerRes()     {}
+func (*StatusResponse) uploadFileRes()         {}
 
-func (*PushLoginSessionForbidden) pushLoginSessionRes() {}
+type StatusResponseStatus struct {
+	Action  OptString  `json:"Action"`
+	Code    OptString  `json:"Code"`
+	Dbid    OptFloat64 `json:"Dbid"`
+	Target  OptString  `json:"Target"`
+	Message OptString  `json:"Message"`
+}
 
-// PushLoginSessionUnauthorized is response for PushLoginSession operation.
-type PushLoginSessionUnauthorized struct{}
+// GetAction returns the value of Action.
+func (s *StatusResponseStatus) GetAction() OptString {
+	return s.Action
+}
 
-func (*PushLoginSessionUnauthorized) pushLoginSessionRes() {}
+// GetCode returns the value of Code.
+func (s *StatusResponseStatus) GetCode() OptString {
+	return s.Code
+}
 
-type RemoveSessionCompatBadRequest Error
+// GetDbid returns the value of Dbid.
+func (s *StatusResponseStatus) GetDbid() OptFloat64 {
+	return s.Dbid
+}
 
-func (*RemoveSessionCompatBadRequest) removeSessionCompatRes() {}
+// GetTarget returns the value of Target.
+func (s *StatusResponseStatus) GetTarget() OptString {
+	return s.Target
+}
 
-type RemoveSessionCompatNotFound Error
+// GetMessage returns the value of Message.
+func (s *StatusResponseStatus) GetMessage() OptString {
+	return s.Message
+}
 
-func (*RemoveSessionCompatNotFound) removeSessionCompatRes() {}
+// SetAction sets the value of Action.
+func (s *StatusResponseStatus) SetAction(val OptString) {
+	s.Action = val
+}
 
-// RemoveSessionCompatOK is response for RemoveSessionCompat operation.
-type RemoveSessionCompatOK struct{}
+// SetCode sets the value of Code.
+func (s *StatusResponseStatus) SetCode(val OptString) {
+	s.Code = val
+}
 
-func (*RemoveSessionCompatOK) removeSessionCompatRes() {}
+// SetDbid sets the value of Dbid.
+func (s *StatusResponseStatus) SetDbid(val OptFloat64) {
+	s.Dbid = val
+}
 
-// Ref: #/components/schemas/Response
-type Response struct {
-	MapName     OptString             `json:"MapName"`
-	FileRecords OptInt                `json:"FileRecords"`
-	NrRecords   OptInt                `json:"NrRecords"`
-	FieldNames  []string              `json:"FieldNames"`
-	Records     []ResponseRecordsItem `json:"Records"`
+// SetTarget sets the value of Target.
+func (s *StatusResponseStatus) SetTarget(val OptString) {
+	s.Target = val
 }
 
-// GetMapName returns the value of MapName.
-func (s *Response) GetMapName() OptString {
-	return s.MapName
+// SetMessage sets the value of Message.
+func (s *StatusResponseStatus) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// GetFileRecords returns the value of FileRecords.
-func (s *Response) GetFileRecords() OptInt {
-	return s.FileRecords
+// StoreConfigForbidden is response for StoreConfig operation.
+type StoreConfigForbidden struct{}
+
+func (*StoreConfigForbidden) storeConfigRes() {}
+
+// StoreConfigOK is response for StoreConfig operation.
+type StoreConfigOK struct {
+	XToken OptString
 }
 
-// GetNrRecords returns the value of NrRecords.
-func (s *Response) GetNrRecords() OptInt {
-	return s.NrRecords
+// GetXToken returns the value of XToken.
+func (s *StoreConfigOK) GetXToken() OptString {
+	return s.XToken
 }
 
-// GetFieldNames returns the value of FieldNames.
-func (s *Response) GetFieldNames() []string {
-	return s.FieldNames
+// SetXToken sets the value of XToken.
+func (s *StoreConfigOK) SetXToken(val OptString) {
+	s.XToken = val
 }
 
-// GetRecords returns the value of Records.
-func (s *Response) GetRecords() []ResponseRecordsItem {
-	return s.Records
+func (*StoreConfigOK) storeConfigRes() {}
+
+// StoreConfigUnauthorized is response for StoreConfig operation.
+type StoreConfigUnauthorized struct{}
+
+func (*StoreConfigUnauthorized) storeConfigRes() {}
+
+// Ref: #/components/schemas/StoreResponse
+type StoreResponse struct {
+	NrStored  OptInt `json:"NrStored"`
+	NrDeleted OptInt `json:"NrDeleted"`
+	Stored    []int  `json:"Stored"`
 }
 
-// SetMapName sets the value of MapName.
-func (s *Response) SetMapName(val OptString) {
-	s.MapName = val
+// GetNrStored returns the value of NrStored.
+func (s *StoreResponse) GetNrStored() OptInt {
+	return s.NrStored
 }
 
-// SetFileRecords sets the value of FileRecords.
-func (s *Response) SetFileRecords(val OptInt) {
-	s.FileRecords = val
+// GetNrDeleted returns the value of NrDeleted.
+func (s *StoreResponse) GetNrDeleted() OptInt {
+	return s.NrDeleted
 }
 
-// SetNrRecords sets the value of NrRecords.
-func (s *Response) SetNrRecords(val OptInt) {
-	s.NrRecords = val
+// GetStored returns the value of Stored.
+func (s *StoreResponse) GetStored() []int {
+	return s.Stored
 }
 
-// SetFieldNames sets the value of FieldNames.
-func (s *Response) SetFieldNames(val []string) {
-	s.FieldNames = val
+// SetNrStored sets the value of NrStored.
+func (s *StoreResponse) SetNrStored(val OptInt) {
+	s.NrStored = val
 }
 
-// SetRecords sets the value of Records.
-func (s *Response) SetRecords(val []ResponseRecordsItem) {
-	s.Records = val
+// SetNrDeleted sets the value of NrDeleted.
+func (s *StoreResponse) SetNrDeleted(val OptInt) {
+	s.NrDeleted = val
 }
 
-func (*Response) searchModellingRes() {}
-func (*Response) searchTableRes()     {}
-func (*Response) triggerJobRes()      {}
+// SetStored sets the value of Stored.
+func (s *StoreResponse) SetStored(val []int) {
+	s.Stored = val
+}
 
-// ResponseHeaders wraps Response with response headers.
-type ResponseHeaders struct {
+// StoreResponseHeaders wraps StoreResponse with response headers.
+type StoreResponseHeaders struct {
 	XToken   OptString
-	Response Response
+	Response StoreResponse
 }
 
 // GetXToken returns the value of XToken.
-func (s *ResponseHeaders) GetXToken() OptString {
+func (s *StoreResponseHeaders) GetXToken() OptString {
 	return s.XToken
 }
 
 // GetResponse returns the value of Response.
-func (s *ResponseHeaders) GetResponse() Response {
+func (s *StoreResponseHeaders) GetResponse() StoreResponse {
 	return s.Response
 }
 
 // SetXToken sets the value of XToken.
-func (s *ResponseHeaders) SetXToken(val OptString) {
+func (s *StoreResponseHeaders) SetXToken(val OptString) {
 	s.XToken = val
 }
 
 // SetResponse sets the value of Response.
-func (s *ResponseHeaders) SetResponse(val Response) {
+func (s *StoreResponseHeaders) SetResponse(val StoreResponse) {
 	s.Response = val
 }
 
-func (*ResponseHeaders) batchParameterQueryRes()   {}
-func (*ResponseHeaders) batchQueryRes()            {}
-func (*ResponseHeaders) batchSelectRes()           {}
-func (*ResponseHeaders) deleteRecordsSearchedRes() {}
-func (*ResponseHeaders) getMapRecordsFieldsRes()   {}
-func (*ResponseHeaders) insertRecordRes()          {}
-func (*ResponseHeaders) searchRecordsFieldsRes()   {}
-func (*ResponseHeaders) updateRecordsByFieldsRes() {}
+func (*StoreResponseHeaders) insertMapFileRecordsRes() {}
 
-// Ref: #/components/schemas/ResponseRaw
-type ResponseRaw map[string]jx.Raw
+// Ref: #/components/schemas/TableColumn
+type TableColumn struct {
+	Name OptString `json:"Name"`
+	// SQL type of the column.
+	Type       OptString `json:"Type"`
+	Length     OptInt    `json:"Length"`
+	Nullable   OptBool   `json:"Nullable"`
+	Default    OptString `json:"Default"`
+	PrimaryKey OptBool   `json:"PrimaryKey"`
+	// Value is generated by the database, like identity or auto increment columns.
+	Generated  OptBool           `json:"Generated"`
+	ForeignKey OptTableReference `json:"ForeignKey"`
+	// Column contains large binary or character objects.
+	Lob OptBool `json:"Lob"`
+	// Column of the same table containing the mimetype of the LOB.
+	MimetypeField OptString `json:"MimetypeField"`
+}
 
-func (s *ResponseRaw) init() ResponseRaw {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// GetName returns the value of Name.
+func (s *TableColumn) GetName() OptString {
+	return s.Name
+}
+
+// GetType returns the value of Type.
+func (s *TableColumn) GetType() OptString {
+	return s.Type
 }
 
-func (*ResponseRaw) callExtendRes()     {}
-func (*ResponseRaw) callPostExtendRes() {}
-func (*ResponseRaw) deleteExtendRes()   {}
-func (*ResponseRaw) triggerExtendRes()  {}
+// GetLength returns the value of Length.
+func (s *TableColumn) GetLength() OptInt {
+	return s.Length
+}
 
-type ResponseRecordsItem map[string]jx.Raw
+// GetNullable returns the value of Nullable.
+func (s *TableColumn) GetNullable() OptBool {
+	return s.Nullable
+}
 
-func (s *ResponseRecordsItem) init() ResponseRecordsItem {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// GetDefault returns the value of Default.
+func (s *TableColumn) GetDefault() OptString {
+	return s.Default
 }
 
-// Ref: #/components/schemas/SQLQuery
-type SQLQuery struct {
-	Batch OptSQLQueryBatch `json:"Batch"`
+// GetPrimaryKey returns the value of PrimaryKey.
+func (s *TableColumn) GetPrimaryKey() OptBool {
+	return s.PrimaryKey
 }
 
-// GetBatch returns the value of Batch.
-func (s *SQLQuery) GetBatch() OptSQLQueryBatch {
-	return s.Batch
+// GetGenerated returns the value of Generated.
+func (s *TableColumn) GetGenerated() OptBool {
+	return s.Generated
 }
 
-// SetBatch sets the value of Batch.
-func (s *SQLQuery) SetBatch(val OptSQLQueryBatch) {
-	s.Batch = val
+// GetForeignKey returns the value of ForeignKey.
+func (s *TableColumn) GetForeignKey() OptTableReference {
+	return s.ForeignKey
 }
 
-func (*SQLQuery) batchQueryReq() {}
+// GetLob returns the value of Lob.
+func (s *TableColumn) GetLob() OptBool {
+	return s.Lob
+}
 
-type SQLQueryBatch struct {
-	SQL OptString `json:"SQL"`
+// GetMimetypeField returns the value of MimetypeField.
+func (s *TableColumn) GetMimetypeField() OptString {
+	return s.MimetypeField
 }
 
-// GetSQL returns the value of SQL.
-func (s *SQLQueryBatch) GetSQL() OptString {
-	return s.SQL
+// SetName sets the value of Name.
+func (s *TableColumn) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetSQL sets the value of SQL.
-func (s *SQLQueryBatch) SetSQL(val OptString) {
-	s.SQL = val
+// SetType sets the value of Type.
+func (s *TableColumn) SetType(val OptString) {
+	s.Type = val
 }
 
-type SearchModellingBadRequest Error
+// SetLength sets the value of Length.
+func (s *TableColumn) SetLength(val OptInt) {
+	s.Length = val
+}
 
-func (*SearchModellingBadRequest) searchModellingRes() {}
+// SetNullable sets the value of Nullable.
+func (s *TableColumn) SetNullable(val OptBool) {
+	s.Nullable = val
+}
 
-// SearchModellingForbidden is response for SearchModelling operation.
-type SearchModellingForbidden struct{}
+// SetDefault sets the value of Default.
+func (s *TableColumn) SetDefault(val OptString) {
+	s.Default = val
+}
 
-func (*SearchModellingForbidden) searchModellingRes() {}
+// SetPrimaryKey sets the value of PrimaryKey.
+func (s *TableColumn) SetPrimaryKey(val OptBool) {
+	s.PrimaryKey = val
+}
 
-type SearchModellingNotFound Error
+// SetGenerated sets the value of Generated.
+func (s *TableColumn) SetGenerated(val OptBool) {
+	s.Generated = val
+}
 
-func (*SearchModellingNotFound) searchModellingRes() {}
+// SetForeignKey sets the value of ForeignKey.
+func (s *TableColumn) SetForeignKey(val OptTableReference) {
+	s.ForeignKey = val
+}
 
-type SearchModellingOKTextCsv struct {
-	Data io.Reader
+// SetLob sets the value of Lob.
+func (s *TableColumn) SetLob(val OptBool) {
+	s.Lob = val
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s SearchModellingOKTextCsv) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetMimetypeField sets the value of MimetypeField.
+func (s *TableColumn) SetMimetypeField(val OptString) {
+	s.MimetypeField = val
 }
 
-func (*SearchModellingOKTextCsv) searchModellingRes() {}
+// Table metadata containing the column definitions.
+// Ref: #/components/schemas/TableMetadata
+type TableMetadata struct {
+	Name    OptString     `json:"Name"`
+	Driver  OptString     `json:"Driver"`
+	Columns []TableColumn `json:"Columns"`
+}
 
-// SearchModellingUnauthorized is response for SearchModelling operation.
-type SearchModellingUnauthorized struct{}
+// GetName returns the value of Name.
+func (s *TableMetadata) GetName() OptString {
+	return s.Name
+}
 
-func (*SearchModellingUnauthorized) searchModellingRes() {}
+// GetDriver returns the value of Driver.
+func (s *TableMetadata) GetDriver() OptString {
+	return s.Driver
+}
 
-// SearchRecordsFieldsForbidden is response for SearchRecordsFields operation.
-type SearchRecordsFieldsForbidden struct{}
+// GetColumns returns the value of Columns.
+func (s *TableMetadata) GetColumns() []TableColumn {
+	return s.Columns
+}
 
-func (*SearchRecordsFieldsForbidden) searchRecordsFieldsRes() {}
+// SetName sets the value of Name.
+func (s *TableMetadata) SetName(val OptString) {
+	s.Name = val
+}
 
-type SearchRecordsFieldsOKTextCsv struct {
-	Data io.Reader
+// SetDriver sets the value of Driver.
+func (s *TableMetadata) SetDriver(val OptString) {
+	s.Driver = val
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s SearchRecordsFieldsOKTextCsv) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetColumns sets the value of Columns.
+func (s *TableMetadata) SetColumns(val []TableColumn) {
+	s.Columns = val
 }
 
-// SearchRecordsFieldsOKTextCsvHeaders wraps SearchRecordsFieldsOKTextCsv with response headers.
-type SearchRecordsFieldsOKTextCsvHeaders struct {
+// TableMetadataHeaders wraps TableMetadata with response headers.
+type TableMetadataHeaders struct {
 	XToken   OptString
-	Response SearchRecordsFieldsOKTextCsv
+	Response TableMetadata
 }
 
 // GetXToken returns the value of XToken.
-func (s *SearchRecordsFieldsOKTextCsvHeaders) GetXToken() OptString {
+func (s *TableMetadataHeaders) GetXToken() OptString {
 	return s.XToken
 }
 
 // GetResponse returns the value of Response.
-func (s *SearchRecordsFieldsOKTextCsvHeaders) GetResponse() SearchRecordsFieldsOKTextCsv {
+func (s *TableMetadataHeaders) GetResponse() TableMetadata {
 	return s.Response
 }
 
 // SetXToken sets the value of XToken.
-func (s *SearchRecordsFieldsOKTextCsvHeaders) SetXToken(val OptString) {
+func (s *TableMetadataHeaders) SetXToken(val OptString) {
 	s.XToken = val
 }
 
 // SetResponse sets the value of Response.
-func (s *SearchRecordsFieldsOKTextCsvHeaders) SetResponse(val SearchRecordsFieldsOKTextCsv) {
+func (s *TableMetadataHeaders) SetResponse(val TableMetadata) {
 	s.Response = val
 }
 
-func (*SearchRecordsFieldsOKTextCsvHeaders) searchRecordsFieldsRes() {}
+func (*TableMetadataHeaders) getFieldsRes()      {}
+func (*TableMetadataHeaders) getMapMetadataRes() {}
 
-// SearchRecordsFieldsUnauthorized is response for SearchRecordsFields operation.
-type SearchRecordsFieldsUnauthorized struct{}
+// Ref: #/components/schemas/TableReference
+type TableReference struct {
+	Table  OptString `json:"Table"`
+	Column OptString `json:"Column"`
+}
 
-func (*SearchRecordsFieldsUnauthorized) searchRecordsFieldsRes() {}
+// GetTable returns the value of Table.
+func (s *TableReference) GetTable() OptString {
+	return s.Table
+}
 
-type SearchTableBadRequest Error
+// GetColumn returns the value of Column.
+func (s *TableReference) GetColumn() OptString {
+	return s.Column
+}
 
-func (*SearchTableBadRequest) searchTableRes() {}
+// SetTable sets the value of Table.
+func (s *TableReference) SetTable(val OptString) {
+	s.Table = val
+}
 
-// SearchTableForbidden is response for SearchTable operation.
-type SearchTableForbidden struct{}
+// SetColumn sets the value of Column.
+func (s *TableReference) SetColumn(val OptString) {
+	s.Column = val
+}
 
-func (*SearchTableForbidden) searchTableRes() {}
+// Metadata of all tables accessible by the user.
+// Ref: #/components/schemas/TablesMetadata
+type TablesMetadata struct {
+	Tables []TableMetadata `json:"Tables"`
+}
 
-type SearchTableNotFound Error
+// GetTables returns the value of Tables.
+func (s *TablesMetadata) GetTables() []TableMetadata {
+	return s.Tables
+}
 
-func (*SearchTableNotFound) searchTableRes() {}
+// SetTables sets the value of Tables.
+func (s *TablesMetadata) SetTables(val []TableMetadata) {
+	s.Tables = val
+}
 
-type SearchTableOKTextCsv struct {
-	Data io.Reader
+func (*TablesMetadata) listModellingRes() {}
+func (*TablesMetadata) listTablesRes()    {}
+
+type TokenCheck struct {
+	APIKey string
+	Roles  []string
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s SearchTableOKTextCsv) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// GetAPIKey returns the value of APIKey.
+func (s *TokenCheck) GetAPIKey() string {
+	return s.APIKey
 }
 
-func (*SearchTableOKTextCsv) searchTableRes() {}
+// GetRoles returns the value of Roles.
+func (s *TokenCheck) GetRoles() []string {
+	return s.Roles
+}
 
-// SearchTableUnauthorized is response for SearchTable operation.
-type SearchTableUnauthorized struct{}
+// SetAPIKey sets the value of APIKey.
+func (s *TokenCheck) SetAPIKey(val string) {
+	s.APIKey = val
+}
 
-func (*SearchTableUnauthorized) searchTableRes() {}
+// SetRoles sets the value of Roles.
+func (s *TokenCheck) SetRoles(val []string) {
+	s.Roles = val
+}
 
-// SetConfigForbidden is response for SetConfig operation.
-type SetConfigForbidden struct{}
+// Ordered list of operations executed in one transaction.
+// Ref: #/components/schemas/Transaction
+type Transaction struct {
+	Operations []TransactionOperation `json:"Operations"`
+	// Execute all operations and roll back, updates and deletes return a confirm token.
+	DryRun OptBool `json:"DryRun"`
+}
 
-func (*SetConfigForbidden) setConfigRes() {}
+// GetOperations returns the value of Operations.
+func (s *Transaction) GetOperations() []TransactionOperation {
+	return s.Operations
+}
 
-// SetConfigOK is response for SetConfig operation.
-type SetConfigOK struct {
-	XToken OptString
+// GetDryRun returns the value of DryRun.
+func (s *Transaction) GetDryRun() OptBool {
+	return s.DryRun
 }
 
-// GetXToken returns the value of XToken.
-func (s *SetConfigOK) GetXToken() OptString {
-	return s.XToken
+// SetOperations sets the value of Operations.
+func (s *Transaction) SetOperations(val []TransactionOperation) {
+	s.Operations = val
 }
 
-// SetXToken sets the value of XToken.
-func (s *SetConfigOK) SetXToken(val OptString) {
-	s.XToken = val
+// SetDryRun sets the value of DryRun.
+func (s *Transaction) SetDryRun(val OptBool) {
+	s.DryRun = val
 }
 
-func (*SetConfigOK) setConfigRes() {}
+// Ref: #/components/schemas/TransactionOperation
+type TransactionOperation struct {
+	Action TransactionOperationAction `json:"Action"`
+	Table  string                     `json:"Table"`
+	// Records to insert or update. A string value like ${0.id} references the returned field id of the
+	// first record of operation 0, ${0.2.id} of the third record.
+	Records []TransactionOperationRecordsItem `json:"Records"`
+	// Comma separated key fields used to search the records to update.
+	Update OptString `json:"Update"`
+	// Search of the records to delete.
+	Search OptString `json:"Search"`
+	// Comma separated fields returned by an insert.
+	Returning OptString `json:"Returning"`
+	// Expected ETag of the record updated on a versioned table, needs exactly one record.
+	IfMatch OptString `json:"IfMatch"`
+	// Confirm token of a dry run to exceed the affected records limit of the table.
+	Confirm OptString `json:"Confirm"`
+}
 
-type SetConfigReqTextPlain struct {
-	Data io.Reader
+// GetAction returns the value of Action.
+func (s *TransactionOperation) GetAction() TransactionOperationAction {
+	return s.Action
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s SetConfigReqTextPlain) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// GetTable returns the value of Table.
+func (s *TransactionOperation) GetTable() string {
+	return s.Table
 }
 
-func (*SetConfigReqTextPlain) setConfigReq() {}
+// GetRecords returns the value of Records.
+func (s *TransactionOperation) GetRecords() []TransactionOperationRecordsItem {
+	return s.Records
+}
 
-// SetConfigUnauthorized is response for SetConfig operation.
-type SetConfigUnauthorized struct{}
+// GetUpdate returns the value of Update.
+func (s *TransactionOperation) GetUpdate() OptString {
+	return s.Update
+}
 
-func (*SetConfigUnauthorized) setConfigRes() {}
+// GetSearch returns the value of Search.
+func (s *TransactionOperation) GetSearch() OptString {
+	return s.Search
+}
 
-// SetJobsConfigForbidden is response for SetJobsConfig operation.
-type SetJobsConfigForbidden struct{}
+// GetReturning returns the value of Returning.
+func (s *TransactionOperation) GetReturning() OptString {
+	return s.Returning
+}
 
-func (*SetJobsConfigForbidden) setJobsConfigRes() {}
+// GetIfMatch returns the value of IfMatch.
+func (s *TransactionOperation) GetIfMatch() OptString {
+	return s.IfMatch
+}
 
-// SetJobsConfigOK is response for SetJobsConfig operation.
-type SetJobsConfigOK struct{}
+// GetConfirm returns the value of Confirm.
+func (s *TransactionOperation) GetConfirm() OptString {
+	return s.Confirm
+}
 
-func (*SetJobsConfigOK) setJobsConfigRes() {}
+// SetAction sets the value of Action.
+func (s *TransactionOperation) SetAction(val TransactionOperationAction) {
+	s.Action = val
+}
 
-// SetJobsConfigUnauthorized is response for SetJobsConfig operation.
-type SetJobsConfigUnauthorized struct{}
+// SetTable sets the value of Table.
+func (s *TransactionOperation) SetTable(val string) {
+	s.Table = val
+}
 
-func (*SetJobsConfigUnauthorized) setJobsConfigRes() {}
+// SetRecords sets the value of Records.
+func (s *TransactionOperation) SetRecords(val []TransactionOperationRecordsItem) {
+	s.Records = val
+}
 
-// ShutdownServerForbidden is response for ShutdownServer operation.
-type ShutdownServerForbidden struct{}
+// SetUpdate sets the value of Update.
+func (s *TransactionOperation) SetUpdate(val OptString) {
+	s.Update = val
+}
 
-func (*ShutdownServerForbidden) shutdownServerRes() {}
+// SetSearch sets the value of Search.
+func (s *TransactionOperation) SetSearch(val OptString) {
+	s.Search = val
+}
 
-// ShutdownServerUnauthorized is response for ShutdownServer operation.
-type ShutdownServerUnauthorized struct{}
+// SetReturning sets the value of Returning.
+func (s *TransactionOperation) SetReturning(val OptString) {
+	s.Returning = val
+}
 
-func (*ShutdownServerUnauthorized) shutdownServerRes() {}
+// SetIfMatch sets the value of IfMatch.
+func (s *TransactionOperation) SetIfMatch(val OptString) {
+	s.IfMatch = val
+}
 
-// Ref: #/components/schemas/StatusResponse
-type StatusResponse struct {
-	Status OptStatusResponseStatus `json:"Status"`
+// SetConfirm sets the value of Confirm.
+func (s *TransactionOperation) SetConfirm(val OptString) {
+	s.Confirm = val
 }
 
-// GetStatus returns the value of Status.
-func (s *StatusResponse) GetStatus() OptStatusResponseStatus {
-	return s.Status
+type TransactionOperationAction string
+
+const (
+	TransactionOperationActionInsert TransactionOperationAction = "insert"
+	TransactionOperationActionUpdate TransactionOperationAction = "update"
+	TransactionOperationActionDelete TransactionOperationAction = "delete"
+)
+
+// AllValues returns all TransactionOperationAction values.
+func (TransactionOperationAction) AllValues() []TransactionOperationAction {
+	return []TransactionOperationAction{
+		TransactionOperationActionInsert,
+		TransactionOperationActionUpdate,
+		TransactionOperationActionDelete,
+	}
 }
 
-// SetStatus sets the value of Status.
-func (s *StatusResponse) SetStatus(val OptStatusResponseStatus) {
-	s.Status = val
+// MarshalText implements encoding.TextMarshaler.
+func (s TransactionOperationAction) MarshalText() ([]byte, error) {
+	switch s {
+	case TransactionOperationActionInsert:
+		return []byte(s), nil
+	case TransactionOperationActionUpdate:
+		return []byte(s), nil
+	case TransactionOperationActionDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-func (*StatusResponse) createDirectoryRes()    {}
-func (*StatusResponse) deleteFileLocationRes() {}
-func (*StatusResponse) postDatabaseRes()       {}
-func (*StatusResponse) postJobRes()            {}
-func (*StatusResponse) shutdownServerRes()     {}
-func (*StatusResponse) uploadFileRes()         {}
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TransactionOperationAction) UnmarshalText(data []byte) error {
+	switch TransactionOperationAction(data) {
+	case TransactionOperationActionInsert:
+		*s = TransactionOperationActionInsert
+		return nil
+	case TransactionOperationActionUpdate:
+		*s = TransactionOperationActionUpdate
+		return nil
+	case TransactionOperationActionDelete:
+		*s = TransactionOperationActionDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-type StatusResponseStatus struct {
-	Action  OptString  `json:"Action"`
-	Code    OptString  `json:"Code"`
-	Dbid    OptFloat64 `json:"Dbid"`
-	Target  OptString  `json:"Target"`
-	Message OptString  `json:"Message"`
+type TransactionOperationRecordsItem map[string]jx.Raw
+
+func (s *TransactionOperationRecordsItem) init() TransactionOperationRecordsItem {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/TransactionOperationResult
+type TransactionOperationResult struct {
+	Action    OptString `json:"Action"`
+	Table     OptString `json:"Table"`
+	NrRecords OptInt    `json:"NrRecords"`
+	// Token to confirm the operation after a dry run.
+	ConfirmToken OptString `json:"ConfirmToken"`
+	// Limit of affected records of the table.
+	MaxAffectedRows OptInt                                  `json:"MaxAffectedRows"`
+	Records         []TransactionOperationResultRecordsItem `json:"Records"`
 }
 
 // GetAction returns the value of Action.
-func (s *StatusResponseStatus) GetAction() OptString {
+func (s *TransactionOperationResult) GetAction() OptString {
 	return s.Action
 }
 
-// GetCode returns the value of Code.
-func (s *StatusResponseStatus) GetCode() OptString {
-	return s.Code
+// GetTable returns the value of Table.
+func (s *TransactionOperationResult) GetTable() OptString {
+	return s.Table
+}
+
+// GetNrRecords returns the value of NrRecords.
+func (s *TransactionOperationResult) GetNrRecords() OptInt {
+	return s.NrRecords
 }
 
-// GetDbid returns the value of Dbid.
-func (s *StatusResponseStatus) GetDbid() OptFloat64 {
-	return s.Dbid
+// GetConfirmToken returns the value of ConfirmToken.
+func (s *TransactionOperationResult) GetConfirmToken() OptString {
+	return s.ConfirmToken
 }
 
-// GetTarget returns the value of Target.
-func (s *StatusResponseStatus) GetTarget() OptString {
-	return s.Target
+// GetMaxAffectedRows returns the value of MaxAffectedRows.
+func (s *TransactionOperationResult) GetMaxAffectedRows() OptInt {
+	return s.MaxAffectedRows
 }
 
-// GetMessage returns the value of Message.
-func (s *StatusResponseStatus) GetMessage() OptString {
-	return s.Message
+// GetRecords returns the value of Records.
+func (s *TransactionOperationResult) GetRecords() []TransactionOperationResultRecordsItem {
+	return s.Records
 }
 
 // SetAction sets the value of Action.
-func (s *StatusResponseStatus) SetAction(val OptString) {
+func (s *TransactionOperationResult) SetAction(val OptString) {
 	s.Action = val
 }
 
-// SetCode sets the value of Code.
-func (s *StatusResponseStatus) SetCode(val OptString) {
-	s.Code = val
+// SetTable sets the value of Table.
+func (s *TransactionOperationResult) SetTable(val OptString) {
+	s.Table = val
 }
 
-// SetDbid sets the value of Dbid.
-func (s *StatusResponseStatus) SetDbid(val OptFloat64) {
-	s.Dbid = val
+// SetNrRecords sets the value of NrRecords.
+func (s *TransactionOperationResult) SetNrRecords(val OptInt) {
+	s.NrRecords = val
 }
 
-// SetTarget sets the value of Target.
-func (s *StatusResponseStatus) SetTarget(val OptString) {
-	s.Target = val
+// SetConfirmToken sets the value of ConfirmToken.
+func (s *TransactionOperationResult) SetConfirmToken(val OptString) {
+	s.ConfirmToken = val
 }
 
-// SetMessage sets the value of Message.
-func (s *StatusResponseStatus) SetMessage(val OptString) {
-	s.Message = val
+// SetMaxAffectedRows sets the value of MaxAffectedRows.
+func (s *TransactionOperationResult) SetMaxAffectedRows(val OptInt) {
+	s.MaxAffectedRows = val
 }
 
-// StoreConfigForbidden is response for StoreConfig operation.
-type StoreConfigForbidden struct{}
+// SetRecords sets the value of Records.
+func (s *TransactionOperationResult) SetRecords(val []TransactionOperationResultRecordsItem) {
+	s.Records = val
+}
 
-func (*StoreConfigForbidden) storeConfigRes() {}
+type TransactionOperationResultRecordsItem map[string]jx.Raw
 
-// StoreConfigOK is response for StoreConfig operation.
-type StoreConfigOK struct {
-	XToken OptString
+func (s *TransactionOperationResultRecordsItem) init() TransactionOperationResultRecordsItem {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// GetXToken returns the value of XToken.
-func (s *StoreConfigOK) GetXToken() OptString {
-	return s.XToken
+// Ref: #/components/schemas/TransactionResult
+type TransactionResult struct {
+	Committed OptBool `json:"Committed"`
+	// Operations were rolled back after a dry run.
+	DryRun OptBool `json:"DryRun"`
+	// Index of the operation which failed.
+	FailedOperation OptInt                       `json:"FailedOperation"`
+	Error           OptError                     `json:"Error"`
+	Results         []TransactionOperationResult `json:"Results"`
 }
 
-// SetXToken sets the value of XToken.
-func (s *StoreConfigOK) SetXToken(val OptString) {
-	s.XToken = val
+// GetCommitted returns the value of Committed.
+func (s *TransactionResult) GetCommitted() OptBool {
+	return s.Committed
 }
 
-func (*StoreConfigOK) storeConfigRes() {}
-
-// StoreConfigUnauthorized is response for StoreConfig operation.
-type StoreConfigUnauthorized struct{}
-
-func (*StoreConfigUnauthorized) storeConfigRes() {}
+// GetDryRun returns the value of DryRun.
+func (s *TransactionResult) GetDryRun() OptBool {
+	return s.DryRun
+}
 
-// Ref: #/components/schemas/StoreResponse
-type StoreResponse struct {
-	NrStored  OptInt `json:"NrStored"`
-	NrDeleted OptInt `json:"NrDeleted"`
-	Stored    []int  `json:"Stored"`
+// GetFailedOperation returns the value of FailedOperation.
+func (s *TransactionResult) GetFailedOperation() OptInt {
+	return s.FailedOperation
 }
 
-// GetNrStored returns the value of NrStored.
-func (s *StoreResponse) GetNrStored() OptInt {
-	return s.NrStored
+// GetError returns the value of Error.
+func (s *TransactionResult) GetError() OptError {
+	return s.Error
 }
 
-// GetNrDeleted returns the value of NrDeleted.
-func (s *StoreResponse) GetNrDeleted() OptInt {
-	return s.NrDeleted
+// GetResults returns the value of Results.
+func (s *TransactionResult) GetResults() []TransactionOperationResult {
+	return s.Results
 }
 
-// GetStored returns the value of Stored.
-func (s *StoreResponse) GetStored() []int {
-	return s.Stored
+// SetCommitted sets the value of Committed.
+func (s *TransactionResult) SetCommitted(val OptBool) {
+	s.Committed = val
 }
 
-// SetNrStored sets the value of NrStored.
-func (s *StoreResponse) SetNrStored(val OptInt) {
-	s.NrStored = val
+// SetDryRun sets the value of DryRun.
+func (s *TransactionResult) SetDryRun(val OptBool) {
+	s.DryRun = val
 }
 
-// SetNrDeleted sets the value of NrDeleted.
-func (s *StoreResponse) SetNrDeleted(val OptInt) {
-	s.NrDeleted = val
+// SetFailedOperation sets the value of FailedOperation.
+func (s *TransactionResult) SetFailedOperation(val OptInt) {
+	s.FailedOperation = val
 }
 
-// SetStored sets the value of Stored.
-func (s *StoreResponse) SetStored(val []int) {
-	s.Stored = val
+// SetError sets the value of Error.
+func (s *TransactionResult) SetError(val OptError) {
+	s.Error = val
 }
 
-func (*StoreResponse) updateLobByMapRes() {}
+// SetResults sets the value of Results.
+func (s *TransactionResult) SetResults(val []TransactionOperationResult) {
+	s.Results = val
+}
 
-// StoreResponseHeaders wraps StoreResponse with response headers.
-type StoreResponseHeaders struct {
+// TransactionResultHeaders wraps TransactionResult with response headers.
+type TransactionResultHeaders struct {
 	XToken   OptString
-	Response StoreResponse
+	Response TransactionResult
 }
 
 // GetXToken returns the value of XToken.
-func (s *StoreResponseHeaders) GetXToken() OptString {
+func (s *TransactionResultHeaders) GetXToken() OptString {
 	return s.XToken
 }
 
 // GetResponse returns the value of Response.
-func (s *StoreResponseHeaders) GetResponse() StoreResponse {
+func (s *TransactionResultHeaders) GetResponse() TransactionResult {
 	return s.Response
 }
 
 // SetXToken sets the value of XToken.
-func (s *StoreResponseHeaders) SetXToken(val OptString) {
+func (s *TransactionResultHeaders) SetXToken(val OptString) {
 	s.XToken = val
 }
 
 // SetResponse sets the value of Response.
-func (s *StoreResponseHeaders) SetResponse(val StoreResponse) {
+func (s *TransactionResultHeaders) SetResponse(val TransactionResult) {
 	s.Response = val
 }
 
-func (*StoreResponseHeaders) insertMapFileRecordsRes() {}
-
-type TokenCheck struct {
-	APIKey string
-	Roles  []string
-}
-
-// GetAPIKey returns the value of APIKey.
-func (s *TokenCheck) GetAPIKey() string {
-	return s.APIKey
-}
-
-// GetRoles returns the value of Roles.
-func (s *TokenCheck) GetRoles() []string {
-	return s.Roles
-}
-
-// SetAPIKey sets the value of APIKey.
-func (s *TokenCheck) SetAPIKey(val string) {
-	s.APIKey = val
-}
-
-// SetRoles sets the value of Roles.
-func (s *TokenCheck) SetRoles(val []string) {
-	s.Roles = val
-}
-
 type TriggerExtendBadRequest Error
 
 func (*TriggerExtendBadRequest) triggerExtendRes() {}
@@ -5591,11 +8598,93 @@ type TriggerJobUnauthorized struct{}
 
 func (*TriggerJobUnauthorized) triggerJobRes() {}
 
+type UpdateImageHashesAlgorithm string
+
+const (
+	UpdateImageHashesAlgorithmAhash UpdateImageHashesAlgorithm = "ahash"
+	UpdateImageHashesAlgorithmDhash UpdateImageHashesAlgorithm = "dhash"
+	UpdateImageHashesAlgorithmPhash UpdateImageHashesAlgorithm = "phash"
+)
+
+// AllValues returns all UpdateImageHashesAlgorithm values.
+func (UpdateImageHashesAlgorithm) AllValues() []UpdateImageHashesAlgorithm {
+	return []UpdateImageHashesAlgorithm{
+		UpdateImageHashesAlgorithmAhash,
+		UpdateImageHashesAlgorithmDhash,
+		UpdateImageHashesAlgorithmPhash,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdateImageHashesAlgorithm) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdateImageHashesAlgorithmAhash:
+		return []byte(s), nil
+	case UpdateImageHashesAlgorithmDhash:
+		return []byte(s), nil
+	case UpdateImageHashesAlgorithmPhash:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdateImageHashesAlgorithm) UnmarshalText(data []byte) error {
+	switch UpdateImageHashesAlgorithm(data) {
+	case UpdateImageHashesAlgorithmAhash:
+		*s = UpdateImageHashesAlgorithmAhash
+		return nil
+	case UpdateImageHashesAlgorithmDhash:
+		*s = UpdateImageHashesAlgorithmDhash
+		return nil
+	case UpdateImageHashesAlgorithmPhash:
+		*s = UpdateImageHashesAlgorithmPhash
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// UpdateImageHashesForbidden is response for UpdateImageHashes operation.
+type UpdateImageHashesForbidden struct{}
+
+func (*UpdateImageHashesForbidden) updateImageHashesRes() {}
+
+// UpdateImageHashesUnauthorized is response for UpdateImageHashes operation.
+type UpdateImageHashesUnauthorized struct {
+	WwwAuthenticate OptString
+}
+
+// GetWwwAuthenticate returns the value of WwwAuthenticate.
+func (s *UpdateImageHashesUnauthorized) GetWwwAuthenticate() OptString {
+	return s.WwwAuthenticate
+}
+
+// SetWwwAuthenticate sets the value of WwwAuthenticate.
+func (s *UpdateImageHashesUnauthorized) SetWwwAuthenticate(val OptString) {
+	s.WwwAuthenticate = val
+}
+
+func (*UpdateImageHashesUnauthorized) updateImageHashesRes() {}
+
+type UpdateLobByMapBadRequest Error
+
+func (*UpdateLobByMapBadRequest) updateLobByMapRes() {}
+
+type UpdateLobByMapConflict Error
+
+func (*UpdateLobByMapConflict) updateLobByMapRes() {}
+
 // UpdateLobByMapForbidden is response for UpdateLobByMap operation.
 type UpdateLobByMapForbidden struct{}
 
 func (*UpdateLobByMapForbidden) updateLobByMapRes() {}
 
+type UpdateLobByMapNotFound Error
+
+func (*UpdateLobByMapNotFound) updateLobByMapRes() {}
+
 type UpdateLobByMapReqApplicationOctetStream struct {
 	Data io.Reader
 }
@@ -5629,16 +8718,36 @@ func (s *UpdateLobByMapReqMultipartFormData) SetUploadLob(val ht.MultipartFile)
 
 func (*UpdateLobByMapReqMultipartFormData) updateLobByMapReq() {}
 
+type UpdateLobByMapRequestEntityTooLarge Error
+
+func (*UpdateLobByMapRequestEntityTooLarge) updateLobByMapRes() {}
+
 // UpdateLobByMapUnauthorized is response for UpdateLobByMap operation.
 type UpdateLobByMapUnauthorized struct{}
 
 func (*UpdateLobByMapUnauthorized) updateLobByMapRes() {}
 
+type UpdateRecordsByFieldsBadRequest Error
+
+func (*UpdateRecordsByFieldsBadRequest) updateRecordsByFieldsRes() {}
+
+type UpdateRecordsByFieldsConflict Response
+
+func (*UpdateRecordsByFieldsConflict) updateRecordsByFieldsRes() {}
+
 // UpdateRecordsByFieldsForbidden is response for UpdateRecordsByFields operation.
 type UpdateRecordsByFieldsForbidden struct{}
 
 func (*UpdateRecordsByFieldsForbidden) updateRecordsByFieldsRes() {}
 
+type UpdateRecordsByFieldsNotFound Error
+
+func (*UpdateRecordsByFieldsNotFound) updateRecordsByFieldsRes() {}
+
+type UpdateRecordsByFieldsPreconditionFailed Response
+
+func (*UpdateRecordsByFieldsPreconditionFailed) updateRecordsByFieldsRes() {}
+
 type UpdateRecordsByFieldsReq struct {
 	Records []UpdateRecordsByFieldsReqRecordsItem `json:"Records"`
 }
@@ -5669,6 +8778,42 @@ type UpdateRecordsByFieldsUnauthorized struct{}
 
 func (*UpdateRecordsByFieldsUnauthorized) updateRecordsByFieldsRes() {}
 
+type UploadChunkBadRequest Error
+
+func (*UploadChunkBadRequest) uploadChunkRes() {}
+
+type UploadChunkConflict Error
+
+func (*UploadChunkConflict) uploadChunkRes() {}
+
+// UploadChunkForbidden is response for UploadChunk operation.
+type UploadChunkForbidden struct{}
+
+func (*UploadChunkForbidden) uploadChunkRes() {}
+
+type UploadChunkNotFound Error
+
+func (*UploadChunkNotFound) uploadChunkRes() {}
+
+type UploadChunkReq struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s UploadChunkReq) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// UploadChunkUnauthorized is response for UploadChunk operation.
+type UploadChunkUnauthorized struct{}
+
+func (*UploadChunkUnauthorized) uploadChunkRes() {}
+
 type UploadFileBadRequest Error
 
 func (*UploadFileBadRequest) uploadFileRes() {}
@@ -5718,6 +8863,130 @@ type UploadFileUnauthorized struct{}
 
 func (*UploadFileUnauthorized) uploadFileRes() {}
 
+// Ref: #/components/schemas/UploadStatus
+type UploadStatus struct {
+	ID OptString `json:"ID"`
+	// Name of the file location.
+	Location OptString `json:"Location"`
+	// Path of the file in the location.
+	File   OptString `json:"File"`
+	Length OptInt64  `json:"Length"`
+	// Number of bytes received.
+	Offset   OptInt64 `json:"Offset"`
+	Complete OptBool  `json:"Complete"`
+	// Time the partial upload is removed.
+	Expires OptDateTime `json:"Expires"`
+}
+
+// GetID returns the value of ID.
+func (s *UploadStatus) GetID() OptString {
+	return s.ID
+}
+
+// GetLocation returns the value of Location.
+func (s *UploadStatus) GetLocation() OptString {
+	return s.Location
+}
+
+// GetFile returns the value of File.
+func (s *UploadStatus) GetFile() OptString {
+	return s.File
+}
+
+// GetLength returns the value of Length.
+func (s *UploadStatus) GetLength() OptInt64 {
+	return s.Length
+}
+
+// GetOffset returns the value of Offset.
+func (s *UploadStatus) GetOffset() OptInt64 {
+	return s.Offset
+}
+
+// GetComplete returns the value of Complete.
+func (s *UploadStatus) GetComplete() OptBool {
+	return s.Complete
+}
+
+// GetExpires returns the value of Expires.
+func (s *UploadStatus) GetExpires() OptDateTime {
+	return s.Expires
+}
+
+// SetID sets the value of ID.
+func (s *UploadStatus) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetLocation sets the value of Location.
+func (s *UploadStatus) SetLocation(val OptString) {
+	s.Location = val
+}
+
+// SetFile sets the value of File.
+func (s *UploadStatus) SetFile(val OptString) {
+	s.File = val
+}
+
+// SetLength sets the value of Length.
+func (s *UploadStatus) SetLength(val OptInt64) {
+	s.Length = val
+}
+
+// SetOffset sets the value of Offset.
+func (s *UploadStatus) SetOffset(val OptInt64) {
+	s.Offset = val
+}
+
+// SetComplete sets the value of Complete.
+func (s *UploadStatus) SetComplete(val OptBool) {
+	s.Complete = val
+}
+
+// SetExpires sets the value of Expires.
+func (s *UploadStatus) SetExpires(val OptDateTime) {
+	s.Expires = val
+}
+
+// UploadStatusHeaders wraps UploadStatus with response headers.
+type UploadStatusHeaders struct {
+	Location     OptString
+	UploadOffset OptInt64
+	Response     UploadStatus
+}
+
+// GetLocation returns the value of Location.
+func (s *UploadStatusHeaders) GetLocation() OptString {
+	return s.Location
+}
+
+// GetUploadOffset returns the value of UploadOffset.
+func (s *UploadStatusHeaders) GetUploadOffset() OptInt64 {
+	return s.UploadOffset
+}
+
+// GetResponse returns the value of Response.
+func (s *UploadStatusHeaders) GetResponse() UploadStatus {
+	return s.Response
+}
+
+// SetLocation sets the value of Location.
+func (s *UploadStatusHeaders) SetLocation(val OptString) {
+	s.Location = val
+}
+
+// SetUploadOffset sets the value of UploadOffset.
+func (s *UploadStatusHeaders) SetUploadOffset(val OptInt64) {
+	s.UploadOffset = val
+}
+
+// SetResponse sets the value of Response.
+func (s *UploadStatusHeaders) SetResponse(val UploadStatus) {
+	s.Response = val
+}
+
+func (*UploadStatusHeaders) createUploadRes() {}
+
 // Ref: #/components/schemas/User
 type User struct {
 	Email      OptString   `json:"email"`
@@ -21,7 +21,7 @@ type SecurityHandler interface {
 	HandleBearerAuth(ctx context.Context, operationName OperationName, t BearerAuth) (context.Context, error)
 	// HandleTokenCheck handles tokenCheck security.
 	// HTTP Basic Authentication. Works over `HTTP` and `HTTPS`.
-	HandleTokenCheck(ctx context.Context, operationName string, t TokenCheck) (context.Context, error)
+	HandleTokenCheck(ctx context.Context, operationName OperationName, t TokenCheck) (context.Context, error)
 	// Request call after receiving
 	Request(ctx context.Context, req *http.Request)
 }
@@ -41,8 +41,10 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 	return "", false
 }
 
+// operationRolesBasicAuth is a private map storing roles per operation.
 var operationRolesBasicAuth = map[string][]string{
 	AddViewOperation:               []string{},
+	AggregateRecordsOperation:      []string{},
 	BatchParameterQueryOperation:   []string{},
 	BatchQueryOperation:            []string{},
 	BatchSelectOperation:           []string{},
@@ -50,17 +52,26 @@ var operationRolesBasicAuth = map[string][]string{
 	BrowseLocationOperation:        []string{},
 	CallExtendOperation:            []string{},
 	CallPostExtendOperation:        []string{},
+	CancelUploadOperation:          []string{},
 	CreateDirectoryOperation:       []string{},
+	CreateUploadOperation:          []string{},
 	DeleteExtendOperation:          []string{},
 	DeleteFileLocationOperation:    []string{},
 	DeleteJobResultOperation:       []string{},
+	DeleteRecordByKeyOperation:     []string{},
 	DeleteRecordsSearchedOperation: []string{},
 	DeleteViewOperation:            []string{},
 	DownloadFileOperation:          []string{},
+	ExecuteTransactionOperation:    []string{},
+	GetArchiveOperation:            []string{},
 	GetConfigOperation:             []string{},
 	GetDatabasesOperation:          []string{},
 	GetFieldsOperation:             []string{},
 	GetImageOperation:              []string{},
+	GetImageCacheStatsOperation:    []string{},
+	GetImageDuplicatesOperation:    []string{},
+	GetImageHashOperation:          []string{},
+	GetImageMetadataOperation:      []string{},
 	GetJobExecutionResultOperation: []string{},
 	GetJobFullInfoOperation:        []string{},
 	GetJobResultOperation:          []string{},
@@ -71,16 +82,23 @@ var operationRolesBasicAuth = map[string][]string{
 	GetMapMetadataOperation:        []string{},
 	GetMapRecordsFieldsOperation:   []string{},
 	GetMapsOperation:               []string{},
+	GetRecordByKeyOperation:        []string{},
+	GetRecordHistoryOperation:      []string{},
+	GetUploadOperation:             []string{},
 	GetVideoOperation:              []string{},
 	GetViewsOperation:              []string{},
+	HeadMapRecordsFieldsOperation:  []string{},
+	ImportRecordsOperation:         []string{},
 	InsertMapFileRecordsOperation:  []string{},
 	InsertRecordOperation:          []string{},
 	ListModellingOperation:         []string{},
 	ListTablesOperation:            []string{},
 	LoginSessionOperation:          []string{},
 	LogoutSessionCompatOperation:   []string{},
+	PatchRecordByKeyOperation:      []string{},
 	PostDatabaseOperation:          []string{},
 	PostJobOperation:               []string{},
+	PurgeImageCacheOperation:       []string{},
 	PushLoginSessionOperation:      []string{},
 	RemoveSessionCompatOperation:   []string{},
 	SearchModellingOperation:       []string{},
@@ -92,39 +110,42 @@ var operationRolesBasicAuth = map[string][]string{
 	StoreConfigOperation:           []string{},
 	TriggerExtendOperation:         []string{},
 	TriggerJobOperation:            []string{},
+	UpdateImageHashesOperation:     []string{},
 	UpdateLobByMapOperation:        []string{},
 	UpdateRecordsByFieldsOperation: []string{},
+	UploadChunkOperation:           []string{},
 	UploadFileOperation:            []string{},
 }
 
-func (s *Server) securityBasicAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
-	var t BasicAuth
-	if _, ok := findAuthorization(req.Header, "Basic"); !ok {
-		return ctx, false, nil
-	}
-	username, password, ok := req.BasicAuth()
+// GetRolesForBasicAuth returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForBasicAuth(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForBasicAuth(operation string) []string {
+	roles, ok := operationRolesBasicAuth[operation]
 	if !ok {
-		return nil, false, errors.New("invalid basic auth")
-	}
-	t.Username = username
-	t.Password = password
-	t.Roles = operationRolesBasicAuth[operationName]
-	rctx, err := s.sec.HandleBasicAuth(ctx, operationName, t)
-	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
-		return nil, false, nil
-	} else if err != nil {
-		return nil, false, err
+		return nil
 	}
-	// CLU TKN addition
-	s.sec.Request(rctx, req)
-
-	return rctx, true, err
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
 }
 
+// operationRolesBearerAuth is a private map storing roles per operation.
 var operationRolesBearerAuth = map[string][]string{
 	AddViewOperation: []string{
 		"admin",
 	},
+	AggregateRecordsOperation: []string{
+		"user",
+	},
 	BatchParameterQueryOperation: []string{
 		"admin",
 	},
@@ -146,9 +167,15 @@ var operationRolesBearerAuth = map[string][]string{
 	CallPostExtendOperation: []string{
 		"admin",
 	},
+	CancelUploadOperation: []string{
+		"admin",
+	},
 	CreateDirectoryOperation: []string{
 		"admin",
 	},
+	CreateUploadOperation: []string{
+		"admin",
+	},
 	DeleteExtendOperation: []string{
 		"admin",
 	},
@@ -158,6 +185,9 @@ var operationRolesBearerAuth = map[string][]string{
 	DeleteJobResultOperation: []string{
 		"admin",
 	},
+	DeleteRecordByKeyOperation: []string{
+		"user",
+	},
 	DeleteRecordsSearchedOperation: []string{
 		"user",
 	},
@@ -167,6 +197,12 @@ var operationRolesBearerAuth = map[string][]string{
 	DownloadFileOperation: []string{
 		"admin",
 	},
+	ExecuteTransactionOperation: []string{
+		"user",
+	},
+	GetArchiveOperation: []string{
+		"user",
+	},
 	GetConfigOperation: []string{
 		"admin",
 	},
@@ -179,6 +215,18 @@ var operationRolesBearerAuth = map[string][]string{
 	GetImageOperation: []string{
 		"user",
 	},
+	GetImageCacheStatsOperation: []string{
+		"admin",
+	},
+	GetImageDuplicatesOperation: []string{
+		"user",
+	},
+	GetImageHashOperation: []string{
+		"user",
+	},
+	GetImageMetadataOperation: []string{
+		"user",
+	},
 	GetJobExecutionResultOperation: []string{
 		"admin",
 	},
@@ -206,12 +254,27 @@ var operationRolesBearerAuth = map[string][]string{
 	GetMapsOperation: []string{
 		"user",
 	},
+	GetRecordByKeyOperation: []string{
+		"user",
+	},
+	GetRecordHistoryOperation: []string{
+		"user",
+	},
+	GetUploadOperation: []string{
+		"admin",
+	},
 	GetVideoOperation: []string{
 		"user",
 	},
 	GetViewsOperation: []string{
 		"admin",
 	},
+	HeadMapRecordsFieldsOperation: []string{
+		"user",
+	},
+	ImportRecordsOperation: []string{
+		"user",
+	},
 	InsertMapFileRecordsOperation: []string{
 		"user",
 	},
@@ -227,12 +290,18 @@ var operationRolesBearerAuth = map[string][]string{
 	LogoutSessionCompatOperation: []string{
 		"user",
 	},
+	PatchRecordByKeyOperation: []string{
+		"user",
+	},
 	PostDatabaseOperation: []string{
 		"admin",
 	},
 	PostJobOperation: []string{
 		"admin",
 	},
+	PurgeImageCacheOperation: []string{
+		"admin",
+	},
 	RemoveSessionCompatOperation: []string{
 		"user",
 	},
@@ -263,39 +332,48 @@ var operationRolesBearerAuth = map[string][]string{
 	TriggerJobOperation: []string{
 		"admin",
 	},
+	UpdateImageHashesOperation: []string{
+		"user",
+	},
 	UpdateLobByMapOperation: []string{
 		"user",
 	},
 	UpdateRecordsByFieldsOperation: []string{
 		"user",
 	},
+	UploadChunkOperation: []string{
+		"admin",
+	},
 	UploadFileOperation: []string{
 		"admin",
 	},
 }
 
-func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
-	var t BearerAuth
-	token, ok := findAuthorization(req.Header, "Bearer")
+// GetRolesForBearerAuth returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForBearerAuth(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForBearerAuth(operation string) []string {
+	roles, ok := operationRolesBearerAuth[operation]
 	if !ok {
-		return ctx, false, nil
-	}
-	t.Token = token
-	t.Roles = operationRolesBearerAuth[operationName]
-	rctx, err := s.sec.HandleBearerAuth(ctx, operationName, t)
-	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
-		return nil, false, nil
-	} else if err != nil {
-		return nil, false, err
+		return nil
 	}
-	// CLU TKN addition
-	s.sec.Request(rctx, req)
-
-	return rctx, true, err
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
 }
 
+// operationRolesTokenCheck is a private map storing roles per operation.
 var operationRolesTokenCheck = map[string][]string{
 	AddViewOperation:               []string{},
+	AggregateRecordsOperation:      []string{},
 	BatchParameterQueryOperation:   []string{},
 	BatchQueryOperation:            []string{},
 	BatchSelectOperation:           []string{},
@@ -303,17 +381,26 @@ var operationRolesTokenCheck = map[string][]string{
 	BrowseLocationOperation:        []string{},
 	CallExtendOperation:            []string{},
 	CallPostExtendOperation:        []string{},
+	CancelUploadOperation:          []string{},
 	CreateDirectoryOperation:       []string{},
+	CreateUploadOperation:          []string{},
 	DeleteExtendOperation:          []string{},
 	DeleteFileLocationOperation:    []string{},
 	DeleteJobResultOperation:       []string{},
+	DeleteRecordByKeyOperation:     []string{},
 	DeleteRecordsSearchedOperation: []string{},
 	DeleteViewOperation:            []string{},
 	DownloadFileOperation:          []string{},
+	ExecuteTransactionOperation:    []string{},
+	GetArchiveOperation:            []string{},
 	GetConfigOperation:             []string{},
 	GetDatabasesOperation:          []string{},
 	GetFieldsOperation:             []string{},
 	GetImageOperation:              []string{},
+	GetImageCacheStatsOperation:    []string{},
+	GetImageDuplicatesOperation:    []string{},
+	GetImageHashOperation:          []string{},
+	GetImageMetadataOperation:      []string{},
 	GetJobExecutionResultOperation: []string{},
 	GetJobFullInfoOperation:        []string{},
 	GetJobResultOperation:          []string{},
@@ -324,16 +411,23 @@ var operationRolesTokenCheck = map[string][]string{
 	GetMapMetadataOperation:        []string{},
 	GetMapRecordsFieldsOperation:   []string{},
 	GetMapsOperation:               []string{},
+	GetRecordByKeyOperation:        []string{},
+	GetRecordHistoryOperation:      []string{},
+	GetUploadOperation:             []string{},
 	GetVideoOperation:              []string{},
 	GetViewsOperation:              []string{},
+	HeadMapRecordsFieldsOperation:  []string{},
+	ImportRecordsOperation:         []string{},
 	InsertMapFileRecordsOperation:  []string{},
 	InsertRecordOperation:          []string{},
 	ListModellingOperation:         []string{},
 	ListTablesOperation:            []string{},
 	LoginSessionOperation:          []string{},
 	LogoutSessionCompatOperation:   []string{},
+	PatchRecordByKeyOperation:      []string{},
 	PostDatabaseOperation:          []string{},
 	PostJobOperation:               []string{},
+	PurgeImageCacheOperation:       []string{},
 	PushLoginSessionOperation:      []string{},
 	RemoveSessionCompatOperation:   []string{},
 	SearchModellingOperation:       []string{},
@@ -345,11 +439,78 @@ var operationRolesTokenCheck = map[string][]string{
 	StoreConfigOperation:           []string{},
 	TriggerExtendOperation:         []string{},
 	TriggerJobOperation:            []string{},
+	UpdateImageHashesOperation:     []string{},
 	UpdateLobByMapOperation:        []string{},
 	UpdateRecordsByFieldsOperation: []string{},
+	UploadChunkOperation:           []string{},
 	UploadFileOperation:            []string{},
 }
 
+// GetRolesForTokenCheck returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForTokenCheck(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForTokenCheck(operation string) []string {
+	roles, ok := operationRolesTokenCheck[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+func (s *Server) securityBasicAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t BasicAuth
+	if _, ok := findAuthorization(req.Header, "Basic"); !ok {
+		return ctx, false, nil
+	}
+	username, password, ok := req.BasicAuth()
+	if !ok {
+		return nil, false, errors.New("invalid basic auth")
+	}
+	t.Username = username
+	t.Password = password
+	t.Roles = operationRolesBasicAuth[operationName]
+	rctx, err := s.sec.HandleBasicAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	// CLU TKN addition
+	s.sec.Request(rctx, req)
+
+	return rctx, true, err
+}
+
+func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t BearerAuth
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Roles = operationRolesBearerAuth[operationName]
+	rctx, err := s.sec.HandleBearerAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	// CLU TKN addition
+	s.sec.Request(rctx, req)
+
+	return rctx, true, err
+}
+
 func (s *Server) securityTokenCheck(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
 	var t TokenCheck
 	const parameterName = "X-Tokencheck"
@@ -14,6 +14,12 @@ type Handler interface {
 	//
 	// POST /config/views
 	AddView(ctx context.Context, params AddViewParams) (AddViewRes, error)
+	// AggregateRecords implements aggregateRecords operation.
+	//
+	// Aggregate records of a table with count, sum, min, max or avg grouped by the given fields.
+	//
+	// GET /rest/aggregate/{table}
+	AggregateRecords(ctx context.Context, params AggregateRecordsParams) (AggregateRecordsRes, error)
 	// BatchParameterQuery implements batchParameterQuery operation.
 	//
 	// Call a SQL query batch command posted in query.
@@ -56,12 +62,25 @@ type Handler interface {
 	//
 	// POST /rest/extend/{path}
 	CallPostExtend(ctx context.Context, req *CallPostExtendReq, params CallPostExtendParams) (CallPostExtendRes, error)
+	// CancelUpload implements cancelUpload operation.
+	//
+	// Cancel a resumable upload and remove the received data.
+	//
+	// DELETE /rest/uploads/{uploadId}
+	CancelUpload(ctx context.Context, params CancelUploadParams) (CancelUploadRes, error)
 	// CreateDirectory implements createDirectory operation.
 	//
 	// Create a new directory.
 	//
 	// PUT /rest/file/{path}
 	CreateDirectory(ctx context.Context, params CreateDirectoryParams) (CreateDirectoryRes, error)
+	// CreateUpload implements createUpload operation.
+	//
+	// Create a resumable upload of a new file to the given location. The data is sent in chunks to the
+	// returned upload.
+	//
+	// POST /rest/upload/{path}
+	CreateUpload(ctx context.Context, params CreateUploadParams) (CreateUploadRes, error)
 	// DeleteExtend implements deleteExtend operation.
 	//
 	// Delete extend/plugin data.
@@ -80,6 +99,12 @@ type Handler interface {
 	//
 	// DELETE /tasks/{jobName}/{jobId}
 	DeleteJobResult(ctx context.Context, params DeleteJobResultParams) (DeleteJobResultRes, error)
+	// DeleteRecordByKey implements deleteRecordByKey operation.
+	//
+	// Delete the record with the given primary key.
+	//
+	// DELETE /rest/view/{table}/pk/{key}
+	DeleteRecordByKey(ctx context.Context, params DeleteRecordByKeyParams) (DeleteRecordByKeyRes, error)
 	// DeleteRecordsSearched implements deleteRecordsSearched operation.
 	//
 	// Delete a record with a given search.
@@ -98,6 +123,19 @@ type Handler interface {
 	//
 	// GET /rest/file/{path}
 	DownloadFile(ctx context.Context, params DownloadFileParams) (DownloadFileRes, error)
+	// ExecuteTransaction implements executeTransaction operation.
+	//
+	// Execute a list of insert, update and delete operations on tables of one database in one transaction.
+	//
+	// POST /rest/transaction
+	ExecuteTransaction(ctx context.Context, req *Transaction) (ExecuteTransactionRes, error)
+	// GetArchive implements getArchive operation.
+	//
+	// Retrieves the large object field of all records matching the search as ZIP archive with one entry
+	// per record.
+	//
+	// GET /archive/{table}/{field}/{search}
+	GetArchive(ctx context.Context, params GetArchiveParams) (GetArchiveRes, error)
 	// GetConfig implements getConfig operation.
 	//
 	// Get current active configuration.
@@ -122,6 +160,31 @@ type Handler interface {
 	//
 	// GET /image/{table}/{field}/{search}
 	GetImage(ctx context.Context, params GetImageParams) (GetImageRes, error)
+	// GetImageCacheStats implements getImageCacheStats operation.
+	//
+	// Get hit and miss statistics of the derived image cache.
+	//
+	// GET /config/images/cache
+	GetImageCacheStats(ctx context.Context) (GetImageCacheStatsRes, error)
+	// GetImageDuplicates implements getImageDuplicates operation.
+	//
+	// Retrieves groups of records with near-duplicate images out of the stored perceptual hashes.
+	//
+	// GET /hash/{table}/{hashField}/duplicates
+	GetImageDuplicates(ctx context.Context, params GetImageDuplicatesParams) (GetImageDuplicatesRes, error)
+	// GetImageHash implements getImageHash operation.
+	//
+	// Calculates the perceptual hash of an image field of a specific table record.
+	//
+	// GET /image/{table}/{field}/{search}/hash
+	GetImageHash(ctx context.Context, params GetImageHashParams) (GetImageHashRes, error)
+	// GetImageMetadata implements getImageMetadata operation.
+	//
+	// Retrieves the EXIF tags, dimensions and format of an image field of a specific table record without
+	// the image data.
+	//
+	// GET /image/{table}/{field}/{search}/metadata
+	GetImageMetadata(ctx context.Context, params GetImageMetadataParams) (GetImageMetadataRes, error)
 	// GetJobExecutionResult implements getJobExecutionResult operation.
 	//
 	// Retrieves a specific job result.
@@ -182,6 +245,24 @@ type Handler interface {
 	//
 	// GET /rest/view
 	GetMaps(ctx context.Context) (GetMapsRes, error)
+	// GetRecordByKey implements getRecordByKey operation.
+	//
+	// Read the record with the given primary key.
+	//
+	// GET /rest/view/{table}/pk/{key}
+	GetRecordByKey(ctx context.Context, params GetRecordByKeyParams) (GetRecordByKeyRes, error)
+	// GetRecordHistory implements getRecordHistory operation.
+	//
+	// Read the change history of the record with the given primary key.
+	//
+	// GET /rest/history/{table}/{key}
+	GetRecordHistory(ctx context.Context, params GetRecordHistoryParams) (GetRecordHistoryRes, error)
+	// GetUpload implements getUpload operation.
+	//
+	// Retrieves the offset and state of a resumable upload.
+	//
+	// GET /rest/uploads/{uploadId}
+	GetUpload(ctx context.Context, params GetUploadParams) (GetUploadRes, error)
 	// GetUserInfo implements getUserInfo operation.
 	//
 	// Get the token user information.
@@ -206,6 +287,18 @@ type Handler interface {
 	//
 	// GET /config/views
 	GetViews(ctx context.Context) (GetViewsRes, error)
+	// HeadMapRecordsFields implements headMapRecordsFields operation.
+	//
+	// Return the total number of records matching the search in the X-Total-Count header.
+	//
+	// HEAD /rest/view/{table}/{fields}/{search}
+	HeadMapRecordsFields(ctx context.Context, params HeadMapRecordsFieldsParams) (HeadMapRecordsFieldsRes, error)
+	// ImportRecords implements importRecords operation.
+	//
+	// Import a CSV or NDJSON stream of records into a table.
+	//
+	// POST /rest/import/{table}
+	ImportRecords(ctx context.Context, req ImportRecordsReq, params ImportRecordsParams) (ImportRecordsRes, error)
 	// InsertMapFileRecords implements insertMapFileRecords operation.
 	//
 	// Store send records into Map definition.
@@ -242,10 +335,16 @@ type Handler interface {
 	//
 	// PUT /logout
 	LogoutSessionCompat(ctx context.Context) (LogoutSessionCompatRes, error)
+	// PatchRecordByKey implements patchRecordByKey operation.
+	//
+	// Update the fields of the record with the given primary key present in the JSON Merge Patch.
+	//
+	// PATCH /rest/view/{table}/pk/{key}
+	PatchRecordByKey(ctx context.Context, req PatchRecordByKeyReq, params PatchRecordByKeyParams) (PatchRecordByKeyRes, error)
 	// PostDatabase implements postDatabase operation.
 	//
-	// Create a new database, the input need to be JSON. A structure level parameter indicate version to
-	// be used.
+	// Create a new database, the input need to be JSON. A structure level parameter indicate version to be
+	// used.
 	//
 	// POST /rest/database
 	PostDatabase(ctx context.Context, req *Database) (PostDatabaseRes, error)
@@ -255,6 +354,12 @@ type Handler interface {
 	//
 	// POST /tasks
 	PostJob(ctx context.Context, req PostJobReq) (PostJobRes, error)
+	// PurgeImageCache implements purgeImageCache operation.
+	//
+	// Purge the derived image cache, all entries or the entries of one table.
+	//
+	// DELETE /config/images/cache
+	PurgeImageCache(ctx context.Context, params PurgeImageCacheParams) (PurgeImageCacheRes, error)
 	// PushLoginSession implements pushLoginSession operation.
 	//
 	// Login using baseauth or bearer to receive or validate token.
@@ -321,9 +426,19 @@ type Handler interface {
 	//
 	// PUT /tasks/{jobName}
 	TriggerJob(ctx context.Context, params TriggerJobParams) (TriggerJobRes, error)
+	// UpdateImageHashes implements updateImageHashes operation.
+	//
+	// Calculates the perceptual hash of the image field of all records matching the search and stores it
+	// in the hash field.
+	//
+	// PUT /hash/{table}/{field}
+	UpdateImageHashes(ctx context.Context, params UpdateImageHashesParams) (UpdateImageHashesRes, error)
 	// UpdateLobByMap implements updateLobByMap operation.
 	//
-	// Set a lob at a specific table record of an field in a Map.
+	// Set a lob at a specific table record of an field in a Map. The request body is streamed into the
+	// field of exactly one record matching the search. On PostgreSQL and MySQL tables with primary key the
+	// body is appended block by block inside one transaction, other tables read the body into memory up to
+	// the maxBinaryBufferSize limit.
 	//
 	// PUT /binary/{table}/{field}/{search}
 	UpdateLobByMap(ctx context.Context, req UpdateLobByMapReq, params UpdateLobByMapParams) (UpdateLobByMapRes, error)
@@ -333,6 +448,13 @@ type Handler interface {
 	//
 	// PUT /rest/view/{table}/{search}
 	UpdateRecordsByFields(ctx context.Context, req OptUpdateRecordsByFieldsReq, params UpdateRecordsByFieldsParams) (UpdateRecordsByFieldsRes, error)
+	// UploadChunk implements uploadChunk operation.
+	//
+	// Append a chunk of data at the offset of a resumable upload. The file is moved to the location if the
+	// upload is complete.
+	//
+	// PATCH /rest/uploads/{uploadId}
+	UploadChunk(ctx context.Context, req UploadChunkReq, params UploadChunkParams) (UploadChunkRes, error)
 	// UploadFile implements uploadFile operation.
 	//
 	// Upload a new file to the given location.
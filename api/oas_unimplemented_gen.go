@@ -22,6 +22,15 @@ func (UnimplementedHandler) AddView(ctx context.Context, params AddViewParams) (
 	return r, ht.ErrNotImplemented
 }
 
+// AggregateRecords implements aggregateRecords operation.
+//
+// Aggregate records of a table with count, sum, min, max or avg grouped by the given fields.
+//
+// GET /rest/aggregate/{table}
+func (UnimplementedHandler) AggregateRecords(ctx context.Context, params AggregateRecordsParams) (r AggregateRecordsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // BatchParameterQuery implements batchParameterQuery operation.
 //
 // Call a SQL query batch command posted in query.
@@ -85,6 +94,15 @@ func (UnimplementedHandler) CallPostExtend(ctx context.Context, req *CallPostExt
 	return r, ht.ErrNotImplemented
 }
 
+// CancelUpload implements cancelUpload operation.
+//
+// Cancel a resumable upload and remove the received data.
+//
+// DELETE /rest/uploads/{uploadId}
+func (UnimplementedHandler) CancelUpload(ctx context.Context, params CancelUploadParams) (r CancelUploadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateDirectory implements createDirectory operation.
 //
 // Create a new directory.
@@ -94,6 +112,16 @@ func (UnimplementedHandler) CreateDirectory(ctx context.Context, params CreateDi
 	return r, ht.ErrNotImplemented
 }
 
+// CreateUpload implements createUpload operation.
+//
+// Create a resumable upload of a new file to the given location. The data is sent in chunks to the
+// returned upload.
+//
+// POST /rest/upload/{path}
+func (UnimplementedHandler) CreateUpload(ctx context.Context, params CreateUploadParams) (r CreateUploadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteExtend implements deleteExtend operation.
 //
 // Delete extend/plugin data.
@@ -121,6 +149,15 @@ func (UnimplementedHandler) DeleteJobResult(ctx context.Context, params DeleteJo
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteRecordByKey implements deleteRecordByKey operation.
+//
+// Delete the record with the given primary key.
+//
+// DELETE /rest/view/{table}/pk/{key}
+func (UnimplementedHandler) DeleteRecordByKey(ctx context.Context, params DeleteRecordByKeyParams) (r DeleteRecordByKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteRecordsSearched implements deleteRecordsSearched operation.
 //
 // Delete a record with a given search.
@@ -148,6 +185,25 @@ func (UnimplementedHandler) DownloadFile(ctx context.Context, params DownloadFil
 	return r, ht.ErrNotImplemented
 }
 
+// ExecuteTransaction implements executeTransaction operation.
+//
+// Execute a list of insert, update and delete operations on tables of one database in one transaction.
+//
+// POST /rest/transaction
+func (UnimplementedHandler) ExecuteTransaction(ctx context.Context, req *Transaction) (r ExecuteTransactionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetArchive implements getArchive operation.
+//
+// Retrieves the large object field of all records matching the search as ZIP archive with one entry
+// per record.
+//
+// GET /archive/{table}/{field}/{search}
+func (UnimplementedHandler) GetArchive(ctx context.Context, params GetArchiveParams) (r GetArchiveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetConfig implements getConfig operation.
 //
 // Get current active configuration.
@@ -184,6 +240,43 @@ func (UnimplementedHandler) GetImage(ctx context.Context, params GetImageParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetImageCacheStats implements getImageCacheStats operation.
+//
+// Get hit and miss statistics of the derived image cache.
+//
+// GET /config/images/cache
+func (UnimplementedHandler) GetImageCacheStats(ctx context.Context) (r GetImageCacheStatsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetImageDuplicates implements getImageDuplicates operation.
+//
+// Retrieves groups of records with near-duplicate images out of the stored perceptual hashes.
+//
+// GET /hash/{table}/{hashField}/duplicates
+func (UnimplementedHandler) GetImageDuplicates(ctx context.Context, params GetImageDuplicatesParams) (r GetImageDuplicatesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetImageHash implements getImageHash operation.
+//
+// Calculates the perceptual hash of an image field of a specific table record.
+//
+// GET /image/{table}/{field}/{search}/hash
+func (UnimplementedHandler) GetImageHash(ctx context.Context, params GetImageHashParams) (r GetImageHashRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetImageMetadata implements getImageMetadata operation.
+//
+// Retrieves the EXIF tags, dimensions and format of an image field of a specific table record without
+// the image data.
+//
+// GET /image/{table}/{field}/{search}/metadata
+func (UnimplementedHandler) GetImageMetadata(ctx context.Context, params GetImageMetadataParams) (r GetImageMetadataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetJobExecutionResult implements getJobExecutionResult operation.
 //
 // Retrieves a specific job result.
@@ -274,6 +367,33 @@ func (UnimplementedHandler) GetMaps(ctx context.Context) (r GetMapsRes, _ error)
 	return r, ht.ErrNotImplemented
 }
 
+// GetRecordByKey implements getRecordByKey operation.
+//
+// Read the record with the given primary key.
+//
+// GET /rest/view/{table}/pk/{key}
+func (UnimplementedHandler) GetRecordByKey(ctx context.Context, params GetRecordByKeyParams) (r GetRecordByKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetRecordHistory implements getRecordHistory operation.
+//
+// Read the change history of the record with the given primary key.
+//
+// GET /rest/history/{table}/{key}
+func (UnimplementedHandler) GetRecordHistory(ctx context.Context, params GetRecordHistoryParams) (r GetRecordHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetUpload implements getUpload operation.
+//
+// Retrieves the offset and state of a resumable upload.
+//
+// GET /rest/uploads/{uploadId}
+func (UnimplementedHandler) GetUpload(ctx context.Context, params GetUploadParams) (r GetUploadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetUserInfo implements getUserInfo operation.
 //
 // Get the token user information.
@@ -310,6 +430,24 @@ func (UnimplementedHandler) GetViews(ctx context.Context) (r GetViewsRes, _ erro
 	return r, ht.ErrNotImplemented
 }
 
+// HeadMapRecordsFields implements headMapRecordsFields operation.
+//
+// Return the total number of records matching the search in the X-Total-Count header.
+//
+// HEAD /rest/view/{table}/{fields}/{search}
+func (UnimplementedHandler) HeadMapRecordsFields(ctx context.Context, params HeadMapRecordsFieldsParams) (r HeadMapRecordsFieldsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ImportRecords implements importRecords operation.
+//
+// Import a CSV or NDJSON stream of records into a table.
+//
+// POST /rest/import/{table}
+func (UnimplementedHandler) ImportRecords(ctx context.Context, req ImportRecordsReq, params ImportRecordsParams) (r ImportRecordsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // InsertMapFileRecords implements insertMapFileRecords operation.
 //
 // Store send records into Map definition.
@@ -364,10 +502,19 @@ func (UnimplementedHandler) LogoutSessionCompat(ctx context.Context) (r LogoutSe
 	return r, ht.ErrNotImplemented
 }
 
+// PatchRecordByKey implements patchRecordByKey operation.
+//
+// Update the fields of the record with the given primary key present in the JSON Merge Patch.
+//
+// PATCH /rest/view/{table}/pk/{key}
+func (UnimplementedHandler) PatchRecordByKey(ctx context.Context, req PatchRecordByKeyReq, params PatchRecordByKeyParams) (r PatchRecordByKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PostDatabase implements postDatabase operation.
 //
-// Create a new database, the input need to be JSON. A structure level parameter indicate version to
-// be used.
+// Create a new database, the input need to be JSON. A structure level parameter indicate version to be
+// used.
 //
 // POST /rest/database
 func (UnimplementedHandler) PostDatabase(ctx context.Context, req *Database) (r PostDatabaseRes, _ error) {
@@ -383,6 +530,15 @@ func (UnimplementedHandler) PostJob(ctx context.Context, req PostJobReq) (r Post
 	return r, ht.ErrNotImplemented
 }
 
+// PurgeImageCache implements purgeImageCache operation.
+//
+// Purge the derived image cache, all entries or the entries of one table.
+//
+// DELETE /config/images/cache
+func (UnimplementedHandler) PurgeImageCache(ctx context.Context, params PurgeImageCacheParams) (r PurgeImageCacheRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PushLoginSession implements pushLoginSession operation.
 //
 // Login using baseauth or bearer to receive or validate token.
@@ -482,9 +638,22 @@ func (UnimplementedHandler) TriggerJob(ctx context.Context, params TriggerJobPar
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateImageHashes implements updateImageHashes operation.
+//
+// Calculates the perceptual hash of the image field of all records matching the search and stores it
+// in the hash field.
+//
+// PUT /hash/{table}/{field}
+func (UnimplementedHandler) UpdateImageHashes(ctx context.Context, params UpdateImageHashesParams) (r UpdateImageHashesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateLobByMap implements updateLobByMap operation.
 //
-// Set a lob at a specific table record of an field in a Map.
+// Set a lob at a specific table record of an field in a Map. The request body is streamed into the
+// field of exactly one record matching the search. On PostgreSQL and MySQL tables with primary key the
+// body is appended block by block inside one transaction, other tables read the body into memory up to
+// the maxBinaryBufferSize limit.
 //
 // PUT /binary/{table}/{field}/{search}
 func (UnimplementedHandler) UpdateLobByMap(ctx context.Context, req UpdateLobByMapReq, params UpdateLobByMapParams) (r UpdateLobByMapRes, _ error) {
@@ -500,6 +669,16 @@ func (UnimplementedHandler) UpdateRecordsByFields(ctx context.Context, req OptUp
 	return r, ht.ErrNotImplemented
 }
 
+// UploadChunk implements uploadChunk operation.
+//
+// Append a chunk of data at the offset of a resumable upload. The file is moved to the location if the
+// upload is complete.
+//
+// PATCH /rest/uploads/{uploadId}
+func (UnimplementedHandler) UploadChunk(ctx context.Context, req UploadChunkReq, params UploadChunkParams) (r UploadChunkRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UploadFile implements uploadFile operation.
 //
 // Upload a new file to the given location.
@@ -12,7 +12,10 @@
 package clu
 
 import (
+	"encoding/json"
 	"os"
+	"regexp"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,6 +32,49 @@ type BatchEntry struct {
 	Query      string `flynn:":BLOB"`
 	Database   string
 	ParamCount int
+	Parameters string `flynn:":BLOB"`
+}
+
+// BatchParameter declared parameter of a batch query. The parameter is
+// referenced in the query with <name> and passed as bind variable.
+// Valid types are string, integer, number, boolean, date and timestamp.
+type BatchParameter struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+	Default  string `json:"default,omitempty"`
+	Pattern  string `json:"pattern,omitempty"`
+}
+
+// BatchPlaceholderRegexp parameter placeholder <name> of a batch query
+var BatchPlaceholderRegexp = regexp.MustCompile(`<([A-Za-z_][A-Za-z0-9_]*)>`)
+
+// DeclaredParameters parse the parameter declaration of the batch entry. If
+// no declaration is given, all <name> placeholders of the query are required
+// string parameters.
+func (entry *BatchEntry) DeclaredParameters() ([]*BatchParameter, error) {
+	if entry.Parameters == "" {
+		params := make([]*BatchParameter, 0)
+		known := make(map[string]bool)
+		for _, m := range BatchPlaceholderRegexp.FindAllStringSubmatch(entry.Query, -1) {
+			if !known[m[1]] {
+				known[m[1]] = true
+				params = append(params, &BatchParameter{Name: m[1], Type: "string", Required: true})
+			}
+		}
+		return params, nil
+	}
+	params := make([]*BatchParameter, 0)
+	err := json.Unmarshal([]byte(entry.Parameters), &params)
+	if err != nil {
+		return nil, errorrepo.NewError("REST00016", entry.Name, err)
+	}
+	for _, p := range params {
+		if p.Type == "" {
+			p.Type = "string"
+		}
+	}
+	return params, nil
 }
 
 var batchDbRef *common.Reference
@@ -94,6 +140,7 @@ func InitBatchRepository(dbRef *common.Reference, dbPassword, tablename string)
 	dbTables := flynn.Maps()
 	for _, d := range dbTables {
 		if d == tablename {
+			adaptBatchRepository(batchStoreID, tablename)
 			batchtablename = tablename
 			batchStoreOnline = true
 			log.Log.Debugf("batch store online = %v", batchStoreOnline)
@@ -113,6 +160,27 @@ func InitBatchRepository(dbRef *common.Reference, dbPassword, tablename string)
 	return true
 }
 
+// adaptBatchRepository add parameter declaration column to batch repositories
+// created by older versions
+func adaptBatchRepository(batchStoreID common.RegDbID, tablename string) {
+	columns, err := batchStoreID.GetTableColumn(tablename)
+	if err != nil {
+		services.ServerMessage("Batch repository columns cannot be read: %v", err)
+		return
+	}
+	for _, c := range columns {
+		if strings.EqualFold(c, "parameters") {
+			return
+		}
+	}
+	err = batchStoreID.AdaptTable(tablename, &BatchEntry{})
+	if err != nil {
+		services.ServerMessage("Batch repository '%s' adapt failed: %v", tablename, err)
+		return
+	}
+	services.ServerMessage("Batch repository '%s' adapted with parameter declaration", tablename)
+}
+
 // BatchSelect search for batchname in an batch repository
 func BatchSelect(batchname string) (*BatchEntry, error) {
 	log.Log.Debugf("batch select online = %v", batchStoreOnline)
@@ -132,7 +200,7 @@ func BatchSelect(batchname string) (*BatchEntry, error) {
 	defer batchStoreID.Close()
 	var b *BatchEntry
 	q := &common.Query{TableName: batchtablename,
-		Search:     "name='" + batchname + "'",
+		Search:     "name='" + strings.ReplaceAll(batchname, "'", "''") + "'",
 		DataStruct: &BatchEntry{},
 		Fields:     []string{"*"}}
 	_, err = batchStoreID.Query(q, func(search *common.Query, result *common.Result) error {
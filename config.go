@@ -79,6 +79,11 @@ type CommonConfig struct {
 	Version             string `yaml:"version"`
 	ConfigWatcher       bool   `yaml:"configWatcher,omitempty"`
 	MaxBinaryBufferSize int    `yaml:"maxBinaryBufferSize,omitempty"`
+	MaxImageWidth       int    `yaml:"maxImageWidth,omitempty"`
+	MaxImageHeight      int    `yaml:"maxImageHeight,omitempty"`
+	MaxArchiveRows      int    `yaml:"maxArchiveRows,omitempty"`
+	MaxArchiveSize      int64  `yaml:"maxArchiveSize,omitempty"`
+	MaxDuplicateRows    int    `yaml:"maxDuplicateRows,omitempty"`
 	StatisticTimer      bool   `yaml:"statisticTimer,omitempty"`
 	AppURL              string `yaml:"AppURL,omitempty"`
 }
@@ -95,6 +100,16 @@ type Server struct {
 	Shutdown     struct {
 		Passcode yaml.Node `yaml:"passcode,omitempty"`
 	} `yaml:"shutdown"`
+	ImageCache *ImageCacheConfig `yaml:"imageCache,omitempty"`
+}
+
+// ImageCacheConfig derived image cache configuration. Images are cached in
+// memory or, if a directory is given, on disk.
+type ImageCacheConfig struct {
+	MaxSize    int           `yaml:"maxSize"`
+	Directory  string        `yaml:"directory,omitempty"`
+	TTL        time.Duration `yaml:"ttl,omitempty"`
+	Invalidate bool          `yaml:"invalidate,omitempty"`
 }
 
 // DatabaseConfig database modelling and access
@@ -104,6 +119,9 @@ type DatabaseConfig struct {
 	SessionInfo     *SessionConfig `yaml:"sessionInfo"`
 	UserInfo        *Database      `yaml:"userInfo"`
 	BatchRepository *Database      `yaml:"batchRepository"`
+	// History change history store, the tables entry lists the tables
+	// with change history
+	History *Database `yaml:"history,omitempty"`
 }
 
 // SessionConfig session configuration
@@ -171,8 +189,9 @@ type DatabaseMap struct {
 
 // FileTransferConfig file transfer config
 type FileTransferConfig struct {
-	Admin       Admin `yaml:"Admin"`
-	Directories struct {
+	Admin        Admin         `yaml:"Admin"`
+	UploadExpiry time.Duration `yaml:"uploadExpiry,omitempty"`
+	Directories  struct {
 		Role      string      `yaml:"role,omitempty"`
 		UseRole   bool        `yaml:"use_role,omitempty"`
 		Directory []Directory `yaml:"directory"`
@@ -213,6 +232,39 @@ type Database struct {
 	Tables               []string `yaml:"tables,omitempty"`
 	Enabled              bool     `yaml:"enabled,omitempty"`
 	AuthenticationGlobal bool     `yaml:"global_authentication,omitempty"`
+	// Versions version or last-modified column per table used for
+	// optimistic concurrency of record updates
+	Versions map[string]string `yaml:"versions,omitempty"`
+	// MaxAffectedRows maximal number of records per table a delete or
+	// update may affect without confirmation, '*' applies to all tables
+	MaxAffectedRows map[string]int `yaml:"maxAffectedRows,omitempty"`
+}
+
+// VersionColumn version column of the table, empty if not configured
+func (db *Database) VersionColumn(table string) string {
+	if db == nil {
+		return ""
+	}
+	for t, c := range db.Versions {
+		if strings.EqualFold(t, table) {
+			return c
+		}
+	}
+	return ""
+}
+
+// MaxAffected maximal number of records a delete or update of the table may
+// affect without confirmation, 0 if unlimited
+func (db *Database) MaxAffected(table string) int {
+	if db == nil {
+		return 0
+	}
+	for t, m := range db.MaxAffectedRows {
+		if strings.EqualFold(t, table) {
+			return m
+		}
+	}
+	return db.MaxAffectedRows["*"]
 }
 
 // DatabaseRegister database register
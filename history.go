@@ -0,0 +1,158 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package clu
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tknie/flynn"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+	"github.com/tknie/services"
+)
+
+// HistoryEntry change of one record stored in the history table. The before
+// and after images contain the record as JSON.
+type HistoryEntry struct {
+	TableName string
+	RecordKey string
+	Operation string
+	UserName  string
+	UUID      string
+	Modified  time.Time
+	Before    string `flynn:":BLOB"`
+	After     string `flynn:":BLOB"`
+}
+
+var historyDbRef *common.Reference
+var historyDbPassword = ""
+var historyTableName = ""
+var historyTables []string
+
+var historyLock sync.Mutex
+var historyOnline = false
+
+func openHistoryStore() (common.RegDbID, error) {
+	if historyDbPassword == "" {
+		historyDbPassword = os.Getenv("REST_HISTORY_PASS")
+	}
+	historyStoreID, err := flynn.Handler(historyDbRef, historyDbPassword)
+	if err != nil {
+		services.ServerMessage("Register error log: %v", err)
+		return 0, err
+	}
+	return historyStoreID, nil
+}
+
+// InitHistory init change history of the given tables
+func InitHistory(dbRef *common.Reference, dbPassword, tablename string, tables []string) bool {
+	historyDbRef = dbRef
+	historyDbPassword = dbPassword
+	historyStoreID, err := openHistoryStore()
+	if err != nil {
+		return false
+	}
+	log.Log.Debugf("Receive history store handler %s", historyStoreID)
+	defer historyStoreID.FreeHandler()
+	defer historyStoreID.Close()
+
+	historyTables = tables
+	for _, d := range flynn.Maps() {
+		if d == tablename {
+			historyTableName = tablename
+			historyOnline = true
+			services.ServerMessage("Storing change history to table '%s'", historyTableName)
+			return true
+		}
+	}
+	err = historyStoreID.CreateTable(tablename, &HistoryEntry{})
+	if err != nil {
+		services.ServerMessage("Database history store creating failed: %v", err)
+		return false
+	}
+	historyTableName = tablename
+	historyOnline = true
+	services.ServerMessage("Database history store '%s' created successfully", historyTableName)
+	return true
+}
+
+// HistoryEnabled check if the changes of the table are stored in the history
+func HistoryEnabled(table string) bool {
+	if !historyOnline {
+		return false
+	}
+	for _, t := range historyTables {
+		if t == "*" || strings.EqualFold(t, table) {
+			return true
+		}
+	}
+	return false
+}
+
+// StoreHistory store history entries
+func StoreHistory(entries []*HistoryEntry) error {
+	if !historyOnline || len(entries) == 0 {
+		return nil
+	}
+	insert := &common.Entries{Fields: []string{"*"}, DataStruct: entries[0]}
+	for _, e := range entries {
+		insert.Values = append(insert.Values, []any{e})
+	}
+	historyLock.Lock()
+	defer historyLock.Unlock()
+	historyStoreID, err := openHistoryStore()
+	if err != nil {
+		return err
+	}
+	defer historyStoreID.FreeHandler()
+	defer historyStoreID.Close()
+	_, err = historyStoreID.Insert(historyTableName, insert)
+	if err != nil {
+		log.Log.Errorf("Error storing history of %s: %v", entries[0].TableName, err)
+		return err
+	}
+	return historyStoreID.Commit()
+}
+
+// QueryHistory query history of the record of the table ordered by the
+// modification time
+func QueryHistory(table, key string) ([]*HistoryEntry, error) {
+	entries := make([]*HistoryEntry, 0)
+	if !historyOnline {
+		return entries, nil
+	}
+	historyStoreID, err := openHistoryStore()
+	if err != nil {
+		return nil, err
+	}
+	defer historyStoreID.FreeHandler()
+	defer historyStoreID.Close()
+	q := &common.Query{TableName: historyTableName,
+		Search: "tablename='" + strings.ReplaceAll(strings.ToLower(table), "'", "''") +
+			"' AND recordkey='" + strings.ReplaceAll(key, "'", "''") + "'",
+		Order:      []string{"modified:ASC"},
+		DataStruct: &HistoryEntry{},
+		Fields:     []string{"*"}}
+	_, err = historyStoreID.Query(q, func(search *common.Query, result *common.Result) error {
+		e := *result.Data.(*HistoryEntry)
+		entries = append(entries, &e)
+		return nil
+	})
+	if err != nil {
+		log.Log.Errorf("Query history store failure: %v", err)
+		return nil, err
+	}
+	return entries, nil
+}
@@ -15,7 +15,10 @@ import (
 	"context"
 
 	ht "github.com/ogen-go/ogen/http"
+	"github.com/tknie/clu"
 	"github.com/tknie/clu/api"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
 )
 
 // AddView implements addView operation.
@@ -54,6 +57,23 @@ func (Handler) GetDatabases(ctx context.Context) (r api.GetDatabasesRes, _ error
 	return r, ht.ErrNotImplemented
 }
 
+// GetImageCacheStats implements getImageCacheStats operation.
+//
+// Get hit and miss statistics of the derived image cache.
+//
+// GET /config/images/cache
+func (Handler) GetImageCacheStats(ctx context.Context) (r api.GetImageCacheStatsRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.AdministratorRole, "") {
+		return &api.GetImageCacheStatsForbidden{}, nil
+	}
+	cache := derivedImages.Load()
+	if cache == nil {
+		return &api.ImageCacheStats{Enabled: api.NewOptBool(false)}, nil
+	}
+	return cache.stats(), nil
+}
+
 // GetViews implements getViews operation.
 //
 // Defines the current views.
@@ -73,6 +93,27 @@ func (Handler) PostDatabase(ctx context.Context, req *api.Database) (r api.PostD
 	return r, ht.ErrNotImplemented
 }
 
+// PurgeImageCache implements purgeImageCache operation.
+//
+// Purge the derived image cache, all entries or the entries of one table.
+//
+// DELETE /config/images/cache
+func (Handler) PurgeImageCache(ctx context.Context, params api.PurgeImageCacheParams) (r api.PurgeImageCacheRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.AdministratorRole, "") {
+		return &api.PurgeImageCacheForbidden{}, nil
+	}
+	cache := derivedImages.Load()
+	if cache == nil {
+		return &api.ImageCacheStats{Enabled: api.NewOptBool(false), Purged: api.NewOptInt(0)}, nil
+	}
+	n := cache.purge(params.Table.Value)
+	log.Log.Debugf("Purged %d images of table '%s' in image cache", n, params.Table.Value)
+	stats := cache.stats()
+	stats.Purged = api.NewOptInt(n)
+	return stats, nil
+}
+
 // SetConfig implements setConfig operation.
 //
 // Store configuration.
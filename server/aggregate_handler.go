@@ -0,0 +1,213 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
+)
+
+var metricRegexp = regexp.MustCompile(`(?i)^\s*(count|sum|min|max|avg)\s*\(\s*(\*|[A-Za-z_][A-Za-z0-9_]*)\s*\)\s*$`)
+
+// aggregateQuery aggregate query definition
+type aggregateQuery struct {
+	table   string
+	driver  common.ReferenceType
+	columns map[string]string
+	groupBy []string
+	metrics []string
+	// names result field names and the corresponding SQL expression
+	names map[string]string
+}
+
+// AggregateRecords implements aggregateRecords operation.
+//
+// Aggregate records of a table with count, sum, min, max or avg grouped by
+// the given fields.
+//
+// GET /rest/aggregate/{table}
+func (Handler) AggregateRecords(ctx context.Context, params api.AggregateRecordsParams) (r api.AggregateRecordsRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.AggregateRecordsForbidden{}, nil
+	}
+	filter := params.Filter.Or("")
+	if isRawSearch(filter) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.AggregateRecordsForbidden{}, nil
+	}
+	log.Log.Debugf("Aggregate %s metrics=%s groupby=%s", params.Table, params.Metrics, params.Groupby.Value)
+	if !fieldNameRegexp.MatchString(params.Table) {
+		return nil, NewBadRequestError(errorrepo.NewError("RERR00026", params.Table))
+	}
+	d, err := ConnectTable(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error search table %s:%v", params.Table, err)
+		return nil, err
+	}
+	defer CloseTable(d)
+
+	columns, err := d.GetTableColumn(params.Table)
+	if err != nil {
+		return nil, err
+	}
+	aq := &aggregateQuery{table: params.Table, driver: TableDriver(params.Table),
+		columns: make(map[string]string), names: make(map[string]string)}
+	for _, c := range columns {
+		aq.columns[strings.ToLower(c)] = c
+	}
+	if aq.driver == common.AdabasType {
+		return nil, NewBadRequestError(errorrepo.NewError("RERR00025", "aggregate", aq.driver.String()))
+	}
+	err = aq.parse(params.Groupby.Or(""), params.Metrics)
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+	search, err := compileSearch(d, params.Table, filter)
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+	statement, err := aq.statement(search, params.Having.Or(""), checkOrderBy(params.Orderby), params.Limit.Or(""))
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+
+	data := make([]api.ResponseRecordsItem, 0)
+	fields := make([]string, 0)
+	err = d.BatchSelectFct(&common.Query{Search: statement}, func(search *common.Query, result *common.Result) error {
+		if result == nil {
+			return errorrepo.NewError("REST00011")
+		}
+		if len(fields) == 0 {
+			fields = result.Fields
+		}
+		data = append(data, generateItem(result.Fields, result.Rows))
+		return nil
+	})
+	if err != nil {
+		log.Log.Errorf("Error during aggregate on %s:%v", params.Table, err)
+		return nil, err
+	}
+	resp := api.Response{Records: data, FieldNames: fields,
+		MapName:   api.NewOptString(params.Table),
+		NrRecords: api.NewOptInt(len(data))}
+	return &api.ResponseHeaders{Response: resp, XToken: api.NewOptString(session.Token)}, nil
+}
+
+// parse check group by fields and metrics against the table columns
+func (aq *aggregateQuery) parse(groupBy, metrics string) error {
+	if groupBy != "" {
+		for _, g := range strings.Split(groupBy, ",") {
+			c, ok := aq.columns[strings.ToLower(strings.TrimSpace(g))]
+			if !ok {
+				return errorrepo.NewError("RERR00026", g)
+			}
+			aq.groupBy = append(aq.groupBy, c)
+			aq.names[strings.ToLower(c)] = c
+		}
+	}
+	for _, m := range strings.Split(metrics, ",") {
+		sm := metricRegexp.FindStringSubmatch(m)
+		if sm == nil {
+			return errorrepo.NewError("REST00020", m)
+		}
+		fct := strings.ToLower(sm[1])
+		field := sm[2]
+		name := fct
+		if field == "*" {
+			if fct != "count" {
+				return errorrepo.NewError("REST00020", m)
+			}
+		} else {
+			c, ok := aq.columns[strings.ToLower(field)]
+			if !ok {
+				return errorrepo.NewError("RERR00026", field)
+			}
+			field = c
+			name = fct + "_" + strings.ToLower(c)
+		}
+		if _, ok := aq.names[name]; ok {
+			return errorrepo.NewError("REST00020", m)
+		}
+		expression := strings.ToUpper(fct) + "(" + field + ")"
+		aq.names[name] = expression
+		aq.metrics = append(aq.metrics, expression+" AS "+name)
+	}
+	return nil
+}
+
+// statement generate the aggregate SQL statement
+func (aq *aggregateQuery) statement(search, having string, orderBy []string, limit string) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	sb.WriteString(strings.Join(append(append([]string{}, aq.groupBy...), aq.metrics...), ","))
+	sb.WriteString(" FROM " + aq.table)
+	if search != "" {
+		sb.WriteString(" WHERE " + search)
+	}
+	if len(aq.groupBy) > 0 {
+		sb.WriteString(" GROUP BY " + strings.Join(aq.groupBy, ","))
+	}
+	if having != "" {
+		if !isStructuredFilter(having) {
+			return "", errorrepo.NewError("RERR00022", having)
+		}
+		n, err := parseFilter(having)
+		if err != nil {
+			return "", err
+		}
+		c := &filterCompiler{driver: aq.driver, columns: aq.names}
+		h, err := c.compile(n)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(" HAVING " + h)
+	}
+	if len(orderBy) > 0 {
+		order := make([]string, 0, len(orderBy))
+		for _, o := range orderBy {
+			f, desc := splitOrder(o)
+			f = strings.ToLower(f)
+			if _, ok := aq.names[f]; !ok {
+				return "", errorrepo.NewError("RERR00026", f)
+			}
+			if desc {
+				order = append(order, f+" DESC")
+			} else {
+				order = append(order, f+" ASC")
+			}
+		}
+		sb.WriteString(" ORDER BY " + strings.Join(order, ","))
+	}
+	if limit != "" && !strings.EqualFold(limit, "ALL") && limit != "-1" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l < 0 {
+			return "", errorrepo.NewError("REST00012", limit)
+		}
+		if aq.driver == common.OracleType {
+			sb.WriteString(" FETCH FIRST " + strconv.Itoa(l) + " ROWS ONLY")
+		} else {
+			sb.WriteString(" LIMIT " + strconv.Itoa(l))
+		}
+	}
+	log.Log.Debugf("Aggregate statement: %s", sb.String())
+	return sb.String(), nil
+}
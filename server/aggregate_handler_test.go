@@ -0,0 +1,75 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/flynn/common"
+)
+
+func testAggregate(driver common.ReferenceType) *aggregateQuery {
+	return &aggregateQuery{table: "Albums", driver: driver, names: make(map[string]string),
+		columns: map[string]string{"artist": "Artist", "price": "Price", "published": "Published"}}
+}
+
+func TestAggregateParse(t *testing.T) {
+	aq := testAggregate(common.PostgresType)
+	if assert.NoError(t, aq.parse("artist", "count(*), SUM(price),max( Published )")) {
+		assert.Equal(t, []string{"Artist"}, aq.groupBy)
+		assert.Equal(t, []string{"COUNT(*) AS count", "SUM(Price) AS sum_price", "MAX(Published) AS max_published"},
+			aq.metrics)
+	}
+	for _, tt := range []struct{ groupBy, metrics, id string }{
+		{"", "sum(*)", "REST00020"},
+		{"", "median(price)", "REST00020"},
+		{"", "count(*),count(*)", "REST00020"},
+		{"", "sum(price);DROP", "REST00020"},
+		{"", "sum(unknown)", "RERR00026"},
+		{"title", "count(*)", "RERR00026"},
+	} {
+		err := testAggregate(common.PostgresType).parse(tt.groupBy, tt.metrics)
+		assert.Equal(t, tt.id, errorID(err), tt.metrics)
+	}
+}
+
+func TestAggregateStatement(t *testing.T) {
+	aq := testAggregate(common.PostgresType)
+	if !assert.NoError(t, aq.parse("artist", "count(*),avg(price)")) {
+		return
+	}
+	statement, err := aq.statement("Price>10", "gt(count,2)", []string{"count:DESC", "artist"}, "5")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "SELECT Artist,COUNT(*) AS count,AVG(Price) AS avg_price FROM Albums WHERE Price>10"+
+			" GROUP BY Artist HAVING COUNT(*)>2 ORDER BY count DESC,artist ASC LIMIT 5", statement)
+	}
+	statement, err = aq.statement("", "", nil, "ALL")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "SELECT Artist,COUNT(*) AS count,AVG(Price) AS avg_price FROM Albums GROUP BY Artist", statement)
+	}
+	_, err = aq.statement("", "count>2", nil, "")
+	assert.Equal(t, "RERR00022", errorID(err))
+	_, err = aq.statement("", "gt(price,2)", nil, "")
+	assert.Equal(t, "RERR00026", errorID(err))
+	_, err = aq.statement("", "", []string{"price"}, "")
+	assert.Equal(t, "RERR00026", errorID(err))
+	_, err = aq.statement("", "", nil, "-5")
+	assert.Equal(t, "REST00012", errorID(err))
+
+	aq = testAggregate(common.OracleType)
+	if assert.NoError(t, aq.parse("", "sum(price)")) {
+		statement, err = aq.statement("", "", nil, "3")
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT SUM(Price) AS sum_price FROM Albums FETCH FIRST 3 ROWS ONLY", statement)
+	}
+}
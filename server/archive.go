@@ -0,0 +1,324 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+)
+
+const (
+	// defaultMaxArchiveRows maximum number of records of an archive if no
+	// maximum is configured
+	defaultMaxArchiveRows = 1000
+	// defaultMaxArchiveSize maximum number of large object bytes of an
+	// archive if no maximum is configured
+	defaultMaxArchiveSize = 1 << 30
+)
+
+// lobSumStatements statements to calculate the size of all large objects
+// matching the search in the database
+var lobSumStatements = map[common.ReferenceType]string{
+	common.PostgresType: "SELECT SUM(octet_length(%s)) FROM %s WHERE %s",
+	common.MysqlType:    "SELECT SUM(LENGTH(%s)) FROM %s WHERE %s",
+}
+
+// archivePatternRegexp field references in the archive name pattern
+var archivePatternRegexp = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// archiveExtensions preferred file extension of common mimetypes
+var archiveExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/heic":      ".heic",
+	"image/tiff":      ".tif",
+	"video/mp4":       ".mp4",
+	"video/quicktime": ".mov",
+	"audio/mpeg":      ".mp3",
+	"application/pdf": ".pdf",
+	"text/plain":      ".txt",
+}
+
+// archiveLimits configured maximum number of records and bytes of an
+// archive. The parameters can only lower the limits.
+func archiveLimits(limit api.OptInt, maxSize api.OptInt64) (int64, int64) {
+	rows, size := int64(defaultMaxArchiveRows), int64(defaultMaxArchiveSize)
+	if clu.Viewer != nil {
+		if clu.Viewer.Common.MaxArchiveRows > 0 {
+			rows = int64(clu.Viewer.Common.MaxArchiveRows)
+		}
+		if clu.Viewer.Common.MaxArchiveSize > 0 {
+			size = clu.Viewer.Common.MaxArchiveSize
+		}
+	}
+	if limit.Set && limit.Value > 0 && int64(limit.Value) < rows {
+		rows = int64(limit.Value)
+	}
+	if maxSize.Set && maxSize.Value > 0 && maxSize.Value < size {
+		size = maxSize.Value
+	}
+	return rows, size
+}
+
+// archiveNames file names of the archive entries out of a name field or
+// a name pattern
+type archiveNames struct {
+	field     string
+	nameField string
+	pattern   string
+	fields    []string
+	used      map[string]bool
+}
+
+// newArchiveNames parse the name pattern and check the referenced fields
+func newArchiveNames(field, nameField, pattern string) (*archiveNames, error) {
+	n := &archiveNames{field: field, nameField: strings.ToLower(nameField), pattern: pattern,
+		used: make(map[string]bool)}
+	if n.nameField != "" {
+		if !fieldNameRegexp.MatchString(n.nameField) {
+			return nil, errorrepo.NewError("RERR00026", nameField)
+		}
+		n.fields = append(n.fields, n.nameField)
+	}
+	for _, m := range archivePatternRegexp.FindAllStringSubmatch(pattern, -1) {
+		name := strings.ToLower(strings.TrimSpace(m[1]))
+		switch {
+		case name == "#":
+		case fieldNameRegexp.MatchString(name):
+			n.fields = append(n.fields, name)
+		default:
+			return nil, errorrepo.NewError("REST00060", m[1])
+		}
+	}
+	return n, nil
+}
+
+// name unique file name of the entry with the extension of the mimetype
+func (n *archiveNames) name(number int, values map[string]string, mimetype string) string {
+	var name string
+	switch {
+	case n.pattern != "":
+		name = archivePatternRegexp.ReplaceAllStringFunc(n.pattern, func(ref string) string {
+			ref = strings.ToLower(strings.TrimSpace(ref[1 : len(ref)-1]))
+			if ref == "#" {
+				return strconv.Itoa(number)
+			}
+			return values[ref]
+		})
+	case n.nameField != "":
+		name = values[n.nameField]
+	default:
+	}
+	name = sanitizeEntryName(name)
+	if name == "" {
+		name = fmt.Sprintf("%s-%d", n.field, number)
+	}
+	if path.Ext(name) == "" {
+		name += archiveExtension(mimetype)
+	}
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for i := 2; n.used[strings.ToLower(name)]; i++ {
+		name = fmt.Sprintf("%s-%d%s", base, i, ext)
+	}
+	n.used[strings.ToLower(name)] = true
+	return name
+}
+
+// sanitizeEntryName remove path separators and control characters out of
+// the entry name
+func sanitizeEntryName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r == '/' || r == '\\' || r == ':' || r < ' ' || r == 0x7f:
+			return '_'
+		default:
+		}
+		return r
+	}, name)
+	return strings.TrimLeft(strings.TrimSpace(name), ".")
+}
+
+// archiveExtension file extension of the mimetype
+func archiveExtension(mimetype string) string {
+	mimetype, _, _ = strings.Cut(strings.ToLower(strings.TrimSpace(mimetype)), ";")
+	if ext, ok := archiveExtensions[mimetype]; ok {
+		return ext
+	}
+	if exts, err := mime.ExtensionsByType(mimetype); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+	return ".bin"
+}
+
+// compressedMimetype images, videos and archives are stored without
+// compression in the archive
+func compressedMimetype(mimetype string) bool {
+	mimetype = strings.ToLower(mimetype)
+	switch {
+	case mimetype == "image/svg+xml", mimetype == "image/bmp", mimetype == "image/tiff":
+		return false
+	case strings.HasPrefix(mimetype, "image/"), strings.HasPrefix(mimetype, "video/"),
+		strings.HasPrefix(mimetype, "audio/"):
+		return true
+	case mimetype == "application/zip", mimetype == "application/gzip":
+		return true
+	default:
+	}
+	return false
+}
+
+// lobBytes data of the large object value delivered by the database
+func lobBytes(v any) []byte {
+	switch t := v.(type) {
+	case []byte:
+		return t
+	case *[]byte:
+		if t != nil {
+			return *t
+		}
+		return nil
+	default:
+	}
+	return []byte(metadataString(v))
+}
+
+// archiveWriter writes one ZIP entry per record as the database delivers
+// the records
+type archiveWriter struct {
+	table         string
+	field         string
+	mimetypeField string
+	names         *archiveNames
+	maxSize       int64
+	size          int64
+	count         int
+	zip           *zip.Writer
+}
+
+// lobSize size of all large objects matching the search, -1 if the driver
+// cannot calculate it in the database
+func lobSize(d common.RegDbID, table, field, search string) (int64, error) {
+	statement, ok := lobSumStatements[TableDriver(table)]
+	if !ok {
+		return -1, nil
+	}
+	size := int64(0)
+	err := d.BatchSelectFct(&common.Query{Search: fmt.Sprintf(statement, field, table, lobCriteria(search))},
+		func(search *common.Query, result *common.Result) error {
+			if result == nil || len(result.Rows) == 0 {
+				return errorrepo.NewError("REST00006")
+			}
+			if s := metadataString(result.Rows[0]); s != "" {
+				v, err := strconv.ParseFloat(s, 64)
+				if err != nil {
+					return err
+				}
+				size = int64(v)
+			}
+			return nil
+		})
+	return size, err
+}
+
+// query fields of the archive query
+func (aw *archiveWriter) fields() []string {
+	fields := []string{strings.ToLower(aw.field)}
+	if aw.mimetypeField != "" {
+		fields = append(fields, strings.ToLower(aw.mimetypeField))
+	}
+	for _, f := range aw.names.fields {
+		found := false
+		for _, e := range fields {
+			found = found || e == f
+		}
+		if !found {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// write result function adding the record as entry to the archive
+func (aw *archiveWriter) write(search *common.Query, result *common.Result) error {
+	if result == nil {
+		return errorrepo.NewError("REST00011")
+	}
+	values := make(map[string]string)
+	var data []byte
+	lobField := strings.ToLower(aw.field)
+	for i, f := range result.Fields {
+		f = strings.ToLower(f)
+		if f != lobField {
+			values[f] = metadataString(result.Rows[i])
+			continue
+		}
+		data = lobBytes(result.Rows[i])
+	}
+	aw.size += int64(len(data))
+	if aw.size > aw.maxSize {
+		return errorrepo.NewError("REST00059", aw.table, aw.maxSize)
+	}
+	aw.count++
+	mimetype := values[strings.ToLower(aw.mimetypeField)]
+	header := &zip.FileHeader{Name: aw.names.name(aw.count, values, mimetype),
+		Method: zip.Deflate, Modified: time.Now()}
+	if compressedMimetype(mimetype) {
+		header.Method = zip.Store
+	}
+	w, err := aw.zip.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// streamArchive runs the query function in the background and returns the
+// reader receiving the ZIP archive. The query is stopped if the request
+// context is done or the client stops reading.
+func streamArchive(ctx context.Context, aw *archiveWriter, run func(common.ResultFunction) error) io.Reader {
+	piper, pipew := io.Pipe()
+	aw.zip = zip.NewWriter(pipew)
+	stop := context.AfterFunc(ctx, func() {
+		log.Log.Debugf("Request done, stop streaming archive")
+		piper.CloseWithError(ctx.Err())
+	})
+	go func() {
+		defer stop()
+		err := run(aw.write)
+		if err == nil {
+			err = aw.zip.Close()
+		}
+		if err != nil {
+			log.Log.Errorf("Error streaming archive: %v", err)
+		}
+		log.Log.Debugf("Streamed archive with %d entries and %d bytes", aw.count, aw.size)
+		pipew.CloseWithError(err)
+	}()
+	return piper
+}
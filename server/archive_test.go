@@ -0,0 +1,76 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/flynn/common"
+)
+
+func TestArchiveNames(t *testing.T) {
+	n, err := newArchiveNames("Media", "", "{#}_{Title}")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"title"}, n.fields)
+	assert.Equal(t, "1_Abbey Road.jpg", n.name(1, map[string]string{"title": "Abbey Road"}, "image/jpeg"))
+	assert.Equal(t, "2_a_b.png", n.name(2, map[string]string{"title": "a/b"}, "image/png"))
+
+	n, err = newArchiveNames("Media", "Name", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "cover.png", n.name(1, map[string]string{"name": "cover.png"}, "image/jpeg"))
+	assert.Equal(t, "cover-2.png", n.name(2, map[string]string{"name": "cover.png"}, "image/jpeg"))
+	assert.Equal(t, "Media-3.bin", n.name(3, map[string]string{"name": ".."}, ""))
+
+	_, err = newArchiveNames("Media", "", "{a b}")
+	assert.Error(t, err)
+}
+
+func TestArchiveStream(t *testing.T) {
+	rows := [][]any{{[]byte("first"), "image/jpeg", "a"}, {[]byte("second"), "text/plain", "b"}}
+	run := func(fct common.ResultFunction) error {
+		for _, r := range rows {
+			err := fct(nil, &common.Result{Fields: []string{"Media", "Mimetype", "Name"}, Rows: r})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	names, _ := newArchiveNames("Media", "Name", "")
+	aw := &archiveWriter{table: "pictures", field: "Media", mimetypeField: "Mimetype", names: names, maxSize: 100}
+	assert.Equal(t, []string{"media", "mimetype", "name"}, aw.fields())
+	data, err := io.ReadAll(streamArchive(context.Background(), aw, run))
+	assert.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, zr.File, 2) {
+		assert.Equal(t, "a.jpg", zr.File[0].Name)
+		assert.Equal(t, zip.Store, zr.File[0].Method)
+		assert.Equal(t, "b.txt", zr.File[1].Name)
+		assert.Equal(t, zip.Deflate, zr.File[1].Method)
+		f, _ := zr.File[1].Open()
+		content, _ := io.ReadAll(f)
+		assert.Equal(t, "second", string(content))
+	}
+
+	names, _ = newArchiveNames("Media", "Name", "")
+	aw = &archiveWriter{table: "pictures", field: "Media", mimetypeField: "Mimetype", names: names, maxSize: 8}
+	_, err = io.ReadAll(streamArchive(context.Background(), aw, run))
+	assert.Error(t, err)
+}
@@ -12,14 +12,18 @@
 package server
 
 import (
-	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
-	"text/template"
+	"time"
 
 	"github.com/tknie/clu"
 	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
 	"github.com/tknie/flynn/common"
 	"github.com/tknie/log"
 	"github.com/tknie/services/auth"
@@ -53,8 +57,36 @@ func (Handler) BatchSelect(ctx context.Context,
 	if entry == nil {
 		log.Log.Fatal("Query entry empty")
 	}
-	respH, err := querySQLstatement(&batchSelect{session: session, table: params.Table,
-		parameter: params.Param, query: entry})
+	query := &batchSelect{session: session, table: params.Table,
+		parameter: params.Param, query: entry}
+	if format := acceptStream(session.CurrentRequest); format != "" {
+		rs := &recordStream{format: format}
+		if format == mimeTypeCSV {
+			var err error
+			rs.csv, err = newCSVDialect(params.Delimiter, params.Quote, params.Header,
+				params.Null, params.Dateformat, params.Bom)
+			if err != nil {
+				return nil, NewBadRequestError(err)
+			}
+		}
+		reader, err := streamSQLstatement(query, rs)
+		if err != nil {
+			return nil, err
+		}
+		token := api.NewOptString(session.Token)
+		switch format {
+		case mimeTypeCSV:
+			return &api.BatchSelectOKTextCsvHeaders{XToken: token,
+				Response: api.BatchSelectOKTextCsv{Data: reader}}, nil
+		case mimeTypeNDJSON:
+			return &api.BatchSelectOKApplicationXNdjsonHeaders{XToken: token,
+				Response: api.BatchSelectOKApplicationXNdjson{Data: reader}}, nil
+		default:
+			return &api.BatchSelectOKApplicationXJSONStreamHeaders{XToken: token,
+				Response: api.BatchSelectOKApplicationXJSONStream{Data: reader}}, nil
+		}
+	}
+	respH, err := querySQLstatement(query)
 	if err != nil {
 		return nil, err
 	}
@@ -93,9 +125,37 @@ func (Handler) BatchQuery(ctx context.Context, req api.BatchQueryReq,
 	log.Log.Debugf("SQL statement on table %s - %v", params.Table, sqlStatement)
 	// services.ServerMessage("SQL query by user %s: %s", session.User.User, sqlStatement)
 
-	respH, err := querySQLstatement(&batchSelect{session: session, table: params.Table,
+	query := &batchSelect{session: session, table: params.Table,
 		parameter: p,
-		query:     &clu.BatchEntry{Query: sqlStatement, Database: params.Table}})
+		query:     &clu.BatchEntry{Query: sqlStatement, Database: params.Table}}
+	if format := acceptStream(session.CurrentRequest); format != "" {
+		rs := &recordStream{format: format}
+		if format == mimeTypeCSV {
+			var err error
+			rs.csv, err = newCSVDialect(params.Delimiter, params.Quote, params.Header,
+				params.Null, params.Dateformat, params.Bom)
+			if err != nil {
+				return nil, NewBadRequestError(err)
+			}
+		}
+		reader, err := streamSQLstatement(query, rs)
+		if err != nil {
+			return nil, err
+		}
+		token := api.NewOptString(session.Token)
+		switch format {
+		case mimeTypeCSV:
+			return &api.BatchQueryOKTextCsvHeaders{XToken: token,
+				Response: api.BatchQueryOKTextCsv{Data: reader}}, nil
+		case mimeTypeNDJSON:
+			return &api.BatchQueryOKApplicationXNdjsonHeaders{XToken: token,
+				Response: api.BatchQueryOKApplicationXNdjson{Data: reader}}, nil
+		default:
+			return &api.BatchQueryOKApplicationXJSONStreamHeaders{XToken: token,
+				Response: api.BatchQueryOKApplicationXJSONStream{Data: reader}}, nil
+		}
+	}
+	respH, err := querySQLstatement(query)
 	if err != nil {
 		return nil, err
 	}
@@ -103,15 +163,42 @@ func (Handler) BatchQuery(ctx context.Context, req api.BatchQueryReq,
 
 }
 
-func querySQLstatement(query *batchSelect) (*api.ResponseHeaders, error) {
-	log.Log.Debugf("Query/Batch SQL statemant %s: %#v", query.query.Query, query.parameter)
+// prepare connect to the database and bind the batch parameters
+func (query *batchSelect) prepare() (common.RegDbID, *common.Query, error) {
 	d, err := ConnectTable(query.session, query.query.Database)
 	if err != nil {
 		log.Log.Errorf("Error search table %s:%v", query.query.Database, err)
+		return 0, nil, err
+	}
+	statement, bind, err := bindBatchParameter(query.query, TableDriver(query.query.Database), query.parameter)
+	if err != nil {
+		CloseTable(d)
+		return 0, nil, NewBadRequestError(err)
+	}
+	return d, &common.Query{Search: statement, Parameters: bind}, nil
+}
+
+// streamSQLstatement stream the batch query records in the format of the record stream
+func streamSQLstatement(query *batchSelect, rs *recordStream) (io.Reader, error) {
+	log.Log.Debugf("Stream Query/Batch SQL statemant %s: %#v", query.query.Query, query.parameter)
+	d, batch, err := query.prepare()
+	if err != nil {
+		return nil, err
+	}
+	return streamRecords(query.session.CurrentRequest.Context(), rs,
+		func(fct common.ResultFunction) error {
+			defer CloseTable(d)
+			return d.BatchSelectFct(batch, fct)
+		}), nil
+}
+
+func querySQLstatement(query *batchSelect) (*api.ResponseHeaders, error) {
+	log.Log.Debugf("Query/Batch SQL statemant %s: %#v", query.query.Query, query.parameter)
+	d, batch, err := query.prepare()
+	if err != nil {
 		return nil, err
 	}
 	defer CloseTable(d)
-	batch := &common.Query{Search: sqlInParameter(query.query.Query, query.parameter)}
 
 	rria := make([]api.ResponseRecordsItem, 0)
 	var fields []string
@@ -148,6 +235,35 @@ func (Handler) BatchParameterQuery(ctx context.Context, params api.BatchParamete
 	log.Log.Debugf("SQL statement on table %s - %v", params.Table, params.Query)
 	// services.ServerMessage("SQL query by user %s: %s", session.User.User, params.Query)
 
+	if format := acceptStream(session.CurrentRequest); format != "" {
+		rs := &recordStream{format: format}
+		if format == mimeTypeCSV {
+			var err error
+			rs.csv, err = newCSVDialect(params.Delimiter, params.Quote, params.Header,
+				params.Null, params.Dateformat, params.Bom)
+			if err != nil {
+				return nil, NewBadRequestError(err)
+			}
+		}
+		reader, err := streamSQLstatement(&batchSelect{session: session, table: params.Table,
+			query: &clu.BatchEntry{Query: params.Query, Database: params.Table}}, rs)
+		if err != nil {
+			return nil, err
+		}
+		token := api.NewOptString(session.Token)
+		switch format {
+		case mimeTypeCSV:
+			return &api.BatchParameterQueryOKTextCsvHeaders{XToken: token,
+				Response: api.BatchParameterQueryOKTextCsv{Data: reader}}, nil
+		case mimeTypeNDJSON:
+			return &api.BatchParameterQueryOKApplicationXNdjsonHeaders{XToken: token,
+				Response: api.BatchParameterQueryOKApplicationXNdjson{Data: reader}}, nil
+		default:
+			return &api.BatchParameterQueryOKApplicationXJSONStreamHeaders{XToken: token,
+				Response: api.BatchParameterQueryOKApplicationXJSONStream{Data: reader}}, nil
+		}
+	}
+
 	d, err := ConnectTable(session, params.Table)
 	if err != nil {
 		log.Log.Errorf("Error search table %s:%v", params.Table, err)
@@ -177,35 +293,162 @@ func (Handler) BatchParameterQuery(ctx context.Context, params api.BatchParamete
 	return respH, nil
 }
 
-func sqlInParameter(statement string, params []string) string {
-	st := statement
-	sqlParameterMap := make(map[string]string)
+// bindBatchParameter parse the ^name:value parameters, check them against the
+// declared parameters of the batch entry and replace the <name> placeholders
+// with bind variables of the database driver. All problems are collected and
+// returned in one error.
+func bindBatchParameter(entry *clu.BatchEntry, driver common.ReferenceType, params []string) (string, []any, error) {
+	declared, err := entry.DeclaredParameters()
+	if err != nil {
+		return "", nil, err
+	}
+	problems := make([]string, 0)
+	given := make(map[string]string)
 	for _, p := range params {
 		log.Log.Debugf("Given parameter '%s'", p)
 		np := strings.Trim(p, "\"")
-		if np[0] == '^' {
-			pv := strings.Split(np, ":")
-			if len(np) > len(pv[0]) {
-				sqlParameterMap[pv[0][1:]] = np[len(pv[0])+1:]
-				log.Log.Debugf("Handle parameter %s : %s", pv[0], np[len(pv[0])+1:])
-				st = strings.Replace(st, "<"+pv[0][1:]+">", np[len(pv[0])+1:], -1)
+		name, value, found := strings.Cut(np, ":")
+		if !found || !strings.HasPrefix(name, "^") {
+			problems = append(problems, fmt.Sprintf("parameter '%s' not in format ^name:value", np))
+			continue
+		}
+		given[name[1:]] = value
+	}
+	values := make(map[string]any)
+	known := make(map[string]bool)
+	for _, d := range declared {
+		known[d.Name] = true
+		v, ok := given[d.Name]
+		if !ok {
+			if d.Default == "" {
+				if d.Required {
+					problems = append(problems, fmt.Sprintf("parameter '%s' missing", d.Name))
+				} else {
+					values[d.Name] = nil
+				}
+				continue
+			}
+			v = d.Default
+		}
+		if d.Pattern != "" {
+			match, err := regexp.MatchString("^(?:"+d.Pattern+")$", v)
+			if err != nil || !match {
+				problems = append(problems, fmt.Sprintf("parameter '%s' does not match pattern %s", d.Name, d.Pattern))
+				continue
 			}
 		}
+		cv, err := convertBatchValue(d.Type, v)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("parameter '%s' is not of type %s", d.Name, d.Type))
+			continue
+		}
+		values[d.Name] = cv
+	}
+	for name := range given {
+		if !known[name] {
+			problems = append(problems, fmt.Sprintf("parameter '%s' unknown", name))
+		}
 	}
-	var buffer bytes.Buffer
-	t, err := template.New("queryTemplate").Parse(st)
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return "", nil, errorrepo.NewError("REST00017", strings.Join(problems, "; "))
+	}
+	bind := make([]any, 0)
+	statement, err := batchStatement(entry.Query, known, func(name string) string {
+		bind = append(bind, values[name])
+		switch driver {
+		case common.PostgresType:
+			return "$" + strconv.Itoa(len(bind))
+		case common.OracleType:
+			return ":" + strconv.Itoa(len(bind))
+		default:
+			return "?"
+		}
+	})
 	if err != nil {
-		log.Log.Errorf("Error creating SQL batch query template: %s", err)
-		return statement
+		return "", nil, err
 	}
+	log.Log.Debugf("SQL in : %s", entry.Query)
+	log.Log.Debugf("SQL out: %s %#v", statement, bind)
+	return statement, bind, nil
+}
 
-	err = t.Execute(&buffer, sqlParameterMap)
-	if err != nil {
-		log.Log.Errorf("Error executing SQL batch query template: %s", err)
-		return statement
+// batchStatement replace the <name> placeholders of the known parameters with
+// the bind variable returned by the bind function. Placeholders quoted like
+// '<name>' are written by older versions inserting the value into the SQL
+// text, the quotes are removed. Placeholders inside a longer quoted string
+// like '%<name>%' cannot be bound and are rejected.
+func batchStatement(query string, known map[string]bool, bind func(name string) string) (string, error) {
+	var b strings.Builder
+	for pos := 0; pos < len(query); {
+		c := query[pos]
+		switch {
+		case c == '\'':
+			end := pos + 1
+			for end < len(query) {
+				if query[end] == '\'' {
+					if end+1 < len(query) && query[end+1] == '\'' {
+						end += 2
+						continue
+					}
+					break
+				}
+				end++
+			}
+			literal := query[pos+1 : min(end, len(query))]
+			quoted := ""
+			for _, m := range clu.BatchPlaceholderRegexp.FindAllStringSubmatch(literal, -1) {
+				if !known[m[1]] {
+					continue
+				}
+				if m[0] != literal {
+					return "", errorrepo.NewError("REST00062", m[1])
+				}
+				quoted = m[1]
+			}
+			if quoted != "" {
+				b.WriteString(bind(quoted))
+			} else {
+				b.WriteString(query[pos:min(end+1, len(query))])
+			}
+			pos = end + 1
+		case c == '<':
+			m := clu.BatchPlaceholderRegexp.FindStringSubmatch(query[pos:])
+			if m != nil && strings.HasPrefix(query[pos:], m[0]) && known[m[1]] {
+				b.WriteString(bind(m[1]))
+				pos += len(m[0])
+				continue
+			}
+			b.WriteByte(c)
+			pos++
+		default:
+			b.WriteByte(c)
+			pos++
+		}
+	}
+	return b.String(), nil
+}
+
+// convertBatchValue convert parameter value to the declared type
+func convertBatchValue(paramType, value string) (any, error) {
+	switch strings.ToLower(paramType) {
+	case "string", "":
+		return value, nil
+	case "integer":
+		return strconv.ParseInt(value, 10, 64)
+	case "number":
+		return strconv.ParseFloat(value, 64)
+	case "boolean":
+		return strconv.ParseBool(value)
+	case "date":
+		return time.Parse(time.DateOnly, value)
+	case "timestamp":
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Parse(TimeFormat, value)
+		}
+		return t, nil
+	default:
 	}
-	log.Log.Debugf("SQL in : %s", statement)
-	log.Log.Debugf("SQL out: %s", st)
-	log.Log.Debugf("SQL outTemplate: %s", buffer.String())
-	return buffer.String()
+	return nil, errorrepo.NewError("REST00018", paramType)
 }
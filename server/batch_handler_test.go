@@ -0,0 +1,88 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+)
+
+func errorID(err error) string {
+	if e, ok := err.(*errorrepo.Error); ok {
+		return e.ID()
+	}
+	return ""
+}
+
+func TestBindBatchParameter(t *testing.T) {
+	entry := &clu.BatchEntry{Name: "tags",
+		Query:      "SELECT * FROM tags WHERE name LIKE <tag> AND id > <from> AND note <> '<unknown>' OR name = <tag>",
+		Parameters: `[{"name":"tag","required":true},{"name":"from","type":"integer","default":"10"}]`}
+	tests := []struct {
+		driver common.ReferenceType
+		want   string
+	}{
+		{common.PostgresType, "SELECT * FROM tags WHERE name LIKE $1 AND id > $2 AND note <> '<unknown>' OR name = $3"},
+		{common.MysqlType, "SELECT * FROM tags WHERE name LIKE ? AND id > ? AND note <> '<unknown>' OR name = ?"},
+		{common.OracleType, "SELECT * FROM tags WHERE name LIKE :1 AND id > :2 AND note <> '<unknown>' OR name = :3"},
+	}
+	for _, tt := range tests {
+		statement, bind, err := bindBatchParameter(entry, tt.driver, []string{"^tag:holiday%"})
+		if assert.NoError(t, err, tt.driver.String()) {
+			assert.Equal(t, tt.want, statement, tt.driver.String())
+			assert.Equal(t, []any{"holiday%", int64(10), "holiday%"}, bind)
+		}
+	}
+
+	_, _, err := bindBatchParameter(entry, common.PostgresType, []string{"^from:x", "^other:1", "tag:x"})
+	assert.Equal(t, "REST00017", errorID(err))
+}
+
+func TestBindBatchQuotedParameter(t *testing.T) {
+	entry := &clu.BatchEntry{Name: "old", Query: "SELECT * FROM t WHERE a = '<a>' AND b = 'it''s' AND c = '<b>'"}
+	statement, bind, err := bindBatchParameter(entry, common.PostgresType, []string{"^a:x", "^b:y"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "SELECT * FROM t WHERE a = $1 AND b = 'it''s' AND c = $2", statement)
+		assert.Equal(t, []any{"x", "y"}, bind)
+	}
+
+	entry.Query = "SELECT * FROM t WHERE a LIKE '%<a>%'"
+	_, _, err = bindBatchParameter(entry, common.MysqlType, []string{"^a:x"})
+	assert.Equal(t, "REST00062", errorID(err))
+
+	entry.Query = "SELECT * FROM t WHERE a = '<a>"
+	statement, _, err = bindBatchParameter(entry, common.MysqlType, []string{"^a:x"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "SELECT * FROM t WHERE a = ?", statement)
+	}
+}
+
+func TestConvertBatchValue(t *testing.T) {
+	v, err := convertBatchValue("boolean", "true")
+	assert.NoError(t, err)
+	assert.Equal(t, true, v)
+	v, err = convertBatchValue("number", "1.5")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, v)
+	v, err = convertBatchValue("date", "2024-12-31")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), v)
+	_, err = convertBatchValue("timestamp", "yesterday")
+	assert.Error(t, err)
+	_, err = convertBatchValue("blob", "x")
+	assert.Equal(t, "REST00018", errorID(err))
+}
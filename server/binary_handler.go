@@ -12,17 +12,33 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
 
 	"github.com/tknie/clu"
 	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
 	"github.com/tknie/log"
 	"github.com/tknie/services/auth"
 )
 
+// defaultMaxLobSize maximum size of uploaded large objects if no
+// maxBinaryBufferSize is configured. The upload is held in memory until it
+// is written with one update, each concurrent upload can use this size.
+const defaultMaxLobSize = 16 << 20
+
 // GetImage implements getImage operation.
 //
-// Retrieves a field of a specific ISN of a Map definition.
+// Retrieves a field of a specific ISN of a Map definition. The image can be
+// resized, cropped and converted to another format. Derived images are kept
+// in the image cache if it is configured.
 //
 // GET /image/{table}/{field}/{search}
 func (Handler) GetImage(ctx context.Context, params api.GetImageParams) (r api.GetImageRes, _ error) {
@@ -31,6 +47,10 @@ func (Handler) GetImage(ctx context.Context, params api.GetImageParams) (r api.G
 	if !Validate(session, auth.UserRole, params.Table) {
 		return &api.GetImageForbidden{}, nil
 	}
+	if isRawSearch(params.Search) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.GetImageForbidden{}, nil
+	}
 
 	mimeTypeField := ""
 	if params.MimetypeField != "" {
@@ -41,15 +61,51 @@ func (Handler) GetImage(ctx context.Context, params api.GetImageParams) (r api.G
 		mimeType = params.Mimetype.Value
 	}
 
-	log.Log.Debugf("SQL image search table=%s field=%s search=%s", params.Table, params.Field, params.Search)
-	read := NewStreamRead(params.Table, params.Field, mimeTypeField)
-	err := read.initStreamFromTable(session, params.Search, mimeType)
-	if err != nil {
-		log.Log.Errorf("Error search table %s:%v", params.Table, err)
-		return nil, err
+	transform := newImageTransform(params)
+	cache := derivedImages.Load()
+	if transform == nil && mimeType == "" {
+		cache = nil
+	}
+	key, source, checked := "", "", false
+	sourceChecksum := func() string {
+		if !checked {
+			source = imageSource(session, params.Table, params.Field, params.Search)
+			checked = true
+		}
+		return source
+	}
+	var read *StreamRead
+	if cache != nil {
+		key = imageCacheKey(params.Table, params.Field, params.Search, mimeTypeField, mimeType, transform)
+		if e, ok := cache.get(key, sourceChecksum); ok {
+			log.Log.Debugf("Image of table %s found in cache", params.Table)
+			read = &StreamRead{table: params.Table, data: e.data, mimetype: e.mimetype}
+		} else if cache.invalidate {
+			sourceChecksum()
+		}
 	}
-	if read.mimetype == "" {
-		read.mimetype = "image/jpeg"
+	if read == nil {
+		log.Log.Debugf("SQL image search table=%s field=%s search=%s", params.Table, params.Field, params.Search)
+		read = NewStreamRead(params.Table, params.Field, mimeTypeField)
+		err := read.initStreamFromTable(session, params.Search, mimeType)
+		if err != nil {
+			log.Log.Errorf("Error search table %s:%v", params.Table, err)
+			return nil, err
+		}
+		if read.mimetype == "" {
+			read.mimetype = "image/jpeg"
+		}
+		if transform != nil {
+			read.data, read.mimetype, err = transform.apply(read.data, read.mimetype)
+			if err != nil {
+				log.Log.Errorf("Error transform image of table %s:%v", params.Table, err)
+				return nil, repoBadRequestError(err)
+			}
+		}
+		if cache != nil {
+			cache.put(&imageCacheEntry{key: key, table: params.Table, mimetype: read.mimetype,
+				source: source, data: read.data})
+		}
 	}
 	read.field = params.Field
 	reader, err := read.streamResponderFunc()
@@ -63,9 +119,121 @@ func (Handler) GetImage(ctx context.Context, params api.GetImageParams) (r api.G
 	return r, nil
 }
 
+// GetArchive implements getArchive operation.
+//
+// Retrieves the large object field of all records matching the search as
+// ZIP archive with one entry per record.
+//
+// GET /archive/{table}/{field}/{search}
+func (Handler) GetArchive(ctx context.Context, params api.GetArchiveParams) (r api.GetArchiveRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.GetArchiveForbidden{}, nil
+	}
+	if isRawSearch(params.Search) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.GetArchiveForbidden{}, nil
+	}
+	for _, n := range []string{params.Table, params.Field, params.MimetypeField.Value} {
+		if n != "" && !fieldNameRegexp.MatchString(n) {
+			return (*api.GetArchiveBadRequest)(lobError(errorrepo.NewError("RERR00026", n))), nil
+		}
+	}
+	names, err := newArchiveNames(params.Field, params.NameField.Value, params.NamePattern.Value)
+	if err != nil {
+		return (*api.GetArchiveBadRequest)(lobError(err)), nil
+	}
+	maxRows, maxSize := archiveLimits(params.Limit, params.MaxSize)
+
+	d, err := ConnectTable(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error search table %s:%v", params.Table, err)
+		return nil, err
+	}
+	search, err := compileSearch(d, params.Table, params.Search)
+	if err != nil {
+		CloseTable(d)
+		return (*api.GetArchiveBadRequest)(lobError(err)), nil
+	}
+	count, err := countRecords(d, params.Table, &common.Query{TableName: params.Table, Search: search})
+	if err != nil {
+		CloseTable(d)
+		return nil, err
+	}
+	switch {
+	case count == 0:
+		CloseTable(d)
+		return (*api.GetArchiveNotFound)(lobError(errorrepo.NewError("REST00002", params.Field, params.Table))), nil
+	case count > maxRows:
+		CloseTable(d)
+		return (*api.GetArchiveRequestEntityTooLarge)(lobError(errorrepo.NewError("REST00058",
+			params.Table, count, maxRows))), nil
+	default:
+	}
+	size, err := lobSize(d, params.Table, params.Field, search)
+	if err != nil {
+		CloseTable(d)
+		return nil, err
+	}
+	if size > maxSize {
+		CloseTable(d)
+		return (*api.GetArchiveRequestEntityTooLarge)(lobError(errorrepo.NewError("REST00059",
+			params.Table, maxSize))), nil
+	}
+	aw := &archiveWriter{table: params.Table, field: params.Field, mimetypeField: params.MimetypeField.Value,
+		names: names, maxSize: maxSize}
+	q := &common.Query{TableName: params.Table, Fields: aw.fields(), Search: search}
+	log.Log.Debugf("Archive %d records of table %s field %s", count, params.Table, params.Field)
+	reader := streamArchive(session.CurrentRequest.Context(), aw, func(fct common.ResultFunction) error {
+		defer CloseTable(d)
+		_, err := d.Query(q, fct)
+		return err
+	})
+	return &api.GetArchiveOKHeaders{
+		ContentDisposition: api.NewOptString(fmt.Sprintf(`attachment; filename="%s.zip"`, params.Table)),
+		Response:           api.GetArchiveOK{Data: reader}}, nil
+}
+
+// GetImageMetadata implements getImageMetadata operation.
+//
+// Retrieves the EXIF tags, dimensions and format of an image field of a
+// specific table record without the image data.
+//
+// GET /image/{table}/{field}/{search}/metadata
+func (Handler) GetImageMetadata(ctx context.Context, params api.GetImageMetadataParams) (r api.GetImageMetadataRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.GetImageMetadataForbidden{}, nil
+	}
+	if isRawSearch(params.Search) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.GetImageMetadataForbidden{}, nil
+	}
+	read := NewStreamRead(params.Table, params.Field, params.MimetypeField.Value)
+	err := read.initStreamFromTable(session, params.Search, "")
+	if err != nil {
+		log.Log.Errorf("Error search table %s:%v", params.Table, err)
+		if e, ok := err.(*errorrepo.Error); ok {
+			switch e.ID() {
+			case "REST00002", "REST00009":
+				return (*api.GetImageMetadataNotFound)(lobError(err)), nil
+			default:
+			}
+		}
+		return nil, repoBadRequestError(err)
+	}
+	m, err := imageMetadata(read.data, read.mimetype)
+	if err != nil {
+		log.Log.Errorf("Error image metadata of table %s:%v", params.Table, err)
+		return (*api.GetImageMetadataBadRequest)(lobError(err)), nil
+	}
+	return m, nil
+}
+
 // GetVideo implements getVideo operation.
 //
-// Retrieves a video stream of a specific ISN of a Map definition.
+// Retrieves a video stream of a specific ISN of a Map definition. Byte
+// ranges of the video can be requested with the Range header.
 //
 // GET /video/{table}/{field}/{search}
 func (Handler) GetVideo(ctx context.Context, params api.GetVideoParams) (r api.GetVideoRes, _ error) {
@@ -74,29 +242,42 @@ func (Handler) GetVideo(ctx context.Context, params api.GetVideoParams) (r api.G
 	if !Validate(session, auth.UserRole, params.Table) {
 		return &api.GetVideoForbidden{}, nil
 	}
+	if isRawSearch(params.Search) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.GetVideoForbidden{}, nil
+	}
 	log.Log.Debugf("SQL video table=%s field=%s search=%s", params.Table, params.Field, params.Search)
-	read := NewStreamRead(params.Table, params.Field, params.MimetypeField)
-	err := read.initStreamFromTable(session, params.Search, params.Mimetype.Value)
+	lc, err := newLobContent(session, params.Table, params.Field, params.MimetypeField,
+		params.Search, params.Mimetype.Value)
 	if err != nil {
 		log.Log.Errorf("Error search table %s:%v", params.Table, err)
 		return nil, err
 	}
-	read.mimetype = params.MimetypeField
-	read.field = params.Field
-	reader, err := read.streamResponderFunc()
-	if err != nil {
-		log.Log.Errorf("Error search table %s:%v", params.Table, err)
-		return nil, err
+	mimetype := lc.mimetype
+	if mimetype == "" {
+		mimetype = "video/mp4"
 	}
-	vOk := api.GetVideoOK{Data: reader}
-	r = &api.GetVideoOKHeaders{ContentType: read.mimetype, Response: vOk}
-	log.Log.Debugf("Return VIDEO: %#v\n", r)
-	return r, nil
+	resp := lc.respond(params.Range.Value, params.IfRange.Value, mimetype)
+	log.Log.Debugf("Return VIDEO status %d length %d", resp.status, resp.length)
+	switch resp.status {
+	case http.StatusRequestedRangeNotSatisfiable:
+		return &api.GetVideoRequestedRangeNotSatisfiable{ContentRange: api.NewOptString(resp.contentRange)}, nil
+	case http.StatusPartialContent:
+		return &api.GetVideoPartialContentHeaders{AcceptRanges: api.NewOptString("bytes"),
+			ContentLength: api.NewOptInt64(resp.length), ContentRange: api.NewOptString(resp.contentRange),
+			ContentType: resp.contentType, ETag: api.NewOptString(lc.etag),
+			Response: api.GetVideoPartialContent{Data: resp.body}}, nil
+	default:
+	}
+	return &api.GetVideoOKHeaders{AcceptRanges: api.NewOptString("bytes"),
+		ContentLength: api.NewOptInt64(resp.length), ContentType: resp.contentType,
+		ETag: api.NewOptString(lc.etag), Response: api.GetVideoOK{Data: resp.body}}, nil
 }
 
 // GetLobByMap implements getLobByMap operation.
 //
-// Retrieves a lob of a specific ISN of an field in a Map.
+// Retrieves a lob of a specific ISN of an field in a Map. Byte ranges of
+// the lob can be requested with the Range header.
 //
 // GET /binary/{table}/{field}/{search}
 func (Handler) GetLobByMap(ctx context.Context, params api.GetLobByMapParams) (r api.GetLobByMapRes, _ error) {
@@ -105,35 +286,253 @@ func (Handler) GetLobByMap(ctx context.Context, params api.GetLobByMapParams) (r
 	if !Validate(session, auth.UserRole, params.Table) {
 		return &api.GetLobByMapForbidden{}, nil
 	}
+	if isRawSearch(params.Search) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.GetLobByMapForbidden{}, nil
+	}
 
-	mimeTypeField := ""
-	if params.MimetypeField.Set && params.MimetypeField.Value != "" {
-		mimeTypeField = params.MimetypeField.Value
+	log.Log.Debugf("SQL lob search table=%s field=%s search=%s", params.Table, params.Field, params.Search)
+	lc, err := newLobContent(session, params.Table, params.Field, params.MimetypeField.Value,
+		params.Search, params.Mimetype.Value)
+	if err != nil {
+		log.Log.Errorf("Error search table %s:%v", params.Table, err)
+		return nil, err
 	}
-	mimeType := ""
-	if params.Mimetype.Set {
-		mimeType = params.Mimetype.Value
+	mimetype := lc.mimetype
+	if mimetype == "" {
+		mimetype = "application/octet-stream"
 	}
-	log.Log.Debugf("SQL image search", params.Table, params.Field, params.Search)
-	read := NewStreamRead(params.Table, params.Field, mimeTypeField)
-	if read.mimetype == "" {
-		read.mimetype = mimeType
+	resp := lc.respond(params.Range.Value, params.IfRange.Value, mimetype)
+	log.Log.Debugf("Return LOB status %d length %d", resp.status, resp.length)
+	switch resp.status {
+	case http.StatusRequestedRangeNotSatisfiable:
+		return &api.GetLobByMapRequestedRangeNotSatisfiable{ContentRange: api.NewOptString(resp.contentRange)}, nil
+	case http.StatusPartialContent:
+		return &api.GetLobByMapPartialContentHeaders{AcceptRanges: api.NewOptString("bytes"),
+			ContentLength: api.NewOptInt64(resp.length), ContentRange: api.NewOptString(resp.contentRange),
+			ContentType: resp.contentType, ETag: api.NewOptString(lc.etag),
+			Response: api.GetLobByMapPartialContent{Data: resp.body}}, nil
+	default:
 	}
-	err := read.initStreamFromTable(session, params.Search, mimeType)
+	return &api.GetLobByMapOKHeaders{AcceptRanges: api.NewOptString("bytes"),
+		ContentLength: api.NewOptInt64(resp.length), ETag: api.NewOptString(lc.etag),
+		Response: api.GetLobByMapOK{Data: resp.body}}, nil
+}
+
+// UpdateLobByMap implements updateLobByMap operation.
+//
+// Set a lob at a specific table record of an field in a Map. The request
+// body is streamed into the field of exactly one record matching the search.
+// On PostgreSQL and MySQL tables with primary key the body is appended block
+// by block inside one transaction, other tables read the body into memory up
+// to the maxBinaryBufferSize limit.
+//
+// PUT /binary/{table}/{field}/{search}
+func (Handler) UpdateLobByMap(ctx context.Context, req api.UpdateLobByMapReq, params api.UpdateLobByMapParams) (r api.UpdateLobByMapRes, _ error) {
+	log.Log.Debugf("PUT LOB ...")
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.UpdateLobByMapForbidden{}, nil
+	}
+	if isRawSearch(params.Search) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.UpdateLobByMapForbidden{}, nil
+	}
+	if driver := TableDriver(params.Table); driver == common.AdabasType {
+		return (*api.UpdateLobByMapBadRequest)(lobError(errorrepo.NewError("REST00054", driver.String()))), nil
+	}
+	field, mimetypeField, err := lobFields(session, params)
 	if err != nil {
-		log.Log.Errorf("Error search table %s:%v", params.Table, err)
+		return (*api.UpdateLobByMapBadRequest)(lobError(err)), nil
+	}
+	mimetype := params.Mimetype.Value
+	var reader io.Reader
+	switch b := req.(type) {
+	case *api.UpdateLobByMapReqApplicationOctetStream:
+		reader = b.Data
+	case *api.UpdateLobByMapReqMultipartFormData:
+		reader = b.UploadLob.File
+		if mimetype == "" {
+			mimetype = b.UploadLob.Header.Get("Content-Type")
+		}
+	default:
+		return nil, NewBadRequestError(errorrepo.NewError("REST00035", "request body"))
+	}
+
+	d, err := ConnectTable(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error update lob table %s:%v", params.Table, err)
 		return nil, err
 	}
-	if read.mimetype == "" {
-		read.mimetype = "application/octet-stream"
+	defer CloseTable(d)
+	search, err := compileSearch(d, params.Table, params.Search)
+	if err != nil {
+		return (*api.UpdateLobByMapBadRequest)(lobError(err)), nil
 	}
-	read.field = params.Field
-	reader, err := read.streamResponderFunc()
+	count, err := countRecords(d, params.Table, &common.Query{TableName: params.Table,
+		Fields: []string{"*"}, Search: search})
 	if err != nil {
-		log.Log.Errorf("Error search table %s:%v", params.Table, err)
 		return nil, err
 	}
-	r = &api.GetLobByMapOK{Data: reader}
-	log.Log.Debugf("Return LOB: %#v\n", r)
-	return r, nil
+	switch {
+	case count == 0:
+		return (*api.UpdateLobByMapNotFound)(lobError(errorrepo.NewError("REST00002", field, params.Table))), nil
+	case count > 1:
+		return (*api.UpdateLobByMapConflict)(lobError(errorrepo.NewError("REST00052", count))), nil
+	default:
+	}
+
+	limit := lobLimit(params.MaxSize)
+	if keys, kerr := primaryKeyFields(session, params.Table); kerr == nil && lobChunked(TableDriver(params.Table)) {
+		lw := &lobWrite{table: params.Table, field: field, mimetypeField: mimetypeField,
+			mimetype: mimetype, driver: TableDriver(params.Table), keys: keys}
+		result, err := lw.execute(d, search, reader, limit)
+		switch {
+		case err != nil:
+			log.Log.Errorf("Error write lob %s.%s: %v", params.Table, field, err)
+			if rerr, ok := err.(*errorrepo.Error); ok && rerr.ID() == "REST00053" {
+				return (*api.UpdateLobByMapRequestEntityTooLarge)(lobError(err)), nil
+			}
+			return nil, err
+		case result.records == 0:
+			return (*api.UpdateLobByMapNotFound)(lobError(errorrepo.NewError("REST00002", field, params.Table))), nil
+		case result.records > 1:
+			return (*api.UpdateLobByMapConflict)(lobError(errorrepo.NewError("REST00052", result.records))), nil
+		default:
+		}
+		purgeImages(params.Table)
+		log.Log.Debugf("Stored lob %s.%s with %d bytes checksum %s", params.Table, field, result.size, result.checksum)
+		return &api.LobUpload{Table: api.NewOptString(params.Table), Field: api.NewOptString(field),
+			Mimetype: optString(mimetype), Size: api.NewOptInt64(result.size),
+			Checksum: api.NewOptString(result.checksum)}, nil
+	}
+
+	data, checksum, err := readLob(reader, limit)
+	if err != nil {
+		log.Log.Errorf("Error reading lob for %s.%s: %v", params.Table, field, err)
+		if _, ok := err.(*errorrepo.Error); ok {
+			return (*api.UpdateLobByMapRequestEntityTooLarge)(lobError(err)), nil
+		}
+		return nil, err
+	}
+	fields := []string{field}
+	values := []any{data}
+	if mimetypeField != "" && mimetype != "" {
+		fields = append(fields, mimetypeField)
+		values = append(values, mimetype)
+	}
+	// the record may have changed since counting, so the update is only
+	// committed if it changes exactly one record
+	err = d.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+	_, n, err := d.Update(params.Table, &common.Entries{Fields: fields,
+		Update: []string{lobCriteria(search)}, Values: [][]any{values}})
+	if err != nil || n != 1 {
+		if rerr := d.Rollback(); rerr != nil {
+			log.Log.Errorf("Error rollback lob update on %s: %v", params.Table, rerr)
+		}
+		switch {
+		case err != nil:
+			log.Log.Errorf("Error update lob %s.%s: %v", params.Table, field, err)
+			return nil, err
+		case n == 0:
+			return (*api.UpdateLobByMapNotFound)(lobError(errorrepo.NewError("REST00002", field, params.Table))), nil
+		default:
+			return (*api.UpdateLobByMapConflict)(lobError(errorrepo.NewError("REST00052", n))), nil
+		}
+	}
+	if err = d.Commit(); err != nil {
+		return nil, err
+	}
+	purgeImages(params.Table)
+	log.Log.Debugf("Stored lob %s.%s with %d bytes checksum %s", params.Table, field, len(data), checksum)
+	return &api.LobUpload{Table: api.NewOptString(params.Table), Field: api.NewOptString(field),
+		Mimetype: optString(mimetype), Size: api.NewOptInt64(int64(len(data))),
+		Checksum: api.NewOptString(checksum)}, nil
+}
+
+// lobFields check the large object field and the mimetype field are part
+// of the table. Without mimetypeField parameter the mimetype column of the
+// table metadata is used.
+func lobFields(session *clu.Context, params api.UpdateLobByMapParams) (string, string, error) {
+	m, err := tableMetadata(session, params.Table)
+	if err != nil {
+		return "", "", err
+	}
+	column := func(name string) (api.TableColumn, bool) {
+		for _, c := range m.Columns {
+			if strings.EqualFold(c.Name.Value, name) {
+				return c, true
+			}
+		}
+		return api.TableColumn{}, false
+	}
+	c, ok := column(params.Field)
+	if !ok {
+		return "", "", errorrepo.NewError("REST00046", params.Field)
+	}
+	mimetypeField := c.MimetypeField.Value
+	if params.MimetypeField.Value != "" {
+		mc, ok := column(params.MimetypeField.Value)
+		if !ok {
+			return "", "", errorrepo.NewError("REST00046", params.MimetypeField.Value)
+		}
+		mimetypeField = mc.Name.Value
+	}
+	return c.Name.Value, mimetypeField, nil
+}
+
+// lobLimit maximum size of the large object. The request can only lower the
+// configured limit.
+func lobLimit(maxSize api.OptInt64) int64 {
+	limit := int64(defaultMaxLobSize)
+	if clu.Viewer != nil && clu.Viewer.Common.MaxBinaryBufferSize > 0 {
+		limit = int64(clu.Viewer.Common.MaxBinaryBufferSize)
+	}
+	if maxSize.Set && maxSize.Value > 0 && maxSize.Value < limit {
+		limit = maxSize.Value
+	}
+	return limit
+}
+
+// readLob read the large object block by block up to the limit and
+// calculate the SHA-256 checksum while reading. The object is kept in memory
+// on drivers without block writes, flynn updates the field with the complete
+// value only.
+func readLob(reader io.Reader, limit int64) ([]byte, string, error) {
+	var buffer bytes.Buffer
+	h := sha256.New()
+	n, err := io.CopyBuffer(io.MultiWriter(&buffer, h), io.LimitReader(reader, limit+1),
+		make([]byte, blockSize))
+	if err != nil {
+		return nil, "", err
+	}
+	if n > limit {
+		return nil, "", errorrepo.NewError("REST00053", limit)
+	}
+	return buffer.Bytes(), hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// lobCriteria update criteria of the search. flynn dbsql.CreateWhere only
+// writes update entries containing one of =<> as condition into the where
+// clause, other entries are taken as field names compared with the field
+// value. Searches without comparison like a LIKE or IS NULL condition get
+// " AND 1=1" appended to be used as condition.
+func lobCriteria(search string) string {
+	switch {
+	case strings.TrimSpace(search) == "":
+		return "1=1"
+	case strings.ContainsAny(search, "=<>"):
+		return "(" + search + ")"
+	default:
+		return "(" + search + ") AND 1=1"
+	}
+}
+
+// lobError error response of the large object upload
+func lobError(err error) *api.Error {
+	e := NewBadRequestError(err).Response
+	return &e
 }
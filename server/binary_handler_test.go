@@ -0,0 +1,41 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+)
+
+func TestLobCriteria(t *testing.T) {
+	assert.Equal(t, "1=1", lobCriteria(" "))
+	assert.Equal(t, "(ID=1)", lobCriteria("ID=1"))
+	assert.Equal(t, "(Name LIKE 'a%') AND 1=1", lobCriteria("Name LIKE 'a%'"))
+	assert.Equal(t, "(Name IS NULL) AND 1=1", lobCriteria("Name IS NULL"))
+}
+
+func TestReadLob(t *testing.T) {
+	assert.Equal(t, int64(defaultMaxLobSize), lobLimit(api.OptInt64{}))
+	assert.Equal(t, int64(10), lobLimit(api.NewOptInt64(10)))
+	assert.Equal(t, int64(defaultMaxLobSize), lobLimit(api.NewOptInt64(defaultMaxLobSize+1)))
+
+	data, checksum, err := readLob(strings.NewReader("abc"), 3)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "abc", string(data))
+		assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", checksum)
+	}
+	_, _, err = readLob(strings.NewReader("abcd"), 3)
+	assert.Equal(t, "REST00053", errorID(err))
+}
@@ -0,0 +1,263 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-faster/jx"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tknie/log"
+)
+
+// maxSafeInteger maximal integer which can be represented in JSON numbers
+// without loosing precision in JavaScript clients
+const maxSafeInteger = 9007199254740991
+
+// TypeEncoder encodes a database result value of a specific Go type into JSON
+type TypeEncoder func(e *jx.Encoder, v any) error
+
+var typeEncoderMap = sync.Map{}
+
+// RegisterTypeEncoder register the JSON encoder for the Go type of the given
+// sample value. Drivers and plugins can add new types or replace the
+// default encoders.
+func RegisterTypeEncoder(sample any, encoder TypeEncoder) {
+	typeEncoderMap.Store(reflect.TypeOf(sample), encoder)
+}
+
+func init() {
+	RegisterTypeEncoder("", func(e *jx.Encoder, v any) error {
+		e.Str(v.(string))
+		return nil
+	})
+	RegisterTypeEncoder([]byte{}, func(e *jx.Encoder, v any) error {
+		e.Base64(v.([]byte))
+		return nil
+	})
+	RegisterTypeEncoder(time.Time{}, func(e *jx.Encoder, v any) error {
+		e.Str(v.(time.Time).UTC().Format(time.RFC3339))
+		return nil
+	})
+	RegisterTypeEncoder(pgtype.Numeric{}, encodeNumeric)
+	RegisterTypeEncoder([16]byte{}, func(e *jx.Encoder, v any) error {
+		u := v.([16]byte)
+		e.Str(fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16]))
+		return nil
+	})
+	RegisterTypeEncoder(pgtype.Interval{}, encodeInterval)
+	RegisterTypeEncoder(pgtype.Time{}, func(e *jx.Encoder, v any) error {
+		t := v.(pgtype.Time)
+		if !t.Valid {
+			e.Null()
+			return nil
+		}
+		d := time.Duration(t.Microseconds) * time.Microsecond
+		e.Str(time.Time{}.Add(d).Format("15:04:05.999999"))
+		return nil
+	})
+	RegisterTypeEncoder(net.HardwareAddr{}, func(e *jx.Encoder, v any) error {
+		e.Str(v.(net.HardwareAddr).String())
+		return nil
+	})
+	RegisterTypeEncoder(netip.Prefix{}, func(e *jx.Encoder, v any) error {
+		e.Str(v.(netip.Prefix).String())
+		return nil
+	})
+	RegisterTypeEncoder(netip.Addr{}, func(e *jx.Encoder, v any) error {
+		e.Str(v.(netip.Addr).String())
+		return nil
+	})
+}
+
+// encodeValue encodes the value using the registered type encoders. Types
+// without registered encoder are encoded by their kind.
+func encodeValue(e *jx.Encoder, v any) error {
+	if v == nil {
+		e.Null()
+		return nil
+	}
+	if enc, ok := typeEncoderMap.Load(reflect.TypeOf(v)); ok {
+		return enc.(TypeEncoder)(e, v)
+	}
+	if dv, ok := v.(driver.Valuer); ok {
+		// database/sql null types like sql.NullString
+		x, err := dv.Value()
+		if err != nil {
+			return err
+		}
+		if _, same := x.(driver.Valuer); !same {
+			return encodeValue(e, x)
+		}
+	}
+	return encodeKind(e, reflect.ValueOf(v))
+}
+
+// encodeKind encodes values dependent on the kind of the type
+func encodeKind(e *jx.Encoder, rv reflect.Value) error {
+	switch rv.Kind() {
+	case reflect.Bool:
+		e.Bool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.Int64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.UInt64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		encodeFloat(e, rv.Float())
+	case reflect.String:
+		e.Str(rv.String())
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			e.Null()
+			return nil
+		}
+		return encodeValue(e, rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			e.Null()
+			return nil
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			e.Base64(b)
+			return nil
+		}
+		e.ArrStart()
+		for i := 0; i < rv.Len(); i++ {
+			if err := encodeValue(e, rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		e.ArrEnd()
+	case reflect.Map:
+		if rv.IsNil() {
+			e.Null()
+			return nil
+		}
+		e.ObjStart()
+		iter := rv.MapRange()
+		for iter.Next() {
+			e.FieldStart(fmt.Sprintf("%v", iter.Key().Interface()))
+			if err := encodeValue(e, iter.Value().Interface()); err != nil {
+				return err
+			}
+		}
+		e.ObjEnd()
+	default:
+		v := rv.Interface()
+		if s, ok := v.(fmt.Stringer); ok {
+			e.Str(s.String())
+			return nil
+		}
+		log.Log.Debugf("Using default string encoding for %T", v)
+		e.Str(fmt.Sprintf("%v", v))
+	}
+	return nil
+}
+
+// encodeFloat encodes floats, values not valid in JSON are written as string
+func encodeFloat(e *jx.Encoder, f float64) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		e.Str(strconv.FormatFloat(f, 'g', -1, 64))
+		return
+	}
+	e.Float64(f)
+}
+
+// encodeNumeric encodes PostgreSQL numeric values. Integer values outside of
+// the safe JSON integer range are written as string.
+func encodeNumeric(e *jx.Encoder, v any) error {
+	t := v.(pgtype.Numeric)
+	switch {
+	case !t.Valid:
+		e.Null()
+		return nil
+	case t.NaN:
+		e.Str("NaN")
+		return nil
+	case t.InfinityModifier == pgtype.Infinity:
+		e.Str("Infinity")
+		return nil
+	case t.InfinityModifier == pgtype.NegativeInfinity:
+		e.Str("-Infinity")
+		return nil
+	default:
+	}
+	if i, err := t.Int64Value(); err == nil {
+		e.Int64(i.Int64)
+		return nil
+	}
+	f, err := t.Float64Value()
+	if err != nil {
+		return err
+	}
+	if (f.Float64 > maxSafeInteger) || (f.Float64 < -float64(maxSafeInteger)) {
+		b, err := t.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		e.Str(string(b))
+		return nil
+	}
+	encodeFloat(e, f.Float64)
+	return nil
+}
+
+// encodeInterval encodes PostgreSQL intervals as ISO 8601 duration
+func encodeInterval(e *jx.Encoder, v any) error {
+	t := v.(pgtype.Interval)
+	if !t.Valid {
+		e.Null()
+		return nil
+	}
+	var sb strings.Builder
+	sb.WriteString("P")
+	if y := t.Months / 12; y != 0 {
+		sb.WriteString(strconv.Itoa(int(y)) + "Y")
+	}
+	if m := t.Months % 12; m != 0 {
+		sb.WriteString(strconv.Itoa(int(m)) + "M")
+	}
+	if t.Days != 0 {
+		sb.WriteString(strconv.Itoa(int(t.Days)) + "D")
+	}
+	if t.Microseconds != 0 {
+		sb.WriteString("T")
+		us := t.Microseconds
+		if h := us / int64(time.Hour/time.Microsecond); h != 0 {
+			sb.WriteString(strconv.FormatInt(h, 10) + "H")
+			us -= h * int64(time.Hour/time.Microsecond)
+		}
+		if m := us / int64(time.Minute/time.Microsecond); m != 0 {
+			sb.WriteString(strconv.FormatInt(m, 10) + "M")
+			us -= m * int64(time.Minute/time.Microsecond)
+		}
+		if us != 0 {
+			sb.WriteString(strconv.FormatFloat(float64(us)/1e6, 'f', -1, 64) + "S")
+		}
+	}
+	if sb.Len() == 1 {
+		sb.WriteString("T0S")
+	}
+	e.Str(sb.String())
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"math/big"
+	"net"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-faster/jx"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+)
+
+func encodeTest(t *testing.T, v any) string {
+	e := &jx.Encoder{}
+	err := encodeValue(e, v)
+	assert.NoError(t, err)
+	assert.True(t, json.Valid(e.Bytes()), "invalid JSON %s for %T", e.String(), v)
+	return e.String()
+}
+
+func TestEncodeBaseTypes(t *testing.T) {
+	s := "abc \"quoted\" \\ \n"
+	assert.Equal(t, `"abc \"quoted\" \\ \n"`, encodeTest(t, s))
+	assert.Equal(t, `"abc \"quoted\" \\ \n"`, encodeTest(t, &s))
+	assert.Equal(t, "null", encodeTest(t, (*string)(nil)))
+	assert.Equal(t, "true", encodeTest(t, true))
+	assert.Equal(t, "-12", encodeTest(t, int8(-12)))
+	assert.Equal(t, "1234", encodeTest(t, int16(1234)))
+	assert.Equal(t, "-123456", encodeTest(t, int32(-123456)))
+	assert.Equal(t, "9223372036854775807", encodeTest(t, int64(math.MaxInt64)))
+	assert.Equal(t, "255", encodeTest(t, uint8(255)))
+	assert.Equal(t, "18446744073709551615", encodeTest(t, uint64(math.MaxUint64)))
+	assert.Equal(t, "1.5", encodeTest(t, float32(1.5)))
+	assert.Equal(t, "3.25", encodeTest(t, 3.25))
+	assert.Equal(t, `"NaN"`, encodeTest(t, math.NaN()))
+	assert.Equal(t, `"+Inf"`, encodeTest(t, math.Inf(1)))
+	assert.Equal(t, `"AQID"`, encodeTest(t, []byte{1, 2, 3}))
+	f := 2.5
+	assert.Equal(t, "2.5", encodeTest(t, &f))
+}
+
+func TestEncodeTime(t *testing.T) {
+	tm := time.Date(2024, 2, 29, 13, 14, 15, 0, time.FixedZone("CET", 3600))
+	assert.Equal(t, `"2024-02-29T12:14:15Z"`, encodeTest(t, tm))
+	assert.Equal(t, `"2024-02-29T12:14:15Z"`, encodeTest(t, &tm))
+	assert.Equal(t, `"13:14:15.5"`, encodeTest(t, pgtype.Time{Microseconds: (13*3600+14*60+15)*1000000 + 500000, Valid: true}))
+	assert.Equal(t, "null", encodeTest(t, pgtype.Time{}))
+	assert.Equal(t, `"P1Y2M3DT4H5M6.5S"`, encodeTest(t, pgtype.Interval{Months: 14, Days: 3,
+		Microseconds: (4*3600+5*60+6)*1000000 + 500000, Valid: true}))
+	assert.Equal(t, `"PT0S"`, encodeTest(t, pgtype.Interval{Valid: true}))
+}
+
+func TestEncodeNumeric(t *testing.T) {
+	assert.Equal(t, "12345", encodeTest(t, pgtype.Numeric{Int: big.NewInt(12345), Valid: true}))
+	assert.Equal(t, "123.45", encodeTest(t, pgtype.Numeric{Int: big.NewInt(12345), Exp: -2, Valid: true}))
+	large, _ := new(big.Int).SetString("11981337726687985304", 10)
+	assert.Equal(t, `"11981337726687985304"`, encodeTest(t, pgtype.Numeric{Int: large, Valid: true}))
+	assert.Equal(t, `"NaN"`, encodeTest(t, pgtype.Numeric{NaN: true, Valid: true}))
+	assert.Equal(t, `"-Infinity"`, encodeTest(t, pgtype.Numeric{InfinityModifier: pgtype.NegativeInfinity, Valid: true}))
+	assert.Equal(t, "null", encodeTest(t, pgtype.Numeric{}))
+	// MySQL decimals are delivered as string
+	assert.Equal(t, `"12.50"`, encodeTest(t, "12.50"))
+}
+
+func TestEncodePostgresTypes(t *testing.T) {
+	uuid := [16]byte{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4, 0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00}
+	assert.Equal(t, `"550e8400-e29b-41d4-a716-446655440000"`, encodeTest(t, uuid))
+	assert.Equal(t, `"192.168.0.0/24"`, encodeTest(t, netip.MustParsePrefix("192.168.0.0/24")))
+	assert.Equal(t, `"::1"`, encodeTest(t, netip.MustParseAddr("::1")))
+	mac, _ := net.ParseMAC("08:00:2b:01:02:03")
+	assert.Equal(t, `"08:00:2b:01:02:03"`, encodeTest(t, mac))
+	assert.Equal(t, `{"a":[1,"x",null,true]}`, encodeTest(t, map[string]any{"a": []any{int64(1), "x", nil, true}}))
+	assert.Equal(t, `[1,2,3]`, encodeTest(t, []int32{1, 2, 3}))
+}
+
+func TestEncodeSQLNullTypes(t *testing.T) {
+	assert.Equal(t, `"abc"`, encodeTest(t, sql.NullString{String: "abc", Valid: true}))
+	assert.Equal(t, "null", encodeTest(t, sql.NullString{}))
+	assert.Equal(t, "42", encodeTest(t, &sql.NullInt64{Int64: 42, Valid: true}))
+	assert.Equal(t, "1.5", encodeTest(t, sql.NullFloat64{Float64: 1.5, Valid: true}))
+	assert.Equal(t, "false", encodeTest(t, sql.NullBool{Valid: true}))
+	tm := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.Equal(t, `"2024-01-02T03:04:05Z"`, encodeTest(t, sql.NullTime{Time: tm, Valid: true}))
+}
+
+type testCustomType struct {
+	a, b int
+}
+
+func TestRegisterTypeEncoder(t *testing.T) {
+	assert.Equal(t, `"{1 2}"`, encodeTest(t, testCustomType{1, 2}))
+	RegisterTypeEncoder(testCustomType{}, func(e *jx.Encoder, v any) error {
+		c := v.(testCustomType)
+		e.ArrStart()
+		e.Int(c.a)
+		e.Int(c.b)
+		e.ArrEnd()
+		return nil
+	})
+	defer typeEncoderMap.Delete(reflect.TypeOf(testCustomType{}))
+	assert.Equal(t, `[1,2]`, encodeTest(t, testCustomType{1, 2}))
+	assert.Equal(t, `[3,4]`, encodeTest(t, &testCustomType{3, 4}))
+}
+
+func TestConvertTypeToRaw(t *testing.T) {
+	d := generateItem([]string{"Name", "Data", "Empty"}, []any{"a\"b", []byte("x"), nil})
+	assert.Equal(t, api.ResponseRecordsItem{"name": jx.Raw(`"a\"b"`), "data": jx.Raw(`"eA=="`)}, d)
+}
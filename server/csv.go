@@ -0,0 +1,140 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/log"
+)
+
+// mimeTypeCSV CSV output media type
+const mimeTypeCSV = "text/csv"
+
+// csvDialect options of the RFC 4180 CSV output
+type csvDialect struct {
+	delimiter  string
+	quote      string
+	header     bool
+	null       string
+	dateFormat string
+	bom        bool
+}
+
+// newCSVDialect create CSV dialect out of the query parameters
+func newCSVDialect(delimiter, quote api.OptString, header api.OptBool,
+	null, dateFormat api.OptString, bom api.OptBool) (*csvDialect, error) {
+	c := &csvDialect{delimiter: ",", quote: "\"", header: header.Or(true),
+		null: null.Or(""), dateFormat: dateFormat.Or(TimeFormat), bom: bom.Or(false)}
+	if delimiter.Set {
+		switch strings.ToLower(delimiter.Value) {
+		case "tab", "\\t":
+			c.delimiter = "\t"
+		default:
+			c.delimiter = delimiter.Value
+		}
+	}
+	if quote.Set {
+		c.quote = quote.Value
+	}
+	if utf8.RuneCountInString(c.delimiter) != 1 || strings.ContainsAny(c.delimiter, "\r\n") {
+		return nil, errorrepo.NewError("REST00019", "delimiter", c.delimiter)
+	}
+	if utf8.RuneCountInString(c.quote) != 1 || c.quote == c.delimiter || strings.ContainsAny(c.quote, "\r\n") {
+		return nil, errorrepo.NewError("REST00019", "quote", c.quote)
+	}
+	if strings.ContainsAny(c.null, "\r\n") || strings.Contains(c.null, c.delimiter) {
+		return nil, errorrepo.NewError("REST00019", "null", c.null)
+	}
+	return c, nil
+}
+
+// field encode one field, quoted if it contains delimiter, quote or line
+// breaks. Empty strings are quoted if they collide with the NULL value.
+func (c *csvDialect) field(s string) string {
+	if strings.Contains(s, c.delimiter) || strings.Contains(s, c.quote) ||
+		strings.ContainsAny(s, "\r\n") || (s == c.null) {
+		return c.quote + strings.ReplaceAll(s, c.quote, c.quote+c.quote) + c.quote
+	}
+	return s
+}
+
+// value convert database value to CSV field
+func (c *csvDialect) value(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return c.null
+	case *string:
+		if t == nil {
+			return c.null
+		}
+		return c.field(*t)
+	case string:
+		return c.field(t)
+	case []byte:
+		return c.field(string(t))
+	case pgtype.Numeric:
+		if !t.Valid {
+			return c.null
+		}
+		if i, err := t.Int64Value(); err == nil {
+			return fmt.Sprintf("%d", i.Int64)
+		}
+		f, err := t.Float64Value()
+		if err != nil {
+			return c.null
+		}
+		return fmt.Sprintf("%v", f.Float64)
+	case time.Time:
+		return c.field(t.Format(c.dateFormat))
+	case *time.Time:
+		if t == nil {
+			return c.null
+		}
+		return c.field(t.Format(c.dateFormat))
+	default:
+		log.Log.Debugf("Default CSV %T", t)
+		return c.field(fmt.Sprintf("%v", t))
+	}
+}
+
+// headerLine generate header line of the field names
+func (c *csvDialect) headerLine(fields []string) []byte {
+	var b strings.Builder
+	for i, f := range fields {
+		if i > 0 {
+			b.WriteString(c.delimiter)
+		}
+		b.WriteString(c.field(f))
+	}
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
+
+// row generate one CSV record line
+func (c *csvDialect) row(values []any) []byte {
+	var b strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			b.WriteString(c.delimiter)
+		}
+		b.WriteString(c.value(v))
+	}
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
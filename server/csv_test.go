@@ -0,0 +1,88 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"encoding/csv"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+)
+
+func TestCSVField(t *testing.T) {
+	c, err := newCSVDialect(api.OptString{}, api.OptString{}, api.OptBool{}, api.OptString{},
+		api.OptString{}, api.OptBool{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	tests := []struct {
+		value any
+		want  string
+	}{
+		{"plain", "plain"},
+		{"a,b", `"a,b"`},
+		{`say "hi"`, `"say ""hi"""`},
+		{"line\nbreak", "\"line\nbreak\""},
+		{"cr\rreturn", "\"cr\rreturn\""},
+		{"", `""`},
+		{nil, ""},
+		{(*string)(nil), ""},
+		{[]byte("x,y"), `"x,y"`},
+		{42, "42"},
+		{pgtype.Numeric{Int: big.NewInt(15), Exp: -1, Valid: true}, "1.5"},
+		{pgtype.Numeric{Int: big.NewInt(12), Exp: 0, Valid: true}, "12"},
+		{pgtype.Numeric{}, ""},
+		{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "2024-01-02 03:04:05"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, c.value(tt.value), "%#v", tt.value)
+	}
+}
+
+func TestCSVDialect(t *testing.T) {
+	c, err := newCSVDialect(api.NewOptString("tab"), api.NewOptString("'"), api.NewOptBool(false),
+		api.NewOptString("NULL"), api.NewOptString(time.DateOnly), api.OptBool{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.False(t, c.header)
+	assert.Equal(t, "'it''s'\ta,b\tNULL\t'NULL'\t2024-01-02\r\n",
+		string(c.row([]any{"it's", "a,b", nil, "NULL", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)})))
+	assert.Equal(t, "'it''s'\t'a\tb'\r\n", string(c.row([]any{"it's", "a\tb"})))
+
+	for _, p := range [][3]string{{"", "\"", ""}, {";;", "\"", ""}, {"\n", "\"", ""},
+		{",", ",", ""}, {",", "", ""}, {",", "\"", "a,b"}, {",", "\"", "x\n"}} {
+		_, err = newCSVDialect(api.NewOptString(p[0]), api.NewOptString(p[1]), api.OptBool{},
+			api.NewOptString(p[2]), api.OptString{}, api.OptBool{})
+		assert.Error(t, err, "%q", p)
+	}
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	c, err := newCSVDialect(api.OptString{}, api.OptString{}, api.OptBool{}, api.OptString{},
+		api.OptString{}, api.OptBool{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	values := []any{"a,b", `q"uote`, "multi\r\nline", "", "x"}
+	data := string(c.headerLine([]string{"A", "B", "C", "D", "E"})) + string(c.row(values))
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if assert.NoError(t, err) && assert.Len(t, records, 2) {
+		assert.Equal(t, []string{"A", "B", "C", "D", "E"}, records[0])
+		assert.Equal(t, []string{"a,b", `q"uote`, "multi\nline", "", "x"}, records[1])
+	}
+}
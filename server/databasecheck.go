@@ -72,5 +72,8 @@ func (loader *Loader) Loaded(nv interface{}) error {
 	// Init authentication/authorization infrastructure like database access and JWT
 	clu.Viewer.InitSecurityInfrastructure()
 
+	initImageCache(clu.Viewer.Server.ImageCache)
+	initUploadExpiry()
+
 	return nil
 }
@@ -29,6 +29,25 @@ func NewAPIError(code string, err error) *api.Error {
 		Error: api.NewOptErrorError(api.ErrorError{Message: api.NewOptString(err.Error())})}
 }
 
+// NewBadRequestError new API error with bad request status code
+func NewBadRequestError(err error) *api.ErrorStatusCode {
+	code := "BADREQ"
+	if e, ok := err.(*errorrepo.Error); ok {
+		code = e.ID()
+	}
+	return &api.ErrorStatusCode{StatusCode: http.StatusBadRequest,
+		Response: *NewAPIError(code, err)}
+}
+
+// repoBadRequestError repository errors are bad requests, others like
+// database errors are returned as is
+func repoBadRequestError(err error) error {
+	if _, ok := err.(*errorrepo.Error); ok {
+		return NewBadRequestError(err)
+	}
+	return err
+}
+
 // NewError creates *ErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
@@ -0,0 +1,461 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
+)
+
+// filterOperators all operators of the structured filter language
+var filterOperators = map[string]int{"eq": 2, "ne": 2, "lt": 2, "le": 2, "gt": 2, "ge": 2,
+	"like": 2, "in": -1, "between": 3, "isnull": 1, "and": -1, "or": -1, "not": 1}
+
+var filterFunctionRegexp = regexp.MustCompile(`^\s*(eq|ne|lt|le|gt|ge|like|in|between|isnull|and|or|not)\s*\(`)
+
+// filterNumberRegexp unquoted numbers of the function notation, only plain
+// decimal literals are passed into the search condition
+var filterNumberRegexp = regexp.MustCompile(`^[-+]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][-+]?[0-9]+)?$`)
+
+// rawSearchPrefix prefix of the table resource permitting raw database
+// search conditions. It differs from the '#' extend plugin permission.
+const rawSearchPrefix = "~"
+
+// init register the raw search prefix, otherwise the '*' read permission
+// of the user permits raw searches on all tables
+func init() {
+	auth.PermissionPrefix = append(auth.PermissionPrefix, rawSearchPrefix)
+}
+
+// filterNode parsed filter expression. Logical operators contain the
+// sub nodes, all other operators contain the field and the values.
+type filterNode struct {
+	op     string
+	field  string
+	values []any
+	nodes  []*filterNode
+}
+
+// isStructuredFilter check if the search is given in the structured filter
+// language, either in function or in JSON notation
+func isStructuredFilter(search string) bool {
+	s := strings.TrimSpace(search)
+	return strings.HasPrefix(s, "{") || filterFunctionRegexp.MatchString(s)
+}
+
+// isRawSearch check if the search is a legacy raw database search condition
+func isRawSearch(search string) bool {
+	return strings.TrimSpace(search) != "" && !isStructuredFilter(search)
+}
+
+// parseFilter parse structured filter in function or JSON notation
+func parseFilter(search string) (*filterNode, error) {
+	s := strings.TrimSpace(search)
+	if strings.HasPrefix(s, "{") {
+		var v any
+		d := json.NewDecoder(strings.NewReader(s))
+		d.UseNumber()
+		err := d.Decode(&v)
+		if err != nil {
+			return nil, errorrepo.NewError("RERR00020", err)
+		}
+		return parseJSONFilter(v)
+	}
+	p := &filterParser{input: s}
+	n, err := p.parseFunction()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.input) {
+		return nil, errorrepo.NewError("RERR00021", p.pos, "end of filter")
+	}
+	return n, nil
+}
+
+// parseJSONFilter parse JSON filter like {"and":[{"eq":["name","x"]},{"isnull":["y"]}]}
+func parseJSONFilter(v any) (*filterNode, error) {
+	m, ok := v.(map[string]any)
+	if !ok || len(m) != 1 {
+		return nil, errorrepo.NewError("RERR00020", "filter object need exactly one operator")
+	}
+	for op, a := range m {
+		op = strings.ToLower(op)
+		if _, ok := filterOperators[op]; !ok {
+			return nil, errorrepo.NewError("RERR00022", op)
+		}
+		args, ok := a.([]any)
+		if !ok {
+			args = []any{a}
+		}
+		n := &filterNode{op: op}
+		switch op {
+		case "and", "or", "not":
+			for _, x := range args {
+				sub, err := parseJSONFilter(x)
+				if err != nil {
+					return nil, err
+				}
+				n.nodes = append(n.nodes, sub)
+			}
+		default:
+			if len(args) == 0 {
+				return nil, errorrepo.NewError("RERR00023", op)
+			}
+			f, ok := args[0].(string)
+			if !ok {
+				return nil, errorrepo.NewError("RERR00020", "field name need to be string")
+			}
+			n.field = f
+			for _, x := range args[1:] {
+				switch t := x.(type) {
+				case json.Number:
+					n.values = append(n.values, t)
+				case string, bool, nil:
+					n.values = append(n.values, t)
+				default:
+					return nil, errorrepo.NewError("RERR00020", "invalid value type")
+				}
+			}
+		}
+		return n, n.check()
+	}
+	return nil, errorrepo.NewError("RERR00020", "empty filter")
+}
+
+// check check the number of arguments of the filter node
+func (n *filterNode) check() error {
+	count := filterOperators[n.op]
+	switch n.op {
+	case "and", "or":
+		if len(n.nodes) == 0 {
+			return errorrepo.NewError("RERR00023", n.op)
+		}
+	case "not":
+		if len(n.nodes) != 1 {
+			return errorrepo.NewError("RERR00023", n.op)
+		}
+	case "in":
+		if len(n.values) == 0 {
+			return errorrepo.NewError("RERR00023", n.op)
+		}
+	default:
+		if len(n.values)+1 != count {
+			return errorrepo.NewError("RERR00023", n.op)
+		}
+	}
+	return nil
+}
+
+// filterParser parser of the function notation like and(eq(name,'x'),gt(id,10))
+type filterParser struct {
+	input string
+	pos   int
+}
+
+func (p *filterParser) skipSpace() {
+	for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *filterParser) expect(c byte) error {
+	p.skipSpace()
+	if p.pos >= len(p.input) || p.input[p.pos] != c {
+		return errorrepo.NewError("RERR00021", p.pos, string(c))
+	}
+	p.pos++
+	return nil
+}
+
+func (p *filterParser) identifier() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c == '_' || c == '.' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			p.pos++
+			continue
+		}
+		break
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *filterParser) parseFunction() (*filterNode, error) {
+	op := strings.ToLower(p.identifier())
+	if _, ok := filterOperators[op]; !ok {
+		return nil, errorrepo.NewError("RERR00022", op)
+	}
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	n := &filterNode{op: op}
+	switch op {
+	case "and", "or", "not":
+		for {
+			sub, err := p.parseFunction()
+			if err != nil {
+				return nil, err
+			}
+			n.nodes = append(n.nodes, sub)
+			if !p.next() {
+				break
+			}
+		}
+	default:
+		n.field = p.identifier()
+		if n.field == "" {
+			return nil, errorrepo.NewError("RERR00021", p.pos, "field name")
+		}
+		for p.next() {
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			n.values = append(n.values, v)
+		}
+	}
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+	return n, n.check()
+}
+
+// next check if a further argument follows
+func (p *filterParser) next() bool {
+	p.skipSpace()
+	if p.pos < len(p.input) && p.input[p.pos] == ',' {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// value parse quoted string, number, boolean or null value
+func (p *filterParser) value() (any, error) {
+	p.skipSpace()
+	if p.pos >= len(p.input) {
+		return nil, errorrepo.NewError("RERR00021", p.pos, "value")
+	}
+	if q := p.input[p.pos]; q == '\'' || q == '"' {
+		var b strings.Builder
+		p.pos++
+		for p.pos < len(p.input) {
+			c := p.input[p.pos]
+			p.pos++
+			if c == q {
+				// doubled quote is an escaped quote
+				if p.pos < len(p.input) && p.input[p.pos] == q {
+					b.WriteByte(q)
+					p.pos++
+					continue
+				}
+				return b.String(), nil
+			}
+			b.WriteByte(c)
+		}
+		return nil, errorrepo.NewError("RERR00021", p.pos, string(q))
+	}
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune(",) \t", rune(p.input[p.pos])) {
+		p.pos++
+	}
+	v := p.input[start:p.pos]
+	switch strings.ToLower(v) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if !filterNumberRegexp.MatchString(v) {
+		return nil, errorrepo.NewError("RERR00024", v)
+	}
+	return json.Number(v), nil
+}
+
+// filterCompiler compile filter into search condition of a database driver
+type filterCompiler struct {
+	driver  common.ReferenceType
+	columns map[string]string
+}
+
+// compile validate fields and generate search condition
+func (c *filterCompiler) compile(n *filterNode) (string, error) {
+	switch n.op {
+	case "and", "or":
+		parts := make([]string, 0, len(n.nodes))
+		for _, sub := range n.nodes {
+			// Adabas searches contain no parentheses, only one logical
+			// operator keeps the meaning
+			if c.driver == common.AdabasType && (sub.op == "and" || sub.op == "or") && sub.op != n.op {
+				return "", errorrepo.NewError("RERR00025", sub.op+" inside "+n.op, c.driver.String())
+			}
+			s, err := c.compile(sub)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, s)
+		}
+		if c.driver == common.AdabasType {
+			return strings.Join(parts, " "+strings.ToUpper(n.op)+" "), nil
+		}
+		return "(" + strings.Join(parts, " "+strings.ToUpper(n.op)+" ") + ")", nil
+	case "not":
+		if c.driver == common.AdabasType {
+			return "", errorrepo.NewError("RERR00025", n.op, c.driver.String())
+		}
+		s, err := c.compile(n.nodes[0])
+		if err != nil {
+			return "", err
+		}
+		return "NOT (" + s + ")", nil
+	}
+	field, ok := c.columns[strings.ToLower(n.field)]
+	if !ok {
+		return "", errorrepo.NewError("RERR00026", n.field)
+	}
+	values := make([]string, 0, len(n.values))
+	for _, v := range n.values {
+		if v == nil && n.op != "eq" && n.op != "ne" {
+			return "", errorrepo.NewError("RERR00024", "null")
+		}
+		values = append(values, c.literal(v))
+	}
+	if c.driver == common.AdabasType {
+		return c.compileAdabas(n, field, values)
+	}
+	switch n.op {
+	case "eq", "ne":
+		if n.values[0] == nil {
+			if n.op == "eq" {
+				return field + " IS NULL", nil
+			}
+			return field + " IS NOT NULL", nil
+		}
+		if n.op == "eq" {
+			return field + "=" + values[0], nil
+		}
+		return field + "<>" + values[0], nil
+	case "lt":
+		return field + "<" + values[0], nil
+	case "le":
+		return field + "<=" + values[0], nil
+	case "gt":
+		return field + ">" + values[0], nil
+	case "ge":
+		return field + ">=" + values[0], nil
+	case "like":
+		return field + " LIKE " + values[0], nil
+	case "in":
+		return field + " IN (" + strings.Join(values, ",") + ")", nil
+	case "between":
+		return field + " BETWEEN " + values[0] + " AND " + values[1], nil
+	case "isnull":
+		return field + " IS NULL", nil
+	default:
+	}
+	return "", errorrepo.NewError("RERR00022", n.op)
+}
+
+// compileAdabas generate Adabas search for the subset supported by Adabas
+func (c *filterCompiler) compileAdabas(n *filterNode, field string, values []string) (string, error) {
+	switch n.op {
+	case "eq":
+		return field + "=" + values[0], nil
+	case "ne":
+		return field + "!=" + values[0], nil
+	case "lt":
+		return field + "<" + values[0], nil
+	case "le":
+		return field + "<=" + values[0], nil
+	case "gt":
+		return field + ">" + values[0], nil
+	case "ge":
+		return field + ">=" + values[0], nil
+	case "between":
+		return field + "=[" + values[0] + ":" + values[1] + "]", nil
+	case "like":
+		s, ok := n.values[0].(string)
+		if ok && strings.HasSuffix(s, "%") && !strings.ContainsAny(s[:len(s)-1], "%_") {
+			v := c.literal(s[:len(s)-1])
+			return field + "=[" + v + "0x00:" + v + "0xff]", nil
+		}
+	default:
+	}
+	return "", errorrepo.NewError("RERR00025", n.op, c.driver.String())
+}
+
+// literal generate driver specific literal of a value
+func (c *filterCompiler) literal(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		if c.driver == common.OracleType || c.driver == common.AdabasType {
+			if t {
+				return "1"
+			}
+			return "0"
+		}
+		return strings.ToUpper(strconv.FormatBool(t))
+	case json.Number:
+		return t.String()
+	case string:
+		s := strings.ReplaceAll(t, "'", "''")
+		if c.driver == common.MysqlType {
+			s = strings.ReplaceAll(s, "\\", "\\\\")
+		}
+		return "'" + s + "'"
+	default:
+	}
+	return "NULL"
+}
+
+// compileSearch compile the search parameter of a table request into the
+// database search condition. Structured filters are parsed, validated against
+// the table columns and compiled for the database driver. Legacy raw search
+// conditions are returned unchanged, the caller need to check the
+// rawSearchPrefix table permission using isRawSearch before.
+func compileSearch(d common.RegDbID, table, search string) (string, error) {
+	if !isStructuredFilter(search) {
+		return search, nil
+	}
+	n, err := parseFilter(search)
+	if err != nil {
+		return "", err
+	}
+	driver := TableDriver(table)
+	columns, err := d.GetTableColumn(table)
+	if err != nil {
+		return "", err
+	}
+	c := &filterCompiler{driver: driver, columns: make(map[string]string)}
+	for _, col := range columns {
+		c.columns[strings.ToLower(col)] = col
+	}
+	s, err := c.compile(n)
+	if err != nil {
+		return "", err
+	}
+	log.Log.Debugf("Compiled filter %s to %s", search, s)
+	return s, nil
+}
@@ -0,0 +1,129 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/services/auth"
+)
+
+func compileTest(driver common.ReferenceType, search string) (string, error) {
+	n, err := parseFilter(search)
+	if err != nil {
+		return "", err
+	}
+	c := &filterCompiler{driver: driver, columns: map[string]string{"id": "ID", "name": "Name",
+		"active": "Active", "published": "Published"}}
+	return c.compile(n)
+}
+
+func TestFilterSearchKind(t *testing.T) {
+	assert.True(t, isStructuredFilter("eq(id,1)"))
+	assert.True(t, isStructuredFilter(` {"eq":["id",1]}`))
+	assert.False(t, isStructuredFilter("ID=1"))
+	assert.True(t, isRawSearch("ID=1"))
+	assert.True(t, isRawSearch("equal(id,1)"))
+	assert.False(t, isRawSearch(""))
+	assert.False(t, isRawSearch("eq(id,1)"))
+}
+
+func TestFilterCompile(t *testing.T) {
+	tests := []struct {
+		driver common.ReferenceType
+		search string
+		want   string
+	}{
+		{common.PostgresType, "eq(id,1)", "ID=1"},
+		{common.PostgresType, "ne(name,'x')", "Name<>'x'"},
+		{common.PostgresType, "lt(id,-1.5)", "ID<-1.5"},
+		{common.PostgresType, "le(id,1e3)", "ID<=1e3"},
+		{common.PostgresType, "gt(id,.5)", "ID>.5"},
+		{common.PostgresType, "ge(id,+2)", "ID>=+2"},
+		{common.PostgresType, "like(name,'Ab%')", "Name LIKE 'Ab%'"},
+		{common.PostgresType, "in(id,1,2,3)", "ID IN (1,2,3)"},
+		{common.PostgresType, "between(published,'2020-01-01','2021-01-01')",
+			"Published BETWEEN '2020-01-01' AND '2021-01-01'"},
+		{common.PostgresType, "isnull(name)", "Name IS NULL"},
+		{common.PostgresType, "eq(name,null)", "Name IS NULL"},
+		{common.PostgresType, "ne(name,NULL)", "Name IS NOT NULL"},
+		{common.PostgresType, "and(eq(id,1),or(eq(name,'a'),not(isnull(name))))",
+			"(ID=1 AND (Name='a' OR NOT (Name IS NULL)))"},
+		{common.PostgresType, `eq(name,'O''Brien')`, "Name='O''Brien'"},
+		{common.PostgresType, `eq(name,"say ""hi"" ")`, `Name='say "hi" '`},
+		{common.PostgresType, `eq(name,'a\')`, `Name='a\'`},
+		{common.MysqlType, `eq(name,'a\'' OR 1=1 -- ')`, `Name='a\\'' OR 1=1 -- '`},
+		{common.PostgresType, "eq(active,true)", "Active=TRUE"},
+		{common.MysqlType, "eq(active,false)", "Active=FALSE"},
+		{common.OracleType, "eq(active,true)", "Active=1"},
+		{common.PostgresType, `{"and":[{"eq":["ID",1]},{"like":["name","x%"]}]}`,
+			"(ID=1 AND Name LIKE 'x%')"},
+		{common.PostgresType, `{"in":["id",1,2.5]}`, "ID IN (1,2.5)"},
+		{common.PostgresType, `{"eq":["name","it's"]}`, "Name='it''s'"},
+		{common.AdabasType, "and(eq(id,1),ne(name,'x'))", "ID=1 AND Name!='x'"},
+		{common.AdabasType, "and(and(eq(id,1),ne(name,'x')),lt(id,9))", "ID=1 AND Name!='x' AND ID<9"},
+		{common.AdabasType, "between(id,1,9)", "ID=[1:9]"},
+		{common.AdabasType, "like(name,'abc%')", "Name=['abc'0x00:'abc'0xff]"},
+	}
+	for _, tt := range tests {
+		s, err := compileTest(tt.driver, tt.search)
+		if assert.NoError(t, err, tt.search) {
+			assert.Equal(t, tt.want, s, tt.search)
+		}
+	}
+}
+
+func TestFilterCompileErrors(t *testing.T) {
+	tests := []struct {
+		driver common.ReferenceType
+		search string
+	}{
+		{common.PostgresType, "eq(id,Inf)"},
+		{common.PostgresType, "eq(id,NaN)"},
+		{common.PostgresType, "eq(id,infinity)"},
+		{common.PostgresType, "eq(id,0x1p-2)"},
+		{common.PostgresType, "eq(id,1_000)"},
+		{common.PostgresType, "eq(id,1;DROP)"},
+		{common.PostgresType, "eq(id,'abc)"},
+		{common.PostgresType, "eq(unknown,1)"},
+		{common.PostgresType, "eq(id)"},
+		{common.PostgresType, "between(id,1)"},
+		{common.PostgresType, "lt(id,null)"},
+		{common.PostgresType, "eq(id,1) OR 1=1"},
+		{common.PostgresType, "xor(eq(id,1))"},
+		{common.PostgresType, `{"eq":["id",{"a":1}]}`},
+		{common.PostgresType, `{"eq":["id",1],"ne":["id",2]}`},
+		{common.AdabasType, "not(eq(id,1))"},
+		{common.AdabasType, "like(name,'a%b%')"},
+		{common.AdabasType, "in(id,1,2)"},
+		{common.AdabasType, "and(or(eq(id,1),eq(id,2)),ne(name,'x'))"},
+		{common.AdabasType, "or(eq(id,1),and(eq(id,2),ne(name,'x')))"},
+	}
+	for _, tt := range tests {
+		_, err := compileTest(tt.driver, tt.search)
+		assert.Error(t, err, tt.search)
+	}
+}
+
+func TestRawSearchPermission(t *testing.T) {
+	users := auth.AllowedUsers
+	t.Cleanup(func() { auth.AllowedUsers = users })
+	auth.AllowedUsers = &auth.Users{UserMap: map[string]*auth.User{
+		"reader": {Name: "reader", ReadMap: map[string]bool{"*": true}},
+		"raw":    {Name: "raw", ReadMap: map[string]bool{"*": true, rawSearchPrefix + "Albums": true}}}}
+	assert.True(t, auth.ValidUser(auth.UserRole, false, &auth.UserInfo{User: "reader"}, "Albums"))
+	assert.False(t, auth.ValidUser(auth.UserRole, false, &auth.UserInfo{User: "reader"}, rawSearchPrefix+"Albums"))
+	assert.True(t, auth.ValidUser(auth.UserRole, false, &auth.UserInfo{User: "raw"}, rawSearchPrefix+"Albums"))
+	assert.False(t, auth.ValidUser(auth.UserRole, false, &auth.UserInfo{User: "raw"}, rawSearchPrefix+"Tracks"))
+}
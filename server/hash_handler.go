@@ -0,0 +1,374 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
+)
+
+// defaultMaxDuplicateRows maximum number of hashes compared by the duplicate
+// search if no maximum is configured. Each hash is compared with all other
+// hashes.
+const defaultMaxDuplicateRows = 10000
+
+// duplicateLimit configured maximum number of hashes of the duplicate search.
+// The parameter can only lower the limit.
+func duplicateLimit(limit api.OptInt) int {
+	rows := defaultMaxDuplicateRows
+	if clu.Viewer != nil && clu.Viewer.Common.MaxDuplicateRows > 0 {
+		rows = clu.Viewer.Common.MaxDuplicateRows
+	}
+	if limit.Set && limit.Value > 0 && limit.Value < rows {
+		rows = limit.Value
+	}
+	return rows
+}
+
+// hashQueryFields fields of the hash query, duplicates are removed
+func hashQueryFields(fields ...string) []string {
+	result := make([]string, 0, len(fields))
+	for _, f := range fields {
+		f = strings.ToLower(f)
+		found := f == ""
+		for _, e := range result {
+			found = found || e == f
+		}
+		if !found {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// checkFieldNames check the names are valid table or field names
+func checkFieldNames(names ...string) error {
+	for _, n := range names {
+		if n != "" && !fieldNameRegexp.MatchString(n) {
+			return errorrepo.NewError("RERR00026", n)
+		}
+	}
+	return nil
+}
+
+// GetImageHash implements getImageHash operation.
+//
+// Calculates the perceptual hash of an image field of a specific table
+// record.
+//
+// GET /image/{table}/{field}/{search}/hash
+func (Handler) GetImageHash(ctx context.Context, params api.GetImageHashParams) (r api.GetImageHashRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.GetImageHashForbidden{}, nil
+	}
+	if isRawSearch(params.Search) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.GetImageHashForbidden{}, nil
+	}
+	read := NewStreamRead(params.Table, params.Field, params.MimetypeField.Value)
+	err := read.initStreamFromTable(session, params.Search, "")
+	if err != nil {
+		log.Log.Errorf("Error search table %s:%v", params.Table, err)
+		if e, ok := err.(*errorrepo.Error); ok {
+			switch e.ID() {
+			case "REST00002", "REST00009":
+				return (*api.GetImageHashNotFound)(lobError(err)), nil
+			default:
+			}
+		}
+		return nil, repoBadRequestError(err)
+	}
+	img, err := decodeImage(read.data, read.mimetype)
+	if err != nil {
+		return (*api.GetImageHashBadRequest)(lobError(errorrepo.NewError("REST00057", read.mimetype, err))), nil
+	}
+	algorithm := string(params.Algorithm.Or(api.GetImageHashAlgorithmPhash))
+	return &api.ImageHash{Algorithm: api.NewOptString(algorithm),
+		Hash:  api.NewOptString(formatHash(imageHash(img, algorithm))),
+		Width: api.NewOptInt(img.Bounds().Dx()), Height: api.NewOptInt(img.Bounds().Dy())}, nil
+}
+
+// UpdateImageHashes implements updateImageHashes operation.
+//
+// Calculates the perceptual hash of the image field of all records matching
+// the search and stores it in the hash field.
+//
+// PUT /hash/{table}/{field}
+func (Handler) UpdateImageHashes(ctx context.Context, params api.UpdateImageHashesParams) (r api.UpdateImageHashesRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.UpdateImageHashesForbidden{}, nil
+	}
+	if isRawSearch(params.Search.Value) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.UpdateImageHashesForbidden{}, nil
+	}
+	if driver := TableDriver(params.Table); driver == common.AdabasType {
+		return lobError(errorrepo.NewError("REST00061", driver.String())), nil
+	}
+	err := checkFieldNames(params.Table, params.Field, params.HashField, params.MimetypeField.Value)
+	if err != nil {
+		return lobError(err), nil
+	}
+	keys, err := primaryKeyFields(session, params.Table)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	algorithm := string(params.Algorithm.Or(api.UpdateImageHashesAlgorithmPhash))
+	d, err := ConnectTable(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error search table %s:%v", params.Table, err)
+		return nil, err
+	}
+	defer CloseTable(d)
+	search, err := compileSearch(d, params.Table, params.Search.Value)
+	if err != nil {
+		return lobError(err), nil
+	}
+	v, err := tableVersion(session, params.Table)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	h, err := newRecordHistory(session, params.Table)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+
+	report := &api.ImageHashReport{Table: api.NewOptString(params.Table), Algorithm: api.NewOptString(algorithm)}
+	processed, skipped, failed := 0, 0, 0
+	updates := make([]hashUpdate, 0)
+	hashField := strings.ToLower(params.HashField)
+	mimetypeField := strings.ToLower(params.MimetypeField.Value)
+	versionField := ""
+	if v != nil {
+		versionField = strings.ToLower(v.column)
+	}
+	q := &common.Query{TableName: params.Table, Search: search,
+		Fields: hashQueryFields(append(append([]string{}, keys...), params.Field, hashField, mimetypeField, versionField)...)}
+	_, err = d.Query(q, func(search *common.Query, result *common.Result) error {
+		if result == nil {
+			return errorrepo.NewError("REST00011")
+		}
+		processed++
+		values := make(map[string]any)
+		for i, f := range result.Fields {
+			values[strings.ToLower(f)] = result.Rows[i]
+		}
+		if !params.Overwrite.Value && metadataString(values[hashField]) != "" {
+			skipped++
+			return nil
+		}
+		mimetype := metadataString(values[mimetypeField])
+		img, err := decodeImage(lobBytes(values[strings.ToLower(params.Field)]), mimetype)
+		if err != nil {
+			log.Log.Debugf("Image of table %s not decoded: %v", params.Table, err)
+			failed++
+			return nil
+		}
+		key := make(map[string]any)
+		for _, k := range keys {
+			key[k] = values[strings.ToLower(k)]
+		}
+		u := hashUpdate{key: key, hash: formatHash(imageHash(img, algorithm))}
+		if etag := versionETag(values[versionField]); v != nil && etag != "" {
+			if u.version, err = v.value(etag); err != nil {
+				return err
+			}
+		}
+		updates = append(updates, u)
+		return nil
+	})
+	if err != nil {
+		log.Log.Errorf("Error query images of table %s:%v", params.Table, err)
+		return nil, repoBadRequestError(err)
+	}
+
+	updated := 0
+	if len(updates) > 0 {
+		var changed string
+		if h != nil {
+			updatedKeys := make([]any, 0, len(updates))
+			for _, u := range updates {
+				updatedKeys = append(updatedKeys, u.key)
+			}
+			changed, err = keySearch(d, params.Table, updatedKeys, keys)
+			if err != nil {
+				return nil, repoBadRequestError(err)
+			}
+			if err = h.capture(d, changed); err != nil {
+				log.Log.Errorf("Error reading updated records of %s: %v", params.Table, err)
+				return nil, err
+			}
+		}
+		if v != nil {
+			updated, err = updateHashVersioned(d, v, keys, hashField, updates)
+		} else {
+			updated, err = updateHashes(d, params.Table, keys, hashField, updates)
+		}
+		if err != nil {
+			log.Log.Errorf("Error update hash %s.%s: %v", params.Table, hashField, err)
+			return nil, repoBadRequestError(err)
+		}
+		purgeImages(params.Table)
+		if h != nil {
+			if err = h.changed(session, d, changed); err != nil {
+				return nil, err
+			}
+		}
+	}
+	log.Log.Debugf("Stored %d %s hashes in %s.%s", updated, algorithm, params.Table, hashField)
+	report.Processed = api.NewOptInt(processed)
+	report.Updated = api.NewOptInt(updated)
+	report.Skipped = api.NewOptInt(skipped)
+	report.Failed = api.NewOptInt(failed)
+	return report, nil
+}
+
+// hashUpdate hash of one record to be stored
+type hashUpdate struct {
+	key     map[string]any
+	hash    string
+	version any
+}
+
+// updateHashes store the hashes of all records in one transaction
+func updateHashes(d common.RegDbID, table string, keys []string, hashField string, updates []hashUpdate) (int, error) {
+	err := d.BeginTransaction()
+	if err != nil {
+		return 0, err
+	}
+	updated := 0
+	for _, u := range updates {
+		criteria, err := keySearch(d, table, []any{u.key}, keys)
+		if err == nil {
+			var n int64
+			_, n, err = d.Update(table, &common.Entries{Fields: []string{hashField},
+				Update: []string{lobCriteria(criteria)}, Values: [][]any{{u.hash}}})
+			updated += int(n)
+		}
+		if err != nil {
+			if rerr := d.Rollback(); rerr != nil {
+				log.Log.Errorf("Error rollback hash update on %s: %v", table, rerr)
+			}
+			return 0, err
+		}
+	}
+	return updated, d.Commit()
+}
+
+// updateHashVersioned store the hashes of a versioned table in one
+// transaction increasing the version. Records changed since reading the
+// image keep their version and hash.
+func updateHashVersioned(d common.RegDbID, v *recordVersion, keys []string, hashField string, updates []hashUpdate) (int, error) {
+	updated := 0
+	err := v.execute(d, func(exec versionExec) error {
+		for _, u := range updates {
+			record := map[string]any{hashField: u.hash}
+			for k, x := range u.key {
+				record[k] = x
+			}
+			nv, err := exec(record, keys, u.version)
+			if err != nil {
+				return err
+			}
+			if nv != nil {
+				updated++
+			}
+		}
+		return nil
+	})
+	return updated, err
+}
+
+// GetImageDuplicates implements getImageDuplicates operation.
+//
+// Retrieves groups of records with near-duplicate images out of the stored
+// perceptual hashes.
+//
+// GET /hash/{table}/{hashField}/duplicates
+func (Handler) GetImageDuplicates(ctx context.Context, params api.GetImageDuplicatesParams) (r api.GetImageDuplicatesRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.GetImageDuplicatesForbidden{}, nil
+	}
+	if isRawSearch(params.Search.Value) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.GetImageDuplicatesForbidden{}, nil
+	}
+	if err := checkFieldNames(params.Table, params.HashField); err != nil {
+		return (*api.GetImageDuplicatesBadRequest)(lobError(err)), nil
+	}
+	keys, err := primaryKeyFields(session, params.Table)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	d, err := ConnectTable(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error search table %s:%v", params.Table, err)
+		return nil, err
+	}
+	defer CloseTable(d)
+	search, err := compileSearch(d, params.Table, params.Search.Value)
+	if err != nil {
+		return (*api.GetImageDuplicatesBadRequest)(lobError(err)), nil
+	}
+	hashField := strings.ToLower(params.HashField)
+	maxRows := duplicateLimit(params.Limit)
+	exceeded := false
+	records := make([]hashedRecord, 0)
+	q := &common.Query{TableName: params.Table, Search: search,
+		Fields: hashQueryFields(append(append([]string{}, keys...), hashField)...)}
+	_, err = d.Query(q, func(search *common.Query, result *common.Result) error {
+		if result == nil {
+			return errorrepo.NewError("REST00011")
+		}
+		values := make(map[string]string)
+		for i, f := range result.Fields {
+			values[strings.ToLower(f)] = metadataString(result.Rows[i])
+		}
+		hash, ok := parseHash(values[hashField])
+		if !ok {
+			return nil
+		}
+		if len(records) >= maxRows {
+			exceeded = true
+			return errorrepo.NewError("REST00067", params.Table, maxRows)
+		}
+		key := make([]string, 0, len(keys))
+		for _, k := range keys {
+			key = append(key, values[strings.ToLower(k)])
+		}
+		records = append(records, hashedRecord{key: formatRecordKey(key), hash: hash})
+		return nil
+	})
+	if exceeded {
+		return (*api.GetImageDuplicatesRequestEntityTooLarge)(lobError(errorrepo.NewError("REST00067",
+			params.Table, maxRows))), nil
+	}
+	if err != nil {
+		log.Log.Errorf("Error query hashes of table %s:%v", params.Table, err)
+		return nil, repoBadRequestError(err)
+	}
+	distance := params.Distance.Or(4)
+	groups := duplicateGroups(records, distance)
+	log.Log.Debugf("Found %d duplicate groups in %d hashes of %s", len(groups), len(records), params.Table)
+	return &api.ImageDuplicates{Table: api.NewOptString(params.Table), Distance: api.NewOptInt(distance),
+		Groups: groups}, nil
+}
@@ -0,0 +1,294 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-faster/jx"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
+)
+
+// recordHistory before images of the records changed by an operation on a
+// table with change history
+type recordHistory struct {
+	table  string
+	keys   []string
+	before map[string]api.ResponseRecordsItem
+	order  []string
+}
+
+// historyWithoutKey tables with change history but without primary key,
+// the warning is logged only once per table
+var historyWithoutKey sync.Map
+
+// newRecordHistory history of the table, nil if the table has no change
+// history configured. Tables without primary key, for example matched by the
+// wildcard, are changed without history.
+func newRecordHistory(session *clu.Context, table string) (*recordHistory, error) {
+	if !clu.HistoryEnabled(table) {
+		return nil, nil
+	}
+	m, err := tableMetadata(session, table)
+	if err != nil {
+		return nil, err
+	}
+	h := &recordHistory{table: table, before: make(map[string]api.ResponseRecordsItem)}
+	for _, c := range m.Columns {
+		if c.PrimaryKey.Value {
+			h.keys = append(h.keys, c.Name.Value)
+		}
+	}
+	if len(h.keys) == 0 {
+		if _, logged := historyWithoutKey.LoadOrStore(strings.ToLower(table), true); !logged {
+			log.Log.Infof("Warning: table %s has no primary key, changes are stored without history", table)
+		}
+		return nil, nil
+	}
+	return h, nil
+}
+
+// itemKey primary key of the record item in the notation of the key path
+// parameter
+func (h *recordHistory) itemKey(item api.ResponseRecordsItem) string {
+	parts := make([]string, 0, len(h.keys))
+	for _, k := range h.keys {
+		raw, ok := item[strings.ToLower(k)]
+		switch {
+		case !ok:
+			parts = append(parts, "")
+		case raw.Type() == jx.String:
+			s, _ := jx.DecodeBytes(raw).Str()
+			parts = append(parts, s)
+		default:
+			parts = append(parts, raw.String())
+		}
+	}
+	return formatRecordKey(parts)
+}
+
+// read read the records matching the search
+func (h *recordHistory) read(d common.RegDbID, search string) ([]api.ResponseRecordsItem, error) {
+	data, _, err := query(d, &common.Query{TableName: h.table, Fields: []string{"*"}, Search: search})
+	return data, err
+}
+
+// capture capture the records matching the search before the change
+func (h *recordHistory) capture(d common.RegDbID, search string) error {
+	data, err := h.read(d, search)
+	if err != nil {
+		return err
+	}
+	for _, item := range data {
+		key := h.itemKey(item)
+		if _, ok := h.before[key]; !ok {
+			h.order = append(h.order, key)
+		}
+		h.before[key] = item
+	}
+	return nil
+}
+
+// returning add the key fields not part of the inserted fields to the
+// returning fields to read the inserted records afterwards
+func (h *recordHistory) returning(fields, returning []string) []string {
+	if TableDriver(h.table) != common.PostgresType {
+		return returning
+	}
+	list := append(make([]string, 0, len(returning)+len(h.keys)), returning...)
+	for _, k := range h.keys {
+		if !containsFold(fields, k) && !containsFold(list, k) {
+			list = append(list, k)
+		}
+	}
+	return list
+}
+
+// inserted read the inserted records. The key values are taken out of the
+// records or the returned values.
+func (h *recordHistory) inserted(d common.RegDbID, records []any, returning []string,
+	returned [][]any) ([]api.ResponseRecordsItem, error) {
+	search, err := h.insertedSearch(d, records, returning, returned)
+	if err != nil || search == "" {
+		return nil, err
+	}
+	return h.read(d, search)
+}
+
+// insertedSearch search of the inserted records, empty if no key values are
+// known
+func (h *recordHistory) insertedSearch(d common.RegDbID, records []any, returning []string,
+	returned [][]any) (string, error) {
+	keys := make([]any, 0, len(records))
+	for i, r := range records {
+		m := make(map[string]any)
+		for _, k := range h.keys {
+			if x, ok := findField(r.(map[string]any), k); ok {
+				m[k] = x
+				continue
+			}
+			for j, f := range returning {
+				if strings.EqualFold(f, k) && i < len(returned) && j < len(returned[i]) && returned[i][j] != nil {
+					m[k] = returned[i][j]
+				}
+			}
+		}
+		if len(m) == len(h.keys) {
+			keys = append(keys, m)
+		}
+	}
+	if len(keys) == 0 {
+		return "", nil
+	}
+	return keySearch(d, h.table, keys, h.keys)
+}
+
+// changed store the history of the records matching the search after the
+// change
+func (h *recordHistory) changed(session *clu.Context, d common.RegDbID, search string) error {
+	if search == "" {
+		return h.store(session, nil)
+	}
+	after, err := h.read(d, search)
+	if err != nil {
+		return errorrepo.NewError("REST00066", h.table, err)
+	}
+	return h.store(session, after)
+}
+
+// store store the history entries of the changed records. The records are
+// already changed, an error storing the history is returned to the caller.
+func (h *recordHistory) store(session *clu.Context, after []api.ResponseRecordsItem) error {
+	entries := h.entries(session, after)
+	log.Log.Debugf("Store %d history entries of %s", len(entries), h.table)
+	if err := clu.StoreHistory(entries); err != nil {
+		log.Log.Errorf("Error storing history of %s: %v", h.table, err)
+		return errorrepo.NewError("REST00066", h.table, err)
+	}
+	return nil
+}
+
+// entries history entries of the changed records. The operation is given
+// by the existence of the before and after image of the record.
+func (h *recordHistory) entries(session *clu.Context, after []api.ResponseRecordsItem) []*clu.HistoryEntry {
+	order := append([]string{}, h.order...)
+	afterMap := make(map[string]api.ResponseRecordsItem)
+	for _, item := range after {
+		key := h.itemKey(item)
+		if _, ok := h.before[key]; !ok {
+			if _, ok := afterMap[key]; !ok {
+				order = append(order, key)
+			}
+		}
+		afterMap[key] = item
+	}
+	modified := time.Now()
+	entries := make([]*clu.HistoryEntry, 0, len(order))
+	for _, key := range order {
+		e := &clu.HistoryEntry{TableName: strings.ToLower(h.table), RecordKey: key,
+			UserName: session.UserName(), UUID: session.UUID(), Modified: modified}
+		b, a := h.before[key], afterMap[key]
+		switch {
+		case b == nil:
+			e.Operation = string(api.RecordChangeOperationInsert)
+		case a == nil:
+			e.Operation = string(api.RecordChangeOperationDelete)
+		default:
+			e.Operation = string(api.RecordChangeOperationUpdate)
+		}
+		e.Before = historyImage(b)
+		e.After = historyImage(a)
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// historyImage JSON image of the record
+func historyImage(item api.ResponseRecordsItem) string {
+	if item == nil {
+		return ""
+	}
+	b, err := item.MarshalJSON()
+	if err != nil {
+		log.Log.Errorf("Error history image: %v", err)
+		return ""
+	}
+	return string(b)
+}
+
+// parseHistoryImage parse JSON image of the record
+func parseHistoryImage(image string) (map[string]jx.Raw, bool) {
+	if image == "" {
+		return nil, false
+	}
+	m := make(map[string]jx.Raw)
+	err := jx.DecodeStr(image).Obj(func(d *jx.Decoder, key string) error {
+		raw, err := d.Raw()
+		if err != nil {
+			return err
+		}
+		m[key] = append(jx.Raw{}, raw...)
+		return nil
+	})
+	if err != nil {
+		log.Log.Errorf("Error parsing history image: %v", err)
+		return nil, false
+	}
+	return m, true
+}
+
+// GetRecordHistory implements getRecordHistory operation.
+//
+// Read the change history of the record with the given primary key.
+//
+// GET /rest/history/{table}/{key}
+func (Handler) GetRecordHistory(ctx context.Context, params api.GetRecordHistoryParams) (r api.GetRecordHistoryRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.GetRecordHistoryForbidden{}, nil
+	}
+	if !clu.HistoryEnabled(params.Table) {
+		return nil, NewBadRequestError(errorrepo.NewError("REST00044", params.Table))
+	}
+	parts, err := splitRecordKey(params.Key)
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+	entries, err := clu.QueryHistory(params.Table, formatRecordKey(parts))
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]api.RecordChange, 0, len(entries))
+	for _, e := range entries {
+		c := api.RecordChange{Operation: api.NewOptRecordChangeOperation(api.RecordChangeOperation(e.Operation)),
+			User: api.NewOptString(e.UserName), UUID: optString(e.UUID),
+			Modified: api.NewOptDateTime(e.Modified)}
+		if b, ok := parseHistoryImage(e.Before); ok {
+			c.Before = api.NewOptRecordChangeBefore(b)
+		}
+		if a, ok := parseHistoryImage(e.After); ok {
+			c.After = api.NewOptRecordChangeAfter(a)
+		}
+		changes = append(changes, c)
+	}
+	resp := api.RecordHistory{Table: api.NewOptString(params.Table), Key: api.NewOptString(params.Key),
+		Changes: changes}
+	return &api.RecordHistoryHeaders{Response: resp, XToken: api.NewOptString(session.Token)}, nil
+}
@@ -0,0 +1,71 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/services/auth"
+)
+
+func TestHistoryInsertedSearch(t *testing.T) {
+	h := &recordHistory{table: "albums", keys: []string{"ID"}}
+	search, err := h.insertedSearch(0, []any{map[string]any{"title": "x"}}, []string{"title"}, [][]any{{"x"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "", search)
+	search, err = h.insertedSearch(0, []any{map[string]any{"title": "x"}}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", search)
+}
+
+func TestHistoryItemKey(t *testing.T) {
+	h := &recordHistory{table: "albums", keys: []string{"ID", "Name"}}
+	assert.Equal(t, "1,x", h.itemKey(api.ResponseRecordsItem{"id": jx.Raw(`1`), "name": jx.Raw(`"x"`)}))
+	assert.Equal(t, "2,'it''s'", h.itemKey(api.ResponseRecordsItem{"id": jx.Raw(`2`), "name": jx.Raw(`"it's"`)}))
+	assert.Equal(t, "3,", h.itemKey(api.ResponseRecordsItem{"id": jx.Raw(`3`)}))
+}
+
+func TestHistoryEntries(t *testing.T) {
+	session := clu.NewContextUserInfo(&auth.UserInfo{User: "tester"}, "")
+	h := &recordHistory{table: "Albums", keys: []string{"ID"}, before: make(map[string]api.ResponseRecordsItem)}
+	for _, id := range []string{"1", "2"} {
+		key := h.itemKey(api.ResponseRecordsItem{"id": jx.Raw(id)})
+		h.order = append(h.order, key)
+		h.before[key] = api.ResponseRecordsItem{"id": jx.Raw(id), "title": jx.Raw(`"old"`)}
+	}
+	after := []api.ResponseRecordsItem{
+		{"id": jx.Raw(`3`), "title": jx.Raw(`"new"`)},
+		{"id": jx.Raw(`1`), "title": jx.Raw(`"changed"`)},
+	}
+	entries := h.entries(session, after)
+	if !assert.Len(t, entries, 3) {
+		return
+	}
+	ops := make(map[string]string)
+	for _, e := range entries {
+		ops[e.RecordKey] = e.Operation
+		assert.Equal(t, "albums", e.TableName)
+		assert.Equal(t, "tester", e.UserName)
+	}
+	assert.Equal(t, map[string]string{"1": string(api.RecordChangeOperationUpdate),
+		"2": string(api.RecordChangeOperationDelete), "3": string(api.RecordChangeOperationInsert)}, ops)
+	assert.Equal(t, []string{"1", "2", "3"}, []string{entries[0].RecordKey, entries[1].RecordKey, entries[2].RecordKey})
+	assert.Empty(t, entries[1].After)
+	assert.Empty(t, entries[2].Before)
+	assert.Contains(t, entries[0].After, "changed")
+
+	assert.Empty(t, (&recordHistory{table: "albums", keys: []string{"ID"}}).entries(session, nil))
+}
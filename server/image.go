@@ -0,0 +1,200 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"bytes"
+	"image"
+	"math"
+	"strings"
+
+	"github.com/kovidgoyal/imaging"
+	"github.com/rwcarlsen/goexif/exif"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/log"
+)
+
+// defaultMaxImageSize maximum width and height of transformed images if no
+// maximum is configured
+const defaultMaxImageSize = 4096
+
+// defaultImageQuality JPEG quality of transformed images
+const defaultImageQuality = 85
+
+// imageFormats image formats of transformed images with their mimetype
+var imageFormats = map[api.GetImageFormat]struct {
+	format   imaging.Format
+	mimetype string
+}{
+	api.GetImageFormatJpeg: {imaging.JPEG, "image/jpeg"},
+	api.GetImageFormatPNG:  {imaging.PNG, "image/png"},
+	api.GetImageFormatGIF:  {imaging.GIF, "image/gif"},
+}
+
+// imageTransform resize, crop and format conversion of an image
+type imageTransform struct {
+	width   int
+	height  int
+	fit     api.GetImageFit
+	quality int
+	format  api.GetImageFormat
+}
+
+// newImageTransform transformation requested by the parameters, nil if the
+// image is returned unchanged
+func newImageTransform(params api.GetImageParams) *imageTransform {
+	if !params.Width.Set && !params.Height.Set && !params.Quality.Set && !params.Format.Set {
+		return nil
+	}
+	return &imageTransform{width: params.Width.Value, height: params.Height.Value,
+		fit: params.Fit.Or(api.GetImageFitContain), quality: params.Quality.Or(defaultImageQuality),
+		format: params.Format.Value}
+}
+
+// maxImageSize configured maximum width and height of transformed images
+func maxImageSize() (int, int) {
+	maxWidth, maxHeight := defaultMaxImageSize, defaultMaxImageSize
+	if clu.Viewer != nil {
+		if clu.Viewer.Common.MaxImageWidth > 0 {
+			maxWidth = clu.Viewer.Common.MaxImageWidth
+		}
+		if clu.Viewer.Common.MaxImageHeight > 0 {
+			maxHeight = clu.Viewer.Common.MaxImageHeight
+		}
+	}
+	return maxWidth, maxHeight
+}
+
+// size output size of the image. Without width and height the image keeps
+// its size, with only one of them the aspect ratio is kept.
+func (t *imageTransform) size(srcWidth, srcHeight int) (int, int) {
+	if t.width == 0 && t.height == 0 {
+		return srcWidth, srcHeight
+	}
+	scaleX := float64(t.width) / float64(srcWidth)
+	scaleY := float64(t.height) / float64(srcHeight)
+	var scale float64
+	switch {
+	case t.width == 0:
+		scale = scaleY
+	case t.height == 0:
+		scale = scaleX
+	case t.fit == api.GetImageFitCrop:
+		return t.width, t.height
+	case t.fit == api.GetImageFitCover:
+		scale = math.Max(scaleX, scaleY)
+	default:
+		scale = math.Min(scaleX, scaleY)
+	}
+	return max(1, int(math.Round(float64(srcWidth)*scale))), max(1, int(math.Round(float64(srcHeight)*scale)))
+}
+
+// apply transform the image data. Returns the new image data and mimetype.
+func (t *imageTransform) apply(data []byte, mimetype string) ([]byte, string, error) {
+	img, err := decodeImage(data, mimetype)
+	if err != nil {
+		return nil, "", errorrepo.NewError("REST00057", mimetype, err)
+	}
+	bounds := img.Bounds()
+	width, height := t.size(bounds.Dx(), bounds.Dy())
+	if width != bounds.Dx() || height != bounds.Dy() {
+		maxWidth, maxHeight := maxImageSize()
+		if width > maxWidth || height > maxHeight {
+			return nil, "", errorrepo.NewError("REST00056", width, height, maxWidth, maxHeight)
+		}
+		if t.fit == api.GetImageFitCrop {
+			img = imaging.Fill(img, width, height, imaging.Center, imaging.Lanczos)
+		} else {
+			img = imaging.Resize(img, width, height, imaging.Lanczos)
+		}
+	}
+	format := t.format
+	if format == "" {
+		switch strings.ToLower(mimetype) {
+		case "image/png":
+			format = api.GetImageFormatPNG
+		case "image/gif":
+			format = api.GetImageFormatGIF
+		default:
+			format = api.GetImageFormatJpeg
+		}
+	}
+	f := imageFormats[format]
+	log.Log.Debugf("Transform image %s %dx%d -> %s %dx%d", mimetype, bounds.Dx(), bounds.Dy(),
+		f.mimetype, width, height)
+	var buffer bytes.Buffer
+	err = imaging.Encode(&buffer, img, f.format, imaging.JPEGQuality(t.quality))
+	if err != nil {
+		return nil, "", err
+	}
+	return buffer.Bytes(), f.mimetype, nil
+}
+
+// decodeImage decode the image and apply the EXIF orientation
+func decodeImage(data []byte, mimetype string) (image.Image, error) {
+	switch strings.ToLower(mimetype) {
+	case "image/heic", "image/heif":
+		return decodeHeif(data)
+	default:
+	}
+	return imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true),
+		imaging.Backends(imaging.GO_IMAGE))
+}
+
+// decodeHeif decode HEIF image and apply the EXIF orientation
+func decodeHeif(data []byte) (image.Image, error) {
+	srcImage, err := heifdecoder(bytes.NewReader(data))
+	if err != nil {
+		log.Log.Debugf("Decode image for conversion error %v", err)
+		return nil, err
+	}
+	exifData, err := heifextractor(bytes.NewReader(data))
+	if err != nil {
+		log.Log.Debugf("Extract exif error %v", err)
+		return nil, err
+	}
+	x, err := exif.Decode(bytes.NewBuffer(exifData))
+	if err != nil {
+		log.Log.Debugf("Decode exif in image error %v", err)
+		return srcImage, nil
+	}
+	log.Log.Debugf("Decode exif in image")
+	t, err := x.Get(exif.Orientation)
+	if err == nil {
+		srcImage = orientImage(srcImage, t.String())
+	}
+	return srcImage, nil
+}
+
+// orientImage transform the image according to the EXIF orientation
+func orientImage(img image.Image, orientation string) image.Image {
+	switch orientation {
+	case "2":
+		return imaging.FlipH(img)
+	case "3":
+		return imaging.Rotate180(img)
+	case "4":
+		return imaging.FlipV(img)
+	case "5":
+		return imaging.Transpose(img)
+	case "6":
+		return imaging.Rotate270(img)
+	case "7":
+		return imaging.Transverse(img)
+	case "8":
+		return imaging.Rotate90(img)
+	default:
+	}
+	return img
+}
@@ -0,0 +1,294 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/log"
+	"github.com/tknie/services"
+)
+
+// imageCacheExtension file extension of cached images in the cache directory
+const imageCacheExtension = ".img"
+
+// imageCacheEntry derived image in the cache. The data is kept in memory or
+// in a file of the cache directory.
+type imageCacheEntry struct {
+	key      string
+	table    string
+	mimetype string
+	source   string
+	size     int64
+	created  time.Time
+	data     []byte
+}
+
+// imageCache size limited LRU cache of derived images
+type imageCache struct {
+	lock          sync.Mutex
+	maxSize       int64
+	directory     string
+	ttl           time.Duration
+	invalidate    bool
+	size          int64
+	lru           *list.List
+	entries       map[string]*list.Element
+	hits          int64
+	misses        int64
+	evictions     int64
+	invalidations int64
+}
+
+// derivedImages derived image cache, nil if no cache is configured
+var derivedImages atomic.Pointer[imageCache]
+
+// initImageCache init the derived image cache out of the configuration.
+// Images and temporary files of a previous cache in the directory are
+// removed.
+func initImageCache(config *clu.ImageCacheConfig) {
+	if config == nil || config.MaxSize <= 0 {
+		derivedImages.Store(nil)
+		return
+	}
+	c := newImageCache(int64(config.MaxSize), os.ExpandEnv(config.Directory), config.TTL, config.Invalidate)
+	if c.directory != "" {
+		err := os.MkdirAll(c.directory, 0o750)
+		if err != nil {
+			services.ServerMessage("Image cache directory %s not usable: %v", c.directory, err)
+			derivedImages.Store(nil)
+			return
+		}
+		// temporary files of writes interrupted by a stop are removed too
+		for _, pattern := range []string{"*" + imageCacheExtension, "*.tmp"} {
+			files, _ := filepath.Glob(filepath.Join(c.directory, pattern))
+			for _, f := range files {
+				os.Remove(f)
+			}
+		}
+	}
+	services.ServerMessage("Image cache enabled with %d bytes in %s", c.maxSize, c.storage())
+	derivedImages.Store(c)
+}
+
+// purgeImages remove the cached images of the table after its records are
+// changed
+func purgeImages(table string) {
+	if cache := derivedImages.Load(); cache != nil {
+		if n := cache.purge(table); n > 0 {
+			log.Log.Debugf("Purged %d cached images of %s", n, table)
+		}
+	}
+}
+
+// newImageCache new image cache, images are kept in memory if no directory
+// is given
+func newImageCache(maxSize int64, directory string, ttl time.Duration, invalidate bool) *imageCache {
+	return &imageCache{maxSize: maxSize, directory: directory, ttl: ttl, invalidate: invalidate,
+		lru: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// imageCacheKey cache key of the derived image
+func imageCacheKey(table, field, search, mimetypeField, mimetype string, t *imageTransform) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00%s", strings.ToLower(table), strings.ToLower(field),
+		search, strings.ToLower(mimetypeField), mimetype)
+	if t != nil {
+		fmt.Fprintf(h, "\x00%d\x00%d\x00%s\x00%d\x00%s", t.width, t.height, t.fit, t.quality, t.format)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// imageSource checksum of the source large object used to invalidate the
+// cached images. Empty if the driver cannot calculate it in the database.
+func imageSource(session *clu.Context, table, field, search string) string {
+	if _, ok := lobInfoStatements[TableDriver(table)]; !ok {
+		return ""
+	}
+	lc := &lobContent{session: session, table: table, field: field}
+	err := lc.readInfo(search)
+	if err != nil {
+		log.Log.Debugf("Image source checksum of %s not available: %v", table, err)
+		return ""
+	}
+	return lc.etag
+}
+
+// storage memory or directory of the cache
+func (c *imageCache) storage() string {
+	if c.directory == "" {
+		return "memory"
+	}
+	return c.directory
+}
+
+// file cache file of the key
+func (c *imageCache) file(key string) string {
+	return filepath.Join(c.directory, key+imageCacheExtension)
+}
+
+// get cached image of the key. With invalidation enabled the source checksum
+// function is called to check the source record is unchanged.
+func (c *imageCache) get(key string, source func() string) (*imageCacheEntry, bool) {
+	c.lock.Lock()
+	el, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		c.lock.Unlock()
+		return nil, false
+	}
+	e := el.Value.(*imageCacheEntry)
+	if c.ttl > 0 && time.Since(e.created) > c.ttl {
+		c.removeElement(el)
+		c.evictions++
+		c.misses++
+		c.lock.Unlock()
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	c.lock.Unlock()
+
+	if c.invalidate && e.source != "" && source != nil && source() != e.source {
+		c.lock.Lock()
+		if el, ok := c.entries[key]; ok && el.Value == e {
+			c.removeElement(el)
+		}
+		c.invalidations++
+		c.misses++
+		c.lock.Unlock()
+		return nil, false
+	}
+	entry := *e
+	if c.directory != "" {
+		data, err := os.ReadFile(c.file(key))
+		if err != nil {
+			log.Log.Errorf("Error reading cached image %s: %v", key, err)
+			c.lock.Lock()
+			if el, ok := c.entries[key]; ok && el.Value == e {
+				c.removeElement(el)
+			}
+			c.misses++
+			c.lock.Unlock()
+			return nil, false
+		}
+		entry.data = data
+	}
+	c.lock.Lock()
+	c.hits++
+	c.lock.Unlock()
+	return &entry, true
+}
+
+// put store the derived image. Images larger than the cache are not stored,
+// least recently used images are evicted if the cache is full.
+func (c *imageCache) put(e *imageCacheEntry) {
+	e.size = int64(len(e.data))
+	if e.size > c.maxSize {
+		return
+	}
+	e.table = strings.ToLower(e.table)
+	e.created = time.Now()
+	tmp := ""
+	if c.directory != "" {
+		f, err := os.CreateTemp(c.directory, e.key+"-*.tmp")
+		if err != nil {
+			log.Log.Errorf("Error creating cached image: %v", err)
+			return
+		}
+		tmp = f.Name()
+		_, err = f.Write(e.data)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			log.Log.Errorf("Error writing cached image: %v", err)
+			os.Remove(tmp)
+			return
+		}
+		e.data = nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if el, ok := c.entries[e.key]; ok {
+		c.size -= el.Value.(*imageCacheEntry).size
+		c.lru.Remove(el)
+		delete(c.entries, e.key)
+	}
+	if tmp != "" {
+		if err := os.Rename(tmp, c.file(e.key)); err != nil {
+			log.Log.Errorf("Error storing cached image: %v", err)
+			os.Remove(tmp)
+			return
+		}
+	}
+	c.entries[e.key] = c.lru.PushFront(e)
+	c.size += e.size
+	for c.size > c.maxSize {
+		c.removeElement(c.lru.Back())
+		c.evictions++
+	}
+}
+
+// purge remove all images of the table, all images if no table is given.
+// Returns the number of removed images.
+func (c *imageCache) purge(table string) int {
+	table = strings.ToLower(table)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	count := 0
+	for el := c.lru.Front(); el != nil; {
+		next := el.Next()
+		if table == "" || el.Value.(*imageCacheEntry).table == table {
+			c.removeElement(el)
+			count++
+		}
+		el = next
+	}
+	return count
+}
+
+// removeElement remove the entry, the lock must be held
+func (c *imageCache) removeElement(el *list.Element) {
+	e := el.Value.(*imageCacheEntry)
+	c.lru.Remove(el)
+	delete(c.entries, e.key)
+	c.size -= e.size
+	if c.directory != "" {
+		os.Remove(c.file(e.key))
+	}
+}
+
+// stats current statistics of the cache
+func (c *imageCache) stats() *api.ImageCacheStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return &api.ImageCacheStats{Enabled: api.NewOptBool(true),
+		Storage:       api.NewOptString(c.storage()),
+		Entries:       api.NewOptInt(len(c.entries)),
+		Size:          api.NewOptInt64(c.size),
+		MaxSize:       api.NewOptInt64(c.maxSize),
+		Hits:          api.NewOptInt64(c.hits),
+		Misses:        api.NewOptInt64(c.misses),
+		Evictions:     api.NewOptInt64(c.evictions),
+		Invalidations: api.NewOptInt64(c.invalidations)}
+}
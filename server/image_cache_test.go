@@ -0,0 +1,101 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+)
+
+func TestImageCacheKey(t *testing.T) {
+	key := imageCacheKey("Pictures", "Media", "id=1", "", "", &imageTransform{width: 100})
+	assert.Equal(t, key, imageCacheKey("pictures", "media", "id=1", "", "", &imageTransform{width: 100}))
+	assert.NotEqual(t, key, imageCacheKey("pictures", "media", "id=1", "", "", &imageTransform{width: 101}))
+	assert.NotEqual(t, key, imageCacheKey("pictures", "media", "id=2", "", "", &imageTransform{width: 100}))
+	assert.NotEqual(t, key, imageCacheKey("pictures", "media", "id=1", "", "image/jpeg", &imageTransform{width: 100}))
+}
+
+func TestImageCacheMemory(t *testing.T) {
+	c := newImageCache(10, "", 0, true)
+	c.put(&imageCacheEntry{key: "a", table: "Pictures", mimetype: "image/png", source: "1", data: []byte("aaaa")})
+	c.put(&imageCacheEntry{key: "b", table: "albums", data: []byte("bbbb")})
+	e, ok := c.get("a", func() string { return "1" })
+	assert.True(t, ok)
+	assert.Equal(t, "aaaa", string(e.data))
+	assert.Equal(t, "image/png", e.mimetype)
+
+	// b is least recently used and evicted
+	c.put(&imageCacheEntry{key: "c", table: "albums", data: []byte("cccc")})
+	_, ok = c.get("b", nil)
+	assert.False(t, ok)
+	// larger than the cache
+	c.put(&imageCacheEntry{key: "d", data: make([]byte, 11)})
+	_, ok = c.get("d", nil)
+	assert.False(t, ok)
+
+	// source record changed
+	_, ok = c.get("a", func() string { return "2" })
+	assert.False(t, ok)
+
+	stats := c.stats()
+	assert.Equal(t, api.NewOptInt64(1), stats.Hits)
+	assert.Equal(t, api.NewOptInt64(3), stats.Misses)
+	assert.Equal(t, api.NewOptInt64(1), stats.Evictions)
+	assert.Equal(t, api.NewOptInt64(1), stats.Invalidations)
+	assert.Equal(t, api.NewOptInt(1), stats.Entries)
+	assert.Equal(t, api.NewOptInt64(4), stats.Size)
+
+	assert.Equal(t, 0, c.purge("pictures"))
+	assert.Equal(t, 1, c.purge("Albums"))
+	assert.Equal(t, api.NewOptInt64(0), c.stats().Size)
+}
+
+func TestImageCacheDisk(t *testing.T) {
+	dir := t.TempDir()
+	c := newImageCache(100, dir, 200*time.Millisecond, false)
+	c.put(&imageCacheEntry{key: "a", table: "pictures", data: []byte("aaaa")})
+	assert.FileExists(t, filepath.Join(dir, "a"+imageCacheExtension))
+	e, ok := c.get("a", nil)
+	if assert.True(t, ok) {
+		assert.Equal(t, "aaaa", string(e.data))
+	}
+
+	time.Sleep(250 * time.Millisecond)
+	_, ok = c.get("a", nil)
+	assert.False(t, ok)
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestImageCacheInit(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a" + imageCacheExtension, "b-123.tmp", "keep.txt"} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x"), 0o600))
+	}
+	initImageCache(&clu.ImageCacheConfig{MaxSize: 100, Directory: dir})
+	defer initImageCache(nil)
+	assert.NotNil(t, derivedImages.Load())
+	assert.NoFileExists(t, filepath.Join(dir, "a"+imageCacheExtension))
+	assert.NoFileExists(t, filepath.Join(dir, "b-123.tmp"))
+	assert.FileExists(t, filepath.Join(dir, "keep.txt"))
+
+	derivedImages.Load().put(&imageCacheEntry{key: "c", table: "pictures", data: []byte("cc")})
+	purgeImages("Pictures")
+	assert.Equal(t, api.NewOptInt(0), derivedImages.Load().stats().Entries)
+}
@@ -0,0 +1,213 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kovidgoyal/imaging"
+	"github.com/tknie/clu/api"
+)
+
+const (
+	// hashAverage average hash of 8x8 gray pixels
+	hashAverage = "ahash"
+	// hashDifference difference hash of neighboured gray pixels
+	hashDifference = "dhash"
+	// hashPerceptual DCT based perceptual hash
+	hashPerceptual = "phash"
+)
+
+// grayPixels resize the image and return the gray values of the pixels
+func grayPixels(img image.Image, width, height int) [][]float64 {
+	gray := imaging.Grayscale(imaging.Resize(img, width, height, imaging.Lanczos))
+	pixels := make([][]float64, height)
+	for y := 0; y < height; y++ {
+		pixels[y] = make([]float64, width)
+		for x := 0; x < width; x++ {
+			pixels[y][x] = float64(gray.Pix[y*gray.Stride+x*4])
+		}
+	}
+	return pixels
+}
+
+// imageHash 64 bit perceptual hash of the image with the algorithm
+func imageHash(img image.Image, algorithm string) uint64 {
+	var hash uint64
+	switch algorithm {
+	case hashAverage:
+		pixels := grayPixels(img, 8, 8)
+		sum := 0.0
+		for _, row := range pixels {
+			for _, p := range row {
+				sum += p
+			}
+		}
+		mean := sum / 64
+		for _, row := range pixels {
+			for _, p := range row {
+				hash <<= 1
+				if p > mean {
+					hash |= 1
+				}
+			}
+		}
+	case hashDifference:
+		for _, row := range grayPixels(img, 9, 8) {
+			for x := 0; x < 8; x++ {
+				hash <<= 1
+				if row[x] < row[x+1] {
+					hash |= 1
+				}
+			}
+		}
+	default:
+		coefficients := dct2(grayPixels(img, 32, 32))
+		values := make([]float64, 0, 64)
+		for y := 0; y < 8; y++ {
+			values = append(values, coefficients[y][:8]...)
+		}
+		// the DC coefficient is not part of the median
+		sorted := append([]float64{}, values[1:]...)
+		sort.Float64s(sorted)
+		median := (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+		for _, v := range values {
+			hash <<= 1
+			if v > median {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+// dct2 two dimensional DCT-II of the square matrix
+func dct2(pixels [][]float64) [][]float64 {
+	n := len(pixels)
+	cosines := make([][]float64, n)
+	for k := 0; k < n; k++ {
+		cosines[k] = make([]float64, n)
+		for i := 0; i < n; i++ {
+			cosines[k][i] = math.Cos(math.Pi / float64(n) * (float64(i) + 0.5) * float64(k))
+		}
+	}
+	transform := func(in []float64) []float64 {
+		out := make([]float64, n)
+		for k := 0; k < n; k++ {
+			for i, v := range in {
+				out[k] += v * cosines[k][i]
+			}
+		}
+		return out
+	}
+	rows := make([][]float64, n)
+	for y, row := range pixels {
+		rows[y] = transform(row)
+	}
+	result := make([][]float64, n)
+	for y := range result {
+		result[y] = make([]float64, n)
+	}
+	column := make([]float64, n)
+	for x := 0; x < n; x++ {
+		for y := 0; y < n; y++ {
+			column[y] = rows[y][x]
+		}
+		for y, v := range transform(column) {
+			result[y][x] = v
+		}
+	}
+	return result
+}
+
+// formatHash hash in hex as stored in the hash field
+func formatHash(hash uint64) string {
+	return fmt.Sprintf("%016x", hash)
+}
+
+// parseHash parse the hash of the hash field
+func parseHash(s string) (uint64, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, false
+	}
+	hash, err := strconv.ParseUint(s, 16, 64)
+	return hash, err == nil
+}
+
+// hashDistance Hamming distance of two hashes
+func hashDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// hashedRecord primary key and hash of a record
+type hashedRecord struct {
+	key  string
+	hash uint64
+}
+
+// duplicateGroups groups of records with hashes in the Hamming distance.
+// Records are grouped transitively, the distance of each record is
+// calculated to the first record of the group.
+func duplicateGroups(records []hashedRecord, distance int) []api.ImageDuplicateGroup {
+	parent := make([]int, len(records))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := range records {
+		for j := i + 1; j < len(records); j++ {
+			if hashDistance(records[i].hash, records[j].hash) <= distance {
+				if a, b := find(i), find(j); a != b {
+					parent[max(a, b)] = min(a, b)
+				}
+			}
+		}
+	}
+	members := make(map[int][]int)
+	roots := make([]int, 0)
+	for i := range records {
+		r := find(i)
+		if _, ok := members[r]; !ok {
+			roots = append(roots, r)
+		}
+		members[r] = append(members[r], i)
+	}
+	groups := make([]api.ImageDuplicateGroup, 0)
+	for _, r := range roots {
+		m := members[r]
+		if len(m) < 2 {
+			continue
+		}
+		group := api.ImageDuplicateGroup{}
+		for _, i := range m {
+			group.Records = append(group.Records, api.ImageDuplicateRecord{
+				Key:      api.NewOptString(records[i].key),
+				Hash:     api.NewOptString(formatHash(records[i].hash)),
+				Distance: api.NewOptInt(hashDistance(records[m[0]].hash, records[i].hash))})
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
@@ -0,0 +1,90 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/kovidgoyal/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+)
+
+func testHashImage(width, height int, inverse bool) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			fx, fy := float64(x)/float64(width), float64(y)/float64(height)
+			v := uint8(127 + 60*math.Sin(9*fx+2*fy) + 60*math.Cos(5*fy*fx+7*fy))
+			if inverse {
+				v = 255 - v
+			}
+			img.Set(x, y, color.NRGBA{v, v, v, 255})
+		}
+	}
+	return img
+}
+
+func TestImageHash(t *testing.T) {
+	img := testHashImage(200, 100, false)
+	resized := imaging.Resize(img, 120, 60, imaging.Lanczos)
+	other := testHashImage(200, 100, true)
+	for _, algorithm := range []string{hashAverage, hashDifference, hashPerceptual} {
+		hash := imageHash(img, algorithm)
+		assert.Equal(t, hash, imageHash(img, algorithm), algorithm)
+		assert.LessOrEqual(t, hashDistance(hash, imageHash(resized, algorithm)), 4, algorithm)
+		assert.Greater(t, hashDistance(hash, imageHash(other, algorithm)), 10, algorithm)
+	}
+	h, ok := parseHash(formatHash(0x0123456789abcdef))
+	assert.True(t, ok)
+	assert.Equal(t, uint64(0x0123456789abcdef), h)
+	_, ok = parseHash("xyz")
+	assert.False(t, ok)
+}
+
+func TestDct2(t *testing.T) {
+	pixels := [][]float64{{1, 1}, {1, 1}}
+	c := dct2(pixels)
+	assert.InDelta(t, 4.0, c[0][0], 1e-9)
+	assert.InDelta(t, 0.0, c[0][1], 1e-9)
+	assert.InDelta(t, 0.0, c[1][0], 1e-9)
+	assert.InDelta(t, 0.0, c[1][1], 1e-9)
+}
+
+func TestDuplicateGroups(t *testing.T) {
+	records := []hashedRecord{{"1", 0x00ff}, {"2", 0xff00}, {"3", 0x00fe}, {"4", 0x01fe}, {"5", 0xf0f0}}
+	groups := duplicateGroups(records, 1)
+	if assert.Len(t, groups, 1) {
+		assert.Len(t, groups[0].Records, 3)
+		assert.Equal(t, "1", groups[0].Records[0].Key.Value)
+		assert.Equal(t, "4", groups[0].Records[2].Key.Value)
+		assert.Equal(t, 2, groups[0].Records[2].Distance.Value)
+	}
+	assert.Empty(t, duplicateGroups(records, 0))
+	assert.Len(t, duplicateGroups(records, 64), 1)
+}
+
+func TestDuplicateLimit(t *testing.T) {
+	viewer := clu.Viewer
+	t.Cleanup(func() { clu.Viewer = viewer })
+	clu.Viewer = nil
+	assert.Equal(t, defaultMaxDuplicateRows, duplicateLimit(api.OptInt{}))
+	assert.Equal(t, 50, duplicateLimit(api.NewOptInt(50)))
+	clu.Viewer = &clu.RestServer{}
+	clu.Viewer.Common.MaxDuplicateRows = 100
+	assert.Equal(t, 100, duplicateLimit(api.NewOptInt(500)))
+	assert.Equal(t, 20, duplicateLimit(api.NewOptInt(20)))
+}
@@ -0,0 +1,181 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"strings"
+
+	"github.com/rwcarlsen/goexif/exif"
+	"github.com/rwcarlsen/goexif/tiff"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/log"
+)
+
+// maxExifTagLength longer tag values like maker notes are not returned
+const maxExifTagLength = 256
+
+// pngSignature signature of PNG images
+const pngSignature = "\x89PNG\r\n\x1a\n"
+
+// imageFormatMimetypes mimetype of the detected image formats
+var imageFormatMimetypes = map[string]string{
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+	"gif":  "image/gif",
+	"heic": "image/heic",
+}
+
+// exifTags walker collecting the EXIF and TIFF tags
+type exifTags map[string]string
+
+// Walk add the tag to the collected tags
+func (tags exifTags) Walk(name exif.FieldName, tag *tiff.Tag) error {
+	var value string
+	switch tag.Format() {
+	case tiff.StringVal:
+		value, _ = tag.StringVal()
+	case tiff.UndefVal:
+		if len(tag.Val) > maxExifTagLength {
+			return nil
+		}
+		value = strings.Trim(tag.String(), `"`)
+	default:
+		value = strings.ReplaceAll(tag.String(), `"`, "")
+	}
+	if len(value) <= maxExifTagLength {
+		tags[string(name)] = strings.TrimRight(value, "\x00 ")
+	}
+	return nil
+}
+
+// detectImageFormat detect the image format out of the first bytes
+func detectImageFormat(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xff, 0xd8, 0xff}):
+		return "jpeg"
+	case bytes.HasPrefix(data, []byte(pngSignature)):
+		return "png"
+	case bytes.HasPrefix(data, []byte("GIF8")):
+		return "gif"
+	case len(data) >= 12 && string(data[4:8]) == "ftyp":
+		switch string(data[8:12]) {
+		case "heic", "heix", "heim", "heis", "hevc", "hevx", "mif1", "msf1":
+			return "heic"
+		default:
+		}
+	default:
+	}
+	return ""
+}
+
+// pngExif raw EXIF data of the eXIf chunk of a PNG image
+func pngExif(data []byte) []byte {
+	pos := len(pngSignature)
+	for pos+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		chunk := string(data[pos+4 : pos+8])
+		start := pos + 8
+		if length < 0 || start+length > len(data) {
+			return nil
+		}
+		switch chunk {
+		case "eXIf":
+			return data[start : start+length]
+		case "IEND":
+			return nil
+		default:
+		}
+		pos = start + length + 4
+	}
+	return nil
+}
+
+// imageMetadata dimensions, format and EXIF information of the image
+func imageMetadata(data []byte, mimetype string) (*api.ImageMetadata, error) {
+	format := detectImageFormat(data)
+	if format == "" {
+		return nil, errorrepo.NewError("REST00057", mimetype, "unknown image format")
+	}
+	m := &api.ImageMetadata{Format: api.NewOptString(format),
+		Mimetype: api.NewOptString(imageFormatMimetypes[format]),
+		Size:     api.NewOptInt64(int64(len(data)))}
+	var exifData []byte
+	switch format {
+	case "heic":
+		img, err := heifdecoder(bytes.NewReader(data))
+		if err != nil {
+			return nil, errorrepo.NewError("REST00057", mimetype, err)
+		}
+		m.Width = api.NewOptInt(img.Bounds().Dx())
+		m.Height = api.NewOptInt(img.Bounds().Dy())
+		exifData, err = heifextractor(bytes.NewReader(data))
+		if err != nil {
+			log.Log.Debugf("Extract exif error %v", err)
+		}
+	default:
+		config, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, errorrepo.NewError("REST00057", mimetype, err)
+		}
+		m.Width = api.NewOptInt(config.Width)
+		m.Height = api.NewOptInt(config.Height)
+		switch format {
+		case "jpeg":
+			exifData = data
+		case "png":
+			exifData = pngExif(data)
+		default:
+		}
+	}
+	m.Orientation = api.NewOptInt(1)
+	if len(exifData) == 0 {
+		return m, nil
+	}
+	x, err := exif.Decode(bytes.NewReader(exifData))
+	if err != nil {
+		log.Log.Debugf("Decode exif in image error %v", err)
+		return m, nil
+	}
+	tags := make(exifTags)
+	if err = x.Walk(tags); err != nil {
+		log.Log.Debugf("Walk exif in image error %v", err)
+	}
+	m.Tags = api.NewOptImageMetadataTags(api.ImageMetadataTags(tags))
+	if t, err := x.Get(exif.Orientation); err == nil {
+		if o, err := t.Int(0); err == nil && o >= 1 && o <= 8 {
+			m.Orientation = api.NewOptInt(o)
+		}
+	}
+	if t, err := x.DateTime(); err == nil {
+		m.DateTime = api.NewOptDateTime(t)
+	}
+	if lat, long, err := x.LatLong(); err == nil {
+		gps := api.ImageGPS{Latitude: api.NewOptFloat64(lat), Longitude: api.NewOptFloat64(long)}
+		if t, err := x.Get(exif.GPSAltitude); err == nil {
+			if r, err := t.Rat(0); err == nil {
+				altitude, _ := r.Float64()
+				if ref, err := x.Get(exif.GPSAltitudeRef); err == nil {
+					if v, err := ref.Int(0); err == nil && v == 1 {
+						altitude = -altitude
+					}
+				}
+				gps.Altitude = api.NewOptFloat64(altitude)
+			}
+		}
+		m.GPS = api.NewOptImageGPS(gps)
+	}
+	return m, nil
+}
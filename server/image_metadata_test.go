@@ -0,0 +1,80 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testExif little endian TIFF structure with orientation and date time tag
+func testExif() []byte {
+	dateTime := "2024:05:17 10:30:00\x00"
+	var b bytes.Buffer
+	b.WriteString("II*\x00")
+	binary.Write(&b, binary.LittleEndian, uint32(8))
+	binary.Write(&b, binary.LittleEndian, uint16(2))
+	// Orientation SHORT 6
+	binary.Write(&b, binary.LittleEndian, []uint16{0x0112, 3})
+	binary.Write(&b, binary.LittleEndian, []uint32{1, 6})
+	// DateTime ASCII after the IFD
+	binary.Write(&b, binary.LittleEndian, []uint16{0x0132, 2})
+	binary.Write(&b, binary.LittleEndian, []uint32{uint32(len(dateTime)), 8 + 2 + 2*12 + 4})
+	binary.Write(&b, binary.LittleEndian, uint32(0))
+	b.WriteString(dateTime)
+	return b.Bytes()
+}
+
+func TestImageMetadataPNG(t *testing.T) {
+	data := testImage(t, 40, 20)
+	m, err := imageMetadata(data, "image/png")
+	assert.NoError(t, err)
+	assert.Equal(t, "png", m.Format.Value)
+	assert.Equal(t, 40, m.Width.Value)
+	assert.Equal(t, 20, m.Height.Value)
+	assert.Equal(t, 1, m.Orientation.Value)
+	assert.False(t, m.Tags.Set)
+
+	// insert eXIf chunk after the IHDR chunk
+	exifData := testExif()
+	var chunk bytes.Buffer
+	binary.Write(&chunk, binary.BigEndian, uint32(len(exifData)))
+	chunk.WriteString("eXIf")
+	chunk.Write(exifData)
+	binary.Write(&chunk, binary.BigEndian, crc32.ChecksumIEEE(append([]byte("eXIf"), exifData...)))
+	pos := len(pngSignature) + 25
+	withExif := append(append(append([]byte{}, data[:pos]...), chunk.Bytes()...), data[pos:]...)
+
+	m, err = imageMetadata(withExif, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "image/png", m.Mimetype.Value)
+	assert.Equal(t, 6, m.Orientation.Value)
+	assert.Equal(t, "6", m.Tags.Value["Orientation"])
+	assert.Equal(t, "2024:05:17 10:30:00", m.Tags.Value["DateTime"])
+	assert.True(t, m.DateTime.Set)
+	assert.Equal(t, 2024, m.DateTime.Value.Year())
+	assert.Equal(t, time.May, m.DateTime.Value.Month())
+	assert.False(t, m.GPS.Set)
+}
+
+func TestImageMetadataFormat(t *testing.T) {
+	assert.Equal(t, "jpeg", detectImageFormat([]byte{0xff, 0xd8, 0xff, 0xe1}))
+	assert.Equal(t, "heic", detectImageFormat([]byte("\x00\x00\x00\x18ftypheic")))
+	assert.Equal(t, "", detectImageFormat([]byte("text")))
+	_, err := imageMetadata([]byte("text"), "text/plain")
+	assert.Error(t, err)
+}
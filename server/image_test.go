@@ -0,0 +1,98 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+)
+
+func testImage(t *testing.T, width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.NRGBA{uint8(x), uint8(y), 0, 255})
+		}
+	}
+	var buffer bytes.Buffer
+	assert.NoError(t, png.Encode(&buffer, img))
+	return buffer.Bytes()
+}
+
+func TestImageTransformSize(t *testing.T) {
+	tests := []struct {
+		transform     imageTransform
+		width, height int
+	}{
+		{imageTransform{}, 400, 200},
+		{imageTransform{width: 100}, 100, 50},
+		{imageTransform{height: 100}, 200, 100},
+		{imageTransform{width: 100, height: 100, fit: api.GetImageFitContain}, 100, 50},
+		{imageTransform{width: 100, height: 100, fit: api.GetImageFitCover}, 200, 100},
+		{imageTransform{width: 100, height: 100, fit: api.GetImageFitCrop}, 100, 100},
+	}
+	for _, test := range tests {
+		width, height := test.transform.size(400, 200)
+		assert.Equal(t, test.width, width, "%#v", test.transform)
+		assert.Equal(t, test.height, height, "%#v", test.transform)
+	}
+}
+
+func TestImageTransformApply(t *testing.T) {
+	data := testImage(t, 40, 20)
+	transform := newImageTransform(api.GetImageParams{Width: api.NewOptInt(10), Height: api.NewOptInt(10),
+		Fit: api.NewOptGetImageFit(api.GetImageFitCrop)})
+	out, mimetype, err := transform.apply(data, "image/png")
+	assert.NoError(t, err)
+	assert.Equal(t, "image/png", mimetype)
+	config, format, err := image.DecodeConfig(bytes.NewReader(out))
+	assert.NoError(t, err)
+	assert.Equal(t, "png", format)
+	assert.Equal(t, 10, config.Width)
+	assert.Equal(t, 10, config.Height)
+
+	transform = newImageTransform(api.GetImageParams{Format: api.NewOptGetImageFormat(api.GetImageFormatJpeg),
+		Quality: api.NewOptInt(50)})
+	out, mimetype, err = transform.apply(data, "image/png")
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", mimetype)
+	config, format, err = image.DecodeConfig(bytes.NewReader(out))
+	assert.NoError(t, err)
+	assert.Equal(t, "jpeg", format)
+	assert.Equal(t, 40, config.Width)
+
+	transform = newImageTransform(api.GetImageParams{Width: api.NewOptInt(defaultMaxImageSize + 1)})
+	_, _, err = transform.apply(data, "image/png")
+	assert.Error(t, err)
+
+	assert.Nil(t, newImageTransform(api.GetImageParams{}))
+}
+
+func TestOrientImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.NRGBA{255, 0, 0, 255})
+	for _, o := range []string{"5", "6", "7", "8"} {
+		b := orientImage(img, o).Bounds()
+		assert.Equal(t, 1, b.Dx(), o)
+		assert.Equal(t, 2, b.Dy(), o)
+	}
+	r, _, _, _ := orientImage(img, "2").At(1, 0).RGBA()
+	assert.Equal(t, uint32(0xffff), r)
+	r, _, _, _ = orientImage(img, "1").At(0, 0).RGBA()
+	assert.Equal(t, uint32(0xffff), r)
+}
@@ -0,0 +1,390 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/jx"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
+)
+
+// maxImportErrors maximal number of errors reported of an import
+const maxImportErrors = 100
+
+// maxImportBatchSize maximal number of records inserted in one batch, larger
+// batch sizes are reduced
+const maxImportBatchSize = 10000
+
+// importTimeLayouts time layouts accepted for date and time stamp columns
+var importTimeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"}
+
+// importer import records of a CSV or NDJSON stream into a table
+type importer struct {
+	table      string
+	d          common.RegDbID
+	columns    map[string]api.TableColumn
+	batchSize  int
+	skip       bool
+	dateFormat string
+	report     api.ImportReport
+	// fields, values and input rows of the current batch
+	fields []string
+	key    string
+	batch  [][]any
+	rows   []int
+}
+
+// ImportRecords implements importRecords operation.
+//
+// Import a CSV or NDJSON stream of records into a table.
+//
+// POST /rest/import/{table}
+func (Handler) ImportRecords(ctx context.Context, req api.ImportRecordsReq, params api.ImportRecordsParams) (r api.ImportRecordsRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.ImportRecordsForbidden{}, nil
+	}
+	batchSize := params.BatchSize.Or(1000)
+	if batchSize < 1 {
+		return nil, NewBadRequestError(errorrepo.NewError("REST00034", batchSize))
+	}
+	if batchSize > maxImportBatchSize {
+		log.Log.Debugf("Import batch size %d reduced to %d", batchSize, maxImportBatchSize)
+		batchSize = maxImportBatchSize
+	}
+	m, err := tableMetadata(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error reading metadata of table %s:%v", params.Table, err)
+		return nil, err
+	}
+	d, err := ConnectTable(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error import table %s:%v", params.Table, err)
+		return nil, err
+	}
+	defer CloseTable(d)
+
+	im := &importer{table: params.Table, d: d, columns: make(map[string]api.TableColumn),
+		batchSize: batchSize, skip: params.OnError.Or(api.ImportRecordsOnErrorAbort) == api.ImportRecordsOnErrorSkip,
+		dateFormat: params.Dateformat.Or(TimeFormat)}
+	for _, c := range m.Columns {
+		im.columns[strings.ToLower(c.Name.Value)] = c
+	}
+	switch body := req.(type) {
+	case *api.ImportRecordsReqTextCsv:
+		var dialect *csvDialect
+		dialect, err = newCSVDialect(params.Delimiter, api.OptString{}, params.Header,
+			params.Null, params.Dateformat, api.OptBool{})
+		if err != nil {
+			return nil, NewBadRequestError(err)
+		}
+		order := make([]string, 0, len(m.Columns))
+		for _, c := range m.Columns {
+			order = append(order, c.Name.Value)
+		}
+		err = im.importCSV(body.Data, dialect, order)
+	case *api.ImportRecordsReqApplicationXNdjson:
+		err = im.importNDJSON(body.Data)
+	default:
+		return nil, NewBadRequestError(errorrepo.NewError("REST00035", fmt.Sprintf("%T", req)))
+	}
+	switch {
+	case err != nil:
+	case im.aborted():
+		im.discard()
+	default:
+		err = im.flush()
+	}
+	if im.report.Accepted.Value > 0 {
+		purgeImages(params.Table)
+	}
+	if err != nil {
+		if _, ok := err.(*errorrepo.Error); ok {
+			return nil, NewBadRequestError(err)
+		}
+		return nil, err
+	}
+	log.Log.Debugf("Import into %s accepted=%d rejected=%d", params.Table,
+		im.report.Accepted.Value, im.report.Rejected.Value)
+	return &api.ImportReportHeaders{Response: im.report, XToken: api.NewOptString(session.Token)}, nil
+}
+
+// importCSV import CSV records. Without header line the fields are expected
+// in the column order of the table.
+func (im *importer) importCSV(data io.Reader, dialect *csvDialect, order []string) error {
+	br := bufio.NewReader(data)
+	if bom, err := br.Peek(3); err == nil && bytes.Equal(bom, []byte("\xef\xbb\xbf")) {
+		br.Discard(3)
+	}
+	cr := csv.NewReader(br)
+	cr.Comma = []rune(dialect.delimiter)[0]
+	cr.FieldsPerRecord = -1
+	fields := order
+	if dialect.header {
+		header, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errorrepo.NewError("REST00035", err.Error())
+		}
+		fields = make([]string, 0, len(header))
+		for _, h := range header {
+			c, ok := im.columns[strings.ToLower(strings.TrimSpace(h))]
+			if !ok {
+				return errorrepo.NewError("RERR00026", h)
+			}
+			fields = append(fields, c.Name.Value)
+		}
+	}
+	for !im.aborted() {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			var pe *csv.ParseError
+			line := 0
+			if errors.As(err, &pe) {
+				line = pe.Line
+			}
+			if err = im.reject(line, err); err != nil {
+				return err
+			}
+			continue
+		}
+		line, _ := cr.FieldPos(0)
+		if len(record) != len(fields) {
+			if err = im.reject(line, errorrepo.NewError("REST00036", len(record), len(fields))); err != nil {
+				return err
+			}
+			continue
+		}
+		values := make([]any, len(fields))
+		for i, f := range fields {
+			if record[i] == dialect.null {
+				continue
+			}
+			values[i], err = im.convert(f, record[i])
+			if err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = im.add(line, fields, values)
+		} else {
+			err = im.reject(line, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importNDJSON import newline delimited JSON records
+func (im *importer) importNDJSON(data io.Reader) error {
+	scanner := bufio.NewScanner(data)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for !im.aborted() && scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		fields, values, err := im.parseJSON(text)
+		if err == nil {
+			err = im.add(line, fields, values)
+		} else {
+			err = im.reject(line, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return im.reject(line+1, err)
+	}
+	return nil
+}
+
+// parseJSON parse one JSON record, values are converted to the column types
+func (im *importer) parseJSON(text []byte) ([]string, []any, error) {
+	fields := make([]string, 0)
+	values := make([]any, 0)
+	err := jx.DecodeBytes(text).Obj(func(d *jx.Decoder, key string) error {
+		c, ok := im.columns[strings.ToLower(key)]
+		if !ok {
+			return errorrepo.NewError("RERR00026", key)
+		}
+		raw, err := d.Raw()
+		if err != nil {
+			return err
+		}
+		var v any
+		switch raw.Type() {
+		case jx.Null:
+		case jx.String:
+			s, err := jx.DecodeBytes(raw).Str()
+			if err != nil {
+				return err
+			}
+			v, err = im.convert(c.Name.Value, s)
+			if err != nil {
+				return err
+			}
+		default:
+			v, err = im.convert(c.Name.Value, raw.String())
+			if err != nil {
+				return err
+			}
+		}
+		fields = append(fields, c.Name.Value)
+		values = append(values, v)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return fields, values, nil
+}
+
+// convert convert text value to the Go type of the column
+func (im *importer) convert(field, value string) (any, error) {
+	return convertColumnValue(im.columns[strings.ToLower(field)], value, im.dateFormat)
+}
+
+// convertColumnValue convert text value to the Go type of the column
+func convertColumnValue(column api.TableColumn, value, dateFormat string) (any, error) {
+	t := strings.ToLower(column.Type.Value)
+	var v any
+	var err error
+	switch {
+	case strings.Contains(t, "bool"):
+		v, err = strconv.ParseBool(value)
+	case integerType(t):
+		v, err = strconv.ParseInt(value, 10, 64)
+	case strings.Contains(t, "float") || strings.Contains(t, "double") || strings.Contains(t, "real"):
+		v, err = strconv.ParseFloat(value, 64)
+	case strings.Contains(t, "numeric") || strings.Contains(t, "decimal") || strings.Contains(t, "number"):
+		_, err = strconv.ParseFloat(value, 64)
+		v = value
+	case strings.Contains(t, "timestamp") || strings.Contains(t, "datetime") || t == "date":
+		for _, layout := range append([]string{dateFormat}, importTimeLayouts...) {
+			if v, err = time.Parse(layout, value); err == nil {
+				break
+			}
+		}
+	case strings.Contains(t, "bytea") || strings.Contains(t, "blob") || strings.Contains(t, "binary") || strings.Contains(t, "raw"):
+		v, err = base64.StdEncoding.DecodeString(value)
+	default:
+		v = value
+	}
+	if err != nil {
+		return nil, errorrepo.NewError("REST00037", value, column.Name.Value, t)
+	}
+	return v, nil
+}
+
+// aborted import is aborted after an error
+func (im *importer) aborted() bool {
+	return im.report.Aborted.Value
+}
+
+// reject count rejected row and report the error. If errors are not
+// skipped, the import is aborted.
+func (im *importer) reject(row int, err error) error {
+	log.Log.Debugf("Reject import row %d: %v", row, err)
+	im.report.Rejected = api.NewOptInt(im.report.Rejected.Value + 1)
+	if len(im.report.Errors) < maxImportErrors {
+		im.report.Errors = append(im.report.Errors, api.ImportError{Row: api.NewOptInt(row),
+			Message: api.NewOptString(err.Error())})
+	}
+	if !im.skip {
+		im.report.Aborted = api.NewOptBool(true)
+	}
+	return nil
+}
+
+// add add the row to the current batch. The batch is inserted if it is full
+// or the fields of the row differ.
+func (im *importer) add(row int, fields []string, values []any) error {
+	key := strings.Join(fields, ",")
+	if key != im.key {
+		if err := im.flush(); err != nil {
+			return err
+		}
+		im.key = key
+		im.fields = fields
+	}
+	im.batch = append(im.batch, values)
+	im.rows = append(im.rows, row)
+	if len(im.batch) >= im.batchSize {
+		return im.flush()
+	}
+	return nil
+}
+
+// discard drop the rows of the current batch of an aborted import. Only the
+// batches committed before are part of the accepted rows.
+func (im *importer) discard() {
+	if len(im.batch) > 0 {
+		log.Log.Debugf("Import aborted, %d rows of the current batch not inserted", len(im.batch))
+	}
+	im.batch, im.rows = nil, nil
+}
+
+// flush insert and commit the current batch. If bad rows are skipped, a
+// failed batch is inserted row by row to find the bad rows.
+func (im *importer) flush() error {
+	if len(im.batch) == 0 {
+		return nil
+	}
+	batch, rows := im.batch, im.rows
+	im.batch, im.rows = nil, nil
+	_, err := im.d.Insert(im.table, &common.Entries{Fields: im.fields, Values: batch})
+	if err == nil {
+		im.report.Accepted = api.NewOptInt(im.report.Accepted.Value + len(batch))
+		return nil
+	}
+	log.Log.Debugf("Import batch of %d rows failed: %v", len(batch), err)
+	if !im.skip {
+		im.report.Rejected = api.NewOptInt(im.report.Rejected.Value + len(batch) - 1)
+		return im.reject(rows[0], errorrepo.NewError("REST00038", rows[0], rows[len(rows)-1], err))
+	}
+	for i, values := range batch {
+		_, err := im.d.Insert(im.table, &common.Entries{Fields: im.fields, Values: [][]any{values}})
+		if err != nil {
+			im.reject(rows[i], err)
+			continue
+		}
+		im.report.Accepted = api.NewOptInt(im.report.Accepted.Value + 1)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+)
+
+func TestImportAbortPendingBatch(t *testing.T) {
+	im := &importer{table: "albums", batchSize: 10, columns: map[string]api.TableColumn{
+		"id":    {Name: api.NewOptString("ID"), Type: api.NewOptString("integer")},
+		"title": {Name: api.NewOptString("Title"), Type: api.NewOptString("text")}}}
+	err := im.importNDJSON(strings.NewReader("{\"id\":1,\"title\":\"a\"}\n{\"id\":\"x\"}\n{\"id\":3}\n"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, im.aborted())
+	assert.Equal(t, 1, im.report.Rejected.Value)
+	assert.Len(t, im.batch, 1)
+	im.discard()
+	assert.Empty(t, im.batch)
+	assert.Empty(t, im.rows)
+	assert.Equal(t, 0, im.report.Accepted.Value)
+}
@@ -0,0 +1,397 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
+)
+
+// recordKey primary key of a record addressed by the key path parameter
+type recordKey struct {
+	table   string
+	key     string
+	fields  []string
+	values  map[string]any
+	columns map[string]string
+}
+
+// newRecordKey parse the key path parameter. Values of composite keys are
+// separated by comma in the column order of the primary key fields, see
+// splitRecordKey.
+func newRecordKey(session *clu.Context, table, key string) (*recordKey, error) {
+	m, err := tableMetadata(session, table)
+	if err != nil {
+		return nil, err
+	}
+	rk := &recordKey{table: table, key: key, values: make(map[string]any),
+		columns: make(map[string]string)}
+	primaryKey := make([]api.TableColumn, 0)
+	for _, c := range m.Columns {
+		rk.columns[strings.ToLower(c.Name.Value)] = c.Name.Value
+		if c.PrimaryKey.Value {
+			primaryKey = append(primaryKey, c)
+		}
+	}
+	if len(primaryKey) == 0 {
+		return nil, errorrepo.NewError("REST00040", table)
+	}
+	parts, err := splitRecordKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != len(primaryKey) {
+		return nil, errorrepo.NewError("REST00041", table, len(primaryKey), len(parts))
+	}
+	for i, c := range primaryKey {
+		v, err := convertColumnValue(c, parts[i], TimeFormat)
+		if err != nil {
+			return nil, err
+		}
+		rk.fields = append(rk.fields, c.Name.Value)
+		rk.values[c.Name.Value] = v
+	}
+	return rk, nil
+}
+
+// splitRecordKey split the key path parameter into the values of the key
+// fields. A value containing a comma is quoted with single or double quotes,
+// a quote inside is doubled. Unquoted values are percent-decoded, so a comma
+// can be sent encoded twice as %252C.
+func splitRecordKey(key string) ([]string, error) {
+	parts := make([]string, 0)
+	rest := key
+	for {
+		var part string
+		if rest != "" && (rest[0] == '\'' || rest[0] == '"') {
+			q := rest[0]
+			var b strings.Builder
+			i, closed := 1, false
+			for i < len(rest) {
+				if rest[i] == q {
+					if i+1 < len(rest) && rest[i+1] == q {
+						b.WriteByte(q)
+						i += 2
+						continue
+					}
+					closed = true
+					i++
+					break
+				}
+				b.WriteByte(rest[i])
+				i++
+			}
+			if !closed || (i < len(rest) && rest[i] != ',') {
+				return nil, errorrepo.NewError("REST00065", key)
+			}
+			part, rest = b.String(), rest[i:]
+		} else {
+			raw := rest
+			if end := strings.IndexByte(rest, ','); end >= 0 {
+				raw = rest[:end]
+			}
+			part, rest = raw, rest[len(raw):]
+			if u, err := url.PathUnescape(raw); err == nil {
+				part = u
+			}
+		}
+		parts = append(parts, part)
+		if rest == "" {
+			return parts, nil
+		}
+		rest = rest[1:]
+	}
+}
+
+// formatRecordKey key path notation of the key values. Values containing a
+// comma, quote or percent sign are quoted.
+func formatRecordKey(parts []string) string {
+	list := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if strings.ContainsAny(p, ",'\"%") {
+			p = "'" + strings.ReplaceAll(p, "'", "''") + "'"
+		}
+		list = append(list, p)
+	}
+	return strings.Join(list, ",")
+}
+
+// read read the record of the key, nil if the record does not exist
+func (rk *recordKey) read(session *clu.Context, d common.RegDbID) (api.ResponseRecordsItem, error) {
+	v, err := tableVersion(session, rk.table)
+	if err != nil {
+		return nil, err
+	}
+	search, err := keySearch(d, rk.table, []any{rk.values}, rk.fields)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := queryVersioned(d, &common.Query{TableName: rk.table, Fields: []string{"*"},
+		Search: search}, v)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	return data[0], nil
+}
+
+// response response of the record
+func (rk *recordKey) response(item api.ResponseRecordsItem) api.Response {
+	return api.Response{NrRecords: api.NewOptInt(1), MapName: api.NewOptString(rk.table),
+		Records: []api.ResponseRecordsItem{item}}
+}
+
+// notFound API error of a missing record
+func (rk *recordKey) notFound() *api.Error {
+	return NewAPIError("REST00042", errorrepo.NewError("REST00042", rk.key, rk.table))
+}
+
+// GetRecordByKey implements getRecordByKey operation.
+//
+// Read the record with the given primary key.
+//
+// GET /rest/view/{table}/pk/{key}
+func (Handler) GetRecordByKey(ctx context.Context, params api.GetRecordByKeyParams) (r api.GetRecordByKeyRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.GetRecordByKeyForbidden{}, nil
+	}
+	rk, err := newRecordKey(session, params.Table, params.Key)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	d, err := ConnectTable(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error read table %s:%v", params.Table, err)
+		return nil, err
+	}
+	defer CloseTable(d)
+	item, err := rk.read(session, d)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	if item == nil {
+		return (*api.GetRecordByKeyNotFound)(rk.notFound()), nil
+	}
+	return &api.GetRecordByKeyOKHeaders{Response: rk.response(item), ETag: optString(recordETag(item)),
+		XToken: api.NewOptString(session.Token)}, nil
+}
+
+// PatchRecordByKey implements patchRecordByKey operation.
+//
+// Update the fields of the record with the given primary key present in the
+// JSON Merge Patch.
+//
+// PATCH /rest/view/{table}/pk/{key}
+func (Handler) PatchRecordByKey(ctx context.Context, req api.PatchRecordByKeyReq,
+	params api.PatchRecordByKeyParams) (r api.PatchRecordByKeyRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.PatchRecordByKeyForbidden{}, nil
+	}
+	rk, err := newRecordKey(session, params.Table, params.Key)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	schema, err := newRecordSchema(session, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	patch := make(map[string]any)
+	var etag any
+	for n, raw := range req {
+		if n == etagField {
+			etag, _ = parseJx(raw)
+			continue
+		}
+		c, ok := rk.columns[strings.ToLower(n)]
+		switch {
+		case !ok:
+			err = errorrepo.NewError("REST00046", n)
+		case containsFold(rk.fields, c):
+			err = errorrepo.NewError("REST00043", c)
+		default:
+		}
+		if err != nil {
+			if schema == nil {
+				return nil, NewBadRequestError(err)
+			}
+			schema.fail("/"+pointerEscaper.Replace(n), err)
+			err = nil
+			continue
+		}
+		if schema == nil {
+			x, err := parseJx(raw)
+			if err != nil {
+				return nil, NewBadRequestError(errorrepo.NewError("RERR00015", n, err))
+			}
+			patch[c] = x
+		} else if x, ok := schema.value("/"+pointerEscaper.Replace(n), schema.columns[strings.ToLower(c)], raw); ok {
+			patch[c] = x
+		}
+	}
+	if schema != nil && len(schema.details) > 0 {
+		return nil, schema.error()
+	}
+	v, err := tableVersion(session, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil && params.IfMatch.Set {
+		return nil, NewBadRequestError(errorrepo.NewError("REST00029", params.Table))
+	}
+	d, err := ConnectTable(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error patch table %s:%v", params.Table, err)
+		return nil, err
+	}
+	defer CloseTable(d)
+
+	h, err := newRecordHistory(session, params.Table)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	log.Log.Debugf("Patch record %s in %s: %v", params.Key, params.Table, patch)
+	if len(patch) > 0 {
+		search, err := keySearch(d, params.Table, []any{rk.values}, rk.fields)
+		if err != nil {
+			return nil, repoBadRequestError(err)
+		}
+		if h != nil {
+			if err = h.capture(d, search); err != nil {
+				return nil, err
+			}
+		}
+		for k, x := range rk.values {
+			patch[k] = x
+		}
+		found := true
+		if v != nil {
+			var expected any
+			switch {
+			case params.IfMatch.Set && parseETag(params.IfMatch.Value) != "*":
+				expected, err = v.value(params.IfMatch.Value)
+			case etag != nil:
+				expected, err = v.value(etag)
+			default:
+			}
+			if err != nil {
+				return nil, NewBadRequestError(err)
+			}
+			versions, conflict, err := v.update(d, []map[string]any{patch}, rk.fields, []any{expected})
+			if err != nil {
+				return nil, repoBadRequestError(err)
+			}
+			if conflict != nil {
+				current, err := v.current(d, rk.fields, conflict)
+				if err != nil {
+					return nil, err
+				}
+				return &api.Response{Records: current, NrRecords: api.NewOptInt(len(current)),
+					MapName: api.NewOptString(v.table)}, nil
+			}
+			found = versions[0] != nil
+		} else {
+			fields := make([]string, 0, len(patch))
+			values := make([]any, 0, len(patch))
+			for f, x := range patch {
+				fields = append(fields, f)
+				values = append(values, x)
+			}
+			// the key search compares all key fields and is taken as condition
+			_, n, err := d.Update(params.Table, &common.Entries{Fields: fields,
+				Update: []string{"(" + search + ")"}, Values: [][]any{values}})
+			if err != nil {
+				log.Log.Debugf("Error patch record: %v", err)
+				return nil, err
+			}
+			found = n > 0
+		}
+		if !found {
+			return (*api.PatchRecordByKeyNotFound)(rk.notFound()), nil
+		}
+		purgeImages(params.Table)
+		if h != nil {
+			if err = h.changed(session, d, search); err != nil {
+				return nil, err
+			}
+		}
+	}
+	item, err := rk.read(session, d)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	if item == nil {
+		return (*api.PatchRecordByKeyNotFound)(rk.notFound()), nil
+	}
+	return &api.GetRecordByKeyOKHeaders{Response: rk.response(item), ETag: optString(recordETag(item)),
+		XToken: api.NewOptString(session.Token)}, nil
+}
+
+// DeleteRecordByKey implements deleteRecordByKey operation.
+//
+// Delete the record with the given primary key.
+//
+// DELETE /rest/view/{table}/pk/{key}
+func (Handler) DeleteRecordByKey(ctx context.Context, params api.DeleteRecordByKeyParams) (r api.DeleteRecordByKeyRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.DeleteRecordByKeyForbidden{}, nil
+	}
+	rk, err := newRecordKey(session, params.Table, params.Key)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	d, err := ConnectTable(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error delete table %s:%v", params.Table, err)
+		return nil, err
+	}
+	defer CloseTable(d)
+	search, err := keySearch(d, params.Table, []any{rk.values}, rk.fields)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	h, err := newRecordHistory(session, params.Table)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	if h != nil {
+		if err = h.capture(d, search); err != nil {
+			return nil, err
+		}
+	}
+	dr, err := d.Delete(params.Table, &common.Entries{Criteria: search})
+	if err != nil {
+		log.Log.Errorf("Error delete key %s->%s:%v", params.Table, params.Key, err)
+		return nil, err
+	}
+	if dr == 0 {
+		return (*api.DeleteRecordByKeyNotFound)(rk.notFound()), nil
+	}
+	purgeImages(params.Table)
+	if h != nil {
+		if err = h.store(session, nil); err != nil {
+			return nil, err
+		}
+	}
+	log.Log.Debugf("Record %s deleted from %s", params.Key, params.Table)
+	resp := api.Response{NrRecords: api.NewOptInt(int(dr)), MapName: api.NewOptString(params.Table)}
+	return &api.ResponseHeaders{Response: resp, XToken: api.NewOptString(session.Token)}, nil
+}
@@ -0,0 +1,77 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/flynn/common"
+)
+
+func TestSplitRecordKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want []string
+	}{
+		{"1", []string{"1"}},
+		{"1,2", []string{"1", "2"}},
+		{"a,", []string{"a", ""}},
+		{"'a,b',2", []string{"a,b", "2"}},
+		{`"a,b",'it''s'`, []string{"a,b", "it's"}},
+		{`1,"say ""hi"""`, []string{"1", `say "hi"`}},
+		{"a%2Cb,2", []string{"a,b", "2"}},
+		{"50%,x", []string{"50%", "x"}},
+		{"''", []string{""}},
+	}
+	for _, tt := range tests {
+		parts, err := splitRecordKey(tt.key)
+		if assert.NoError(t, err, tt.key) {
+			assert.Equal(t, tt.want, parts, tt.key)
+		}
+	}
+	for _, key := range []string{"'a,b", "'a'b,2", `"x`} {
+		_, err := splitRecordKey(key)
+		assert.Equal(t, "REST00065", errorID(err), key)
+	}
+}
+
+func TestFormatRecordKey(t *testing.T) {
+	for _, parts := range [][]string{{"1", "2"}, {"a,b", "2"}, {"it's", "x"}, {`say "hi"`}, {"50%", ""}} {
+		key := formatRecordKey(parts)
+		split, err := splitRecordKey(key)
+		if assert.NoError(t, err, key) {
+			assert.Equal(t, parts, split, key)
+		}
+	}
+	assert.Equal(t, "1,2", formatRecordKey([]string{"1", "2"}))
+	assert.Equal(t, "'a,b','it''s'", formatRecordKey([]string{"a,b", "it's"}))
+
+	h := &recordHistory{keys: []string{"Name", "Nr"}}
+	item := api.ResponseRecordsItem{"name": jx.Raw(`"a,b"`), "nr": jx.Raw(`3`)}
+	assert.Equal(t, "'a,b',3", h.itemKey(item))
+}
+
+func TestKeyFilterQuote(t *testing.T) {
+	filter, err := keyFilter([]any{map[string]any{"name": "a' OR '1'='1", "ID": 2}}, []string{"Name", " id"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	search, err := compileTest(common.PostgresType, filter)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "((Name='a'' OR ''1''=''1' AND ID=2))", search)
+	}
+	_, err = keyFilter([]any{map[string]any{"ID": 2}}, []string{"Name"})
+	assert.Equal(t, "REST00032", errorID(err))
+}
@@ -0,0 +1,271 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+)
+
+// lobChunkSize size of the blocks appended to the large object field
+const lobChunkSize = 16 * blockSize
+
+// lobWrite large object written block by block into exactly one record
+// inside one transaction. The record is locked and identified by its
+// primary key, so the search is evaluated only once.
+type lobWrite struct {
+	table         string
+	field         string
+	mimetypeField string
+	mimetype      string
+	driver        common.ReferenceType
+	keys          []string
+}
+
+// lobTx statements of the large object write inside the transaction
+type lobTx interface {
+	query(statement string, args ...any) ([][]any, error)
+	exec(statement string, args ...any) (int64, error)
+}
+
+// lobResult result of the large object write. Records contains the number
+// of records matching the search, the object is only stored if exactly one
+// record matches.
+type lobResult struct {
+	records  int
+	size     int64
+	checksum string
+}
+
+// lobChunked large objects are written block by block on drivers
+// concatenating binary values in an update
+func lobChunked(driver common.ReferenceType) bool {
+	return driver == common.PostgresType || driver == common.MysqlType
+}
+
+// placeholder bind placeholder of the driver
+func (lw *lobWrite) placeholder(i int) string {
+	if lw.driver == common.PostgresType {
+		return "$" + strconv.Itoa(i)
+	}
+	return "?"
+}
+
+// selectStatement lock the records matching the search and read their
+// primary key, two records are enough to detect an ambiguous search
+func (lw *lobWrite) selectStatement(search string) string {
+	where := ""
+	if strings.TrimSpace(search) != "" {
+		where = " WHERE (" + search + ")"
+	}
+	return "SELECT " + strings.Join(lw.keys, ",") + " FROM " + lw.table + where + " LIMIT 2 FOR UPDATE"
+}
+
+// keyCondition condition of the primary key with placeholders starting
+// after offset
+func (lw *lobWrite) keyCondition(offset int) string {
+	cond := make([]string, 0, len(lw.keys))
+	for i, k := range lw.keys {
+		cond = append(cond, k+"="+lw.placeholder(offset+i+1))
+	}
+	return strings.Join(cond, " AND ")
+}
+
+// clearStatement empty the large object field and set the mimetype
+func (lw *lobWrite) clearStatement() (string, int) {
+	set := lw.field + "=" + lw.placeholder(1)
+	n := 1
+	if lw.mimetypeField != "" && lw.mimetype != "" {
+		n++
+		set += "," + lw.mimetypeField + "=" + lw.placeholder(n)
+	}
+	return "UPDATE " + lw.table + " SET " + set + " WHERE " + lw.keyCondition(n), n
+}
+
+// appendStatement append one block to the large object field
+func (lw *lobWrite) appendStatement() string {
+	value := "CONCAT(" + lw.field + "," + lw.placeholder(1) + ")"
+	if lw.driver == common.PostgresType {
+		value = lw.field + "||" + lw.placeholder(1)
+	}
+	return "UPDATE " + lw.table + " SET " + lw.field + "=" + value + " WHERE " + lw.keyCondition(1)
+}
+
+// write store the reader into the large object field of the record
+// matching the search. The SHA-256 checksum is calculated while reading,
+// objects exceeding the limit fail.
+func (lw *lobWrite) write(tx lobTx, search string, reader io.Reader, limit int64) (*lobResult, error) {
+	rows, err := tx.query(lw.selectStatement(search))
+	if err != nil {
+		return nil, err
+	}
+	result := &lobResult{records: len(rows)}
+	if len(rows) != 1 {
+		return result, nil
+	}
+	key := rows[0]
+	statement, n := lw.clearStatement()
+	args := []any{[]byte{}}
+	if n > 1 {
+		args = append(args, lw.mimetype)
+	}
+	if _, err = tx.exec(statement, append(args, key...)...); err != nil {
+		return nil, err
+	}
+	statement = lw.appendStatement()
+	h := sha256.New()
+	buffer := make([]byte, lobChunkSize)
+	limited := io.LimitReader(reader, limit+1)
+	for {
+		l, rerr := io.ReadFull(limited, buffer)
+		if l > 0 {
+			result.size += int64(l)
+			if result.size > limit {
+				return nil, errorrepo.NewError("REST00053", limit)
+			}
+			h.Write(buffer[:l])
+			if _, err = tx.exec(statement, append([]any{buffer[:l]}, key...)...); err != nil {
+				return nil, err
+			}
+		}
+		if rerr == io.EOF || rerr == io.ErrUnexpectedEOF {
+			break
+		}
+		if rerr != nil {
+			return nil, rerr
+		}
+	}
+	result.checksum = hex.EncodeToString(h.Sum(nil))
+	log.Log.Debugf("Appended %d bytes to %s.%s", result.size, lw.table, lw.field)
+	return result, nil
+}
+
+// execute run the large object write in one own database transaction. The
+// transaction is only committed if exactly one record is written.
+func (lw *lobWrite) execute(d common.RegDbID, search string, reader io.Reader, limit int64) (*lobResult, error) {
+	dbOpen, err := d.Open()
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	switch db := dbOpen.(type) {
+	case *pgxpool.Conn:
+		tx, err := db.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+		result, err := lw.write(&pgxLobTx{ctx: ctx, tx: tx}, search, reader, limit)
+		if err != nil || result.records != 1 {
+			return result, err
+		}
+		return result, tx.Commit(ctx)
+	case *sql.DB:
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback()
+		result, err := lw.write(&sqlLobTx{ctx: ctx, tx: tx}, search, reader, limit)
+		if err != nil || result.records != 1 {
+			return result, err
+		}
+		return result, tx.Commit()
+	default:
+		return nil, errorrepo.NewError("REST00054", lw.driver.String())
+	}
+}
+
+// pgxLobTx large object statements inside a pgx transaction
+type pgxLobTx struct {
+	ctx context.Context
+	tx  pgx.Tx
+}
+
+// query read all rows of the statement
+func (t *pgxLobTx) query(statement string, args ...any) ([][]any, error) {
+	rows, err := t.tx.Query(t.ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := make([][]any, 0)
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, values)
+	}
+	return result, rows.Err()
+}
+
+// exec execute the statement and return the number of changed records
+func (t *pgxLobTx) exec(statement string, args ...any) (int64, error) {
+	tag, err := t.tx.Exec(t.ctx, statement, args...)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// sqlLobTx large object statements inside a database/sql transaction
+type sqlLobTx struct {
+	ctx context.Context
+	tx  *sql.Tx
+}
+
+// query read all rows of the statement
+func (t *sqlLobTx) query(statement string, args ...any) ([][]any, error) {
+	rows, err := t.tx.QueryContext(t.ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	result := make([][]any, 0)
+	for rows.Next() {
+		values := make([]any, len(columns))
+		scan := make([]any, len(columns))
+		for i := range values {
+			scan[i] = &values[i]
+		}
+		if err = rows.Scan(scan...); err != nil {
+			return nil, err
+		}
+		result = append(result, values)
+	}
+	return result, rows.Err()
+}
+
+// exec execute the statement and return the number of changed records
+func (t *sqlLobTx) exec(statement string, args ...any) (int64, error) {
+	res, err := t.tx.ExecContext(t.ctx, statement, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
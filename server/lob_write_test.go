@@ -0,0 +1,101 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/flynn/common"
+)
+
+// testLobTx records the statements of the large object write and appends
+// the blocks to data
+type testLobTx struct {
+	rows       [][]any
+	statements []string
+	data       bytes.Buffer
+}
+
+func (t *testLobTx) query(statement string, args ...any) ([][]any, error) {
+	t.statements = append(t.statements, statement)
+	return t.rows, nil
+}
+
+func (t *testLobTx) exec(statement string, args ...any) (int64, error) {
+	t.statements = append(t.statements, statement)
+	if strings.Contains(statement, "||") || strings.Contains(statement, "CONCAT") {
+		t.data.Write(args[0].([]byte))
+	}
+	return 1, nil
+}
+
+func TestLobWriteStatements(t *testing.T) {
+	lw := &lobWrite{table: "Pictures", field: "Media", mimetypeField: "Mimetype", mimetype: "image/png",
+		driver: common.PostgresType, keys: []string{"ID", "Nr"}}
+	assert.Equal(t, "SELECT ID,Nr FROM Pictures WHERE (Name='a''b') LIMIT 2 FOR UPDATE", lw.selectStatement("Name='a''b'"))
+	assert.Equal(t, "SELECT ID,Nr FROM Pictures LIMIT 2 FOR UPDATE", lw.selectStatement(" "))
+	statement, n := lw.clearStatement()
+	assert.Equal(t, "UPDATE Pictures SET Media=$1,Mimetype=$2 WHERE ID=$3 AND Nr=$4", statement)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "UPDATE Pictures SET Media=Media||$1 WHERE ID=$2 AND Nr=$3", lw.appendStatement())
+
+	lw = &lobWrite{table: "Pictures", field: "Media", driver: common.MysqlType, keys: []string{"ID"}}
+	statement, n = lw.clearStatement()
+	assert.Equal(t, "UPDATE Pictures SET Media=? WHERE ID=?", statement)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "UPDATE Pictures SET Media=CONCAT(Media,?) WHERE ID=?", lw.appendStatement())
+
+	assert.True(t, lobChunked(common.PostgresType))
+	assert.True(t, lobChunked(common.MysqlType))
+	assert.False(t, lobChunked(common.OracleType))
+}
+
+func TestLobWrite(t *testing.T) {
+	lw := &lobWrite{table: "Pictures", field: "Media", driver: common.PostgresType, keys: []string{"ID"}}
+	data := bytes.Repeat([]byte("0123456789"), lobChunkSize/4)
+	tx := &testLobTx{rows: [][]any{{int64(1)}}}
+	result, err := lw.write(tx, "ID=1", bytes.NewReader(data), int64(len(data)))
+	if assert.NoError(t, err) {
+		sum := sha256.Sum256(data)
+		assert.Equal(t, 1, result.records)
+		assert.Equal(t, int64(len(data)), result.size)
+		assert.Equal(t, hex.EncodeToString(sum[:]), result.checksum)
+		assert.Equal(t, data, tx.data.Bytes())
+		// select, clear and three blocks
+		assert.Len(t, tx.statements, 5)
+	}
+
+	tx = &testLobTx{rows: [][]any{{int64(1)}}}
+	result, err = lw.write(tx, "ID=1", strings.NewReader(""), 10)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(0), result.size)
+		assert.Len(t, tx.statements, 2)
+	}
+
+	tx = &testLobTx{rows: [][]any{{int64(1)}}}
+	_, err = lw.write(tx, "ID=1", bytes.NewReader(data), int64(len(data)-1))
+	assert.Equal(t, "REST00053", errorID(err))
+
+	for _, rows := range [][][]any{{}, {{int64(1)}, {int64(2)}}} {
+		tx = &testLobTx{rows: rows}
+		result, err = lw.write(tx, "ID>0", strings.NewReader("abc"), 10)
+		if assert.NoError(t, err) {
+			assert.Equal(t, len(rows), result.records)
+			assert.Len(t, tx.statements, 1)
+		}
+	}
+}
@@ -78,6 +78,7 @@ func loadTableOfDatabases() {
 			services.ServerMessage("Collected %04d table(s) in dictionary", len(newDatabases))
 		}
 	}
+	clearTableMetadata()
 	clu.DumpStat()
 
 }
@@ -111,6 +112,15 @@ func ConnectTable(ctx *clu.Context, table string) (common.RegDbID, error) {
 	return id, nil
 }
 
+// TableDriver database driver type of the table
+func TableDriver(table string) common.ReferenceType {
+	databaseTableEntry, err := clu.SearchTable(table)
+	if err != nil || databaseTableEntry.Reference == nil {
+		return common.NoType
+	}
+	return databaseTableEntry.Reference.Driver
+}
+
 // CloseTable close table id
 func CloseTable(id common.RegDbID) {
 	log.Log.Debugf("Close table and free database handle %s", id)
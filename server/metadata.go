@@ -0,0 +1,280 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
+)
+
+// tableMetadataCache cache of table metadata, cleared by each table refresh.
+// Tables accessed with the credentials of the user are cached per user.
+var tableMetadataCache = sync.Map{}
+
+// lobTypes SQL type parts marking large object columns
+var lobTypes = []string{"blob", "clob", "bytea", "binary", "raw", "mediumtext", "longtext"}
+
+// mimetypeFields column names containing the mimetype of large objects
+var mimetypeFields = []string{"mimetype", "mime_type", "contenttype", "content_type"}
+
+// columnStatements SQL statements reading name, type, nullable, default,
+// length and generated flag of all columns of a table
+var columnStatements = map[common.ReferenceType]string{
+	common.PostgresType: `SELECT column_name, data_type, is_nullable, column_default, character_maximum_length,
+ CASE WHEN is_identity = 'YES' OR is_generated = 'ALWAYS' THEN 'YES' ELSE 'NO' END
+ FROM information_schema.columns WHERE table_schema = current_schema() AND lower(table_name) = '%s'
+ ORDER BY ordinal_position`,
+	common.MysqlType: `SELECT COLUMN_NAME, COLUMN_TYPE, IS_NULLABLE, COLUMN_DEFAULT, CHARACTER_MAXIMUM_LENGTH,
+ IF(LOCATE('auto_increment', EXTRA) > 0 OR LOCATE('GENERATED', EXTRA) > 0, 'YES', 'NO')
+ FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND lower(TABLE_NAME) = '%s'
+ ORDER BY ORDINAL_POSITION`,
+	common.OracleType: `SELECT column_name, data_type, nullable, data_default, char_length, identity_column
+ FROM user_tab_columns WHERE lower(table_name) = '%s' ORDER BY column_id`,
+}
+
+// keyStatements SQL statements reading column, constraint type and the
+// referenced table and column of primary and foreign keys. Columns of
+// composite foreign keys are paired with the referenced column of the same
+// position.
+var keyStatements = map[common.ReferenceType]string{
+	common.PostgresType: `SELECT kcu.column_name, tc.constraint_type, ucu.table_name, ucu.column_name
+ FROM information_schema.table_constraints tc
+ JOIN information_schema.key_column_usage kcu ON kcu.constraint_name = tc.constraint_name AND kcu.table_schema = tc.table_schema
+  AND kcu.table_name = tc.table_name
+ LEFT JOIN information_schema.referential_constraints rc ON tc.constraint_type = 'FOREIGN KEY'
+  AND rc.constraint_name = tc.constraint_name AND rc.constraint_schema = tc.table_schema
+ LEFT JOIN information_schema.key_column_usage ucu ON ucu.constraint_name = rc.unique_constraint_name
+  AND ucu.constraint_schema = rc.unique_constraint_schema AND ucu.ordinal_position = kcu.position_in_unique_constraint
+ WHERE tc.table_schema = current_schema() AND lower(tc.table_name) = '%s'
+  AND tc.constraint_type IN ('PRIMARY KEY','FOREIGN KEY')`,
+	common.MysqlType: `SELECT COLUMN_NAME, IF(CONSTRAINT_NAME = 'PRIMARY','PRIMARY KEY','FOREIGN KEY'),
+ REFERENCED_TABLE_NAME, REFERENCED_COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE
+ WHERE TABLE_SCHEMA = DATABASE() AND lower(TABLE_NAME) = '%s'
+  AND (CONSTRAINT_NAME = 'PRIMARY' OR REFERENCED_TABLE_NAME IS NOT NULL)`,
+	common.OracleType: `SELECT cc.column_name, DECODE(c.constraint_type,'P','PRIMARY KEY','FOREIGN KEY'), rc.table_name, rc.column_name
+ FROM user_constraints c JOIN user_cons_columns cc ON cc.constraint_name = c.constraint_name
+ LEFT JOIN user_cons_columns rc ON rc.constraint_name = c.r_constraint_name AND rc.position = cc.position
+ WHERE lower(c.table_name) = '%s' AND c.constraint_type IN ('P','R')`,
+}
+
+// clearTableMetadata remove all cached table metadata
+func clearTableMetadata() {
+	log.Log.Debugf("Clear table metadata cache")
+	tableMetadataCache.Clear()
+}
+
+// accessibleTables all registered tables the user has read access to
+func accessibleTables(session *clu.Context) []string {
+	tables := make([]string, 0)
+	for _, t := range clu.GetAllViews() {
+		if auth.ValidUser(auth.UserRole, false, session.User(), t) {
+			tables = append(tables, t)
+		}
+	}
+	slices.Sort(tables)
+	return tables
+}
+
+// tablesMetadata metadata of all tables the user has read access to. Tables
+// which metadata cannot be read are skipped.
+func tablesMetadata(session *clu.Context) *api.TablesMetadata {
+	tm := &api.TablesMetadata{Tables: make([]api.TableMetadata, 0)}
+	for _, t := range accessibleTables(session) {
+		m, err := tableMetadata(session, t)
+		if err != nil {
+			log.Log.Errorf("Error reading metadata of table %s: %v", t, err)
+			continue
+		}
+		tm.Tables = append(tm.Tables, *m)
+	}
+	return tm
+}
+
+// tableMetadata get the metadata of the table out of the cache or read it
+// out of the database
+func tableMetadata(session *clu.Context, table string) (*api.TableMetadata, error) {
+	name := strings.ToLower(table)
+	key := metadataKey(session, name)
+	if m, ok := tableMetadataCache.Load(key); ok {
+		return m.(*api.TableMetadata), nil
+	}
+	if !fieldNameRegexp.MatchString(table) {
+		return nil, errorrepo.NewError("RERR00026", table)
+	}
+	d, err := ConnectTable(session, table)
+	if err != nil {
+		return nil, err
+	}
+	defer CloseTable(d)
+	driver := TableDriver(table)
+	m := &api.TableMetadata{Name: api.NewOptString(name),
+		Driver: api.NewOptString(driver.String())}
+	if _, ok := columnStatements[driver]; ok {
+		err = readColumns(d, driver, m)
+	} else {
+		var fields []string
+		fields, err = d.GetTableColumn(table)
+		for _, f := range fields {
+			m.Columns = append(m.Columns, api.TableColumn{Name: api.NewOptString(f)})
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	adaptLobColumns(m.Columns)
+	tableMetadataCache.Store(key, m)
+	log.Log.Debugf("Loaded metadata of table %s with %d columns", name, len(m.Columns))
+	return m, nil
+}
+
+// primaryKeyFields primary key fields of the table in column order
+func primaryKeyFields(session *clu.Context, table string) ([]string, error) {
+	m, err := tableMetadata(session, table)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0)
+	for _, c := range m.Columns {
+		if c.PrimaryKey.Value {
+			keys = append(keys, c.Name.Value)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errorrepo.NewError("REST00040", table)
+	}
+	return keys, nil
+}
+
+// metadataKey cache key of the table metadata. The column visibility can
+// differ between database users, so tables without global authentication are
+// cached per user.
+func metadataKey(session *clu.Context, table string) string {
+	entry, err := clu.SearchTable(table)
+	if err != nil || entry.Database.AuthenticationGlobal {
+		return table
+	}
+	return session.UserName() + "\x00" + table
+}
+
+// readColumns read column definitions and keys out of the database catalog
+func readColumns(d common.RegDbID, driver common.ReferenceType, m *api.TableMetadata) error {
+	table := m.Name.Value
+	index := make(map[string]int)
+	err := d.BatchSelectFct(&common.Query{Search: fmt.Sprintf(columnStatements[driver], table)},
+		func(search *common.Query, result *common.Result) error {
+			if result == nil {
+				return errorrepo.NewError("REST00011")
+			}
+			c := api.TableColumn{Name: api.NewOptString(metadataString(result.Rows[0])),
+				Type: api.NewOptString(metadataString(result.Rows[1]))}
+			nullable := strings.ToUpper(metadataString(result.Rows[2]))
+			c.Nullable = api.NewOptBool(nullable == "YES" || nullable == "Y")
+			if def := columnDefault(result.Rows[3]); def != "" {
+				c.Default = api.NewOptString(def)
+			}
+			if l, err := strconv.Atoi(metadataString(result.Rows[4])); err == nil {
+				c.Length = api.NewOptInt(l)
+			}
+			c.Generated = api.NewOptBool(strings.ToUpper(metadataString(result.Rows[5])) == "YES")
+			c.PrimaryKey = api.NewOptBool(false)
+			index[strings.ToLower(c.Name.Value)] = len(m.Columns)
+			m.Columns = append(m.Columns, c)
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	return d.BatchSelectFct(&common.Query{Search: fmt.Sprintf(keyStatements[driver], table)},
+		func(search *common.Query, result *common.Result) error {
+			if result == nil {
+				return errorrepo.NewError("REST00011")
+			}
+			i, ok := index[strings.ToLower(metadataString(result.Rows[0]))]
+			if !ok {
+				return nil
+			}
+			if metadataString(result.Rows[1]) == "PRIMARY KEY" {
+				m.Columns[i].PrimaryKey = api.NewOptBool(true)
+				return nil
+			}
+			m.Columns[i].ForeignKey = api.NewOptTableReference(api.TableReference{
+				Table:  api.NewOptString(strings.ToLower(metadataString(result.Rows[2]))),
+				Column: api.NewOptString(metadataString(result.Rows[3]))})
+			return nil
+		})
+}
+
+// columnDefault default expression of the column, empty if the column has
+// no default. Oracle keeps the expression text including trailing white
+// space, Oracle and MariaDB report a missing default as NULL.
+func columnDefault(v any) string {
+	def := strings.TrimSpace(metadataString(v))
+	if strings.EqualFold(def, "NULL") {
+		return ""
+	}
+	return def
+}
+
+// adaptLobColumns mark large object columns and reference the column
+// containing the mimetype if the table has one
+func adaptLobColumns(columns []api.TableColumn) {
+	mimetypeField := ""
+	for _, c := range columns {
+		if slices.Contains(mimetypeFields, strings.ToLower(c.Name.Value)) {
+			mimetypeField = c.Name.Value
+			break
+		}
+	}
+	for i := range columns {
+		if !columns[i].Type.Set {
+			continue
+		}
+		t := strings.ToLower(columns[i].Type.Value)
+		lob := slices.ContainsFunc(lobTypes, func(l string) bool { return strings.Contains(t, l) })
+		columns[i].Lob = api.NewOptBool(lob)
+		if lob && mimetypeField != "" {
+			columns[i].MimetypeField = api.NewOptString(mimetypeField)
+		}
+	}
+}
+
+// metadataString convert catalog value to string
+func metadataString(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	default:
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return ""
+		}
+		return metadataString(rv.Elem().Interface())
+	}
+	return fmt.Sprintf("%v", v)
+}
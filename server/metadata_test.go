@@ -0,0 +1,37 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnDefault(t *testing.T) {
+	tests := []struct {
+		value any
+		want  string
+	}{
+		{nil, ""},
+		{"", ""},
+		{"NULL", ""},
+		{"null ", ""},
+		{"'draft' \n", "'draft'"},
+		{"sysdate", "sysdate"},
+		{[]byte("0"), "0"},
+		{"nextval('albums_id_seq'::regclass)", "nextval('albums_id_seq'::regclass)"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, columnDefault(tt.value), "%#v", tt.value)
+	}
+}
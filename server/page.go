@@ -0,0 +1,449 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-faster/jx"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+)
+
+var fieldNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+var serverSecretKey []byte
+var serverSecretOnce sync.Once
+
+// pageCursor opaque keyset cursor transferred to the client. It contains
+// the order fields and the values of the boundary record of the page.
+type pageCursor struct {
+	Order  []string          `json:"o"`
+	Values []json.RawMessage `json:"v"`
+	Prev   bool              `json:"p,omitempty"`
+}
+
+// serverSecret random key of the confirm tokens and page cursors of this
+// server instance
+func serverSecret() []byte {
+	serverSecretOnce.Do(func() {
+		serverSecretKey = make([]byte, 32)
+		if _, err := rand.Read(serverSecretKey); err != nil {
+			log.Log.Fatal("Error generating server secret:", err)
+		}
+	})
+	return serverSecretKey
+}
+
+// pagination page request parameters of a record query
+type pagination struct {
+	limit   int
+	offset  int
+	order   []string
+	cursor  *pageCursor
+	request *http.Request
+	more    bool
+	next    string
+	prev    string
+}
+
+// newPagination parse limit, offset and cursor parameters. A negative limit
+// means all records.
+func newPagination(req *http.Request, limit string, offset api.OptInt,
+	cursor api.OptString, order []string) (*pagination, error) {
+	p := &pagination{limit: -1, request: req, order: order}
+	switch strings.ToUpper(limit) {
+	case "", "ALL", "-1":
+	default:
+		l, err := strconv.Atoi(limit)
+		if err != nil || l < 0 {
+			return nil, errorrepo.NewError("REST00012", limit)
+		}
+		p.limit = l
+	}
+	if offset.Set {
+		if offset.Value < 0 {
+			return nil, errorrepo.NewError("REST00013", offset.Value)
+		}
+		p.offset = offset.Value
+	}
+	if cursor.Set && cursor.Value != "" {
+		c, err := decodeCursor(cursor.Value)
+		if err != nil {
+			return nil, err
+		}
+		if strings.Join(p.order, ",") != strings.Join(c.Order, ",") {
+			return nil, errorrepo.NewError("REST00014", "orderby differs from cursor")
+		}
+		p.cursor = c
+	}
+	return p, nil
+}
+
+// cursorSignature signature of the cursor content
+func cursorSignature(content string) string {
+	mac := hmac.New(sha256.New, serverSecret())
+	mac.Write([]byte(content))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// decodeCursor check the signature, decode base64 cursor and check the
+// field names contained
+func decodeCursor(cursor string) (*pageCursor, error) {
+	content, signature, ok := strings.Cut(cursor, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(cursorSignature(content))) {
+		return nil, errorrepo.NewError("REST00014", "signature mismatch")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(content)
+	if err != nil {
+		return nil, errorrepo.NewError("REST00014", err)
+	}
+	c := &pageCursor{}
+	err = json.Unmarshal(b, c)
+	if err != nil {
+		return nil, errorrepo.NewError("REST00014", err)
+	}
+	if len(c.Order) == 0 || len(c.Order) != len(c.Values) {
+		return nil, errorrepo.NewError("REST00015")
+	}
+	for _, o := range c.Order {
+		f, _ := splitOrder(o)
+		if !fieldNameRegexp.MatchString(f) {
+			return nil, errorrepo.NewError("REST00014", "invalid field "+f)
+		}
+	}
+	return c, nil
+}
+
+// encode generate the opaque cursor string signed with the server secret,
+// the client cannot change the cursor values
+func (c *pageCursor) encode() string {
+	b, err := json.Marshal(c)
+	if err != nil {
+		log.Log.Errorf("Error encoding cursor: %v", err)
+		return ""
+	}
+	content := base64.RawURLEncoding.EncodeToString(b)
+	return content + "." + cursorSignature(content)
+}
+
+// splitOrder split order entry in field name and descending flag
+func splitOrder(order string) (string, bool) {
+	f, d, _ := strings.Cut(order, ":")
+	return strings.TrimSpace(f), strings.EqualFold(strings.TrimSpace(d), "DESC")
+}
+
+// cursorValue convert JSON value of cursor into filter value, nil for NULL
+func cursorValue(v json.RawMessage) (any, error) {
+	d := jx.DecodeBytes(v)
+	switch d.Next() {
+	case jx.String:
+		return d.Str()
+	case jx.Number:
+		n, err := d.Num()
+		if err != nil {
+			return nil, err
+		}
+		return json.Number(n.String()), nil
+	case jx.Bool:
+		return d.Bool()
+	case jx.Null:
+		return nil, d.Null()
+	default:
+		return nil, errorrepo.NewError("REST00014", "unsupported value "+string(v))
+	}
+}
+
+// nullsLast database sorts NULL values after all other values in ascending
+// order
+func nullsLast(driver common.ReferenceType) bool {
+	return driver == common.PostgresType || driver == common.OracleType
+}
+
+// predicate generate keyset search condition of the cursor, records after
+// the cursor record (or before if previous page is requested). NULL values
+// are placed like the database driver sorts them.
+func (c *pageCursor) predicate(driver common.ReferenceType) (string, error) {
+	fc := &filterCompiler{driver: driver}
+	var or []string
+	var equal []string
+	for i, o := range c.Order {
+		f, desc := splitOrder(o)
+		v, err := cursorValue(c.Values[i])
+		if err != nil {
+			return "", errorrepo.NewError("REST00014", err)
+		}
+		op := ">"
+		if desc != c.Prev {
+			op = "<"
+		}
+		nullsAfter := nullsLast(driver) == (op == ">")
+		after := ""
+		switch {
+		case v == nil && !nullsAfter:
+			after = f + " IS NOT NULL"
+		case v == nil:
+		case nullsAfter:
+			after = "(" + f + op + fc.literal(v) + " OR " + f + " IS NULL)"
+		default:
+			after = f + op + fc.literal(v)
+		}
+		if after != "" {
+			and := append(append([]string{}, equal...), after)
+			or = append(or, "("+strings.Join(and, " AND ")+")")
+		}
+		if v == nil {
+			equal = append(equal, f+" IS NULL")
+		} else {
+			equal = append(equal, f+"="+fc.literal(v))
+		}
+	}
+	if len(or) == 0 {
+		// cursor record is the last record
+		return "1=0", nil
+	}
+	return strings.Join(or, " OR "), nil
+}
+
+// uniqueOrder order of keyset pages with the primary key fields appended.
+// The keyset predicate skips records with the same order values as the
+// last record of the page if the order is not unique. Tables without
+// primary key keep the requested order.
+func uniqueOrder(session *clu.Context, table string, order []string) []string {
+	if len(order) == 0 {
+		return order
+	}
+	m, err := tableMetadata(session, table)
+	if err != nil {
+		log.Log.Debugf("Order of %s not unique: %v", table, err)
+		return order
+	}
+	keys := make([]string, 0)
+	for _, c := range m.Columns {
+		if c.PrimaryKey.Value {
+			keys = append(keys, c.Name.Value)
+		}
+	}
+	return appendKeyOrder(order, keys)
+}
+
+// appendKeyOrder append the key fields not part of the order
+func appendKeyOrder(order []string, keys []string) []string {
+	result := append([]string{}, order...)
+	for _, k := range keys {
+		found := false
+		for _, o := range order {
+			f, _ := splitOrder(o)
+			found = found || strings.EqualFold(f, k)
+		}
+		if !found {
+			result = append(result, k)
+		}
+	}
+	return result
+}
+
+// adapt adapt the query to the requested page
+func (p *pagination) adapt(q *common.Query) error {
+	if p.cursor != nil {
+		pred, err := p.cursor.predicate(TableDriver(q.TableName))
+		if err != nil {
+			return err
+		}
+		if q.Search != "" {
+			q.Search = "(" + q.Search + ") AND (" + pred + ")"
+		} else {
+			q.Search = pred
+		}
+		if p.cursor.Prev {
+			q.Order = make([]string, 0, len(p.order))
+			for _, o := range p.order {
+				f, desc := splitOrder(o)
+				if desc {
+					q.Order = append(q.Order, f+":ASC")
+				} else {
+					q.Order = append(q.Order, f+":DESC")
+				}
+			}
+		} else {
+			q.Order = p.order
+		}
+	}
+	if len(p.order) > 0 && !(len(q.Fields) == 1 && q.Fields[0] == "*") {
+		for _, o := range p.order {
+			f, _ := splitOrder(o)
+			if !containsField(q.Fields, f) {
+				q.Fields = append(q.Fields, f)
+			}
+		}
+	}
+	switch {
+	case p.limit < 0 && p.offset == 0:
+		q.Limit = "ALL"
+	case p.limit < 0:
+		q.Limit = "ALL OFFSET " + strconv.Itoa(p.offset)
+	default:
+		// one record more to detect if a further page exists
+		q.Limit = strconv.Itoa(p.limit + 1)
+		if p.offset > 0 {
+			q.Limit += " OFFSET " + strconv.Itoa(p.offset)
+		}
+	}
+	return nil
+}
+
+func containsField(fields []string, f string) bool {
+	for _, x := range fields {
+		if strings.EqualFold(strings.TrimSpace(x), f) {
+			return true
+		}
+	}
+	return false
+}
+
+// page cut the lookahead record, restore order of previous pages and
+// evaluate next and previous cursors
+func (p *pagination) page(data []api.ResponseRecordsItem) []api.ResponseRecordsItem {
+	if p.limit >= 0 && len(data) > p.limit {
+		p.more = true
+		data = data[:p.limit]
+	}
+	backward := p.cursor != nil && p.cursor.Prev
+	if backward {
+		for i, j := 0, len(data)-1; i < j; i, j = i+1, j-1 {
+			data[i], data[j] = data[j], data[i]
+		}
+	}
+	if len(p.order) == 0 || len(data) == 0 || p.limit < 0 {
+		return data
+	}
+	if p.more || backward {
+		p.next = p.cursorOf(data[len(data)-1], false)
+	}
+	if (backward && p.more) || (!backward && (p.cursor != nil || p.offset > 0)) {
+		p.prev = p.cursorOf(data[0], true)
+	}
+	return data
+}
+
+// cursorOf generate cursor of the given record. NULL values are not part
+// of the record item and are stored as JSON null.
+func (p *pagination) cursorOf(item api.ResponseRecordsItem, prev bool) string {
+	c := &pageCursor{Order: p.order, Prev: prev}
+	for _, o := range p.order {
+		f, _ := splitOrder(o)
+		v, ok := item[strings.ToLower(f)]
+		if !ok || len(v) == 0 {
+			v = jx.Raw("null")
+		}
+		c.Values = append(c.Values, json.RawMessage(v))
+	}
+	return c.encode()
+}
+
+// boundaries evaluate next and previous cursors for streamed output using a
+// query on the order fields only
+func (p *pagination) boundaries(d common.RegDbID, q *common.Query) error {
+	if p.limit < 0 {
+		return nil
+	}
+	bq := *q
+	bq.Fields = make([]string, 0, len(p.order))
+	for _, o := range p.order {
+		f, _ := splitOrder(o)
+		bq.Fields = append(bq.Fields, f)
+	}
+	if len(bq.Fields) == 0 {
+		bq.Fields = []string{"*"}
+	}
+	data, _, err := query(d, &bq)
+	if err != nil {
+		return err
+	}
+	p.page(data)
+	return nil
+}
+
+// streamLimit limit used for streamed output without lookahead record
+func (p *pagination) streamLimit(q *common.Query) {
+	if p.limit < 0 {
+		return
+	}
+	q.Limit = strconv.Itoa(p.limit)
+	if p.offset > 0 {
+		q.Limit += " OFFSET " + strconv.Itoa(p.offset)
+	}
+}
+
+// link generate RFC 8288 Link header value with next and previous page
+func (p *pagination) link() string {
+	if p.request == nil || p.limit < 0 {
+		return ""
+	}
+	links := make([]string, 0, 2)
+	keyset := len(p.order) > 0
+	switch {
+	case keyset && p.next != "":
+		links = append(links, p.linkEntry("next", "cursor", p.next))
+	case !keyset && p.more:
+		links = append(links, p.linkEntry("next", "offset", strconv.Itoa(p.offset+p.limit)))
+	}
+	switch {
+	case keyset && p.prev != "":
+		links = append(links, p.linkEntry("prev", "cursor", p.prev))
+	case !keyset && p.offset > 0:
+		links = append(links, p.linkEntry("prev", "offset", strconv.Itoa(max(0, p.offset-p.limit))))
+	}
+	return strings.Join(links, ", ")
+}
+
+func (p *pagination) linkEntry(rel, key, value string) string {
+	u := *p.request.URL
+	v := u.Query()
+	v.Del("cursor")
+	if key == "cursor" {
+		v.Del("offset")
+	}
+	v.Set(key, value)
+	u.RawQuery = v.Encode()
+	return "<" + u.String() + ">; rel=\"" + rel + "\""
+}
+
+// apply set next and previous cursors into response
+func (p *pagination) apply(resp *api.Response) {
+	if p.next != "" {
+		resp.Next = api.NewOptString(p.next)
+	}
+	if p.prev != "" {
+		resp.Prev = api.NewOptString(p.prev)
+	}
+}
+
+// optString returns optional string only set if not empty
+func optString(s string) api.OptString {
+	if s == "" {
+		return api.OptString{}
+	}
+	return api.NewOptString(s)
+}
@@ -0,0 +1,123 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/flynn/common"
+)
+
+func testCursor(order []string, values ...string) *pageCursor {
+	c := &pageCursor{Order: order}
+	for _, v := range values {
+		c.Values = append(c.Values, json.RawMessage(v))
+	}
+	return c
+}
+
+func TestCursorEncodeDecode(t *testing.T) {
+	c := testCursor([]string{"published:DESC", "ID"}, `"2024-01-02"`, `17`)
+	c.Prev = true
+	encoded := c.encode()
+	d, err := decodeCursor(encoded)
+	if assert.NoError(t, err) {
+		assert.Equal(t, c, d)
+	}
+
+	content, _, _ := strings.Cut(encoded, ".")
+	forged := testCursor([]string{"ID"}, `"x' OR 1=1 --"`)
+	b, _ := json.Marshal(forged)
+	_, err = decodeCursor(base64.RawURLEncoding.EncodeToString(b) + "." + cursorSignature(content))
+	assert.Error(t, err)
+	_, err = decodeCursor(base64.RawURLEncoding.EncodeToString(b))
+	assert.Error(t, err)
+	_, err = decodeCursor("")
+	assert.Error(t, err)
+
+	_, err = decodeCursor(testCursor([]string{"ID; DROP"}, `1`).encode())
+	assert.Error(t, err)
+	_, err = decodeCursor(testCursor([]string{"ID"}).encode())
+	assert.Error(t, err)
+}
+
+func TestCursorOrder(t *testing.T) {
+	encoded := testCursor([]string{"ID:ASC"}, `5`).encode()
+	p, err := newPagination(nil, "10", api.OptInt{}, api.NewOptString(encoded), []string{"ID:ASC"})
+	if assert.NoError(t, err) {
+		assert.NotNil(t, p.cursor)
+	}
+	_, err = newPagination(nil, "10", api.OptInt{}, api.NewOptString(encoded), []string{"Name:ASC"})
+	assert.Error(t, err)
+	_, err = newPagination(nil, "10", api.OptInt{}, api.NewOptString(encoded), nil)
+	assert.Error(t, err)
+}
+
+func TestCursorPredicate(t *testing.T) {
+	tests := []struct {
+		driver common.ReferenceType
+		cursor *pageCursor
+		want   string
+	}{
+		{common.PostgresType, testCursor([]string{"ID"}, `5`), "((ID>5 OR ID IS NULL))"},
+		{common.MysqlType, testCursor([]string{"ID"}, `5`), "(ID>5)"},
+		{common.MysqlType, testCursor([]string{"ID:DESC"}, `5`), "((ID<5 OR ID IS NULL))"},
+		{common.MysqlType, testCursor([]string{"Name", "ID"}, `"a'b\\"`, `2`),
+			`(Name>'a''b\\') OR (Name='a''b\\' AND ID>2)`},
+		{common.PostgresType, testCursor([]string{"Name", "ID"}, `"a'b\\"`, `2`),
+			`((Name>'a''b\' OR Name IS NULL)) OR (Name='a''b\' AND (ID>2 OR ID IS NULL))`},
+		{common.OracleType, testCursor([]string{"Active"}, `true`), "((Active>1 OR Active IS NULL))"},
+		{common.MysqlType, testCursor([]string{"Title", "ID"}, `null`, `3`),
+			"(Title IS NOT NULL) OR (Title IS NULL AND ID>3)"},
+		{common.PostgresType, testCursor([]string{"Title", "ID"}, `null`, `3`),
+			"(Title IS NULL AND (ID>3 OR ID IS NULL))"},
+		{common.PostgresType, testCursor([]string{"Title"}, `null`), "1=0"},
+	}
+	for _, tt := range tests {
+		p, err := tt.cursor.predicate(tt.driver)
+		if assert.NoError(t, err) {
+			assert.Equal(t, tt.want, p, tt.driver.String())
+		}
+	}
+
+	prev := testCursor([]string{"Title"}, `null`)
+	prev.Prev = true
+	p, err := prev.predicate(common.PostgresType)
+	assert.NoError(t, err)
+	assert.Equal(t, "(Title IS NOT NULL)", p)
+
+	_, err = testCursor([]string{"ID"}, `{"a":1}`).predicate(common.PostgresType)
+	assert.Error(t, err)
+}
+
+func TestCursorOfNull(t *testing.T) {
+	p := &pagination{limit: 1, order: []string{"published:DESC", "ID"}}
+	encoded := p.cursorOf(api.ResponseRecordsItem{"id": []byte(`17`)}, false)
+	c, err := decodeCursor(encoded)
+	if assert.NoError(t, err) {
+		assert.Equal(t, testCursor(p.order, `null`, `17`), c)
+		pred, err := c.predicate(common.PostgresType)
+		assert.NoError(t, err)
+		assert.Equal(t, "(published IS NOT NULL) OR (published IS NULL AND (ID>17 OR ID IS NULL))", pred)
+	}
+}
+
+func TestAppendKeyOrder(t *testing.T) {
+	assert.Equal(t, []string{"name", "ID"}, appendKeyOrder([]string{"name"}, []string{"ID"}))
+	assert.Equal(t, []string{"id:DESC", "name"}, appendKeyOrder([]string{"id:DESC", "name"}, []string{"ID"}))
+	assert.Equal(t, []string{"name", "Album", "Track"}, appendKeyOrder([]string{"name"}, []string{"Album", "Track"}))
+}
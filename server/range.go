@@ -0,0 +1,383 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	"github.com/tknie/clu"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+)
+
+// maxRanges maximum number of ranges of one request, requests with more
+// ranges get the complete large object
+const maxRanges = 16
+
+// rangeChunkSize size of the byte windows read out of the database
+const rangeChunkSize = 16 * blockSize
+
+// lobInfoStatements SQL statements reading size, checksum and mimetype of a
+// large object
+var lobInfoStatements = map[common.ReferenceType]string{
+	common.PostgresType: "SELECT octet_length(%[1]s), md5(%[1]s)%[2]s FROM %[3]s WHERE %[4]s LIMIT 2",
+	common.MysqlType:    "SELECT LENGTH(%[1]s), MD5(%[1]s)%[2]s FROM %[3]s WHERE %[4]s LIMIT 2",
+}
+
+// lobWindowStatements SQL statements reading a byte window of a large object
+var lobWindowStatements = map[common.ReferenceType]string{
+	common.PostgresType: "SELECT substring(%s FROM $1 FOR $2) FROM %s WHERE %s",
+	common.MysqlType:    "SELECT SUBSTRING(%s, ?, ?) FROM %s WHERE %s",
+}
+
+// byteRange byte window of a large object
+type byteRange struct {
+	start  int64
+	length int64
+}
+
+// contentRange Content-Range header value of the range
+func (r byteRange) contentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", r.start, r.start+r.length-1, size)
+}
+
+// parseRange parse the Range header for a large object of the given size.
+// Invalid headers are ignored and return no ranges, the result is false if
+// none of the ranges is satisfiable.
+func parseRange(header string, size int64) ([]byteRange, bool) {
+	spec, ok := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !ok {
+		return nil, true
+	}
+	ranges := make([]byteRange, 0)
+	parts := strings.Split(spec, ",")
+	if len(parts) > maxRanges {
+		return nil, true
+	}
+	total := int64(0)
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		first, last, ok := strings.Cut(p, "-")
+		if !ok {
+			return nil, true
+		}
+		first, last = strings.TrimSpace(first), strings.TrimSpace(last)
+		var r byteRange
+		if first == "" {
+			// suffix range of the last bytes
+			n, err := strconv.ParseInt(last, 10, 64)
+			if err != nil || n < 0 {
+				return nil, true
+			}
+			if n == 0 || size == 0 {
+				continue
+			}
+			n = min(n, size)
+			r = byteRange{start: size - n, length: n}
+		} else {
+			start, err := strconv.ParseInt(first, 10, 64)
+			if err != nil || start < 0 {
+				return nil, true
+			}
+			end := size - 1
+			if last != "" {
+				end, err = strconv.ParseInt(last, 10, 64)
+				if err != nil || end < start {
+					return nil, true
+				}
+				end = min(end, size-1)
+			}
+			if start >= size {
+				continue
+			}
+			r = byteRange{start: start, length: end - start + 1}
+		}
+		total += r.length
+		ranges = append(ranges, r)
+	}
+	if len(ranges) == 0 {
+		return nil, false
+	}
+	if total > size {
+		// overlapping ranges would send more than the complete object
+		return nil, true
+	}
+	return ranges, true
+}
+
+// lobContent large object of one record served in byte ranges. If the
+// driver supports it, the byte windows are read out of the database,
+// otherwise the complete large object is read.
+type lobContent struct {
+	session       *clu.Context
+	table         string
+	field         string
+	mimetypeField string
+	search        string
+	mimetype      string
+	size          int64
+	etag          string
+	window        bool
+	data          []byte
+}
+
+// lobResponse response to the requested ranges of a large object
+type lobResponse struct {
+	status       int
+	body         io.ReadCloser
+	contentType  string
+	length       int64
+	contentRange string
+}
+
+// newLobContent large object of the record matching the search. A
+// conversion to the destination mimetype needs the complete large object.
+func newLobContent(session *clu.Context, table, field, mimetypeField, search, destMimeType string) (*lobContent, error) {
+	lc := &lobContent{session: session, table: table, field: field, mimetypeField: mimetypeField}
+	if _, ok := lobInfoStatements[TableDriver(table)]; ok {
+		err := lc.readInfo(search)
+		if err != nil {
+			return nil, err
+		}
+		if destMimeType == "" || strings.EqualFold(destMimeType, lc.mimetype) {
+			return lc, nil
+		}
+	}
+	read := NewStreamRead(table, field, mimetypeField)
+	err := read.initStreamFromTable(session, search, destMimeType)
+	if err != nil {
+		return nil, err
+	}
+	sum := md5.Sum(read.data)
+	lc.window = false
+	lc.data = read.data
+	lc.mimetype = read.mimetype
+	lc.size = int64(len(read.data))
+	lc.etag = `"` + hex.EncodeToString(sum[:]) + `"`
+	return lc, nil
+}
+
+// readInfo read size, checksum and mimetype of the large object
+func (lc *lobContent) readInfo(search string) error {
+	for _, n := range []string{lc.table, lc.field, lc.mimetypeField} {
+		if n != "" && !fieldNameRegexp.MatchString(n) {
+			return errorrepo.NewError("RERR00026", n)
+		}
+	}
+	d, err := ConnectTable(lc.session, lc.table)
+	if err != nil {
+		return err
+	}
+	defer CloseTable(d)
+	lc.search, err = compileSearch(d, lc.table, search)
+	if err != nil {
+		return NewBadRequestError(err)
+	}
+	if strings.TrimSpace(lc.search) == "" {
+		lc.search = "1=1"
+	}
+	mimetypeColumn := ""
+	if lc.mimetypeField != "" {
+		mimetypeColumn = ", " + lc.mimetypeField
+	}
+	statement := fmt.Sprintf(lobInfoStatements[TableDriver(lc.table)], lc.field, mimetypeColumn,
+		lc.table, lc.search)
+	log.Log.Debugf("Lob info statement: %s", statement)
+	count := 0
+	err = d.BatchSelectFct(&common.Query{Search: statement}, func(search *common.Query, result *common.Result) error {
+		if result == nil || len(result.Rows) < 2 {
+			return errorrepo.NewError("REST00006")
+		}
+		count++
+		if result.Rows[0] == nil {
+			return errorrepo.NewError("REST00009")
+		}
+		size, err := strconv.ParseInt(metadataString(result.Rows[0]), 10, 64)
+		if err != nil {
+			return err
+		}
+		lc.size = size
+		lc.etag = `"` + metadataString(result.Rows[1]) + `"`
+		if len(result.Rows) > 2 {
+			lc.mimetype = metadataString(result.Rows[2])
+		}
+		return nil
+	})
+	switch {
+	case err != nil:
+		return err
+	case count == 0:
+		return errorrepo.NewError("REST00002", lc.field, lc.table)
+	case count > 1:
+		return errorrepo.NewError("REST00007")
+	default:
+	}
+	lc.window = true
+	log.Log.Debugf("Lob %s.%s has %d bytes etag %s", lc.table, lc.field, lc.size, lc.etag)
+	return nil
+}
+
+// respond response to the Range and If-Range header. If the If-Range does
+// not match the ETag of the large object, the complete object is sent.
+func (lc *lobContent) respond(rangeHeader, ifRange, mimetype string) *lobResponse {
+	var ranges []byteRange
+	if rangeHeader != "" && (ifRange == "" || ifRange == lc.etag) {
+		var ok bool
+		ranges, ok = parseRange(rangeHeader, lc.size)
+		if !ok {
+			return &lobResponse{status: http.StatusRequestedRangeNotSatisfiable,
+				contentRange: fmt.Sprintf("bytes */%d", lc.size)}
+		}
+	}
+	switch len(ranges) {
+	case 0:
+		r := byteRange{start: 0, length: lc.size}
+		return &lobResponse{status: http.StatusOK, contentType: mimetype, length: lc.size,
+			body: lc.pipe(func(w io.Writer, d common.RegDbID) error {
+				return lc.copyRange(w, d, r)
+			})}
+	case 1:
+		r := ranges[0]
+		return &lobResponse{status: http.StatusPartialContent, contentType: mimetype, length: r.length,
+			contentRange: r.contentRange(lc.size),
+			body: lc.pipe(func(w io.Writer, d common.RegDbID) error {
+				return lc.copyRange(w, d, r)
+			})}
+	default:
+	}
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+	header := func(r byteRange) textproto.MIMEHeader {
+		return textproto.MIMEHeader{"Content-Range": {r.contentRange(lc.size)},
+			"Content-Type": {mimetype}}
+	}
+	// the size of the multipart body is the size of the parts and the
+	// multipart framing
+	var framing countingWriter
+	mw := multipart.NewWriter(&framing)
+	_ = mw.SetBoundary(boundary)
+	length := int64(0)
+	for _, r := range ranges {
+		_, _ = mw.CreatePart(header(r))
+		length += r.length
+	}
+	_ = mw.Close()
+	return &lobResponse{status: http.StatusPartialContent,
+		contentType: "multipart/byteranges; boundary=" + boundary, length: length + int64(framing),
+		body: lc.pipe(func(w io.Writer, d common.RegDbID) error {
+			mw := multipart.NewWriter(w)
+			if err := mw.SetBoundary(boundary); err != nil {
+				return err
+			}
+			for _, r := range ranges {
+				part, err := mw.CreatePart(header(r))
+				if err != nil {
+					return err
+				}
+				if err = lc.copyRange(part, d, r); err != nil {
+					return err
+				}
+			}
+			return mw.Close()
+		})}
+}
+
+// pipe stream the output of the write function. Reading byte windows out
+// of the database uses an own connection, closed after the last window.
+func (lc *lobContent) pipe(write func(w io.Writer, d common.RegDbID) error) io.ReadCloser {
+	reader, writer := io.Pipe()
+	go func() {
+		var d common.RegDbID
+		if lc.window {
+			var err error
+			d, err = ConnectTable(lc.session, lc.table)
+			if err != nil {
+				writer.CloseWithError(err)
+				return
+			}
+			defer CloseTable(d)
+		}
+		err := write(writer, d)
+		if err != nil {
+			log.Log.Debugf("Lob stream of %s.%s ended: %v", lc.table, lc.field, err)
+		}
+		writer.CloseWithError(err)
+	}()
+	return reader
+}
+
+// copyRange copy the byte range of the large object to the writer
+func (lc *lobContent) copyRange(w io.Writer, d common.RegDbID, r byteRange) error {
+	if !lc.window {
+		_, err := w.Write(lc.data[r.start : r.start+r.length])
+		return err
+	}
+	end := r.start + r.length
+	for offset := r.start; offset < end; offset += rangeChunkSize {
+		n := min(int64(rangeChunkSize), end-offset)
+		data, err := lc.readWindow(d, offset, n)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readWindow read byte window of the large object out of the database
+func (lc *lobContent) readWindow(d common.RegDbID, offset, length int64) ([]byte, error) {
+	statement := fmt.Sprintf(lobWindowStatements[TableDriver(lc.table)], lc.field, lc.table, lc.search)
+	var data []byte
+	err := d.BatchSelectFct(&common.Query{Search: statement, Parameters: []any{offset + 1, length}},
+		func(search *common.Query, result *common.Result) error {
+			if result == nil || len(result.Rows) == 0 {
+				return errorrepo.NewError("REST00006")
+			}
+			switch v := result.Rows[0].(type) {
+			case []byte:
+				data = v
+			case *[]byte:
+				data = *v
+			default:
+				data = []byte(metadataString(v))
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != length {
+		return nil, errorrepo.NewError("REST00055", lc.field, lc.table)
+	}
+	return data, nil
+}
+
+// countingWriter count the bytes written
+type countingWriter int64
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	*w += countingWriter(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,103 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		header string
+		ranges []byteRange
+		ok     bool
+	}{
+		{"", nil, true},
+		{"bytes=0-9", []byteRange{{0, 10}}, true},
+		{"bytes=90-", []byteRange{{90, 10}}, true},
+		{"bytes=-5", []byteRange{{95, 5}}, true},
+		{"bytes=-500", []byteRange{{0, 100}}, true},
+		{"bytes=95-200", []byteRange{{95, 5}}, true},
+		{"bytes=0-0, 10-19", []byteRange{{0, 1}, {10, 10}}, true},
+		{"bytes=100-", nil, false},
+		{"bytes=-0", nil, false},
+		{"bytes=200-300, 100-", nil, false},
+		{"bytes=200-300, 0-1", []byteRange{{0, 2}}, true},
+		{"bytes=9-5", nil, true},
+		{"bytes=a-b", nil, true},
+		{"items=0-9", nil, true},
+		{"bytes=0-99, 0-99", nil, true},
+		{"bytes=" + strings.Repeat("0-1,", maxRanges+1), nil, true},
+	}
+	for _, test := range tests {
+		ranges, ok := parseRange(test.header, 100)
+		assert.Equal(t, test.ok, ok, test.header)
+		assert.Equal(t, test.ranges, ranges, test.header)
+	}
+	_, ok := parseRange("bytes=0-", 0)
+	assert.False(t, ok)
+}
+
+func TestLobContentRespond(t *testing.T) {
+	data := []byte("0123456789abcdefghij")
+	lc := &lobContent{table: "videos", field: "media", data: data, size: int64(len(data)), etag: `"x"`}
+
+	resp := lc.respond("", "", "video/mp4")
+	assert.Equal(t, http.StatusOK, resp.status)
+	body, err := io.ReadAll(resp.body)
+	assert.NoError(t, err)
+	assert.Equal(t, data, body)
+	assert.Equal(t, int64(len(data)), resp.length)
+
+	resp = lc.respond("bytes=5-9", `"x"`, "video/mp4")
+	assert.Equal(t, http.StatusPartialContent, resp.status)
+	assert.Equal(t, "bytes 5-9/20", resp.contentRange)
+	body, _ = io.ReadAll(resp.body)
+	assert.Equal(t, "56789", string(body))
+
+	resp = lc.respond("bytes=5-9", `"y"`, "video/mp4")
+	assert.Equal(t, http.StatusOK, resp.status)
+	resp.body.Close()
+
+	resp = lc.respond("bytes=30-", "", "video/mp4")
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, resp.status)
+	assert.Equal(t, "bytes */20", resp.contentRange)
+
+	resp = lc.respond("bytes=0-1,-3", "", "video/mp4")
+	assert.Equal(t, http.StatusPartialContent, resp.status)
+	body, _ = io.ReadAll(resp.body)
+	assert.Equal(t, resp.length, int64(len(body)))
+	mt, params, err := mime.ParseMediaType(resp.contentType)
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/byteranges", mt)
+	mr := multipart.NewReader(strings.NewReader(string(body)), params["boundary"])
+	for _, expected := range []struct{ contentRange, content string }{
+		{"bytes 0-1/20", "01"}, {"bytes 17-19/20", "hij"}} {
+		part, err := mr.NextPart()
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, expected.contentRange, part.Header.Get("Content-Range"))
+		assert.Equal(t, "video/mp4", part.Header.Get("Content-Type"))
+		content, _ := io.ReadAll(part)
+		assert.Equal(t, expected.content, string(content))
+	}
+	_, err = mr.NextPart()
+	assert.Equal(t, io.EOF, err)
+}
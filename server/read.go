@@ -13,8 +13,8 @@ package server
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/go-faster/jx"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -26,6 +26,12 @@ import (
 
 // query query SQL tables
 func query(d common.RegDbID, query *common.Query) ([]api.ResponseRecordsItem, []string, error) {
+	return queryVersioned(d, query, nil)
+}
+
+// queryVersioned query SQL tables adding the ETag of each record if the
+// table has a version column
+func queryVersioned(d common.RegDbID, query *common.Query, v *recordVersion) ([]api.ResponseRecordsItem, []string, error) {
 	log.Log.Debugf("Query in db ID %04d", d)
 	data := make([]api.ResponseRecordsItem, 0)
 	var fields []string
@@ -38,6 +44,9 @@ func query(d common.RegDbID, query *common.Query) ([]api.ResponseRecordsItem, []
 		}
 		log.Log.Debugf("Result Rows received: %d", len(result.Rows))
 		d := generateItem(result.Fields, result.Rows)
+		if v != nil {
+			v.addETag(d, result.Fields, result.Rows)
+		}
 		data = append(data, d)
 		return nil
 	})
@@ -48,6 +57,87 @@ func query(d common.RegDbID, query *common.Query) ([]api.ResponseRecordsItem, []
 	return data, fields, nil
 }
 
+// countRecords count all records matching the search of the query. Limit,
+// order and paging of the query are ignored.
+func countRecords(d common.RegDbID, table string, q *common.Query) (int64, error) {
+	if TableDriver(table) == common.AdabasType {
+		count := int64(0)
+		cq := &common.Query{TableName: q.TableName, Search: q.Search,
+			Fields: q.Fields, Descriptor: q.Descriptor}
+		_, err := d.Query(cq, func(search *common.Query, result *common.Result) error {
+			count++
+			return nil
+		})
+		return count, err
+	}
+	statement, err := countStatement(q)
+	if err != nil {
+		return 0, err
+	}
+	log.Log.Debugf("Count statement: %s", statement)
+	count := int64(-1)
+	err = d.BatchSelectFct(&common.Query{Search: statement}, func(search *common.Query, result *common.Result) error {
+		if result == nil || len(result.Rows) == 0 {
+			return errorrepo.NewError("REST00011")
+		}
+		count, err = countValue(result.Rows[0])
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	if count < 0 {
+		return 0, errorrepo.NewError("REST00011")
+	}
+	return count, nil
+}
+
+// countStatement SQL statement counting the records of the query. Distinct
+// queries count the distinct values of the fields.
+func countStatement(q *common.Query) (string, error) {
+	if !fieldNameRegexp.MatchString(q.TableName) {
+		return "", errorrepo.NewError("RERR00026", q.TableName)
+	}
+	from := q.TableName
+	if q.Search != "" {
+		from += " WHERE " + q.Search
+	}
+	if !q.Descriptor || (len(q.Fields) == 1 && q.Fields[0] == "*") {
+		return "SELECT COUNT(*) FROM " + from, nil
+	}
+	for _, f := range q.Fields {
+		if !fieldNameRegexp.MatchString(strings.TrimSpace(f)) {
+			return "", errorrepo.NewError("RERR00026", f)
+		}
+	}
+	return "SELECT COUNT(*) FROM (SELECT DISTINCT " + strings.Join(q.Fields, ",") +
+		" FROM " + from + ") c", nil
+}
+
+// countValue convert the count returned by the database driver
+func countValue(v any) (int64, error) {
+	switch t := v.(type) {
+	case int64:
+		return t, nil
+	case int32:
+		return int64(t), nil
+	case int:
+		return int64(t), nil
+	case pgtype.Numeric:
+		i, err := t.Int64Value()
+		if err != nil {
+			return 0, err
+		}
+		return i.Int64, nil
+	default:
+	}
+	c, err := strconv.ParseInt(fmt.Sprintf("%s", v), 10, 64)
+	if err != nil {
+		return 0, errorrepo.NewError("REST00010", v)
+	}
+	return c, nil
+}
+
 func generateItem(fields []string, rows []any) api.ResponseRecordsItem {
 	///var d api.ResponseRecordsItem
 	d := make(api.ResponseRecordsItem)
@@ -62,50 +152,14 @@ func generateItem(fields []string, rows []any) api.ResponseRecordsItem {
 }
 
 func convertTypeToRaw(d api.ResponseRecordsItem, s string, r interface{}) {
-	switch t := r.(type) {
-	case *string:
-		log.Log.Debugf("String Pointer %s", *t)
-		raw := jx.Raw([]byte("\"" + *t + "\""))
-		d[s] = raw
-	case string:
-		log.Log.Debugf("String %s", t)
-		str := strings.Replace(t, "\"", "\\\"", -1)
-		raw := jx.Raw([]byte("\"" + str + "\""))
-		d[s] = raw
-	case *time.Time:
-		d[s] = jx.Raw([]byte("\"" + (*t).UTC().Format(time.RFC3339) + "\""))
-	case time.Time:
-		d[s] = jx.Raw([]byte("\"" + (t).UTC().Format(time.RFC3339) + "\""))
-	case pgtype.Numeric:
-		v, err := t.Int64Value()
-		if err == nil {
-			var e jx.Encoder
-			e.Int64(v.Int64)
-			d[s] = jx.Raw([]byte(e.String()))
-		} else {
-			log.Log.Debugf("Use float, int receive error: %v", err)
-			v, err := t.Float64Value()
-			if err == nil {
-				log.Log.Debugf("%f -> %d", v.Float64, 9007199254740991)
-				if (v.Float64 > 9007199254740991) || (v.Float64 < -float64(9007199254740991)) {
-					log.Log.Debugf("Use range float ... string")
-					log.Log.Debugf("-> %s", t.Int.String())
-					d[s] = jx.Raw([]byte("\"" + t.Int.String() + "\""))
-				} else {
-					log.Log.Debugf("Use float ... native")
-					var e jx.Encoder
-					e.Float64(v.Float64)
-					d[s] = jx.Raw([]byte(e.String()))
-				}
-			} else {
-				log.Log.Debugf("No Numeric value %s", t)
-				d[s] = jx.Raw([]byte("\"error no numeric\""))
-			}
-		}
-	default:
-		if r != nil {
-			log.Log.Debugf("using default ---> %v %T", r, t)
-			d[s] = jx.Raw(fmt.Sprintf("%v", r))
-		}
+	if r == nil {
+		return
+	}
+	e := &jx.Encoder{}
+	if err := encodeValue(e, r); err != nil {
+		log.Log.Debugf("Error encoding %T: %v", r, err)
+		e.Reset()
+		e.Str(fmt.Sprintf("%v", r))
 	}
+	d[s] = jx.Raw(e.Bytes())
 }
@@ -13,16 +13,11 @@ package server
 
 import (
 	"context"
-	"fmt"
-	"io"
 	"strings"
-	"time"
 
-	"github.com/jackc/pgx/v5/pgtype"
 	ht "github.com/ogen-go/ogen/http"
 	"github.com/tknie/clu"
 	"github.com/tknie/clu/api"
-	"github.com/tknie/errorrepo"
 	"github.com/tknie/flynn/common"
 	"github.com/tknie/log"
 	"github.com/tknie/services/auth"
@@ -31,8 +26,6 @@ import (
 // TimeFormat time format for date time representation
 const TimeFormat = "2006-01-02 15:04:05"
 
-var csvDelimiter = ","
-
 // Handler server handler to ogen API
 type Handler struct {
 }
@@ -60,89 +53,102 @@ func (Handler) SearchRecordsFields(ctx context.Context, params api.SearchRecords
 	if params.Descriptor.Value {
 		descriptor = true
 	}
-	limit := "ALL"
-	if params.Limit.Set {
-		limit = params.Limit.Value
-		if params.Limit.Value == "-1" {
-			limit = "ALL"
-		}
-	}
+	req := session.CurrentRequest
 	q := &common.Query{TableName: params.Table,
 		Fields:     extractFieldList(params.Search),
 		Search:     "",
-		Limit:      limit,
 		Descriptor: descriptor,
 		Order:      checkOrderBy(params.Orderby)}
-	req := session.CurrentRequest
-	accept := req.Header.Get("Accept")
-	if accept == "text/csv" {
-		piper, pipew := io.Pipe()
-		hs := api.SearchRecordsFieldsOKTextCsv{Data: piper}
-		s := &api.SearchRecordsFieldsOKTextCsvHeaders{Response: hs}
-		go parallelQuery(d, q, piper, pipew)
-
+	if params.Limit.Set || params.Cursor.Set {
+		q.Order = uniqueOrder(session, params.Table, q.Order)
+	}
+	total := int64(-1)
+	if params.Count.Or(false) {
+		total, err = countRecords(d, params.Table, q)
 		if err != nil {
+			CloseTable(d)
 			return nil, err
 		}
-		return s, nil
 	}
-	data, fields, err := query(d, q)
+	p, err := newPagination(req, params.Limit.Or("ALL"), params.Offset, params.Cursor, q.Order)
+	if err != nil {
+		CloseTable(d)
+		return nil, NewBadRequestError(err)
+	}
+	err = p.adapt(q)
+	if err != nil {
+		CloseTable(d)
+		return nil, NewBadRequestError(err)
+	}
+	if format := acceptStream(req); format != "" {
+		rs := &recordStream{format: format, reverse: p.cursor != nil && p.cursor.Prev}
+		if format == mimeTypeCSV {
+			rs.csv, err = newCSVDialect(params.Delimiter, params.Quote, params.Header,
+				params.Null, params.Dateformat, params.Bom)
+			if err != nil {
+				CloseTable(d)
+				return nil, NewBadRequestError(err)
+			}
+		}
+		err = p.boundaries(d, q)
+		if err != nil {
+			CloseTable(d)
+			return nil, err
+		}
+		p.streamLimit(q)
+		reader := streamRecords(req.Context(), rs, func(fct common.ResultFunction) error {
+			defer CloseTable(d)
+			_, err := d.Query(q, fct)
+			return err
+		})
+		link := optString(p.link())
+		token := api.NewOptString(session.Token)
+		switch format {
+		case mimeTypeCSV:
+			return &api.SearchRecordsFieldsOKTextCsvHeaders{Link: link, XToken: token,
+				Response: api.SearchRecordsFieldsOKTextCsv{Data: reader}}, nil
+		case mimeTypeNDJSON:
+			return &api.SearchRecordsFieldsOKApplicationXNdjsonHeaders{Link: link, XToken: token,
+				Response: api.SearchRecordsFieldsOKApplicationXNdjson{Data: reader}}, nil
+		default:
+			return &api.SearchRecordsFieldsOKApplicationXJSONStreamHeaders{Link: link, XToken: token,
+				Response: api.SearchRecordsFieldsOKApplicationXJSONStream{Data: reader}}, nil
+		}
+	}
+	defer CloseTable(d)
+	v, err := tableVersion(session, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if v != nil {
+		q.Fields = v.fields(q.Fields)
+	}
+	data, fields, err := queryVersioned(d, q, v)
 	if err != nil {
 		log.Log.Errorf("Error during query on %s:%v", params.Table, err)
 		return nil, err
 	}
+	data = p.page(data)
 
 	if len(fields) == 0 {
 		fields = extractFieldList(params.Search)
 	}
 	resp := api.Response{Records: data, FieldNames: fields}
-	respH := &api.ResponseHeaders{Response: resp, XToken: api.NewOptString(session.Token)}
+	if total >= 0 {
+		resp.NrRecords = api.NewOptInt(int(total))
+	}
+	p.apply(&resp)
+	respH := &api.GetMapRecordsFieldsOKApplicationJSONHeaders{Response: resp,
+		Link: optString(p.link()), XToken: api.NewOptString(session.Token)}
+	if len(data) == 1 {
+		respH.ETag = optString(recordETag(data[0]))
+	}
 	log.Log.Debugf("DONE SQL search fields %s - %v", params.Table, params.Search)
 	log.Log.Debugf("DONE SQL result %#v", respH)
 	return respH, nil
 
 }
 
-func parallelQuery(d common.RegDbID, q *common.Query, piper *io.PipeReader, pipew *io.PipeWriter) {
-	defer CloseTable(d)
-
-	_, err := d.Query(q, func(search *common.Query, result *common.Result) error {
-		if result == nil {
-			return errorrepo.NewError("REST00011")
-		}
-		if result.Counter == 1 {
-			x := strings.Join(result.Fields, csvDelimiter)
-			pipew.Write([]byte(x + "\n"))
-		}
-		str := ""
-		for i, x := range result.Rows {
-			if i > 0 {
-				str += csvDelimiter
-			}
-			switch t := x.(type) {
-			case pgtype.Numeric:
-				f, err := t.Float64Value()
-				if err == nil {
-					str += fmt.Sprintf("%v", f.Float64)
-				}
-			case time.Time:
-				str += t.Format(TimeFormat)
-			case nil:
-			default:
-				log.Log.Debugf("Default CSV %T\n", t)
-				str += fmt.Sprintf("%v", t)
-			}
-		}
-		pipew.Write([]byte(str + "\n"))
-		return nil
-	})
-	if err != nil {
-		pipew.Write([]byte(fmt.Sprintf("Error: %v", err)))
-	}
-	//	piper.Close()
-	pipew.Close()
-}
-
 // GetMapRecordsFields implements getMapRecordsFields operation.
 //
 // Retrieves a field of a specific ISN of a Map definition.
@@ -153,56 +159,159 @@ func (Handler) GetMapRecordsFields(ctx context.Context, params api.GetMapRecords
 	if !Validate(session, auth.UserRole, params.Table) {
 		return &api.GetMapRecordsFieldsForbidden{}, nil
 	}
+	if isRawSearch(params.Search) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.GetMapRecordsFieldsForbidden{}, nil
+	}
 	log.Log.Debugf("SQL search %s - %v -> %s", params.Table, params.Fields, params.Search)
 	d, err := ConnectTable(session, params.Table)
 	if err != nil {
 		log.Log.Errorf("Error search table %s:%v", params.Table, err)
 		return nil, err
 	}
-	defer CloseTable(d)
+	search, err := compileSearch(d, params.Table, params.Search)
+	if err != nil {
+		CloseTable(d)
+		return nil, NewBadRequestError(err)
+	}
 
 	descriptor := false
 	if params.Descriptor.Set && params.Descriptor.Value {
 		descriptor = true
 	}
-	limit := "ALL"
-	if params.Limit.Set {
-		limit = params.Limit.Value
-	}
+	req := session.CurrentRequest
 	q := &common.Query{TableName: params.Table,
 		Fields:     extractFieldList(params.Fields),
-		Search:     params.Search,
+		Search:     search,
 		Descriptor: descriptor,
-		Limit:      limit,
 		Order:      checkOrderBy(params.Orderby)}
-	req := session.CurrentRequest
-	accept := req.Header.Get("Accept")
-	if accept == "text/csv" {
-		piper, pipew := io.Pipe()
-		hs := api.GetMapRecordsFieldsOKTextCsv{Data: piper}
-		s := &api.GetMapRecordsFieldsOKTextCsvHeaders{Response: hs}
-		go parallelQuery(d, q, piper, pipew)
-
+	if params.Limit.Set || params.Cursor.Set {
+		q.Order = uniqueOrder(session, params.Table, q.Order)
+	}
+	total := int64(-1)
+	if params.Count.Or(false) {
+		total, err = countRecords(d, params.Table, q)
 		if err != nil {
+			CloseTable(d)
 			return nil, err
 		}
-		return s, nil
 	}
-	data, fields, err := query(d, q)
+	p, err := newPagination(req, params.Limit.Or("ALL"), params.Offset, params.Cursor, q.Order)
+	if err != nil {
+		CloseTable(d)
+		return nil, NewBadRequestError(err)
+	}
+	err = p.adapt(q)
+	if err != nil {
+		CloseTable(d)
+		return nil, NewBadRequestError(err)
+	}
+	if format := acceptStream(req); format != "" {
+		rs := &recordStream{format: format, reverse: p.cursor != nil && p.cursor.Prev}
+		if format == mimeTypeCSV {
+			rs.csv, err = newCSVDialect(params.Delimiter, params.Quote, params.Header,
+				params.Null, params.Dateformat, params.Bom)
+			if err != nil {
+				CloseTable(d)
+				return nil, NewBadRequestError(err)
+			}
+		}
+		err = p.boundaries(d, q)
+		if err != nil {
+			CloseTable(d)
+			return nil, err
+		}
+		p.streamLimit(q)
+		reader := streamRecords(req.Context(), rs, func(fct common.ResultFunction) error {
+			defer CloseTable(d)
+			_, err := d.Query(q, fct)
+			return err
+		})
+		link := optString(p.link())
+		token := api.NewOptString(session.Token)
+		switch format {
+		case mimeTypeCSV:
+			return &api.GetMapRecordsFieldsOKTextCsvHeaders{Link: link, XToken: token,
+				Response: api.GetMapRecordsFieldsOKTextCsv{Data: reader}}, nil
+		case mimeTypeNDJSON:
+			return &api.GetMapRecordsFieldsOKApplicationXNdjsonHeaders{Link: link, XToken: token,
+				Response: api.GetMapRecordsFieldsOKApplicationXNdjson{Data: reader}}, nil
+		default:
+			return &api.GetMapRecordsFieldsOKApplicationXJSONStreamHeaders{Link: link, XToken: token,
+				Response: api.GetMapRecordsFieldsOKApplicationXJSONStream{Data: reader}}, nil
+		}
+	}
+	defer CloseTable(d)
+	v, err := tableVersion(session, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if v != nil {
+		q.Fields = v.fields(q.Fields)
+	}
+	data, fields, err := queryVersioned(d, q, v)
 	if err != nil {
 		log.Log.Errorf("Error during query on %s:%v", params.Table, err)
 		return nil, err
 	}
+	data = p.page(data)
 	log.Log.Debugf("Return SQL search payload %d entries", len(data))
 
 	resp := api.Response{Records: data, FieldNames: fields,
 		MapName:   api.NewOptString(params.Table),
 		NrRecords: api.NewOptInt(len(data))}
-	respH := &api.ResponseHeaders{Response: resp, XToken: api.NewOptString(session.Token)}
+	if total >= 0 {
+		resp.NrRecords = api.NewOptInt(int(total))
+	}
+	p.apply(&resp)
+	respH := &api.GetMapRecordsFieldsOKApplicationJSONHeaders{Response: resp,
+		Link: optString(p.link()), XToken: api.NewOptString(session.Token)}
+	if len(data) == 1 {
+		respH.ETag = optString(recordETag(data[0]))
+	}
 	log.Log.Debugf("Return SQL search %s - %v -> %s", params.Table, params.Fields, params.Search)
 	return respH, nil
 }
 
+// HeadMapRecordsFields implements headMapRecordsFields operation.
+//
+// Return the total number of records matching the search in the
+// X-Total-Count header.
+//
+// HEAD /rest/view/{table}/{fields}/{search}
+func (Handler) HeadMapRecordsFields(ctx context.Context, params api.HeadMapRecordsFieldsParams) (r api.HeadMapRecordsFieldsRes, _ error) {
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.HeadMapRecordsFieldsForbidden{}, nil
+	}
+	if isRawSearch(params.Search) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.HeadMapRecordsFieldsForbidden{}, nil
+	}
+	log.Log.Debugf("SQL count %s - %v -> %s", params.Table, params.Fields, params.Search)
+	d, err := ConnectTable(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error search table %s:%v", params.Table, err)
+		return nil, err
+	}
+	defer CloseTable(d)
+	search, err := compileSearch(d, params.Table, params.Search)
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+	q := &common.Query{TableName: params.Table,
+		Fields:     extractFieldList(params.Fields),
+		Search:     search,
+		Descriptor: params.Descriptor.Or(false)}
+	total, err := countRecords(d, params.Table, q)
+	if err != nil {
+		log.Log.Errorf("Error during count on %s:%v", params.Table, err)
+		return nil, err
+	}
+	return &api.HeadMapRecordsFieldsOK{XToken: api.NewOptString(session.Token),
+		XTotalCount: api.NewOptInt64(total)}, nil
+}
+
 func extractFieldList(fields string) []string {
 	f := []string{"*"}
 	if fields != "" {
@@ -239,21 +348,18 @@ func (Handler) SearchModelling(ctx context.Context, params api.SearchModellingPa
 	if !Validate(session, auth.UserRole, params.Path) {
 		return &api.SearchModellingForbidden{}, nil
 	}
-	log.Log.Debugf("SQL modelling field of an table %s - %v", params.Path, params.Path)
-	d, err := ConnectTable(session, params.Path)
+	log.Log.Debugf("SQL modelling field of an table %s", params.Path)
+	m, err := tableMetadata(session, params.Path)
 	if err != nil {
 		log.Log.Errorf("Error search table %s:%v", params.Path, err)
 		return nil, err
 	}
-	defer CloseTable(d)
-
-	fields, err := d.GetTableColumn(params.Path)
-	if err != nil {
-		return nil, err
+	fields := make([]string, 0, len(m.Columns))
+	for _, c := range m.Columns {
+		fields = append(fields, c.Name.Value)
 	}
-	res := &api.Response{MapName: api.NewOptString(params.Path), FieldNames: fields}
-	log.Log.Debugf("Return SQL modelling field of an table %s - %v", params.Path, params.Path)
-	return res, nil
+	log.Log.Debugf("Return SQL modelling field of an table %s", params.Path)
+	return &api.Response{MapName: api.NewOptString(params.Path), FieldNames: fields}, nil
 }
 
 // ListModelling implements listModelling operation.
@@ -262,5 +368,9 @@ func (Handler) SearchModelling(ctx context.Context, params api.SearchModellingPa
 //
 // GET /rest/map
 func (Handler) ListModelling(ctx context.Context) (r api.ListModellingRes, _ error) {
-	return r, ht.ErrNotImplemented
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, "*Maps") {
+		return &api.ListModellingForbidden{}, nil
+	}
+	return tablesMetadata(session), nil
 }
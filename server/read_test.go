@@ -0,0 +1,52 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/flynn/common"
+)
+
+func TestCountStatement(t *testing.T) {
+	statement, err := countStatement(&common.Query{TableName: "Albums", Fields: []string{"*"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT COUNT(*) FROM Albums", statement)
+	statement, err = countStatement(&common.Query{TableName: "Albums", Fields: []string{"Title"},
+		Search: "ID>5", Order: []string{"Title"}, Limit: "10"})
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT COUNT(*) FROM Albums WHERE ID>5", statement)
+	statement, err = countStatement(&common.Query{TableName: "Albums", Fields: []string{"Artist", " Title"},
+		Search: "ID>5", Descriptor: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT COUNT(*) FROM (SELECT DISTINCT Artist, Title FROM Albums WHERE ID>5) c", statement)
+
+	_, err = countStatement(&common.Query{TableName: "Albums;DROP", Fields: []string{"*"}})
+	assert.Equal(t, "RERR00026", errorID(err))
+	_, err = countStatement(&common.Query{TableName: "Albums", Fields: []string{"count(*)"}, Descriptor: true})
+	assert.Equal(t, "RERR00026", errorID(err))
+}
+
+func TestCountValue(t *testing.T) {
+	for _, v := range []any{int64(42), int32(42), 42, []byte("42"), "42",
+		pgtype.Numeric{Int: big.NewInt(42), Valid: true}} {
+		c, err := countValue(v)
+		if assert.NoError(t, err, v) {
+			assert.Equal(t, int64(42), c)
+		}
+	}
+	_, err := countValue("x")
+	assert.Equal(t, "REST00010", errorID(err))
+}
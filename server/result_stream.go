@@ -0,0 +1,159 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/jx"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+)
+
+const (
+	// mimeTypeNDJSON newline delimited JSON, one record per line
+	mimeTypeNDJSON = "application/x-ndjson"
+	// mimeTypeJSONStream streamed JSON array of records
+	mimeTypeJSONStream = "application/x-json-stream"
+)
+
+// acceptStream returns the streamed media type accepted by the request or
+// an empty string if no streamed output is requested
+func acceptStream(req *http.Request) string {
+	if req == nil {
+		return ""
+	}
+	for _, a := range strings.Split(req.Header.Get("Accept"), ",") {
+		mt, _, _ := strings.Cut(a, ";")
+		switch mt = strings.TrimSpace(mt); mt {
+		case mimeTypeNDJSON, mimeTypeJSONStream, mimeTypeCSV:
+			return mt
+		default:
+		}
+	}
+	return ""
+}
+
+// recordStream writes the records into a pipe as the database delivers them.
+// The pipe blocks the database read until the client consumed the data.
+// If reverse is set, the records are collected and written in reverse order.
+type recordStream struct {
+	format  string
+	reverse bool
+	csv     *csvDialect
+	writer  *io.PipeWriter
+	count   int
+	header  bool
+	records [][]byte
+}
+
+// streamRecords runs the query function in the background and returns the
+// reader receiving the records. The query is stopped if the request context
+// is done or the client stops reading. A failure in the middle of the
+// stream aborts the response instead of writing an error into the data.
+func streamRecords(ctx context.Context, rs *recordStream, run func(common.ResultFunction) error) io.Reader {
+	piper, pipew := io.Pipe()
+	rs.writer = pipew
+	format := rs.format
+	stop := context.AfterFunc(ctx, func() {
+		log.Log.Debugf("Request done, stop streaming records")
+		piper.CloseWithError(ctx.Err())
+	})
+	go func() {
+		defer stop()
+		var err error
+		switch {
+		case format == mimeTypeJSONStream:
+			_, err = pipew.Write([]byte("["))
+		case format == mimeTypeCSV && rs.csv.bom:
+			_, err = pipew.Write([]byte("\uFEFF"))
+		default:
+		}
+		if err == nil {
+			err = run(rs.write)
+		}
+		if err == nil {
+			err = rs.finish()
+		}
+		if err != nil {
+			log.Log.Errorf("Error streaming records: %v", err)
+		}
+		log.Log.Debugf("Streamed %d records", rs.count)
+		pipew.CloseWithError(err)
+	}()
+	return piper
+}
+
+// write result function called for each record of the database
+func (rs *recordStream) write(search *common.Query, result *common.Result) error {
+	if result == nil {
+		return errorrepo.NewError("REST00011")
+	}
+	var record []byte
+	if rs.format == mimeTypeCSV {
+		if !rs.header && rs.csv.header {
+			if _, err := rs.writer.Write(rs.csv.headerLine(result.Fields)); err != nil {
+				return err
+			}
+		}
+		rs.header = true
+		record = rs.csv.row(result.Rows)
+	} else {
+		item := generateItem(result.Fields, result.Rows)
+		e := &jx.Encoder{}
+		item.Encode(e)
+		record = e.Bytes()
+	}
+	if rs.reverse {
+		rs.records = append(rs.records, record)
+		return nil
+	}
+	return rs.emit(record)
+}
+
+// emit write one record in the stream format
+func (rs *recordStream) emit(record []byte) error {
+	var err error
+	switch rs.format {
+	case mimeTypeJSONStream:
+		if rs.count > 0 {
+			_, err = rs.writer.Write([]byte(","))
+		}
+		if err == nil {
+			_, err = rs.writer.Write(record)
+		}
+	case mimeTypeCSV:
+		_, err = rs.writer.Write(record)
+	default:
+		_, err = rs.writer.Write(append(record, '\n'))
+	}
+	rs.count++
+	return err
+}
+
+// finish write collected records in reverse order and close JSON array
+func (rs *recordStream) finish() error {
+	for i := len(rs.records) - 1; i >= 0; i-- {
+		if err := rs.emit(rs.records[i]); err != nil {
+			return err
+		}
+	}
+	if rs.format == mimeTypeJSONStream {
+		_, err := rs.writer.Write([]byte("]"))
+		return err
+	}
+	return nil
+}
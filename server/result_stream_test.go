@@ -0,0 +1,101 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/flynn/common"
+)
+
+func testStream(rs *recordStream, rows ...[]any) (string, error) {
+	reader := streamRecords(context.Background(), rs, func(fct common.ResultFunction) error {
+		for _, r := range rows {
+			if err := fct(nil, &common.Result{Fields: []string{"Name"}, Rows: r}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	data, err := io.ReadAll(reader)
+	return string(data), err
+}
+
+func TestAcceptStream(t *testing.T) {
+	assert.Equal(t, "", acceptStream(nil))
+	req, _ := http.NewRequest(http.MethodGet, "/rest/view/Albums/*", nil)
+	assert.Equal(t, "", acceptStream(req))
+	req.Header.Set("Accept", "application/json")
+	assert.Equal(t, "", acceptStream(req))
+	req.Header.Set("Accept", "text/html, application/x-ndjson;q=0.9")
+	assert.Equal(t, mimeTypeNDJSON, acceptStream(req))
+	req.Header.Set("Accept", mimeTypeJSONStream)
+	assert.Equal(t, mimeTypeJSONStream, acceptStream(req))
+}
+
+func TestStreamRecords(t *testing.T) {
+	data, err := testStream(&recordStream{format: mimeTypeNDJSON}, []any{"a"}, []any{"b"})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"name\":\"a\"}\n{\"name\":\"b\"}\n", data)
+
+	data, err = testStream(&recordStream{format: mimeTypeJSONStream}, []any{"a"}, []any{"b"})
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"name":"a"},{"name":"b"}]`, data)
+
+	data, err = testStream(&recordStream{format: mimeTypeJSONStream, reverse: true}, []any{"a"}, []any{"b"})
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"name":"b"},{"name":"a"}]`, data)
+
+	data, err = testStream(&recordStream{format: mimeTypeJSONStream})
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", data)
+}
+
+func TestStreamRecordsAbort(t *testing.T) {
+	failure := errors.New("connection lost")
+	reader := streamRecords(context.Background(), &recordStream{format: mimeTypeNDJSON},
+		func(fct common.ResultFunction) error {
+			if err := fct(nil, &common.Result{Fields: []string{"ID"}, Rows: []any{1}}); err != nil {
+				return err
+			}
+			return failure
+		})
+	data, err := io.ReadAll(reader)
+	assert.Equal(t, failure, err)
+	assert.Equal(t, "{\"id\":1}\n", string(data))
+
+	reader = streamRecords(context.Background(), &recordStream{format: mimeTypeNDJSON},
+		func(fct common.ResultFunction) error { return fct(nil, nil) })
+	_, err = io.ReadAll(reader)
+	assert.Equal(t, "REST00011", errorID(err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	reader = streamRecords(ctx, &recordStream{format: mimeTypeNDJSON}, func(fct common.ResultFunction) error {
+		var err error
+		for err == nil {
+			err = fct(nil, &common.Result{Fields: []string{"ID"}, Rows: []any{1}})
+		}
+		done <- err
+		return err
+	})
+	buffer := make([]byte, 4)
+	_, err = reader.Read(buffer)
+	assert.NoError(t, err)
+	cancel()
+	assert.Error(t, <-done)
+}
@@ -0,0 +1,184 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+)
+
+// confirmTokenExpiry validity of the confirm token of a dry run
+const confirmTokenExpiry = 10 * time.Minute
+
+// safeguard dry run and affected records limit of a delete or update
+type safeguard struct {
+	session   *clu.Context
+	table     string
+	operation string
+	criteria  string
+	dryRun    bool
+	confirm   string
+	limit     int
+}
+
+// newSafeguard create safeguard of the operation. The criteria identify the
+// operation the confirm token is valid for.
+func newSafeguard(session *clu.Context, table, operation string, dryRun api.OptBool,
+	confirm api.OptString, criteria ...any) (*safeguard, error) {
+	entry, err := clu.SearchTable(table)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	for _, c := range criteria {
+		fmt.Fprintf(h, "%v\x00", c)
+	}
+	return &safeguard{session: session, table: table, operation: operation,
+		criteria: base64.RawURLEncoding.EncodeToString(h.Sum(nil)),
+		dryRun:   dryRun.Value, confirm: confirm.Value,
+		limit: entry.Database.MaxAffected(table)}, nil
+}
+
+// active dry run is requested or the table has a limit
+func (sg *safeguard) active() bool {
+	return sg.dryRun || sg.limit > 0
+}
+
+// run execute the operation inside a transaction. The transaction is only
+// committed if commit is set, it is no dry run and the number of affected
+// records is allowed. Returns the number of affected records and if the
+// operation is committed.
+func (sg *safeguard) run(d common.RegDbID, commit bool, op func() (int64, error)) (int64, bool, error) {
+	if driver := TableDriver(sg.table); driver == common.AdabasType {
+		return 0, false, errorrepo.NewError("REST00039", driver.String())
+	}
+	err := d.BeginTransaction()
+	if err != nil {
+		return 0, false, err
+	}
+	n, err := op()
+	if err != nil || !commit || sg.dryRun || !sg.allowed(n) {
+		if rerr := d.Rollback(); rerr != nil {
+			log.Log.Errorf("Error rollback %s on %s: %v", sg.operation, sg.table, rerr)
+		}
+		log.Log.Debugf("Safeguard %s on %s affects %d records, rolled back", sg.operation, sg.table, n)
+		return n, false, err
+	}
+	return n, true, d.Commit()
+}
+
+// allowed number of affected records is in the limit or confirmed by a
+// valid token
+func (sg *safeguard) allowed(n int64) bool {
+	if sg.limit == 0 || n <= int64(sg.limit) {
+		return true
+	}
+	return sg.valid(n)
+}
+
+// signature signature of the operation for the number of records and expiry
+func (sg *safeguard) signature(n int64, expiry int64) string {
+	mac := hmac.New(sha256.New, serverSecret())
+	fmt.Fprintf(mac, "%s\x00%s\x00%s\x00%s\x00%d\x00%d", sg.session.UserName(), sg.table,
+		sg.operation, sg.criteria, n, expiry)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// token confirm token of the dry run, valid up to n affected records
+func (sg *safeguard) token(n int64) string {
+	expiry := time.Now().Add(confirmTokenExpiry).Unix()
+	return strconv.FormatInt(n, 10) + "." + strconv.FormatInt(expiry, 10) + "." + sg.signature(n, expiry)
+}
+
+// valid check the confirm token allows n affected records
+func (sg *safeguard) valid(n int64) bool {
+	parts := strings.Split(sg.confirm, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	confirmed, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || n > confirmed {
+		return false
+	}
+	expiry, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || time.Now().Unix() > expiry {
+		return false
+	}
+	return hmac.Equal([]byte(parts[2]), []byte(sg.signature(confirmed, expiry)))
+}
+
+// response response of a dry run or refused operation
+func (sg *safeguard) response(n int64, sample []api.ResponseRecordsItem) api.Response {
+	resp := api.Response{NrRecords: api.NewOptInt(int(n)), MapName: api.NewOptString(sg.table),
+		Records: sample}
+	if sg.limit > 0 {
+		resp.MaxAffectedRows = api.NewOptInt(sg.limit)
+	}
+	if sg.dryRun {
+		resp.DryRun = api.NewOptBool(true)
+		resp.ConfirmToken = api.NewOptString(sg.token(n))
+	}
+	return resp
+}
+
+// sample read up to n records matching the search
+func (sg *safeguard) sample(d common.RegDbID, search string, n int) ([]api.ResponseRecordsItem, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	data, _, err := query(d, &common.Query{TableName: sg.table, Fields: []string{"*"},
+		Search: search, Limit: strconv.Itoa(n)})
+	return data, err
+}
+
+// keySearch search matching the key fields of the records
+func keySearch(d common.RegDbID, table string, records []any, keys []string) (string, error) {
+	filter, err := keyFilter(records, keys)
+	if err != nil {
+		return "", err
+	}
+	return compileSearch(d, table, filter)
+}
+
+// keyFilter structured filter of the key fields of the records
+func keyFilter(records []any, keys []string) (string, error) {
+	or := make([]any, 0, len(records))
+	for _, r := range records {
+		and := make([]any, 0, len(keys))
+		for _, k := range keys {
+			k = strings.TrimSpace(k)
+			x, ok := findField(r.(map[string]any), k)
+			if !ok {
+				return "", errorrepo.NewError("REST00032", k)
+			}
+			and = append(and, map[string]any{"eq": []any{k, x}})
+		}
+		or = append(or, map[string]any{"and": and})
+	}
+	filter, err := json.Marshal(map[string]any{"or": or})
+	if err != nil {
+		return "", err
+	}
+	return string(filter), nil
+}
@@ -0,0 +1,92 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu"
+)
+
+func testSafeguard(user, criteria, confirm string, limit int) *safeguard {
+	return &safeguard{session: clu.NewContext(user, "x"), table: "albums", operation: "delete",
+		criteria: criteria, confirm: confirm, limit: limit}
+}
+
+func TestSafeguardLimit(t *testing.T) {
+	tests := []struct {
+		limit int
+		n     int64
+		want  bool
+	}{
+		{0, 100000, true},
+		{10, 0, true},
+		{10, 10, true},
+		{10, 11, false},
+	}
+	for _, tt := range tests {
+		sg := testSafeguard("admin", "c", "", tt.limit)
+		assert.Equal(t, tt.want, sg.allowed(tt.n), "limit=%d n=%d", tt.limit, tt.n)
+		assert.Equal(t, tt.limit > 0, sg.active())
+	}
+}
+
+func TestSafeguardToken(t *testing.T) {
+	token := testSafeguard("admin", "c", "", 10).token(50)
+	tests := []struct {
+		name     string
+		user     string
+		criteria string
+		confirm  string
+		n        int64
+		want     bool
+	}{
+		{"same", "admin", "c", token, 50, true},
+		{"fewer", "admin", "c", token, 20, true},
+		{"more", "admin", "c", token, 51, false},
+		{"user", "other", "c", token, 50, false},
+		{"criteria", "admin", "d", token, 50, false},
+		{"count", "admin", "c", "99" + token[strings.Index(token, "."):], 60, false},
+		{"signature", "admin", "c", token[:len(token)-2] + "xx", 50, false},
+		{"format", "admin", "c", "50", 50, false},
+		{"empty", "admin", "c", "", 50, false},
+	}
+	for _, tt := range tests {
+		sg := testSafeguard(tt.user, tt.criteria, tt.confirm, 10)
+		assert.Equal(t, tt.want, sg.allowed(tt.n), tt.name)
+	}
+
+	sg := testSafeguard("admin", "c", "", 10)
+	expiry := time.Now().Add(-time.Minute).Unix()
+	sg.confirm = "50." + strconv.FormatInt(expiry, 10) + "." + sg.signature(50, expiry)
+	assert.False(t, sg.allowed(50), "expired")
+}
+
+func TestSafeguardResponse(t *testing.T) {
+	sg := testSafeguard("admin", "c", "", 10)
+	resp := sg.response(12, nil)
+	assert.Equal(t, 12, resp.NrRecords.Value)
+	assert.Equal(t, 10, resp.MaxAffectedRows.Value)
+	assert.False(t, resp.DryRun.Set)
+	assert.False(t, resp.ConfirmToken.Set)
+
+	sg.dryRun = true
+	resp = sg.response(12, nil)
+	assert.True(t, resp.DryRun.Value)
+	sg.confirm = resp.ConfirmToken.Value
+	sg.dryRun = false
+	assert.True(t, sg.allowed(12))
+}
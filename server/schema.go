@@ -0,0 +1,230 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"encoding/base64"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-faster/jx"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/log"
+)
+
+// pointerEscaper escape JSON pointer reference tokens
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// recordSchema check incoming record values against the column metadata of
+// the table and convert them to the column types
+type recordSchema struct {
+	table   string
+	columns map[string]api.TableColumn
+	details []api.ErrorDetail
+}
+
+// newRecordSchema schema of the table, nil if the column types of the table
+// are not known
+func newRecordSchema(session *clu.Context, table string) (*recordSchema, error) {
+	m, err := tableMetadata(session, table)
+	if err != nil {
+		return nil, err
+	}
+	s := &recordSchema{table: table, columns: make(map[string]api.TableColumn)}
+	for _, c := range m.Columns {
+		if !c.Type.Set {
+			return nil, nil
+		}
+		s.columns[strings.ToLower(c.Name.Value)] = c
+	}
+	return s, nil
+}
+
+// parseRecords parse the JSON records. If the column types of the table are
+// known, the values are checked and converted to the column types. All
+// invalid values are reported in one error. Missing NOT NULL fields are
+// only reported for complete records like inserted ones.
+func parseRecords(session *clu.Context, table string, records []map[string]jx.Raw,
+	complete bool) ([]any, []string, error) {
+	s, err := newRecordSchema(session, table)
+	if err != nil {
+		return nil, nil, err
+	}
+	list := make([]any, 0, len(records))
+	nameMap := make(map[string]bool)
+	fields := make([]string, 0)
+	for i, r := range records {
+		var m map[string]any
+		if s != nil {
+			m = s.record("/Records/"+strconv.Itoa(i), r, complete)
+		} else {
+			m = make(map[string]any)
+			for n, v := range r {
+				v, err := parseJx(v)
+				if err != nil {
+					log.Log.Debugf("Error JSON parser %s: %v", n, err)
+					return nil, nil, errorrepo.NewError("RERR00015", n, err)
+				}
+				m[n] = v
+			}
+		}
+		for n := range m {
+			if !nameMap[n] {
+				nameMap[n] = true
+				fields = append(fields, n)
+			}
+		}
+		list = append(list, m)
+	}
+	if s != nil && len(s.details) > 0 {
+		return nil, nil, s.error()
+	}
+	return list, fields, nil
+}
+
+// record check and convert the fields of the record
+func (s *recordSchema) record(pointer string, r map[string]jx.Raw, complete bool) map[string]any {
+	m := make(map[string]any)
+	for n, raw := range r {
+		p := pointer + "/" + pointerEscaper.Replace(n)
+		if n == etagField {
+			v, _ := parseJx(raw)
+			m[n] = v
+			continue
+		}
+		c, ok := s.columns[strings.ToLower(n)]
+		if !ok {
+			s.fail(p, errorrepo.NewError("REST00046", n))
+			continue
+		}
+		if v, ok := s.value(p, c, raw); ok {
+			m[n] = v
+		}
+	}
+	if complete {
+		for _, c := range s.columns {
+			if c.Nullable.Value || c.Default.Set || c.Generated.Value {
+				continue
+			}
+			if _, ok := findField(m, c.Name.Value); !ok {
+				s.fail(pointer+"/"+pointerEscaper.Replace(c.Name.Value), errorrepo.NewError("REST00047", c.Name.Value))
+			}
+		}
+	}
+	return m
+}
+
+// value convert the JSON value to the column type
+func (s *recordSchema) value(pointer string, c api.TableColumn, raw jx.Raw) (any, bool) {
+	name := c.Name.Value
+	t := strings.ToLower(c.Type.Value)
+	var text string
+	switch raw.Type() {
+	case jx.Null:
+		if !c.Nullable.Value {
+			s.fail(pointer, errorrepo.NewError("REST00047", name))
+			return nil, false
+		}
+		return nil, true
+	case jx.String:
+		text, _ = jx.DecodeBytes(raw).Str()
+	case jx.Number, jx.Bool:
+		text = raw.String()
+	default:
+		if strings.Contains(t, "json") {
+			return raw.String(), true
+		}
+		s.fail(pointer, errorrepo.NewError("REST00049", name, t))
+		return nil, false
+	}
+	var v any
+	var err error
+	switch {
+	case strings.Contains(t, "json"):
+		v = raw.String()
+	case strings.Contains(t, "bool"):
+		v, err = strconv.ParseBool(text)
+	case integerType(t):
+		v, err = strconv.ParseInt(text, 10, 64)
+		switch {
+		case raw.Type() == jx.Bool:
+			// boolean stored in integer columns like MySQL tinyint(1)
+			v, err = int64(0), nil
+			if text == "true" {
+				v = int64(1)
+			}
+		case err != nil:
+			// whole numbers in floating point notation
+			var f float64
+			if f, err = strconv.ParseFloat(text, 64); err == nil && f == math.Trunc(f) {
+				v = int64(f)
+			} else if err == nil {
+				err = strconv.ErrSyntax
+			}
+		}
+	case strings.Contains(t, "float") || strings.Contains(t, "double") || strings.Contains(t, "real"):
+		v, err = strconv.ParseFloat(text, 64)
+	case strings.Contains(t, "numeric") || strings.Contains(t, "decimal") || strings.Contains(t, "number"):
+		// keep the exact decimal representation
+		_, err = strconv.ParseFloat(text, 64)
+		v = text
+	case strings.Contains(t, "timestamp") || strings.Contains(t, "datetime") || t == "date":
+		for _, layout := range append([]string{TimeFormat}, importTimeLayouts...) {
+			if v, err = time.Parse(layout, text); err == nil {
+				break
+			}
+		}
+	case strings.Contains(t, "bytea") || strings.Contains(t, "blob") || strings.Contains(t, "binary") || t == "raw":
+		v, err = base64.StdEncoding.DecodeString(text)
+	default:
+		if raw.Type() != jx.String && (strings.Contains(t, "char") || strings.Contains(t, "text")) {
+			s.fail(pointer, errorrepo.NewError("REST00049", name, t))
+			return nil, false
+		}
+		if c.Length.Value > 0 && utf8.RuneCountInString(text) > c.Length.Value {
+			s.fail(pointer, errorrepo.NewError("REST00048", name, c.Length.Value))
+			return nil, false
+		}
+		v = text
+	}
+	if err != nil {
+		s.fail(pointer, errorrepo.NewError("REST00049", name, t))
+		return nil, false
+	}
+	return v, true
+}
+
+// integerType check if the SQL type is an integer type
+func integerType(t string) bool {
+	return (strings.Contains(t, "int") && !strings.Contains(t, "interval") && !strings.Contains(t, "point")) ||
+		strings.Contains(t, "serial")
+}
+
+// fail add invalid field to the error details
+func (s *recordSchema) fail(pointer string, err error) {
+	log.Log.Debugf("Invalid field %s: %v", pointer, err)
+	s.details = append(s.details, api.ErrorDetail{Pointer: api.NewOptString(pointer),
+		Message: api.NewOptString(err.Error())})
+}
+
+// error bad request error containing all invalid fields
+func (s *recordSchema) error() *api.ErrorStatusCode {
+	e := NewAPIError("REST00045", errorrepo.NewError("REST00045", len(s.details), s.table))
+	e.Details = s.details
+	return &api.ErrorStatusCode{StatusCode: http.StatusBadRequest, Response: *e}
+}
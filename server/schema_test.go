@@ -0,0 +1,97 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+)
+
+func testSchema() *recordSchema {
+	column := func(name, t string, nullable bool, length int) api.TableColumn {
+		c := api.TableColumn{Name: api.NewOptString(name), Type: api.NewOptString(t),
+			Nullable: api.NewOptBool(nullable)}
+		if length > 0 {
+			c.Length = api.NewOptInt(length)
+		}
+		return c
+	}
+	s := &recordSchema{table: "albums", columns: make(map[string]api.TableColumn)}
+	for _, c := range []api.TableColumn{
+		column("id", "integer", false, 0),
+		column("title", "character varying", false, 10),
+		column("description", "text", true, 0),
+		column("price", "numeric", true, 0),
+		column("created", "timestamp without time zone", true, 0),
+		column("published", "boolean", true, 0),
+	} {
+		s.columns[c.Name.Value] = c
+	}
+	id := s.columns["id"]
+	id.Generated = api.NewOptBool(true)
+	s.columns["id"] = id
+	return s
+}
+
+func TestSchemaRecordCoerce(t *testing.T) {
+	s := testSchema()
+	m := s.record("/Records/0", map[string]jx.Raw{
+		"title":       jx.Raw(`"Abbey Road"`),
+		"description": jx.Raw(`null`),
+		"price":       jx.Raw(`12345678901234567890.10`),
+		"created":     jx.Raw(`"2024-01-02T10:00:00Z"`),
+		"published":   jx.Raw(`true`),
+		"id":          jx.Raw(`4.0`),
+	}, true)
+	assert.Empty(t, s.details)
+	assert.Equal(t, "Abbey Road", m["title"])
+	assert.Nil(t, m["description"])
+	assert.Equal(t, "12345678901234567890.10", m["price"])
+	assert.Equal(t, time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), m["created"])
+	assert.Equal(t, true, m["published"])
+	assert.Equal(t, int64(4), m["id"])
+}
+
+func TestSchemaRecordErrors(t *testing.T) {
+	s := testSchema()
+	s.record("/Records/1", map[string]jx.Raw{
+		"unknown":   jx.Raw(`1`),
+		"price":     jx.Raw(`"abc"`),
+		"created":   jx.Raw(`12`),
+		"published": jx.Raw(`"maybe"`),
+		"id":        jx.Raw(`1.5`),
+		"a/b":       jx.Raw(`1`),
+	}, true)
+	pointers := make([]string, 0)
+	for _, d := range s.details {
+		pointers = append(pointers, d.Pointer.Value)
+	}
+	assert.ElementsMatch(t, []string{"/Records/1/unknown", "/Records/1/price", "/Records/1/created",
+		"/Records/1/published", "/Records/1/id", "/Records/1/a~1b", "/Records/1/title"}, pointers)
+
+	s = testSchema()
+	s.record("/Records/0", map[string]jx.Raw{
+		"title":       jx.Raw(`"much too long title"`),
+		"description": jx.Raw(`12`),
+	}, false)
+	assert.Len(t, s.details, 2)
+	s = testSchema()
+	s.record("/Records/0", map[string]jx.Raw{"title": jx.Raw(`null`)}, false)
+	assert.Len(t, s.details, 1)
+	e := s.error()
+	assert.Equal(t, 400, e.StatusCode)
+	assert.Len(t, e.Response.Details, 1)
+}
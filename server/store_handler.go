@@ -19,7 +19,6 @@ import (
 	"time"
 
 	"github.com/go-faster/jx"
-	ht "github.com/ogen-go/ogen/http"
 	"github.com/tknie/clu"
 	"github.com/tknie/clu/api"
 	"github.com/tknie/errorrepo"
@@ -52,54 +51,55 @@ func (Handler) InsertRecord(ctx context.Context, req api.OptInsertRecordReq, par
 		return &api.InsertRecordBadRequest{}, nil
 	}
 
-	records := make([]any, 0)
-	nameMap := make(map[string]bool)
+	items := make([]map[string]jx.Raw, 0, len(req.Value.Records))
 	for _, r := range req.Value.Records {
-		m := make(map[string]any)
-		for n, v := range r {
-			v, err := parseJx(v)
-			if err != nil {
-				log.Log.Debugf("Error JSON parser %s: %v", n, err)
-				return nil, errorrepo.NewError("RERR00015", n, err)
-			}
-			log.Log.Debugf("[%s]=%v", n, v)
-			m[n] = v
-			nameMap[n] = true
-		}
-		records = append(records, m)
+		items = append(items, r)
 	}
-	fields := make([]string, 0)
-	for n := range nameMap {
-		fields = append(fields, n)
+	records, fields, err := parseRecords(session, params.Table, items, true)
+	if err != nil {
+		return nil, err
 	}
-	list := make([][]any, 0)
-	for _, r := range records {
-		subList := make([]any, 0)
-		m := r.(map[string]any)
-		for _, n := range fields {
-			subList = append(subList, m[n])
-		}
-		list = append(list, subList)
+	list := recordValues(records, fields)
+	h, err := newRecordHistory(session, params.Table)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	if params.OnConflict.Or(api.InsertRecordOnConflictError) != api.InsertRecordOnConflictError {
+		return insertUpsert(session, d, h, records, fields, list, params)
 	}
 	// list := [][]any{{vId1, "xxxxxx", 1}, {vId2, "yyywqwqwqw", 2}}
 	input := &common.Entries{Fields: fields,
 		Values: list}
+	var returning []string
 	if params.Returning.Set {
-		input.Returning = strings.Split(params.Returning.Value, ",")
+		returning = strings.Split(params.Returning.Value, ",")
+	}
+	input.Returning = returning
+	if h != nil {
+		input.Returning = h.returning(fields, returning)
 	}
 	retValue, err := d.Insert(params.Table, input)
 	if err != nil {
 		log.Log.Debugf("Error: %v", err)
 		return nil, err
 	}
+	if h != nil {
+		after, err := h.inserted(d, records, input.Returning, retValue)
+		if err != nil {
+			return nil, errorrepo.NewError("REST00066", params.Table, err)
+		}
+		if err = h.store(session, after); err != nil {
+			return nil, err
+		}
+	}
 
 	resp := api.Response{NrRecords: api.NewOptInt(len(records))}
-	if len(input.Returning) > 0 {
+	if len(returning) > 0 {
 		log.Log.Debugf("Returning value: %v", retValue)
 		data := make([]api.ResponseRecordsItem, 0)
 		for _, r := range retValue {
 			d := make(api.ResponseRecordsItem)
-			for x, field := range input.Returning {
+			for x, field := range returning {
 				convertTypeToRaw(d, field, r[x])
 			}
 			data = append(data, d)
@@ -111,6 +111,68 @@ func (Handler) InsertRecord(ctx context.Context, req api.OptInsertRecordReq, par
 	return respH, nil
 }
 
+// recordValues values of the records in the order of the fields
+func recordValues(records []any, fields []string) [][]any {
+	list := make([][]any, 0, len(records))
+	for _, r := range records {
+		subList := make([]any, 0, len(fields))
+		m := r.(map[string]any)
+		for _, n := range fields {
+			subList = append(subList, m[n])
+		}
+		list = append(list, subList)
+	}
+	return list
+}
+
+// insertUpsert insert records with conflict handling and report the number
+// of inserted and updated records
+func insertUpsert(session *clu.Context, d common.RegDbID, h *recordHistory, records []any, fields []string,
+	list [][]any, params api.InsertRecordParams) (api.InsertRecordRes, error) {
+	u, err := newUpsert(session, params.Table, fields, params)
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+	if h != nil {
+		// records without key fields are only recorded as inserted
+		if search, err := keySearch(d, params.Table, records, h.keys); err == nil {
+			if err = h.capture(d, search); err != nil {
+				return nil, err
+			}
+		}
+	}
+	err = u.execute(d, list)
+	if err != nil {
+		log.Log.Debugf("Error upsert: %v", err)
+		return nil, err
+	}
+	purgeImages(params.Table)
+	if h != nil {
+		after, err := h.inserted(d, records, u.returning, u.returned)
+		if err != nil {
+			return nil, errorrepo.NewError("REST00066", params.Table, err)
+		}
+		if err = h.store(session, after); err != nil {
+			return nil, err
+		}
+	}
+	resp := api.Response{NrRecords: api.NewOptInt(len(list)),
+		NrInserted: api.NewOptInt(u.inserted), NrUpdated: api.NewOptInt(u.updated)}
+	if len(u.returning) > 0 {
+		data := make([]api.ResponseRecordsItem, 0)
+		for _, r := range u.returned {
+			d := make(api.ResponseRecordsItem)
+			for x, field := range u.returning {
+				convertTypeToRaw(d, strings.ToLower(field), r[x])
+			}
+			data = append(data, d)
+		}
+		resp.Records = data
+	}
+	log.Log.Debugf("Upsert %s inserted=%d updated=%d", params.Table, u.inserted, u.updated)
+	return &api.ResponseHeaders{Response: resp, XToken: api.NewOptString(session.Token)}, nil
+}
+
 func parseJx(v jx.Raw) (any, error) {
 	d := jx.DecodeBytes(v)
 	switch v.Type() {
@@ -181,6 +243,10 @@ func (Handler) DeleteRecordsSearched(ctx context.Context, params api.DeleteRecor
 	if !Validate(session, auth.UserRole, params.Table) {
 		return &api.DeleteRecordsSearchedForbidden{}, nil
 	}
+	if isRawSearch(params.Search) && !Validate(session, auth.UserRole, rawSearchPrefix+params.Table) {
+		log.Log.Debugf("Raw search not permitted for %s", params.Table)
+		return &api.DeleteRecordsSearchedForbidden{}, nil
+	}
 	log.Log.Debugf("SQL search fields %s - %v", params.Table, params.Search)
 	d, err := ConnectTable(session, params.Table)
 	if err != nil {
@@ -188,11 +254,70 @@ func (Handler) DeleteRecordsSearched(ctx context.Context, params api.DeleteRecor
 		return nil, err
 	}
 	defer CloseTable(d)
-	dr, err := d.Delete(params.Table, &common.Entries{Criteria: params.Search})
+	search, err := compileSearch(d, params.Table, params.Search)
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+	sg, err := newSafeguard(session, params.Table, "delete", params.DryRun, params.Confirm, search)
 	if err != nil {
-		log.Log.Errorf("Error delete search %s->%s:%v", params.Table, params.Search, err)
 		return nil, err
 	}
+	h, err := newRecordHistory(session, params.Table)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	if h != nil && sg.limit > 0 && !params.DryRun.Value {
+		// a refused delete must not read all matching records into the history
+		n, err := countRecords(d, params.Table, &common.Query{TableName: params.Table, Search: search})
+		if err != nil {
+			return nil, err
+		}
+		if !sg.allowed(n) {
+			resp := sg.response(n, nil)
+			return &resp, nil
+		}
+	}
+	if h != nil && !params.DryRun.Value {
+		if err = h.capture(d, search); err != nil {
+			log.Log.Errorf("Error reading deleted records of %s: %v", params.Table, err)
+			return nil, err
+		}
+	}
+	var dr int64
+	committed := false
+	if sg.active() {
+		sample, err := sg.sample(d, search, params.Sample.Value)
+		if err != nil {
+			log.Log.Errorf("Error sample search %s->%s:%v", params.Table, params.Search, err)
+			return nil, err
+		}
+		dr, committed, err = sg.run(d, true, func() (int64, error) {
+			return d.Delete(params.Table, &common.Entries{Criteria: search})
+		})
+		if err != nil {
+			return nil, repoBadRequestError(err)
+		}
+		if params.DryRun.Value {
+			return &api.ResponseHeaders{Response: sg.response(dr, sample), XToken: api.NewOptString(session.Token)}, nil
+		}
+		if !committed {
+			resp := sg.response(dr, nil)
+			return &resp, nil
+		}
+	}
+	if !committed {
+		dr, err = d.Delete(params.Table, &common.Entries{Criteria: search})
+		if err != nil {
+			log.Log.Errorf("Error delete search %s->%s:%v", params.Table, params.Search, err)
+			return nil, err
+		}
+	}
+	purgeImages(params.Table)
+	if h != nil {
+		if err = h.store(session, nil); err != nil {
+			return nil, err
+		}
+	}
 	log.Log.Errorf("%d Data record deleted from %s: %s", dr, params.Table, params.Search)
 	resp := api.Response{NrRecords: api.NewOptInt(int(dr))}
 	respH := &api.ResponseHeaders{Response: resp, XToken: api.NewOptString(session.Token)}
@@ -220,55 +345,159 @@ func (Handler) UpdateRecordsByFields(ctx context.Context, req api.OptUpdateRecor
 	}
 	defer CloseTable(d)
 
-	records := make([]any, 0)
-	nameMap := make(map[string]bool)
+	items := make([]map[string]jx.Raw, 0, len(req.Value.Records))
 	for _, r := range req.Value.Records {
-		m := make(map[string]any)
-		for n, v := range r {
-			v, err := parseJx(v)
-			if err != nil {
-				log.Log.Debugf("Error JSON parser %s: %v", n, err)
-				return nil, errorrepo.NewError("RERR00015", n, err)
-			}
-			log.Log.Debugf("[%s]=%v", n, v)
-			m[n] = v
-			nameMap[n] = true
-		}
-		records = append(records, m)
-	}
-	fields := make([]string, 0)
-	for n := range nameMap {
-		fields = append(fields, n)
+		items = append(items, r)
 	}
-	list := make([][]any, 0)
-	for _, r := range records {
-		subList := make([]any, 0)
-		m := r.(map[string]any)
-		for _, n := range fields {
-			subList = append(subList, m[n])
-		}
-		list = append(list, subList)
+	records, fields, err := parseRecords(session, params.Table, items, false)
+	if err != nil {
+		return nil, err
 	}
+	list := recordValues(records, fields)
 	updateFields := strings.Split(params.Search, ",")
+	v, err := tableVersion(session, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil && params.IfMatch.Set {
+		return nil, NewBadRequestError(errorrepo.NewError("REST00029", params.Table))
+	}
 	input := &common.Entries{Fields: fields,
 		Update: updateFields,
 		Values: list}
-	_, uNr, err := d.Update(params.Table, input)
+	sg, err := newSafeguard(session, params.Table, "update", params.DryRun, params.Confirm, params.Search, records)
 	if err != nil {
-		log.Log.Debugf("Error: %v", err)
 		return nil, err
 	}
+	h, err := newRecordHistory(session, params.Table)
+	if err != nil {
+		return nil, repoBadRequestError(err)
+	}
+	var search string
+	if h != nil && !params.DryRun.Value {
+		search, err = keySearch(d, params.Table, records, updateFields)
+		if err != nil {
+			return nil, repoBadRequestError(err)
+		}
+		if err = h.capture(d, search); err != nil {
+			log.Log.Errorf("Error reading updated records of %s: %v", params.Table, err)
+			return nil, err
+		}
+	}
+	var uNr int64
+	committed := false
+	if sg.active() {
+		var sample []api.ResponseRecordsItem
+		if params.DryRun.Value && params.Sample.Value > 0 {
+			search, err := keySearch(d, params.Table, records, updateFields)
+			if err != nil {
+				return nil, NewBadRequestError(err)
+			}
+			sample, err = sg.sample(d, search, params.Sample.Value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		// versioned updates are counted only and done afterwards
+		uNr, committed, err = sg.run(d, v == nil, func() (int64, error) {
+			_, n, err := d.Update(params.Table, input)
+			return n, err
+		})
+		if err != nil {
+			return nil, repoBadRequestError(err)
+		}
+		if params.DryRun.Value {
+			return &api.ResponseHeaders{Response: sg.response(uNr, sample), XToken: api.NewOptString(session.Token)}, nil
+		}
+		if !sg.allowed(uNr) {
+			resp := api.UpdateRecordsByFieldsConflict(sg.response(uNr, nil))
+			return &resp, nil
+		}
+	}
+	if v != nil {
+		res, err := updateVersioned(session, d, v, records, updateFields, params.IfMatch)
+		if _, ok := res.(*api.ResponseHeaders); ok {
+			purgeImages(params.Table)
+			if h != nil {
+				if herr := h.changed(session, d, search); herr != nil {
+					return nil, herr
+				}
+			}
+		}
+		return res, err
+	}
+	if !committed {
+		_, uNr, err = d.Update(params.Table, input)
+		if err != nil {
+			log.Log.Debugf("Error: %v", err)
+			return nil, err
+		}
+	}
+	purgeImages(params.Table)
+	if h != nil {
+		if err = h.changed(session, d, search); err != nil {
+			return nil, err
+		}
+	}
 	resp := api.Response{NrRecords: api.NewOptInt(int(uNr))}
 	respH := &api.ResponseHeaders{Response: resp, XToken: api.NewOptString(session.Token)}
 	log.Log.Debugf("Return Update records for fields %s -> %s", session.User, params.Table)
 	return respH, nil
 }
 
-// UpdateLobByMap implements updateLobByMap operation.
-//
-// Set a lob at a specific ISN of an field in a Map.
-//
-// PUT /binary/{table}/{field}/{search}
-func (Handler) UpdateLobByMap(ctx context.Context, req api.UpdateLobByMapReq, params api.UpdateLobByMapParams) (r api.UpdateLobByMapRes, _ error) {
-	return r, ht.ErrNotImplemented
+// updateVersioned update records of a table with version column. The
+// expected version is taken out of the If-Match header, the ETag field or
+// the version field of the record.
+func updateVersioned(session *clu.Context, d common.RegDbID, v *recordVersion, records []any,
+	keys []string, ifMatch api.OptString) (api.UpdateRecordsByFieldsRes, error) {
+	if ifMatch.Set && len(records) != 1 {
+		return nil, NewBadRequestError(errorrepo.NewError("REST00030"))
+	}
+	list := make([]map[string]any, 0, len(records))
+	expected := make([]any, 0, len(records))
+	for _, r := range records {
+		m := r.(map[string]any)
+		e, err := v.expected(m, ifMatch)
+		if err != nil {
+			return nil, NewBadRequestError(err)
+		}
+		list = append(list, m)
+		expected = append(expected, e)
+	}
+	versions, conflict, err := v.update(d, list, keys, expected)
+	if err != nil {
+		if _, ok := err.(*errorrepo.Error); ok {
+			return nil, NewBadRequestError(err)
+		}
+		log.Log.Debugf("Error versioned update: %v", err)
+		return nil, err
+	}
+	if conflict != nil {
+		log.Log.Debugf("Version conflict on table %s", v.table)
+		current, err := v.current(d, keys, conflict)
+		if err != nil {
+			return nil, err
+		}
+		return &api.UpdateRecordsByFieldsPreconditionFailed{Records: current, NrRecords: api.NewOptInt(len(current)),
+			MapName: api.NewOptString(v.table)}, nil
+	}
+	data := make([]api.ResponseRecordsItem, 0, len(list))
+	nr := 0
+	for i, m := range list {
+		if versions[i] == nil {
+			continue
+		}
+		nr++
+		item := make(api.ResponseRecordsItem)
+		for _, k := range keys {
+			if x, ok := findField(m, strings.TrimSpace(k)); ok {
+				convertTypeToRaw(item, strings.ToLower(strings.TrimSpace(k)), x)
+			}
+		}
+		v.addETag(item, []string{v.column}, []any{versions[i]})
+		convertTypeToRaw(item, strings.ToLower(v.column), versions[i])
+		data = append(data, item)
+	}
+	resp := api.Response{NrRecords: api.NewOptInt(nr), Records: data}
+	return &api.ResponseHeaders{Response: resp, XToken: api.NewOptString(session.Token)}, nil
 }
@@ -41,6 +41,15 @@ func InitDatabaseStores() error {
 			}
 		}
 	}
+	dm = clu.Viewer.Database.History
+	if dm != nil {
+		r, err := dm.Handles()
+		if err == nil {
+			clu.InitHistory(r, os.ExpandEnv(dm.Password), os.ExpandEnv(dm.Table), dm.Tables)
+		} else {
+			log.Fatal("history store not being able to start:", err)
+		}
+	}
 	go clu.InitBatchWatcherThread()
 
 	return nil
@@ -13,16 +13,12 @@ package server
 
 import (
 	"bytes"
-	"fmt"
 	"image/jpeg"
 	"io"
 	"os"
 	"strings"
 	"time"
 
-	"github.com/kovidgoyal/imaging"
-	"github.com/rwcarlsen/goexif/exif"
-	"github.com/rwcarlsen/goexif/tiff"
 	"github.com/tknie/clu"
 	"github.com/tknie/errorrepo"
 	"github.com/tknie/flynn/common"
@@ -59,6 +55,10 @@ func (read *StreamRead) initStreamFromTable(srvctx *clu.Context, search, destMim
 	}
 	defer CloseTable(d)
 
+	search, err = compileSearch(d, read.table, search)
+	if err != nil {
+		return NewBadRequestError(err)
+	}
 	log.Log.Debugf("Init stream for table %s and search %s for field %s dest mimetype=%s", read.table, search, read.field, destMimeType)
 
 	fields := []string{strings.ToLower(read.field)}
@@ -222,44 +222,10 @@ func (read *StreamRead) convertMimeType(destMimeType string) error {
 		log.Log.Debugf("Check destination mimetype")
 		switch strings.ToLower(read.mimetype) {
 		case "image/heic":
-			r := bytes.NewBuffer(read.data)
-			srcImage, err := heifdecoder(r)
-			if err != nil {
-				log.Log.Debugf("Decode image for conversion error %v", err)
-				return err
-			}
-			ra := bytes.NewReader(read.data)
-			exifData, err := heifextractor(ra)
+			srcImage, err := decodeHeif(read.data)
 			if err != nil {
-				log.Log.Debugf("Extract exif error %v", err)
 				return err
 			}
-			x, err := exif.Decode(bytes.NewBuffer(exifData))
-			if err == nil {
-				log.Log.Debugf("Decode exif in image")
-				t, err := x.Get(exif.Orientation)
-				if err == nil {
-					switch t.String() {
-					case "1":
-					case "2":
-						srcImage = imaging.FlipV(srcImage)
-					case "3":
-						srcImage = imaging.Rotate180(srcImage)
-					case "4":
-						srcImage = imaging.Rotate180(imaging.FlipV(srcImage))
-					case "5":
-						srcImage = imaging.Rotate270(imaging.FlipV(srcImage))
-					case "6":
-						srcImage = imaging.Rotate270(srcImage)
-					case "7":
-						srcImage = imaging.Rotate90(imaging.FlipV(srcImage))
-					case "8":
-						srcImage = imaging.Rotate90(srcImage)
-					}
-				}
-			} else {
-				log.Log.Debugf("Decode exif in image error %v", err)
-			}
 			buf := new(bytes.Buffer)
 			err = jpeg.Encode(buf, srcImage, nil)
 			if err != nil {
@@ -267,6 +233,7 @@ func (read *StreamRead) convertMimeType(destMimeType string) error {
 				return err
 			}
 			read.data = buf.Bytes()
+			read.mimetype = "image/jpeg"
 		case "image/jpeg", "image/jpg", "image/gif":
 		default:
 			log.Log.Debugf("No convert available -> %s", read.mimetype)
@@ -275,13 +242,3 @@ func (read *StreamRead) convertMimeType(destMimeType string) error {
 	}
 	return nil
 }
-
-// Printer temporary
-type Printer struct {
-}
-
-// Walk walk in printer
-func (p *Printer) Walk(name exif.FieldName, tag *tiff.Tag) error {
-	fmt.Printf("%s: %s\n", name, tag)
-	return nil
-}
@@ -15,7 +15,10 @@ import (
 	"context"
 
 	ht "github.com/ogen-go/ogen/http"
+	"github.com/tknie/clu"
 	"github.com/tknie/clu/api"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
 )
 
 // GetFields implements getFields operation.
@@ -24,7 +27,16 @@ import (
 //
 // GET /rest/tables/{table}/fields
 func (Handler) GetFields(ctx context.Context, params api.GetFieldsParams) (r api.GetFieldsRes, _ error) {
-	return r, ht.ErrNotImplemented
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.GetFieldsForbidden{}, nil
+	}
+	m, err := tableMetadata(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error reading fields of table %s:%v", params.Table, err)
+		return nil, err
+	}
+	return &api.TableMetadataHeaders{Response: *m, XToken: api.NewOptString(session.Token)}, nil
 }
 
 // GetMapMetadata implements getMapMetadata operation.
@@ -33,7 +45,16 @@ func (Handler) GetFields(ctx context.Context, params api.GetFieldsParams) (r api
 //
 // GET /rest/metadata/view/{table}
 func (Handler) GetMapMetadata(ctx context.Context, params api.GetMapMetadataParams) (r api.GetMapMetadataRes, _ error) {
-	return r, ht.ErrNotImplemented
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, params.Table) {
+		return &api.GetMapMetadataForbidden{}, nil
+	}
+	m, err := tableMetadata(session, params.Table)
+	if err != nil {
+		log.Log.Errorf("Error reading metadata of table %s:%v", params.Table, err)
+		return nil, err
+	}
+	return &api.TableMetadataHeaders{Response: *m, XToken: api.NewOptString(session.Token)}, nil
 }
 
 // InsertMapFileRecords implements insertMapFileRecords operation.
@@ -51,5 +72,9 @@ func (Handler) InsertMapFileRecords(ctx context.Context, req api.OptInsertMapFil
 //
 // GET /rest/tables
 func (Handler) ListTables(ctx context.Context) (r api.ListTablesRes, _ error) {
-	return r, ht.ErrNotImplemented
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, "*Maps") {
+		return &api.ListTablesForbidden{}, nil
+	}
+	return tablesMetadata(session), nil
 }
@@ -0,0 +1,432 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/jx"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
+)
+
+// referenceRegexp references a returned field of a previous operation,
+// like ${0.id} or ${0.2.id}
+var referenceRegexp = regexp.MustCompile(`^\$\{(\d+)\.(?:(\d+)\.)?([A-Za-z_][A-Za-z0-9_]*)\}$`)
+
+// transaction state of the operations executed in one transaction
+type transaction struct {
+	session *clu.Context
+	d       common.RegDbID
+	dryRun  bool
+	// returned values of each operation, per record the field values
+	returned  [][]map[string]any
+	results   []api.TransactionOperationResult
+	histories []transactionHistory
+}
+
+// transactionHistory history of one operation, stored after the commit
+// with the records matching the search
+type transactionHistory struct {
+	h      *recordHistory
+	search string
+}
+
+// ExecuteTransaction implements executeTransaction operation.
+//
+// Execute a list of insert, update and delete operations on tables of one
+// database in one transaction.
+//
+// POST /rest/transaction
+func (Handler) ExecuteTransaction(ctx context.Context, req *api.Transaction) (r api.ExecuteTransactionRes, _ error) {
+	session := ctx.(*clu.Context)
+	if len(req.Operations) == 0 {
+		return nil, NewBadRequestError(errorrepo.NewError("REST00021"))
+	}
+	for _, op := range req.Operations {
+		if !Validate(session, auth.UserRole, op.Table) {
+			return &api.ExecuteTransactionForbidden{}, nil
+		}
+		if op.Action == api.TransactionOperationActionDelete && isRawSearch(op.Search.Value) &&
+			!Validate(session, auth.UserRole, rawSearchPrefix+op.Table) {
+			log.Log.Debugf("Raw search not permitted for %s", op.Table)
+			return &api.ExecuteTransactionForbidden{}, nil
+		}
+	}
+	first, err := clu.SearchTable(req.Operations[0].Table)
+	if err != nil {
+		return nil, NewBadRequestError(err)
+	}
+	for _, op := range req.Operations[1:] {
+		entry, err := clu.SearchTable(op.Table)
+		if err != nil {
+			return nil, NewBadRequestError(err)
+		}
+		if entry.Reference != first.Reference {
+			return nil, NewBadRequestError(errorrepo.NewError("REST00022", op.Table, req.Operations[0].Table))
+		}
+	}
+	if first.Reference.Driver == common.AdabasType {
+		return nil, NewBadRequestError(errorrepo.NewError("REST00023", first.Reference.Driver.String()))
+	}
+	log.Log.Debugf("Transaction with %d operations", len(req.Operations))
+	d, err := ConnectTable(session, req.Operations[0].Table)
+	if err != nil {
+		log.Log.Errorf("Error connect table %s:%v", req.Operations[0].Table, err)
+		return nil, err
+	}
+	defer CloseTable(d)
+
+	err = d.BeginTransaction()
+	if err != nil {
+		log.Log.Errorf("Error begin transaction: %v", err)
+		return nil, err
+	}
+	tr := &transaction{session: session, d: d, dryRun: req.DryRun.Value,
+		results: make([]api.TransactionOperationResult, 0)}
+	for i, op := range req.Operations {
+		err = tr.execute(i, &op)
+		if err != nil {
+			log.Log.Errorf("Transaction operation %d on %s failed: %v", i, op.Table, err)
+			if rerr := d.Rollback(); rerr != nil {
+				log.Log.Errorf("Error rollback transaction: %v", rerr)
+			}
+			resp := api.TransactionResult{Committed: api.NewOptBool(false),
+				FailedOperation: api.NewOptInt(i), Error: api.NewOptError(*transactionError(err)),
+				Results: tr.results}
+			return &api.ExecuteTransactionUnprocessableEntity{Response: resp, XToken: api.NewOptString(session.Token)}, nil
+		}
+	}
+	if tr.dryRun {
+		if err = d.Rollback(); err != nil {
+			log.Log.Errorf("Error rollback transaction: %v", err)
+			return nil, err
+		}
+		resp := api.TransactionResult{Committed: api.NewOptBool(false), DryRun: api.NewOptBool(true),
+			Results: tr.results}
+		return &api.ExecuteTransactionOK{Response: resp, XToken: api.NewOptString(session.Token)}, nil
+	}
+	err = d.Commit()
+	if err != nil {
+		log.Log.Errorf("Error commit transaction: %v", err)
+		resp := api.TransactionResult{Committed: api.NewOptBool(false),
+			Error: api.NewOptError(*transactionError(err)), Results: tr.results}
+		return &api.ExecuteTransactionUnprocessableEntity{Response: resp, XToken: api.NewOptString(session.Token)}, nil
+	}
+	for _, op := range req.Operations {
+		purgeImages(op.Table)
+	}
+	for _, th := range tr.histories {
+		if err = th.h.changed(session, d, th.search); err != nil {
+			return nil, err
+		}
+	}
+	resp := api.TransactionResult{Committed: api.NewOptBool(true), Results: tr.results}
+	return &api.ExecuteTransactionOK{Response: resp, XToken: api.NewOptString(session.Token)}, nil
+}
+
+// transactionError API error of the failed operation
+func transactionError(err error) *api.Error {
+	switch e := err.(type) {
+	case *errorrepo.Error:
+		return NewAPIError(e.ID(), e)
+	case *api.ErrorStatusCode:
+		return &e.Response
+	default:
+	}
+	return NewAPIError("DBERR", err)
+}
+
+// execute execute one operation inside the transaction
+func (tr *transaction) execute(index int, op *api.TransactionOperation) error {
+	log.Log.Debugf("Transaction operation %d: %s on %s", index, op.Action, op.Table)
+	result := api.TransactionOperationResult{Action: api.NewOptString(string(op.Action)),
+		Table: api.NewOptString(op.Table)}
+	returned := make([]map[string]any, 0)
+	var returning []string
+	if op.Returning.Value != "" {
+		returning = strings.Split(op.Returning.Value, ",")
+	}
+	switch op.Action {
+	case api.TransactionOperationActionInsert, api.TransactionOperationActionUpdate:
+		if len(op.Records) == 0 {
+			return errorrepo.NewError("REST00024", index, "records missing")
+		}
+		items, err := tr.records(op.Records)
+		if err != nil {
+			return err
+		}
+		insert := op.Action == api.TransactionOperationActionInsert
+		records, fields, err := parseRecords(tr.session, op.Table, items, insert)
+		if err != nil {
+			return err
+		}
+		h, err := tr.history(op.Table)
+		if err != nil {
+			return err
+		}
+		input := &common.Entries{Fields: fields, Values: recordValues(records, fields), Returning: returning}
+		var retValue [][]any
+		nr := int64(len(records))
+		if insert {
+			if h != nil {
+				input.Returning = h.returning(fields, returning)
+			}
+			retValue, err = tr.d.Insert(op.Table, input)
+			if err == nil && h != nil {
+				var search string
+				search, err = h.insertedSearch(tr.d, records, input.Returning, retValue)
+				tr.histories = append(tr.histories, transactionHistory{h: h, search: search})
+			}
+		} else {
+			if op.Update.Value == "" {
+				return errorrepo.NewError("REST00024", index, "update fields missing")
+			}
+			input.Update = strings.Split(op.Update.Value, ",")
+			if h != nil {
+				search, err := keySearch(tr.d, op.Table, records, input.Update)
+				if err != nil {
+					return err
+				}
+				if err = h.capture(tr.d, search); err != nil {
+					return err
+				}
+				tr.histories = append(tr.histories, transactionHistory{h: h, search: search})
+			}
+			var v *recordVersion
+			v, err = tableVersion(tr.session, op.Table)
+			switch {
+			case err != nil:
+			case v != nil:
+				nr, err = tr.updateVersioned(v, op, records, input.Update, &result, &returned)
+			case op.IfMatch.Set:
+				err = errorrepo.NewError("REST00029", op.Table)
+			default:
+				retValue, nr, err = tr.d.Update(op.Table, input)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if !insert {
+			if err = tr.guard(op, &result, nr, op.Update.Value, op.Records); err != nil {
+				return err
+			}
+		}
+		result.NrRecords = api.NewOptInt(int(nr))
+		for _, rv := range retValue {
+			item := make(api.TransactionOperationResultRecordsItem)
+			values := make(map[string]any)
+			for x, field := range returning {
+				if x < len(rv) {
+					convertTypeToRaw(api.ResponseRecordsItem(item), field, rv[x])
+					values[strings.ToLower(field)] = rv[x]
+				}
+			}
+			result.Records = append(result.Records, item)
+			returned = append(returned, values)
+		}
+	case api.TransactionOperationActionDelete:
+		if op.Search.Value == "" {
+			return errorrepo.NewError("REST00024", index, "search missing")
+		}
+		search, err := compileSearch(tr.d, op.Table, op.Search.Value)
+		if err != nil {
+			return err
+		}
+		h, err := tr.history(op.Table)
+		if err != nil {
+			return err
+		}
+		if h != nil {
+			// a refused delete must not read all matching records into the history
+			n, err := countRecords(tr.d, op.Table, &common.Query{TableName: op.Table, Search: search})
+			if err != nil {
+				return err
+			}
+			if err = tr.guard(op, &result, n, search); err != nil {
+				return err
+			}
+			if err = h.capture(tr.d, search); err != nil {
+				return err
+			}
+			tr.histories = append(tr.histories, transactionHistory{h: h})
+		}
+		nr, err := tr.d.Delete(op.Table, &common.Entries{Criteria: search})
+		if err != nil {
+			return err
+		}
+		if err = tr.guard(op, &result, nr, search); err != nil {
+			return err
+		}
+		result.NrRecords = api.NewOptInt(int(nr))
+	default:
+		return errorrepo.NewError("REST00024", index, "unknown action "+string(op.Action))
+	}
+	tr.results = append(tr.results, result)
+	tr.returned = append(tr.returned, returned)
+	return nil
+}
+
+// history history of the changed table, nil if the table has no change
+// history or on a dry run. The before images are read outside of the
+// transaction on MySQL, the history is stored after the commit.
+func (tr *transaction) history(table string) (*recordHistory, error) {
+	if tr.dryRun {
+		return nil, nil
+	}
+	return newRecordHistory(tr.session, table)
+}
+
+// guard check the number of affected records of an update or delete against
+// the limit of the table like on a single request. A dry run returns the
+// confirm token of the operation.
+func (tr *transaction) guard(op *api.TransactionOperation, result *api.TransactionOperationResult,
+	n int64, criteria ...any) error {
+	sg, err := newSafeguard(tr.session, op.Table, string(op.Action), api.NewOptBool(tr.dryRun), op.Confirm, criteria...)
+	if err != nil {
+		return err
+	}
+	if sg.limit > 0 {
+		result.MaxAffectedRows = api.NewOptInt(sg.limit)
+	}
+	if tr.dryRun {
+		result.ConfirmToken = api.NewOptString(sg.token(n))
+		return nil
+	}
+	if !sg.allowed(n) {
+		return errorrepo.NewError("REST00064", string(op.Action), n, op.Table, sg.limit)
+	}
+	return nil
+}
+
+// updateVersioned update the records of a versioned table inside the
+// transaction. The version is checked and increased like on a single update
+// request. The bound update statement runs on the connection of the
+// transaction, which is only accessible on Postgres.
+func (tr *transaction) updateVersioned(v *recordVersion, op *api.TransactionOperation, records []any,
+	keys []string, result *api.TransactionOperationResult, returned *[]map[string]any) (int64, error) {
+	if v.driver != common.PostgresType {
+		return 0, errorrepo.NewError("REST00033", v.driver.String())
+	}
+	if op.IfMatch.Set && len(records) != 1 {
+		return 0, errorrepo.NewError("REST00030")
+	}
+	dbOpen, err := tr.d.Open()
+	if err != nil {
+		return 0, err
+	}
+	conn, ok := dbOpen.(*pgxpool.Conn)
+	if !ok {
+		return 0, errorrepo.NewError("REST00033", v.driver.String())
+	}
+	exec := v.pgxExec(context.Background(), conn)
+	nr := int64(0)
+	for _, r := range records {
+		m := r.(map[string]any)
+		_, keyValues, err := v.where(m, keys, 0)
+		if err != nil {
+			return 0, err
+		}
+		expected, err := v.expected(m, op.IfMatch)
+		if err != nil {
+			return 0, err
+		}
+		version, err := exec(m, keys, expected)
+		if err != nil {
+			return 0, err
+		}
+		if version == nil {
+			if expected != nil {
+				return 0, errorrepo.NewError("REST00063", keyValues, op.Table)
+			}
+			continue
+		}
+		nr++
+		item := make(api.ResponseRecordsItem)
+		values := make(map[string]any)
+		for _, k := range keys {
+			k = strings.TrimSpace(k)
+			if x, ok := findField(m, k); ok {
+				convertTypeToRaw(item, strings.ToLower(k), x)
+				values[strings.ToLower(k)] = x
+			}
+		}
+		v.addETag(item, []string{v.column}, []any{version})
+		convertTypeToRaw(item, strings.ToLower(v.column), version)
+		values[strings.ToLower(v.column)] = version
+		result.Records = append(result.Records, api.TransactionOperationResultRecordsItem(item))
+		*returned = append(*returned, values)
+	}
+	return nr, nil
+}
+
+// records resolve the references to returned values of previous operations.
+// The records are converted afterwards like the records of a single insert
+// or update request.
+func (tr *transaction) records(records []api.TransactionOperationRecordsItem) ([]map[string]jx.Raw, error) {
+	items := make([]map[string]jx.Raw, 0, len(records))
+	for _, r := range records {
+		m := make(map[string]jx.Raw, len(r))
+		for n, raw := range r {
+			v, err := tr.value(raw)
+			if err != nil {
+				log.Log.Debugf("Error resolve reference %s: %v", n, err)
+				return nil, err
+			}
+			m[n] = v
+		}
+		items = append(items, m)
+	}
+	return items, nil
+}
+
+// value resolve a reference to a returned value into its JSON value, other
+// values are returned unchanged
+func (tr *transaction) value(raw jx.Raw) (jx.Raw, error) {
+	if raw.Type() != jx.String {
+		return raw, nil
+	}
+	s, err := jx.DecodeBytes(raw).Str()
+	if err != nil {
+		return nil, errorrepo.NewError("RERR00015", "<value>", err)
+	}
+	sm := referenceRegexp.FindStringSubmatch(s)
+	if sm == nil {
+		return raw, nil
+	}
+	op, _ := strconv.Atoi(sm[1])
+	rec := 0
+	if sm[2] != "" {
+		rec, _ = strconv.Atoi(sm[2])
+	}
+	if op >= len(tr.returned) || rec >= len(tr.returned[op]) {
+		return nil, errorrepo.NewError("REST00025", s)
+	}
+	v, ok := tr.returned[op][rec][strings.ToLower(sm[3])]
+	if !ok {
+		return nil, errorrepo.NewError("REST00025", s)
+	}
+	e := &jx.Encoder{}
+	if err := encodeValue(e, v); err != nil {
+		return nil, errorrepo.NewError("RERR00015", "<value>", err)
+	}
+	return jx.Raw(e.Bytes()), nil
+}
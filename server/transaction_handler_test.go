@@ -0,0 +1,40 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+)
+
+func TestTransactionReference(t *testing.T) {
+	tr := &transaction{returned: [][]map[string]any{{{"id": int64(42)},
+		{"id": int64(43), "created": time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}}}}
+	items, err := tr.records([]api.TransactionOperationRecordsItem{{
+		"parent": jx.Raw(`"${0.id}"`), "second": jx.Raw(`"${0.1.id}"`),
+		"created": jx.Raw(`"${0.1.created}"`), "name": jx.Raw(`"${x}"`), "nr": jx.Raw(`7`)}})
+	if assert.NoError(t, err) && assert.Len(t, items, 1) {
+		assert.Equal(t, "42", items[0]["parent"].String())
+		assert.Equal(t, "43", items[0]["second"].String())
+		assert.Equal(t, `"2024-01-02T03:04:05Z"`, items[0]["created"].String())
+		assert.Equal(t, `"${x}"`, items[0]["name"].String())
+		assert.Equal(t, "7", items[0]["nr"].String())
+	}
+	for _, ref := range []string{`"${1.id}"`, `"${0.2.id}"`, `"${0.name}"`} {
+		_, err = tr.value(jx.Raw(ref))
+		assert.Equal(t, "REST00025", errorID(err), ref)
+	}
+}
@@ -0,0 +1,505 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/log"
+	"github.com/tknie/services/auth"
+)
+
+const (
+	// uploadDirectory directory of the partial uploads inside the location
+	uploadDirectory = ".uploads"
+	// defaultUploadExpiry partial uploads are removed after this duration
+	// if no uploadExpiry is configured
+	defaultUploadExpiry = 24 * time.Hour
+)
+
+// uploadIDRegexp valid upload identifier
+var uploadIDRegexp = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// uploadChecksums supported checksum algorithms
+var uploadChecksums = map[string]func() hash.Hash{
+	"sha256": sha256.New,
+	"sha1":   sha1.New,
+	"md5":    md5.New,
+}
+
+// uploadLocks uploads receiving data, a chunk is written by one request only
+var uploadLocks sync.Map
+
+// uploadSweep periodic removal of expired uploads, started only once
+var uploadSweep sync.Once
+
+// uploadInfo state of a resumable upload, stored beside the partial data
+// in the upload directory of the location
+type uploadInfo struct {
+	ID       string    `json:"id"`
+	Location string    `json:"location"`
+	File     string    `json:"file"`
+	Target   string    `json:"target"`
+	Length   int64     `json:"length"`
+	Checksum string    `json:"checksum,omitempty"`
+	User     string    `json:"user"`
+	Expires  time.Time `json:"expires"`
+	dir      string
+	offset   int64
+	complete bool
+}
+
+// uploadExpiry duration after which partial uploads are removed
+func uploadExpiry() time.Duration {
+	if clu.Viewer != nil && clu.Viewer.FileTransfer.UploadExpiry > 0 {
+		return clu.Viewer.FileTransfer.UploadExpiry
+	}
+	return defaultUploadExpiry
+}
+
+// uploadError API error of the upload
+func uploadError(err error) *api.Error {
+	e := NewBadRequestError(err).Response
+	return &e
+}
+
+// parseChecksum parse checksum header value like 'sha256 <base64>'
+func parseChecksum(value string) (hash.Hash, []byte, error) {
+	algorithm, encoded, ok := strings.Cut(strings.TrimSpace(value), " ")
+	newHash, found := uploadChecksums[strings.ToLower(algorithm)]
+	if !ok || !found {
+		return nil, nil, errorrepo.NewError("REST00124", value)
+	}
+	expected, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, nil, errorrepo.NewError("REST00124", value)
+	}
+	return newHash(), expected, nil
+}
+
+// uploadPath staging directory of the partial uploads of the location
+func uploadPath(d *clu.Directory) string {
+	return filepath.Join(os.ExpandEnv(d.Location), uploadDirectory)
+}
+
+// uploadTarget target file of the upload in the location. The file name
+// must not contain a path, the target need to be inside the location and
+// outside of the upload directory.
+func uploadTarget(d *clu.Directory, path, file string) (string, error) {
+	if strings.ContainsAny(file, `/\`) || strings.Contains(file, "..") {
+		return "", errorrepo.NewError("REST00126", path+file, d.Name)
+	}
+	location := filepath.Clean(os.ExpandEnv(d.Location))
+	target := filepath.Join(location, path+file)
+	rel, err := filepath.Rel(location, target)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) ||
+		rel == uploadDirectory || strings.HasPrefix(rel, uploadDirectory+string(filepath.Separator)) {
+		return "", errorrepo.NewError("REST00126", path+file, d.Name)
+	}
+	return target, nil
+}
+
+// newUpload create the upload of the file in the location. Expired uploads
+// of the location are removed.
+func newUpload(d *clu.Directory, path, file, user string, length int64, checksum string) (*uploadInfo, error) {
+	if checksum != "" {
+		if _, _, err := parseChecksum(checksum); err != nil {
+			return nil, err
+		}
+	}
+	target, err := uploadTarget(d, path, file)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(target); err == nil {
+		return nil, errorrepo.NewError("REST00125", path+file)
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	u := &uploadInfo{ID: hex.EncodeToString(id), Location: d.Name, File: filepath.Clean(path + file),
+		Target: target, Length: length, Checksum: checksum, User: user,
+		Expires: time.Now().Add(uploadExpiry()), dir: uploadPath(d)}
+	expireUploads(u.dir)
+	if err := os.MkdirAll(u.dir, 0o750); err != nil {
+		return nil, errorrepo.NewError("REST00116", u.dir, err)
+	}
+	f, err := os.OpenFile(u.partFile(), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return nil, errorrepo.NewError("REST00116", u.partFile(), err)
+	}
+	f.Close()
+	if err = u.store(); err != nil {
+		u.remove()
+		return nil, err
+	}
+	log.Log.Debugf("Created upload %s for %s with %d bytes", u.ID, u.Target, u.Length)
+	if u.Length == 0 {
+		err = u.finish()
+	}
+	return u, err
+}
+
+// loadUpload read the state of the upload out of the upload directories of
+// all locations. Expired uploads are removed.
+func loadUpload(id string) (*uploadInfo, error) {
+	if !uploadIDRegexp.MatchString(id) {
+		return nil, errorrepo.NewError("REST00119", id)
+	}
+	for _, d := range clu.Viewer.FileTransfer.Directories.Directory {
+		if d.Location == "" {
+			continue
+		}
+		dir := uploadPath(&d)
+		data, err := os.ReadFile(filepath.Join(dir, id+".json"))
+		if err != nil {
+			continue
+		}
+		u := &uploadInfo{dir: dir}
+		if err = json.Unmarshal(data, u); err != nil || u.ID != id {
+			log.Log.Errorf("Invalid upload %s in %s: %v", id, dir, err)
+			continue
+		}
+		if time.Now().After(u.Expires) {
+			log.Log.Debugf("Upload %s expired", id)
+			u.remove()
+			break
+		}
+		fi, err := os.Stat(u.partFile())
+		if err != nil {
+			break
+		}
+		u.offset = fi.Size()
+		return u, nil
+	}
+	return nil, errorrepo.NewError("REST00119", id)
+}
+
+// initUploadExpiry remove the expired uploads of all locations and start
+// the periodic removal. Uploads of locations without new uploads are
+// removed this way, too.
+func initUploadExpiry() {
+	expireAllUploads()
+	uploadSweep.Do(func() { go uploadExpiryThread() })
+}
+
+// uploadExpiryThread remove the expired uploads of all locations every hour
+func uploadExpiryThread() {
+	ticker := time.NewTicker(time.Hour)
+	for {
+		<-ticker.C
+		expireAllUploads()
+	}
+}
+
+// expireAllUploads remove the expired uploads of all locations
+func expireAllUploads() {
+	if clu.Viewer == nil {
+		return
+	}
+	for _, d := range clu.Viewer.FileTransfer.Directories.Directory {
+		if d.Location != "" {
+			expireUploads(uploadPath(&d))
+		}
+	}
+}
+
+// expireUploads remove the expired uploads of the upload directory
+func expireUploads(dir string) {
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			continue
+		}
+		u := &uploadInfo{dir: dir}
+		if json.Unmarshal(data, u) == nil && uploadIDRegexp.MatchString(u.ID) && time.Now().After(u.Expires) {
+			log.Log.Debugf("Remove expired upload %s", u.ID)
+			u.remove()
+		}
+	}
+}
+
+// partFile file containing the received data
+func (u *uploadInfo) partFile() string {
+	return filepath.Join(u.dir, u.ID+".part")
+}
+
+// store write the state of the upload
+func (u *uploadInfo) store() error {
+	data, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(u.dir, u.ID+".tmp")
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(u.dir, u.ID+".json"))
+}
+
+// remove remove the state and the received data of the upload
+func (u *uploadInfo) remove() {
+	os.Remove(u.partFile())
+	os.Remove(filepath.Join(u.dir, u.ID+".json"))
+}
+
+// status API state of the upload
+func (u *uploadInfo) status() api.UploadStatus {
+	s := api.UploadStatus{ID: api.NewOptString(u.ID), Location: api.NewOptString(u.Location),
+		File: api.NewOptString(u.File), Length: api.NewOptInt64(u.Length),
+		Offset: api.NewOptInt64(u.offset), Complete: api.NewOptBool(u.complete)}
+	if !u.complete {
+		s.Expires = api.NewOptDateTime(u.Expires)
+	}
+	return s
+}
+
+// write append the chunk at the offset. The chunk is removed again if it
+// exceeds the length or the checksum does not match. The file is moved to
+// the location if the upload is complete.
+func (u *uploadInfo) write(offset int64, r io.Reader, checksum string) error {
+	l, _ := uploadLocks.LoadOrStore(u.ID, &sync.Mutex{})
+	lock := l.(*sync.Mutex)
+	if !lock.TryLock() {
+		return errorrepo.NewError("REST00121", u.ID)
+	}
+	defer lock.Unlock()
+	// the offset may have changed before the lock was acquired
+	fi, err := os.Stat(u.partFile())
+	if err != nil {
+		return errorrepo.NewError("REST00119", u.ID)
+	}
+	u.offset = fi.Size()
+	if offset != u.offset {
+		return errorrepo.NewError("REST00120", offset, u.offset)
+	}
+	var h hash.Hash
+	var expected []byte
+	if checksum != "" {
+		if h, expected, err = parseChecksum(checksum); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(u.partFile(), os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return errorrepo.NewError("REST00116", u.partFile(), err)
+	}
+	defer f.Close()
+	w := io.Writer(f)
+	if h != nil {
+		w = io.MultiWriter(f, h)
+	}
+	remaining := u.Length - u.offset
+	n, err := io.CopyBuffer(w, io.LimitReader(r, remaining+1), make([]byte, blockSize))
+	switch {
+	case n > remaining:
+		err = errorrepo.NewError("REST00122", u.Length)
+	case err != nil:
+		err = errorrepo.NewError("REST00117", u.File, err)
+	case h != nil && !bytes.Equal(h.Sum(nil), expected):
+		err = errorrepo.NewError("REST00123", u.ID)
+	default:
+	}
+	if err != nil && (h != nil || n > remaining) {
+		// unverified data is not kept
+		if terr := f.Truncate(u.offset); terr != nil {
+			log.Log.Errorf("Error truncate upload %s: %v", u.ID, terr)
+		}
+		return err
+	}
+	u.offset += n
+	log.Log.Debugf("Upload %s received %d bytes at offset %d", u.ID, n, offset)
+	if err != nil {
+		return err
+	}
+	if u.offset == u.Length {
+		f.Close()
+		return u.finish()
+	}
+	u.Expires = time.Now().Add(uploadExpiry())
+	return u.store()
+}
+
+// finish verify the checksum of the complete file and move it to the
+// location. Existing files are not overwritten.
+func (u *uploadInfo) finish() error {
+	if u.Checksum != "" {
+		h, expected, err := parseChecksum(u.Checksum)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(u.partFile())
+		if err != nil {
+			return errorrepo.NewError("REST00116", u.partFile(), err)
+		}
+		_, err = io.CopyBuffer(h, f, make([]byte, blockSize))
+		f.Close()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(h.Sum(nil), expected) {
+			u.remove()
+			return errorrepo.NewError("REST00123", u.ID)
+		}
+	}
+	// a hard link fails if the file exists, rename is used on file systems
+	// without link support
+	err := os.Link(u.partFile(), u.Target)
+	switch {
+	case err == nil:
+	case errors.Is(err, fs.ErrExist):
+		return errorrepo.NewError("REST00125", u.File)
+	default:
+		if _, serr := os.Stat(u.Target); serr == nil {
+			return errorrepo.NewError("REST00125", u.File)
+		}
+		if err = os.Rename(u.partFile(), u.Target); err != nil {
+			return errorrepo.NewError("REST00117", u.File, err)
+		}
+	}
+	u.remove()
+	uploadLocks.Delete(u.ID)
+	u.complete = true
+	log.Log.Debugf("Upload %s complete, stored %s", u.ID, u.Target)
+	return nil
+}
+
+// uploadAccess check the user created the upload and may write into the
+// location
+func uploadAccess(session *clu.Context, u *uploadInfo) bool {
+	return u.User == session.UserName() && Validate(session, auth.UserRole, ">"+u.Location)
+}
+
+// CreateUpload implements createUpload operation.
+//
+// Create a resumable upload of a new file to the given location.
+//
+// POST /rest/upload/{path}
+func (Handler) CreateUpload(ctx context.Context, params api.CreateUploadParams) (r api.CreateUploadRes, _ error) {
+	d, path, err := extraceLocationPath(params.Path)
+	if err != nil {
+		log.Log.Errorf("Error extracting location path: %v (original=%v)", err, params.Path)
+		return (*api.CreateUploadNotFound)(uploadError(err)), nil
+	}
+	session := ctx.(*clu.Context)
+	if !Validate(session, auth.UserRole, ">"+d.Name) {
+		return &api.CreateUploadForbidden{}, nil
+	}
+	u, err := newUpload(d, path, params.File.Value, session.UserName(), params.UploadLength,
+		params.UploadChecksum.Value)
+	if err != nil {
+		log.Log.Errorf("Error create upload: %v", err)
+		if e, ok := err.(*errorrepo.Error); ok && e.ID() == "REST00125" {
+			return (*api.CreateUploadConflict)(uploadError(err)), nil
+		}
+		return (*api.CreateUploadBadRequest)(uploadError(err)), nil
+	}
+	return &api.UploadStatusHeaders{Location: api.NewOptString("/rest/uploads/" + u.ID),
+		UploadOffset: api.NewOptInt64(u.offset), Response: u.status()}, nil
+}
+
+// GetUpload implements getUpload operation.
+//
+// Retrieves the offset and state of a resumable upload.
+//
+// GET /rest/uploads/{uploadId}
+func (Handler) GetUpload(ctx context.Context, params api.GetUploadParams) (r api.GetUploadRes, _ error) {
+	u, err := loadUpload(params.UploadId)
+	if err != nil {
+		return uploadError(err), nil
+	}
+	session := ctx.(*clu.Context)
+	if !uploadAccess(session, u) {
+		return &api.GetUploadForbidden{}, nil
+	}
+	return &api.GetUploadOKHeaders{UploadOffset: api.NewOptInt64(u.offset), Response: u.status()}, nil
+}
+
+// UploadChunk implements uploadChunk operation.
+//
+// Append a chunk of data at the offset of a resumable upload.
+//
+// PATCH /rest/uploads/{uploadId}
+func (Handler) UploadChunk(ctx context.Context, req api.UploadChunkReq, params api.UploadChunkParams) (r api.UploadChunkRes, _ error) {
+	u, err := loadUpload(params.UploadId)
+	if err != nil {
+		return (*api.UploadChunkNotFound)(uploadError(err)), nil
+	}
+	session := ctx.(*clu.Context)
+	if !uploadAccess(session, u) {
+		return &api.UploadChunkForbidden{}, nil
+	}
+	err = u.write(params.UploadOffset, req.Data, params.UploadChecksum.Value)
+	if err != nil {
+		log.Log.Errorf("Error upload chunk %s: %v", u.ID, err)
+		if e, ok := err.(*errorrepo.Error); ok {
+			switch e.ID() {
+			case "REST00119":
+				return (*api.UploadChunkNotFound)(uploadError(err)), nil
+			case "REST00120", "REST00121", "REST00125":
+				return (*api.UploadChunkConflict)(uploadError(err)), nil
+			default:
+			}
+		}
+		return (*api.UploadChunkBadRequest)(uploadError(err)), nil
+	}
+	return &api.GetUploadOKHeaders{UploadOffset: api.NewOptInt64(u.offset), Response: u.status()}, nil
+}
+
+// CancelUpload implements cancelUpload operation.
+//
+// Cancel a resumable upload and remove the received data.
+//
+// DELETE /rest/uploads/{uploadId}
+func (Handler) CancelUpload(ctx context.Context, params api.CancelUploadParams) (r api.CancelUploadRes, _ error) {
+	u, err := loadUpload(params.UploadId)
+	if err != nil {
+		return uploadError(err), nil
+	}
+	session := ctx.(*clu.Context)
+	if !uploadAccess(session, u) {
+		return &api.CancelUploadForbidden{}, nil
+	}
+	l, _ := uploadLocks.LoadOrStore(u.ID, &sync.Mutex{})
+	lock := l.(*sync.Mutex)
+	if !lock.TryLock() {
+		return uploadError(errorrepo.NewError("REST00121", u.ID)), nil
+	}
+	u.remove()
+	uploadLocks.Delete(u.ID)
+	lock.Unlock()
+	v := api.StatusResponseStatus{Message: api.NewOptString("cancelled")}
+	return &api.StatusResponse{Status: api.NewOptStatusResponseStatus(v)}, nil
+}
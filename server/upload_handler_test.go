@@ -0,0 +1,130 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu"
+)
+
+func testUploadLocation(t *testing.T) *clu.Directory {
+	viewer := clu.Viewer
+	t.Cleanup(func() { clu.Viewer = viewer })
+	clu.Viewer = &clu.RestServer{}
+	d := clu.Directory{Name: "tmp", Location: t.TempDir()}
+	clu.Viewer.FileTransfer.Directories.Directory = []clu.Directory{d}
+	return &d
+}
+
+func uploadChecksum(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return "sha256 " + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func TestUploadResume(t *testing.T) {
+	d := testUploadLocation(t)
+	content := "0123456789abcdefghij"
+	u, err := newUpload(d, "/", "logs.tar", "admin", int64(len(content)), uploadChecksum(content))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	u, err = loadUpload(u.ID)
+	assert.NoError(t, err)
+	assert.NoError(t, u.write(0, strings.NewReader(content[:8]), uploadChecksum(content[:8])))
+	assert.Equal(t, "REST00120", errorID(u.write(4, strings.NewReader(content[4:]), "")))
+	assert.Equal(t, "REST00123", errorID(u.write(8, strings.NewReader(content[8:12]), uploadChecksum("xxxx"))))
+	assert.Equal(t, "REST00122", errorID(u.write(8, strings.NewReader(content[8:]+"x"), "")))
+
+	u, err = loadUpload(u.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), u.offset)
+	assert.NoError(t, u.write(8, strings.NewReader(content[8:]), ""))
+	assert.True(t, u.complete)
+	data, err := os.ReadFile(filepath.Join(d.Location, "logs.tar"))
+	assert.NoError(t, err)
+	assert.Equal(t, content, string(data))
+	_, err = loadUpload(u.ID)
+	assert.Equal(t, "REST00119", errorID(err))
+
+	_, err = newUpload(d, "/", "logs.tar", "admin", 1, "")
+	assert.Equal(t, "REST00125", errorID(err))
+	_, err = newUpload(d, "/", "other.tar", "admin", 1, "crc32 AAAA")
+	assert.Equal(t, "REST00124", errorID(err))
+}
+
+func TestUploadChecksumMismatch(t *testing.T) {
+	d := testUploadLocation(t)
+	u, err := newUpload(d, "/", "data.bin", "admin", 4, uploadChecksum("abcd"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "REST00123", errorID(u.write(0, strings.NewReader("abce"), "")))
+	_, err = os.Stat(filepath.Join(d.Location, "data.bin"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = loadUpload(u.ID)
+	assert.Error(t, err)
+}
+
+func TestUploadExpiry(t *testing.T) {
+	d := testUploadLocation(t)
+	u, err := newUpload(d, "/", "old.bin", "admin", 10, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	u.Expires = time.Now().Add(-time.Minute)
+	assert.NoError(t, u.store())
+	expireUploads(uploadPath(d))
+	_, err = os.Stat(u.partFile())
+	assert.True(t, os.IsNotExist(err))
+	_, err = loadUpload(u.ID)
+	assert.Equal(t, "REST00119", errorID(err))
+	_, err = loadUpload("../../etc/passwd")
+	assert.Equal(t, "REST00119", errorID(err))
+}
+
+func TestUploadTarget(t *testing.T) {
+	d := testUploadLocation(t)
+	target, err := uploadTarget(d, "/docs/", "logs.tar")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(d.Location, "docs", "logs.tar"), target)
+	for _, f := range [][2]string{{"/", "../../../etc/cron.d/x"}, {"/", "a/b"}, {"/", `a\b`}, {"/", ".."},
+		{"/../", "x"}, {"/", ""}, {"/.uploads/", "x"}, {"/", ".uploads"}} {
+		_, err = uploadTarget(d, f[0], f[1])
+		assert.Equal(t, "REST00126", errorID(err), f[0]+f[1])
+	}
+	_, err = newUpload(d, "/", "../../../etc/cron.d/x", "admin", 1, "")
+	assert.Equal(t, "REST00126", errorID(err))
+}
+
+func TestExpireAllUploads(t *testing.T) {
+	d := testUploadLocation(t)
+	u, err := newUpload(d, "/", "logs.tar", "admin", 10, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	expireAllUploads()
+	assert.FileExists(t, u.partFile())
+	u.Expires = time.Now().Add(-time.Minute)
+	assert.NoError(t, u.store())
+	expireAllUploads()
+	assert.NoFileExists(t, u.partFile())
+	assert.NoFileExists(t, filepath.Join(u.dir, u.ID+".json"))
+}
@@ -0,0 +1,266 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+)
+
+// upsert insert records and handle conflicts with existing records
+type upsert struct {
+	table     string
+	driver    common.ReferenceType
+	mode      api.InsertRecordOnConflict
+	fields    []string
+	conflict  []string
+	update    []string
+	returning []string
+	inserted  int
+	updated   int
+	// returned values of the returning fields, one entry per record
+	returned [][]any
+}
+
+// newUpsert check the conflict options against the table metadata
+func newUpsert(session *clu.Context, table string, fields []string, params api.InsertRecordParams) (*upsert, error) {
+	u := &upsert{table: table, driver: TableDriver(table),
+		mode: params.OnConflict.Or(api.InsertRecordOnConflictError)}
+	switch u.driver {
+	case common.PostgresType, common.MysqlType:
+	default:
+		return nil, errorrepo.NewError("REST00026", u.driver.String())
+	}
+	m, err := tableMetadata(session, table)
+	if err != nil {
+		return nil, err
+	}
+	if err = u.options(m, fields, params); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// options check fields, conflict fields, update fields and returning fields
+// against the table columns
+func (u *upsert) options(m *api.TableMetadata, fields []string, params api.InsertRecordParams) error {
+	columns := make(map[string]string)
+	primaryKey := make([]string, 0)
+	for _, c := range m.Columns {
+		columns[strings.ToLower(c.Name.Value)] = c.Name.Value
+		if c.PrimaryKey.Value {
+			primaryKey = append(primaryKey, c.Name.Value)
+		}
+	}
+	column := func(list string) ([]string, error) {
+		names := make([]string, 0)
+		for _, f := range strings.Split(list, ",") {
+			c, ok := columns[strings.ToLower(strings.TrimSpace(f))]
+			if !ok {
+				return nil, errorrepo.NewError("RERR00026", f)
+			}
+			names = append(names, c)
+		}
+		return names, nil
+	}
+	var err error
+	u.fields, err = column(strings.Join(fields, ","))
+	if err != nil {
+		return err
+	}
+	if params.ConflictFields.Value != "" {
+		// MySQL handles the conflicts of all unique keys
+		if u.driver == common.MysqlType {
+			return errorrepo.NewError("REST00026", u.driver.String())
+		}
+		u.conflict, err = column(params.ConflictFields.Value)
+		if err != nil {
+			return err
+		}
+	} else {
+		u.conflict = primaryKey
+	}
+	if len(u.conflict) == 0 && u.driver == common.PostgresType {
+		return errorrepo.NewError("REST00027", u.table)
+	}
+	switch u.mode {
+	case api.InsertRecordOnConflictUpdateAll:
+		for _, f := range u.fields {
+			if !containsFold(u.conflict, f) {
+				u.update = append(u.update, f)
+			}
+		}
+	case api.InsertRecordOnConflictUpdateListedFields:
+		if params.UpdateFields.Value == "" {
+			return errorrepo.NewError("REST00028")
+		}
+		u.update, err = column(params.UpdateFields.Value)
+		if err != nil {
+			return err
+		}
+		for _, f := range u.update {
+			if !containsFold(u.fields, f) {
+				return errorrepo.NewError("RERR00026", f)
+			}
+		}
+	default:
+	}
+	if params.Returning.Value != "" {
+		if u.driver != common.PostgresType {
+			return errorrepo.NewError("REST00026", u.driver.String())
+		}
+		u.returning, err = column(params.Returning.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// containsFold check if list contains the name ignoring case
+func containsFold(list []string, name string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// statement generate the dialect specific insert statement of one record
+func (u *upsert) statement() string {
+	var sb strings.Builder
+	if u.driver == common.MysqlType && len(u.update) == 0 {
+		sb.WriteString("INSERT IGNORE INTO ")
+	} else {
+		sb.WriteString("INSERT INTO ")
+	}
+	sb.WriteString(u.table + " (" + strings.Join(u.fields, ",") + ") VALUES (")
+	for i := range u.fields {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		if u.driver == common.PostgresType {
+			sb.WriteString("$" + strconv.Itoa(i+1))
+		} else {
+			sb.WriteString("?")
+		}
+	}
+	sb.WriteString(")")
+	set := make([]string, 0, len(u.update))
+	if u.driver == common.PostgresType {
+		sb.WriteString(" ON CONFLICT (" + strings.Join(u.conflict, ",") + ")")
+		if len(u.update) == 0 {
+			sb.WriteString(" DO NOTHING")
+		} else {
+			for _, f := range u.update {
+				set = append(set, f+"=EXCLUDED."+f)
+			}
+			sb.WriteString(" DO UPDATE SET " + strings.Join(set, ","))
+		}
+		sb.WriteString(" RETURNING (xmax = 0)")
+		for _, r := range u.returning {
+			sb.WriteString("," + r)
+		}
+	} else if len(u.update) > 0 {
+		for _, f := range u.update {
+			set = append(set, f+"=VALUES("+f+")")
+		}
+		sb.WriteString(" ON DUPLICATE KEY UPDATE " + strings.Join(set, ","))
+	}
+	log.Log.Debugf("Upsert statement: %s", sb.String())
+	return sb.String()
+}
+
+// execute insert all records in one transaction counting the inserted and
+// updated records. Ignored records are not counted and return no values.
+func (u *upsert) execute(d common.RegDbID, values [][]any) error {
+	dbOpen, err := d.Open()
+	if err != nil {
+		return err
+	}
+	statement := u.statement()
+	ctx := context.Background()
+	switch db := dbOpen.(type) {
+	case *pgxpool.Conn:
+		tx, err := db.Begin(ctx)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback(ctx)
+		for _, v := range values {
+			rows, err := tx.Query(ctx, statement, v...)
+			if err != nil {
+				return err
+			}
+			returned := false
+			for rows.Next() {
+				row, err := rows.Values()
+				if err != nil {
+					rows.Close()
+					return err
+				}
+				if inserted, ok := row[0].(bool); ok && inserted {
+					u.inserted++
+				} else {
+					u.updated++
+				}
+				u.returned = append(u.returned, row[1:])
+				returned = true
+			}
+			rows.Close()
+			if err = rows.Err(); err != nil {
+				return err
+			}
+			if !returned {
+				u.returned = append(u.returned, make([]any, len(u.returning)))
+			}
+		}
+		return tx.Commit(ctx)
+	case *sql.DB:
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+		for _, v := range values {
+			res, err := tx.ExecContext(ctx, statement, v...)
+			if err != nil {
+				return err
+			}
+			// MySQL reports 1 for inserted and 2 for updated records
+			n, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			switch n {
+			case 1:
+				u.inserted++
+			case 2:
+				u.updated++
+			default:
+			}
+		}
+		return tx.Commit()
+	default:
+		return errorrepo.NewError("REST00026", u.driver.String())
+	}
+}
@@ -0,0 +1,100 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/flynn/common"
+)
+
+func testUpsertMetadata() *api.TableMetadata {
+	return &api.TableMetadata{Columns: []api.TableColumn{
+		{Name: api.NewOptString("ID"), PrimaryKey: api.NewOptBool(true)},
+		{Name: api.NewOptString("Title")},
+		{Name: api.NewOptString("Price")},
+		{Name: api.NewOptString("Code")}}}
+}
+
+func TestUpsertStatement(t *testing.T) {
+	tests := []struct {
+		driver common.ReferenceType
+		mode   api.InsertRecordOnConflict
+		want   string
+	}{
+		{common.PostgresType, api.InsertRecordOnConflictError,
+			"INSERT INTO Albums (ID,Title,Price) VALUES ($1,$2,$3) ON CONFLICT (ID) DO NOTHING RETURNING (xmax = 0)"},
+		{common.PostgresType, api.InsertRecordOnConflictIgnore,
+			"INSERT INTO Albums (ID,Title,Price) VALUES ($1,$2,$3) ON CONFLICT (ID) DO NOTHING RETURNING (xmax = 0)"},
+		{common.PostgresType, api.InsertRecordOnConflictUpdateAll,
+			"INSERT INTO Albums (ID,Title,Price) VALUES ($1,$2,$3) ON CONFLICT (ID)" +
+				" DO UPDATE SET Title=EXCLUDED.Title,Price=EXCLUDED.Price RETURNING (xmax = 0)"},
+		{common.PostgresType, api.InsertRecordOnConflictUpdateListedFields,
+			"INSERT INTO Albums (ID,Title,Price) VALUES ($1,$2,$3) ON CONFLICT (ID)" +
+				" DO UPDATE SET Price=EXCLUDED.Price RETURNING (xmax = 0)"},
+		{common.MysqlType, api.InsertRecordOnConflictError,
+			"INSERT IGNORE INTO Albums (ID,Title,Price) VALUES (?,?,?)"},
+		{common.MysqlType, api.InsertRecordOnConflictIgnore,
+			"INSERT IGNORE INTO Albums (ID,Title,Price) VALUES (?,?,?)"},
+		{common.MysqlType, api.InsertRecordOnConflictUpdateAll,
+			"INSERT INTO Albums (ID,Title,Price) VALUES (?,?,?)" +
+				" ON DUPLICATE KEY UPDATE Title=VALUES(Title),Price=VALUES(Price)"},
+		{common.MysqlType, api.InsertRecordOnConflictUpdateListedFields,
+			"INSERT INTO Albums (ID,Title,Price) VALUES (?,?,?) ON DUPLICATE KEY UPDATE Price=VALUES(Price)"},
+	}
+	for _, tt := range tests {
+		u := &upsert{table: "Albums", driver: tt.driver, mode: tt.mode}
+		params := api.InsertRecordParams{UpdateFields: api.NewOptString("price")}
+		if assert.NoError(t, u.options(testUpsertMetadata(), []string{"id", "title", "PRICE"}, params), tt.want) {
+			assert.Equal(t, tt.want, u.statement(), tt.want)
+		}
+	}
+
+	u := &upsert{table: "Albums", driver: common.PostgresType, mode: api.InsertRecordOnConflictUpdateAll}
+	params := api.InsertRecordParams{ConflictFields: api.NewOptString("code"), Returning: api.NewOptString("id")}
+	if assert.NoError(t, u.options(testUpsertMetadata(), []string{"title", "code"}, params)) {
+		assert.Equal(t, "INSERT INTO Albums (Title,Code) VALUES ($1,$2) ON CONFLICT (Code)"+
+			" DO UPDATE SET Title=EXCLUDED.Title RETURNING (xmax = 0),ID", u.statement())
+	}
+}
+
+func TestUpsertOptionErrors(t *testing.T) {
+	tests := []struct {
+		driver common.ReferenceType
+		mode   api.InsertRecordOnConflict
+		params api.InsertRecordParams
+		fields []string
+		id     string
+	}{
+		{common.MysqlType, api.InsertRecordOnConflictIgnore,
+			api.InsertRecordParams{ConflictFields: api.NewOptString("code")}, []string{"code"}, "REST00026"},
+		{common.MysqlType, api.InsertRecordOnConflictIgnore,
+			api.InsertRecordParams{Returning: api.NewOptString("id")}, []string{"code"}, "REST00026"},
+		{common.PostgresType, api.InsertRecordOnConflictUpdateListedFields,
+			api.InsertRecordParams{}, []string{"id", "title"}, "REST00028"},
+		{common.PostgresType, api.InsertRecordOnConflictUpdateListedFields,
+			api.InsertRecordParams{UpdateFields: api.NewOptString("price")}, []string{"id", "title"}, "RERR00026"},
+		{common.PostgresType, api.InsertRecordOnConflictIgnore,
+			api.InsertRecordParams{}, []string{"id", "unknown"}, "RERR00026"},
+		{common.PostgresType, api.InsertRecordOnConflictIgnore,
+			api.InsertRecordParams{ConflictFields: api.NewOptString("id;DROP")}, []string{"id"}, "RERR00026"},
+	}
+	for _, tt := range tests {
+		u := &upsert{table: "Albums", driver: tt.driver, mode: tt.mode}
+		assert.Equal(t, tt.id, errorID(u.options(testUpsertMetadata(), tt.fields, tt.params)))
+	}
+	u := &upsert{table: "Albums", driver: common.PostgresType, mode: api.InsertRecordOnConflictIgnore}
+	m := &api.TableMetadata{Columns: []api.TableColumn{{Name: api.NewOptString("Title")}}}
+	assert.Equal(t, "REST00027", errorID(u.options(m, []string{"title"}, api.InsertRecordParams{})))
+}
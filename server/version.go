@@ -0,0 +1,443 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/jx"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tknie/clu"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/errorrepo"
+	"github.com/tknie/flynn/common"
+	"github.com/tknie/log"
+)
+
+// etagField record field containing the ETag of records of versioned tables
+const etagField = "_etag"
+
+// recordVersion version or last-modified column of a table used for
+// optimistic concurrency
+type recordVersion struct {
+	table     string
+	column    string
+	timestamp bool
+	driver    common.ReferenceType
+	columns   map[string]string
+}
+
+// versionConflict the stored version does not match the expected version
+type versionConflict struct {
+	keys []any
+}
+
+// errVersionConflict rollback of the transaction on a version conflict
+var errVersionConflict = errors.New("version conflict")
+
+// tableVersion version definition of the table, nil if the table has no
+// version column configured
+func tableVersion(session *clu.Context, table string) (*recordVersion, error) {
+	entry, err := clu.SearchTable(table)
+	if err != nil {
+		return nil, err
+	}
+	column := entry.Database.VersionColumn(table)
+	if column == "" {
+		return nil, nil
+	}
+	m, err := tableMetadata(session, table)
+	if err != nil {
+		return nil, err
+	}
+	v := &recordVersion{table: table, driver: TableDriver(table), columns: make(map[string]string)}
+	for _, c := range m.Columns {
+		v.columns[strings.ToLower(c.Name.Value)] = c.Name.Value
+		if strings.EqualFold(c.Name.Value, column) {
+			v.column = c.Name.Value
+			t := strings.ToLower(c.Type.Value)
+			v.timestamp = strings.Contains(t, "time") || strings.Contains(t, "date")
+		}
+	}
+	if v.column == "" {
+		return nil, errorrepo.NewError("RERR00026", column)
+	}
+	return v, nil
+}
+
+// versionETag ETag of the version value
+func versionETag(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return `"` + t.UTC().Format(time.RFC3339Nano) + `"`
+	case *time.Time:
+		if t == nil {
+			return ""
+		}
+		return versionETag(*t)
+	case pgtype.Numeric:
+		if i, err := t.Int64Value(); err == nil {
+			return `"` + strconv.FormatInt(i.Int64, 10) + `"`
+		}
+	default:
+	}
+	return `"` + metadataString(v) + `"`
+}
+
+// parseETag strip weak marker and quotes of the ETag
+func parseETag(etag string) string {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	return strings.Trim(etag, `"`)
+}
+
+// value convert the expected version into the column type
+func (v *recordVersion) value(x any) (any, error) {
+	switch t := x.(type) {
+	case time.Time:
+		if v.timestamp {
+			return t, nil
+		}
+	case int:
+		if !v.timestamp {
+			return int64(t), nil
+		}
+	case float64:
+		if !v.timestamp {
+			return int64(t), nil
+		}
+	case string:
+		s := parseETag(t)
+		if v.timestamp {
+			if ts, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return ts, nil
+			}
+		} else if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i, nil
+		}
+	default:
+	}
+	return nil, errorrepo.NewError("REST00031", metadataString(x))
+}
+
+// addETag add the ETag of the version column value of the row to the item
+func (v *recordVersion) addETag(item api.ResponseRecordsItem, fields []string, rows []any) {
+	for i, f := range fields {
+		if strings.EqualFold(f, v.column) && i < len(rows) {
+			if etag := versionETag(rows[i]); etag != "" {
+				e := &jx.Encoder{}
+				e.Str(etag)
+				item[etagField] = jx.Raw(e.Bytes())
+			}
+			return
+		}
+	}
+}
+
+// fields add the version column to the query fields if not part of it
+func (v *recordVersion) fields(fields []string) []string {
+	for _, f := range fields {
+		if f == "*" || strings.EqualFold(f, v.column) {
+			return fields
+		}
+	}
+	return append(fields, v.column)
+}
+
+// recordETag ETag of a record item containing the ETag field
+func recordETag(item api.ResponseRecordsItem) string {
+	raw, ok := item[etagField]
+	if !ok {
+		return ""
+	}
+	etag, err := jx.DecodeBytes(raw).Str()
+	if err != nil {
+		return ""
+	}
+	return etag
+}
+
+// placeholder bind placeholder of the driver
+func (v *recordVersion) placeholder(i int) string {
+	switch v.driver {
+	case common.PostgresType:
+		return "$" + strconv.Itoa(i)
+	case common.OracleType:
+		return ":" + strconv.Itoa(i)
+	default:
+	}
+	return "?"
+}
+
+// statement generate update statement of one record setting all record
+// fields and increasing the version. If the expected version is given, the
+// update is only done if the stored version matches.
+func (v *recordVersion) statement(record map[string]any, keys []string, expected any) (string, []any, error) {
+	args := make([]any, 0)
+	set := make([]string, 0)
+	for n, x := range record {
+		c, ok := v.columns[strings.ToLower(n)]
+		if !ok {
+			if n == etagField {
+				continue
+			}
+			return "", nil, errorrepo.NewError("RERR00026", n)
+		}
+		if c == v.column || containsFold(keys, c) {
+			continue
+		}
+		args = append(args, x)
+		set = append(set, c+"="+v.placeholder(len(args)))
+	}
+	switch {
+	case v.timestamp && v.driver == common.MysqlType:
+		// CURRENT_TIMESTAMP has only seconds resolution on MySQL
+		set = append(set, v.column+"=CURRENT_TIMESTAMP(6)")
+	case v.timestamp:
+		set = append(set, v.column+"=CURRENT_TIMESTAMP")
+	default:
+		// records without version start with version 1
+		set = append(set, v.column+"=COALESCE("+v.column+",0)+1")
+	}
+	where, keyValues, err := v.where(record, keys, len(args))
+	if err != nil {
+		return "", nil, err
+	}
+	args = append(args, keyValues...)
+	if expected != nil {
+		args = append(args, expected)
+		where += " AND " + v.column + "=" + v.placeholder(len(args))
+	}
+	statement := "UPDATE " + v.table + " SET " + strings.Join(set, ",") + " WHERE " + where
+	if v.driver == common.PostgresType {
+		statement += " RETURNING " + v.column
+	}
+	log.Log.Debugf("Versioned update: %s", statement)
+	return statement, args, nil
+}
+
+// where generate key condition of the record
+func (v *recordVersion) where(record map[string]any, keys []string, offset int) (string, []any, error) {
+	cond := make([]string, 0, len(keys))
+	values := make([]any, 0, len(keys))
+	for _, k := range keys {
+		c, ok := v.columns[strings.ToLower(strings.TrimSpace(k))]
+		if !ok {
+			return "", nil, errorrepo.NewError("RERR00026", k)
+		}
+		x, found := findField(record, c)
+		if !found {
+			return "", nil, errorrepo.NewError("REST00032", c)
+		}
+		values = append(values, x)
+		cond = append(cond, c+"="+v.placeholder(offset+len(values)))
+	}
+	return strings.Join(cond, " AND "), values, nil
+}
+
+// findField search record field ignoring case
+func findField(record map[string]any, name string) (any, bool) {
+	for n, x := range record {
+		if strings.EqualFold(n, name) {
+			return x, true
+		}
+	}
+	return nil, false
+}
+
+// expected expected version of the record taken out of the If-Match
+// header, the ETag field or the version field of the record. Nil if no
+// version is given.
+func (v *recordVersion) expected(record map[string]any, ifMatch api.OptString) (any, error) {
+	switch {
+	case ifMatch.Set && parseETag(ifMatch.Value) != "*":
+		return v.value(ifMatch.Value)
+	case record[etagField] != nil:
+		return v.value(record[etagField])
+	default:
+		if x, ok := findField(record, v.column); ok && x != nil {
+			return v.value(x)
+		}
+	}
+	return nil, nil
+}
+
+// newVersion scan target of the version column
+func (v *recordVersion) newVersion() any {
+	if v.timestamp {
+		return &time.Time{}
+	}
+	return new(int64)
+}
+
+// versionValue dereference the scanned version
+func versionValue(nv any) any {
+	switch t := nv.(type) {
+	case *time.Time:
+		return *t
+	case *int64:
+		return *t
+	default:
+	}
+	return nv
+}
+
+// versionExec run the versioned update of one record and return the new
+// version. Nil is returned if no record is updated.
+type versionExec func(record map[string]any, keys []string, expected any) (any, error)
+
+// pgxQuerier pgx connection or transaction
+type pgxQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// pgxExec versioned update using the RETURNING clause on Postgres
+func (v *recordVersion) pgxExec(ctx context.Context, q pgxQuerier) versionExec {
+	return func(record map[string]any, keys []string, expected any) (any, error) {
+		statement, args, err := v.statement(record, keys, expected)
+		if err != nil {
+			return nil, err
+		}
+		nv := v.newVersion()
+		err = q.QueryRow(ctx, statement, args...).Scan(nv)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, nil
+		case err != nil:
+			return nil, err
+		default:
+		}
+		return versionValue(nv), nil
+	}
+}
+
+// sqlExec versioned update re-reading the new version inside the
+// transaction
+func (v *recordVersion) sqlExec(ctx context.Context, tx *sql.Tx) versionExec {
+	return func(record map[string]any, keys []string, expected any) (any, error) {
+		statement, args, err := v.statement(record, keys, expected)
+		if err != nil {
+			return nil, err
+		}
+		res, err := tx.ExecContext(ctx, statement, args...)
+		if err != nil {
+			return nil, err
+		}
+		n, err := res.RowsAffected()
+		if err != nil || n == 0 {
+			return nil, err
+		}
+		where, keyValues, err := v.where(record, keys, 0)
+		if err != nil {
+			return nil, err
+		}
+		nv := v.newVersion()
+		err = tx.QueryRowContext(ctx, "SELECT "+v.column+" FROM "+v.table+" WHERE "+where, keyValues...).Scan(nv)
+		if err != nil {
+			return nil, err
+		}
+		return versionValue(nv), nil
+	}
+}
+
+// execute run the versioned updates of fct in one own database
+// transaction. The transaction is only committed if fct returns no error.
+func (v *recordVersion) execute(d common.RegDbID, fct func(exec versionExec) error) error {
+	dbOpen, err := d.Open()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	switch db := dbOpen.(type) {
+	case *pgxpool.Conn:
+		tx, err := db.Begin(ctx)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback(ctx)
+		if err = fct(v.pgxExec(ctx, tx)); err != nil {
+			return err
+		}
+		return tx.Commit(ctx)
+	case *sql.DB:
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+		if err = fct(v.sqlExec(ctx, tx)); err != nil {
+			return err
+		}
+		return tx.Commit()
+	default:
+		return errorrepo.NewError("REST00033", v.driver.String())
+	}
+}
+
+// update update all records in one transaction. The new version of each
+// record is returned. A version mismatch returns the versionConflict.
+func (v *recordVersion) update(d common.RegDbID, records []map[string]any, keys []string,
+	expected []any) ([]any, *versionConflict, error) {
+	versions := make([]any, 0, len(records))
+	var conflict *versionConflict
+	err := v.execute(d, func(exec versionExec) error {
+		for i, r := range records {
+			nv, err := exec(r, keys, expected[i])
+			if err != nil {
+				return err
+			}
+			if nv == nil && expected[i] != nil {
+				_, keyValues, _ := v.where(r, keys, 0)
+				conflict = &versionConflict{keys: keyValues}
+				return errVersionConflict
+			}
+			versions = append(versions, nv)
+		}
+		return nil
+	})
+	switch {
+	case conflict != nil:
+		return nil, conflict, nil
+	case err != nil:
+		return nil, nil, err
+	default:
+	}
+	return versions, nil, nil
+}
+
+// current read the current records of the conflicting keys
+func (v *recordVersion) current(d common.RegDbID, keys []string, conflict *versionConflict) ([]api.ResponseRecordsItem, error) {
+	cond := make([]string, 0, len(keys))
+	for i, k := range keys {
+		cond = append(cond, v.columns[strings.ToLower(strings.TrimSpace(k))]+"="+v.placeholder(i+1))
+	}
+	data := make([]api.ResponseRecordsItem, 0)
+	err := d.BatchSelectFct(&common.Query{Search: "SELECT * FROM " + v.table + " WHERE " + strings.Join(cond, " AND "),
+		Parameters: conflict.keys}, func(search *common.Query, result *common.Result) error {
+		if result == nil {
+			return errorrepo.NewError("REST00011")
+		}
+		item := generateItem(result.Fields, result.Rows)
+		v.addETag(item, result.Fields, result.Rows)
+		data = append(data, item)
+		return nil
+	})
+	return data, err
+}
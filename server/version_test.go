@@ -0,0 +1,86 @@
+/*
+* Copyright 2022-2025 Thorsten A. Knieling
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+ */
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tknie/clu/api"
+	"github.com/tknie/flynn/common"
+)
+
+func testVersion(driver common.ReferenceType, timestamp bool) *recordVersion {
+	return &recordVersion{table: "albums", column: "Version", timestamp: timestamp, driver: driver,
+		columns: map[string]string{"id": "ID", "title": "Title", "version": "Version"}}
+}
+
+func TestRecordVersionStatement(t *testing.T) {
+	record := map[string]any{"ID": 1}
+	statement, _, err := testVersion(common.MysqlType, true).statement(record, []string{"id"}, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "UPDATE albums SET Version=CURRENT_TIMESTAMP(6) WHERE ID=?", statement)
+	}
+	statement, _, err = testVersion(common.PostgresType, true).statement(record, []string{"id"}, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "UPDATE albums SET Version=CURRENT_TIMESTAMP WHERE ID=$1 RETURNING Version", statement)
+	}
+	statement, args, err := testVersion(common.MysqlType, false).statement(record, []string{"id"}, int64(3))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "UPDATE albums SET Version=COALESCE(Version,0)+1 WHERE ID=? AND Version=?", statement)
+		assert.Equal(t, []any{1, int64(3)}, args)
+	}
+}
+
+func TestRecordVersionExpected(t *testing.T) {
+	v := testVersion(common.PostgresType, false)
+	e, err := v.expected(map[string]any{"id": 1, "version": 4}, api.OptString{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), e)
+	e, err = v.expected(map[string]any{"id": 1, "version": 4, etagField: `"5"`}, api.OptString{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), e)
+	e, err = v.expected(map[string]any{"id": 1, "version": 4}, api.NewOptString(`W/"6"`))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), e)
+	e, err = v.expected(map[string]any{"id": 1}, api.NewOptString("*"))
+	assert.NoError(t, err)
+	assert.Nil(t, e)
+	_, err = v.expected(map[string]any{"id": 1}, api.NewOptString(`"x"`))
+	assert.Equal(t, "REST00031", errorID(err))
+}
+
+func TestRecordVersionPlaceholder(t *testing.T) {
+	record := map[string]any{"ID": 1, "Title": "x"}
+	statement, args, err := testVersion(common.OracleType, false).statement(record, []string{"id"}, int64(2))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "UPDATE albums SET Title=:1,Version=COALESCE(Version,0)+1 WHERE ID=:2 AND Version=:3", statement)
+		assert.Equal(t, []any{"x", 1, int64(2)}, args)
+	}
+	statement, _, err = testVersion(common.PostgresType, false).statement(record, []string{"id"}, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "UPDATE albums SET Title=$1,Version=COALESCE(Version,0)+1 WHERE ID=$2 RETURNING Version", statement)
+	}
+}
+
+func TestVersionValue(t *testing.T) {
+	v := testVersion(common.PostgresType, true)
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	nv := v.newVersion()
+	*nv.(*time.Time) = ts
+	assert.Equal(t, ts, versionValue(nv))
+	v = testVersion(common.PostgresType, false)
+	nv = v.newVersion()
+	*nv.(*int64) = 3
+	assert.Equal(t, int64(3), versionValue(nv))
+}
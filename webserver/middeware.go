@@ -81,7 +81,9 @@ func InitMiddleWare(s *api.Server) http.Handler {
 		AllowedHeaders: []string{"*"},
 		AllowedOrigins: []string{"*"},
 		AllowedMethods: []string{http.MethodGet, http.MethodPut, http.MethodPost,
-			http.MethodDelete, http.MethodOptions},
+			http.MethodDelete, http.MethodOptions, http.MethodHead, http.MethodPatch},
+		ExposedHeaders: []string{"Link", "X-Total-Count", "ETag",
+			"Content-Range", "Accept-Ranges", "Upload-Offset", "Location"},
 		AllowCredentials: true,
 		MaxAge:           1000,
 	})